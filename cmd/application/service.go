@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/51st-state/api/pkg/api"
+	"github.com/51st-state/api/pkg/apis/privacy"
+	"github.com/51st-state/api/pkg/encode"
+	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/keys"
+	pubsubNSQ "github.com/51st-state/api/pkg/pubsub/nsq"
+	"github.com/51st-state/api/pkg/rbac"
+	"google.golang.org/grpc"
+
+	"github.com/51st-state/api/pkg/apis/application"
+	"github.com/51st-state/api/pkg/apis/application/cockroachdb"
+
+	"github.com/nsqio/go-nsq"
+	"github.com/playnet-public/flagenv"
+	"go.uber.org/zap"
+
+	_ "github.com/lib/pq"
+)
+
+var (
+	httpAddr        = flagenv.String("http-addr", ":8080", "the http address of the service")
+	dbHost          = flagenv.String("db-host", "localhost", "the host of the database")
+	dbPort          = flagenv.Int("db-port", 1234, "the port of the database")
+	dbUsername      = flagenv.String("db-username", "user", "the username of the database")
+	dbPassword      = flagenv.String("db-password", "1234", "the password of the database")
+	dbName          = flagenv.String("db-name", "application", "the name of the database")
+	publicKeyPath   = flagenv.String("public-key-path", "/secrets/public.pem", "the public key to validate jwt token")
+	rbacGRPCAddress = flagenv.String("rbac-grpc-addr", "rbac-service:2345", "the grpc address to the rbac control")
+	whitelistRole   = flagenv.String("whitelist-role", "whitelisted", "the role granted to users with an accepted application")
+	nsqdAddr        = flagenv.String("nsqd-addr", "nsqd:4150", "the address of the nsq lookupd servers")
+	nsqLookupdAddr  = flagenv.String("nsq-lookupd-addr", "nsqlookupd:4161", "the address of the nsq lookupd to consume events from")
+)
+
+func main() {
+	flagenv.Parse()
+
+	l, err := zap.NewProductionConfig().Build()
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	l.Info("connecting to database")
+	db, err := makeCockroachDBDatabase()
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	if err := cockroachdb.CreateSchema(context.Background(), db); err != nil {
+		l.Fatal(err.Error())
+	}
+
+	publicKey, err := keys.GetPublicKey(*publicKeyPath)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	l.Info("creating rbac grpc connection")
+	rbacCtrl, rbacConn, err := makeRBACControl()
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+	defer rbacConn.Close()
+
+	l.Info("registering rbac rules")
+	if err := rbacCtrl.RegisterRules(context.Background(), application.Rules); err != nil {
+		l.Fatal(err.Error())
+	}
+
+	l.Info("creating nsq event producer")
+	eventProd, err := makeNSQEventProducer()
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	repo := cockroachdb.NewRepository(db)
+	m := application.NewManager(repo, rbacCtrl, eventProd, rbac.RoleID(*whitelistRole))
+	go consumeEvents(l, "application-privacy", privacy.NewEventHandler("application", application.NewPrivacyHandler(repo), eventProd))
+
+	a := api.New(*httpAddr, l)
+	a.Get("/applications", application.MakeGetOwnEndpoint(l, m, encode.NewJSONEncoder(), *publicKey))
+	a.Post("/applications", application.MakeSubmitEndpoint(l, m, encode.NewJSONEncoder(), *publicKey))
+	a.Get("/applications/users/{uuid}", application.MakeGetByUserEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/applications/states/{state}", application.MakeGetByStateEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/applications/{guid}", application.MakeGetEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/applications/{guid}/claim", application.MakeClaimEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/applications/{guid}/comments", application.MakeGetCommentsEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/applications/{guid}/comments", application.MakeCommentEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/applications/{guid}/votes", application.MakeGetVotesEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Put("/applications/{guid}/votes", application.MakeVoteEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/applications/{guid}/accept", application.MakeAcceptEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/applications/{guid}/reject", application.MakeRejectEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+
+	if err := a.Serve(); err != nil {
+		l.Fatal(err.Error())
+	}
+}
+
+func makeCockroachDBDatabase() (*sql.DB, error) {
+	return sql.Open("postgres", fmt.Sprintf(
+		"postgres://%s:%s@%s:%d/%s?sslmode=disable",
+		*dbUsername,
+		*dbPassword,
+		*dbHost,
+		*dbPort,
+		*dbName,
+	))
+}
+
+func makeGRPCConn(addr string) (*grpc.ClientConn, error) {
+	return grpc.Dial(
+		addr,
+		grpc.WithInsecure(),
+		grpc.WithTimeout(time.Second*10),
+	)
+}
+
+func makeRBACControl() (rbac.Control, *grpc.ClientConn, error) {
+	conn, err := makeGRPCConn(*rbacGRPCAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rbac.NewGRPCClient(conn), conn, nil
+}
+
+func makeNSQEventProducer() (*event.Producer, error) {
+	p, err := nsq.NewProducer(*nsqdAddr, nsq.NewConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	return event.NewProducer(pubsubNSQ.NewProducer(p, "events")), nil
+}
+
+// consumeEvents shared by all instances of the service on a channel
+func consumeEvents(l *zap.Logger, channel string, h event.HandlerFunc) {
+	c, err := pubsubNSQ.NewConsumer("events", channel, *nsqLookupdAddr, nsq.NewConfig())
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	if err := event.NewConsumer(c).Consume(context.Background(), h); err != nil {
+		l.Fatal(err.Error())
+	}
+}
@@ -15,13 +15,17 @@ import (
 
 	"github.com/51st-state/api/pkg/api"
 	"github.com/51st-state/api/pkg/apis/auth"
+	"github.com/51st-state/api/pkg/apis/privacy"
 	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/event"
+	pubsubNSQ "github.com/51st-state/api/pkg/pubsub/nsq"
 
 	"google.golang.org/grpc"
 
 	"github.com/51st-state/api/pkg/keys"
 
 	"github.com/51st-state/api/pkg/apis/auth/cockroachdb"
+	"github.com/nsqio/go-nsq"
 	"github.com/playnet-public/flagenv"
 	"go.uber.org/zap"
 
@@ -34,6 +38,8 @@ var (
 	privateKeyPath         = flagenv.String("private-key-path", "/secrets/private.pem", "the private key to sign valid access token")
 	grpcUserAddr           = flagenv.String("user-addr", "user-service:2345", "the grpc address to the user microservice")
 	grpcServiceAccountAddr = flagenv.String("serviceaccount-addr", "serviceaccount-service:2345", "the grpc address to the serviceaccount service")
+	nsqdAddr               = flagenv.String("nsqd-addr", "nsqd:4150", "the address of the nsq lookupd servers")
+	nsqLookupdAddr         = flagenv.String("nsq-lookupd-addr", "nsqlookupd:4161", "the address of the nsq lookupd to consume events from")
 
 	recaptchaPrivateKey = flagenv.String("recaptcha-private-key", "", "the private key for authenticating with a recaptcha")
 
@@ -86,9 +92,18 @@ func main() {
 	}
 	defer saKeyManagerConn.Close()
 
+	l.Info("creating nsq event producer")
+	eventProd, err := makeNSQEventProducer()
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	repo := cockroachdb.NewRepository(db)
+	go consumeEvents(l, "auth-privacy", privacy.NewEventHandler("auth", auth.NewPrivacyHandler(repo), eventProd))
+
 	m := auth.NewManager(
 		privateKey,
-		cockroachdb.NewRepository(db),
+		repo,
 		userMgr,
 		&recaptcha.Verifier{
 			Secret: *recaptchaPrivateKey,
@@ -99,6 +114,7 @@ func main() {
 	a := api.New(*httpAddr, l)
 	a.Post("/auth/login", auth.MakeLoginEndpoint(l, m, encode.NewJSONEncoder()))
 	a.Post("/auth/login/recaptcha", auth.MakeRecaptchaLoginEndpoint(l, m, encode.NewJSONEncoder()))
+	a.Post("/auth/login/server", auth.MakeServerLoginEndpoint(l, m, encode.NewJSONEncoder()))
 	a.Post("/auth/refresh", auth.MakeRefreshTokenEndpoint(l, m, encode.NewJSONEncoder(), *publicKey))
 
 	if err := a.Serve(); err != nil {
@@ -142,3 +158,24 @@ func makeServiceAccountKeysManager() (key.Manager, *grpc.ClientConn, error) {
 
 	return key.NewGRPCClient(conn), conn, nil
 }
+
+func makeNSQEventProducer() (*event.Producer, error) {
+	p, err := nsq.NewProducer(*nsqdAddr, nsq.NewConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	return event.NewProducer(pubsubNSQ.NewProducer(p, "events")), nil
+}
+
+// consumeEvents shared by all instances of the service on a channel
+func consumeEvents(l *zap.Logger, channel string, h event.HandlerFunc) {
+	c, err := pubsubNSQ.NewConsumer("events", channel, *nsqLookupdAddr, nsq.NewConfig())
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	if err := event.NewConsumer(c).Consume(context.Background(), h); err != nil {
+		l.Fatal(err.Error())
+	}
+}
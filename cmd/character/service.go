@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/51st-state/api/pkg/api"
+	"github.com/51st-state/api/pkg/apis/privacy"
+	"github.com/51st-state/api/pkg/encode"
+	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/keys"
+	pubsubNSQ "github.com/51st-state/api/pkg/pubsub/nsq"
+	"github.com/51st-state/api/pkg/rbac"
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpcZap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/51st-state/api/pkg/apis/character"
+	"github.com/51st-state/api/pkg/apis/character/cockroachdb"
+	pb "github.com/51st-state/api/pkg/apis/character/proto"
+	"github.com/51st-state/api/pkg/apis/inventory"
+	"github.com/51st-state/api/pkg/apis/preselect"
+	"github.com/51st-state/api/pkg/apis/topgenerator"
+
+	"github.com/nsqio/go-nsq"
+	"github.com/playnet-public/flagenv"
+	"go.uber.org/zap"
+
+	_ "github.com/lib/pq"
+)
+
+var (
+	httpAddr        = flagenv.String("http-addr", ":8080", "the http address of the service")
+	grpcAddr        = flagenv.String("grpc-addr", ":2345", "the grpc addr of the service")
+	dbHost          = flagenv.String("db-host", "localhost", "the host of the database")
+	dbPort          = flagenv.Int("db-port", 1234, "the port of the database")
+	dbUsername      = flagenv.String("db-username", "user", "the username of the database")
+	dbPassword      = flagenv.String("db-password", "1234", "the password of the database")
+	dbName          = flagenv.String("db-name", "character", "the name of the database")
+	publicKeyPath   = flagenv.String("public-key-path", "/secrets/public.pem", "the public key to validate jwt token")
+	rbacGRPCAddress = flagenv.String("rbac-grpc-addr", "rbac-service:2345", "the grpc address to the rbac control")
+	invGRPCAddress  = flagenv.String("inventory-grpc-addr", "inventory-service:2345", "the grpc address to the inventory manager")
+	psGRPCAddress   = flagenv.String("preselect-grpc-addr", "preselect-service:2345", "the grpc address to the pre-selections")
+	topsGRPCAddress = flagenv.String("topgenerator-grpc-addr", "topgenerator-service:2345", "the grpc address to the top generator")
+	nsqdAddr        = flagenv.String("nsqd-addr", "nsqd:4150", "the address of the nsq lookupd servers")
+	nsqLookupdAddr  = flagenv.String("nsq-lookupd-addr", "nsqlookupd:4161", "the address of the nsq lookupd to consume events from")
+)
+
+func main() {
+	flagenv.Parse()
+
+	l, err := zap.NewProductionConfig().Build()
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	l.Info("connecting to database")
+	db, err := makeCockroachDBDatabase()
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	if err := cockroachdb.CreateSchema(context.Background(), db); err != nil {
+		l.Fatal(err.Error())
+	}
+
+	publicKey, err := keys.GetPublicKey(*publicKeyPath)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	l.Info("creating rbac grpc connection")
+	rbacCtrl, rbacConn, err := makeRBACControl()
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+	defer rbacConn.Close()
+
+	l.Info("registering rbac rules")
+	if err := rbacCtrl.RegisterRules(context.Background(), character.Rules); err != nil {
+		l.Fatal(err.Error())
+	}
+
+	l.Info("creating nsq event producer")
+	eventProd, err := makeNSQEventProducer()
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	l.Info("creating inventory grpc connection")
+	invConn, err := makeGRPCConn(*invGRPCAddress)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+	defer invConn.Close()
+	inv := inventory.NewGRPCClient(invConn)
+
+	l.Info("creating preselect grpc connection")
+	psConn, err := makeGRPCConn(*psGRPCAddress)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+	defer psConn.Close()
+
+	l.Info("creating topgenerator grpc connection")
+	topsConn, err := makeGRPCConn(*topsGRPCAddress)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+	defer topsConn.Close()
+
+	repo := cockroachdb.NewRepository(db)
+	m := character.NewManager(
+		repo,
+		inv,
+		rbacCtrl,
+		eventProd,
+		preselect.NewGRPCClient(psConn),
+		topgenerator.NewGRPCClient(topsConn),
+	)
+	go consumeEvents(l, "character-privacy", privacy.NewEventHandler("character", character.NewPrivacyHandler(repo, inv, eventProd), eventProd))
+
+	a := api.New(*httpAddr, l)
+	a.Get("/characters", character.MakeGetOwnEndpoint(l, m, encode.NewJSONEncoder(), *publicKey))
+	a.Post("/characters", character.MakeCreateEndpoint(l, m, encode.NewJSONEncoder(), *publicKey))
+	a.Get("/characters/limit", character.MakeGetLimitEndpoint(l, m, encode.NewJSONEncoder(), *publicKey))
+	a.Get("/characters/users/{uuid}", character.MakeGetByUserEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/characters/{guid}", character.MakeGetEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Patch("/characters/{guid}", character.MakeUpdateEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Delete("/characters/{guid}", character.MakeDeleteEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/characters/{guid}/appearance", character.MakeGetAppearanceEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Put("/characters/{guid}/appearance", character.MakeSetAppearanceEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/characters/{guid}/outfits", character.MakeGetOutfitsEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Put("/characters/{guid}/outfits/{name}", character.MakeSetOutfitEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Delete("/characters/{guid}/outfits/{name}", character.MakeDeleteOutfitEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+
+	go serveGrpc(l, m)
+
+	if err := a.Serve(); err != nil {
+		l.Fatal(err.Error())
+	}
+}
+
+func makeCockroachDBDatabase() (*sql.DB, error) {
+	return sql.Open("postgres", fmt.Sprintf(
+		"postgres://%s:%s@%s:%d/%s?sslmode=disable",
+		*dbUsername,
+		*dbPassword,
+		*dbHost,
+		*dbPort,
+		*dbName,
+	))
+}
+
+func makeGRPCConn(addr string) (*grpc.ClientConn, error) {
+	return grpc.Dial(
+		addr,
+		grpc.WithInsecure(),
+		grpc.WithTimeout(time.Second*10),
+	)
+}
+
+func makeRBACControl() (rbac.Control, *grpc.ClientConn, error) {
+	conn, err := makeGRPCConn(*rbacGRPCAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rbac.NewGRPCClient(conn), conn, nil
+}
+
+func serveGrpc(l *zap.Logger, m character.Manager) {
+	l.Info("preparing grpc server")
+	s := grpc.NewServer(
+		grpc.StreamInterceptor(grpcMiddleware.ChainStreamServer(
+			grpcZap.StreamServerInterceptor(l),
+		)),
+		grpc.UnaryInterceptor(grpcMiddleware.ChainUnaryServer(
+			grpcZap.UnaryServerInterceptor(l),
+		)),
+	)
+	pb.RegisterManagerServer(s, character.NewGRPCServer(m))
+	reflection.Register(s)
+
+	listener, err := net.Listen("tcp", *grpcAddr)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	l.Info("starting grpc server")
+	if err := s.Serve(listener); err != nil {
+		l.Fatal(err.Error())
+	}
+}
+
+func makeNSQEventProducer() (*event.Producer, error) {
+	p, err := nsq.NewProducer(*nsqdAddr, nsq.NewConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	return event.NewProducer(pubsubNSQ.NewProducer(p, "events")), nil
+}
+
+// consumeEvents shared by all instances of the service on a channel
+func consumeEvents(l *zap.Logger, channel string, h event.HandlerFunc) {
+	c, err := pubsubNSQ.NewConsumer("events", channel, *nsqLookupdAddr, nsq.NewConfig())
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	if err := event.NewConsumer(c).Consume(context.Background(), h); err != nil {
+		l.Fatal(err.Error())
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"crypto/rsa"
+	"database/sql"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	"github.com/51st-state/api/pkg/api"
+	"github.com/51st-state/api/pkg/apis/privacy"
+	"github.com/51st-state/api/pkg/encode"
+	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/keys"
+	pubsubNSQ "github.com/51st-state/api/pkg/pubsub/nsq"
+	"github.com/51st-state/api/pkg/rbac"
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpcZap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/51st-state/api/pkg/apis/faction"
+	"github.com/51st-state/api/pkg/apis/faction/cockroachdb"
+	pb "github.com/51st-state/api/pkg/apis/faction/proto"
+
+	"github.com/nsqio/go-nsq"
+	"github.com/playnet-public/flagenv"
+	"go.uber.org/zap"
+
+	_ "github.com/lib/pq"
+)
+
+var (
+	httpAddr            = flagenv.String("http-addr", ":8080", "the http address of the service")
+	grpcAddr            = flagenv.String("grpc-addr", ":2345", "the grpc addr of the service")
+	grpcTrustedNetworks = flagenv.String("grpc-trusted-networks", "127.0.0.0/8,10.0.0.0/8", "the networks of the grpc peers allowed to act on behalf of other accounts")
+	dbHost              = flagenv.String("db-host", "localhost", "the host of the database")
+	dbPort              = flagenv.Int("db-port", 1234, "the port of the database")
+	dbUsername          = flagenv.String("db-username", "user", "the username of the database")
+	dbPassword          = flagenv.String("db-password", "1234", "the password of the database")
+	dbName              = flagenv.String("db-name", "faction", "the name of the database")
+	publicKeyPath       = flagenv.String("public-key-path", "/secrets/public.pem", "the public key to validate jwt token")
+	rbacGRPCAddress     = flagenv.String("rbac-grpc-addr", "rbac-service:2345", "the grpc address to the rbac control")
+	nsqdAddr            = flagenv.String("nsqd-addr", "nsqd:4150", "the address of the nsq lookupd servers")
+	nsqLookupdAddr      = flagenv.String("nsq-lookupd-addr", "nsqlookupd:4161", "the address of the nsq lookupd to consume events from")
+)
+
+func main() {
+	flagenv.Parse()
+
+	l, err := zap.NewProductionConfig().Build()
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	l.Info("connecting to database")
+	db, err := makeCockroachDBDatabase()
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	if err := cockroachdb.CreateSchema(context.Background(), db); err != nil {
+		l.Fatal(err.Error())
+	}
+
+	publicKey, err := keys.GetPublicKey(*publicKeyPath)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	trustedNetworks, err := rbac.ParseNetworks(*grpcTrustedNetworks)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	l.Info("creating rbac grpc connection")
+	rbacCtrl, rbacConn, err := makeRBACControl()
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+	defer rbacConn.Close()
+
+	l.Info("registering rbac rules")
+	if err := rbacCtrl.RegisterRules(context.Background(), faction.Rules); err != nil {
+		l.Fatal(err.Error())
+	}
+
+	l.Info("creating nsq event producer")
+	eventProd, err := makeNSQEventProducer()
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	repo := cockroachdb.NewRepository(db)
+	m := faction.NewManager(
+		repo,
+		rbacCtrl,
+	)
+	go consumeEvents(l, "faction-privacy", privacy.NewEventHandler("faction", faction.NewPrivacyHandler(repo), eventProd))
+
+	a := api.New(*httpAddr, l)
+	a.Get("/factions/{guid}", faction.MakeGetEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Patch("/factions/{guid}", faction.MakeUpdateEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Delete("/factions/{guid}", faction.MakeDeleteEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/factions", faction.MakeCreateEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/factions/{guid}/sync", faction.MakeSyncEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/factions/{guid}/ranks", faction.MakeGetRanksEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Put("/factions/{guid}/ranks/{rank}", faction.MakeSetRankEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Delete("/factions/{guid}/ranks/{rank}", faction.MakeDeleteRankEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/factions/{guid}/members", faction.MakeGetMembersEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Put("/factions/{guid}/members", faction.MakeSetMemberEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Delete("/factions/{guid}/members", faction.MakeRemoveMemberEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/factions/{guid}/invite", faction.MakeInviteEndpoint(l, m, encode.NewJSONEncoder(), *publicKey))
+	a.Post("/factions/{guid}/accept", faction.MakeAcceptInviteEndpoint(l, m, encode.NewJSONEncoder(), *publicKey))
+	a.Post("/factions/{guid}/promote", faction.MakePromoteEndpoint(l, m, encode.NewJSONEncoder(), *publicKey))
+	a.Post("/factions/{guid}/demote", faction.MakeDemoteEndpoint(l, m, encode.NewJSONEncoder(), *publicKey))
+	a.Post("/factions/{guid}/kick", faction.MakeKickEndpoint(l, m, encode.NewJSONEncoder(), *publicKey))
+	a.Post("/factions/{guid}/leave", faction.MakeLeaveEndpoint(l, m, encode.NewJSONEncoder(), *publicKey))
+
+	go serveGrpc(l, m, *publicKey, trustedNetworks)
+
+	if err := a.Serve(); err != nil {
+		l.Fatal(err.Error())
+	}
+}
+
+func makeCockroachDBDatabase() (*sql.DB, error) {
+	return sql.Open("postgres", fmt.Sprintf(
+		"postgres://%s:%s@%s:%d/%s?sslmode=disable",
+		*dbUsername,
+		*dbPassword,
+		*dbHost,
+		*dbPort,
+		*dbName,
+	))
+}
+
+func makeGRPCConn(addr string) (*grpc.ClientConn, error) {
+	return grpc.Dial(
+		addr,
+		grpc.WithInsecure(),
+		grpc.WithTimeout(time.Second*10),
+	)
+}
+
+func makeRBACControl() (rbac.Control, *grpc.ClientConn, error) {
+	conn, err := makeGRPCConn(*rbacGRPCAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rbac.NewGRPCClient(conn), conn, nil
+}
+
+func serveGrpc(l *zap.Logger, m faction.Manager, pK rsa.PublicKey, trusted []*net.IPNet) {
+	l.Info("preparing grpc server")
+	s := grpc.NewServer(
+		grpc.StreamInterceptor(grpcMiddleware.ChainStreamServer(
+			grpcZap.StreamServerInterceptor(l),
+		)),
+		grpc.UnaryInterceptor(grpcMiddleware.ChainUnaryServer(
+			grpcZap.UnaryServerInterceptor(l),
+			rbac.NewActorInterceptor(pK, trusted),
+		)),
+	)
+	pb.RegisterManagerServer(s, faction.NewGRPCServer(m))
+	reflection.Register(s)
+
+	listener, err := net.Listen("tcp", *grpcAddr)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	l.Info("starting grpc server")
+	if err := s.Serve(listener); err != nil {
+		l.Fatal(err.Error())
+	}
+}
+
+func makeNSQEventProducer() (*event.Producer, error) {
+	p, err := nsq.NewProducer(*nsqdAddr, nsq.NewConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	return event.NewProducer(pubsubNSQ.NewProducer(p, "events")), nil
+}
+
+// consumeEvents shared by all instances of the service on a channel
+func consumeEvents(l *zap.Logger, channel string, h event.HandlerFunc) {
+	c, err := pubsubNSQ.NewConsumer("events", channel, *nsqLookupdAddr, nsq.NewConfig())
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	if err := event.NewConsumer(c).Consume(context.Background(), h); err != nil {
+		l.Fatal(err.Error())
+	}
+}
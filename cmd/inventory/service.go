@@ -10,17 +10,22 @@ import (
 
 	"github.com/51st-state/api/pkg/api"
 	"github.com/51st-state/api/pkg/encode"
+	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/item"
 	"github.com/51st-state/api/pkg/keys"
+	pubsubNSQ "github.com/51st-state/api/pkg/pubsub/nsq"
 	"github.com/51st-state/api/pkg/rbac"
 	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcZap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/51st-state/api/pkg/apis/character"
 	"github.com/51st-state/api/pkg/apis/inventory"
 	"github.com/51st-state/api/pkg/apis/inventory/cockroachdb"
 	pb "github.com/51st-state/api/pkg/apis/inventory/proto"
 
+	"github.com/nsqio/go-nsq"
 	"github.com/playnet-public/flagenv"
 	"go.uber.org/zap"
 
@@ -37,6 +42,10 @@ var (
 	dbName          = flagenv.String("db-name", "preselect", "the name of the database")
 	publicKeyPath   = flagenv.String("public-key-path", "/secrets/public.pem", "the public key to validate jwt token")
 	rbacGRPCAddress = flagenv.String("rbac-grpc-addr", "rbac-service:2345", "the grpc address to the rbac control")
+	itemsPath       = flagenv.String("items-path", "/config/items.json", "the config of the items which can be stored in inventories")
+	containersPath  = flagenv.String("containers-path", "/config/containers.json", "the config of the container types and their default capacities")
+	capacity        = flagenv.Int("capacity", 50, "the default max weight of the items in an inventory")
+	nsqLookupdAddr  = flagenv.String("nsq-lookupd-addr", "nsqlookupd:4161", "the address of the nsq lookupd to consume events from")
 )
 
 func main() {
@@ -69,16 +78,43 @@ func main() {
 	}
 	defer rbacConn.Close()
 
+	l.Info("registering rbac rules")
+	if err := rbacCtrl.RegisterRules(context.Background(), inventory.Rules); err != nil {
+		l.Fatal(err.Error())
+	}
+
+	l.Info("loading item config")
+	items, err := item.FromConfig(*itemsPath)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	l.Info("loading container config")
+	containers, err := inventory.ContainersFromConfig(*containersPath)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
 	m := inventory.NewManager(
 		cockroachdb.NewRepository(db),
+		items,
+		containers,
+		float64(*capacity),
 	)
 
+	go consumeEvents(l, "inventory-owners", inventory.NewEventHandler(m, inventory.OwnerEvents{
+		character.PurgedEventID: inventory.OwnerCharacter,
+	}))
+
 	a := api.New(*httpAddr, l)
-	a.Get("/inventory/{guid}", inventory.MakeGetEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/inventory/{guid}", inventory.MakeGetEndpoint(l, m, inventory.NewETagEncoder(), rbacCtrl, *publicKey))
+	a.Get("/inventory/owners/{type}/{id}", inventory.MakeGetByOwnerEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
 	a.Patch("/inventory/{guid}/items/add", inventory.MakeAddItemEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
 	a.Patch("/inventory/{guid}/items/remove", inventory.MakeRemoveItemEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Patch("/inventory/{guid}/items/transfer", inventory.MakeTransferEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/inventory/operations", inventory.MakeApplyEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
 	a.Delete("/inventory{guid}", inventory.MakeDeleteEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
-	a.Post("/inventory", inventory.MakeCreateEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/inventory", inventory.MakeCreateEndpoint(l, m, inventory.NewETagEncoder(), rbacCtrl, *publicKey))
 
 	go serveGrpc(l, m)
 
@@ -115,6 +151,18 @@ func makeRBACControl() (rbac.Control, *grpc.ClientConn, error) {
 	return rbac.NewGRPCClient(conn), conn, nil
 }
 
+// consumeEvents shared by all instances of the service on a channel
+func consumeEvents(l *zap.Logger, channel string, h event.HandlerFunc) {
+	c, err := pubsubNSQ.NewConsumer("events", channel, *nsqLookupdAddr, nsq.NewConfig())
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	if err := event.NewConsumer(c).Consume(context.Background(), h); err != nil {
+		l.Fatal(err.Error())
+	}
+}
+
 func serveGrpc(l *zap.Logger, m inventory.Manager) {
 	l.Info("preparing grpc server")
 	s := grpc.NewServer(
@@ -5,20 +5,27 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"net"
 
 	"github.com/51st-state/api/pkg/apis/preselect"
 	"github.com/51st-state/api/pkg/apis/preselect/cockroachdb"
+	pb "github.com/51st-state/api/pkg/apis/preselect/proto"
 	"github.com/51st-state/api/pkg/encode"
 
 	"github.com/51st-state/api/pkg/api"
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpcZap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"github.com/playnet-public/flagenv"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 
 	_ "github.com/lib/pq"
 )
 
 var (
 	httpAddr   = flagenv.String("http-addr", ":8080", "the http address of this service")
+	grpcAddr   = flagenv.String("grpc-addr", ":2345", "the grpc address of this service")
 	dbHost     = flagenv.String("db-host", "localhost", "the host of the database")
 	dbPort     = flagenv.Int("db-port", 1234, "the port of the database")
 	dbUsername = flagenv.String("db-username", "user", "the username of the database")
@@ -65,6 +72,8 @@ func main() {
 		preselect.MakeSetPreSelectionsEndpoint(logger, m, encode.NewJSONEncoder()),
 	)
 
+	go serveGrpc(logger, m)
+
 	if err := a.Serve(); err != nil {
 		logger.Fatal("http server failed listening", zap.Error(err))
 	}
@@ -80,3 +89,27 @@ func makeCockroachDBDatabase() (*sql.DB, error) {
 		*dbName,
 	))
 }
+
+func serveGrpc(l *zap.Logger, m *preselect.Manager) {
+	l.Info("preparing grpc server")
+	s := grpc.NewServer(
+		grpc.StreamInterceptor(grpcMiddleware.ChainStreamServer(
+			grpcZap.StreamServerInterceptor(l),
+		)),
+		grpc.UnaryInterceptor(grpcMiddleware.ChainUnaryServer(
+			grpcZap.UnaryServerInterceptor(l),
+		)),
+	)
+	pb.RegisterManagerServer(s, preselect.NewGRPCServer(m))
+	reflection.Register(s)
+
+	listener, err := net.Listen("tcp", *grpcAddr)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	l.Info("starting grpc server")
+	if err := s.Serve(listener); err != nil {
+		l.Fatal(err.Error())
+	}
+}
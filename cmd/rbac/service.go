@@ -2,17 +2,26 @@ package main
 
 import (
 	"context"
+	"crypto/rsa"
 	"database/sql"
 	"fmt"
 	"log"
 	"net"
+	"time"
 
+	"github.com/51st-state/api/pkg/api"
+	"github.com/51st-state/api/pkg/apis/privacy"
+	"github.com/51st-state/api/pkg/encode"
+	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/keys"
+	pubsubNSQ "github.com/51st-state/api/pkg/pubsub/nsq"
 	"github.com/51st-state/api/pkg/rbac"
 
 	"github.com/51st-state/api/pkg/rbac/cockroachdb"
 	pb "github.com/51st-state/api/pkg/rbac/proto"
 	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcZap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
+	"github.com/nsqio/go-nsq"
 	"github.com/playnet-public/flagenv"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -21,12 +30,18 @@ import (
 )
 
 var (
-	grpcAddr   = flagenv.String("grpc-addr", ":1234", "the grpc addr to host the grpc server on")
-	dbHost     = flagenv.String("db-host", "localhost", "the host of the database")
-	dbPort     = flagenv.Int("db-port", 1234, "the port of the database")
-	dbUsername = flagenv.String("db-username", "user", "the username of the database")
-	dbPassword = flagenv.String("db-password", "1234", "the password of the database")
-	dbName     = flagenv.String("db-name", "preselect", "the name of the database")
+	httpAddr            = flagenv.String("http-addr", ":8080", "the http addr of the service")
+	grpcAddr            = flagenv.String("grpc-addr", ":1234", "the grpc addr to host the grpc server on")
+	grpcTrustedNetworks = flagenv.String("grpc-trusted-networks", "127.0.0.0/8,10.0.0.0/8", "the networks of the grpc peers allowed to act on behalf of other accounts")
+	publicKeyPath       = flagenv.String("public-key-path", "/secrets/public.pem", "the public key to validate jwt token")
+	dbHost              = flagenv.String("db-host", "localhost", "the host of the database")
+	dbPort              = flagenv.Int("db-port", 1234, "the port of the database")
+	dbUsername          = flagenv.String("db-username", "user", "the username of the database")
+	dbPassword          = flagenv.String("db-password", "1234", "the password of the database")
+	dbName              = flagenv.String("db-name", "preselect", "the name of the database")
+	nsqdAddr            = flagenv.String("nsqd-addr", "nsqd:4150", "the address of the nsq lookupd servers")
+	nsqLookupdAddr      = flagenv.String("nsq-lookupd-addr", "nsqlookupd:4161", "the address of the nsq lookupd to consume events from")
+	publishInterval     = flagenv.Duration("publish-interval", time.Second*10, "the interval to publish the events of audit entries which could not be published right away")
 )
 
 func main() {
@@ -48,6 +63,84 @@ func main() {
 		l.Fatal(err.Error())
 	}
 
+	publicKey, err := keys.GetPublicKey(*publicKeyPath)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	trustedNetworks, err := rbac.ParseNetworks(*grpcTrustedNetworks)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	l.Info("creating nsq event producer")
+	eventProd, err := makeNSQEventProducer()
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	repo := cockroachdb.NewRepository(db)
+	ctrl := rbac.NewControl(
+		repo,
+		eventProd,
+	)
+	go publishAuditEntries(l, repo, eventProd)
+	go consumeEvents(l, "rbac-privacy", privacy.NewEventHandler("rbac", rbac.NewPrivacyHandler(repo), eventProd))
+
+	l.Info("registering rbac rules")
+	if err := ctrl.RegisterRules(context.Background(), rbac.Rules); err != nil {
+		l.Fatal(err.Error())
+	}
+
+	go serveGrpc(l, ctrl, *publicKey, trustedNetworks)
+
+	a := api.New(*httpAddr, l)
+	a.Get("/rbac/me/permissions", rbac.MakeGetOwnPermissionsEndpoint(l, ctrl, encode.NewJSONEncoder(), *publicKey))
+	a.Get("/rbac/me/permissions/check", rbac.MakeCheckOwnPermissionsEndpoint(l, ctrl, encode.NewJSONEncoder(), *publicKey))
+	a.Get("/rbac/me/permissions/explain", rbac.MakeExplainOwnPermissionEndpoint(l, ctrl, encode.NewJSONEncoder(), *publicKey))
+	a.Get("/rbac/rules", rbac.MakeGetRulesEndpoint(l, ctrl, encode.NewJSONEncoder(), *publicKey))
+	a.Get("/rbac/rules/bound", rbac.MakeListRulesEndpoint(l, ctrl, encode.NewJSONEncoder(), *publicKey))
+	a.Get("/rbac/rules/{rule}/roles", rbac.MakeListRuleRolesEndpoint(l, ctrl, encode.NewJSONEncoder(), *publicKey))
+	a.Get("/rbac/roles", rbac.MakeListRolesEndpoint(l, ctrl, encode.NewJSONEncoder(), *publicKey))
+	a.Get("/rbac/roles/{id}/accounts", rbac.MakeListRoleAccountsEndpoint(l, ctrl, encode.NewJSONEncoder(), *publicKey))
+	a.Get("/rbac/audit", rbac.MakeListAuditEntriesEndpoint(l, ctrl, encode.NewJSONEncoder(), *publicKey))
+
+	if err := a.Serve(); err != nil {
+		l.Fatal(err.Error())
+	}
+}
+
+// publishAuditEntries periodically publishes the events of audit entries
+// which could not be published right after their change
+func publishAuditEntries(l *zap.Logger, r rbac.Repository, prod *event.Producer) {
+	for range time.Tick(*publishInterval) {
+		if err := rbac.PublishAuditEntries(context.Background(), r, prod); err != nil {
+			l.Error("publishing audit entries", zap.Error(err))
+		}
+	}
+}
+
+func makeCockroachDBDatabase() (*sql.DB, error) {
+	return sql.Open("postgres", fmt.Sprintf(
+		"postgres://%s:%s@%s:%d/%s?sslmode=disable",
+		*dbUsername,
+		*dbPassword,
+		*dbHost,
+		*dbPort,
+		*dbName,
+	))
+}
+
+func makeNSQEventProducer() (*event.Producer, error) {
+	p, err := nsq.NewProducer(*nsqdAddr, nsq.NewConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	return event.NewProducer(pubsubNSQ.NewProducer(p, "events")), nil
+}
+
+func serveGrpc(l *zap.Logger, ctrl rbac.Control, pK rsa.PublicKey, trusted []*net.IPNet) {
 	l.Info(fmt.Sprintf("creating grpc listener on %s", *grpcAddr))
 	grpcListener, err := net.Listen("tcp", *grpcAddr)
 	if err != nil {
@@ -60,17 +153,12 @@ func main() {
 		)),
 		grpc.UnaryInterceptor(grpcMiddleware.ChainUnaryServer(
 			grpcZap.UnaryServerInterceptor(l),
+			rbac.NewActorInterceptor(pK, trusted),
 		)),
 	)
 	pb.RegisterControlServer(
 		grpcServer,
-		rbac.NewGRPCServer(
-			rbac.NewControl(
-				cockroachdb.NewRepository(
-					db,
-				),
-			),
-		),
+		rbac.NewGRPCServer(ctrl),
 	)
 
 	if err := grpcServer.Serve(grpcListener); err != nil {
@@ -78,13 +166,14 @@ func main() {
 	}
 }
 
-func makeCockroachDBDatabase() (*sql.DB, error) {
-	return sql.Open("postgres", fmt.Sprintf(
-		"postgres://%s:%s@%s:%d/%s?sslmode=disable",
-		*dbUsername,
-		*dbPassword,
-		*dbHost,
-		*dbPort,
-		*dbName,
-	))
+// consumeEvents shared by all instances of the service on a channel
+func consumeEvents(l *zap.Logger, channel string, h event.HandlerFunc) {
+	c, err := pubsubNSQ.NewConsumer("events", channel, *nsqLookupdAddr, nsq.NewConfig())
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	if err := event.NewConsumer(c).Consume(context.Background(), h); err != nil {
+		l.Fatal(err.Error())
+	}
 }
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/role"
+	"github.com/51st-state/api/pkg/apis/role/cockroachdb"
+	"github.com/51st-state/api/pkg/rbac"
+	"github.com/51st-state/api/pkg/rbac/policy"
+	"github.com/playnet-public/flagenv"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	_ "github.com/lib/pq"
+)
+
+var (
+	dbHost          = flagenv.String("db-host", "localhost", "the host of the role database")
+	dbPort          = flagenv.Int("db-port", 1234, "the port of the role database")
+	dbUsername      = flagenv.String("db-username", "user", "the username of the role database")
+	dbPassword      = flagenv.String("db-password", "1234", "the password of the role database")
+	dbName          = flagenv.String("db-name", "preselect", "the name of the role database")
+	rbacGRPCAddress = flagenv.String("rbac-grpc-addr", "rbac-service:2345", "the grpc address to the rbac control")
+)
+
+const usage = `usage:
+  rbacctl [flags] plan <policy file>
+  rbacctl [flags] apply <policy file>
+  rbacctl [flags] export [-format yaml|json] [-role id]... [-account id]...
+  rbacctl [flags] bootstrap <account id>
+`
+
+// listFlag collects the values of a repeated flag
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		flag.PrintDefaults()
+	}
+	flagenv.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	l, err := zap.NewDevelopmentConfig().Build()
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	db, err := makeCockroachDBDatabase()
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+	defer db.Close()
+
+	rbacCtrl, rbacConn, err := makeRBACControl()
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+	defer rbacConn.Close()
+
+	roles := role.NewManager(l, cockroachdb.NewRepository(db), rbacCtrl)
+	m := policy.NewManager(roles, rbacCtrl)
+
+	ctx := context.Background()
+	switch flag.Arg(0) {
+	case "plan":
+		plan, err := planFile(ctx, m, flag.Arg(1))
+		if err != nil {
+			l.Fatal(err.Error())
+		}
+
+		fmt.Print(plan.String())
+	case "apply":
+		plan, err := planFile(ctx, m, flag.Arg(1))
+		if err != nil {
+			l.Fatal(err.Error())
+		}
+
+		fmt.Print(plan.String())
+		if err := m.Apply(ctx, plan); err != nil {
+			l.Fatal(err.Error())
+		}
+	case "export":
+		if err := export(ctx, m, flag.Args()[1:]); err != nil {
+			l.Fatal(err.Error())
+		}
+	case "bootstrap":
+		if err := roles.Bootstrap(ctx, rbac.AccountID(flag.Arg(1))); err != nil {
+			l.Fatal(err.Error())
+		}
+
+		fmt.Printf("granted %s to %s\n", role.SuperadminID, flag.Arg(1))
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+var errMissingPolicyFile = errors.New("missing policy file")
+
+func planFile(ctx context.Context, m policy.Manager, path string) (*policy.Plan, error) {
+	if path == "" {
+		return nil, errMissingPolicyFile
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := policy.Decode(b, policy.FormatFromPath(path))
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Plan(ctx, p)
+}
+
+func export(ctx context.Context, m policy.Manager, args []string) error {
+	var roles, accounts listFlag
+
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	format := fs.String("format", string(policy.FormatYAML), "the format of the exported policy (yaml or json)")
+	fs.Var(&roles, "role", "the id of a role to export, can be repeated")
+	fs.Var(&accounts, "account", "the id of an account to export the roles of, can be repeated")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	roleIDs := make([]rbac.RoleID, 0)
+	for _, v := range roles {
+		roleIDs = append(roleIDs, rbac.RoleID(v))
+	}
+
+	accountIDs := make([]rbac.AccountID, 0)
+	for _, v := range accounts {
+		accountIDs = append(accountIDs, rbac.AccountID(v))
+	}
+
+	p, err := m.Export(ctx, roleIDs, accountIDs)
+	if err != nil {
+		return err
+	}
+
+	b, err := policy.Encode(p, policy.Format(*format))
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stdout.Write(b)
+	return err
+}
+
+func makeCockroachDBDatabase() (*sql.DB, error) {
+	return sql.Open("postgres", fmt.Sprintf(
+		"postgres://%s:%s@%s:%d/%s?sslmode=disable",
+		*dbUsername,
+		*dbPassword,
+		*dbHost,
+		*dbPort,
+		*dbName,
+	))
+}
+
+func makeRBACControl() (rbac.Control, *grpc.ClientConn, error) {
+	conn, err := grpc.Dial(
+		*rbacGRPCAddress,
+		grpc.WithInsecure(),
+		grpc.WithTimeout(time.Second*10),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rbac.NewGRPCClient(conn), conn, nil
+}
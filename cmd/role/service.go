@@ -33,6 +33,8 @@ var (
 	dbName          = flagenv.String("db-name", "preselect", "the name of the database")
 	publicKeyPath   = flagenv.String("public-key-path", "/secrets/public.pem", "the public key to validate jwt token")
 	rbacGRPCAddress = flagenv.String("rbac-grpc-addr", "rbac-service:2345", "the grpc address to the rbac control")
+	superadmin      = flagenv.String("superadmin", "", "the account to grant the superadmin role if no account holds it yet")
+	syncInterval    = flagenv.Duration("sync-interval", time.Second*10, "the interval to apply pending role changes to the rbac service")
 )
 
 func main() {
@@ -65,10 +67,34 @@ func main() {
 	}
 	defer rbacConn.Close()
 
-	m := role.NewManager(cockroachdb.NewRepository(db), rbacCtrl)
+	l.Info("registering rbac rules")
+	if err := rbacCtrl.RegisterRules(context.Background(), role.Rules); err != nil {
+		l.Fatal(err.Error())
+	}
+
+	m := role.NewManager(l, cockroachdb.NewRepository(db), rbacCtrl)
+
+	l.Info("seeding system roles")
+	if err := m.SeedSystemRoles(context.Background()); err != nil {
+		l.Fatal(err.Error())
+	}
+
+	if *superadmin != "" {
+		l.Info("bootstrapping superadmin")
+		switch err := m.Bootstrap(context.Background(), rbac.AccountID(*superadmin)); err {
+		case nil:
+		case role.ErrAlreadyBootstrapped:
+			l.Info(err.Error())
+		default:
+			l.Fatal(err.Error())
+		}
+	}
+
+	go syncRoles(l, m)
 
 	a := api.New(*httpAddr, l)
 
+	a.Get("/roles", role.MakeListEndpoint(l, m, encode.NewJSONEncoder(), *publicKey, rbacCtrl))
 	a.Get("/roles/{id}", role.MakeGetEndpoint(l, m, encode.NewJSONEncoder(), *publicKey, rbacCtrl))
 	a.Patch("/roles/{id}", role.MakeSetEndpoint(l, m, encode.NewJSONEncoder(), *publicKey, rbacCtrl))
 	a.Delete("/roles/{id}", role.MakeDeleteEndpoint(l, m, encode.NewJSONEncoder(), *publicKey, rbacCtrl))
@@ -79,6 +105,16 @@ func main() {
 	}
 }
 
+// syncRoles periodically applies the role changes which could not be
+// applied to the rbac service right away
+func syncRoles(l *zap.Logger, m role.Manager) {
+	for range time.Tick(*syncInterval) {
+		if err := m.Sync(context.Background()); err != nil {
+			l.Error("syncing roles", zap.Error(err))
+		}
+	}
+}
+
 func makeCockroachDBDatabase() (*sql.DB, error) {
 	return sql.Open("postgres", fmt.Sprintf(
 		"postgres://%s:%s@%s:%d/%s?sslmode=disable",
@@ -74,6 +74,11 @@ func main() {
 	}
 	defer rbacConn.Close()
 
+	l.Info("registering rbac rules")
+	if err := rbacCtrl.RegisterRules(context.Background(), append(serviceaccount.Rules, key.Rules...)); err != nil {
+		l.Fatal(err.Error())
+	}
+
 	manager := serviceaccount.NewManager(cockroachdb.NewRepository(db), rbacCtrl)
 	keyManager := key.NewManager(keyCockroachdb.NewRepository(db), manager)
 
@@ -2,10 +2,12 @@ package main
 
 import (
 	"context"
+	"crypto/rsa"
 	"database/sql"
 	"fmt"
 	"log"
 	"net"
+	"strings"
 	"time"
 
 	pubsubNSQ "github.com/51st-state/api/pkg/pubsub/nsq"
@@ -17,6 +19,8 @@ import (
 	"github.com/51st-state/api/pkg/encode"
 
 	"github.com/51st-state/api/pkg/api"
+	"github.com/51st-state/api/pkg/apis/privacy"
+	privacyCockroachDB "github.com/51st-state/api/pkg/apis/privacy/cockroachdb"
 	"github.com/51st-state/api/pkg/apis/user"
 	"github.com/51st-state/api/pkg/event"
 
@@ -28,6 +32,7 @@ import (
 	"github.com/51st-state/api/pkg/apis/user/cockroachdb"
 	"github.com/51st-state/api/pkg/apis/user/mysql"
 	pb "github.com/51st-state/api/pkg/apis/user/proto"
+	"github.com/51st-state/api/pkg/apis/user/wcfcache"
 	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpcZap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"github.com/playnet-public/flagenv"
@@ -38,22 +43,29 @@ import (
 )
 
 var (
-	httpAddr        = flagenv.String("http-addr", ":8080", "the http addr of the service")
-	grpcAddr        = flagenv.String("grpc-addr", ":2345", "the grpc address of this service")
-	nsqdAddr        = flagenv.String("nsqd-addr", "nsqd:4150", "the address of the nsq lookupd servers")
-	publicKeyPath   = flagenv.String("public-key-path", "/secrets/public.pem", "the public key to validate jwt token")
-	rbacGRPCAddress = flagenv.String("rbac-grpc-addr", "rbac-service:2345", "the grpc address to the rbac control")
-
-	dbHost        = flagenv.String("db-host", "localhost", "the host of the database")
-	dbPort        = flagenv.Int("db-port", 1234, "the port of the database")
-	dbUsername    = flagenv.String("db-username", "user", "the username of the database")
-	dbPassword    = flagenv.String("db-password", "1234", "the password of the database")
-	dbName        = flagenv.String("db-name", "preselect", "the name of the database")
-	wcfDBHost     = flagenv.String("wcf-db-host", "localhost", "the host of the database of the wcf framework")
-	wcfDBPort     = flagenv.Int("wcf-db-port", 1234, "the port of the wcf database")
-	wcfDBUsername = flagenv.String("wcf-db-username", "user", "the username of the wcf database to login")
-	wcfDBPassword = flagenv.String("wcf-db-password", "1234", "the password of the wcf database user")
-	wcfDBName     = flagenv.String("wcf-db-name", "name", "the name of the wcf database")
+	httpAddr            = flagenv.String("http-addr", ":8080", "the http addr of the service")
+	grpcAddr            = flagenv.String("grpc-addr", ":2345", "the grpc address of this service")
+	grpcTrustedNetworks = flagenv.String("grpc-trusted-networks", "127.0.0.0/8,10.0.0.0/8", "the networks of the grpc peers allowed to act on behalf of other accounts")
+	nsqdAddr            = flagenv.String("nsqd-addr", "nsqd:4150", "the address of the nsq lookupd servers")
+	nsqLookupdAddr      = flagenv.String("nsq-lookupd-addr", "nsqlookupd:4161", "the address of the nsq lookupd to consume events from")
+	publicKeyPath       = flagenv.String("public-key-path", "/secrets/public.pem", "the public key to validate jwt token")
+	rbacGRPCAddress     = flagenv.String("rbac-grpc-addr", "rbac-service:2345", "the grpc address to the rbac control")
+	purgeInterval       = flagenv.Duration("purge-interval", time.Hour, "the interval deleted users are purged in after the deletion retention")
+	privacyServices     = flagenv.String("privacy-services", "user,auth,rbac,faction,character,application,presence", "the comma separated services storing data of users, which have to report on privacy jobs")
+
+	dbHost         = flagenv.String("db-host", "localhost", "the host of the database")
+	dbPort         = flagenv.Int("db-port", 1234, "the port of the database")
+	dbUsername     = flagenv.String("db-username", "user", "the username of the database")
+	dbPassword     = flagenv.String("db-password", "1234", "the password of the database")
+	dbName         = flagenv.String("db-name", "preselect", "the name of the database")
+	wcfDBHost      = flagenv.String("wcf-db-host", "localhost", "the host of the database of the wcf framework")
+	wcfDBPort      = flagenv.Int("wcf-db-port", 1234, "the port of the wcf database")
+	wcfDBUsername  = flagenv.String("wcf-db-username", "user", "the username of the wcf database to login")
+	wcfDBPassword  = flagenv.String("wcf-db-password", "1234", "the password of the wcf database user")
+	wcfDBName      = flagenv.String("wcf-db-name", "name", "the name of the wcf database")
+	wcfCacheTTL    = flagenv.Duration("wcf-cache-ttl", time.Minute, "the duration wcf user infos are cached")
+	wcfCacheNegTTL = flagenv.Duration("wcf-cache-negative-ttl", time.Second*10, "the duration unknown wcf users are cached")
+	wcfCacheSweep  = flagenv.Duration("wcf-cache-sweep-interval", time.Minute, "the interval expired wcf user infos are dropped from the cache in")
 )
 
 func main() {
@@ -79,11 +91,20 @@ func main() {
 		l.Fatal(err.Error())
 	}
 
+	if err := privacyCockroachDB.CreateSchema(context.Background(), db); err != nil {
+		l.Fatal(err.Error())
+	}
+
 	publicKey, err := keys.GetPublicKey(*publicKeyPath)
 	if err != nil {
 		l.Fatal(err.Error())
 	}
 
+	trustedNetworks, err := rbac.ParseNetworks(*grpcTrustedNetworks)
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
 	l.Info("creating rbac grpc connection")
 	rbacCtrl, rbacConn, err := makeRBACControl()
 	if err != nil {
@@ -91,25 +112,61 @@ func main() {
 	}
 	defer rbacConn.Close()
 
+	l.Info("registering rbac rules")
+	if err := rbacCtrl.RegisterRules(context.Background(), user.Rules); err != nil {
+		l.Fatal(err.Error())
+	}
+
+	if err := rbacCtrl.RegisterRules(context.Background(), privacy.Rules); err != nil {
+		l.Fatal(err.Error())
+	}
+
 	eventProd, err := makeNSQEventProducer()
 
+	wcfRepo := wcfcache.NewRepository(mysql.NewWCFRepository(wcfDB), *wcfCacheTTL, *wcfCacheNegTTL)
+	go sweepWCFCache(wcfRepo)
+
+	repo := cockroachdb.NewRepository(db)
 	m := user.NewManager(
-		cockroachdb.NewRepository(db),
-		mysql.NewWCFRepository(wcfDB),
+		repo,
+		wcfRepo,
 		eventProd,
 		rbacCtrl,
 	)
 
+	pm := privacy.NewManager(
+		privacyCockroachDB.NewRepository(db),
+		eventProd,
+		strings.Split(*privacyServices, ","),
+	)
+	go consumeEvents(l, "privacy", pm.HandleEvent)
+	go consumeEvents(l, "user-privacy", privacy.NewEventHandler("user", user.NewPrivacyHandler(repo, wcfRepo), eventProd))
+
 	a := api.New(*httpAddr, l)
+	a.Get("/users", user.MakeListEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
 	a.Get("/users/{uuid}", user.MakeGetEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
 	a.Get("/users/hash/{hash}", user.MakeGetByGameSerialHashEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
 	a.Post("/users", user.MakeCreateEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
 	a.Delete("/users/{uuid}", user.MakeDeleteEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/users/{uuid}/restore", user.MakeRestoreEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
 	a.Patch("/users/{uuid}", user.MakeUpdateEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
 	a.Get("/users/{uuid}/roles", user.MakeGetRolesEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
 	a.Patch("/users/{uuid}/roles", user.MakeSetRolesEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
-
-	go serveGrpc(l, m)
+	a.Get("/users/{uuid}/bans", user.MakeGetBansEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/users/{uuid}/bans", user.MakeBanEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Delete("/users/{uuid}/bans/{ban}", user.MakeUnbanEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/users/link/codes", user.MakeRequestLinkCodeEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/users/link", user.MakeRedeemLinkCodeEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Delete("/users/link", user.MakeUnlinkOwnGameEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Delete("/users/{uuid}/link", user.MakeUnlinkGameEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/users/{uuid}/links", user.MakeGetGameLinksEndpoint(l, m, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Post("/privacy/users/{uuid}/exports", privacy.MakeRequestExportEndpoint(l, pm, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/privacy/users/{uuid}/jobs", privacy.MakeGetJobsEndpoint(l, pm, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/privacy/jobs/{id}", privacy.MakeGetJobEndpoint(l, pm, encode.NewJSONEncoder(), rbacCtrl, *publicKey))
+	a.Get("/privacy/jobs/{id}/archive", privacy.MakeGetArchiveEndpoint(l, pm, privacy.NewArchiveEncoder(), rbacCtrl, *publicKey))
+
+	go serveGrpc(l, m, *publicKey, trustedNetworks)
+	go purgeUsers(l, m)
 
 	if err := a.Serve(); err != nil {
 		l.Fatal(err.Error())
@@ -164,7 +221,35 @@ func makeNSQEventProducer() (*event.Producer, error) {
 	return event.NewProducer(pubsubNSQ.NewProducer(p, "events")), nil
 }
 
-func serveGrpc(l *zap.Logger, m user.Manager) {
+// sweepWCFCache drops the expired wcf user infos in an interval
+func sweepWCFCache(r *wcfcache.Repository) {
+	for range time.Tick(*wcfCacheSweep) {
+		r.Sweep()
+	}
+}
+
+// consumeEvents shared by all instances of the service on a channel
+func consumeEvents(l *zap.Logger, channel string, h event.HandlerFunc) {
+	c, err := pubsubNSQ.NewConsumer("events", channel, *nsqLookupdAddr, nsq.NewConfig())
+	if err != nil {
+		l.Fatal(err.Error())
+	}
+
+	if err := event.NewConsumer(c).Consume(context.Background(), h); err != nil {
+		l.Fatal(err.Error())
+	}
+}
+
+// purgeUsers deleted longer than the deletion retention ago in an interval
+func purgeUsers(l *zap.Logger, m user.Manager) {
+	for range time.Tick(*purgeInterval) {
+		if err := m.Purge(context.Background()); err != nil {
+			l.Error(err.Error())
+		}
+	}
+}
+
+func serveGrpc(l *zap.Logger, m user.Manager, pK rsa.PublicKey, trusted []*net.IPNet) {
 	l.Info("preparing grpc server")
 	s := grpc.NewServer(
 		grpc.StreamInterceptor(grpcMiddleware.ChainStreamServer(
@@ -172,6 +257,7 @@ func serveGrpc(l *zap.Logger, m user.Manager) {
 		)),
 		grpc.UnaryInterceptor(grpcMiddleware.ChainUnaryServer(
 			grpcZap.UnaryServerInterceptor(l),
+			rbac.NewActorInterceptor(pK, trusted),
 		)),
 	)
 	pb.RegisterManagerServer(s, user.NewGRPCServer(m))
@@ -23,7 +23,13 @@ func New(addr string, l *zap.Logger) *API {
 	r.Use(func(h http.Handler) http.Handler {
 		return newLoggerMiddleware(h, l)
 	})
-	r.Use(cors.AllowAll().Handler)
+	r.Use(cors.New(cors.Options{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"HEAD", "GET", "POST", "PUT", "PATCH", "DELETE"},
+		AllowedHeaders: []string{"*"},
+		// the versions of resources are read from their ETag for conditional requests
+		ExposedHeaders: []string{"ETag"},
+	}).Handler)
 
 	// add prometheus
 	r.Handle("/metrics", promhttp.Handler())
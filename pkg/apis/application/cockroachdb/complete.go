@@ -0,0 +1,36 @@
+package cockroachdb
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/application"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+type complete struct {
+	application.Identifier
+	application.Incomplete
+}
+
+func (c *complete) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		GUID      string            `json:"guid"`
+		UserUUID  string            `json:"user_uuid"`
+		Answers   json.RawMessage   `json:"answers"`
+		State     application.State `json:"state"`
+		Reviewer  rbac.AccountID    `json:"reviewer,omitempty"`
+		Reason    string            `json:"reason,omitempty"`
+		CreatedAt time.Time         `json:"created_at"`
+		DecidedAt *time.Time        `json:"decided_at,omitempty"`
+	}{
+		c.GUID(),
+		c.Data().UserUUID,
+		c.Data().Answers,
+		c.Data().State,
+		c.Data().Reviewer,
+		c.Data().Reason,
+		c.Data().CreatedAt,
+		c.Data().DecidedAt,
+	})
+}
@@ -0,0 +1,371 @@
+package cockroachdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/application"
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+// CreateSchema creates a new cockroachdb schema in a cockroachdb database for the application service
+func CreateSchema(ctx context.Context, db *sql.DB) (err error) {
+	_, err = db.ExecContext(
+		ctx,
+		`CREATE TABLE IF NOT EXISTS applications (
+            guid UUID PRIMARY KEY,
+            userUUID UUID NOT NULL,
+            answers JSONB NOT NULL,
+            state TEXT NOT NULL,
+            reviewer TEXT NOT NULL DEFAULT '',
+            reason TEXT NOT NULL DEFAULT '',
+            createdAt TIMESTAMPTZ NOT NULL,
+            decidedAt TIMESTAMPTZ NULL
+        );
+        CREATE INDEX IF NOT EXISTS applications_idx_user ON applications (userUUID, createdAt);
+        CREATE INDEX IF NOT EXISTS applications_idx_state ON applications (state, createdAt);
+
+        CREATE TABLE IF NOT EXISTS application_comments (
+            guid UUID PRIMARY KEY,
+            applicationId UUID NOT NULL,
+            author TEXT NOT NULL,
+            text TEXT NOT NULL,
+            createdAt TIMESTAMPTZ NOT NULL
+        );
+        CREATE INDEX IF NOT EXISTS application_comments_idx_application ON application_comments (applicationId, createdAt);
+
+        CREATE TABLE IF NOT EXISTS application_votes (
+            applicationId UUID NOT NULL,
+            reviewer TEXT NOT NULL,
+            approve BOOL NOT NULL,
+            PRIMARY KEY (applicationId, reviewer)
+        );`,
+	)
+	return
+}
+
+type db struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new cockroachdb db storage repository
+func NewRepository(d *sql.DB) application.Repository {
+	return &db{d}
+}
+
+func txError(tx *sql.Tx, err error) error {
+	if rErr := tx.Rollback(); rErr != nil {
+		return rErr
+	}
+
+	return err
+}
+
+type scanner interface {
+	Scan(...interface{}) error
+}
+
+func scanComplete(s scanner) (application.Complete, error) {
+	var (
+		guid    string
+		answers []byte
+	)
+	inc := application.NewIncomplete("", nil)
+	if err := s.Scan(
+		&guid,
+		&inc.Data().UserUUID,
+		&answers,
+		&inc.Data().State,
+		&inc.Data().Reviewer,
+		&inc.Data().Reason,
+		&inc.Data().CreatedAt,
+		&inc.Data().DecidedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	inc.Data().Answers = answers
+
+	return &complete{
+		application.NewIdentifier(guid),
+		inc,
+	}, nil
+}
+
+func (d *db) Get(ctx context.Context, id application.Identifier) (application.Complete, error) {
+	return scanComplete(d.db.QueryRowContext(
+		ctx,
+		`SELECT guid,
+        userUUID,
+        answers,
+        state,
+        reviewer,
+        reason,
+        createdAt,
+        decidedAt
+        FROM applications
+        WHERE guid = $1`,
+		id.GUID(),
+	))
+}
+
+func (d *db) query(ctx context.Context, query string, args ...interface{}) ([]application.Complete, error) {
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applications := make([]application.Complete, 0)
+	for rows.Next() {
+		c, err := scanComplete(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		applications = append(applications, c)
+	}
+
+	return applications, rows.Err()
+}
+
+func (d *db) GetByUser(ctx context.Context, id user.Identifier) ([]application.Complete, error) {
+	return d.query(
+		ctx,
+		`SELECT guid,
+        userUUID,
+        answers,
+        state,
+        reviewer,
+        reason,
+        createdAt,
+        decidedAt
+        FROM applications
+        WHERE userUUID = $1
+        ORDER BY createdAt, guid`,
+		id.UUID(),
+	)
+}
+
+func (d *db) GetByState(ctx context.Context, s application.State) ([]application.Complete, error) {
+	return d.query(
+		ctx,
+		`SELECT guid,
+        userUUID,
+        answers,
+        state,
+        reviewer,
+        reason,
+        createdAt,
+        decidedAt
+        FROM applications
+        WHERE state = $1
+        ORDER BY createdAt, guid`,
+		string(s),
+	)
+}
+
+func (d *db) Create(ctx context.Context, inc application.Incomplete) (application.Complete, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := d.db.ExecContext(
+		ctx,
+		`INSERT INTO applications (
+            guid,
+            userUUID,
+            answers,
+            state,
+            reviewer,
+            reason,
+            createdAt,
+            decidedAt
+        ) SELECT $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8`,
+		rand.String(),
+		inc.Data().UserUUID,
+		[]byte(inc.Data().Answers),
+		string(inc.Data().State),
+		string(inc.Data().Reviewer),
+		inc.Data().Reason,
+		inc.Data().CreatedAt,
+		inc.Data().DecidedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &complete{
+		application.NewIdentifier(rand.String()),
+		inc,
+	}, nil
+}
+
+func (d *db) Update(ctx context.Context, c application.Complete) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`UPDATE applications
+        SET state = $1,
+        reviewer = $2,
+        reason = $3,
+        decidedAt = $4
+        WHERE guid = $5`,
+		string(c.Data().State),
+		string(c.Data().Reviewer),
+		c.Data().Reason,
+		c.Data().DecidedAt,
+		c.GUID(),
+	)
+	return err
+}
+
+func (d *db) Delete(ctx context.Context, id application.Identifier) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, query := range []string{
+		`DELETE FROM application_votes WHERE applicationId = $1`,
+		`DELETE FROM application_comments WHERE applicationId = $1`,
+		`DELETE FROM applications WHERE guid = $1`,
+	} {
+		if _, err := tx.ExecContext(ctx, query, id.GUID()); err != nil {
+			return txError(tx, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (d *db) GetComments(ctx context.Context, id application.Identifier) ([]*application.Comment, error) {
+	rows, err := d.db.QueryContext(
+		ctx,
+		`SELECT guid,
+        author,
+        text,
+        createdAt
+        FROM application_comments
+        WHERE applicationId = $1
+        ORDER BY createdAt, guid`,
+		id.GUID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := make([]*application.Comment, 0)
+	for rows.Next() {
+		c := &application.Comment{}
+		if err := rows.Scan(
+			&c.ID,
+			&c.Author,
+			&c.Text,
+			&c.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		comments = append(comments, c)
+	}
+
+	return comments, rows.Err()
+}
+
+func (d *db) AddComment(ctx context.Context, id application.Identifier, c *application.Comment) (*application.Comment, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := d.db.ExecContext(
+		ctx,
+		`INSERT INTO application_comments (
+            guid,
+            applicationId,
+            author,
+            text,
+            createdAt
+        ) SELECT $1,
+        $2,
+        $3,
+        $4,
+        $5`,
+		rand.String(),
+		id.GUID(),
+		string(c.Author),
+		c.Text,
+		c.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	s := *c
+	s.ID = rand.String()
+
+	return &s, nil
+}
+
+func (d *db) GetVotes(ctx context.Context, id application.Identifier) ([]*application.Vote, error) {
+	rows, err := d.db.QueryContext(
+		ctx,
+		`SELECT reviewer,
+        approve
+        FROM application_votes
+        WHERE applicationId = $1
+        ORDER BY reviewer`,
+		id.GUID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	votes := make([]*application.Vote, 0)
+	for rows.Next() {
+		v := &application.Vote{}
+		if err := rows.Scan(
+			&v.Reviewer,
+			&v.Approve,
+		); err != nil {
+			return nil, err
+		}
+
+		votes = append(votes, v)
+	}
+
+	return votes, rows.Err()
+}
+
+func (d *db) SetVote(ctx context.Context, id application.Identifier, v *application.Vote) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`INSERT INTO application_votes (
+            applicationId,
+            reviewer,
+            approve
+        ) VALUES (
+            $1,
+            $2,
+            $3
+        ) ON CONFLICT (
+            applicationId,
+            reviewer
+        ) DO UPDATE SET approve = $4`,
+		id.GUID(),
+		string(v.Reviewer),
+		v.Approve,
+		v.Approve,
+	)
+	return err
+}
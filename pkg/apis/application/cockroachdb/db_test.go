@@ -0,0 +1,16 @@
+package cockroachdb_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/application"
+	"github.com/51st-state/api/pkg/apis/application/cockroachdb"
+	"github.com/51st-state/api/pkg/apis/application/repositorytest"
+	"github.com/51st-state/api/test"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) application.Repository {
+		return cockroachdb.NewRepository(test.NewCockroachDB(t, cockroachdb.CreateSchema))
+	})
+}
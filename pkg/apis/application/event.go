@@ -0,0 +1,39 @@
+package application
+
+import "github.com/51st-state/api/pkg/event"
+
+// SubmittedEventID of an application object
+const SubmittedEventID event.ID = "application_submitted"
+
+// SubmittedEvent of an application object
+type SubmittedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data Complete           `json:"data"`
+}
+
+// ClaimedEventID of an application object, produced once a reviewer claimed it
+const ClaimedEventID event.ID = "application_claimed"
+
+// ClaimedEvent of an application object
+type ClaimedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data Complete           `json:"data"`
+}
+
+// AcceptedEventID of an application object, produced once the role has been granted
+const AcceptedEventID event.ID = "application_accepted"
+
+// AcceptedEvent of an application object
+type AcceptedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data Complete           `json:"data"`
+}
+
+// RejectedEventID of an application object
+const RejectedEventID event.ID = "application_rejected"
+
+// RejectedEvent of an application object
+type RejectedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data Complete           `json:"data"`
+}
@@ -0,0 +1,355 @@
+package application
+
+//go:generate counterfeiter -o ./mocks/manager.go . Manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/problems"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+// Manager provides methods to submit and review whitelist applications.
+// Reviewers are the acting accounts of a context. Accepting an application
+// grants its user the configured whitelist role.
+type Manager interface {
+	Get(context.Context, Identifier) (Complete, error)
+	GetByUser(context.Context, user.Identifier) ([]Complete, error)
+	GetByState(context.Context, State) ([]Complete, error)
+	// Submit an application if the user has no open or accepted one
+	Submit(context.Context, Incomplete) (Complete, error)
+	// Claim a pending application for the acting reviewer
+	Claim(context.Context, Identifier) error
+	GetComments(context.Context, Identifier) ([]*Comment, error)
+	// Comment on an application as the acting reviewer
+	Comment(context.Context, Identifier, string) (*Comment, error)
+	GetVotes(context.Context, Identifier) ([]*Vote, error)
+	// Vote on an open application as the acting reviewer
+	Vote(context.Context, Identifier, bool) error
+	// Accept and Reject an application claimed by the acting reviewer
+	Accept(context.Context, Identifier, string) error
+	Reject(context.Context, Identifier, string) error
+}
+
+type manager struct {
+	repository Repository
+	rbac       rbac.Control
+	event      *event.Producer
+	role       rbac.RoleID
+}
+
+// NewManager creates a new application manager granting the role to accepted users
+func NewManager(r Repository, rb rbac.Control, prod *event.Producer, role rbac.RoleID) Manager {
+	return &manager{r, rb, prod, role}
+}
+
+// limits of the stored texts
+const (
+	maxAnswersSize = 16 * 1024
+	maxTextLength  = 2000
+)
+
+var (
+	errInvalidGUID     = errors.New("invalid guid given")
+	errInvalidUserUUID = errors.New("invalid user uuid given")
+	errNoReviewer      = errors.New("there is no acting reviewer")
+	errInvalidState    = problems.New("invalid state", "the state of the application is unknown", http.StatusBadRequest)
+	errInvalidAnswers  = problems.New("invalid answers", fmt.Sprintf("the answers have to be a json object of at most %d bytes", maxAnswersSize), http.StatusBadRequest)
+	errInvalidComment  = problems.New("invalid comment", fmt.Sprintf("comments have to consist of 1 to %d characters", maxTextLength), http.StatusBadRequest)
+	errInvalidReason   = problems.New("invalid reason", fmt.Sprintf("rejections need a reason of at most %d characters", maxTextLength), http.StatusBadRequest)
+	errAlreadyApplied  = problems.New("already applied", "there is an open or accepted application already", http.StatusConflict)
+	errAlreadyClaimed  = problems.New("already claimed", "the application has been claimed by another reviewer", http.StatusConflict)
+	errNotClaimed      = problems.New("not claimed", "the application has to be claimed by you first", http.StatusConflict)
+	errDecided         = problems.New("already decided", "the application has been accepted or rejected already", http.StatusConflict)
+)
+
+func (m *manager) Get(ctx context.Context, id Identifier) (Complete, error) {
+	if id.GUID() == "" {
+		return nil, errInvalidGUID
+	}
+
+	return m.repository.Get(ctx, id)
+}
+
+func (m *manager) GetByUser(ctx context.Context, id user.Identifier) ([]Complete, error) {
+	if id.UUID() == "" {
+		return nil, errInvalidUserUUID
+	}
+
+	return m.repository.GetByUser(ctx, id)
+}
+
+func (m *manager) GetByState(ctx context.Context, s State) ([]Complete, error) {
+	if !containsState(states, s) {
+		return nil, errInvalidState
+	}
+
+	return m.repository.GetByState(ctx, s)
+}
+
+func validateAnswers(answers json.RawMessage) error {
+	if len(answers) > maxAnswersSize {
+		return errInvalidAnswers
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal(answers, &object); err != nil || object == nil {
+		return errInvalidAnswers
+	}
+
+	return nil
+}
+
+func (m *manager) Submit(ctx context.Context, inc Incomplete) (Complete, error) {
+	if inc.Data().UserUUID == "" {
+		return nil, errInvalidUserUUID
+	}
+
+	if err := validateAnswers(inc.Data().Answers); err != nil {
+		return nil, err
+	}
+
+	applications, err := m.repository.GetByUser(ctx, &userIdentifier{inc.Data().UserUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range applications {
+		if v.Data().State.Open() || v.Data().State == StateAccepted {
+			return nil, errAlreadyApplied
+		}
+	}
+
+	inc.Data().State = StatePending
+	inc.Data().Reviewer = ""
+	inc.Data().Reason = ""
+	inc.Data().CreatedAt = time.Now()
+	inc.Data().DecidedAt = nil
+
+	c, err := m.repository.Create(ctx, inc)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, m.event.Produce(ctx, SubmittedEventID, &SubmittedEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		c,
+	})
+}
+
+func (m *manager) Claim(ctx context.Context, id Identifier) error {
+	reviewer, err := actingReviewer(ctx)
+	if err != nil {
+		return err
+	}
+
+	c, err := m.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !c.Data().State.Open():
+		return errDecided
+	case c.Data().Reviewer == reviewer:
+		return nil
+	case c.Data().State == StateInReview:
+		return errAlreadyClaimed
+	}
+
+	c.Data().State = StateInReview
+	c.Data().Reviewer = reviewer
+
+	if err := m.repository.Update(ctx, c); err != nil {
+		return err
+	}
+
+	return m.event.Produce(ctx, ClaimedEventID, &ClaimedEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		c,
+	})
+}
+
+func (m *manager) GetComments(ctx context.Context, id Identifier) ([]*Comment, error) {
+	if id.GUID() == "" {
+		return nil, errInvalidGUID
+	}
+
+	return m.repository.GetComments(ctx, id)
+}
+
+func (m *manager) Comment(ctx context.Context, id Identifier, text string) (*Comment, error) {
+	author, err := actingReviewer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if text == "" || len([]rune(text)) > maxTextLength {
+		return nil, errInvalidComment
+	}
+
+	if _, err := m.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return m.repository.AddComment(ctx, id, &Comment{
+		Author:    author,
+		Text:      text,
+		CreatedAt: time.Now(),
+	})
+}
+
+func (m *manager) GetVotes(ctx context.Context, id Identifier) ([]*Vote, error) {
+	if id.GUID() == "" {
+		return nil, errInvalidGUID
+	}
+
+	return m.repository.GetVotes(ctx, id)
+}
+
+func (m *manager) Vote(ctx context.Context, id Identifier, approve bool) error {
+	reviewer, err := actingReviewer(ctx)
+	if err != nil {
+		return err
+	}
+
+	c, err := m.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !c.Data().State.Open() {
+		return errDecided
+	}
+
+	return m.repository.SetVote(ctx, id, &Vote{
+		Reviewer: reviewer,
+		Approve:  approve,
+	})
+}
+
+// actingReviewer returns the acting account, changes by the system are not reviews
+func actingReviewer(ctx context.Context) (rbac.AccountID, error) {
+	actor := rbac.ActorFromContext(ctx)
+	if actor == rbac.SystemActor {
+		return "", errNoReviewer
+	}
+
+	return actor, nil
+}
+
+// claimed returns an application if it is in review by the acting reviewer
+func (m *manager) claimed(ctx context.Context, id Identifier) (Complete, error) {
+	c, err := m.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.Data().State.Open() {
+		return nil, errDecided
+	}
+
+	if c.Data().State != StateInReview || c.Data().Reviewer != rbac.ActorFromContext(ctx) {
+		return nil, errNotClaimed
+	}
+
+	return c, nil
+}
+
+// decide stores the decision on an application
+func (m *manager) decide(ctx context.Context, c Complete, s State, reason string) error {
+	now := time.Now()
+	c.Data().State = s
+	c.Data().Reason = reason
+	c.Data().DecidedAt = &now
+
+	return m.repository.Update(ctx, c)
+}
+
+func (m *manager) Accept(ctx context.Context, id Identifier, reason string) error {
+	if len([]rune(reason)) > maxTextLength {
+		return errInvalidReason
+	}
+
+	c, err := m.claimed(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// the role is granted first, so a failed decision can be retried
+	if err := m.grant(ctx, c.Data().UserUUID); err != nil {
+		return err
+	}
+
+	if err := m.decide(ctx, c, StateAccepted, reason); err != nil {
+		return err
+	}
+
+	return m.event.Produce(ctx, AcceptedEventID, &AcceptedEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		c,
+	})
+}
+
+func (m *manager) Reject(ctx context.Context, id Identifier, reason string) error {
+	if reason == "" || len([]rune(reason)) > maxTextLength {
+		return errInvalidReason
+	}
+
+	c, err := m.claimed(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := m.decide(ctx, c, StateRejected, reason); err != nil {
+		return err
+	}
+
+	return m.event.Produce(ctx, RejectedEventID, &RejectedEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		c,
+	})
+}
+
+// grant adds the whitelist role to the account of a user.
+// The role is added by the rbac system, so concurrent changes
+// of the other roles of the account are kept.
+func (m *manager) grant(ctx context.Context, userUUID string) error {
+	accountID := rbac.AccountID(fmt.Sprintf("user/%s", userUUID))
+
+	roles, err := m.rbac.GetAccountRoles(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	if roles.Contains(m.role) {
+		return nil
+	}
+
+	return m.rbac.AddAccountRoles(ctx, accountID, rbac.AccountRoles{m.role})
+}
+
+func containsState(values []State, value State) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
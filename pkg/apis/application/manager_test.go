@@ -0,0 +1,386 @@
+package application_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/application"
+	"github.com/51st-state/api/pkg/apis/application/memory"
+	"github.com/51st-state/api/pkg/apis/application/mocks"
+	"github.com/51st-state/api/pkg/event"
+	pubsubMocks "github.com/51st-state/api/pkg/pubsub/mocks"
+	"github.com/51st-state/api/pkg/rbac"
+	rbacMemory "github.com/51st-state/api/pkg/rbac/memory"
+	rbacMocks "github.com/51st-state/api/pkg/rbac/mocks"
+)
+
+type userIdentifier string
+
+func (i userIdentifier) UUID() string {
+	return string(i)
+}
+
+const applicant = userIdentifier("5d0c4b8e-2f1a-4c7e-8b3d-6a9f0e1c2d33")
+
+var answers = json.RawMessage(`{"age":"21","story":"a long story"}`)
+
+func as(accountID rbac.AccountID) context.Context {
+	return rbac.ActorToContext(context.Background(), accountID)
+}
+
+// producedEvents decodes the ids of the produced events in their order
+func producedEvents(t *testing.T, prod *pubsubMocks.FakeProducer) []event.ID {
+	ids := make([]event.ID, 0)
+	for i := 0; i < prod.ProduceCallCount(); i++ {
+		_, b := prod.ProduceArgsForCall(i)
+		e, err := event.Decode(b)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		ids = append(ids, e.Meta.ID)
+	}
+
+	return ids
+}
+
+func TestManagerGet(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	manager := application.NewManager(repo, &rbacMocks.FakeControl{}, event.NewProducer(&pubsubMocks.FakeProducer{}), "whitelisted")
+
+	id := &mocks.FakeIdentifier{}
+
+	if _, err := manager.Get(context.Background(), id); err == nil {
+		t.Fatal("there has to be an error since the guid is invalid")
+	}
+
+	id.GUIDReturns("test")
+
+	if _, err := manager.Get(context.Background(), id); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestManagerGetByState(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	manager := application.NewManager(repo, &rbacMocks.FakeControl{}, event.NewProducer(&pubsubMocks.FakeProducer{}), "whitelisted")
+
+	if _, err := manager.GetByState(context.Background(), "unknown"); err == nil {
+		t.Fatal("the state is unknown")
+	}
+
+	if _, err := manager.GetByState(context.Background(), application.StatePending); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestManagerSubmit(t *testing.T) {
+	ctx := context.Background()
+	prod := &pubsubMocks.FakeProducer{}
+	manager := application.NewManager(memory.NewRepository(), &rbacMocks.FakeControl{}, event.NewProducer(prod), "whitelisted")
+
+	if _, err := manager.Submit(ctx, application.NewIncomplete("", answers)); err == nil {
+		t.Fatal("the user uuid is invalid")
+	}
+
+	for _, v := range []string{``, `null`, `[]`, `"answers"`, `{"age":`} {
+		if _, err := manager.Submit(ctx, application.NewIncomplete(applicant.UUID(), json.RawMessage(v))); err == nil {
+			t.Fatalf("the answers %q are not a json object", v)
+		}
+	}
+
+	inc := application.NewIncomplete(applicant.UUID(), answers)
+	inc.Data().State = application.StateAccepted
+	inc.Data().Reviewer = "user/reviewer"
+
+	c, err := manager.Submit(ctx, inc)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Data().State != application.StatePending || c.Data().Reviewer != "" || c.Data().CreatedAt.IsZero() {
+		t.Fatal("a submitted application should be pending without a reviewer")
+	}
+
+	if _, err := manager.Submit(ctx, application.NewIncomplete(applicant.UUID(), answers)); err == nil {
+		t.Fatal("the user has an open application already")
+	}
+
+	if ids := producedEvents(t, prod); len(ids) != 1 || ids[0] != application.SubmittedEventID {
+		t.Fatal("a submitted event should be produced")
+	}
+}
+
+type workflowFixture struct {
+	manager application.Manager
+	rbac    rbac.Control
+	prod    *pubsubMocks.FakeProducer
+	id      application.Identifier
+}
+
+func newWorkflowFixture(t *testing.T) *workflowFixture {
+	ctx := context.Background()
+	prod := &pubsubMocks.FakeProducer{}
+	rb := rbac.NewControl(rbacMemory.NewRepository(), event.NewProducer(&pubsubMocks.FakeProducer{}))
+	manager := application.NewManager(memory.NewRepository(), rb, event.NewProducer(prod), "whitelisted")
+
+	for _, v := range []rbac.RoleID{"civilian", "whitelisted"} {
+		if err := rb.SetRoleParents(ctx, v, rbac.RoleParents{}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	if err := rb.SetAccountRoles(ctx, "user/"+rbac.AccountID(applicant), rbac.AccountRoles{"civilian"}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	c, err := manager.Submit(ctx, application.NewIncomplete(applicant.UUID(), answers))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return &workflowFixture{manager, rb, prod, c}
+}
+
+func (f *workflowFixture) state(t *testing.T) application.State {
+	c, err := f.manager.Get(context.Background(), f.id)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return c.Data().State
+}
+
+func TestManagerClaim(t *testing.T) {
+	f := newWorkflowFixture(t)
+
+	if err := f.manager.Claim(context.Background(), f.id); err == nil {
+		t.Fatal("there is no acting reviewer")
+	}
+
+	if err := f.manager.Claim(as("user/first"), f.id); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if f.state(t) != application.StateInReview {
+		t.Fatal("a claimed application should be in review")
+	}
+
+	if err := f.manager.Claim(as("user/first"), f.id); err != nil {
+		t.Fatal("claiming an application twice should not fail")
+	}
+
+	if err := f.manager.Claim(as("user/second"), f.id); err == nil {
+		t.Fatal("the application has been claimed by another reviewer")
+	}
+
+	if ids := producedEvents(t, f.prod); len(ids) != 2 || ids[1] != application.ClaimedEventID {
+		t.Fatal("a single claimed event should be produced")
+	}
+}
+
+func TestManagerCommentAndVote(t *testing.T) {
+	f := newWorkflowFixture(t)
+
+	if _, err := f.manager.Comment(as("user/first"), f.id, ""); err == nil {
+		t.Fatal("the comment is empty")
+	}
+
+	c, err := f.manager.Comment(as("user/first"), f.id, "looks good")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.ID == "" || c.Author != "user/first" || c.CreatedAt.IsZero() {
+		t.Fatal("the comment should be stored for the acting reviewer")
+	}
+
+	if _, err := f.manager.Comment(as("user/first"), application.NewIdentifier("unknown"), "looks good"); err == nil {
+		t.Fatal("the application is unknown")
+	}
+
+	if err := f.manager.Vote(as("user/first"), f.id, false); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := f.manager.Vote(as("user/first"), f.id, true); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := f.manager.Vote(as("user/second"), f.id, true); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	votes, err := f.manager.GetVotes(context.Background(), f.id)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(votes) != 2 || !votes[0].Approve || !votes[1].Approve {
+		t.Fatal("every reviewer should have a single vote")
+	}
+
+	if err := f.manager.Claim(as("user/first"), f.id); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := f.manager.Reject(as("user/first"), f.id, "too short"); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := f.manager.Vote(as("user/second"), f.id, false); err == nil {
+		t.Fatal("a decided application cannot be voted on")
+	}
+}
+
+func TestManagerAccept(t *testing.T) {
+	f := newWorkflowFixture(t)
+
+	if err := f.manager.Accept(as("user/first"), f.id, ""); err == nil {
+		t.Fatal("the application has not been claimed")
+	}
+
+	if err := f.manager.Claim(as("user/first"), f.id); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := f.manager.Accept(as("user/second"), f.id, ""); err == nil {
+		t.Fatal("the application has been claimed by another reviewer")
+	}
+
+	if err := f.manager.Accept(as("user/first"), f.id, "welcome"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	c, err := f.manager.Get(context.Background(), f.id)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if c.Data().State != application.StateAccepted || c.Data().Reason != "welcome" || c.Data().DecidedAt == nil {
+		t.Fatal("the decision should be stored")
+	}
+
+	roles, err := f.rbac.GetAccountRoles(context.Background(), "user/"+rbac.AccountID(applicant))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(roles) != 2 || !roles.Contains("civilian") || !roles.Contains("whitelisted") {
+		t.Fatal("the whitelist role should be granted in addition to the other roles")
+	}
+
+	if err := f.manager.Reject(as("user/first"), f.id, "changed my mind"); err == nil {
+		t.Fatal("the application has been decided already")
+	}
+
+	if _, err := f.manager.Submit(context.Background(), application.NewIncomplete(applicant.UUID(), answers)); err == nil {
+		t.Fatal("an accepted user cannot apply again")
+	}
+
+	if ids := producedEvents(t, f.prod); len(ids) != 3 || ids[2] != application.AcceptedEventID {
+		t.Fatal("an accepted event should be produced")
+	}
+}
+
+func TestManagerAcceptRetry(t *testing.T) {
+	ctx := as("user/first")
+	repo := &mocks.FakeRepository{}
+	rb := &rbacMocks.FakeControl{}
+	manager := application.NewManager(repo, rb, event.NewProducer(&pubsubMocks.FakeProducer{}), "whitelisted")
+
+	inc := application.NewIncomplete(applicant.UUID(), answers)
+	inc.Data().State = application.StateInReview
+	inc.Data().Reviewer = "user/first"
+	repo.GetReturns(&struct {
+		application.Identifier
+		application.Incomplete
+	}{application.NewIdentifier("test"), inc}, nil)
+
+	rb.GetAccountRolesReturns(rbac.AccountRoles{"whitelisted"}, nil)
+	repo.UpdateReturns(errors.New("fake error"))
+
+	if err := manager.Accept(ctx, application.NewIdentifier("test"), ""); err == nil {
+		t.Fatal("the error of the repository should be returned")
+	}
+
+	if rb.AddAccountRolesCallCount() != 0 || rb.SetAccountRolesCallCount() != 0 {
+		t.Fatal("a granted role should not be granted again")
+	}
+}
+
+func TestManagerReject(t *testing.T) {
+	f := newWorkflowFixture(t)
+
+	if err := f.manager.Claim(as("user/first"), f.id); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := f.manager.Reject(as("user/first"), f.id, ""); err == nil {
+		t.Fatal("a rejection needs a reason")
+	}
+
+	if err := f.manager.Reject(as("user/first"), f.id, "too short"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if f.state(t) != application.StateRejected {
+		t.Fatal("the application should be rejected")
+	}
+
+	roles, err := f.rbac.GetAccountRoles(context.Background(), "user/"+rbac.AccountID(applicant))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if roles.Contains("whitelisted") {
+		t.Fatal("a rejected user should not be whitelisted")
+	}
+
+	if _, err := f.manager.Submit(context.Background(), application.NewIncomplete(applicant.UUID(), answers)); err != nil {
+		t.Fatal("a rejected user may apply again")
+	}
+
+	if ids := producedEvents(t, f.prod); len(ids) != 4 || ids[2] != application.RejectedEventID {
+		t.Fatal("a rejected event should be produced")
+	}
+}
+
+func TestPrivacyHandler(t *testing.T) {
+	repo := memory.NewRepository()
+	h := application.NewPrivacyHandler(repo)
+	ctx := as("user/first")
+
+	c, err := repo.Create(ctx, application.NewIncomplete(applicant.UUID(), answers))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, err := repo.AddComment(ctx, c, &application.Comment{Author: "user/first", Text: "fine"}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, err := h.Export(ctx, applicant.UUID()); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := h.Erase(ctx, applicant.UUID()); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	applications, err := repo.GetByUser(ctx, applicant)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	comments, err := repo.GetComments(ctx, c)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(applications) != 0 || len(comments) != 0 {
+		t.Fatal("the applications should be erased with their comments")
+	}
+}
@@ -0,0 +1,264 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/application"
+	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+type complete struct {
+	application.Identifier
+	application.Incomplete
+}
+
+func (c *complete) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		GUID      string            `json:"guid"`
+		UserUUID  string            `json:"user_uuid"`
+		Answers   json.RawMessage   `json:"answers"`
+		State     application.State `json:"state"`
+		Reviewer  rbac.AccountID    `json:"reviewer,omitempty"`
+		Reason    string            `json:"reason,omitempty"`
+		CreatedAt time.Time         `json:"created_at"`
+		DecidedAt *time.Time        `json:"decided_at,omitempty"`
+	}{
+		c.GUID(),
+		c.Data().UserUUID,
+		c.Data().Answers,
+		c.Data().State,
+		c.Data().Reviewer,
+		c.Data().Reason,
+		c.Data().CreatedAt,
+		c.Data().DecidedAt,
+	})
+}
+
+type entry struct {
+	guid     string
+	data     application.Incomplete
+	comments []*application.Comment
+	votes    []*application.Vote
+}
+
+type repository struct {
+	mutex sync.RWMutex
+	// applications in the order of their creation
+	applications []*entry
+}
+
+// NewRepository creates a new in memory storage repository
+func NewRepository() application.Repository {
+	return &repository{
+		applications: make([]*entry, 0),
+	}
+}
+
+func stored(inc application.Incomplete) application.Incomplete {
+	s := application.NewIncomplete(
+		inc.Data().UserUUID,
+		append(json.RawMessage{}, inc.Data().Answers...),
+	)
+	s.Data().State = inc.Data().State
+	s.Data().Reviewer = inc.Data().Reviewer
+	s.Data().Reason = inc.Data().Reason
+	s.Data().CreatedAt = inc.Data().CreatedAt
+	if inc.Data().DecidedAt != nil {
+		decidedAt := *inc.Data().DecidedAt
+		s.Data().DecidedAt = &decidedAt
+	}
+
+	return s
+}
+
+func (e *entry) complete() application.Complete {
+	return &complete{
+		application.NewIdentifier(e.guid),
+		stored(e.data),
+	}
+}
+
+func (r *repository) find(id application.Identifier) *entry {
+	for _, v := range r.applications {
+		if v.guid == id.GUID() {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) Get(ctx context.Context, id application.Identifier) (application.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	e := r.find(id)
+	if e == nil {
+		return nil, sql.ErrNoRows
+	}
+
+	return e.complete(), nil
+}
+
+func (r *repository) filter(match func(application.Incomplete) bool) []application.Complete {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	applications := make([]application.Complete, 0)
+	for _, v := range r.applications {
+		if match(v.data) {
+			applications = append(applications, v.complete())
+		}
+	}
+
+	return applications
+}
+
+func (r *repository) GetByUser(ctx context.Context, id user.Identifier) ([]application.Complete, error) {
+	return r.filter(func(inc application.Incomplete) bool {
+		return inc.Data().UserUUID == id.UUID()
+	}), nil
+}
+
+func (r *repository) GetByState(ctx context.Context, s application.State) ([]application.Complete, error) {
+	return r.filter(func(inc application.Incomplete) bool {
+		return inc.Data().State == s
+	}), nil
+}
+
+func (r *repository) Create(ctx context.Context, inc application.Incomplete) (application.Complete, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	e := &entry{
+		guid:     rand.String(),
+		data:     stored(inc),
+		comments: make([]*application.Comment, 0),
+		votes:    make([]*application.Vote, 0),
+	}
+	r.applications = append(r.applications, e)
+
+	return e.complete(), nil
+}
+
+func (r *repository) Update(ctx context.Context, c application.Complete) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	e := r.find(c)
+	if e == nil {
+		return nil
+	}
+
+	// the user, the answers and the creation time never change
+	s := stored(c)
+	e.data.Data().State = s.Data().State
+	e.data.Data().Reviewer = s.Data().Reviewer
+	e.data.Data().Reason = s.Data().Reason
+	e.data.Data().DecidedAt = s.Data().DecidedAt
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id application.Identifier) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, v := range r.applications {
+		if v.guid == id.GUID() {
+			r.applications = append(r.applications[:i], r.applications[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) GetComments(ctx context.Context, id application.Identifier) ([]*application.Comment, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	comments := make([]*application.Comment, 0)
+	if e := r.find(id); e != nil {
+		for _, v := range e.comments {
+			c := *v
+			comments = append(comments, &c)
+		}
+	}
+
+	return comments, nil
+}
+
+func (r *repository) AddComment(ctx context.Context, id application.Identifier, c *application.Comment) (*application.Comment, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	s := *c
+	s.ID = rand.String()
+
+	if e := r.find(id); e != nil {
+		stored := s
+		e.comments = append(e.comments, &stored)
+	}
+
+	return &s, nil
+}
+
+func (r *repository) GetVotes(ctx context.Context, id application.Identifier) ([]*application.Vote, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	votes := make([]*application.Vote, 0)
+	if e := r.find(id); e != nil {
+		for _, v := range e.votes {
+			vote := *v
+			votes = append(votes, &vote)
+		}
+	}
+
+	sort.Slice(votes, func(i, j int) bool {
+		return votes[i].Reviewer < votes[j].Reviewer
+	})
+
+	return votes, nil
+}
+
+func (r *repository) SetVote(ctx context.Context, id application.Identifier, v *application.Vote) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	e := r.find(id)
+	if e == nil {
+		return nil
+	}
+
+	vote := *v
+	for i, stored := range e.votes {
+		if stored.Reviewer == v.Reviewer {
+			e.votes[i] = &vote
+			return nil
+		}
+	}
+
+	e.votes = append(e.votes, &vote)
+
+	return nil
+}
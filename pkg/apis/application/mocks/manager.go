@@ -0,0 +1,775 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/application"
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+type FakeManager struct {
+	GetStub        func(context.Context, application.Identifier) (application.Complete, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}
+	getReturns struct {
+		result1 application.Complete
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 application.Complete
+		result2 error
+	}
+	GetByUserStub        func(context.Context, user.Identifier) ([]application.Complete, error)
+	getByUserMutex       sync.RWMutex
+	getByUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	getByUserReturns struct {
+		result1 []application.Complete
+		result2 error
+	}
+	getByUserReturnsOnCall map[int]struct {
+		result1 []application.Complete
+		result2 error
+	}
+	GetByStateStub        func(context.Context, application.State) ([]application.Complete, error)
+	getByStateMutex       sync.RWMutex
+	getByStateArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.State
+	}
+	getByStateReturns struct {
+		result1 []application.Complete
+		result2 error
+	}
+	getByStateReturnsOnCall map[int]struct {
+		result1 []application.Complete
+		result2 error
+	}
+	SubmitStub        func(context.Context, application.Incomplete) (application.Complete, error)
+	submitMutex       sync.RWMutex
+	submitArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Incomplete
+	}
+	submitReturns struct {
+		result1 application.Complete
+		result2 error
+	}
+	submitReturnsOnCall map[int]struct {
+		result1 application.Complete
+		result2 error
+	}
+	ClaimStub        func(context.Context, application.Identifier) error
+	claimMutex       sync.RWMutex
+	claimArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}
+	claimReturns struct {
+		result1 error
+	}
+	claimReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetCommentsStub        func(context.Context, application.Identifier) ([]*application.Comment, error)
+	getCommentsMutex       sync.RWMutex
+	getCommentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}
+	getCommentsReturns struct {
+		result1 []*application.Comment
+		result2 error
+	}
+	getCommentsReturnsOnCall map[int]struct {
+		result1 []*application.Comment
+		result2 error
+	}
+	CommentStub        func(context.Context, application.Identifier, string) (*application.Comment, error)
+	commentMutex       sync.RWMutex
+	commentArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+		arg3 string
+	}
+	commentReturns struct {
+		result1 *application.Comment
+		result2 error
+	}
+	commentReturnsOnCall map[int]struct {
+		result1 *application.Comment
+		result2 error
+	}
+	GetVotesStub        func(context.Context, application.Identifier) ([]*application.Vote, error)
+	getVotesMutex       sync.RWMutex
+	getVotesArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}
+	getVotesReturns struct {
+		result1 []*application.Vote
+		result2 error
+	}
+	getVotesReturnsOnCall map[int]struct {
+		result1 []*application.Vote
+		result2 error
+	}
+	VoteStub        func(context.Context, application.Identifier, bool) error
+	voteMutex       sync.RWMutex
+	voteArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+		arg3 bool
+	}
+	voteReturns struct {
+		result1 error
+	}
+	voteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AcceptStub        func(context.Context, application.Identifier, string) error
+	acceptMutex       sync.RWMutex
+	acceptArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+		arg3 string
+	}
+	acceptReturns struct {
+		result1 error
+	}
+	acceptReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RejectStub        func(context.Context, application.Identifier, string) error
+	rejectMutex       sync.RWMutex
+	rejectArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+		arg3 string
+	}
+	rejectReturns struct {
+		result1 error
+	}
+	rejectReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeManager) Get(arg1 context.Context, arg2 application.Identifier) (application.Complete, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getReturns.result1, fake.getReturns.result2
+}
+
+func (fake *FakeManager) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeManager) GetArgsForCall(i int) (context.Context, application.Identifier) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return fake.getArgsForCall[i].arg1, fake.getArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetReturns(result1 application.Complete, result2 error) {
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetReturnsOnCall(i int, result1 application.Complete, result2 error) {
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 application.Complete
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetByUser(arg1 context.Context, arg2 user.Identifier) ([]application.Complete, error) {
+	fake.getByUserMutex.Lock()
+	ret, specificReturn := fake.getByUserReturnsOnCall[len(fake.getByUserArgsForCall)]
+	fake.getByUserArgsForCall = append(fake.getByUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetByUser", []interface{}{arg1, arg2})
+	fake.getByUserMutex.Unlock()
+	if fake.GetByUserStub != nil {
+		return fake.GetByUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getByUserReturns.result1, fake.getByUserReturns.result2
+}
+
+func (fake *FakeManager) GetByUserCallCount() int {
+	fake.getByUserMutex.RLock()
+	defer fake.getByUserMutex.RUnlock()
+	return len(fake.getByUserArgsForCall)
+}
+
+func (fake *FakeManager) GetByUserArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getByUserMutex.RLock()
+	defer fake.getByUserMutex.RUnlock()
+	return fake.getByUserArgsForCall[i].arg1, fake.getByUserArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetByUserReturns(result1 []application.Complete, result2 error) {
+	fake.GetByUserStub = nil
+	fake.getByUserReturns = struct {
+		result1 []application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetByUserReturnsOnCall(i int, result1 []application.Complete, result2 error) {
+	fake.GetByUserStub = nil
+	if fake.getByUserReturnsOnCall == nil {
+		fake.getByUserReturnsOnCall = make(map[int]struct {
+			result1 []application.Complete
+			result2 error
+		})
+	}
+	fake.getByUserReturnsOnCall[i] = struct {
+		result1 []application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetByState(arg1 context.Context, arg2 application.State) ([]application.Complete, error) {
+	fake.getByStateMutex.Lock()
+	ret, specificReturn := fake.getByStateReturnsOnCall[len(fake.getByStateArgsForCall)]
+	fake.getByStateArgsForCall = append(fake.getByStateArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.State
+	}{arg1, arg2})
+	fake.recordInvocation("GetByState", []interface{}{arg1, arg2})
+	fake.getByStateMutex.Unlock()
+	if fake.GetByStateStub != nil {
+		return fake.GetByStateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getByStateReturns.result1, fake.getByStateReturns.result2
+}
+
+func (fake *FakeManager) GetByStateCallCount() int {
+	fake.getByStateMutex.RLock()
+	defer fake.getByStateMutex.RUnlock()
+	return len(fake.getByStateArgsForCall)
+}
+
+func (fake *FakeManager) GetByStateArgsForCall(i int) (context.Context, application.State) {
+	fake.getByStateMutex.RLock()
+	defer fake.getByStateMutex.RUnlock()
+	return fake.getByStateArgsForCall[i].arg1, fake.getByStateArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetByStateReturns(result1 []application.Complete, result2 error) {
+	fake.GetByStateStub = nil
+	fake.getByStateReturns = struct {
+		result1 []application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetByStateReturnsOnCall(i int, result1 []application.Complete, result2 error) {
+	fake.GetByStateStub = nil
+	if fake.getByStateReturnsOnCall == nil {
+		fake.getByStateReturnsOnCall = make(map[int]struct {
+			result1 []application.Complete
+			result2 error
+		})
+	}
+	fake.getByStateReturnsOnCall[i] = struct {
+		result1 []application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Submit(arg1 context.Context, arg2 application.Incomplete) (application.Complete, error) {
+	fake.submitMutex.Lock()
+	ret, specificReturn := fake.submitReturnsOnCall[len(fake.submitArgsForCall)]
+	fake.submitArgsForCall = append(fake.submitArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Incomplete
+	}{arg1, arg2})
+	fake.recordInvocation("Submit", []interface{}{arg1, arg2})
+	fake.submitMutex.Unlock()
+	if fake.SubmitStub != nil {
+		return fake.SubmitStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.submitReturns.result1, fake.submitReturns.result2
+}
+
+func (fake *FakeManager) SubmitCallCount() int {
+	fake.submitMutex.RLock()
+	defer fake.submitMutex.RUnlock()
+	return len(fake.submitArgsForCall)
+}
+
+func (fake *FakeManager) SubmitArgsForCall(i int) (context.Context, application.Incomplete) {
+	fake.submitMutex.RLock()
+	defer fake.submitMutex.RUnlock()
+	return fake.submitArgsForCall[i].arg1, fake.submitArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) SubmitReturns(result1 application.Complete, result2 error) {
+	fake.SubmitStub = nil
+	fake.submitReturns = struct {
+		result1 application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) SubmitReturnsOnCall(i int, result1 application.Complete, result2 error) {
+	fake.SubmitStub = nil
+	if fake.submitReturnsOnCall == nil {
+		fake.submitReturnsOnCall = make(map[int]struct {
+			result1 application.Complete
+			result2 error
+		})
+	}
+	fake.submitReturnsOnCall[i] = struct {
+		result1 application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Claim(arg1 context.Context, arg2 application.Identifier) error {
+	fake.claimMutex.Lock()
+	ret, specificReturn := fake.claimReturnsOnCall[len(fake.claimArgsForCall)]
+	fake.claimArgsForCall = append(fake.claimArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Claim", []interface{}{arg1, arg2})
+	fake.claimMutex.Unlock()
+	if fake.ClaimStub != nil {
+		return fake.ClaimStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.claimReturns.result1
+}
+
+func (fake *FakeManager) ClaimCallCount() int {
+	fake.claimMutex.RLock()
+	defer fake.claimMutex.RUnlock()
+	return len(fake.claimArgsForCall)
+}
+
+func (fake *FakeManager) ClaimArgsForCall(i int) (context.Context, application.Identifier) {
+	fake.claimMutex.RLock()
+	defer fake.claimMutex.RUnlock()
+	return fake.claimArgsForCall[i].arg1, fake.claimArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) ClaimReturns(result1 error) {
+	fake.ClaimStub = nil
+	fake.claimReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) ClaimReturnsOnCall(i int, result1 error) {
+	fake.ClaimStub = nil
+	if fake.claimReturnsOnCall == nil {
+		fake.claimReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.claimReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) GetComments(arg1 context.Context, arg2 application.Identifier) ([]*application.Comment, error) {
+	fake.getCommentsMutex.Lock()
+	ret, specificReturn := fake.getCommentsReturnsOnCall[len(fake.getCommentsArgsForCall)]
+	fake.getCommentsArgsForCall = append(fake.getCommentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetComments", []interface{}{arg1, arg2})
+	fake.getCommentsMutex.Unlock()
+	if fake.GetCommentsStub != nil {
+		return fake.GetCommentsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getCommentsReturns.result1, fake.getCommentsReturns.result2
+}
+
+func (fake *FakeManager) GetCommentsCallCount() int {
+	fake.getCommentsMutex.RLock()
+	defer fake.getCommentsMutex.RUnlock()
+	return len(fake.getCommentsArgsForCall)
+}
+
+func (fake *FakeManager) GetCommentsArgsForCall(i int) (context.Context, application.Identifier) {
+	fake.getCommentsMutex.RLock()
+	defer fake.getCommentsMutex.RUnlock()
+	return fake.getCommentsArgsForCall[i].arg1, fake.getCommentsArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetCommentsReturns(result1 []*application.Comment, result2 error) {
+	fake.GetCommentsStub = nil
+	fake.getCommentsReturns = struct {
+		result1 []*application.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetCommentsReturnsOnCall(i int, result1 []*application.Comment, result2 error) {
+	fake.GetCommentsStub = nil
+	if fake.getCommentsReturnsOnCall == nil {
+		fake.getCommentsReturnsOnCall = make(map[int]struct {
+			result1 []*application.Comment
+			result2 error
+		})
+	}
+	fake.getCommentsReturnsOnCall[i] = struct {
+		result1 []*application.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Comment(arg1 context.Context, arg2 application.Identifier, arg3 string) (*application.Comment, error) {
+	fake.commentMutex.Lock()
+	ret, specificReturn := fake.commentReturnsOnCall[len(fake.commentArgsForCall)]
+	fake.commentArgsForCall = append(fake.commentArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Comment", []interface{}{arg1, arg2, arg3})
+	fake.commentMutex.Unlock()
+	if fake.CommentStub != nil {
+		return fake.CommentStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.commentReturns.result1, fake.commentReturns.result2
+}
+
+func (fake *FakeManager) CommentCallCount() int {
+	fake.commentMutex.RLock()
+	defer fake.commentMutex.RUnlock()
+	return len(fake.commentArgsForCall)
+}
+
+func (fake *FakeManager) CommentArgsForCall(i int) (context.Context, application.Identifier, string) {
+	fake.commentMutex.RLock()
+	defer fake.commentMutex.RUnlock()
+	return fake.commentArgsForCall[i].arg1, fake.commentArgsForCall[i].arg2, fake.commentArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) CommentReturns(result1 *application.Comment, result2 error) {
+	fake.CommentStub = nil
+	fake.commentReturns = struct {
+		result1 *application.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) CommentReturnsOnCall(i int, result1 *application.Comment, result2 error) {
+	fake.CommentStub = nil
+	if fake.commentReturnsOnCall == nil {
+		fake.commentReturnsOnCall = make(map[int]struct {
+			result1 *application.Comment
+			result2 error
+		})
+	}
+	fake.commentReturnsOnCall[i] = struct {
+		result1 *application.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetVotes(arg1 context.Context, arg2 application.Identifier) ([]*application.Vote, error) {
+	fake.getVotesMutex.Lock()
+	ret, specificReturn := fake.getVotesReturnsOnCall[len(fake.getVotesArgsForCall)]
+	fake.getVotesArgsForCall = append(fake.getVotesArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetVotes", []interface{}{arg1, arg2})
+	fake.getVotesMutex.Unlock()
+	if fake.GetVotesStub != nil {
+		return fake.GetVotesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getVotesReturns.result1, fake.getVotesReturns.result2
+}
+
+func (fake *FakeManager) GetVotesCallCount() int {
+	fake.getVotesMutex.RLock()
+	defer fake.getVotesMutex.RUnlock()
+	return len(fake.getVotesArgsForCall)
+}
+
+func (fake *FakeManager) GetVotesArgsForCall(i int) (context.Context, application.Identifier) {
+	fake.getVotesMutex.RLock()
+	defer fake.getVotesMutex.RUnlock()
+	return fake.getVotesArgsForCall[i].arg1, fake.getVotesArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetVotesReturns(result1 []*application.Vote, result2 error) {
+	fake.GetVotesStub = nil
+	fake.getVotesReturns = struct {
+		result1 []*application.Vote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetVotesReturnsOnCall(i int, result1 []*application.Vote, result2 error) {
+	fake.GetVotesStub = nil
+	if fake.getVotesReturnsOnCall == nil {
+		fake.getVotesReturnsOnCall = make(map[int]struct {
+			result1 []*application.Vote
+			result2 error
+		})
+	}
+	fake.getVotesReturnsOnCall[i] = struct {
+		result1 []*application.Vote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Vote(arg1 context.Context, arg2 application.Identifier, arg3 bool) error {
+	fake.voteMutex.Lock()
+	ret, specificReturn := fake.voteReturnsOnCall[len(fake.voteArgsForCall)]
+	fake.voteArgsForCall = append(fake.voteArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+		arg3 bool
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Vote", []interface{}{arg1, arg2, arg3})
+	fake.voteMutex.Unlock()
+	if fake.VoteStub != nil {
+		return fake.VoteStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.voteReturns.result1
+}
+
+func (fake *FakeManager) VoteCallCount() int {
+	fake.voteMutex.RLock()
+	defer fake.voteMutex.RUnlock()
+	return len(fake.voteArgsForCall)
+}
+
+func (fake *FakeManager) VoteArgsForCall(i int) (context.Context, application.Identifier, bool) {
+	fake.voteMutex.RLock()
+	defer fake.voteMutex.RUnlock()
+	return fake.voteArgsForCall[i].arg1, fake.voteArgsForCall[i].arg2, fake.voteArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) VoteReturns(result1 error) {
+	fake.VoteStub = nil
+	fake.voteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) VoteReturnsOnCall(i int, result1 error) {
+	fake.VoteStub = nil
+	if fake.voteReturnsOnCall == nil {
+		fake.voteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.voteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Accept(arg1 context.Context, arg2 application.Identifier, arg3 string) error {
+	fake.acceptMutex.Lock()
+	ret, specificReturn := fake.acceptReturnsOnCall[len(fake.acceptArgsForCall)]
+	fake.acceptArgsForCall = append(fake.acceptArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Accept", []interface{}{arg1, arg2, arg3})
+	fake.acceptMutex.Unlock()
+	if fake.AcceptStub != nil {
+		return fake.AcceptStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.acceptReturns.result1
+}
+
+func (fake *FakeManager) AcceptCallCount() int {
+	fake.acceptMutex.RLock()
+	defer fake.acceptMutex.RUnlock()
+	return len(fake.acceptArgsForCall)
+}
+
+func (fake *FakeManager) AcceptArgsForCall(i int) (context.Context, application.Identifier, string) {
+	fake.acceptMutex.RLock()
+	defer fake.acceptMutex.RUnlock()
+	return fake.acceptArgsForCall[i].arg1, fake.acceptArgsForCall[i].arg2, fake.acceptArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) AcceptReturns(result1 error) {
+	fake.AcceptStub = nil
+	fake.acceptReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) AcceptReturnsOnCall(i int, result1 error) {
+	fake.AcceptStub = nil
+	if fake.acceptReturnsOnCall == nil {
+		fake.acceptReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.acceptReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Reject(arg1 context.Context, arg2 application.Identifier, arg3 string) error {
+	fake.rejectMutex.Lock()
+	ret, specificReturn := fake.rejectReturnsOnCall[len(fake.rejectArgsForCall)]
+	fake.rejectArgsForCall = append(fake.rejectArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Reject", []interface{}{arg1, arg2, arg3})
+	fake.rejectMutex.Unlock()
+	if fake.RejectStub != nil {
+		return fake.RejectStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.rejectReturns.result1
+}
+
+func (fake *FakeManager) RejectCallCount() int {
+	fake.rejectMutex.RLock()
+	defer fake.rejectMutex.RUnlock()
+	return len(fake.rejectArgsForCall)
+}
+
+func (fake *FakeManager) RejectArgsForCall(i int) (context.Context, application.Identifier, string) {
+	fake.rejectMutex.RLock()
+	defer fake.rejectMutex.RUnlock()
+	return fake.rejectArgsForCall[i].arg1, fake.rejectArgsForCall[i].arg2, fake.rejectArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) RejectReturns(result1 error) {
+	fake.RejectStub = nil
+	fake.rejectReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) RejectReturnsOnCall(i int, result1 error) {
+	fake.RejectStub = nil
+	if fake.rejectReturnsOnCall == nil {
+		fake.rejectReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rejectReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getByUserMutex.RLock()
+	defer fake.getByUserMutex.RUnlock()
+	fake.getByStateMutex.RLock()
+	defer fake.getByStateMutex.RUnlock()
+	fake.submitMutex.RLock()
+	defer fake.submitMutex.RUnlock()
+	fake.claimMutex.RLock()
+	defer fake.claimMutex.RUnlock()
+	fake.getCommentsMutex.RLock()
+	defer fake.getCommentsMutex.RUnlock()
+	fake.commentMutex.RLock()
+	defer fake.commentMutex.RUnlock()
+	fake.getVotesMutex.RLock()
+	defer fake.getVotesMutex.RUnlock()
+	fake.voteMutex.RLock()
+	defer fake.voteMutex.RUnlock()
+	fake.acceptMutex.RLock()
+	defer fake.acceptMutex.RUnlock()
+	fake.rejectMutex.RLock()
+	defer fake.rejectMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeManager) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ application.Manager = new(FakeManager)
@@ -0,0 +1,708 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/application"
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+type FakeRepository struct {
+	GetStub        func(context.Context, application.Identifier) (application.Complete, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}
+	getReturns struct {
+		result1 application.Complete
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 application.Complete
+		result2 error
+	}
+	GetByUserStub        func(context.Context, user.Identifier) ([]application.Complete, error)
+	getByUserMutex       sync.RWMutex
+	getByUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	getByUserReturns struct {
+		result1 []application.Complete
+		result2 error
+	}
+	getByUserReturnsOnCall map[int]struct {
+		result1 []application.Complete
+		result2 error
+	}
+	GetByStateStub        func(context.Context, application.State) ([]application.Complete, error)
+	getByStateMutex       sync.RWMutex
+	getByStateArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.State
+	}
+	getByStateReturns struct {
+		result1 []application.Complete
+		result2 error
+	}
+	getByStateReturnsOnCall map[int]struct {
+		result1 []application.Complete
+		result2 error
+	}
+	CreateStub        func(context.Context, application.Incomplete) (application.Complete, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Incomplete
+	}
+	createReturns struct {
+		result1 application.Complete
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 application.Complete
+		result2 error
+	}
+	UpdateStub        func(context.Context, application.Complete) error
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Complete
+	}
+	updateReturns struct {
+		result1 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteStub        func(context.Context, application.Identifier) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetCommentsStub        func(context.Context, application.Identifier) ([]*application.Comment, error)
+	getCommentsMutex       sync.RWMutex
+	getCommentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}
+	getCommentsReturns struct {
+		result1 []*application.Comment
+		result2 error
+	}
+	getCommentsReturnsOnCall map[int]struct {
+		result1 []*application.Comment
+		result2 error
+	}
+	AddCommentStub        func(context.Context, application.Identifier, *application.Comment) (*application.Comment, error)
+	addCommentMutex       sync.RWMutex
+	addCommentArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+		arg3 *application.Comment
+	}
+	addCommentReturns struct {
+		result1 *application.Comment
+		result2 error
+	}
+	addCommentReturnsOnCall map[int]struct {
+		result1 *application.Comment
+		result2 error
+	}
+	GetVotesStub        func(context.Context, application.Identifier) ([]*application.Vote, error)
+	getVotesMutex       sync.RWMutex
+	getVotesArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}
+	getVotesReturns struct {
+		result1 []*application.Vote
+		result2 error
+	}
+	getVotesReturnsOnCall map[int]struct {
+		result1 []*application.Vote
+		result2 error
+	}
+	SetVoteStub        func(context.Context, application.Identifier, *application.Vote) error
+	setVoteMutex       sync.RWMutex
+	setVoteArgsForCall []struct {
+		arg1 context.Context
+		arg2 application.Identifier
+		arg3 *application.Vote
+	}
+	setVoteReturns struct {
+		result1 error
+	}
+	setVoteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRepository) Get(arg1 context.Context, arg2 application.Identifier) (application.Complete, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getReturns.result1, fake.getReturns.result2
+}
+
+func (fake *FakeRepository) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeRepository) GetArgsForCall(i int) (context.Context, application.Identifier) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return fake.getArgsForCall[i].arg1, fake.getArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetReturns(result1 application.Complete, result2 error) {
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetReturnsOnCall(i int, result1 application.Complete, result2 error) {
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 application.Complete
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetByUser(arg1 context.Context, arg2 user.Identifier) ([]application.Complete, error) {
+	fake.getByUserMutex.Lock()
+	ret, specificReturn := fake.getByUserReturnsOnCall[len(fake.getByUserArgsForCall)]
+	fake.getByUserArgsForCall = append(fake.getByUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetByUser", []interface{}{arg1, arg2})
+	fake.getByUserMutex.Unlock()
+	if fake.GetByUserStub != nil {
+		return fake.GetByUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getByUserReturns.result1, fake.getByUserReturns.result2
+}
+
+func (fake *FakeRepository) GetByUserCallCount() int {
+	fake.getByUserMutex.RLock()
+	defer fake.getByUserMutex.RUnlock()
+	return len(fake.getByUserArgsForCall)
+}
+
+func (fake *FakeRepository) GetByUserArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getByUserMutex.RLock()
+	defer fake.getByUserMutex.RUnlock()
+	return fake.getByUserArgsForCall[i].arg1, fake.getByUserArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetByUserReturns(result1 []application.Complete, result2 error) {
+	fake.GetByUserStub = nil
+	fake.getByUserReturns = struct {
+		result1 []application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetByUserReturnsOnCall(i int, result1 []application.Complete, result2 error) {
+	fake.GetByUserStub = nil
+	if fake.getByUserReturnsOnCall == nil {
+		fake.getByUserReturnsOnCall = make(map[int]struct {
+			result1 []application.Complete
+			result2 error
+		})
+	}
+	fake.getByUserReturnsOnCall[i] = struct {
+		result1 []application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetByState(arg1 context.Context, arg2 application.State) ([]application.Complete, error) {
+	fake.getByStateMutex.Lock()
+	ret, specificReturn := fake.getByStateReturnsOnCall[len(fake.getByStateArgsForCall)]
+	fake.getByStateArgsForCall = append(fake.getByStateArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.State
+	}{arg1, arg2})
+	fake.recordInvocation("GetByState", []interface{}{arg1, arg2})
+	fake.getByStateMutex.Unlock()
+	if fake.GetByStateStub != nil {
+		return fake.GetByStateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getByStateReturns.result1, fake.getByStateReturns.result2
+}
+
+func (fake *FakeRepository) GetByStateCallCount() int {
+	fake.getByStateMutex.RLock()
+	defer fake.getByStateMutex.RUnlock()
+	return len(fake.getByStateArgsForCall)
+}
+
+func (fake *FakeRepository) GetByStateArgsForCall(i int) (context.Context, application.State) {
+	fake.getByStateMutex.RLock()
+	defer fake.getByStateMutex.RUnlock()
+	return fake.getByStateArgsForCall[i].arg1, fake.getByStateArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetByStateReturns(result1 []application.Complete, result2 error) {
+	fake.GetByStateStub = nil
+	fake.getByStateReturns = struct {
+		result1 []application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetByStateReturnsOnCall(i int, result1 []application.Complete, result2 error) {
+	fake.GetByStateStub = nil
+	if fake.getByStateReturnsOnCall == nil {
+		fake.getByStateReturnsOnCall = make(map[int]struct {
+			result1 []application.Complete
+			result2 error
+		})
+	}
+	fake.getByStateReturnsOnCall[i] = struct {
+		result1 []application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) Create(arg1 context.Context, arg2 application.Incomplete) (application.Complete, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Incomplete
+	}{arg1, arg2})
+	fake.recordInvocation("Create", []interface{}{arg1, arg2})
+	fake.createMutex.Unlock()
+	if fake.CreateStub != nil {
+		return fake.CreateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createReturns.result1, fake.createReturns.result2
+}
+
+func (fake *FakeRepository) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeRepository) CreateArgsForCall(i int) (context.Context, application.Incomplete) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return fake.createArgsForCall[i].arg1, fake.createArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) CreateReturns(result1 application.Complete, result2 error) {
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) CreateReturnsOnCall(i int, result1 application.Complete, result2 error) {
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 application.Complete
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 application.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) Update(arg1 context.Context, arg2 application.Complete) error {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Complete
+	}{arg1, arg2})
+	fake.recordInvocation("Update", []interface{}{arg1, arg2})
+	fake.updateMutex.Unlock()
+	if fake.UpdateStub != nil {
+		return fake.UpdateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateReturns.result1
+}
+
+func (fake *FakeRepository) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeRepository) UpdateArgsForCall(i int) (context.Context, application.Complete) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return fake.updateArgsForCall[i].arg1, fake.updateArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) UpdateReturns(result1 error) {
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) UpdateReturnsOnCall(i int, result1 error) {
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) Delete(arg1 context.Context, arg2 application.Identifier) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteReturns.result1
+}
+
+func (fake *FakeRepository) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeRepository) DeleteArgsForCall(i int) (context.Context, application.Identifier) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return fake.deleteArgsForCall[i].arg1, fake.deleteArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) DeleteReturns(result1 error) {
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeleteReturnsOnCall(i int, result1 error) {
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetComments(arg1 context.Context, arg2 application.Identifier) ([]*application.Comment, error) {
+	fake.getCommentsMutex.Lock()
+	ret, specificReturn := fake.getCommentsReturnsOnCall[len(fake.getCommentsArgsForCall)]
+	fake.getCommentsArgsForCall = append(fake.getCommentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetComments", []interface{}{arg1, arg2})
+	fake.getCommentsMutex.Unlock()
+	if fake.GetCommentsStub != nil {
+		return fake.GetCommentsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getCommentsReturns.result1, fake.getCommentsReturns.result2
+}
+
+func (fake *FakeRepository) GetCommentsCallCount() int {
+	fake.getCommentsMutex.RLock()
+	defer fake.getCommentsMutex.RUnlock()
+	return len(fake.getCommentsArgsForCall)
+}
+
+func (fake *FakeRepository) GetCommentsArgsForCall(i int) (context.Context, application.Identifier) {
+	fake.getCommentsMutex.RLock()
+	defer fake.getCommentsMutex.RUnlock()
+	return fake.getCommentsArgsForCall[i].arg1, fake.getCommentsArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetCommentsReturns(result1 []*application.Comment, result2 error) {
+	fake.GetCommentsStub = nil
+	fake.getCommentsReturns = struct {
+		result1 []*application.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetCommentsReturnsOnCall(i int, result1 []*application.Comment, result2 error) {
+	fake.GetCommentsStub = nil
+	if fake.getCommentsReturnsOnCall == nil {
+		fake.getCommentsReturnsOnCall = make(map[int]struct {
+			result1 []*application.Comment
+			result2 error
+		})
+	}
+	fake.getCommentsReturnsOnCall[i] = struct {
+		result1 []*application.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) AddComment(arg1 context.Context, arg2 application.Identifier, arg3 *application.Comment) (*application.Comment, error) {
+	fake.addCommentMutex.Lock()
+	ret, specificReturn := fake.addCommentReturnsOnCall[len(fake.addCommentArgsForCall)]
+	fake.addCommentArgsForCall = append(fake.addCommentArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+		arg3 *application.Comment
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AddComment", []interface{}{arg1, arg2, arg3})
+	fake.addCommentMutex.Unlock()
+	if fake.AddCommentStub != nil {
+		return fake.AddCommentStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.addCommentReturns.result1, fake.addCommentReturns.result2
+}
+
+func (fake *FakeRepository) AddCommentCallCount() int {
+	fake.addCommentMutex.RLock()
+	defer fake.addCommentMutex.RUnlock()
+	return len(fake.addCommentArgsForCall)
+}
+
+func (fake *FakeRepository) AddCommentArgsForCall(i int) (context.Context, application.Identifier, *application.Comment) {
+	fake.addCommentMutex.RLock()
+	defer fake.addCommentMutex.RUnlock()
+	return fake.addCommentArgsForCall[i].arg1, fake.addCommentArgsForCall[i].arg2, fake.addCommentArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) AddCommentReturns(result1 *application.Comment, result2 error) {
+	fake.AddCommentStub = nil
+	fake.addCommentReturns = struct {
+		result1 *application.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) AddCommentReturnsOnCall(i int, result1 *application.Comment, result2 error) {
+	fake.AddCommentStub = nil
+	if fake.addCommentReturnsOnCall == nil {
+		fake.addCommentReturnsOnCall = make(map[int]struct {
+			result1 *application.Comment
+			result2 error
+		})
+	}
+	fake.addCommentReturnsOnCall[i] = struct {
+		result1 *application.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetVotes(arg1 context.Context, arg2 application.Identifier) ([]*application.Vote, error) {
+	fake.getVotesMutex.Lock()
+	ret, specificReturn := fake.getVotesReturnsOnCall[len(fake.getVotesArgsForCall)]
+	fake.getVotesArgsForCall = append(fake.getVotesArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetVotes", []interface{}{arg1, arg2})
+	fake.getVotesMutex.Unlock()
+	if fake.GetVotesStub != nil {
+		return fake.GetVotesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getVotesReturns.result1, fake.getVotesReturns.result2
+}
+
+func (fake *FakeRepository) GetVotesCallCount() int {
+	fake.getVotesMutex.RLock()
+	defer fake.getVotesMutex.RUnlock()
+	return len(fake.getVotesArgsForCall)
+}
+
+func (fake *FakeRepository) GetVotesArgsForCall(i int) (context.Context, application.Identifier) {
+	fake.getVotesMutex.RLock()
+	defer fake.getVotesMutex.RUnlock()
+	return fake.getVotesArgsForCall[i].arg1, fake.getVotesArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetVotesReturns(result1 []*application.Vote, result2 error) {
+	fake.GetVotesStub = nil
+	fake.getVotesReturns = struct {
+		result1 []*application.Vote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetVotesReturnsOnCall(i int, result1 []*application.Vote, result2 error) {
+	fake.GetVotesStub = nil
+	if fake.getVotesReturnsOnCall == nil {
+		fake.getVotesReturnsOnCall = make(map[int]struct {
+			result1 []*application.Vote
+			result2 error
+		})
+	}
+	fake.getVotesReturnsOnCall[i] = struct {
+		result1 []*application.Vote
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) SetVote(arg1 context.Context, arg2 application.Identifier, arg3 *application.Vote) error {
+	fake.setVoteMutex.Lock()
+	ret, specificReturn := fake.setVoteReturnsOnCall[len(fake.setVoteArgsForCall)]
+	fake.setVoteArgsForCall = append(fake.setVoteArgsForCall, struct {
+		arg1 context.Context
+		arg2 application.Identifier
+		arg3 *application.Vote
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetVote", []interface{}{arg1, arg2, arg3})
+	fake.setVoteMutex.Unlock()
+	if fake.SetVoteStub != nil {
+		return fake.SetVoteStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setVoteReturns.result1
+}
+
+func (fake *FakeRepository) SetVoteCallCount() int {
+	fake.setVoteMutex.RLock()
+	defer fake.setVoteMutex.RUnlock()
+	return len(fake.setVoteArgsForCall)
+}
+
+func (fake *FakeRepository) SetVoteArgsForCall(i int) (context.Context, application.Identifier, *application.Vote) {
+	fake.setVoteMutex.RLock()
+	defer fake.setVoteMutex.RUnlock()
+	return fake.setVoteArgsForCall[i].arg1, fake.setVoteArgsForCall[i].arg2, fake.setVoteArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) SetVoteReturns(result1 error) {
+	fake.SetVoteStub = nil
+	fake.setVoteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) SetVoteReturnsOnCall(i int, result1 error) {
+	fake.SetVoteStub = nil
+	if fake.setVoteReturnsOnCall == nil {
+		fake.setVoteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setVoteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getByUserMutex.RLock()
+	defer fake.getByUserMutex.RUnlock()
+	fake.getByStateMutex.RLock()
+	defer fake.getByStateMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.getCommentsMutex.RLock()
+	defer fake.getCommentsMutex.RUnlock()
+	fake.addCommentMutex.RLock()
+	defer fake.addCommentMutex.RUnlock()
+	fake.getVotesMutex.RLock()
+	defer fake.getVotesMutex.RUnlock()
+	fake.setVoteMutex.RLock()
+	defer fake.setVoteMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ application.Repository = new(FakeRepository)
@@ -0,0 +1,44 @@
+package application
+
+import "context"
+
+// PrivacyHandler exports and erases the applications of users.
+// The comments and votes of reviewers are internal and not exported.
+type PrivacyHandler struct {
+	repository Repository
+}
+
+// NewPrivacyHandler for the data of the application service
+func NewPrivacyHandler(r Repository) *PrivacyHandler {
+	return &PrivacyHandler{r}
+}
+
+type privacyExport struct {
+	Applications []Complete `json:"applications"`
+}
+
+// Export the applications of a user
+func (h *PrivacyHandler) Export(ctx context.Context, userUUID string) (interface{}, error) {
+	applications, err := h.repository.GetByUser(ctx, &userIdentifier{userUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &privacyExport{applications}, nil
+}
+
+// Erase the applications of a user with their comments and votes
+func (h *PrivacyHandler) Erase(ctx context.Context, userUUID string) error {
+	applications, err := h.repository.GetByUser(ctx, &userIdentifier{userUUID})
+	if err != nil {
+		return err
+	}
+
+	for _, v := range applications {
+		if err := h.repository.Delete(ctx, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package application
+
+//go:generate counterfeiter -o ./mocks/repository.go . Repository
+
+import (
+	"context"
+
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+// Repository to manage the storage of applications with their comments and votes.
+// Deleting an application removes its comments and votes as well.
+type Repository interface {
+	Get(context.Context, Identifier) (Complete, error)
+	// GetByUser returns the applications of a user ordered by their creation
+	GetByUser(context.Context, user.Identifier) ([]Complete, error)
+	// GetByState returns the applications in a state ordered by their creation
+	GetByState(context.Context, State) ([]Complete, error)
+	Create(context.Context, Incomplete) (Complete, error)
+	// Update the state, the reviewer, the reason and the decision time of an application
+	Update(context.Context, Complete) error
+	Delete(context.Context, Identifier) error
+	// GetComments returns the comments of an application ordered by their creation
+	GetComments(context.Context, Identifier) ([]*Comment, error)
+	// AddComment stores a comment with a new id
+	AddComment(context.Context, Identifier, *Comment) (*Comment, error)
+	// GetVotes returns the votes on an application ordered by their reviewer
+	GetVotes(context.Context, Identifier) ([]*Vote, error)
+	// SetVote replaces the vote of the reviewer
+	SetVote(context.Context, Identifier, *Vote) error
+}
@@ -0,0 +1,358 @@
+// Package repositorytest contains the conformance tests of application repositories
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/application"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) application.Repository) {
+	t.Run("Create", func(t *testing.T) {
+		testCreate(t, newRepository(t))
+	})
+	t.Run("GetByUser", func(t *testing.T) {
+		testGetByUser(t, newRepository(t))
+	})
+	t.Run("GetByState", func(t *testing.T) {
+		testGetByState(t, newRepository(t))
+	})
+	t.Run("Update", func(t *testing.T) {
+		testUpdate(t, newRepository(t))
+	})
+	t.Run("Delete", func(t *testing.T) {
+		testDelete(t, newRepository(t))
+	})
+	t.Run("Comments", func(t *testing.T) {
+		testComments(t, newRepository(t))
+	})
+	t.Run("Votes", func(t *testing.T) {
+		testVotes(t, newRepository(t))
+	})
+}
+
+type userIdentifier string
+
+func (i userIdentifier) UUID() string {
+	return string(i)
+}
+
+func randomUUID(t *testing.T) string {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return rand.String()
+}
+
+var createdAt = time.Date(2018, time.May, 1, 12, 0, 0, 0, time.UTC)
+
+// newIncomplete creates a pending application, later offsets are created later
+func newIncomplete(userUUID string, offset int) application.Incomplete {
+	inc := application.NewIncomplete(userUUID, json.RawMessage(`{"age":"21"}`))
+	inc.Data().State = application.StatePending
+	inc.Data().CreatedAt = createdAt.Add(time.Duration(offset) * time.Minute)
+	return inc
+}
+
+func equalAnswers(a, b json.RawMessage) bool {
+	var x, y map[string]interface{}
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return false
+	}
+
+	return len(x) == len(y) && x["age"] == y["age"]
+}
+
+func testCreate(t *testing.T, r application.Repository) {
+	ctx := context.Background()
+
+	if _, err := r.Get(ctx, application.NewIdentifier(randomUUID(t))); err != sql.ErrNoRows {
+		t.Fatal("an unknown application should not be found")
+	}
+
+	inc := newIncomplete(randomUUID(t), 0)
+	first, err := r.Create(ctx, inc)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	second, err := r.Create(ctx, newIncomplete(inc.Data().UserUUID, 1))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if first.GUID() == "" || first.GUID() == second.GUID() {
+		t.Fatal("the created applications should have distinct ids")
+	}
+
+	c, err := r.Get(ctx, first)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.GUID() != first.GUID() ||
+		c.Data().UserUUID != inc.Data().UserUUID ||
+		!equalAnswers(c.Data().Answers, inc.Data().Answers) ||
+		c.Data().State != application.StatePending ||
+		c.Data().Reviewer != "" ||
+		c.Data().Reason != "" ||
+		!c.Data().CreatedAt.Equal(createdAt) ||
+		c.Data().DecidedAt != nil {
+		t.Fatal("the stored data is not equal")
+	}
+}
+
+func testGetByUser(t *testing.T, r application.Repository) {
+	ctx := context.Background()
+
+	owner := userIdentifier(randomUUID(t))
+
+	applications, err := r.GetByUser(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(applications) != 0 {
+		t.Fatal("a user without applications should have an empty list")
+	}
+
+	first, err := r.Create(ctx, newIncomplete(owner.UUID(), 0))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	second, err := r.Create(ctx, newIncomplete(owner.UUID(), 1))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Create(ctx, newIncomplete(randomUUID(t), 2)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	applications, err = r.GetByUser(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(applications) != 2 || applications[0].GUID() != first.GUID() || applications[1].GUID() != second.GUID() {
+		t.Fatal("the applications of the user should be ordered by their creation")
+	}
+}
+
+func testGetByState(t *testing.T, r application.Repository) {
+	ctx := context.Background()
+
+	first, err := r.Create(ctx, newIncomplete(randomUUID(t), 0))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	rejected := newIncomplete(randomUUID(t), 1)
+	rejected.Data().State = application.StateRejected
+	if _, err := r.Create(ctx, rejected); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	second, err := r.Create(ctx, newIncomplete(randomUUID(t), 2))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	applications, err := r.GetByState(ctx, application.StatePending)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(applications) != 2 || applications[0].GUID() != first.GUID() || applications[1].GUID() != second.GUID() {
+		t.Fatal("the applications in the state should be ordered by their creation")
+	}
+
+	applications, err = r.GetByState(ctx, application.StateAccepted)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(applications) != 0 {
+		t.Fatal("a state without applications should have an empty list")
+	}
+}
+
+type complete struct {
+	application.Identifier
+	application.Incomplete
+}
+
+func testUpdate(t *testing.T, r application.Repository) {
+	ctx := context.Background()
+
+	inc := newIncomplete(randomUUID(t), 0)
+	c, err := r.Create(ctx, inc)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	decidedAt := createdAt.Add(time.Hour)
+	updated := application.NewIncomplete(randomUUID(t), json.RawMessage(`{"age":"30"}`))
+	updated.Data().State = application.StateRejected
+	updated.Data().Reviewer = rbac.AccountID("user/" + randomUUID(t))
+	updated.Data().Reason = "too short"
+	updated.Data().CreatedAt = decidedAt
+	updated.Data().DecidedAt = &decidedAt
+
+	if err := r.Update(ctx, &complete{c, updated}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	stored, err := r.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if stored.Data().State != application.StateRejected ||
+		stored.Data().Reviewer != updated.Data().Reviewer ||
+		stored.Data().Reason != "too short" ||
+		stored.Data().DecidedAt == nil ||
+		!stored.Data().DecidedAt.Equal(decidedAt) {
+		t.Fatal("the state, the reviewer, the reason and the decision time should be updated")
+	}
+
+	if stored.Data().UserUUID != inc.Data().UserUUID ||
+		!equalAnswers(stored.Data().Answers, inc.Data().Answers) ||
+		!stored.Data().CreatedAt.Equal(createdAt) {
+		t.Fatal("the user, the answers and the creation time should never change")
+	}
+}
+
+func testDelete(t *testing.T, r application.Repository) {
+	ctx := context.Background()
+
+	if err := r.Delete(ctx, application.NewIdentifier(randomUUID(t))); err != nil {
+		t.Fatal("deleting an unknown application should not fail")
+	}
+
+	c, err := r.Create(ctx, newIncomplete(randomUUID(t), 0))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.AddComment(ctx, c, &application.Comment{Author: "user/reviewer", Text: "fine", CreatedAt: createdAt}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetVote(ctx, c, &application.Vote{Reviewer: "user/reviewer", Approve: true}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Delete(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Get(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("a deleted application should not be found")
+	}
+
+	comments, err := r.GetComments(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	votes, err := r.GetVotes(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(comments) != 0 || len(votes) != 0 {
+		t.Fatal("the comments and votes should be deleted with the application")
+	}
+}
+
+func testComments(t *testing.T, r application.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, newIncomplete(randomUUID(t), 0))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	comments, err := r.GetComments(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(comments) != 0 {
+		t.Fatal("an application without comments should have an empty list")
+	}
+
+	first, err := r.AddComment(ctx, c, &application.Comment{Author: "user/first", Text: "looks good", CreatedAt: createdAt})
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	second, err := r.AddComment(ctx, c, &application.Comment{Author: "user/second", Text: "agreed", CreatedAt: createdAt.Add(time.Minute)})
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if first.ID == "" || first.ID == second.ID {
+		t.Fatal("the added comments should have distinct ids")
+	}
+
+	comments, err = r.GetComments(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(comments) != 2 || comments[0].ID != first.ID || comments[1].ID != second.ID {
+		t.Fatal("the comments should be ordered by their creation")
+	}
+
+	if comments[0].Author != "user/first" || comments[0].Text != "looks good" || !comments[0].CreatedAt.Equal(createdAt) {
+		t.Fatal("the stored comment is not equal")
+	}
+}
+
+func testVotes(t *testing.T, r application.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, newIncomplete(randomUUID(t), 0))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetVote(ctx, c, &application.Vote{Reviewer: "user/second", Approve: true}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetVote(ctx, c, &application.Vote{Reviewer: "user/first", Approve: true}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetVote(ctx, c, &application.Vote{Reviewer: "user/second", Approve: false}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	votes, err := r.GetVotes(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(votes) != 2 || votes[0].Reviewer != "user/first" || votes[1].Reviewer != "user/second" {
+		t.Fatal("every reviewer should have a single vote ordered by the reviewer")
+	}
+
+	if !votes[0].Approve || votes[1].Approve {
+		t.Fatal("a vote should replace the previous vote of the reviewer")
+	}
+}
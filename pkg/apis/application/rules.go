@@ -0,0 +1,20 @@
+package application
+
+import "github.com/51st-state/api/pkg/rbac"
+
+// rules enforced by the application service.
+// Users may always submit and get their own applications.
+const (
+	ruleGet    rbac.Rule = "applications.get"
+	ruleList   rbac.Rule = "applications.list"
+	ruleReview rbac.Rule = "applications.review"
+	ruleDecide rbac.Rule = "applications.decide"
+)
+
+// Rules enforced by the application service
+var Rules = rbac.RuleCatalog{
+	{Rule: ruleGet, Description: "Get any application", Service: "application"},
+	{Rule: ruleList, Description: "List the applications of any user or state", Service: "application"},
+	{Rule: ruleReview, Description: "Claim, comment on and vote on applications", Service: "application"},
+	{Rule: ruleDecide, Description: "Accept or reject claimed applications", Service: "application"},
+}
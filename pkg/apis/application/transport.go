@@ -0,0 +1,219 @@
+package application
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/51st-state/api/pkg/api/endpoint"
+	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/encode"
+	"github.com/51st-state/api/pkg/problems"
+	"github.com/51st-state/api/pkg/rbac"
+	rbacMiddleware "github.com/51st-state/api/pkg/rbac/middleware"
+	"github.com/51st-state/api/pkg/token"
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+)
+
+var (
+	errNoUserToken            = errors.New("the token does not belong to a user")
+	errInsufficientPermission = problems.New("insufficient permissions", "the account is not allowed to access this application", http.StatusForbidden)
+)
+
+// tokenUser returns the user authenticated by the token of a context
+func tokenUser(ctx context.Context) (user.Identifier, error) {
+	tok, err := token.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if tok.Data().User == nil || tok.Data().User.Type != "user" {
+		return nil, errNoUserToken
+	}
+
+	return &userIdentifier{tok.Data().User.ID}, nil
+}
+
+// MakeGetEndpoint creates a http endpoint to retrieve an application
+// of the user of the token or any application with the get rule
+func MakeGetEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		c, err := m.Get(ctx, &identifier{chi.URLParam(r, "guid")})
+		if err != nil {
+			return nil, err
+		}
+
+		if u, err := tokenUser(ctx); err == nil && u.UUID() == c.Data().UserUUID {
+			return c, nil
+		}
+
+		allowed, err := rb.IsAccountAllowed(ctx, rbac.ActorFromContext(ctx), ruleGet)
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			return nil, errInsufficientPermission
+		}
+
+		return c, nil
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeGetOwnEndpoint creates a http endpoint to retrieve the applications of the user of the token
+func MakeGetOwnEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := tokenUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.GetByUser(ctx, id)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeGetByUserEndpoint creates a http endpoint to retrieve the applications of any user
+func MakeGetByUserEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetByUser(ctx, &userIdentifier{chi.URLParam(r, "uuid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleList)).
+		HandlerFunc(l)
+}
+
+// MakeGetByStateEndpoint creates a http endpoint to retrieve the applications in a state
+func MakeGetByStateEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetByState(ctx, State(chi.URLParam(r, "state")))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleList)).
+		HandlerFunc(l)
+}
+
+// MakeSubmitEndpoint creates a http endpoint to submit an application for the user of the token
+func MakeSubmitEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := tokenUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var req struct {
+			Answers json.RawMessage `json:"answers"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, err
+		}
+
+		return m.Submit(ctx, NewIncomplete(id.UUID(), req.Answers))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeClaimEndpoint creates a http endpoint to claim an application for the acting reviewer
+func MakeClaimEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return struct{}{}, m.Claim(ctx, &identifier{chi.URLParam(r, "guid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleReview)).
+		HandlerFunc(l)
+}
+
+// MakeGetCommentsEndpoint creates a http endpoint to retrieve the comments on an application
+func MakeGetCommentsEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetComments(ctx, &identifier{chi.URLParam(r, "guid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleReview)).
+		HandlerFunc(l)
+}
+
+// MakeCommentEndpoint creates a http endpoint to comment on an application
+func MakeCommentEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		var req struct {
+			Text string `json:"text"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, err
+		}
+
+		return m.Comment(ctx, &identifier{chi.URLParam(r, "guid")}, req.Text)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleReview)).
+		HandlerFunc(l)
+}
+
+// MakeGetVotesEndpoint creates a http endpoint to retrieve the votes on an application
+func MakeGetVotesEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetVotes(ctx, &identifier{chi.URLParam(r, "guid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleReview)).
+		HandlerFunc(l)
+}
+
+// MakeVoteEndpoint creates a http endpoint to vote on an application
+func MakeVoteEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		var req struct {
+			Approve bool `json:"approve"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, err
+		}
+
+		return struct{}{}, m.Vote(ctx, &identifier{chi.URLParam(r, "guid")}, req.Approve)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleReview)).
+		HandlerFunc(l)
+}
+
+type decisionRequest struct {
+	Reason string `json:"reason"`
+}
+
+// MakeAcceptEndpoint creates a http endpoint to accept an application claimed by the acting reviewer
+func MakeAcceptEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		req := &decisionRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			return nil, err
+		}
+
+		return struct{}{}, m.Accept(ctx, &identifier{chi.URLParam(r, "guid")}, req.Reason)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleDecide)).
+		HandlerFunc(l)
+}
+
+// MakeRejectEndpoint creates a http endpoint to reject an application claimed by the acting reviewer
+func MakeRejectEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		req := &decisionRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			return nil, err
+		}
+
+		return struct{}{}, m.Reject(ctx, &identifier{chi.URLParam(r, "guid")}, req.Reason)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleDecide)).
+		HandlerFunc(l)
+}
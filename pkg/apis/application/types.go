@@ -0,0 +1,121 @@
+package application
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+//go:generate counterfeiter -o ./mocks/identifier.go . Identifier
+
+// Identifier of an application
+type Identifier interface {
+	GUID() string
+}
+
+type identifier struct {
+	guid string
+}
+
+// NewIdentifier creates a new identifier object
+func NewIdentifier(guid string) Identifier {
+	return &identifier{guid}
+}
+
+func (i *identifier) GUID() string {
+	return i.guid
+}
+
+// Provider provides methods for the incomplete application object
+type Provider interface {
+	Data() *data
+}
+
+// Incomplete represents an incomplete application object
+type Incomplete interface {
+	Provider
+}
+
+// Complete represents a complete application object
+type Complete interface {
+	Identifier
+	Incomplete
+}
+
+type complete struct {
+	Identifier
+	Incomplete
+}
+
+// State of an application in the review workflow
+type State string
+
+// states of an application. Accepted and rejected applications are decided
+// and cannot change anymore.
+const (
+	StatePending  State = "pending"
+	StateInReview State = "in_review"
+	StateAccepted State = "accepted"
+	StateRejected State = "rejected"
+)
+
+var states = []State{
+	StatePending,
+	StateInReview,
+	StateAccepted,
+	StateRejected,
+}
+
+// Open reports whether the application has not been decided yet
+func (s State) Open() bool {
+	return s == StatePending || s == StateInReview
+}
+
+type data struct {
+	UserUUID string `json:"user_uuid"`
+	// Answers of the questionnaire, the questions are up to the frontend
+	Answers json.RawMessage `json:"answers"`
+	State   State           `json:"state"`
+	// Reviewer who claimed the application
+	Reviewer rbac.AccountID `json:"reviewer,omitempty"`
+	// Reason given for the decision
+	Reason    string     `json:"reason,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	DecidedAt *time.Time `json:"decided_at,omitempty"`
+}
+
+// NewIncomplete creates a new incomplete application object of a user.
+// The state and the creation time are set by the manager.
+func NewIncomplete(userUUID string, answers json.RawMessage) Incomplete {
+	return &data{
+		UserUUID: userUUID,
+		Answers:  answers,
+	}
+}
+
+func (d *data) Data() *data {
+	return d
+}
+
+// Comment of a reviewer on an application
+type Comment struct {
+	ID        string         `json:"id"`
+	Author    rbac.AccountID `json:"author"`
+	Text      string         `json:"text"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// Vote of a reviewer on an application, every reviewer has a single vote
+type Vote struct {
+	Reviewer rbac.AccountID `json:"reviewer"`
+	Approve  bool           `json:"approve"`
+}
+
+type userIdentifier struct {
+	uuid string
+}
+
+func (i *userIdentifier) UUID() string {
+	return i.uuid
+}
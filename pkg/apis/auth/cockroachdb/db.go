@@ -62,3 +62,40 @@ func (d *db) AddLoginAttempt(ctx context.Context, id string, t time.Time) error
 	)
 	return err
 }
+
+func (d *db) GetLoginAttempts(ctx context.Context, id string) ([]time.Time, error) {
+	rows, err := d.database.QueryContext(
+		ctx,
+		`SELECT attemptedAt
+        FROM login_attempts
+        WHERE id = $1
+        ORDER BY attemptedAt`,
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attempts := make([]time.Time, 0)
+	for rows.Next() {
+		var t time.Time
+		if err := rows.Scan(&t); err != nil {
+			return nil, err
+		}
+
+		attempts = append(attempts, t)
+	}
+
+	return attempts, rows.Err()
+}
+
+func (d *db) DeleteLoginAttempts(ctx context.Context, id string) error {
+	_, err := d.database.ExecContext(
+		ctx,
+		`DELETE FROM login_attempts
+        WHERE id = $1`,
+		id,
+	)
+	return err
+}
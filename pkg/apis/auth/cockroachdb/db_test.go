@@ -0,0 +1,16 @@
+package cockroachdb_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/auth"
+	"github.com/51st-state/api/pkg/apis/auth/cockroachdb"
+	"github.com/51st-state/api/pkg/apis/auth/repositorytest"
+	"github.com/51st-state/api/test"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) auth.Repository {
+		return cockroachdb.NewRepository(test.NewCockroachDB(t, cockroachdb.CreateSchema))
+	})
+}
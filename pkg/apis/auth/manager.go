@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/51st-state/api/pkg/problems"
@@ -70,6 +71,8 @@ func (m *Manager) RecaptchaLogin(ctx context.Context, c Credentials) (*Token, er
 
 var errTooManyAttempts = problems.New("too many login attempts", "you may have to provide a recaptcha response token", 425)
 
+var errUserDeleted = problems.New("user deleted", "your account is deleted, contact an administrator to restore it", http.StatusForbidden)
+
 const serviceAccountLoginName = "_json_key"
 
 // Login logs a user in with their connected wcf user credentials
@@ -110,7 +113,9 @@ func (m *Manager) loginUserRecaptcha(ctx context.Context, c Credentials) (*Token
 	u, err := m.user.GetByWCFUserID(ctx, info.UserID)
 	if err == user.ErrNotFound {
 		u, err = m.user.Create(ctx, user.NewIncomplete(info.UserID, "", "", "", false))
-		if err != nil {
+		if err == user.ErrDeleted {
+			return nil, errUserDeleted
+		} else if err != nil {
 			return nil, err
 		}
 	} else if err != nil {
@@ -130,6 +135,10 @@ func (m *Manager) loginUserRecaptcha(ctx context.Context, c Credentials) (*Token
 		return nil, err
 	}
 
+	if err := m.checkBan(ctx, u, user.BanScopeAPI); err != nil {
+		return nil, err
+	}
+
 	return m.keypair(ctx, "user", u.UUID())
 }
 
@@ -146,7 +155,9 @@ func (m *Manager) loginUser(ctx context.Context, c Credentials) (*Token, error)
 	u, err := m.user.GetByWCFUserID(ctx, info.UserID)
 	if err == user.ErrNotFound {
 		u, err = m.user.Create(ctx, user.NewIncomplete(info.UserID, "", "", "", false))
-		if err != nil {
+		if err == user.ErrDeleted {
+			return nil, errUserDeleted
+		} else if err != nil {
 			return nil, err
 		}
 	} else if err != nil {
@@ -179,9 +190,83 @@ func (m *Manager) loginUser(ctx context.Context, c Credentials) (*Token, error)
 		return nil, err
 	}
 
+	if err := m.checkBan(ctx, u, user.BanScopeAPI); err != nil {
+		return nil, err
+	}
+
 	return m.keypair(ctx, "user", u.UUID())
 }
 
+// ServerLogin logs a user in on a game server with its game serial hash
+func (m *Manager) ServerLogin(ctx context.Context, c ServerCredentials) (*Token, error) {
+	u, err := m.user.GetByGameSerialHash(ctx, c.GameSerialHash())
+	if err != nil {
+		return nil, err
+	}
+
+	attempts, err := m.repo.LoginAttemptsCountSince(
+		ctx,
+		fmt.Sprintf("user/%s", u.UUID()),
+		time.Now().Add(-(time.Hour * 24)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if attempts > 0 {
+		return nil, errTooManyAttempts
+	}
+
+	if err := m.user.CheckPassword(ctx, u, c); err != nil {
+		if err := m.repo.AddLoginAttempt(
+			ctx,
+			fmt.Sprintf("user/%s", u.UUID()),
+			time.Now(),
+		); err != nil {
+			return nil, err
+		}
+
+		return nil, err
+	}
+
+	if err := m.checkBan(ctx, u, user.BanScopeGame); err != nil {
+		return nil, err
+	}
+
+	return m.keypair(ctx, "user", u.UUID())
+}
+
+// checkBan returns a problem if the user is banned in the scope
+func (m *Manager) checkBan(ctx context.Context, id user.Identifier, scope user.BanScope) error {
+	ban, err := m.user.GetActiveBan(ctx, id, scope)
+	if err != nil {
+		return err
+	}
+
+	if ban == nil {
+		return nil
+	}
+
+	until := "permanently"
+	if ban.EndsAt != nil {
+		until = fmt.Sprintf("until %s", ban.EndsAt.UTC().Format(time.RFC3339))
+	}
+
+	return problems.New(
+		"user banned",
+		fmt.Sprintf("you are banned %s: %s", until, ban.Reason),
+		http.StatusForbidden,
+	)
+}
+
+type userIdentifier struct {
+	uuid string
+}
+
+func (i *userIdentifier) UUID() string {
+	return i.uuid
+}
+
 var errServiceAccountGUIDNotEqual = errors.New("the service account guid's are not equal")
 
 func (m *Manager) loginServiceAccount(ctx context.Context, c Credentials) (*Token, error) {
@@ -235,5 +320,20 @@ func (m *Manager) RefreshToken(ctx context.Context, accessToken token.Token, ref
 		return nil, errors.New("the UUIDs are not equal")
 	}
 
-	return m.keypair(ctx, accessToken.Data().User.ID, accessToken.Data().User.Type)
+	if accessToken.Data().User.Type == "user" {
+		id := &userIdentifier{accessToken.Data().User.ID}
+
+		// deleted users are not found anymore and may not renew their tokens
+		if _, err := m.user.Get(ctx, id); err == user.ErrNotFound {
+			return nil, errUserDeleted
+		} else if err != nil {
+			return nil, err
+		}
+
+		if err := m.checkBan(ctx, id, user.BanScopeAPI); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.keypair(ctx, accessToken.Data().User.Type, accessToken.Data().User.ID)
 }
@@ -5,6 +5,7 @@ import (
 	"crypto/rsa"
 	"database/sql"
 	"errors"
+	"net/http"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/51st-state/api/pkg/apis/user"
 
 	"github.com/51st-state/api/pkg/keys"
+	"github.com/51st-state/api/pkg/problems"
 
 	mocks "github.com/51st-state/api/pkg/apis/auth/mocks"
 	keyMocks "github.com/51st-state/api/pkg/apis/serviceaccount/key/mocks"
@@ -141,6 +143,16 @@ func TestManagerLogin(t *testing.T) {
 		t.Fatal("create user returns an error")
 	}
 
+	userManager.GetByWCFUserIDReturns(nil, user.ErrNotFound)
+	userManager.CreateReturns(nil, user.ErrDeleted)
+	_, err = manager.Login(context.Background(), &testCredentials{
+		"user/test",
+		"1234",
+	})
+	if p, ok := err.(*problems.Problem); !ok || p.Status != http.StatusForbidden {
+		t.Fatal("a deleted user should not be created again on login")
+	}
+
 	userManager.GetByWCFUserIDReturns(nil, sql.ErrConnDone)
 	if _, err := manager.Login(context.Background(), &testCredentials{
 		"user/test",
@@ -246,4 +258,134 @@ func TestManagerRefreshToken(t *testing.T) {
 	})); err != nil {
 		t.Fatal("there should be no error")
 	}
+
+	userManager.GetReturns(nil, user.ErrNotFound)
+	if _, err := manager.RefreshToken(context.Background(), token.New(&jwt.StandardClaims{
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		Audience:  "default",
+	}, &token.User{
+		ID:   "1234",
+		Type: "user",
+	}), token.New(&jwt.StandardClaims{
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		Audience:  "auth/refresh",
+	}, &token.User{
+		ID:   "1234",
+		Type: "user",
+	})); err == nil {
+		t.Fatal("a deleted user should not refresh its token")
+	}
+
+	if _, id := userManager.GetArgsForCall(1); id.UUID() != "1234" {
+		t.Fatal("the user of the token should be checked")
+	}
+}
+
+func TestManagerBannedLogin(t *testing.T) {
+	userManager := &userMocks.FakeManager{}
+	repo := &mocks.FakeRepository{}
+
+	manager := auth.NewManager(testPrivateKey, repo, userManager, nil, nil)
+
+	id := &userMocks.FakeIdentifier{}
+	id.UUIDReturns("test")
+	userManager.GetWCFInfoReturns(&user.WCFUserInfo{
+		UserID: 1,
+	}, nil)
+	userManager.GetByWCFUserIDReturns(newComplete(
+		id,
+		user.NewIncomplete(1, "", "", "", false),
+	), nil)
+	userManager.GetByGameSerialHashReturns(newComplete(
+		id,
+		user.NewIncomplete(1, "", "", "hash", false),
+	), nil)
+
+	endsAt := time.Now().Add(time.Hour)
+	userManager.GetActiveBanReturns(&user.Ban{
+		Reason: "cheating",
+		Scopes: []user.BanScope{user.BanScopeAPI},
+		EndsAt: &endsAt,
+	}, nil)
+
+	if _, err := manager.Login(context.Background(), &testCredentials{
+		"user/test",
+		"1234",
+	}); err == nil {
+		t.Fatal("a banned user should not be logged in")
+	}
+
+	if _, _, scope := userManager.GetActiveBanArgsForCall(0); scope != user.BanScopeAPI {
+		t.Fatal("the api ban should be checked on login")
+	}
+
+	if _, err := manager.RefreshToken(context.Background(), token.New(&jwt.StandardClaims{
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		Audience:  "default",
+	}, &token.User{
+		ID:   "test",
+		Type: "user",
+	}), token.New(&jwt.StandardClaims{
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		Audience:  "auth/refresh",
+	}, &token.User{
+		ID:   "test",
+		Type: "user",
+	})); err == nil {
+		t.Fatal("the token of a banned user should not be refreshed")
+	}
+
+	if _, err := manager.ServerLogin(context.Background(), newServerCredentials("hash", "1234")); err == nil {
+		t.Fatal("a banned user should not be logged in on a game server")
+	}
+
+	if _, _, scope := userManager.GetActiveBanArgsForCall(2); scope != user.BanScopeGame {
+		t.Fatal("the game ban should be checked on a game server login")
+	}
+
+	userManager.GetActiveBanReturns(nil, errors.New("fake error"))
+	if _, err := manager.ServerLogin(context.Background(), newServerCredentials("hash", "1234")); err == nil {
+		t.Fatal("the user manager returns an error")
+	}
+
+	userManager.GetActiveBanReturns(nil, nil)
+	tok, err := manager.ServerLogin(context.Background(), newServerCredentials("hash", "1234"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := tok.MarshalJSON(); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	userManager.CheckPasswordReturns(errors.New("fake error"))
+	if _, err := manager.ServerLogin(context.Background(), newServerCredentials("hash", "1234")); err == nil {
+		t.Fatal("the password is invalid")
+	}
+
+	if repo.AddLoginAttemptCallCount() != 1 {
+		t.Fatal("the failed login attempt should be recorded")
+	}
+
+	repo.LoginAttemptsCountSinceReturns(1, nil)
+	if _, err := manager.ServerLogin(context.Background(), newServerCredentials("hash", "1234")); err == nil {
+		t.Fatal("the count of login attempts is = 1")
+	}
+}
+
+type serverCredentials struct {
+	hash     string
+	password string
+}
+
+func newServerCredentials(hash, password string) auth.ServerCredentials {
+	return &serverCredentials{hash, password}
+}
+
+func (c *serverCredentials) GameSerialHash() string {
+	return c.hash
+}
+
+func (c *serverCredentials) Password() string {
+	return c.password
 }
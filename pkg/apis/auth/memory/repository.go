@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/auth"
+)
+
+var errDuplicateLoginAttempt = errors.New("duplicate login attempt")
+
+type repository struct {
+	mutex         sync.RWMutex
+	loginAttempts map[string][]time.Time
+}
+
+// NewRepository creates a new instance of a repository storing login attempts in memory
+func NewRepository() auth.Repository {
+	return &repository{
+		loginAttempts: make(map[string][]time.Time),
+	}
+}
+
+func (r *repository) LoginAttemptsCountSince(ctx context.Context, id string, t time.Time) (uint64, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var count uint64
+	for _, v := range r.loginAttempts[id] {
+		if !v.Before(t) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *repository) AddLoginAttempt(ctx context.Context, id string, t time.Time) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, v := range r.loginAttempts[id] {
+		if v.Equal(t) {
+			return errDuplicateLoginAttempt
+		}
+	}
+
+	r.loginAttempts[id] = append(r.loginAttempts[id], t)
+
+	return nil
+}
+
+func (r *repository) GetLoginAttempts(ctx context.Context, id string) ([]time.Time, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	attempts := append(make([]time.Time, 0), r.loginAttempts[id]...)
+	sort.Slice(attempts, func(i, j int) bool {
+		return attempts[i].Before(attempts[j])
+	})
+
+	return attempts, nil
+}
+
+func (r *repository) DeleteLoginAttempts(ctx context.Context, id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.loginAttempts, id)
+
+	return nil
+}
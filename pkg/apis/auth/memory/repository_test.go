@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/auth"
+	"github.com/51st-state/api/pkg/apis/auth/memory"
+	"github.com/51st-state/api/pkg/apis/auth/repositorytest"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) auth.Repository {
+		return memory.NewRepository()
+	})
+}
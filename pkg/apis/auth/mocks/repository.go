@@ -38,6 +38,32 @@ type FakeRepository struct {
 	addLoginAttemptReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetLoginAttemptsStub        func(ctx context.Context, id string) ([]time.Time, error)
+	getLoginAttemptsMutex       sync.RWMutex
+	getLoginAttemptsArgsForCall []struct {
+		ctx context.Context
+		id  string
+	}
+	getLoginAttemptsReturns struct {
+		result1 []time.Time
+		result2 error
+	}
+	getLoginAttemptsReturnsOnCall map[int]struct {
+		result1 []time.Time
+		result2 error
+	}
+	DeleteLoginAttemptsStub        func(ctx context.Context, id string) error
+	deleteLoginAttemptsMutex       sync.RWMutex
+	deleteLoginAttemptsArgsForCall []struct {
+		ctx context.Context
+		id  string
+	}
+	deleteLoginAttemptsReturns struct {
+		result1 error
+	}
+	deleteLoginAttemptsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -145,6 +171,107 @@ func (fake *FakeRepository) AddLoginAttemptReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeRepository) GetLoginAttempts(ctx context.Context, id string) ([]time.Time, error) {
+	fake.getLoginAttemptsMutex.Lock()
+	ret, specificReturn := fake.getLoginAttemptsReturnsOnCall[len(fake.getLoginAttemptsArgsForCall)]
+	fake.getLoginAttemptsArgsForCall = append(fake.getLoginAttemptsArgsForCall, struct {
+		ctx context.Context
+		id  string
+	}{ctx, id})
+	fake.recordInvocation("GetLoginAttempts", []interface{}{ctx, id})
+	fake.getLoginAttemptsMutex.Unlock()
+	if fake.GetLoginAttemptsStub != nil {
+		return fake.GetLoginAttemptsStub(ctx, id)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getLoginAttemptsReturns.result1, fake.getLoginAttemptsReturns.result2
+}
+
+func (fake *FakeRepository) GetLoginAttemptsCallCount() int {
+	fake.getLoginAttemptsMutex.RLock()
+	defer fake.getLoginAttemptsMutex.RUnlock()
+	return len(fake.getLoginAttemptsArgsForCall)
+}
+
+func (fake *FakeRepository) GetLoginAttemptsArgsForCall(i int) (context.Context, string) {
+	fake.getLoginAttemptsMutex.RLock()
+	defer fake.getLoginAttemptsMutex.RUnlock()
+	return fake.getLoginAttemptsArgsForCall[i].ctx, fake.getLoginAttemptsArgsForCall[i].id
+}
+
+func (fake *FakeRepository) GetLoginAttemptsReturns(result1 []time.Time, result2 error) {
+	fake.GetLoginAttemptsStub = nil
+	fake.getLoginAttemptsReturns = struct {
+		result1 []time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetLoginAttemptsReturnsOnCall(i int, result1 []time.Time, result2 error) {
+	fake.GetLoginAttemptsStub = nil
+	if fake.getLoginAttemptsReturnsOnCall == nil {
+		fake.getLoginAttemptsReturnsOnCall = make(map[int]struct {
+			result1 []time.Time
+			result2 error
+		})
+	}
+	fake.getLoginAttemptsReturnsOnCall[i] = struct {
+		result1 []time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) DeleteLoginAttempts(ctx context.Context, id string) error {
+	fake.deleteLoginAttemptsMutex.Lock()
+	ret, specificReturn := fake.deleteLoginAttemptsReturnsOnCall[len(fake.deleteLoginAttemptsArgsForCall)]
+	fake.deleteLoginAttemptsArgsForCall = append(fake.deleteLoginAttemptsArgsForCall, struct {
+		ctx context.Context
+		id  string
+	}{ctx, id})
+	fake.recordInvocation("DeleteLoginAttempts", []interface{}{ctx, id})
+	fake.deleteLoginAttemptsMutex.Unlock()
+	if fake.DeleteLoginAttemptsStub != nil {
+		return fake.DeleteLoginAttemptsStub(ctx, id)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteLoginAttemptsReturns.result1
+}
+
+func (fake *FakeRepository) DeleteLoginAttemptsCallCount() int {
+	fake.deleteLoginAttemptsMutex.RLock()
+	defer fake.deleteLoginAttemptsMutex.RUnlock()
+	return len(fake.deleteLoginAttemptsArgsForCall)
+}
+
+func (fake *FakeRepository) DeleteLoginAttemptsArgsForCall(i int) (context.Context, string) {
+	fake.deleteLoginAttemptsMutex.RLock()
+	defer fake.deleteLoginAttemptsMutex.RUnlock()
+	return fake.deleteLoginAttemptsArgsForCall[i].ctx, fake.deleteLoginAttemptsArgsForCall[i].id
+}
+
+func (fake *FakeRepository) DeleteLoginAttemptsReturns(result1 error) {
+	fake.DeleteLoginAttemptsStub = nil
+	fake.deleteLoginAttemptsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeleteLoginAttemptsReturnsOnCall(i int, result1 error) {
+	fake.DeleteLoginAttemptsStub = nil
+	if fake.deleteLoginAttemptsReturnsOnCall == nil {
+		fake.deleteLoginAttemptsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteLoginAttemptsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -152,6 +279,10 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.loginAttemptsCountSinceMutex.RUnlock()
 	fake.addLoginAttemptMutex.RLock()
 	defer fake.addLoginAttemptMutex.RUnlock()
+	fake.getLoginAttemptsMutex.RLock()
+	defer fake.getLoginAttemptsMutex.RUnlock()
+	fake.deleteLoginAttemptsMutex.RLock()
+	defer fake.deleteLoginAttemptsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
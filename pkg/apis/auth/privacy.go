@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PrivacyHandler exports and erases the login attempts of users
+type PrivacyHandler struct {
+	repository Repository
+}
+
+// NewPrivacyHandler for the data of the authentication service
+func NewPrivacyHandler(r Repository) *PrivacyHandler {
+	return &PrivacyHandler{r}
+}
+
+type privacyExport struct {
+	LoginAttempts []time.Time `json:"login_attempts"`
+}
+
+// Export the failed login attempts of a user
+func (h *PrivacyHandler) Export(ctx context.Context, userUUID string) (interface{}, error) {
+	attempts, err := h.repository.GetLoginAttempts(ctx, fmt.Sprintf("user/%s", userUUID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &privacyExport{attempts}, nil
+}
+
+// Erase the failed login attempts of a user
+func (h *PrivacyHandler) Erase(ctx context.Context, userUUID string) error {
+	return h.repository.DeleteLoginAttempts(ctx, fmt.Sprintf("user/%s", userUUID))
+}
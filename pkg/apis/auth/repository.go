@@ -10,4 +10,7 @@ import (
 type Repository interface {
 	LoginAttemptsCountSince(ctx context.Context, id string, t time.Time) (uint64, error)
 	AddLoginAttempt(ctx context.Context, id string, t time.Time) error
+	// GetLoginAttempts returns the times of the login attempts ordered by time
+	GetLoginAttempts(ctx context.Context, id string) ([]time.Time, error)
+	DeleteLoginAttempts(ctx context.Context, id string) error
 }
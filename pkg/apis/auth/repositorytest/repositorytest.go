@@ -0,0 +1,106 @@
+// Package repositorytest contains the conformance tests of authentication repositories
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/auth"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) auth.Repository) {
+	t.Run("LoginAttempts", func(t *testing.T) {
+		testLoginAttempts(t, newRepository(t))
+	})
+	t.Run("DeleteLoginAttempts", func(t *testing.T) {
+		testDeleteLoginAttempts(t, newRepository(t))
+	})
+}
+
+func testLoginAttempts(t *testing.T, r auth.Repository) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+
+	count, err := r.LoginAttemptsCountSince(ctx, "id", now.Add(-time.Hour))
+	if err != nil || count != 0 {
+		t.Fatal("there should be no login attempts")
+	}
+
+	for _, v := range []time.Duration{-time.Hour, -time.Minute, 0} {
+		if err := r.AddLoginAttempt(ctx, "id", now.Add(v)); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	if err := r.AddLoginAttempt(ctx, "other", now); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.AddLoginAttempt(ctx, "id", now); err == nil {
+		t.Fatal("the login attempt already exists")
+	}
+
+	count, err = r.LoginAttemptsCountSince(ctx, "id", now.Add(-time.Minute))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if count != 2 {
+		t.Fatal("the attempts at and after the given time should be counted")
+	}
+
+	count, err = r.LoginAttemptsCountSince(ctx, "id", now.Add(-2*time.Hour))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if count != 3 {
+		t.Fatal("all attempts should be counted")
+	}
+
+	count, err = r.LoginAttemptsCountSince(ctx, "id", now.Add(time.Second))
+	if err != nil || count != 0 {
+		t.Fatal("there should be no attempts after the last attempt")
+	}
+}
+
+func testDeleteLoginAttempts(t *testing.T, r auth.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	for _, v := range []time.Duration{0, -time.Hour} {
+		if err := r.AddLoginAttempt(ctx, "id", now.Add(v)); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	if err := r.AddLoginAttempt(ctx, "other", now); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	attempts, err := r.GetLoginAttempts(ctx, "id")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(attempts) != 2 || !attempts[0].Equal(now.Add(-time.Hour)) || !attempts[1].Equal(now) {
+		t.Fatal("the login attempts should be ordered by time")
+	}
+
+	if err := r.DeleteLoginAttempts(ctx, "id"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	attempts, err = r.GetLoginAttempts(ctx, "id")
+	if err != nil || len(attempts) != 0 {
+		t.Fatal("the login attempts should be deleted")
+	}
+
+	attempts, err = r.GetLoginAttempts(ctx, "other")
+	if err != nil || len(attempts) != 1 {
+		t.Fatal("the login attempts of others should be kept")
+	}
+}
@@ -39,6 +39,19 @@ func MakeLoginEndpoint(l *zap.Logger, m *Manager, e encode.Encoder) http.Handler
 		HandlerFunc(l)
 }
 
+// MakeServerLoginEndpoint creates a new http endpoint for a login on a game server
+func MakeServerLoginEndpoint(l *zap.Logger, m *Manager, e encode.Encoder) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		creds := newServerCredentials("", "")
+		if err := json.NewDecoder(r.Body).Decode(&creds); err != nil {
+			return nil, err
+		}
+
+		return m.ServerLogin(ctx, creds)
+	}).
+		HandlerFunc(l)
+}
+
 type refreshTokenRequest struct {
 	RefreshToken string `json:"refresh_token"`
 }
@@ -68,6 +68,21 @@ func (c *serverCredentials) Password() string {
 	return c.password
 }
 
+func (c *serverCredentials) UnmarshalJSON(b []byte) error {
+	var req struct {
+		GameSerialHash string `json:"game_serial_hash"`
+		Password       string `json:"password"`
+	}
+	if err := json.Unmarshal(b, &req); err != nil {
+		return err
+	}
+
+	c.hash = req.GameSerialHash
+	c.password = req.Password
+
+	return nil
+}
+
 // Token to return to the client
 type Token struct {
 	pK           *rsa.PrivateKey
@@ -0,0 +1,185 @@
+package character
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/51st-state/api/pkg/apis/preselect"
+	"github.com/51st-state/api/pkg/apis/topgenerator"
+	"github.com/51st-state/api/pkg/problems"
+)
+
+// sexes of the freemode models
+const (
+	SexMale   uint64 = 0
+	SexFemale uint64 = 1
+)
+
+// component slots, which are not validated against the pre-selections.
+// The torso is validated against the top generator instead.
+const (
+	componentFace       uint64 = 0
+	componentHair       uint64 = 2
+	componentTorso      uint64 = 3
+	componentUndershirt uint64 = 8
+	componentTop        uint64 = 11
+)
+
+// counts of the component and prop slots and of the face features
+const (
+	componentCount   = 12
+	propCount        = 8
+	faceFeatureCount = 20
+	headBlendParents = 46
+)
+
+// Component worn in a component slot of a character
+type Component struct {
+	ComponentID uint64 `json:"component_id"`
+	DrawableID  uint64 `json:"drawable_id"`
+	TextureID   uint64 `json:"texture_id"`
+}
+
+// Prop worn in a prop slot of a character, like hats or glasses
+type Prop struct {
+	PropID     uint64 `json:"prop_id"`
+	DrawableID uint64 `json:"drawable_id"`
+	TextureID  uint64 `json:"texture_id"`
+}
+
+// Outfit of a character. Only the current outfit of an appearance is unnamed.
+type Outfit struct {
+	Name       string       `json:"name,omitempty"`
+	Components []*Component `json:"components"`
+	Props      []*Prop      `json:"props"`
+}
+
+// HeadBlend inherits the face shape and the skin of two parents
+type HeadBlend struct {
+	ShapeFirst  uint64  `json:"shape_first"`
+	ShapeSecond uint64  `json:"shape_second"`
+	SkinFirst   uint64  `json:"skin_first"`
+	SkinSecond  uint64  `json:"skin_second"`
+	ShapeMix    float64 `json:"shape_mix"`
+	SkinMix     float64 `json:"skin_mix"`
+}
+
+// Appearance of a character with the outfit currently worn
+type Appearance struct {
+	Sex       uint64    `json:"sex"`
+	HeadBlend HeadBlend `json:"head_blend"`
+	// FaceFeatures scaled from -1 to 1 in the order of the game
+	FaceFeatures []float64 `json:"face_features"`
+	Outfit       *Outfit   `json:"outfit"`
+}
+
+func invalidAppearance(detail string, args ...interface{}) error {
+	return problems.New("invalid appearance", fmt.Sprintf(detail, args...), http.StatusBadRequest)
+}
+
+func validateAppearance(a *Appearance) error {
+	if a.Sex != SexMale && a.Sex != SexFemale {
+		return invalidAppearance("the sex %d is unknown", a.Sex)
+	}
+
+	b := a.HeadBlend
+	if b.ShapeFirst >= headBlendParents ||
+		b.ShapeSecond >= headBlendParents ||
+		b.SkinFirst >= headBlendParents ||
+		b.SkinSecond >= headBlendParents {
+		return invalidAppearance("the parents of the head blend have to be below %d", headBlendParents)
+	}
+
+	if b.ShapeMix < 0 || b.ShapeMix > 1 || b.SkinMix < 0 || b.SkinMix > 1 {
+		return invalidAppearance("the mixes of the head blend have to be between 0 and 1")
+	}
+
+	if len(a.FaceFeatures) != faceFeatureCount {
+		return invalidAppearance("there have to be %d face features", faceFeatureCount)
+	}
+
+	for i, v := range a.FaceFeatures {
+		if v < -1 || v > 1 {
+			return invalidAppearance("the face feature %d has to be between -1 and 1", i)
+		}
+	}
+
+	if a.Outfit == nil {
+		return invalidAppearance("the outfit is missing")
+	}
+
+	return nil
+}
+
+// validateOutfit against the accepted pre-selections and the torsos of the top generator
+func (m *manager) validateOutfit(ctx context.Context, sex uint64, o *Outfit) error {
+	components := make(map[uint64]*Component)
+	for _, v := range o.Components {
+		if v.ComponentID >= componentCount {
+			return invalidAppearance("the component %d is unknown", v.ComponentID)
+		}
+
+		if _, ok := components[v.ComponentID]; ok {
+			return invalidAppearance("the component %d is worn twice", v.ComponentID)
+		}
+		components[v.ComponentID] = v
+
+		switch v.ComponentID {
+		case componentFace, componentHair, componentTorso:
+			continue
+		}
+
+		p, err := m.preselections.Get(ctx, preselect.NewIdentifier(sex, v.ComponentID, v.DrawableID, v.TextureID))
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+
+		if err == sql.ErrNoRows || p.Data().Accepted != preselect.StateAccepted {
+			return invalidAppearance(
+				"the drawable %d with the texture %d of the component %d is not accepted",
+				v.DrawableID,
+				v.TextureID,
+				v.ComponentID,
+			)
+		}
+	}
+
+	props := make(map[uint64]bool)
+	for _, v := range o.Props {
+		if v.PropID >= propCount {
+			return invalidAppearance("the prop %d is unknown", v.PropID)
+		}
+
+		if props[v.PropID] {
+			return invalidAppearance("the prop %d is worn twice", v.PropID)
+		}
+		props[v.PropID] = true
+	}
+
+	top, ok := components[componentTop]
+	if !ok {
+		return nil
+	}
+
+	undershirt, hasUndershirt := components[componentUndershirt]
+	torso, hasTorso := components[componentTorso]
+	if !hasUndershirt || !hasTorso {
+		return invalidAppearance("a top has to be worn with an undershirt and a torso")
+	}
+
+	// the sex of the top generator is parsed as a bool, so the sex 1 is true
+	t, err := m.tops.Get(ctx, topgenerator.NewIdentifier(sex == SexFemale, undershirt.DrawableID, top.DrawableID))
+	if err == topgenerator.ErrTopNotFound {
+		return invalidAppearance("the undershirt %d is not linked to the top %d", undershirt.DrawableID, top.DrawableID)
+	} else if err != nil {
+		return err
+	}
+
+	if uint64(t.Data().TorsoID) != torso.DrawableID {
+		return invalidAppearance("the top %d has to be worn with the torso %d", top.DrawableID, t.Data().TorsoID)
+	}
+
+	return nil
+}
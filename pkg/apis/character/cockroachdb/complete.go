@@ -0,0 +1,31 @@
+package cockroachdb
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/character"
+)
+
+type complete struct {
+	character.Identifier
+	character.Incomplete
+}
+
+func (c *complete) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		GUID          string    `json:"guid"`
+		UserUUID      string    `json:"user_uuid"`
+		FirstName     string    `json:"first_name"`
+		LastName      string    `json:"last_name"`
+		Birthdate     time.Time `json:"birthdate"`
+		InventoryGUID string    `json:"inventory_guid"`
+	}{
+		c.GUID(),
+		c.Data().UserUUID,
+		c.Data().FirstName,
+		c.Data().LastName,
+		c.Data().Birthdate,
+		c.Data().InventoryGUID,
+	})
+}
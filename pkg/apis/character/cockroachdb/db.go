@@ -0,0 +1,359 @@
+package cockroachdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+
+	"github.com/51st-state/api/pkg/apis/character"
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+// CreateSchema creates a new cockroachdb schema in a cockroachdb database for the character service
+func CreateSchema(ctx context.Context, db *sql.DB) (err error) {
+	_, err = db.ExecContext(
+		ctx,
+		`CREATE TABLE IF NOT EXISTS characters (
+            guid UUID PRIMARY KEY,
+            userUUID UUID NOT NULL,
+            firstName TEXT NOT NULL DEFAULT '',
+            lastName TEXT NOT NULL DEFAULT '',
+            birthdate TIMESTAMPTZ NOT NULL,
+            inventoryGUID UUID NOT NULL,
+            createdAt TIMESTAMPTZ NOT NULL DEFAULT now(),
+            deletedAt TIMESTAMPTZ NULL
+        );
+        CREATE INDEX IF NOT EXISTS characters_idx_user ON characters (userUUID, createdAt);
+
+        CREATE TABLE IF NOT EXISTS character_appearances (
+            characterId UUID PRIMARY KEY,
+            appearance JSONB NOT NULL
+        );
+
+        CREATE TABLE IF NOT EXISTS character_outfits (
+            characterId UUID NOT NULL,
+            name TEXT NOT NULL,
+            outfit JSONB NOT NULL,
+            PRIMARY KEY (characterId, name)
+        );`,
+	)
+	return
+}
+
+type db struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new cockroachdb db storage repository
+func NewRepository(d *sql.DB) character.Repository {
+	return &db{d}
+}
+
+func txError(tx *sql.Tx, err error) error {
+	if rErr := tx.Rollback(); rErr != nil {
+		return rErr
+	}
+
+	return err
+}
+
+type scanner interface {
+	Scan(...interface{}) error
+}
+
+func scanComplete(s scanner) (character.Complete, error) {
+	var guid string
+	inc := character.NewIncomplete("", "", "", time.Time{})
+	if err := s.Scan(
+		&guid,
+		&inc.Data().UserUUID,
+		&inc.Data().FirstName,
+		&inc.Data().LastName,
+		&inc.Data().Birthdate,
+		&inc.Data().InventoryGUID,
+	); err != nil {
+		return nil, err
+	}
+
+	return &complete{
+		character.NewIdentifier(guid),
+		inc,
+	}, nil
+}
+
+func (d *db) Get(ctx context.Context, id character.Identifier) (character.Complete, error) {
+	return scanComplete(d.db.QueryRowContext(
+		ctx,
+		`SELECT guid,
+        userUUID,
+        firstName,
+        lastName,
+        birthdate,
+        inventoryGUID
+        FROM characters
+        WHERE guid = $1
+        AND deletedAt IS NULL`,
+		id.GUID(),
+	))
+}
+
+func (d *db) getByUser(ctx context.Context, id user.Identifier, withDeleted bool) ([]character.Complete, error) {
+	rows, err := d.db.QueryContext(
+		ctx,
+		`SELECT guid,
+        userUUID,
+        firstName,
+        lastName,
+        birthdate,
+        inventoryGUID
+        FROM characters
+        WHERE userUUID = $1
+        AND ($2 OR deletedAt IS NULL)
+        ORDER BY createdAt, guid`,
+		id.UUID(),
+		withDeleted,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	characters := make([]character.Complete, 0)
+	for rows.Next() {
+		c, err := scanComplete(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		characters = append(characters, c)
+	}
+
+	return characters, rows.Err()
+}
+
+func (d *db) GetByUser(ctx context.Context, id user.Identifier) ([]character.Complete, error) {
+	return d.getByUser(ctx, id, false)
+}
+
+func (d *db) GetAllByUser(ctx context.Context, id user.Identifier) ([]character.Complete, error) {
+	return d.getByUser(ctx, id, true)
+}
+
+func (d *db) Create(ctx context.Context, id character.Identifier, inc character.Incomplete, limit int) (character.Complete, error) {
+	// the count and the insert run in one serializable statement,
+	// so a concurrent creation is retried against the new count
+	res, err := d.db.ExecContext(
+		ctx,
+		`INSERT INTO characters (
+            guid,
+            userUUID,
+            firstName,
+            lastName,
+            birthdate,
+            inventoryGUID
+        ) SELECT $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6
+        WHERE (
+            SELECT count(*)
+            FROM characters
+            WHERE userUUID = $2
+            AND deletedAt IS NULL
+        ) < $7`,
+		id.GUID(),
+		inc.Data().UserUUID,
+		inc.Data().FirstName,
+		inc.Data().LastName,
+		inc.Data().Birthdate,
+		inc.Data().InventoryGUID,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if n == 0 {
+		return nil, character.ErrLimitReached
+	}
+
+	return &complete{
+		character.NewIdentifier(id.GUID()),
+		inc,
+	}, nil
+}
+
+func (d *db) Update(ctx context.Context, c character.Complete) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`UPDATE characters
+        SET firstName = $1,
+        lastName = $2,
+        birthdate = $3
+        WHERE guid = $4
+        AND deletedAt IS NULL`,
+		c.Data().FirstName,
+		c.Data().LastName,
+		c.Data().Birthdate,
+		c.GUID(),
+	)
+	return err
+}
+
+func (d *db) Delete(ctx context.Context, id character.Identifier) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`UPDATE characters
+        SET deletedAt = now()
+        WHERE guid = $1
+        AND deletedAt IS NULL`,
+		id.GUID(),
+	)
+	return err
+}
+
+func (d *db) Purge(ctx context.Context, id character.Identifier) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, query := range []string{
+		`DELETE FROM character_outfits WHERE characterId = $1`,
+		`DELETE FROM character_appearances WHERE characterId = $1`,
+		`DELETE FROM characters WHERE guid = $1`,
+	} {
+		if _, err := tx.ExecContext(ctx, query, id.GUID()); err != nil {
+			return txError(tx, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (d *db) GetAppearance(ctx context.Context, id character.Identifier) (*character.Appearance, error) {
+	var b []byte
+	if err := d.db.QueryRowContext(
+		ctx,
+		`SELECT appearance
+        FROM character_appearances
+        WHERE characterId = $1`,
+		id.GUID(),
+	).Scan(
+		&b,
+	); err != nil {
+		return nil, err
+	}
+
+	a := &character.Appearance{}
+	if err := json.Unmarshal(b, a); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (d *db) SetAppearance(ctx context.Context, id character.Identifier, a *character.Appearance) error {
+	b, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.db.ExecContext(
+		ctx,
+		`INSERT INTO character_appearances (
+            characterId,
+            appearance
+        ) VALUES (
+            $1,
+            $2
+        ) ON CONFLICT (
+            characterId
+        ) DO UPDATE SET appearance = $3`,
+		id.GUID(),
+		b,
+		b,
+	)
+	return err
+}
+
+func (d *db) GetOutfits(ctx context.Context, id character.Identifier) ([]*character.Outfit, error) {
+	rows, err := d.db.QueryContext(
+		ctx,
+		`SELECT outfit
+        FROM character_outfits
+        WHERE characterId = $1
+        ORDER BY name`,
+		id.GUID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	outfits := make([]*character.Outfit, 0)
+	for rows.Next() {
+		var b []byte
+		if err := rows.Scan(&b); err != nil {
+			return nil, err
+		}
+
+		o := &character.Outfit{}
+		if err := json.Unmarshal(b, o); err != nil {
+			return nil, err
+		}
+
+		outfits = append(outfits, o)
+	}
+
+	return outfits, rows.Err()
+}
+
+func (d *db) SetOutfit(ctx context.Context, id character.Identifier, o *character.Outfit) error {
+	b, err := json.Marshal(o)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.db.ExecContext(
+		ctx,
+		`INSERT INTO character_outfits (
+            characterId,
+            name,
+            outfit
+        ) VALUES (
+            $1,
+            $2,
+            $3
+        ) ON CONFLICT (
+            characterId,
+            name
+        ) DO UPDATE SET outfit = $4`,
+		id.GUID(),
+		o.Name,
+		b,
+		b,
+	)
+	return err
+}
+
+func (d *db) DeleteOutfit(ctx context.Context, id character.Identifier, name string) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`DELETE FROM character_outfits
+        WHERE characterId = $1
+        AND name = $2`,
+		id.GUID(),
+		name,
+	)
+	return err
+}
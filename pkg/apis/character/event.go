@@ -0,0 +1,40 @@
+package character
+
+import "github.com/51st-state/api/pkg/event"
+
+// CreatedEventID of a character object
+const CreatedEventID event.ID = "character_created"
+
+// CreatedEvent of a character object
+type CreatedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data Complete           `json:"data"`
+}
+
+// UpdatedEventID of a character object
+const UpdatedEventID event.ID = "character_updated"
+
+// UpdatedEvent of a character object
+type UpdatedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data Complete           `json:"data"`
+}
+
+// DeletedEventID of a character object, produced once a character is soft deleted
+const DeletedEventID event.ID = "character_deleted"
+
+// DeletedEvent of a character object
+type DeletedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data Complete           `json:"data"`
+}
+
+// PurgedEventID of a character object, produced once a character is erased
+// for good. Soft deleted characters are only purged this way.
+const PurgedEventID event.ID = "character_purged"
+
+// PurgedEvent of a character object
+type PurgedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data Complete           `json:"data"`
+}
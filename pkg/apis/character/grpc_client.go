@@ -0,0 +1,150 @@
+package character
+
+import (
+	"context"
+	"database/sql"
+
+	pb "github.com/51st-state/api/pkg/apis/character/proto"
+	"github.com/51st-state/api/pkg/apis/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcClient struct {
+	client pb.ManagerClient
+}
+
+// NewGRPCClient creates a new grpc client for the character manager
+func NewGRPCClient(c *grpc.ClientConn) Manager {
+	return &grpcClient{
+		pb.NewManagerClient(c),
+	}
+}
+
+func completeFromGRPC(c *pb.Complete) Complete {
+	return &complete{
+		&identifier{c.GetIdentifier().GetGUID()},
+		incompleteFromGRPC(c.GetIncomplete()),
+	}
+}
+
+func (g *grpcClient) Get(ctx context.Context, id Identifier) (Complete, error) {
+	resp, err := g.client.Get(ctx, &pb.Identifier{
+		GUID: id.GUID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return completeFromGRPC(resp), nil
+}
+
+func (g *grpcClient) GetByUser(ctx context.Context, id user.Identifier) ([]Complete, error) {
+	resp, err := g.client.GetByUser(ctx, &pb.UserIdentifier{
+		UUID: id.UUID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	characters := make([]Complete, 0)
+	for _, v := range resp.GetCharacters() {
+		characters = append(characters, completeFromGRPC(v))
+	}
+
+	return characters, nil
+}
+
+func (g *grpcClient) Create(ctx context.Context, inc Incomplete) (Complete, error) {
+	resp, err := g.client.Create(ctx, incompleteToGRPC(inc))
+	if err != nil {
+		return nil, err
+	}
+
+	return completeFromGRPC(resp), nil
+}
+
+func (g *grpcClient) Update(ctx context.Context, c Complete) error {
+	_, err := g.client.Update(ctx, completeToGRPC(c))
+	return err
+}
+
+func (g *grpcClient) Delete(ctx context.Context, id Identifier) error {
+	_, err := g.client.Delete(ctx, &pb.Identifier{
+		GUID: id.GUID(),
+	})
+	return err
+}
+
+func (g *grpcClient) GetLimit(ctx context.Context, id user.Identifier) (int, error) {
+	resp, err := g.client.GetLimit(ctx, &pb.UserIdentifier{
+		UUID: id.UUID(),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.GetLimit()), nil
+}
+
+func (g *grpcClient) GetAppearance(ctx context.Context, id Identifier) (*Appearance, error) {
+	resp, err := g.client.GetAppearance(ctx, &pb.Identifier{
+		GUID: id.GUID(),
+	})
+	if err != nil {
+		if status.Convert(err).Code() == codes.NotFound {
+			return nil, sql.ErrNoRows
+		}
+
+		return nil, err
+	}
+
+	return appearanceFromGRPC(resp), nil
+}
+
+func (g *grpcClient) SetAppearance(ctx context.Context, id Identifier, a *Appearance) error {
+	_, err := g.client.SetAppearance(ctx, &pb.SetAppearanceRequest{
+		Identifier: &pb.Identifier{
+			GUID: id.GUID(),
+		},
+		Appearance: appearanceToGRPC(a),
+	})
+	return err
+}
+
+func (g *grpcClient) GetOutfits(ctx context.Context, id Identifier) ([]*Outfit, error) {
+	resp, err := g.client.GetOutfits(ctx, &pb.Identifier{
+		GUID: id.GUID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	outfits := make([]*Outfit, 0)
+	for _, v := range resp.GetOutfits() {
+		outfits = append(outfits, outfitFromGRPC(v))
+	}
+
+	return outfits, nil
+}
+
+func (g *grpcClient) SetOutfit(ctx context.Context, id Identifier, o *Outfit) error {
+	_, err := g.client.SetOutfit(ctx, &pb.SetOutfitRequest{
+		Identifier: &pb.Identifier{
+			GUID: id.GUID(),
+		},
+		Outfit: outfitToGRPC(o),
+	})
+	return err
+}
+
+func (g *grpcClient) DeleteOutfit(ctx context.Context, id Identifier, name string) error {
+	_, err := g.client.DeleteOutfit(ctx, &pb.OutfitRequest{
+		Identifier: &pb.Identifier{
+			GUID: id.GUID(),
+		},
+		Name: name,
+	})
+	return err
+}
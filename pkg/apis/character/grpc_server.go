@@ -0,0 +1,234 @@
+package character
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	pb "github.com/51st-state/api/pkg/apis/character/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcServer struct {
+	manager Manager
+}
+
+// NewGRPCServer creates a new instance of a grpc server for managing characters
+func NewGRPCServer(m Manager) pb.ManagerServer {
+	return &grpcServer{m}
+}
+
+func completeToGRPC(c Complete) *pb.Complete {
+	return &pb.Complete{
+		Identifier: &pb.Identifier{
+			GUID: c.GUID(),
+		},
+		Incomplete: incompleteToGRPC(c),
+	}
+}
+
+func incompleteToGRPC(inc Incomplete) *pb.Incomplete {
+	return &pb.Incomplete{
+		UserUUID:      inc.Data().UserUUID,
+		FirstName:     inc.Data().FirstName,
+		LastName:      inc.Data().LastName,
+		Birthdate:     inc.Data().Birthdate.UnixNano(),
+		InventoryGUID: inc.Data().InventoryGUID,
+	}
+}
+
+func incompleteFromGRPC(inc *pb.Incomplete) Incomplete {
+	i := NewIncomplete(
+		inc.GetUserUUID(),
+		inc.GetFirstName(),
+		inc.GetLastName(),
+		time.Unix(0, inc.GetBirthdate()).UTC(),
+	)
+	i.Data().InventoryGUID = inc.GetInventoryGUID()
+	return i
+}
+
+func outfitToGRPC(o *Outfit) *pb.Outfit {
+	outfit := &pb.Outfit{
+		Name:       o.Name,
+		Components: make([]*pb.Component, 0),
+		Props:      make([]*pb.Prop, 0),
+	}
+	for _, v := range o.Components {
+		outfit.Components = append(outfit.Components, &pb.Component{
+			ComponentID: v.ComponentID,
+			DrawableID:  v.DrawableID,
+			TextureID:   v.TextureID,
+		})
+	}
+	for _, v := range o.Props {
+		outfit.Props = append(outfit.Props, &pb.Prop{
+			PropID:     v.PropID,
+			DrawableID: v.DrawableID,
+			TextureID:  v.TextureID,
+		})
+	}
+
+	return outfit
+}
+
+func outfitFromGRPC(o *pb.Outfit) *Outfit {
+	outfit := &Outfit{
+		Name:       o.GetName(),
+		Components: make([]*Component, 0),
+		Props:      make([]*Prop, 0),
+	}
+	for _, v := range o.GetComponents() {
+		outfit.Components = append(outfit.Components, &Component{
+			ComponentID: v.GetComponentID(),
+			DrawableID:  v.GetDrawableID(),
+			TextureID:   v.GetTextureID(),
+		})
+	}
+	for _, v := range o.GetProps() {
+		outfit.Props = append(outfit.Props, &Prop{
+			PropID:     v.GetPropID(),
+			DrawableID: v.GetDrawableID(),
+			TextureID:  v.GetTextureID(),
+		})
+	}
+
+	return outfit
+}
+
+func appearanceToGRPC(a *Appearance) *pb.Appearance {
+	return &pb.Appearance{
+		Sex: a.Sex,
+		HeadBlend: &pb.HeadBlend{
+			ShapeFirst:  a.HeadBlend.ShapeFirst,
+			ShapeSecond: a.HeadBlend.ShapeSecond,
+			SkinFirst:   a.HeadBlend.SkinFirst,
+			SkinSecond:  a.HeadBlend.SkinSecond,
+			ShapeMix:    a.HeadBlend.ShapeMix,
+			SkinMix:     a.HeadBlend.SkinMix,
+		},
+		FaceFeatures: a.FaceFeatures,
+		Outfit:       outfitToGRPC(a.Outfit),
+	}
+}
+
+func appearanceFromGRPC(a *pb.Appearance) *Appearance {
+	return &Appearance{
+		Sex: a.GetSex(),
+		HeadBlend: HeadBlend{
+			ShapeFirst:  a.GetHeadBlend().GetShapeFirst(),
+			ShapeSecond: a.GetHeadBlend().GetShapeSecond(),
+			SkinFirst:   a.GetHeadBlend().GetSkinFirst(),
+			SkinSecond:  a.GetHeadBlend().GetSkinSecond(),
+			ShapeMix:    a.GetHeadBlend().GetShapeMix(),
+			SkinMix:     a.GetHeadBlend().GetSkinMix(),
+		},
+		FaceFeatures: a.GetFaceFeatures(),
+		Outfit:       outfitFromGRPC(a.GetOutfit()),
+	}
+}
+
+func (g *grpcServer) Get(ctx context.Context, id *pb.Identifier) (*pb.Complete, error) {
+	c, err := g.manager.Get(ctx, &identifier{id.GetGUID()})
+	if err != nil {
+		return nil, err
+	}
+
+	return completeToGRPC(c), nil
+}
+
+func (g *grpcServer) GetByUser(ctx context.Context, id *pb.UserIdentifier) (*pb.Characters, error) {
+	characters, err := g.manager.GetByUser(ctx, &userIdentifier{id.GetUUID()})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.Characters{
+		Characters: make([]*pb.Complete, 0),
+	}
+	for _, v := range characters {
+		resp.Characters = append(resp.Characters, completeToGRPC(v))
+	}
+
+	return resp, nil
+}
+
+func (g *grpcServer) Create(ctx context.Context, inc *pb.Incomplete) (*pb.Complete, error) {
+	c, err := g.manager.Create(ctx, incompleteFromGRPC(inc))
+	if err != nil {
+		return nil, err
+	}
+
+	return completeToGRPC(c), nil
+}
+
+func (g *grpcServer) Update(ctx context.Context, c *pb.Complete) (*empty.Empty, error) {
+	return &empty.Empty{}, g.manager.Update(ctx, &complete{
+		&identifier{c.GetIdentifier().GetGUID()},
+		incompleteFromGRPC(c.GetIncomplete()),
+	})
+}
+
+func (g *grpcServer) Delete(ctx context.Context, id *pb.Identifier) (*empty.Empty, error) {
+	return &empty.Empty{}, g.manager.Delete(ctx, &identifier{id.GetGUID()})
+}
+
+func (g *grpcServer) GetLimit(ctx context.Context, id *pb.UserIdentifier) (*pb.Limit, error) {
+	limit, err := g.manager.GetLimit(ctx, &userIdentifier{id.GetUUID()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Limit{
+		Limit: int64(limit),
+	}, nil
+}
+
+func (g *grpcServer) GetAppearance(ctx context.Context, id *pb.Identifier) (*pb.Appearance, error) {
+	a, err := g.manager.GetAppearance(ctx, &identifier{id.GetGUID()})
+	if err == sql.ErrNoRows {
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	} else if err != nil {
+		return nil, err
+	}
+
+	return appearanceToGRPC(a), nil
+}
+
+func (g *grpcServer) SetAppearance(ctx context.Context, req *pb.SetAppearanceRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, g.manager.SetAppearance(
+		ctx,
+		&identifier{req.GetIdentifier().GetGUID()},
+		appearanceFromGRPC(req.GetAppearance()),
+	)
+}
+
+func (g *grpcServer) GetOutfits(ctx context.Context, id *pb.Identifier) (*pb.Outfits, error) {
+	outfits, err := g.manager.GetOutfits(ctx, &identifier{id.GetGUID()})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.Outfits{
+		Outfits: make([]*pb.Outfit, 0),
+	}
+	for _, v := range outfits {
+		resp.Outfits = append(resp.Outfits, outfitToGRPC(v))
+	}
+
+	return resp, nil
+}
+
+func (g *grpcServer) SetOutfit(ctx context.Context, req *pb.SetOutfitRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, g.manager.SetOutfit(
+		ctx,
+		&identifier{req.GetIdentifier().GetGUID()},
+		outfitFromGRPC(req.GetOutfit()),
+	)
+}
+
+func (g *grpcServer) DeleteOutfit(ctx context.Context, req *pb.OutfitRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, g.manager.DeleteOutfit(ctx, &identifier{req.GetIdentifier().GetGUID()}, req.GetName())
+}
@@ -0,0 +1,321 @@
+package character
+
+//go:generate counterfeiter -o ./mocks/manager.go . Manager
+//go:generate protoc -I./../../../../../../ -I ./proto --go_out=plugins=grpc:./proto ./proto/manager.proto
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/inventory"
+	"github.com/51st-state/api/pkg/apis/preselect"
+	"github.com/51st-state/api/pkg/apis/topgenerator"
+	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/problems"
+	"github.com/51st-state/api/pkg/rbac"
+	"github.com/google/uuid"
+)
+
+// Manager provides methods to manage the characters of users.
+// Every character owns an inventory, which is created along with it
+// and deleted by the inventory service once the character is deleted.
+type Manager interface {
+	Get(context.Context, Identifier) (Complete, error)
+	GetByUser(context.Context, user.Identifier) ([]Complete, error)
+	// Create a character if the user has not reached its limit yet
+	Create(context.Context, Incomplete) (Complete, error)
+	// Update the names and the birthdate of a character
+	Update(context.Context, Complete) error
+	// Delete a character softly, its inventory is deleted by the inventory service
+	Delete(context.Context, Identifier) error
+	// GetLimit returns the number of characters a user may own
+	GetLimit(context.Context, user.Identifier) (int, error)
+	GetAppearance(context.Context, Identifier) (*Appearance, error)
+	// SetAppearance validates the outfit against the catalogs of clothing
+	SetAppearance(context.Context, Identifier, *Appearance) error
+	GetOutfits(context.Context, Identifier) ([]*Outfit, error)
+	// SetOutfit validates the outfit for the sex of the appearance of a character
+	SetOutfit(context.Context, Identifier, *Outfit) error
+	DeleteOutfit(context.Context, Identifier, string) error
+}
+
+type manager struct {
+	repository    Repository
+	inventory     inventory.Manager
+	rbac          rbac.Control
+	event         *event.Producer
+	preselections preselect.Getter
+	tops          topgenerator.Getter
+}
+
+// NewManager creates a new character manager.
+// Outfits are validated against the accepted pre-selections and the torsos of the top generator.
+func NewManager(r Repository, inv inventory.Manager, rb rbac.Control, prod *event.Producer, p preselect.Getter, t topgenerator.Getter) Manager {
+	return &manager{r, inv, rb, prod, p, t}
+}
+
+// names start with an upper case letter and may contain hyphens and apostrophes
+var namePattern = regexp.MustCompile(`^\p{Lu}[\p{L}'-]{1,23}$`)
+
+// the age characters may have
+const (
+	minAge = 18
+	maxAge = 100
+)
+
+var (
+	errInvalidGUID      = errors.New("invalid guid given")
+	errInvalidUserUUID  = errors.New("invalid user uuid given")
+	errInvalidFirstName = problems.New("invalid first name", "names have to start with an upper case letter and consist of 2 to 24 letters", http.StatusBadRequest)
+	errInvalidLastName  = problems.New("invalid last name", "names have to start with an upper case letter and consist of 2 to 24 letters", http.StatusBadRequest)
+	errInvalidBirthdate = problems.New("invalid birthdate", fmt.Sprintf("characters have to be between %d and %d years old", minAge, maxAge), http.StatusBadRequest)
+)
+
+func validate(inc Incomplete) error {
+	if !namePattern.MatchString(inc.Data().FirstName) {
+		return errInvalidFirstName
+	}
+
+	if !namePattern.MatchString(inc.Data().LastName) {
+		return errInvalidLastName
+	}
+
+	now := time.Now()
+	if b := inc.Data().Birthdate; b.After(now.AddDate(-minAge, 0, 0)) || b.Before(now.AddDate(-maxAge, 0, 0)) {
+		return errInvalidBirthdate
+	}
+
+	return nil
+}
+
+func (m *manager) Get(ctx context.Context, id Identifier) (Complete, error) {
+	if id.GUID() == "" {
+		return nil, errInvalidGUID
+	}
+
+	return m.repository.Get(ctx, id)
+}
+
+func (m *manager) GetByUser(ctx context.Context, id user.Identifier) ([]Complete, error) {
+	if id.UUID() == "" {
+		return nil, errInvalidUserUUID
+	}
+
+	return m.repository.GetByUser(ctx, id)
+}
+
+func limitReached(limit int) error {
+	return problems.New(
+		"character limit reached",
+		fmt.Sprintf("you may not own more than %d characters", limit),
+		http.StatusConflict,
+	)
+}
+
+func (m *manager) Create(ctx context.Context, inc Incomplete) (Complete, error) {
+	if inc.Data().UserUUID == "" {
+		return nil, errInvalidUserUUID
+	}
+
+	if err := validate(inc); err != nil {
+		return nil, err
+	}
+
+	owner := &userIdentifier{inc.Data().UserUUID}
+
+	characters, err := m.repository.GetByUser(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := m.GetLimit(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// the repository enforces the limit on insert as well,
+	// this check only spares the inventory in the common case
+	if len(characters) >= limit {
+		return nil, limitReached(limit)
+	}
+
+	// the guid is generated upfront so the inventory is owned by the character
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	invInc := inventory.NewIncomplete(make([]*inventory.Item, 0))
+	invInc.Data().Owner = inventory.NewOwner(inventory.OwnerCharacter, rand.String())
+
+	inv, err := m.inventory.Create(ctx, invInc)
+	if err != nil {
+		return nil, err
+	}
+
+	inc.Data().InventoryGUID = inv.GUID()
+
+	c, err := m.repository.Create(ctx, NewIdentifier(rand.String()), inc, limit)
+	if err != nil {
+		// the inventory would be orphaned otherwise
+		if dErr := m.inventory.Delete(ctx, inv); dErr != nil {
+			return nil, dErr
+		}
+
+		if err == ErrLimitReached {
+			return nil, limitReached(limit)
+		}
+
+		return nil, err
+	}
+
+	return c, m.event.Produce(ctx, CreatedEventID, &CreatedEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		c,
+	})
+}
+
+func (m *manager) Update(ctx context.Context, c Complete) error {
+	if err := validate(c); err != nil {
+		return err
+	}
+
+	stored, err := m.Get(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	// the owner and the inventory of a character never change
+	stored.Data().FirstName = c.Data().FirstName
+	stored.Data().LastName = c.Data().LastName
+	stored.Data().Birthdate = c.Data().Birthdate
+
+	if err := m.repository.Update(ctx, stored); err != nil {
+		return err
+	}
+
+	return m.event.Produce(ctx, UpdatedEventID, &UpdatedEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		stored,
+	})
+}
+
+func (m *manager) Delete(ctx context.Context, id Identifier) error {
+	c, err := m.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := m.repository.Delete(ctx, c); err != nil {
+		return err
+	}
+
+	return m.event.Produce(ctx, DeletedEventID, &DeletedEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		c,
+	})
+}
+
+func (m *manager) GetLimit(ctx context.Context, id user.Identifier) (int, error) {
+	if id.UUID() == "" {
+		return 0, errInvalidUserUUID
+	}
+
+	rules := make([]rbac.Rule, 0, len(limitRules))
+	for rule := range limitRules {
+		rules = append(rules, rule)
+	}
+
+	allowed, err := m.rbac.CheckMany(ctx, rbac.AccountID(fmt.Sprintf("user/%s", id.UUID())), rules)
+	if err != nil {
+		return 0, err
+	}
+
+	limit := DefaultLimit
+	for rule, ok := range allowed {
+		if ok && limitRules[rule] > limit {
+			limit = limitRules[rule]
+		}
+	}
+
+	return limit, nil
+}
+
+func (m *manager) GetAppearance(ctx context.Context, id Identifier) (*Appearance, error) {
+	if _, err := m.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return m.repository.GetAppearance(ctx, id)
+}
+
+func (m *manager) SetAppearance(ctx context.Context, id Identifier, a *Appearance) error {
+	if err := validateAppearance(a); err != nil {
+		return err
+	}
+
+	if _, err := m.Get(ctx, id); err != nil {
+		return err
+	}
+
+	// the current outfit is never named
+	a.Outfit.Name = ""
+	if err := m.validateOutfit(ctx, a.Sex, a.Outfit); err != nil {
+		return err
+	}
+
+	return m.repository.SetAppearance(ctx, id, a)
+}
+
+func (m *manager) GetOutfits(ctx context.Context, id Identifier) ([]*Outfit, error) {
+	if _, err := m.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return m.repository.GetOutfits(ctx, id)
+}
+
+var (
+	errInvalidOutfitName = problems.New("invalid outfit name", "outfits have to be named with 1 to 32 characters", http.StatusBadRequest)
+	errNoAppearance      = problems.New("no appearance", "the appearance of the character has to be set before saving outfits", http.StatusConflict)
+)
+
+func (m *manager) SetOutfit(ctx context.Context, id Identifier, o *Outfit) error {
+	if o.Name == "" || len(o.Name) > 32 {
+		return errInvalidOutfitName
+	}
+
+	// the sex of the character determines which clothing is accepted
+	a, err := m.GetAppearance(ctx, id)
+	if err == sql.ErrNoRows {
+		return errNoAppearance
+	} else if err != nil {
+		return err
+	}
+
+	if err := m.validateOutfit(ctx, a.Sex, o); err != nil {
+		return err
+	}
+
+	return m.repository.SetOutfit(ctx, id, o)
+}
+
+func (m *manager) DeleteOutfit(ctx context.Context, id Identifier, name string) error {
+	if _, err := m.Get(ctx, id); err != nil {
+		return err
+	}
+
+	return m.repository.DeleteOutfit(ctx, id, name)
+}
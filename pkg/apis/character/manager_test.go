@@ -0,0 +1,448 @@
+package character_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/character"
+	"github.com/51st-state/api/pkg/apis/character/memory"
+	"github.com/51st-state/api/pkg/apis/character/mocks"
+	"github.com/51st-state/api/pkg/apis/inventory"
+	inventoryMocks "github.com/51st-state/api/pkg/apis/inventory/mocks"
+	"github.com/51st-state/api/pkg/apis/preselect"
+	preselectMemory "github.com/51st-state/api/pkg/apis/preselect/memory"
+	"github.com/51st-state/api/pkg/apis/topgenerator"
+	topgeneratorMemory "github.com/51st-state/api/pkg/apis/topgenerator/memory"
+	"github.com/51st-state/api/pkg/event"
+	pubsubMocks "github.com/51st-state/api/pkg/pubsub/mocks"
+	"github.com/51st-state/api/pkg/rbac"
+	rbacMocks "github.com/51st-state/api/pkg/rbac/mocks"
+)
+
+type fakeInventory struct {
+	inventory.Identifier
+	inventory.Incomplete
+}
+
+type userIdentifier string
+
+func (i userIdentifier) UUID() string {
+	return string(i)
+}
+
+const owner = userIdentifier("7b3d5d1e-6c5a-4c1e-9f3c-2a1d8c9e0f11")
+
+var birthdate = time.Now().AddDate(-30, 0, 0)
+
+func newInventoryManager() *inventoryMocks.FakeManager {
+	inv := &inventoryMocks.FakeManager{}
+	inv.CreateReturns(&fakeInventory{
+		inventory.NewIdentifier("inventory"),
+		inventory.NewIncomplete(nil),
+	}, nil)
+	return inv
+}
+
+func newManager(r character.Repository, inv inventory.Manager, rb rbac.Control) character.Manager {
+	return character.NewManager(
+		r,
+		inv,
+		rb,
+		event.NewProducer(&pubsubMocks.FakeProducer{}),
+		preselect.NewManager(preselectMemory.NewRepository()),
+		topgenerator.NewManager(topgeneratorMemory.New()),
+	)
+}
+
+func TestManagerGet(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	manager := newManager(repo, newInventoryManager(), &rbacMocks.FakeControl{})
+
+	id := &mocks.FakeIdentifier{}
+
+	if _, err := manager.Get(context.Background(), id); err == nil {
+		t.Fatal("there has to be an error since the guid is invalid")
+	}
+
+	id.GUIDReturns("test")
+
+	if _, err := manager.Get(context.Background(), id); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestManagerCreate(t *testing.T) {
+	ctx := context.Background()
+	inv := newInventoryManager()
+	rb := &rbacMocks.FakeControl{}
+	manager := newManager(memory.NewRepository(), inv, rb)
+
+	for _, inc := range []character.Incomplete{
+		character.NewIncomplete("", "John", "Doe", birthdate),
+		character.NewIncomplete(owner.UUID(), "john", "Doe", birthdate),
+		character.NewIncomplete(owner.UUID(), "John", "D", birthdate),
+		character.NewIncomplete(owner.UUID(), "John", "Doe1", birthdate),
+		character.NewIncomplete(owner.UUID(), "John", "Doe", time.Now().AddDate(-10, 0, 0)),
+		character.NewIncomplete(owner.UUID(), "John", "Doe", time.Now().AddDate(-120, 0, 0)),
+	} {
+		if _, err := manager.Create(ctx, inc); err == nil {
+			t.Fatal("there has to be an error since the character is invalid")
+		}
+	}
+
+	if inv.CreateCallCount() != 0 {
+		t.Fatal("no inventory should be created for an invalid character")
+	}
+
+	c, err := manager.Create(ctx, character.NewIncomplete(owner.UUID(), "John", "O'Neil-Doe", birthdate))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Data().InventoryGUID != "inventory" || inv.CreateCallCount() != 1 {
+		t.Fatal("an inventory should be created for the character")
+	}
+
+	if _, created := inv.CreateArgsForCall(0); created.Data().Owner.String() != "character/"+c.GUID() {
+		t.Fatal("the inventory should be owned by the character")
+	}
+
+	if _, err := manager.Create(ctx, character.NewIncomplete(owner.UUID(), "Jane", "Doe", birthdate)); err == nil {
+		t.Fatal("there has to be an error since the default limit is reached")
+	}
+
+	rb.CheckManyReturns(map[rbac.Rule]bool{"characters.limit.2": true}, nil)
+
+	if _, err := manager.Create(ctx, character.NewIncomplete(owner.UUID(), "Jane", "Doe", birthdate)); err != nil {
+		t.Fatal("the limit rule should allow a second character")
+	}
+
+	if err := manager.Delete(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := manager.Create(ctx, character.NewIncomplete(owner.UUID(), "Jack", "Doe", birthdate)); err != nil {
+		t.Fatal("deleted characters should not count towards the limit")
+	}
+}
+
+func TestManagerCreateDeletesInventory(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	repo.CreateReturns(nil, errors.New("test error"))
+	inv := newInventoryManager()
+	manager := newManager(repo, inv, &rbacMocks.FakeControl{})
+
+	if _, err := manager.Create(context.Background(), character.NewIncomplete(owner.UUID(), "John", "Doe", birthdate)); err == nil {
+		t.Fatal("the error of the repository should be returned")
+	}
+
+	if inv.DeleteCallCount() != 1 {
+		t.Fatal("the inventory should be deleted if the character could not be created")
+	}
+
+	if _, id := inv.DeleteArgsForCall(0); id.GUID() != "inventory" {
+		t.Fatal("the created inventory should be deleted")
+	}
+}
+
+func TestManagerCreateConcurrentLimit(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	repo.CreateReturns(nil, character.ErrLimitReached)
+	inv := newInventoryManager()
+	manager := newManager(repo, inv, &rbacMocks.FakeControl{})
+
+	_, err := manager.Create(context.Background(), character.NewIncomplete(owner.UUID(), "John", "Doe", birthdate))
+	if err == nil || err == character.ErrLimitReached {
+		t.Fatal("the limit reached by a concurrent creation should be reported as such")
+	}
+
+	if _, _, _, limit := repo.CreateArgsForCall(0); limit != character.DefaultLimit {
+		t.Fatal("the limit of the user should be enforced by the repository")
+	}
+
+	if inv.DeleteCallCount() != 1 {
+		t.Fatal("the inventory should be deleted if the limit was reached")
+	}
+}
+
+func TestManagerUpdate(t *testing.T) {
+	ctx := context.Background()
+	manager := newManager(memory.NewRepository(), newInventoryManager(), &rbacMocks.FakeControl{})
+
+	c, err := manager.Create(ctx, character.NewIncomplete(owner.UUID(), "John", "Doe", birthdate))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	inc := character.NewIncomplete("other", "jane", "Doe", birthdate)
+	if err := manager.Update(ctx, &struct {
+		character.Identifier
+		character.Incomplete
+	}{c, inc}); err == nil {
+		t.Fatal("there has to be an error since the first name is invalid")
+	}
+
+	inc.Data().FirstName = "Jane"
+	inc.Data().InventoryGUID = "other"
+	if err := manager.Update(ctx, &struct {
+		character.Identifier
+		character.Incomplete
+	}{c, inc}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	stored, err := manager.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if stored.Data().FirstName != "Jane" {
+		t.Fatal("the first name should be updated")
+	}
+
+	if stored.Data().UserUUID != owner.UUID() || stored.Data().InventoryGUID != "inventory" {
+		t.Fatal("the owner and the inventory should not be updated")
+	}
+}
+
+func TestManagerGetLimit(t *testing.T) {
+	rb := &rbacMocks.FakeControl{}
+	manager := newManager(&mocks.FakeRepository{}, newInventoryManager(), rb)
+
+	if _, err := manager.GetLimit(context.Background(), userIdentifier("")); err == nil {
+		t.Fatal("there has to be an error since the uuid is invalid")
+	}
+
+	limit, err := manager.GetLimit(context.Background(), owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if limit != character.DefaultLimit {
+		t.Fatal("the default limit should be returned without any limit rule")
+	}
+
+	rb.CheckManyReturns(map[rbac.Rule]bool{
+		"characters.limit.2": true,
+		"characters.limit.3": false,
+		"characters.limit.4": true,
+		"characters.limit.5": false,
+	}, nil)
+
+	limit, err = manager.GetLimit(context.Background(), owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if limit != 4 {
+		t.Fatal("the highest granted limit should be returned")
+	}
+
+	if _, account, _ := rb.CheckManyArgsForCall(1); account != rbac.AccountID("user/"+owner.UUID()) {
+		t.Fatal("the rules should be checked for the account of the user")
+	}
+
+	rb.CheckManyReturns(nil, errors.New("test error"))
+
+	if _, err := manager.GetLimit(context.Background(), owner); err == nil {
+		t.Fatal("the error of the rbac control should be returned")
+	}
+}
+
+func TestPrivacyHandler(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRepository()
+	inv := newInventoryManager()
+	rb := &rbacMocks.FakeControl{}
+	rb.CheckManyReturns(map[rbac.Rule]bool{"characters.limit.2": true}, nil)
+	manager := newManager(repo, inv, rb)
+	producer := &pubsubMocks.FakeProducer{}
+	h := character.NewPrivacyHandler(repo, inv, event.NewProducer(producer))
+
+	first, err := manager.Create(ctx, character.NewIncomplete(owner.UUID(), "John", "Doe", birthdate))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := manager.Create(ctx, character.NewIncomplete(owner.UUID(), "Jane", "Doe", birthdate)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := manager.Delete(ctx, first); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := h.Export(ctx, owner.UUID()); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := h.Erase(ctx, owner.UUID()); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if inv.DeleteCallCount() != 2 {
+		t.Fatal("the inventories of all characters should be deleted")
+	}
+
+	if producer.ProduceCallCount() != 2 {
+		t.Fatal("a purged event should be produced for every character")
+	}
+
+	characters, err := repo.GetAllByUser(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(characters) != 0 {
+		t.Fatal("the soft deleted characters should be erased as well")
+	}
+}
+
+type preselection struct {
+	preselect.Identifier
+	preselect.Incomplete
+}
+
+type top struct {
+	topgenerator.Identifier
+	topgenerator.Incomplete
+}
+
+func newAppearance(components ...*character.Component) *character.Appearance {
+	return &character.Appearance{
+		Sex: character.SexFemale,
+		HeadBlend: character.HeadBlend{
+			ShapeFirst: 21,
+			SkinFirst:  21,
+			ShapeMix:   0.5,
+			SkinMix:    0.5,
+		},
+		FaceFeatures: make([]float64, 20),
+		Outfit: &character.Outfit{
+			Components: components,
+			Props: []*character.Prop{
+				{PropID: 0, DrawableID: 3, TextureID: 0},
+			},
+		},
+	}
+}
+
+func TestManagerAppearance(t *testing.T) {
+	ctx := context.Background()
+
+	preselections := preselectMemory.NewRepository()
+	if err := preselections.Create(
+		ctx,
+		&preselection{preselect.NewIdentifier(character.SexFemale, 4, 1, 0), preselect.NewIncomplete(preselect.StateAccepted)},
+		&preselection{preselect.NewIdentifier(character.SexFemale, 4, 2, 0), preselect.NewIncomplete(preselect.StateDeclined)},
+		&preselection{preselect.NewIdentifier(character.SexFemale, 8, 3, 0), preselect.NewIncomplete(preselect.StateAccepted)},
+		&preselection{preselect.NewIdentifier(character.SexFemale, 11, 5, 1), preselect.NewIncomplete(preselect.StateAccepted)},
+	); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	tops := topgeneratorMemory.New()
+	if err := tops.Upsert(ctx, &top{
+		topgenerator.NewIdentifier(true, 3, 5),
+		topgenerator.NewIncomplete(0, 0, 0, 7, 25, 25, 25, 25, 1),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	manager := character.NewManager(
+		memory.NewRepository(),
+		newInventoryManager(),
+		&rbacMocks.FakeControl{},
+		event.NewProducer(&pubsubMocks.FakeProducer{}),
+		preselect.NewManager(preselections),
+		topgenerator.NewManager(tops),
+	)
+
+	c, err := manager.Create(ctx, character.NewIncomplete(owner.UUID(), "Jane", "Doe", birthdate))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	legs := &character.Component{ComponentID: 4, DrawableID: 1, TextureID: 0}
+	hair := &character.Component{ComponentID: 2, DrawableID: 12, TextureID: 3}
+	torso := &character.Component{ComponentID: 3, DrawableID: 7, TextureID: 0}
+	undershirt := &character.Component{ComponentID: 8, DrawableID: 3, TextureID: 0}
+	topComponent := &character.Component{ComponentID: 11, DrawableID: 5, TextureID: 1}
+
+	if err := manager.SetOutfit(ctx, c, &character.Outfit{Name: "casual"}); err == nil {
+		t.Fatal("there has to be an error since the character has no appearance yet")
+	}
+
+	for _, a := range []*character.Appearance{
+		newAppearance(&character.Component{ComponentID: 4, DrawableID: 2, TextureID: 0}),
+		newAppearance(&character.Component{ComponentID: 4, DrawableID: 9, TextureID: 0}),
+		newAppearance(&character.Component{ComponentID: 12, DrawableID: 1, TextureID: 0}),
+		newAppearance(legs, legs),
+		newAppearance(topComponent, undershirt),
+		newAppearance(topComponent, undershirt, &character.Component{ComponentID: 3, DrawableID: 8, TextureID: 0}),
+	} {
+		if err := manager.SetAppearance(ctx, c, a); err == nil {
+			t.Fatal("there has to be an error since the outfit is invalid")
+		}
+	}
+
+	invalid := newAppearance(legs)
+	invalid.FaceFeatures = invalid.FaceFeatures[1:]
+	if err := manager.SetAppearance(ctx, c, invalid); err == nil {
+		t.Fatal("there has to be an error since a face feature is missing")
+	}
+
+	invalid = newAppearance(legs)
+	invalid.Sex = character.SexMale
+	if err := manager.SetAppearance(ctx, c, invalid); err == nil {
+		t.Fatal("there has to be an error since the clothing is accepted for another sex only")
+	}
+
+	if err := manager.SetAppearance(ctx, c, newAppearance(legs, hair, torso, undershirt, topComponent)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	a, err := manager.GetAppearance(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if a.Sex != character.SexFemale || len(a.Outfit.Components) != 5 {
+		t.Fatal("the appearance should be stored")
+	}
+
+	if err := manager.SetOutfit(ctx, c, &character.Outfit{Components: []*character.Component{legs}}); err == nil {
+		t.Fatal("there has to be an error since the outfit is unnamed")
+	}
+
+	if err := manager.SetOutfit(ctx, c, &character.Outfit{Name: "casual", Components: []*character.Component{topComponent}}); err == nil {
+		t.Fatal("there has to be an error since the top is worn without an undershirt")
+	}
+
+	if err := manager.SetOutfit(ctx, c, &character.Outfit{Name: "casual", Components: []*character.Component{legs}}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	outfits, err := manager.GetOutfits(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(outfits) != 1 || outfits[0].Name != "casual" {
+		t.Fatal("the outfit should be saved")
+	}
+
+	if err := manager.DeleteOutfit(ctx, c, "casual"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := manager.Delete(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := manager.GetAppearance(ctx, c); err == nil {
+		t.Fatal("the appearance of a deleted character should not be found")
+	}
+}
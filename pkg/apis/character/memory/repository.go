@@ -0,0 +1,291 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+
+	"github.com/51st-state/api/pkg/apis/character"
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+type complete struct {
+	character.Identifier
+	character.Incomplete
+}
+
+func (c *complete) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		GUID          string    `json:"guid"`
+		UserUUID      string    `json:"user_uuid"`
+		FirstName     string    `json:"first_name"`
+		LastName      string    `json:"last_name"`
+		Birthdate     time.Time `json:"birthdate"`
+		InventoryGUID string    `json:"inventory_guid"`
+	}{
+		c.GUID(),
+		c.Data().UserUUID,
+		c.Data().FirstName,
+		c.Data().LastName,
+		c.Data().Birthdate,
+		c.Data().InventoryGUID,
+	})
+}
+
+type entry struct {
+	guid       string
+	data       character.Incomplete
+	deleted    bool
+	appearance *character.Appearance
+	outfits    []*character.Outfit
+}
+
+type repository struct {
+	mutex sync.RWMutex
+	// characters in the order of their creation
+	characters []*entry
+}
+
+// NewRepository creates a new in memory storage repository
+func NewRepository() character.Repository {
+	return &repository{
+		characters: make([]*entry, 0),
+	}
+}
+
+func storedOutfit(o *character.Outfit) *character.Outfit {
+	s := &character.Outfit{
+		Name:       o.Name,
+		Components: make([]*character.Component, 0),
+		Props:      make([]*character.Prop, 0),
+	}
+	for _, v := range o.Components {
+		c := *v
+		s.Components = append(s.Components, &c)
+	}
+	for _, v := range o.Props {
+		p := *v
+		s.Props = append(s.Props, &p)
+	}
+
+	return s
+}
+
+func storedAppearance(a *character.Appearance) *character.Appearance {
+	s := *a
+	s.FaceFeatures = append(make([]float64, 0), a.FaceFeatures...)
+	s.Outfit = storedOutfit(a.Outfit)
+	return &s
+}
+
+func stored(inc character.Incomplete) character.Incomplete {
+	s := character.NewIncomplete(
+		inc.Data().UserUUID,
+		inc.Data().FirstName,
+		inc.Data().LastName,
+		inc.Data().Birthdate,
+	)
+	s.Data().InventoryGUID = inc.Data().InventoryGUID
+	return s
+}
+
+func (e *entry) complete() character.Complete {
+	return &complete{
+		character.NewIdentifier(e.guid),
+		stored(e.data),
+	}
+}
+
+func (r *repository) find(id character.Identifier) *entry {
+	for _, v := range r.characters {
+		if v.guid == id.GUID() {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) Get(ctx context.Context, id character.Identifier) (character.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	e := r.find(id)
+	if e == nil || e.deleted {
+		return nil, sql.ErrNoRows
+	}
+
+	return e.complete(), nil
+}
+
+func (r *repository) getByUser(id user.Identifier, withDeleted bool) []character.Complete {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	characters := make([]character.Complete, 0)
+	for _, v := range r.characters {
+		if v.data.Data().UserUUID == id.UUID() && (withDeleted || !v.deleted) {
+			characters = append(characters, v.complete())
+		}
+	}
+
+	return characters
+}
+
+func (r *repository) GetByUser(ctx context.Context, id user.Identifier) ([]character.Complete, error) {
+	return r.getByUser(id, false), nil
+}
+
+func (r *repository) GetAllByUser(ctx context.Context, id user.Identifier) ([]character.Complete, error) {
+	return r.getByUser(id, true), nil
+}
+
+func (r *repository) Create(ctx context.Context, id character.Identifier, inc character.Incomplete, limit int) (character.Complete, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	owned := 0
+	for _, v := range r.characters {
+		if v.data.Data().UserUUID == inc.Data().UserUUID && !v.deleted {
+			owned++
+		}
+	}
+
+	if owned >= limit {
+		return nil, character.ErrLimitReached
+	}
+
+	e := &entry{
+		guid: id.GUID(),
+		data: stored(inc),
+	}
+	r.characters = append(r.characters, e)
+
+	return e.complete(), nil
+}
+
+func (r *repository) Update(ctx context.Context, c character.Complete) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	e := r.find(c)
+	if e == nil || e.deleted {
+		return nil
+	}
+
+	e.data.Data().FirstName = c.Data().FirstName
+	e.data.Data().LastName = c.Data().LastName
+	e.data.Data().Birthdate = c.Data().Birthdate
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id character.Identifier) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if e := r.find(id); e != nil {
+		e.deleted = true
+	}
+
+	return nil
+}
+
+func (r *repository) Purge(ctx context.Context, id character.Identifier) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, v := range r.characters {
+		if v.guid == id.GUID() {
+			r.characters = append(r.characters[:i], r.characters[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) GetAppearance(ctx context.Context, id character.Identifier) (*character.Appearance, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	e := r.find(id)
+	if e == nil || e.appearance == nil {
+		return nil, sql.ErrNoRows
+	}
+
+	return storedAppearance(e.appearance), nil
+}
+
+func (r *repository) SetAppearance(ctx context.Context, id character.Identifier, a *character.Appearance) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if e := r.find(id); e != nil {
+		e.appearance = storedAppearance(a)
+	}
+
+	return nil
+}
+
+func (r *repository) GetOutfits(ctx context.Context, id character.Identifier) ([]*character.Outfit, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	outfits := make([]*character.Outfit, 0)
+	if e := r.find(id); e != nil {
+		for _, v := range e.outfits {
+			outfits = append(outfits, storedOutfit(v))
+		}
+	}
+
+	sort.Slice(outfits, func(i, j int) bool {
+		return outfits[i].Name < outfits[j].Name
+	})
+
+	return outfits, nil
+}
+
+func (r *repository) SetOutfit(ctx context.Context, id character.Identifier, o *character.Outfit) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	e := r.find(id)
+	if e == nil {
+		return nil
+	}
+
+	for i, v := range e.outfits {
+		if v.Name == o.Name {
+			e.outfits[i] = storedOutfit(o)
+			return nil
+		}
+	}
+
+	e.outfits = append(e.outfits, storedOutfit(o))
+
+	return nil
+}
+
+func (r *repository) DeleteOutfit(ctx context.Context, id character.Identifier, name string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	e := r.find(id)
+	if e == nil {
+		return nil
+	}
+
+	for i, v := range e.outfits {
+		if v.Name == name {
+			e.outfits = append(e.outfits[:i], e.outfits[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,768 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/character"
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+type FakeManager struct {
+	GetStub        func(context.Context, character.Identifier) (character.Complete, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}
+	getReturns struct {
+		result1 character.Complete
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 character.Complete
+		result2 error
+	}
+	GetByUserStub        func(context.Context, user.Identifier) ([]character.Complete, error)
+	getByUserMutex       sync.RWMutex
+	getByUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	getByUserReturns struct {
+		result1 []character.Complete
+		result2 error
+	}
+	getByUserReturnsOnCall map[int]struct {
+		result1 []character.Complete
+		result2 error
+	}
+	CreateStub        func(context.Context, character.Incomplete) (character.Complete, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Incomplete
+	}
+	createReturns struct {
+		result1 character.Complete
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 character.Complete
+		result2 error
+	}
+	UpdateStub        func(context.Context, character.Complete) error
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Complete
+	}
+	updateReturns struct {
+		result1 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteStub        func(context.Context, character.Identifier) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetLimitStub        func(context.Context, user.Identifier) (int, error)
+	getLimitMutex       sync.RWMutex
+	getLimitArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	getLimitReturns struct {
+		result1 int
+		result2 error
+	}
+	getLimitReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	GetAppearanceStub        func(context.Context, character.Identifier) (*character.Appearance, error)
+	getAppearanceMutex       sync.RWMutex
+	getAppearanceArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}
+	getAppearanceReturns struct {
+		result1 *character.Appearance
+		result2 error
+	}
+	getAppearanceReturnsOnCall map[int]struct {
+		result1 *character.Appearance
+		result2 error
+	}
+	SetAppearanceStub        func(context.Context, character.Identifier, *character.Appearance) error
+	setAppearanceMutex       sync.RWMutex
+	setAppearanceArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 *character.Appearance
+	}
+	setAppearanceReturns struct {
+		result1 error
+	}
+	setAppearanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetOutfitsStub        func(context.Context, character.Identifier) ([]*character.Outfit, error)
+	getOutfitsMutex       sync.RWMutex
+	getOutfitsArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}
+	getOutfitsReturns struct {
+		result1 []*character.Outfit
+		result2 error
+	}
+	getOutfitsReturnsOnCall map[int]struct {
+		result1 []*character.Outfit
+		result2 error
+	}
+	SetOutfitStub        func(context.Context, character.Identifier, *character.Outfit) error
+	setOutfitMutex       sync.RWMutex
+	setOutfitArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 *character.Outfit
+	}
+	setOutfitReturns struct {
+		result1 error
+	}
+	setOutfitReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteOutfitStub        func(context.Context, character.Identifier, string) error
+	deleteOutfitMutex       sync.RWMutex
+	deleteOutfitArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 string
+	}
+	deleteOutfitReturns struct {
+		result1 error
+	}
+	deleteOutfitReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeManager) Get(arg1 context.Context, arg2 character.Identifier) (character.Complete, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getReturns.result1, fake.getReturns.result2
+}
+
+func (fake *FakeManager) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeManager) GetArgsForCall(i int) (context.Context, character.Identifier) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return fake.getArgsForCall[i].arg1, fake.getArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetReturns(result1 character.Complete, result2 error) {
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetReturnsOnCall(i int, result1 character.Complete, result2 error) {
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 character.Complete
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetByUser(arg1 context.Context, arg2 user.Identifier) ([]character.Complete, error) {
+	fake.getByUserMutex.Lock()
+	ret, specificReturn := fake.getByUserReturnsOnCall[len(fake.getByUserArgsForCall)]
+	fake.getByUserArgsForCall = append(fake.getByUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetByUser", []interface{}{arg1, arg2})
+	fake.getByUserMutex.Unlock()
+	if fake.GetByUserStub != nil {
+		return fake.GetByUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getByUserReturns.result1, fake.getByUserReturns.result2
+}
+
+func (fake *FakeManager) GetByUserCallCount() int {
+	fake.getByUserMutex.RLock()
+	defer fake.getByUserMutex.RUnlock()
+	return len(fake.getByUserArgsForCall)
+}
+
+func (fake *FakeManager) GetByUserArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getByUserMutex.RLock()
+	defer fake.getByUserMutex.RUnlock()
+	return fake.getByUserArgsForCall[i].arg1, fake.getByUserArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetByUserReturns(result1 []character.Complete, result2 error) {
+	fake.GetByUserStub = nil
+	fake.getByUserReturns = struct {
+		result1 []character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetByUserReturnsOnCall(i int, result1 []character.Complete, result2 error) {
+	fake.GetByUserStub = nil
+	if fake.getByUserReturnsOnCall == nil {
+		fake.getByUserReturnsOnCall = make(map[int]struct {
+			result1 []character.Complete
+			result2 error
+		})
+	}
+	fake.getByUserReturnsOnCall[i] = struct {
+		result1 []character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Create(arg1 context.Context, arg2 character.Incomplete) (character.Complete, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Incomplete
+	}{arg1, arg2})
+	fake.recordInvocation("Create", []interface{}{arg1, arg2})
+	fake.createMutex.Unlock()
+	if fake.CreateStub != nil {
+		return fake.CreateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createReturns.result1, fake.createReturns.result2
+}
+
+func (fake *FakeManager) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeManager) CreateArgsForCall(i int) (context.Context, character.Incomplete) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return fake.createArgsForCall[i].arg1, fake.createArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) CreateReturns(result1 character.Complete, result2 error) {
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) CreateReturnsOnCall(i int, result1 character.Complete, result2 error) {
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 character.Complete
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Update(arg1 context.Context, arg2 character.Complete) error {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Complete
+	}{arg1, arg2})
+	fake.recordInvocation("Update", []interface{}{arg1, arg2})
+	fake.updateMutex.Unlock()
+	if fake.UpdateStub != nil {
+		return fake.UpdateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateReturns.result1
+}
+
+func (fake *FakeManager) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeManager) UpdateArgsForCall(i int) (context.Context, character.Complete) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return fake.updateArgsForCall[i].arg1, fake.updateArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) UpdateReturns(result1 error) {
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) UpdateReturnsOnCall(i int, result1 error) {
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Delete(arg1 context.Context, arg2 character.Identifier) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteReturns.result1
+}
+
+func (fake *FakeManager) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeManager) DeleteArgsForCall(i int) (context.Context, character.Identifier) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return fake.deleteArgsForCall[i].arg1, fake.deleteArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) DeleteReturns(result1 error) {
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) DeleteReturnsOnCall(i int, result1 error) {
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) GetLimit(arg1 context.Context, arg2 user.Identifier) (int, error) {
+	fake.getLimitMutex.Lock()
+	ret, specificReturn := fake.getLimitReturnsOnCall[len(fake.getLimitArgsForCall)]
+	fake.getLimitArgsForCall = append(fake.getLimitArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetLimit", []interface{}{arg1, arg2})
+	fake.getLimitMutex.Unlock()
+	if fake.GetLimitStub != nil {
+		return fake.GetLimitStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getLimitReturns.result1, fake.getLimitReturns.result2
+}
+
+func (fake *FakeManager) GetLimitCallCount() int {
+	fake.getLimitMutex.RLock()
+	defer fake.getLimitMutex.RUnlock()
+	return len(fake.getLimitArgsForCall)
+}
+
+func (fake *FakeManager) GetLimitArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getLimitMutex.RLock()
+	defer fake.getLimitMutex.RUnlock()
+	return fake.getLimitArgsForCall[i].arg1, fake.getLimitArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetLimitReturns(result1 int, result2 error) {
+	fake.GetLimitStub = nil
+	fake.getLimitReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetLimitReturnsOnCall(i int, result1 int, result2 error) {
+	fake.GetLimitStub = nil
+	if fake.getLimitReturnsOnCall == nil {
+		fake.getLimitReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.getLimitReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetAppearance(arg1 context.Context, arg2 character.Identifier) (*character.Appearance, error) {
+	fake.getAppearanceMutex.Lock()
+	ret, specificReturn := fake.getAppearanceReturnsOnCall[len(fake.getAppearanceArgsForCall)]
+	fake.getAppearanceArgsForCall = append(fake.getAppearanceArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetAppearance", []interface{}{arg1, arg2})
+	fake.getAppearanceMutex.Unlock()
+	if fake.GetAppearanceStub != nil {
+		return fake.GetAppearanceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAppearanceReturns.result1, fake.getAppearanceReturns.result2
+}
+
+func (fake *FakeManager) GetAppearanceCallCount() int {
+	fake.getAppearanceMutex.RLock()
+	defer fake.getAppearanceMutex.RUnlock()
+	return len(fake.getAppearanceArgsForCall)
+}
+
+func (fake *FakeManager) GetAppearanceArgsForCall(i int) (context.Context, character.Identifier) {
+	fake.getAppearanceMutex.RLock()
+	defer fake.getAppearanceMutex.RUnlock()
+	return fake.getAppearanceArgsForCall[i].arg1, fake.getAppearanceArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetAppearanceReturns(result1 *character.Appearance, result2 error) {
+	fake.GetAppearanceStub = nil
+	fake.getAppearanceReturns = struct {
+		result1 *character.Appearance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetAppearanceReturnsOnCall(i int, result1 *character.Appearance, result2 error) {
+	fake.GetAppearanceStub = nil
+	if fake.getAppearanceReturnsOnCall == nil {
+		fake.getAppearanceReturnsOnCall = make(map[int]struct {
+			result1 *character.Appearance
+			result2 error
+		})
+	}
+	fake.getAppearanceReturnsOnCall[i] = struct {
+		result1 *character.Appearance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) SetAppearance(arg1 context.Context, arg2 character.Identifier, arg3 *character.Appearance) error {
+	fake.setAppearanceMutex.Lock()
+	ret, specificReturn := fake.setAppearanceReturnsOnCall[len(fake.setAppearanceArgsForCall)]
+	fake.setAppearanceArgsForCall = append(fake.setAppearanceArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 *character.Appearance
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetAppearance", []interface{}{arg1, arg2, arg3})
+	fake.setAppearanceMutex.Unlock()
+	if fake.SetAppearanceStub != nil {
+		return fake.SetAppearanceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setAppearanceReturns.result1
+}
+
+func (fake *FakeManager) SetAppearanceCallCount() int {
+	fake.setAppearanceMutex.RLock()
+	defer fake.setAppearanceMutex.RUnlock()
+	return len(fake.setAppearanceArgsForCall)
+}
+
+func (fake *FakeManager) SetAppearanceArgsForCall(i int) (context.Context, character.Identifier, *character.Appearance) {
+	fake.setAppearanceMutex.RLock()
+	defer fake.setAppearanceMutex.RUnlock()
+	return fake.setAppearanceArgsForCall[i].arg1, fake.setAppearanceArgsForCall[i].arg2, fake.setAppearanceArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) SetAppearanceReturns(result1 error) {
+	fake.SetAppearanceStub = nil
+	fake.setAppearanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) SetAppearanceReturnsOnCall(i int, result1 error) {
+	fake.SetAppearanceStub = nil
+	if fake.setAppearanceReturnsOnCall == nil {
+		fake.setAppearanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setAppearanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) GetOutfits(arg1 context.Context, arg2 character.Identifier) ([]*character.Outfit, error) {
+	fake.getOutfitsMutex.Lock()
+	ret, specificReturn := fake.getOutfitsReturnsOnCall[len(fake.getOutfitsArgsForCall)]
+	fake.getOutfitsArgsForCall = append(fake.getOutfitsArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetOutfits", []interface{}{arg1, arg2})
+	fake.getOutfitsMutex.Unlock()
+	if fake.GetOutfitsStub != nil {
+		return fake.GetOutfitsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOutfitsReturns.result1, fake.getOutfitsReturns.result2
+}
+
+func (fake *FakeManager) GetOutfitsCallCount() int {
+	fake.getOutfitsMutex.RLock()
+	defer fake.getOutfitsMutex.RUnlock()
+	return len(fake.getOutfitsArgsForCall)
+}
+
+func (fake *FakeManager) GetOutfitsArgsForCall(i int) (context.Context, character.Identifier) {
+	fake.getOutfitsMutex.RLock()
+	defer fake.getOutfitsMutex.RUnlock()
+	return fake.getOutfitsArgsForCall[i].arg1, fake.getOutfitsArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetOutfitsReturns(result1 []*character.Outfit, result2 error) {
+	fake.GetOutfitsStub = nil
+	fake.getOutfitsReturns = struct {
+		result1 []*character.Outfit
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetOutfitsReturnsOnCall(i int, result1 []*character.Outfit, result2 error) {
+	fake.GetOutfitsStub = nil
+	if fake.getOutfitsReturnsOnCall == nil {
+		fake.getOutfitsReturnsOnCall = make(map[int]struct {
+			result1 []*character.Outfit
+			result2 error
+		})
+	}
+	fake.getOutfitsReturnsOnCall[i] = struct {
+		result1 []*character.Outfit
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) SetOutfit(arg1 context.Context, arg2 character.Identifier, arg3 *character.Outfit) error {
+	fake.setOutfitMutex.Lock()
+	ret, specificReturn := fake.setOutfitReturnsOnCall[len(fake.setOutfitArgsForCall)]
+	fake.setOutfitArgsForCall = append(fake.setOutfitArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 *character.Outfit
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetOutfit", []interface{}{arg1, arg2, arg3})
+	fake.setOutfitMutex.Unlock()
+	if fake.SetOutfitStub != nil {
+		return fake.SetOutfitStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setOutfitReturns.result1
+}
+
+func (fake *FakeManager) SetOutfitCallCount() int {
+	fake.setOutfitMutex.RLock()
+	defer fake.setOutfitMutex.RUnlock()
+	return len(fake.setOutfitArgsForCall)
+}
+
+func (fake *FakeManager) SetOutfitArgsForCall(i int) (context.Context, character.Identifier, *character.Outfit) {
+	fake.setOutfitMutex.RLock()
+	defer fake.setOutfitMutex.RUnlock()
+	return fake.setOutfitArgsForCall[i].arg1, fake.setOutfitArgsForCall[i].arg2, fake.setOutfitArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) SetOutfitReturns(result1 error) {
+	fake.SetOutfitStub = nil
+	fake.setOutfitReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) SetOutfitReturnsOnCall(i int, result1 error) {
+	fake.SetOutfitStub = nil
+	if fake.setOutfitReturnsOnCall == nil {
+		fake.setOutfitReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setOutfitReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) DeleteOutfit(arg1 context.Context, arg2 character.Identifier, arg3 string) error {
+	fake.deleteOutfitMutex.Lock()
+	ret, specificReturn := fake.deleteOutfitReturnsOnCall[len(fake.deleteOutfitArgsForCall)]
+	fake.deleteOutfitArgsForCall = append(fake.deleteOutfitArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteOutfit", []interface{}{arg1, arg2, arg3})
+	fake.deleteOutfitMutex.Unlock()
+	if fake.DeleteOutfitStub != nil {
+		return fake.DeleteOutfitStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteOutfitReturns.result1
+}
+
+func (fake *FakeManager) DeleteOutfitCallCount() int {
+	fake.deleteOutfitMutex.RLock()
+	defer fake.deleteOutfitMutex.RUnlock()
+	return len(fake.deleteOutfitArgsForCall)
+}
+
+func (fake *FakeManager) DeleteOutfitArgsForCall(i int) (context.Context, character.Identifier, string) {
+	fake.deleteOutfitMutex.RLock()
+	defer fake.deleteOutfitMutex.RUnlock()
+	return fake.deleteOutfitArgsForCall[i].arg1, fake.deleteOutfitArgsForCall[i].arg2, fake.deleteOutfitArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) DeleteOutfitReturns(result1 error) {
+	fake.DeleteOutfitStub = nil
+	fake.deleteOutfitReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) DeleteOutfitReturnsOnCall(i int, result1 error) {
+	fake.DeleteOutfitStub = nil
+	if fake.deleteOutfitReturnsOnCall == nil {
+		fake.deleteOutfitReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteOutfitReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getByUserMutex.RLock()
+	defer fake.getByUserMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.getLimitMutex.RLock()
+	defer fake.getLimitMutex.RUnlock()
+	fake.getAppearanceMutex.RLock()
+	defer fake.getAppearanceMutex.RUnlock()
+	fake.setAppearanceMutex.RLock()
+	defer fake.setAppearanceMutex.RUnlock()
+	fake.getOutfitsMutex.RLock()
+	defer fake.getOutfitsMutex.RUnlock()
+	fake.setOutfitMutex.RLock()
+	defer fake.setOutfitMutex.RUnlock()
+	fake.deleteOutfitMutex.RLock()
+	defer fake.deleteOutfitMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeManager) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ character.Manager = new(FakeManager)
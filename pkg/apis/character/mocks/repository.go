@@ -0,0 +1,835 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/character"
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+type FakeRepository struct {
+	GetStub        func(context.Context, character.Identifier) (character.Complete, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}
+	getReturns struct {
+		result1 character.Complete
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 character.Complete
+		result2 error
+	}
+	GetByUserStub        func(context.Context, user.Identifier) ([]character.Complete, error)
+	getByUserMutex       sync.RWMutex
+	getByUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	getByUserReturns struct {
+		result1 []character.Complete
+		result2 error
+	}
+	getByUserReturnsOnCall map[int]struct {
+		result1 []character.Complete
+		result2 error
+	}
+	GetAllByUserStub        func(context.Context, user.Identifier) ([]character.Complete, error)
+	getAllByUserMutex       sync.RWMutex
+	getAllByUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	getAllByUserReturns struct {
+		result1 []character.Complete
+		result2 error
+	}
+	getAllByUserReturnsOnCall map[int]struct {
+		result1 []character.Complete
+		result2 error
+	}
+	CreateStub        func(context.Context, character.Identifier, character.Incomplete, int) (character.Complete, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 character.Incomplete
+		arg4 int
+	}
+	createReturns struct {
+		result1 character.Complete
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 character.Complete
+		result2 error
+	}
+	UpdateStub        func(context.Context, character.Complete) error
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Complete
+	}
+	updateReturns struct {
+		result1 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteStub        func(context.Context, character.Identifier) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PurgeStub        func(context.Context, character.Identifier) error
+	purgeMutex       sync.RWMutex
+	purgeArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}
+	purgeReturns struct {
+		result1 error
+	}
+	purgeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAppearanceStub        func(context.Context, character.Identifier) (*character.Appearance, error)
+	getAppearanceMutex       sync.RWMutex
+	getAppearanceArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}
+	getAppearanceReturns struct {
+		result1 *character.Appearance
+		result2 error
+	}
+	getAppearanceReturnsOnCall map[int]struct {
+		result1 *character.Appearance
+		result2 error
+	}
+	SetAppearanceStub        func(context.Context, character.Identifier, *character.Appearance) error
+	setAppearanceMutex       sync.RWMutex
+	setAppearanceArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 *character.Appearance
+	}
+	setAppearanceReturns struct {
+		result1 error
+	}
+	setAppearanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetOutfitsStub        func(context.Context, character.Identifier) ([]*character.Outfit, error)
+	getOutfitsMutex       sync.RWMutex
+	getOutfitsArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}
+	getOutfitsReturns struct {
+		result1 []*character.Outfit
+		result2 error
+	}
+	getOutfitsReturnsOnCall map[int]struct {
+		result1 []*character.Outfit
+		result2 error
+	}
+	SetOutfitStub        func(context.Context, character.Identifier, *character.Outfit) error
+	setOutfitMutex       sync.RWMutex
+	setOutfitArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 *character.Outfit
+	}
+	setOutfitReturns struct {
+		result1 error
+	}
+	setOutfitReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteOutfitStub        func(context.Context, character.Identifier, string) error
+	deleteOutfitMutex       sync.RWMutex
+	deleteOutfitArgsForCall []struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 string
+	}
+	deleteOutfitReturns struct {
+		result1 error
+	}
+	deleteOutfitReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRepository) Get(arg1 context.Context, arg2 character.Identifier) (character.Complete, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getReturns.result1, fake.getReturns.result2
+}
+
+func (fake *FakeRepository) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeRepository) GetArgsForCall(i int) (context.Context, character.Identifier) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return fake.getArgsForCall[i].arg1, fake.getArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetReturns(result1 character.Complete, result2 error) {
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetReturnsOnCall(i int, result1 character.Complete, result2 error) {
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 character.Complete
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetByUser(arg1 context.Context, arg2 user.Identifier) ([]character.Complete, error) {
+	fake.getByUserMutex.Lock()
+	ret, specificReturn := fake.getByUserReturnsOnCall[len(fake.getByUserArgsForCall)]
+	fake.getByUserArgsForCall = append(fake.getByUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetByUser", []interface{}{arg1, arg2})
+	fake.getByUserMutex.Unlock()
+	if fake.GetByUserStub != nil {
+		return fake.GetByUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getByUserReturns.result1, fake.getByUserReturns.result2
+}
+
+func (fake *FakeRepository) GetByUserCallCount() int {
+	fake.getByUserMutex.RLock()
+	defer fake.getByUserMutex.RUnlock()
+	return len(fake.getByUserArgsForCall)
+}
+
+func (fake *FakeRepository) GetByUserArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getByUserMutex.RLock()
+	defer fake.getByUserMutex.RUnlock()
+	return fake.getByUserArgsForCall[i].arg1, fake.getByUserArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetByUserReturns(result1 []character.Complete, result2 error) {
+	fake.GetByUserStub = nil
+	fake.getByUserReturns = struct {
+		result1 []character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetByUserReturnsOnCall(i int, result1 []character.Complete, result2 error) {
+	fake.GetByUserStub = nil
+	if fake.getByUserReturnsOnCall == nil {
+		fake.getByUserReturnsOnCall = make(map[int]struct {
+			result1 []character.Complete
+			result2 error
+		})
+	}
+	fake.getByUserReturnsOnCall[i] = struct {
+		result1 []character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetAllByUser(arg1 context.Context, arg2 user.Identifier) ([]character.Complete, error) {
+	fake.getAllByUserMutex.Lock()
+	ret, specificReturn := fake.getAllByUserReturnsOnCall[len(fake.getAllByUserArgsForCall)]
+	fake.getAllByUserArgsForCall = append(fake.getAllByUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetAllByUser", []interface{}{arg1, arg2})
+	fake.getAllByUserMutex.Unlock()
+	if fake.GetAllByUserStub != nil {
+		return fake.GetAllByUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAllByUserReturns.result1, fake.getAllByUserReturns.result2
+}
+
+func (fake *FakeRepository) GetAllByUserCallCount() int {
+	fake.getAllByUserMutex.RLock()
+	defer fake.getAllByUserMutex.RUnlock()
+	return len(fake.getAllByUserArgsForCall)
+}
+
+func (fake *FakeRepository) GetAllByUserArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getAllByUserMutex.RLock()
+	defer fake.getAllByUserMutex.RUnlock()
+	return fake.getAllByUserArgsForCall[i].arg1, fake.getAllByUserArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetAllByUserReturns(result1 []character.Complete, result2 error) {
+	fake.GetAllByUserStub = nil
+	fake.getAllByUserReturns = struct {
+		result1 []character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetAllByUserReturnsOnCall(i int, result1 []character.Complete, result2 error) {
+	fake.GetAllByUserStub = nil
+	if fake.getAllByUserReturnsOnCall == nil {
+		fake.getAllByUserReturnsOnCall = make(map[int]struct {
+			result1 []character.Complete
+			result2 error
+		})
+	}
+	fake.getAllByUserReturnsOnCall[i] = struct {
+		result1 []character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) Create(arg1 context.Context, arg2 character.Identifier, arg3 character.Incomplete, arg4 int) (character.Complete, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 character.Incomplete
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("Create", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createMutex.Unlock()
+	if fake.CreateStub != nil {
+		return fake.CreateStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createReturns.result1, fake.createReturns.result2
+}
+
+func (fake *FakeRepository) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeRepository) CreateArgsForCall(i int) (context.Context, character.Identifier, character.Incomplete, int) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return fake.createArgsForCall[i].arg1, fake.createArgsForCall[i].arg2, fake.createArgsForCall[i].arg3, fake.createArgsForCall[i].arg4
+}
+
+func (fake *FakeRepository) CreateReturns(result1 character.Complete, result2 error) {
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) CreateReturnsOnCall(i int, result1 character.Complete, result2 error) {
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 character.Complete
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 character.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) Update(arg1 context.Context, arg2 character.Complete) error {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Complete
+	}{arg1, arg2})
+	fake.recordInvocation("Update", []interface{}{arg1, arg2})
+	fake.updateMutex.Unlock()
+	if fake.UpdateStub != nil {
+		return fake.UpdateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateReturns.result1
+}
+
+func (fake *FakeRepository) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeRepository) UpdateArgsForCall(i int) (context.Context, character.Complete) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return fake.updateArgsForCall[i].arg1, fake.updateArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) UpdateReturns(result1 error) {
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) UpdateReturnsOnCall(i int, result1 error) {
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) Delete(arg1 context.Context, arg2 character.Identifier) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteReturns.result1
+}
+
+func (fake *FakeRepository) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeRepository) DeleteArgsForCall(i int) (context.Context, character.Identifier) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return fake.deleteArgsForCall[i].arg1, fake.deleteArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) DeleteReturns(result1 error) {
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeleteReturnsOnCall(i int, result1 error) {
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) Purge(arg1 context.Context, arg2 character.Identifier) error {
+	fake.purgeMutex.Lock()
+	ret, specificReturn := fake.purgeReturnsOnCall[len(fake.purgeArgsForCall)]
+	fake.purgeArgsForCall = append(fake.purgeArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Purge", []interface{}{arg1, arg2})
+	fake.purgeMutex.Unlock()
+	if fake.PurgeStub != nil {
+		return fake.PurgeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.purgeReturns.result1
+}
+
+func (fake *FakeRepository) PurgeCallCount() int {
+	fake.purgeMutex.RLock()
+	defer fake.purgeMutex.RUnlock()
+	return len(fake.purgeArgsForCall)
+}
+
+func (fake *FakeRepository) PurgeArgsForCall(i int) (context.Context, character.Identifier) {
+	fake.purgeMutex.RLock()
+	defer fake.purgeMutex.RUnlock()
+	return fake.purgeArgsForCall[i].arg1, fake.purgeArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) PurgeReturns(result1 error) {
+	fake.PurgeStub = nil
+	fake.purgeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) PurgeReturnsOnCall(i int, result1 error) {
+	fake.PurgeStub = nil
+	if fake.purgeReturnsOnCall == nil {
+		fake.purgeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.purgeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetAppearance(arg1 context.Context, arg2 character.Identifier) (*character.Appearance, error) {
+	fake.getAppearanceMutex.Lock()
+	ret, specificReturn := fake.getAppearanceReturnsOnCall[len(fake.getAppearanceArgsForCall)]
+	fake.getAppearanceArgsForCall = append(fake.getAppearanceArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetAppearance", []interface{}{arg1, arg2})
+	fake.getAppearanceMutex.Unlock()
+	if fake.GetAppearanceStub != nil {
+		return fake.GetAppearanceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAppearanceReturns.result1, fake.getAppearanceReturns.result2
+}
+
+func (fake *FakeRepository) GetAppearanceCallCount() int {
+	fake.getAppearanceMutex.RLock()
+	defer fake.getAppearanceMutex.RUnlock()
+	return len(fake.getAppearanceArgsForCall)
+}
+
+func (fake *FakeRepository) GetAppearanceArgsForCall(i int) (context.Context, character.Identifier) {
+	fake.getAppearanceMutex.RLock()
+	defer fake.getAppearanceMutex.RUnlock()
+	return fake.getAppearanceArgsForCall[i].arg1, fake.getAppearanceArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetAppearanceReturns(result1 *character.Appearance, result2 error) {
+	fake.GetAppearanceStub = nil
+	fake.getAppearanceReturns = struct {
+		result1 *character.Appearance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetAppearanceReturnsOnCall(i int, result1 *character.Appearance, result2 error) {
+	fake.GetAppearanceStub = nil
+	if fake.getAppearanceReturnsOnCall == nil {
+		fake.getAppearanceReturnsOnCall = make(map[int]struct {
+			result1 *character.Appearance
+			result2 error
+		})
+	}
+	fake.getAppearanceReturnsOnCall[i] = struct {
+		result1 *character.Appearance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) SetAppearance(arg1 context.Context, arg2 character.Identifier, arg3 *character.Appearance) error {
+	fake.setAppearanceMutex.Lock()
+	ret, specificReturn := fake.setAppearanceReturnsOnCall[len(fake.setAppearanceArgsForCall)]
+	fake.setAppearanceArgsForCall = append(fake.setAppearanceArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 *character.Appearance
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetAppearance", []interface{}{arg1, arg2, arg3})
+	fake.setAppearanceMutex.Unlock()
+	if fake.SetAppearanceStub != nil {
+		return fake.SetAppearanceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setAppearanceReturns.result1
+}
+
+func (fake *FakeRepository) SetAppearanceCallCount() int {
+	fake.setAppearanceMutex.RLock()
+	defer fake.setAppearanceMutex.RUnlock()
+	return len(fake.setAppearanceArgsForCall)
+}
+
+func (fake *FakeRepository) SetAppearanceArgsForCall(i int) (context.Context, character.Identifier, *character.Appearance) {
+	fake.setAppearanceMutex.RLock()
+	defer fake.setAppearanceMutex.RUnlock()
+	return fake.setAppearanceArgsForCall[i].arg1, fake.setAppearanceArgsForCall[i].arg2, fake.setAppearanceArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) SetAppearanceReturns(result1 error) {
+	fake.SetAppearanceStub = nil
+	fake.setAppearanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) SetAppearanceReturnsOnCall(i int, result1 error) {
+	fake.SetAppearanceStub = nil
+	if fake.setAppearanceReturnsOnCall == nil {
+		fake.setAppearanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setAppearanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetOutfits(arg1 context.Context, arg2 character.Identifier) ([]*character.Outfit, error) {
+	fake.getOutfitsMutex.Lock()
+	ret, specificReturn := fake.getOutfitsReturnsOnCall[len(fake.getOutfitsArgsForCall)]
+	fake.getOutfitsArgsForCall = append(fake.getOutfitsArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetOutfits", []interface{}{arg1, arg2})
+	fake.getOutfitsMutex.Unlock()
+	if fake.GetOutfitsStub != nil {
+		return fake.GetOutfitsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOutfitsReturns.result1, fake.getOutfitsReturns.result2
+}
+
+func (fake *FakeRepository) GetOutfitsCallCount() int {
+	fake.getOutfitsMutex.RLock()
+	defer fake.getOutfitsMutex.RUnlock()
+	return len(fake.getOutfitsArgsForCall)
+}
+
+func (fake *FakeRepository) GetOutfitsArgsForCall(i int) (context.Context, character.Identifier) {
+	fake.getOutfitsMutex.RLock()
+	defer fake.getOutfitsMutex.RUnlock()
+	return fake.getOutfitsArgsForCall[i].arg1, fake.getOutfitsArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetOutfitsReturns(result1 []*character.Outfit, result2 error) {
+	fake.GetOutfitsStub = nil
+	fake.getOutfitsReturns = struct {
+		result1 []*character.Outfit
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetOutfitsReturnsOnCall(i int, result1 []*character.Outfit, result2 error) {
+	fake.GetOutfitsStub = nil
+	if fake.getOutfitsReturnsOnCall == nil {
+		fake.getOutfitsReturnsOnCall = make(map[int]struct {
+			result1 []*character.Outfit
+			result2 error
+		})
+	}
+	fake.getOutfitsReturnsOnCall[i] = struct {
+		result1 []*character.Outfit
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) SetOutfit(arg1 context.Context, arg2 character.Identifier, arg3 *character.Outfit) error {
+	fake.setOutfitMutex.Lock()
+	ret, specificReturn := fake.setOutfitReturnsOnCall[len(fake.setOutfitArgsForCall)]
+	fake.setOutfitArgsForCall = append(fake.setOutfitArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 *character.Outfit
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetOutfit", []interface{}{arg1, arg2, arg3})
+	fake.setOutfitMutex.Unlock()
+	if fake.SetOutfitStub != nil {
+		return fake.SetOutfitStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setOutfitReturns.result1
+}
+
+func (fake *FakeRepository) SetOutfitCallCount() int {
+	fake.setOutfitMutex.RLock()
+	defer fake.setOutfitMutex.RUnlock()
+	return len(fake.setOutfitArgsForCall)
+}
+
+func (fake *FakeRepository) SetOutfitArgsForCall(i int) (context.Context, character.Identifier, *character.Outfit) {
+	fake.setOutfitMutex.RLock()
+	defer fake.setOutfitMutex.RUnlock()
+	return fake.setOutfitArgsForCall[i].arg1, fake.setOutfitArgsForCall[i].arg2, fake.setOutfitArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) SetOutfitReturns(result1 error) {
+	fake.SetOutfitStub = nil
+	fake.setOutfitReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) SetOutfitReturnsOnCall(i int, result1 error) {
+	fake.SetOutfitStub = nil
+	if fake.setOutfitReturnsOnCall == nil {
+		fake.setOutfitReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setOutfitReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeleteOutfit(arg1 context.Context, arg2 character.Identifier, arg3 string) error {
+	fake.deleteOutfitMutex.Lock()
+	ret, specificReturn := fake.deleteOutfitReturnsOnCall[len(fake.deleteOutfitArgsForCall)]
+	fake.deleteOutfitArgsForCall = append(fake.deleteOutfitArgsForCall, struct {
+		arg1 context.Context
+		arg2 character.Identifier
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteOutfit", []interface{}{arg1, arg2, arg3})
+	fake.deleteOutfitMutex.Unlock()
+	if fake.DeleteOutfitStub != nil {
+		return fake.DeleteOutfitStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteOutfitReturns.result1
+}
+
+func (fake *FakeRepository) DeleteOutfitCallCount() int {
+	fake.deleteOutfitMutex.RLock()
+	defer fake.deleteOutfitMutex.RUnlock()
+	return len(fake.deleteOutfitArgsForCall)
+}
+
+func (fake *FakeRepository) DeleteOutfitArgsForCall(i int) (context.Context, character.Identifier, string) {
+	fake.deleteOutfitMutex.RLock()
+	defer fake.deleteOutfitMutex.RUnlock()
+	return fake.deleteOutfitArgsForCall[i].arg1, fake.deleteOutfitArgsForCall[i].arg2, fake.deleteOutfitArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) DeleteOutfitReturns(result1 error) {
+	fake.DeleteOutfitStub = nil
+	fake.deleteOutfitReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeleteOutfitReturnsOnCall(i int, result1 error) {
+	fake.DeleteOutfitStub = nil
+	if fake.deleteOutfitReturnsOnCall == nil {
+		fake.deleteOutfitReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteOutfitReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.getByUserMutex.RLock()
+	defer fake.getByUserMutex.RUnlock()
+	fake.getAllByUserMutex.RLock()
+	defer fake.getAllByUserMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.purgeMutex.RLock()
+	defer fake.purgeMutex.RUnlock()
+	fake.getAppearanceMutex.RLock()
+	defer fake.getAppearanceMutex.RUnlock()
+	fake.setAppearanceMutex.RLock()
+	defer fake.setAppearanceMutex.RUnlock()
+	fake.getOutfitsMutex.RLock()
+	defer fake.getOutfitsMutex.RUnlock()
+	fake.setOutfitMutex.RLock()
+	defer fake.setOutfitMutex.RUnlock()
+	fake.deleteOutfitMutex.RLock()
+	defer fake.deleteOutfitMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ character.Repository = new(FakeRepository)
@@ -0,0 +1,88 @@
+package character
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/51st-state/api/pkg/apis/inventory"
+	"github.com/51st-state/api/pkg/event"
+)
+
+// PrivacyHandler exports and erases the characters of users, soft deleted ones included.
+// The inventories of erased characters are deleted as well.
+type PrivacyHandler struct {
+	repository Repository
+	inventory  inventory.Manager
+	event      *event.Producer
+}
+
+// NewPrivacyHandler for the data of the character service
+func NewPrivacyHandler(r Repository, inv inventory.Manager, prod *event.Producer) *PrivacyHandler {
+	return &PrivacyHandler{r, inv, prod}
+}
+
+type privacyCharacter struct {
+	Character  Complete    `json:"character"`
+	Appearance *Appearance `json:"appearance,omitempty"`
+	Outfits    []*Outfit   `json:"outfits"`
+}
+
+type privacyExport struct {
+	Characters []*privacyCharacter `json:"characters"`
+}
+
+// Export the characters of a user with their appearances and outfits
+func (h *PrivacyHandler) Export(ctx context.Context, userUUID string) (interface{}, error) {
+	characters, err := h.repository.GetAllByUser(ctx, &userIdentifier{userUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	export := &privacyExport{
+		Characters: make([]*privacyCharacter, 0),
+	}
+	for _, v := range characters {
+		a, err := h.repository.GetAppearance(ctx, v)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+
+		outfits, err := h.repository.GetOutfits(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+
+		export.Characters = append(export.Characters, &privacyCharacter{v, a, outfits})
+	}
+
+	return export, nil
+}
+
+// Erase the characters of a user with their appearances, outfits and inventories
+func (h *PrivacyHandler) Erase(ctx context.Context, userUUID string) error {
+	characters, err := h.repository.GetAllByUser(ctx, &userIdentifier{userUUID})
+	if err != nil {
+		return err
+	}
+
+	for _, v := range characters {
+		if err := h.inventory.Delete(ctx, inventory.NewIdentifier(v.Data().InventoryGUID)); err != nil {
+			return err
+		}
+
+		if err := h.repository.Purge(ctx, v); err != nil {
+			return err
+		}
+
+		if err := h.event.Produce(ctx, PurgedEventID, &PurgedEvent{
+			&event.PayloadMeta{
+				Version: "1",
+			},
+			v,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,1261 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: manager.proto
+
+package character
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Identifier struct {
+	GUID                 string   `protobuf:"bytes,1,opt,name=GUID,proto3" json:"GUID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Identifier) Reset()         { *m = Identifier{} }
+func (m *Identifier) String() string { return proto.CompactTextString(m) }
+func (*Identifier) ProtoMessage()    {}
+func (*Identifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{0}
+}
+
+func (m *Identifier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Identifier.Unmarshal(m, b)
+}
+func (m *Identifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Identifier.Marshal(b, m, deterministic)
+}
+func (m *Identifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Identifier.Merge(m, src)
+}
+func (m *Identifier) XXX_Size() int {
+	return xxx_messageInfo_Identifier.Size(m)
+}
+func (m *Identifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_Identifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Identifier proto.InternalMessageInfo
+
+func (m *Identifier) GetGUID() string {
+	if m != nil {
+		return m.GUID
+	}
+	return ""
+}
+
+type UserIdentifier struct {
+	UUID                 string   `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserIdentifier) Reset()         { *m = UserIdentifier{} }
+func (m *UserIdentifier) String() string { return proto.CompactTextString(m) }
+func (*UserIdentifier) ProtoMessage()    {}
+func (*UserIdentifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{1}
+}
+
+func (m *UserIdentifier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserIdentifier.Unmarshal(m, b)
+}
+func (m *UserIdentifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserIdentifier.Marshal(b, m, deterministic)
+}
+func (m *UserIdentifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserIdentifier.Merge(m, src)
+}
+func (m *UserIdentifier) XXX_Size() int {
+	return xxx_messageInfo_UserIdentifier.Size(m)
+}
+func (m *UserIdentifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserIdentifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserIdentifier proto.InternalMessageInfo
+
+func (m *UserIdentifier) GetUUID() string {
+	if m != nil {
+		return m.UUID
+	}
+	return ""
+}
+
+type Incomplete struct {
+	UserUUID             string   `protobuf:"bytes,1,opt,name=UserUUID,proto3" json:"UserUUID,omitempty"`
+	FirstName            string   `protobuf:"bytes,2,opt,name=FirstName,proto3" json:"FirstName,omitempty"`
+	LastName             string   `protobuf:"bytes,3,opt,name=LastName,proto3" json:"LastName,omitempty"`
+	Birthdate            int64    `protobuf:"varint,4,opt,name=Birthdate,proto3" json:"Birthdate,omitempty"`
+	InventoryGUID        string   `protobuf:"bytes,5,opt,name=InventoryGUID,proto3" json:"InventoryGUID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Incomplete) Reset()         { *m = Incomplete{} }
+func (m *Incomplete) String() string { return proto.CompactTextString(m) }
+func (*Incomplete) ProtoMessage()    {}
+func (*Incomplete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{2}
+}
+
+func (m *Incomplete) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Incomplete.Unmarshal(m, b)
+}
+func (m *Incomplete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Incomplete.Marshal(b, m, deterministic)
+}
+func (m *Incomplete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Incomplete.Merge(m, src)
+}
+func (m *Incomplete) XXX_Size() int {
+	return xxx_messageInfo_Incomplete.Size(m)
+}
+func (m *Incomplete) XXX_DiscardUnknown() {
+	xxx_messageInfo_Incomplete.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Incomplete proto.InternalMessageInfo
+
+func (m *Incomplete) GetUserUUID() string {
+	if m != nil {
+		return m.UserUUID
+	}
+	return ""
+}
+
+func (m *Incomplete) GetFirstName() string {
+	if m != nil {
+		return m.FirstName
+	}
+	return ""
+}
+
+func (m *Incomplete) GetLastName() string {
+	if m != nil {
+		return m.LastName
+	}
+	return ""
+}
+
+func (m *Incomplete) GetBirthdate() int64 {
+	if m != nil {
+		return m.Birthdate
+	}
+	return 0
+}
+
+func (m *Incomplete) GetInventoryGUID() string {
+	if m != nil {
+		return m.InventoryGUID
+	}
+	return ""
+}
+
+type Complete struct {
+	Identifier           *Identifier `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Incomplete           *Incomplete `protobuf:"bytes,2,opt,name=Incomplete,proto3" json:"Incomplete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Complete) Reset()         { *m = Complete{} }
+func (m *Complete) String() string { return proto.CompactTextString(m) }
+func (*Complete) ProtoMessage()    {}
+func (*Complete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{3}
+}
+
+func (m *Complete) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Complete.Unmarshal(m, b)
+}
+func (m *Complete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Complete.Marshal(b, m, deterministic)
+}
+func (m *Complete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Complete.Merge(m, src)
+}
+func (m *Complete) XXX_Size() int {
+	return xxx_messageInfo_Complete.Size(m)
+}
+func (m *Complete) XXX_DiscardUnknown() {
+	xxx_messageInfo_Complete.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Complete proto.InternalMessageInfo
+
+func (m *Complete) GetIdentifier() *Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *Complete) GetIncomplete() *Incomplete {
+	if m != nil {
+		return m.Incomplete
+	}
+	return nil
+}
+
+type Characters struct {
+	Characters           []*Complete `protobuf:"bytes,1,rep,name=Characters,proto3" json:"Characters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Characters) Reset()         { *m = Characters{} }
+func (m *Characters) String() string { return proto.CompactTextString(m) }
+func (*Characters) ProtoMessage()    {}
+func (*Characters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{4}
+}
+
+func (m *Characters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Characters.Unmarshal(m, b)
+}
+func (m *Characters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Characters.Marshal(b, m, deterministic)
+}
+func (m *Characters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Characters.Merge(m, src)
+}
+func (m *Characters) XXX_Size() int {
+	return xxx_messageInfo_Characters.Size(m)
+}
+func (m *Characters) XXX_DiscardUnknown() {
+	xxx_messageInfo_Characters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Characters proto.InternalMessageInfo
+
+func (m *Characters) GetCharacters() []*Complete {
+	if m != nil {
+		return m.Characters
+	}
+	return nil
+}
+
+type Limit struct {
+	Limit                int64    `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Limit) Reset()         { *m = Limit{} }
+func (m *Limit) String() string { return proto.CompactTextString(m) }
+func (*Limit) ProtoMessage()    {}
+func (*Limit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{5}
+}
+
+func (m *Limit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Limit.Unmarshal(m, b)
+}
+func (m *Limit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Limit.Marshal(b, m, deterministic)
+}
+func (m *Limit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Limit.Merge(m, src)
+}
+func (m *Limit) XXX_Size() int {
+	return xxx_messageInfo_Limit.Size(m)
+}
+func (m *Limit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Limit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Limit proto.InternalMessageInfo
+
+func (m *Limit) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type Component struct {
+	ComponentID          uint64   `protobuf:"varint,1,opt,name=ComponentID,proto3" json:"ComponentID,omitempty"`
+	DrawableID           uint64   `protobuf:"varint,2,opt,name=DrawableID,proto3" json:"DrawableID,omitempty"`
+	TextureID            uint64   `protobuf:"varint,3,opt,name=TextureID,proto3" json:"TextureID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Component) Reset()         { *m = Component{} }
+func (m *Component) String() string { return proto.CompactTextString(m) }
+func (*Component) ProtoMessage()    {}
+func (*Component) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{6}
+}
+
+func (m *Component) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Component.Unmarshal(m, b)
+}
+func (m *Component) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Component.Marshal(b, m, deterministic)
+}
+func (m *Component) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Component.Merge(m, src)
+}
+func (m *Component) XXX_Size() int {
+	return xxx_messageInfo_Component.Size(m)
+}
+func (m *Component) XXX_DiscardUnknown() {
+	xxx_messageInfo_Component.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Component proto.InternalMessageInfo
+
+func (m *Component) GetComponentID() uint64 {
+	if m != nil {
+		return m.ComponentID
+	}
+	return 0
+}
+
+func (m *Component) GetDrawableID() uint64 {
+	if m != nil {
+		return m.DrawableID
+	}
+	return 0
+}
+
+func (m *Component) GetTextureID() uint64 {
+	if m != nil {
+		return m.TextureID
+	}
+	return 0
+}
+
+type Prop struct {
+	PropID               uint64   `protobuf:"varint,1,opt,name=PropID,proto3" json:"PropID,omitempty"`
+	DrawableID           uint64   `protobuf:"varint,2,opt,name=DrawableID,proto3" json:"DrawableID,omitempty"`
+	TextureID            uint64   `protobuf:"varint,3,opt,name=TextureID,proto3" json:"TextureID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Prop) Reset()         { *m = Prop{} }
+func (m *Prop) String() string { return proto.CompactTextString(m) }
+func (*Prop) ProtoMessage()    {}
+func (*Prop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{7}
+}
+
+func (m *Prop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Prop.Unmarshal(m, b)
+}
+func (m *Prop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Prop.Marshal(b, m, deterministic)
+}
+func (m *Prop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Prop.Merge(m, src)
+}
+func (m *Prop) XXX_Size() int {
+	return xxx_messageInfo_Prop.Size(m)
+}
+func (m *Prop) XXX_DiscardUnknown() {
+	xxx_messageInfo_Prop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Prop proto.InternalMessageInfo
+
+func (m *Prop) GetPropID() uint64 {
+	if m != nil {
+		return m.PropID
+	}
+	return 0
+}
+
+func (m *Prop) GetDrawableID() uint64 {
+	if m != nil {
+		return m.DrawableID
+	}
+	return 0
+}
+
+func (m *Prop) GetTextureID() uint64 {
+	if m != nil {
+		return m.TextureID
+	}
+	return 0
+}
+
+type Outfit struct {
+	Name                 string       `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Components           []*Component `protobuf:"bytes,2,rep,name=Components,proto3" json:"Components,omitempty"`
+	Props                []*Prop      `protobuf:"bytes,3,rep,name=Props,proto3" json:"Props,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Outfit) Reset()         { *m = Outfit{} }
+func (m *Outfit) String() string { return proto.CompactTextString(m) }
+func (*Outfit) ProtoMessage()    {}
+func (*Outfit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{8}
+}
+
+func (m *Outfit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Outfit.Unmarshal(m, b)
+}
+func (m *Outfit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Outfit.Marshal(b, m, deterministic)
+}
+func (m *Outfit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Outfit.Merge(m, src)
+}
+func (m *Outfit) XXX_Size() int {
+	return xxx_messageInfo_Outfit.Size(m)
+}
+func (m *Outfit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Outfit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Outfit proto.InternalMessageInfo
+
+func (m *Outfit) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Outfit) GetComponents() []*Component {
+	if m != nil {
+		return m.Components
+	}
+	return nil
+}
+
+func (m *Outfit) GetProps() []*Prop {
+	if m != nil {
+		return m.Props
+	}
+	return nil
+}
+
+type Outfits struct {
+	Outfits              []*Outfit `protobuf:"bytes,1,rep,name=Outfits,proto3" json:"Outfits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Outfits) Reset()         { *m = Outfits{} }
+func (m *Outfits) String() string { return proto.CompactTextString(m) }
+func (*Outfits) ProtoMessage()    {}
+func (*Outfits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{9}
+}
+
+func (m *Outfits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Outfits.Unmarshal(m, b)
+}
+func (m *Outfits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Outfits.Marshal(b, m, deterministic)
+}
+func (m *Outfits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Outfits.Merge(m, src)
+}
+func (m *Outfits) XXX_Size() int {
+	return xxx_messageInfo_Outfits.Size(m)
+}
+func (m *Outfits) XXX_DiscardUnknown() {
+	xxx_messageInfo_Outfits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Outfits proto.InternalMessageInfo
+
+func (m *Outfits) GetOutfits() []*Outfit {
+	if m != nil {
+		return m.Outfits
+	}
+	return nil
+}
+
+type HeadBlend struct {
+	ShapeFirst           uint64   `protobuf:"varint,1,opt,name=ShapeFirst,proto3" json:"ShapeFirst,omitempty"`
+	ShapeSecond          uint64   `protobuf:"varint,2,opt,name=ShapeSecond,proto3" json:"ShapeSecond,omitempty"`
+	SkinFirst            uint64   `protobuf:"varint,3,opt,name=SkinFirst,proto3" json:"SkinFirst,omitempty"`
+	SkinSecond           uint64   `protobuf:"varint,4,opt,name=SkinSecond,proto3" json:"SkinSecond,omitempty"`
+	ShapeMix             float64  `protobuf:"fixed64,5,opt,name=ShapeMix,proto3" json:"ShapeMix,omitempty"`
+	SkinMix              float64  `protobuf:"fixed64,6,opt,name=SkinMix,proto3" json:"SkinMix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HeadBlend) Reset()         { *m = HeadBlend{} }
+func (m *HeadBlend) String() string { return proto.CompactTextString(m) }
+func (*HeadBlend) ProtoMessage()    {}
+func (*HeadBlend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{10}
+}
+
+func (m *HeadBlend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeadBlend.Unmarshal(m, b)
+}
+func (m *HeadBlend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeadBlend.Marshal(b, m, deterministic)
+}
+func (m *HeadBlend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadBlend.Merge(m, src)
+}
+func (m *HeadBlend) XXX_Size() int {
+	return xxx_messageInfo_HeadBlend.Size(m)
+}
+func (m *HeadBlend) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadBlend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeadBlend proto.InternalMessageInfo
+
+func (m *HeadBlend) GetShapeFirst() uint64 {
+	if m != nil {
+		return m.ShapeFirst
+	}
+	return 0
+}
+
+func (m *HeadBlend) GetShapeSecond() uint64 {
+	if m != nil {
+		return m.ShapeSecond
+	}
+	return 0
+}
+
+func (m *HeadBlend) GetSkinFirst() uint64 {
+	if m != nil {
+		return m.SkinFirst
+	}
+	return 0
+}
+
+func (m *HeadBlend) GetSkinSecond() uint64 {
+	if m != nil {
+		return m.SkinSecond
+	}
+	return 0
+}
+
+func (m *HeadBlend) GetShapeMix() float64 {
+	if m != nil {
+		return m.ShapeMix
+	}
+	return 0
+}
+
+func (m *HeadBlend) GetSkinMix() float64 {
+	if m != nil {
+		return m.SkinMix
+	}
+	return 0
+}
+
+type Appearance struct {
+	Sex                  uint64     `protobuf:"varint,1,opt,name=Sex,proto3" json:"Sex,omitempty"`
+	HeadBlend            *HeadBlend `protobuf:"bytes,2,opt,name=HeadBlend,proto3" json:"HeadBlend,omitempty"`
+	FaceFeatures         []float64  `protobuf:"fixed64,3,rep,packed,name=FaceFeatures,proto3" json:"FaceFeatures,omitempty"`
+	Outfit               *Outfit    `protobuf:"bytes,4,opt,name=Outfit,proto3" json:"Outfit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *Appearance) Reset()         { *m = Appearance{} }
+func (m *Appearance) String() string { return proto.CompactTextString(m) }
+func (*Appearance) ProtoMessage()    {}
+func (*Appearance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{11}
+}
+
+func (m *Appearance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Appearance.Unmarshal(m, b)
+}
+func (m *Appearance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Appearance.Marshal(b, m, deterministic)
+}
+func (m *Appearance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Appearance.Merge(m, src)
+}
+func (m *Appearance) XXX_Size() int {
+	return xxx_messageInfo_Appearance.Size(m)
+}
+func (m *Appearance) XXX_DiscardUnknown() {
+	xxx_messageInfo_Appearance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Appearance proto.InternalMessageInfo
+
+func (m *Appearance) GetSex() uint64 {
+	if m != nil {
+		return m.Sex
+	}
+	return 0
+}
+
+func (m *Appearance) GetHeadBlend() *HeadBlend {
+	if m != nil {
+		return m.HeadBlend
+	}
+	return nil
+}
+
+func (m *Appearance) GetFaceFeatures() []float64 {
+	if m != nil {
+		return m.FaceFeatures
+	}
+	return nil
+}
+
+func (m *Appearance) GetOutfit() *Outfit {
+	if m != nil {
+		return m.Outfit
+	}
+	return nil
+}
+
+type SetAppearanceRequest struct {
+	Identifier           *Identifier `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Appearance           *Appearance `protobuf:"bytes,2,opt,name=Appearance,proto3" json:"Appearance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SetAppearanceRequest) Reset()         { *m = SetAppearanceRequest{} }
+func (m *SetAppearanceRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAppearanceRequest) ProtoMessage()    {}
+func (*SetAppearanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{12}
+}
+
+func (m *SetAppearanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAppearanceRequest.Unmarshal(m, b)
+}
+func (m *SetAppearanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAppearanceRequest.Marshal(b, m, deterministic)
+}
+func (m *SetAppearanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAppearanceRequest.Merge(m, src)
+}
+func (m *SetAppearanceRequest) XXX_Size() int {
+	return xxx_messageInfo_SetAppearanceRequest.Size(m)
+}
+func (m *SetAppearanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAppearanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAppearanceRequest proto.InternalMessageInfo
+
+func (m *SetAppearanceRequest) GetIdentifier() *Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *SetAppearanceRequest) GetAppearance() *Appearance {
+	if m != nil {
+		return m.Appearance
+	}
+	return nil
+}
+
+type SetOutfitRequest struct {
+	Identifier           *Identifier `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Outfit               *Outfit     `protobuf:"bytes,2,opt,name=Outfit,proto3" json:"Outfit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SetOutfitRequest) Reset()         { *m = SetOutfitRequest{} }
+func (m *SetOutfitRequest) String() string { return proto.CompactTextString(m) }
+func (*SetOutfitRequest) ProtoMessage()    {}
+func (*SetOutfitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{13}
+}
+
+func (m *SetOutfitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetOutfitRequest.Unmarshal(m, b)
+}
+func (m *SetOutfitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetOutfitRequest.Marshal(b, m, deterministic)
+}
+func (m *SetOutfitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetOutfitRequest.Merge(m, src)
+}
+func (m *SetOutfitRequest) XXX_Size() int {
+	return xxx_messageInfo_SetOutfitRequest.Size(m)
+}
+func (m *SetOutfitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetOutfitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetOutfitRequest proto.InternalMessageInfo
+
+func (m *SetOutfitRequest) GetIdentifier() *Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *SetOutfitRequest) GetOutfit() *Outfit {
+	if m != nil {
+		return m.Outfit
+	}
+	return nil
+}
+
+type OutfitRequest struct {
+	Identifier           *Identifier `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Name                 string      `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *OutfitRequest) Reset()         { *m = OutfitRequest{} }
+func (m *OutfitRequest) String() string { return proto.CompactTextString(m) }
+func (*OutfitRequest) ProtoMessage()    {}
+func (*OutfitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{14}
+}
+
+func (m *OutfitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OutfitRequest.Unmarshal(m, b)
+}
+func (m *OutfitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OutfitRequest.Marshal(b, m, deterministic)
+}
+func (m *OutfitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutfitRequest.Merge(m, src)
+}
+func (m *OutfitRequest) XXX_Size() int {
+	return xxx_messageInfo_OutfitRequest.Size(m)
+}
+func (m *OutfitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutfitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutfitRequest proto.InternalMessageInfo
+
+func (m *OutfitRequest) GetIdentifier() *Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *OutfitRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Identifier)(nil), "character.Identifier")
+	proto.RegisterType((*UserIdentifier)(nil), "character.UserIdentifier")
+	proto.RegisterType((*Incomplete)(nil), "character.Incomplete")
+	proto.RegisterType((*Complete)(nil), "character.Complete")
+	proto.RegisterType((*Characters)(nil), "character.Characters")
+	proto.RegisterType((*Limit)(nil), "character.Limit")
+	proto.RegisterType((*Component)(nil), "character.Component")
+	proto.RegisterType((*Prop)(nil), "character.Prop")
+	proto.RegisterType((*Outfit)(nil), "character.Outfit")
+	proto.RegisterType((*Outfits)(nil), "character.Outfits")
+	proto.RegisterType((*HeadBlend)(nil), "character.HeadBlend")
+	proto.RegisterType((*Appearance)(nil), "character.Appearance")
+	proto.RegisterType((*SetAppearanceRequest)(nil), "character.SetAppearanceRequest")
+	proto.RegisterType((*SetOutfitRequest)(nil), "character.SetOutfitRequest")
+	proto.RegisterType((*OutfitRequest)(nil), "character.OutfitRequest")
+}
+
+func init() { proto.RegisterFile("manager.proto", fileDescriptor_cde9ec64f0d2c859) }
+
+var fileDescriptor_cde9ec64f0d2c859 = []byte{
+	// 791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xe3, 0x24, 0x6d, 0xa6, 0x0d, 0x94, 0xa5, 0x54, 0x26, 0xe5, 0x27, 0x5a, 0x15, 0xa9,
+	0x08, 0x29, 0x95, 0x52, 0x5a, 0xc4, 0x09, 0x35, 0x09, 0x0d, 0x41, 0x2d, 0x20, 0x87, 0x5c, 0x10,
+	0x97, 0x6d, 0x32, 0x6d, 0xad, 0x26, 0xb6, 0xb1, 0x37, 0x90, 0xdc, 0x79, 0x11, 0x2e, 0x3c, 0x02,
+	0x77, 0xde, 0x0c, 0xed, 0x7a, 0x6d, 0x6f, 0x5a, 0x37, 0x3d, 0x94, 0x93, 0x3d, 0x33, 0xdf, 0xfc,
+	0x7d, 0x3b, 0xb3, 0x0b, 0x95, 0x31, 0x73, 0xd9, 0x19, 0x06, 0x75, 0x3f, 0xf0, 0xb8, 0x47, 0xca,
+	0x83, 0x73, 0x16, 0xb0, 0x01, 0xc7, 0xa0, 0xba, 0x79, 0xe6, 0x79, 0x67, 0x23, 0xdc, 0x91, 0x86,
+	0x93, 0xc9, 0xe9, 0x0e, 0x8e, 0x7d, 0x3e, 0x8b, 0x70, 0xb4, 0x06, 0xd0, 0x1d, 0xa2, 0xcb, 0x9d,
+	0x53, 0x07, 0x03, 0x42, 0xa0, 0xd0, 0xe9, 0x77, 0xdb, 0x96, 0x51, 0x33, 0xb6, 0xcb, 0xb6, 0xfc,
+	0xa7, 0x5b, 0x70, 0xa7, 0x1f, 0x62, 0x30, 0x8f, 0xea, 0x6b, 0x28, 0xf1, 0x4f, 0x7f, 0x1b, 0x00,
+	0x5d, 0x77, 0xe0, 0x8d, 0xfd, 0x11, 0x72, 0x24, 0x55, 0x58, 0x16, 0x4e, 0x1a, 0x2c, 0x91, 0xc9,
+	0x23, 0x28, 0x1f, 0x3a, 0x41, 0xc8, 0x3f, 0xb0, 0x31, 0x5a, 0x79, 0x69, 0x4c, 0x15, 0xc2, 0xf3,
+	0x88, 0x29, 0xa3, 0x19, 0x79, 0xc6, 0xb2, 0xf0, 0x6c, 0x3a, 0x01, 0x3f, 0x1f, 0x32, 0x8e, 0x56,
+	0xa1, 0x66, 0x6c, 0x9b, 0x76, 0xaa, 0x20, 0x5b, 0x50, 0xe9, 0xba, 0xdf, 0xd1, 0xe5, 0x5e, 0x30,
+	0x93, 0x5d, 0x14, 0xa5, 0xfb, 0xbc, 0x92, 0x4e, 0x61, 0xb9, 0x15, 0x57, 0xb9, 0xa7, 0x37, 0x2f,
+	0xeb, 0x5c, 0x69, 0x3c, 0xa8, 0x27, 0xcc, 0xd5, 0x53, 0xa3, 0xad, 0xb3, 0xb4, 0xa7, 0xb7, 0x6a,
+	0xe5, 0xaf, 0xba, 0x25, 0x46, 0x5b, 0x03, 0xd2, 0x03, 0x80, 0x56, 0x8c, 0x09, 0xc9, 0xae, 0x2e,
+	0x59, 0x46, 0xcd, 0xdc, 0x5e, 0x69, 0xdc, 0xd7, 0x82, 0xb4, 0x92, 0x10, 0x29, 0x8c, 0x3e, 0x86,
+	0xe2, 0x91, 0x33, 0x76, 0x38, 0x59, 0x57, 0x3f, 0xb2, 0x68, 0xd3, 0x8e, 0x04, 0x7a, 0x01, 0x65,
+	0xe1, 0xe6, 0xb9, 0xe8, 0x72, 0x52, 0x83, 0x95, 0x44, 0x50, 0xa7, 0x50, 0xb0, 0x75, 0x15, 0x79,
+	0x02, 0xd0, 0x0e, 0xd8, 0x0f, 0x76, 0x32, 0xc2, 0x6e, 0x5b, 0xf6, 0x51, 0xb0, 0x35, 0x8d, 0xa0,
+	0xfb, 0x33, 0x4e, 0xf9, 0x24, 0x10, 0x66, 0x53, 0x9a, 0x53, 0x05, 0xfd, 0x0a, 0x85, 0x4f, 0x81,
+	0xe7, 0x93, 0x0d, 0x28, 0x89, 0x6f, 0x92, 0x42, 0x49, 0xb7, 0x8c, 0x3e, 0x83, 0xd2, 0xc7, 0x09,
+	0x3f, 0x75, 0xb8, 0x98, 0x36, 0x39, 0x0c, 0x6a, 0xda, 0xc4, 0x3f, 0x79, 0x09, 0x90, 0x34, 0x12,
+	0x5a, 0x79, 0x49, 0xde, 0xfa, 0x25, 0xf2, 0xa4, 0xd1, 0xd6, 0x70, 0xe4, 0x19, 0x14, 0x45, 0x6d,
+	0xa1, 0x65, 0x4a, 0x87, 0xbb, 0x9a, 0x83, 0xd0, 0xdb, 0x91, 0x95, 0xee, 0xc3, 0x52, 0x94, 0x3a,
+	0x24, 0x2f, 0x92, 0x5f, 0x75, 0x42, 0xf7, 0x34, 0x9f, 0xc8, 0x62, 0xc7, 0x08, 0xfa, 0xd7, 0x80,
+	0xf2, 0x3b, 0x64, 0xc3, 0xe6, 0x08, 0xdd, 0xa1, 0x68, 0xbf, 0x77, 0xce, 0x7c, 0x94, 0x93, 0xad,
+	0xa8, 0xd1, 0x34, 0xe2, 0x78, 0xa4, 0xd4, 0xc3, 0x81, 0xe7, 0x0e, 0x15, 0x3f, 0xba, 0x4a, 0x10,
+	0xd4, 0xbb, 0x70, 0xdc, 0x28, 0x80, 0x22, 0x28, 0x51, 0xc8, 0xf8, 0x17, 0x8e, 0xab, 0xdc, 0x0b,
+	0x2a, 0x7e, 0xa2, 0x11, 0x7b, 0x24, 0x83, 0x1d, 0x3b, 0x53, 0xb9, 0x08, 0x86, 0x9d, 0xc8, 0xc4,
+	0x82, 0x25, 0x81, 0x14, 0xa6, 0x92, 0x34, 0xc5, 0x22, 0xfd, 0x65, 0x00, 0x1c, 0xf8, 0x3e, 0xb2,
+	0x80, 0xb9, 0x03, 0x24, 0x6b, 0x60, 0xf6, 0x70, 0xaa, 0xaa, 0x17, 0xbf, 0xa4, 0xa1, 0xf5, 0xa8,
+	0x46, 0x5f, 0x27, 0x3e, 0xb1, 0xd9, 0x1a, 0x15, 0x14, 0x56, 0x0f, 0xd9, 0x00, 0x0f, 0x91, 0x89,
+	0xc3, 0x8d, 0xe8, 0x37, 0xec, 0x39, 0x1d, 0x79, 0x1e, 0x9f, 0xb7, 0x6c, 0x25, 0x93, 0x68, 0x05,
+	0xa0, 0x3f, 0x0d, 0x58, 0xef, 0x21, 0x4f, 0xcb, 0xb4, 0xf1, 0xdb, 0x04, 0x43, 0x7e, 0x8b, 0x75,
+	0x4e, 0x63, 0x65, 0xac, 0xb3, 0x96, 0x48, 0x03, 0x52, 0x0e, 0x6b, 0x3d, 0xe4, 0xaa, 0xb6, 0xdb,
+	0x55, 0x90, 0x36, 0x9f, 0xbf, 0xa9, 0xf9, 0x2f, 0x50, 0xf9, 0x2f, 0x29, 0xe3, 0xad, 0xca, 0xa7,
+	0x5b, 0xd5, 0xf8, 0x53, 0x84, 0xa5, 0xe3, 0xe8, 0x15, 0x21, 0xbb, 0x60, 0x76, 0x90, 0x93, 0xec,
+	0x48, 0xd5, 0xac, 0x8b, 0x8a, 0xe6, 0xc8, 0x1b, 0x28, 0x77, 0x90, 0x37, 0x67, 0xe2, 0xaa, 0x27,
+	0x0f, 0x35, 0xcc, 0xfc, 0x03, 0x52, 0xd5, 0xa3, 0x6a, 0xb7, 0x5b, 0x8e, 0xec, 0x43, 0xa9, 0x15,
+	0x20, 0xe3, 0x48, 0xb2, 0xef, 0xd3, 0xeb, 0x12, 0xbf, 0x82, 0x52, 0xdf, 0x97, 0x8f, 0x40, 0x16,
+	0xa0, 0xba, 0x51, 0x8f, 0x9e, 0xc0, 0x7a, 0xfc, 0x04, 0xd6, 0xdf, 0x8a, 0x27, 0x90, 0xe6, 0xc8,
+	0x6b, 0x28, 0xb5, 0x71, 0x84, 0x97, 0x13, 0xa6, 0xa5, 0x2e, 0x72, 0x5d, 0xee, 0x20, 0x8f, 0xae,
+	0xe3, 0x05, 0xbd, 0xae, 0x69, 0xa6, 0xe8, 0x96, 0x16, 0x3c, 0x55, 0x3a, 0xfa, 0x00, 0x5f, 0x97,
+	0x3c, 0x7b, 0x0a, 0x69, 0x8e, 0xbc, 0x87, 0xca, 0xdc, 0x06, 0x90, 0xa7, 0x1a, 0x32, 0x6b, 0x37,
+	0x16, 0xf6, 0x01, 0x9d, 0x78, 0x8e, 0xc3, 0xeb, 0x2a, 0x21, 0x57, 0x26, 0x52, 0x1c, 0x57, 0x13,
+	0xca, 0xc9, 0x0a, 0x90, 0xcd, 0xf9, 0x12, 0xe6, 0xa6, 0x74, 0x41, 0xfa, 0x26, 0xac, 0x46, 0x27,
+	0xa0, 0xc2, 0x58, 0x57, 0x67, 0xff, 0xa6, 0x18, 0x27, 0x25, 0xa9, 0xd9, 0xfd, 0x37, 0x00, 0x22,
+	0xf7, 0x73, 0xeb, 0x04, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ManagerClient is the client API for Manager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ManagerClient interface {
+	Get(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Complete, error)
+	GetByUser(ctx context.Context, in *UserIdentifier, opts ...grpc.CallOption) (*Characters, error)
+	Create(ctx context.Context, in *Incomplete, opts ...grpc.CallOption) (*Complete, error)
+	Update(ctx context.Context, in *Complete, opts ...grpc.CallOption) (*empty.Empty, error)
+	Delete(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetLimit(ctx context.Context, in *UserIdentifier, opts ...grpc.CallOption) (*Limit, error)
+	GetAppearance(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Appearance, error)
+	SetAppearance(ctx context.Context, in *SetAppearanceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetOutfits(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Outfits, error)
+	SetOutfit(ctx context.Context, in *SetOutfitRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteOutfit(ctx context.Context, in *OutfitRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type managerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewManagerClient(cc *grpc.ClientConn) ManagerClient {
+	return &managerClient{cc}
+}
+
+func (c *managerClient) Get(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Complete, error) {
+	out := new(Complete)
+	err := c.cc.Invoke(ctx, "/character.Manager/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetByUser(ctx context.Context, in *UserIdentifier, opts ...grpc.CallOption) (*Characters, error) {
+	out := new(Characters)
+	err := c.cc.Invoke(ctx, "/character.Manager/GetByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Create(ctx context.Context, in *Incomplete, opts ...grpc.CallOption) (*Complete, error) {
+	out := new(Complete)
+	err := c.cc.Invoke(ctx, "/character.Manager/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Update(ctx context.Context, in *Complete, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/character.Manager/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Delete(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/character.Manager/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetLimit(ctx context.Context, in *UserIdentifier, opts ...grpc.CallOption) (*Limit, error) {
+	out := new(Limit)
+	err := c.cc.Invoke(ctx, "/character.Manager/GetLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetAppearance(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Appearance, error) {
+	out := new(Appearance)
+	err := c.cc.Invoke(ctx, "/character.Manager/GetAppearance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) SetAppearance(ctx context.Context, in *SetAppearanceRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/character.Manager/SetAppearance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetOutfits(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Outfits, error) {
+	out := new(Outfits)
+	err := c.cc.Invoke(ctx, "/character.Manager/GetOutfits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) SetOutfit(ctx context.Context, in *SetOutfitRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/character.Manager/SetOutfit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) DeleteOutfit(ctx context.Context, in *OutfitRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/character.Manager/DeleteOutfit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManagerServer is the server API for Manager service.
+type ManagerServer interface {
+	Get(context.Context, *Identifier) (*Complete, error)
+	GetByUser(context.Context, *UserIdentifier) (*Characters, error)
+	Create(context.Context, *Incomplete) (*Complete, error)
+	Update(context.Context, *Complete) (*empty.Empty, error)
+	Delete(context.Context, *Identifier) (*empty.Empty, error)
+	GetLimit(context.Context, *UserIdentifier) (*Limit, error)
+	GetAppearance(context.Context, *Identifier) (*Appearance, error)
+	SetAppearance(context.Context, *SetAppearanceRequest) (*empty.Empty, error)
+	GetOutfits(context.Context, *Identifier) (*Outfits, error)
+	SetOutfit(context.Context, *SetOutfitRequest) (*empty.Empty, error)
+	DeleteOutfit(context.Context, *OutfitRequest) (*empty.Empty, error)
+}
+
+func RegisterManagerServer(s *grpc.Server, srv ManagerServer) {
+	s.RegisterService(&_Manager_serviceDesc, srv)
+}
+
+func _Manager_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/character.Manager/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Get(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/character.Manager/GetByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetByUser(ctx, req.(*UserIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Incomplete)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/character.Manager/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Create(ctx, req.(*Incomplete))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Complete)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/character.Manager/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Update(ctx, req.(*Complete))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/character.Manager/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Delete(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/character.Manager/GetLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetLimit(ctx, req.(*UserIdentifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetAppearance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetAppearance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/character.Manager/GetAppearance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetAppearance(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_SetAppearance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAppearanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).SetAppearance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/character.Manager/SetAppearance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).SetAppearance(ctx, req.(*SetAppearanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetOutfits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetOutfits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/character.Manager/GetOutfits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetOutfits(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_SetOutfit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOutfitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).SetOutfit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/character.Manager/SetOutfit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).SetOutfit(ctx, req.(*SetOutfitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_DeleteOutfit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OutfitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).DeleteOutfit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/character.Manager/DeleteOutfit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).DeleteOutfit(ctx, req.(*OutfitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Manager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "character.Manager",
+	HandlerType: (*ManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Manager_Get_Handler,
+		},
+		{
+			MethodName: "GetByUser",
+			Handler:    _Manager_GetByUser_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Manager_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Manager_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Manager_Delete_Handler,
+		},
+		{
+			MethodName: "GetLimit",
+			Handler:    _Manager_GetLimit_Handler,
+		},
+		{
+			MethodName: "GetAppearance",
+			Handler:    _Manager_GetAppearance_Handler,
+		},
+		{
+			MethodName: "SetAppearance",
+			Handler:    _Manager_SetAppearance_Handler,
+		},
+		{
+			MethodName: "GetOutfits",
+			Handler:    _Manager_GetOutfits_Handler,
+		},
+		{
+			MethodName: "SetOutfit",
+			Handler:    _Manager_SetOutfit_Handler,
+		},
+		{
+			MethodName: "DeleteOutfit",
+			Handler:    _Manager_DeleteOutfit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "manager.proto",
+}
@@ -0,0 +1,40 @@
+package character
+
+//go:generate counterfeiter -o ./mocks/repository.go . Repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+// ErrLimitReached is returned by a repository if the user already owns as many characters as allowed
+var ErrLimitReached = errors.New("character limit reached")
+
+// Repository to manage the storage of characters.
+// Soft deleted characters are not found anymore, except by GetAllByUser.
+type Repository interface {
+	Get(context.Context, Identifier) (Complete, error)
+	// GetByUser returns the characters of a user ordered by their creation
+	GetByUser(context.Context, user.Identifier) ([]Complete, error)
+	// GetAllByUser returns the characters of a user including the soft deleted ones
+	GetAllByUser(context.Context, user.Identifier) ([]Complete, error)
+	// Create a character with the guid generated by the manager, unless
+	// the user already owns limit characters. The characters are counted
+	// atomically with the insert, so concurrent creations keep the limit.
+	Create(context.Context, Identifier, Incomplete, int) (Complete, error)
+	// Update the names and the birthdate of a character
+	Update(context.Context, Complete) error
+	// Delete a character softly
+	Delete(context.Context, Identifier) error
+	// Purge a character, whether it is soft deleted or not, with its appearance and outfits
+	Purge(context.Context, Identifier) error
+	GetAppearance(context.Context, Identifier) (*Appearance, error)
+	SetAppearance(context.Context, Identifier, *Appearance) error
+	// GetOutfits returns the saved outfits of a character ordered by their name
+	GetOutfits(context.Context, Identifier) ([]*Outfit, error)
+	// SetOutfit saves an outfit, replacing the outfit with the same name
+	SetOutfit(context.Context, Identifier, *Outfit) error
+	DeleteOutfit(context.Context, Identifier, string) error
+}
@@ -0,0 +1,381 @@
+// Package repositorytest contains the conformance tests of character repositories
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/character"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) character.Repository) {
+	t.Run("Create", func(t *testing.T) {
+		testCreate(t, newRepository(t))
+	})
+	t.Run("GetByUser", func(t *testing.T) {
+		testGetByUser(t, newRepository(t))
+	})
+	t.Run("Update", func(t *testing.T) {
+		testUpdate(t, newRepository(t))
+	})
+	t.Run("Delete", func(t *testing.T) {
+		testDelete(t, newRepository(t))
+	})
+	t.Run("Appearance", func(t *testing.T) {
+		testAppearance(t, newRepository(t))
+	})
+	t.Run("Outfits", func(t *testing.T) {
+		testOutfits(t, newRepository(t))
+	})
+}
+
+type userIdentifier string
+
+func (i userIdentifier) UUID() string {
+	return string(i)
+}
+
+func randomUUID(t *testing.T) string {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return rand.String()
+}
+
+var birthdate = time.Date(1990, time.March, 4, 0, 0, 0, 0, time.UTC)
+
+// limit of characters per user, high enough to not be reached by the tests
+const limit = 10
+
+func newIncomplete(t *testing.T, userUUID, firstName string) character.Incomplete {
+	inc := character.NewIncomplete(userUUID, firstName, "Doe", birthdate)
+	inc.Data().InventoryGUID = randomUUID(t)
+	return inc
+}
+
+func testCreate(t *testing.T, r character.Repository) {
+	ctx := context.Background()
+
+	if _, err := r.Get(ctx, character.NewIdentifier(randomUUID(t))); err != sql.ErrNoRows {
+		t.Fatal("an unknown character should not be found")
+	}
+
+	inc := newIncomplete(t, randomUUID(t), "John")
+	id := character.NewIdentifier(randomUUID(t))
+	first, err := r.Create(ctx, id, inc, limit)
+	if err != nil || first.GUID() != id.GUID() {
+		t.Fatal("the character should be created with the given guid")
+	}
+
+	second, err := r.Create(ctx, character.NewIdentifier(randomUUID(t)), newIncomplete(t, inc.Data().UserUUID, "John"), limit)
+	if err != nil {
+		t.Fatal("the name of a character does not have to be unique")
+	}
+
+	if first.GUID() == "" || first.GUID() == second.GUID() {
+		t.Fatal("the created characters should have distinct ids")
+	}
+
+	if _, err := r.Create(ctx, character.NewIdentifier(randomUUID(t)), newIncomplete(t, inc.Data().UserUUID, "Jane"), 2); err != character.ErrLimitReached {
+		t.Fatal("the character should not be created if the user reached the limit")
+	}
+
+	if _, err := r.Create(ctx, character.NewIdentifier(randomUUID(t)), newIncomplete(t, randomUUID(t), "Jane"), 1); err != nil {
+		t.Fatal("the characters of other users should not count towards the limit")
+	}
+
+	if err := r.Delete(ctx, second); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Create(ctx, character.NewIdentifier(randomUUID(t)), newIncomplete(t, inc.Data().UserUUID, "Jane"), 2); err != nil {
+		t.Fatal("soft deleted characters should not count towards the limit")
+	}
+
+	c, err := r.Get(ctx, first)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.GUID() != first.GUID() ||
+		c.Data().UserUUID != inc.Data().UserUUID ||
+		c.Data().FirstName != "John" ||
+		c.Data().LastName != "Doe" ||
+		!c.Data().Birthdate.Equal(birthdate) ||
+		c.Data().InventoryGUID != inc.Data().InventoryGUID {
+		t.Fatal("the stored data is not equal")
+	}
+}
+
+func testGetByUser(t *testing.T, r character.Repository) {
+	ctx := context.Background()
+
+	owner := userIdentifier(randomUUID(t))
+
+	characters, err := r.GetByUser(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(characters) != 0 {
+		t.Fatal("a user without characters should have an empty list")
+	}
+
+	first, err := r.Create(ctx, character.NewIdentifier(randomUUID(t)), newIncomplete(t, owner.UUID(), "John"), limit)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	second, err := r.Create(ctx, character.NewIdentifier(randomUUID(t)), newIncomplete(t, owner.UUID(), "Jane"), limit)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Create(ctx, character.NewIdentifier(randomUUID(t)), newIncomplete(t, randomUUID(t), "Jack"), limit); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	characters, err = r.GetByUser(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(characters) != 2 || characters[0].GUID() != first.GUID() || characters[1].GUID() != second.GUID() {
+		t.Fatal("the characters of the user should be ordered by their creation")
+	}
+}
+
+func testUpdate(t *testing.T, r character.Repository) {
+	ctx := context.Background()
+
+	inc := newIncomplete(t, randomUUID(t), "John")
+	c, err := r.Create(ctx, character.NewIdentifier(randomUUID(t)), inc, limit)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	updated := newIncomplete(t, randomUUID(t), "Jane")
+	updated.Data().LastName = "Roe"
+	updated.Data().Birthdate = birthdate.AddDate(1, 0, 0)
+
+	if err := r.Update(ctx, &complete{c, updated}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	stored, err := r.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if stored.Data().FirstName != "Jane" || stored.Data().LastName != "Roe" || !stored.Data().Birthdate.Equal(birthdate.AddDate(1, 0, 0)) {
+		t.Fatal("the names and the birthdate should be updated")
+	}
+
+	if stored.Data().UserUUID != inc.Data().UserUUID || stored.Data().InventoryGUID != inc.Data().InventoryGUID {
+		t.Fatal("the owner and the inventory of a character should never change")
+	}
+}
+
+type complete struct {
+	character.Identifier
+	character.Incomplete
+}
+
+func testDelete(t *testing.T, r character.Repository) {
+	ctx := context.Background()
+
+	owner := userIdentifier(randomUUID(t))
+
+	if err := r.Delete(ctx, character.NewIdentifier(randomUUID(t))); err != nil {
+		t.Fatal("deleting an unknown character should not fail")
+	}
+
+	c, err := r.Create(ctx, character.NewIdentifier(randomUUID(t)), newIncomplete(t, owner.UUID(), "John"), limit)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Delete(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Get(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("a soft deleted character should not be found")
+	}
+
+	characters, err := r.GetByUser(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(characters) != 0 {
+		t.Fatal("a soft deleted character should not be listed")
+	}
+
+	characters, err = r.GetAllByUser(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(characters) != 1 || characters[0].GUID() != c.GUID() {
+		t.Fatal("a soft deleted character should be kept")
+	}
+
+	if err := r.Purge(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	characters, err = r.GetAllByUser(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(characters) != 0 {
+		t.Fatal("a purged character should be removed")
+	}
+}
+
+func newOutfit(name string, drawableID uint64) *character.Outfit {
+	return &character.Outfit{
+		Name: name,
+		Components: []*character.Component{
+			{ComponentID: 4, DrawableID: drawableID, TextureID: 1},
+		},
+		Props: []*character.Prop{
+			{PropID: 0, DrawableID: drawableID, TextureID: 2},
+		},
+	}
+}
+
+func testAppearance(t *testing.T, r character.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, character.NewIdentifier(randomUUID(t)), newIncomplete(t, randomUUID(t), "John"), limit)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.GetAppearance(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("a character without an appearance should have none")
+	}
+
+	a := &character.Appearance{
+		Sex: character.SexFemale,
+		HeadBlend: character.HeadBlend{
+			ShapeFirst:  1,
+			ShapeSecond: 2,
+			SkinFirst:   3,
+			SkinSecond:  4,
+			ShapeMix:    0.5,
+			SkinMix:     0.25,
+		},
+		FaceFeatures: []float64{-1, 0, 0.5, 1},
+		Outfit:       newOutfit("", 5),
+	}
+
+	if err := r.SetAppearance(ctx, c, a); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	a.Outfit.Components[0].DrawableID = 6
+	if err := r.SetAppearance(ctx, c, a); err != nil {
+		t.Fatal("setting an appearance again should replace it")
+	}
+
+	stored, err := r.GetAppearance(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if stored.Sex != a.Sex || stored.HeadBlend != a.HeadBlend || len(stored.FaceFeatures) != 4 || stored.FaceFeatures[2] != 0.5 {
+		t.Fatal("the stored appearance is not equal")
+	}
+
+	if len(stored.Outfit.Components) != 1 || *stored.Outfit.Components[0] != *a.Outfit.Components[0] ||
+		len(stored.Outfit.Props) != 1 || *stored.Outfit.Props[0] != *a.Outfit.Props[0] {
+		t.Fatal("the stored outfit is not equal")
+	}
+
+	if err := r.Purge(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.GetAppearance(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("the appearance of a purged character should be removed")
+	}
+}
+
+func testOutfits(t *testing.T, r character.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, character.NewIdentifier(randomUUID(t)), newIncomplete(t, randomUUID(t), "John"), limit)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	outfits, err := r.GetOutfits(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(outfits) != 0 {
+		t.Fatal("a character without outfits should have an empty list")
+	}
+
+	for _, v := range []*character.Outfit{newOutfit("work", 1), newOutfit("casual", 2), newOutfit("work", 3)} {
+		if err := r.SetOutfit(ctx, c, v); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	outfits, err = r.GetOutfits(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(outfits) != 2 || outfits[0].Name != "casual" || outfits[1].Name != "work" {
+		t.Fatal("the outfits should be ordered by their name and replaced by their name")
+	}
+
+	if outfits[1].Components[0].DrawableID != 3 {
+		t.Fatal("the outfit should be replaced")
+	}
+
+	if err := r.DeleteOutfit(ctx, c, "unknown"); err != nil {
+		t.Fatal("deleting an unknown outfit should not fail")
+	}
+
+	if err := r.DeleteOutfit(ctx, c, "work"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	outfits, err = r.GetOutfits(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(outfits) != 1 || outfits[0].Name != "casual" {
+		t.Fatal("the outfit should be deleted")
+	}
+
+	if err := r.Purge(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	outfits, err = r.GetOutfits(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(outfits) != 0 {
+		t.Fatal("the outfits of a purged character should be removed")
+	}
+}
@@ -0,0 +1,36 @@
+package character
+
+import "github.com/51st-state/api/pkg/rbac"
+
+// rules enforced by the character service.
+// Users may always get, update and delete their own characters.
+const (
+	ruleGet    rbac.Rule = "characters.get"
+	ruleList   rbac.Rule = "characters.list"
+	ruleUpdate rbac.Rule = "characters.update"
+	ruleDelete rbac.Rule = "characters.delete"
+)
+
+// DefaultLimit of characters a user may own without any limit rule
+const DefaultLimit = 1
+
+// limitRules grant users to own more characters than the DefaultLimit.
+// The limit of a user is the highest one granted.
+var limitRules = map[rbac.Rule]int{
+	"characters.limit.2": 2,
+	"characters.limit.3": 3,
+	"characters.limit.4": 4,
+	"characters.limit.5": 5,
+}
+
+// Rules enforced by the character service
+var Rules = rbac.RuleCatalog{
+	{Rule: ruleGet, Description: "Get any character", Service: "character"},
+	{Rule: ruleList, Description: "List the characters of any user", Service: "character"},
+	{Rule: ruleUpdate, Description: "Update any character", Service: "character"},
+	{Rule: ruleDelete, Description: "Delete any character", Service: "character"},
+	{Rule: "characters.limit.2", Description: "Own up to 2 characters", Service: "character"},
+	{Rule: "characters.limit.3", Description: "Own up to 3 characters", Service: "character"},
+	{Rule: "characters.limit.4", Description: "Own up to 4 characters", Service: "character"},
+	{Rule: "characters.limit.5", Description: "Own up to 5 characters", Service: "character"},
+}
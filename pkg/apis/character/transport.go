@@ -0,0 +1,250 @@
+package character
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/51st-state/api/pkg/api/endpoint"
+	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/encode"
+	"github.com/51st-state/api/pkg/problems"
+	"github.com/51st-state/api/pkg/rbac"
+	rbacMiddleware "github.com/51st-state/api/pkg/rbac/middleware"
+	"github.com/51st-state/api/pkg/token"
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+)
+
+var (
+	errNoUserToken            = errors.New("the token does not belong to a user")
+	errInsufficientPermission = problems.New("insufficient permissions", "the account is not allowed to access this character", http.StatusForbidden)
+)
+
+// tokenUser returns the user authenticated by the token of a context
+func tokenUser(ctx context.Context) (user.Identifier, error) {
+	tok, err := token.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if tok.Data().User == nil || tok.Data().User.Type != "user" {
+		return nil, errNoUserToken
+	}
+
+	return &userIdentifier{tok.Data().User.ID}, nil
+}
+
+// authorize returns the character if it is owned by the user of the token
+// or if the acting account has access to the rule
+func authorize(ctx context.Context, m Manager, rb rbac.Control, id Identifier, rule rbac.Rule) (Complete, error) {
+	c, err := m.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if u, err := tokenUser(ctx); err == nil && u.UUID() == c.Data().UserUUID {
+		return c, nil
+	}
+
+	allowed, err := rb.IsAccountAllowed(ctx, rbac.ActorFromContext(ctx), rule)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed {
+		return nil, errInsufficientPermission
+	}
+
+	return c, nil
+}
+
+// MakeGetEndpoint creates a http endpoint to retrieve a character
+func MakeGetEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return authorize(ctx, m, rb, &identifier{chi.URLParam(r, "guid")}, ruleGet)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeGetOwnEndpoint creates a http endpoint to retrieve the characters of the user of the token
+func MakeGetOwnEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := tokenUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.GetByUser(ctx, id)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeGetByUserEndpoint creates a http endpoint to retrieve the characters of any user
+func MakeGetByUserEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetByUser(ctx, &userIdentifier{chi.URLParam(r, "uuid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleList)).
+		HandlerFunc(l)
+}
+
+// MakeGetLimitEndpoint creates a http endpoint to retrieve the character limit of the user of the token
+func MakeGetLimitEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := tokenUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		limit, err := m.GetLimit(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return struct {
+			Limit int `json:"limit"`
+		}{limit}, nil
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeCreateEndpoint creates a http endpoint to create a character for the user of the token
+func MakeCreateEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := tokenUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		inc := &data{}
+		if err := json.NewDecoder(r.Body).Decode(inc); err != nil {
+			return nil, err
+		}
+
+		return m.Create(ctx, NewIncomplete(id.UUID(), inc.FirstName, inc.LastName, inc.Birthdate))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeUpdateEndpoint creates a http endpoint to update a character
+func MakeUpdateEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		c, err := authorize(ctx, m, rb, &identifier{chi.URLParam(r, "guid")}, ruleUpdate)
+		if err != nil {
+			return nil, err
+		}
+
+		// absent fields keep their stored value
+		if err := json.NewDecoder(r.Body).Decode(c.Data()); err != nil {
+			return nil, err
+		}
+
+		return struct{}{}, m.Update(ctx, c)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeDeleteEndpoint creates a http endpoint to delete a character softly
+func MakeDeleteEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		c, err := authorize(ctx, m, rb, &identifier{chi.URLParam(r, "guid")}, ruleDelete)
+		if err != nil {
+			return nil, err
+		}
+
+		return struct{}{}, m.Delete(ctx, c)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeGetAppearanceEndpoint creates a http endpoint to retrieve the appearance of a character
+func MakeGetAppearanceEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		c, err := authorize(ctx, m, rb, &identifier{chi.URLParam(r, "guid")}, ruleGet)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.GetAppearance(ctx, c)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeSetAppearanceEndpoint creates a http endpoint to set the appearance of a character
+func MakeSetAppearanceEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		c, err := authorize(ctx, m, rb, &identifier{chi.URLParam(r, "guid")}, ruleUpdate)
+		if err != nil {
+			return nil, err
+		}
+
+		a := &Appearance{}
+		if err := json.NewDecoder(r.Body).Decode(a); err != nil {
+			return nil, err
+		}
+
+		return struct{}{}, m.SetAppearance(ctx, c, a)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeGetOutfitsEndpoint creates a http endpoint to retrieve the saved outfits of a character
+func MakeGetOutfitsEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		c, err := authorize(ctx, m, rb, &identifier{chi.URLParam(r, "guid")}, ruleGet)
+		if err != nil {
+			return nil, err
+		}
+
+		return m.GetOutfits(ctx, c)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeSetOutfitEndpoint creates a http endpoint to save an outfit of a character
+func MakeSetOutfitEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		c, err := authorize(ctx, m, rb, &identifier{chi.URLParam(r, "guid")}, ruleUpdate)
+		if err != nil {
+			return nil, err
+		}
+
+		o := &Outfit{}
+		if err := json.NewDecoder(r.Body).Decode(o); err != nil {
+			return nil, err
+		}
+
+		o.Name = chi.URLParam(r, "name")
+
+		return struct{}{}, m.SetOutfit(ctx, c, o)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeDeleteOutfitEndpoint creates a http endpoint to delete a saved outfit of a character
+func MakeDeleteOutfitEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		c, err := authorize(ctx, m, rb, &identifier{chi.URLParam(r, "guid")}, ruleUpdate)
+		if err != nil {
+			return nil, err
+		}
+
+		return struct{}{}, m.DeleteOutfit(ctx, c, chi.URLParam(r, "name"))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
@@ -0,0 +1,76 @@
+package character
+
+import "time"
+
+//go:generate counterfeiter -o ./mocks/identifier.go . Identifier
+
+// Identifier of a character
+type Identifier interface {
+	GUID() string
+}
+
+type identifier struct {
+	guid string
+}
+
+// NewIdentifier creates a new identifier object
+func NewIdentifier(guid string) Identifier {
+	return &identifier{guid}
+}
+
+func (i *identifier) GUID() string {
+	return i.guid
+}
+
+// Provider provides methods for the incomplete character object
+type Provider interface {
+	Data() *data
+}
+
+// Incomplete represents an incomplete character object
+type Incomplete interface {
+	Provider
+}
+
+// Complete represents a complete character object
+type Complete interface {
+	Identifier
+	Incomplete
+}
+
+type complete struct {
+	Identifier
+	Incomplete
+}
+
+type data struct {
+	UserUUID  string    `json:"user_uuid"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	Birthdate time.Time `json:"birthdate"`
+	// InventoryGUID of the inventory created for the character
+	InventoryGUID string `json:"inventory_guid"`
+}
+
+// NewIncomplete creates a new incomplete character object owned by a user.
+// The inventory of the character is created by the manager.
+func NewIncomplete(userUUID, firstName, lastName string, birthdate time.Time) Incomplete {
+	return &data{
+		UserUUID:  userUUID,
+		FirstName: firstName,
+		LastName:  lastName,
+		Birthdate: birthdate,
+	}
+}
+
+func (d *data) Data() *data {
+	return d
+}
+
+type userIdentifier struct {
+	uuid string
+}
+
+func (i *userIdentifier) UUID() string {
+	return i.uuid
+}
@@ -0,0 +1,400 @@
+package cockroachdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/faction"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+// CreateSchema creates a new cockroachdb schema in a cockroachdb database for the faction service
+func CreateSchema(ctx context.Context, db *sql.DB) (err error) {
+	_, err = db.ExecContext(
+		ctx,
+		`CREATE TABLE IF NOT EXISTS factions (
+            guid UUID PRIMARY KEY,
+            name TEXT NOT NULL DEFAULT '',
+            description TEXT NOT NULL DEFAULT ''
+        );
+        CREATE UNIQUE INDEX IF NOT EXISTS factions_idx_guid ON factions (guid);
+
+        CREATE TABLE IF NOT EXISTS faction_ranks (
+            factionId UUID NOT NULL,
+            rankId TEXT NOT NULL,
+            name TEXT NOT NULL DEFAULT '',
+            level INTEGER NOT NULL,
+            roleId TEXT NOT NULL DEFAULT '',
+            permissions JSONB NOT NULL DEFAULT '[]',
+            PRIMARY KEY (factionId, rankId)
+        );
+
+        CREATE TABLE IF NOT EXISTS faction_members (
+            factionId UUID NOT NULL,
+            accountId TEXT NOT NULL,
+            rankId TEXT NOT NULL,
+            PRIMARY KEY (factionId, accountId)
+        );
+
+        CREATE TABLE IF NOT EXISTS faction_invites (
+            factionId UUID NOT NULL,
+            accountId TEXT NOT NULL,
+            PRIMARY KEY (factionId, accountId)
+        );`,
+	)
+	return
+}
+
+type db struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new cockroachdb db storage repository
+func NewRepository(d *sql.DB) faction.Repository {
+	return &db{d}
+}
+
+func txError(tx *sql.Tx, err error) error {
+	if rErr := tx.Rollback(); rErr != nil {
+		return rErr
+	}
+
+	return err
+}
+
+func (d *db) Get(ctx context.Context, id faction.Identifier) (faction.Complete, error) {
+	c := &complete{
+		id,
+		faction.NewIncomplete("", ""),
+	}
+	if err := d.db.QueryRowContext(
+		ctx,
+		`SELECT name,
+        description
+        FROM factions
+        WHERE guid = $1`,
+		id.GUID(),
+	).Scan(
+		&c.Data().Name,
+		&c.Data().Description,
+	); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (d *db) Create(ctx context.Context, inc faction.Incomplete) (faction.Complete, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := d.db.ExecContext(
+		ctx,
+		`INSERT INTO factions (
+            guid,
+            name,
+            description
+        ) SELECT $1,
+        $2,
+        $3`,
+		rand.String(),
+		inc.Data().Name,
+		inc.Data().Description,
+	); err != nil {
+		return nil, err
+	}
+
+	return &complete{
+		faction.NewIdentifier(rand.String()),
+		inc,
+	}, nil
+}
+
+func (d *db) Update(ctx context.Context, c faction.Complete) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`UPDATE factions
+        SET name = $1,
+        description = $2
+        WHERE guid = $3`,
+		c.Data().Name,
+		c.Data().Description,
+		c.GUID(),
+	)
+	return err
+}
+
+func (d *db) Delete(ctx context.Context, id faction.Identifier) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, query := range []string{
+		`DELETE FROM faction_invites WHERE factionId = $1`,
+		`DELETE FROM faction_members WHERE factionId = $1`,
+		`DELETE FROM faction_ranks WHERE factionId = $1`,
+		`DELETE FROM factions WHERE guid = $1`,
+	} {
+		if _, err := tx.ExecContext(ctx, query, id.GUID()); err != nil {
+			return txError(tx, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (d *db) GetRanks(ctx context.Context, id faction.Identifier) ([]*faction.Rank, error) {
+	rows, err := d.db.QueryContext(
+		ctx,
+		`SELECT rankId,
+        name,
+        level,
+        roleId,
+        permissions
+        FROM faction_ranks
+        WHERE factionId = $1
+        ORDER BY level`,
+		id.GUID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ranks := make([]*faction.Rank, 0)
+	for rows.Next() {
+		rank := &faction.Rank{}
+		var permissions []byte
+		if err := rows.Scan(
+			&rank.ID,
+			&rank.Name,
+			&rank.Level,
+			&rank.RoleID,
+			&permissions,
+		); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(permissions, &rank.Permissions); err != nil {
+			return nil, err
+		}
+
+		ranks = append(ranks, rank)
+	}
+
+	return ranks, rows.Err()
+}
+
+func (d *db) SetRank(ctx context.Context, id faction.Identifier, rank *faction.Rank) error {
+	permissions, err := json.Marshal(append(make([]faction.Permission, 0), rank.Permissions...))
+	if err != nil {
+		return err
+	}
+
+	_, err = d.db.ExecContext(
+		ctx,
+		`UPSERT INTO faction_ranks (
+            factionId,
+            rankId,
+            name,
+            level,
+            roleId,
+            permissions
+        ) VALUES ($1, $2, $3, $4, $5, $6)`,
+		id.GUID(),
+		rank.ID,
+		rank.Name,
+		rank.Level,
+		rank.RoleID,
+		permissions,
+	)
+	return err
+}
+
+func (d *db) DeleteRank(ctx context.Context, id faction.Identifier, rankID string) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`DELETE FROM faction_ranks
+        WHERE factionId = $1
+        AND rankId = $2`,
+		id.GUID(),
+		rankID,
+	)
+	return err
+}
+
+func (d *db) GetMembers(ctx context.Context, id faction.Identifier) ([]*faction.Member, error) {
+	rows, err := d.db.QueryContext(
+		ctx,
+		`SELECT accountId,
+        rankId
+        FROM faction_members
+        WHERE factionId = $1
+        ORDER BY accountId`,
+		id.GUID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := make([]*faction.Member, 0)
+	for rows.Next() {
+		member := &faction.Member{}
+		if err := rows.Scan(
+			&member.AccountID,
+			&member.RankID,
+		); err != nil {
+			return nil, err
+		}
+
+		members = append(members, member)
+	}
+
+	return members, rows.Err()
+}
+
+func (d *db) GetMember(ctx context.Context, id faction.Identifier, accountID rbac.AccountID) (*faction.Member, error) {
+	member := &faction.Member{
+		AccountID: accountID,
+	}
+	if err := d.db.QueryRowContext(
+		ctx,
+		`SELECT rankId
+        FROM faction_members
+        WHERE factionId = $1
+        AND accountId = $2`,
+		id.GUID(),
+		accountID,
+	).Scan(
+		&member.RankID,
+	); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
+func (d *db) SetMember(ctx context.Context, id faction.Identifier, member *faction.Member) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`UPSERT INTO faction_members (
+            factionId,
+            accountId,
+            rankId
+        ) VALUES ($1, $2, $3)`,
+		id.GUID(),
+		member.AccountID,
+		member.RankID,
+	)
+	return err
+}
+
+func (d *db) RemoveMember(ctx context.Context, id faction.Identifier, accountID rbac.AccountID) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`DELETE FROM faction_members
+        WHERE factionId = $1
+        AND accountId = $2`,
+		id.GUID(),
+		accountID,
+	)
+	return err
+}
+
+func (d *db) GetInvites(ctx context.Context, id faction.Identifier) ([]rbac.AccountID, error) {
+	rows, err := d.db.QueryContext(
+		ctx,
+		`SELECT accountId
+        FROM faction_invites
+        WHERE factionId = $1
+        ORDER BY accountId`,
+		id.GUID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	invites := make([]rbac.AccountID, 0)
+	for rows.Next() {
+		var accountID rbac.AccountID
+		if err := rows.Scan(&accountID); err != nil {
+			return nil, err
+		}
+
+		invites = append(invites, accountID)
+	}
+
+	return invites, rows.Err()
+}
+
+func (d *db) AddInvite(ctx context.Context, id faction.Identifier, accountID rbac.AccountID) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`UPSERT INTO faction_invites (
+            factionId,
+            accountId
+        ) VALUES ($1, $2)`,
+		id.GUID(),
+		accountID,
+	)
+	return err
+}
+
+func (d *db) RemoveInvite(ctx context.Context, id faction.Identifier, accountID rbac.AccountID) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`DELETE FROM faction_invites
+        WHERE factionId = $1
+        AND accountId = $2`,
+		id.GUID(),
+		accountID,
+	)
+	return err
+}
+
+func (d *db) GetMemberships(ctx context.Context, accountID rbac.AccountID) ([]*faction.Membership, error) {
+	rows, err := d.db.QueryContext(
+		ctx,
+		`SELECT factionId::STRING,
+        rankId,
+        false AS invited
+        FROM faction_members
+        WHERE accountId = $1
+        UNION ALL
+        SELECT factionId::STRING,
+        '' AS rankId,
+        true AS invited
+        FROM faction_invites
+        WHERE accountId = $1
+        ORDER BY 1, 3`,
+		accountID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	memberships := make([]*faction.Membership, 0)
+	for rows.Next() {
+		membership := &faction.Membership{}
+		if err := rows.Scan(
+			&membership.FactionGUID,
+			&membership.RankID,
+			&membership.Invited,
+		); err != nil {
+			return nil, err
+		}
+
+		memberships = append(memberships, membership)
+	}
+
+	return memberships, rows.Err()
+}
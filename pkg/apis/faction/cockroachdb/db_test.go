@@ -0,0 +1,16 @@
+package cockroachdb_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/faction"
+	"github.com/51st-state/api/pkg/apis/faction/cockroachdb"
+	"github.com/51st-state/api/pkg/apis/faction/repositorytest"
+	"github.com/51st-state/api/test"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) faction.Repository {
+		return cockroachdb.NewRepository(test.NewCockroachDB(t, cockroachdb.CreateSchema))
+	})
+}
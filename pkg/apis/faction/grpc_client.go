@@ -0,0 +1,200 @@
+package faction
+
+import (
+	"context"
+
+	pb "github.com/51st-state/api/pkg/apis/faction/proto"
+	"github.com/51st-state/api/pkg/rbac"
+	"google.golang.org/grpc"
+)
+
+type grpcClient struct {
+	client pb.ManagerClient
+}
+
+// NewGRPCClient creates a new grpc client for the faction manager.
+// The acting account of a context is forwarded to the server.
+func NewGRPCClient(c *grpc.ClientConn) Manager {
+	return &grpcClient{
+		pb.NewManagerClient(c),
+	}
+}
+
+func (g *grpcClient) Get(ctx context.Context, id Identifier) (Complete, error) {
+	resp, err := g.client.Get(ctx, &pb.Identifier{
+		GUID: id.GUID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &complete{
+		&identifier{
+			resp.GetIdentifier().GetGUID(),
+		},
+		NewIncomplete(
+			resp.GetIncomplete().GetName(),
+			resp.GetIncomplete().GetDescription(),
+		),
+	}, nil
+}
+
+func (g *grpcClient) Create(ctx context.Context, inc Incomplete) (Complete, error) {
+	resp, err := g.client.Create(ctx, &pb.Incomplete{
+		Name:        inc.Data().Name,
+		Description: inc.Data().Description,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &complete{
+		&identifier{
+			resp.GetIdentifier().GetGUID(),
+		},
+		inc,
+	}, nil
+}
+
+func (g *grpcClient) Update(ctx context.Context, c Complete) error {
+	_, err := g.client.Update(ctx, &pb.Complete{
+		Identifier: &pb.Identifier{
+			GUID: c.GUID(),
+		},
+		Incomplete: &pb.Incomplete{
+			Name:        c.Data().Name,
+			Description: c.Data().Description,
+		},
+	})
+	return err
+}
+
+func (g *grpcClient) Delete(ctx context.Context, id Identifier) error {
+	_, err := g.client.Delete(rbac.ActorToOutgoingContext(ctx), &pb.Identifier{
+		GUID: id.GUID(),
+	})
+	return err
+}
+
+func (g *grpcClient) GetRanks(ctx context.Context, id Identifier) ([]*Rank, error) {
+	resp, err := g.client.GetRanks(ctx, &pb.Identifier{
+		GUID: id.GUID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ranks := make([]*Rank, 0)
+	for _, v := range resp.GetRanks() {
+		ranks = append(ranks, rankFromGRPC(v))
+	}
+
+	return ranks, nil
+}
+
+func (g *grpcClient) SetRank(ctx context.Context, id Identifier, rank *Rank) error {
+	_, err := g.client.SetRank(rbac.ActorToOutgoingContext(ctx), &pb.SetRankRequest{
+		Identifier: &pb.Identifier{
+			GUID: id.GUID(),
+		},
+		Rank: rankToGRPC(rank),
+	})
+	return err
+}
+
+func (g *grpcClient) DeleteRank(ctx context.Context, id Identifier, rankID string) error {
+	_, err := g.client.DeleteRank(ctx, &pb.RankRequest{
+		Identifier: &pb.Identifier{
+			GUID: id.GUID(),
+		},
+		RankID: rankID,
+	})
+	return err
+}
+
+func (g *grpcClient) GetMembers(ctx context.Context, id Identifier) ([]*Member, error) {
+	resp, err := g.client.GetMembers(ctx, &pb.Identifier{
+		GUID: id.GUID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*Member, 0)
+	for _, v := range resp.GetMembers() {
+		members = append(members, &Member{
+			AccountID: rbac.AccountID(v.GetAccountID()),
+			RankID:    v.GetRankID(),
+		})
+	}
+
+	return members, nil
+}
+
+func (g *grpcClient) SetMember(ctx context.Context, id Identifier, member *Member) error {
+	_, err := g.client.SetMember(rbac.ActorToOutgoingContext(ctx), &pb.SetMemberRequest{
+		Identifier: &pb.Identifier{
+			GUID: id.GUID(),
+		},
+		Member: &pb.Member{
+			AccountID: string(member.AccountID),
+			RankID:    member.RankID,
+		},
+	})
+	return err
+}
+
+func (g *grpcClient) RemoveMember(ctx context.Context, id Identifier, accountID rbac.AccountID) error {
+	_, err := g.client.RemoveMember(rbac.ActorToOutgoingContext(ctx), grpcAccountRequest(id, accountID))
+	return err
+}
+
+func (g *grpcClient) Sync(ctx context.Context, id Identifier) error {
+	_, err := g.client.Sync(rbac.ActorToOutgoingContext(ctx), &pb.Identifier{
+		GUID: id.GUID(),
+	})
+	return err
+}
+
+func (g *grpcClient) Invite(ctx context.Context, id Identifier, accountID rbac.AccountID) error {
+	_, err := g.client.Invite(rbac.ActorToOutgoingContext(ctx), grpcAccountRequest(id, accountID))
+	return err
+}
+
+func (g *grpcClient) Promote(ctx context.Context, id Identifier, accountID rbac.AccountID) error {
+	_, err := g.client.Promote(rbac.ActorToOutgoingContext(ctx), grpcAccountRequest(id, accountID))
+	return err
+}
+
+func (g *grpcClient) Demote(ctx context.Context, id Identifier, accountID rbac.AccountID) error {
+	_, err := g.client.Demote(rbac.ActorToOutgoingContext(ctx), grpcAccountRequest(id, accountID))
+	return err
+}
+
+func (g *grpcClient) Kick(ctx context.Context, id Identifier, accountID rbac.AccountID) error {
+	_, err := g.client.Kick(rbac.ActorToOutgoingContext(ctx), grpcAccountRequest(id, accountID))
+	return err
+}
+
+func (g *grpcClient) AcceptInvite(ctx context.Context, id Identifier) error {
+	_, err := g.client.AcceptInvite(rbac.ActorToOutgoingContext(ctx), &pb.Identifier{
+		GUID: id.GUID(),
+	})
+	return err
+}
+
+func (g *grpcClient) Leave(ctx context.Context, id Identifier) error {
+	_, err := g.client.Leave(rbac.ActorToOutgoingContext(ctx), &pb.Identifier{
+		GUID: id.GUID(),
+	})
+	return err
+}
+
+func grpcAccountRequest(id Identifier, accountID rbac.AccountID) *pb.AccountRequest {
+	return &pb.AccountRequest{
+		Identifier: &pb.Identifier{
+			GUID: id.GUID(),
+		},
+		AccountID: string(accountID),
+	}
+}
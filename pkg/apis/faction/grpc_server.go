@@ -0,0 +1,256 @@
+package faction
+
+import (
+	"context"
+
+	pb "github.com/51st-state/api/pkg/apis/faction/proto"
+	"github.com/51st-state/api/pkg/rbac"
+	"github.com/51st-state/api/pkg/token"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcServer struct {
+	manager Manager
+}
+
+// NewGRPCServer creates a new instance of a grpc server for managing factions.
+// The account of the token verified by the rbac.NewActorInterceptor is used for rank checks.
+func NewGRPCServer(m Manager) pb.ManagerServer {
+	return &grpcServer{m}
+}
+
+// memberContext acts as the account of the verified token of a request.
+// Member actions are authorized by the rank of the acting account,
+// so an actor set explicitly by a peer is not accepted.
+func memberContext(ctx context.Context) (context.Context, error) {
+	tok, err := token.FromContext(ctx)
+	if err != nil || tok.Data().User == nil {
+		return nil, status.New(codes.Unauthenticated, "member actions require the token of the acting account").Err()
+	}
+
+	return rbac.ActorToContext(ctx, rbac.AccountID(tok.Data().User.String())), nil
+}
+
+func (g *grpcServer) Get(ctx context.Context, id *pb.Identifier) (*pb.Complete, error) {
+	c, err := g.manager.Get(ctx, &identifier{id.GetGUID()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Complete{
+		Identifier: id,
+		Incomplete: &pb.Incomplete{
+			Name:        c.Data().Name,
+			Description: c.Data().Description,
+		},
+	}, nil
+}
+
+func (g *grpcServer) Create(ctx context.Context, inc *pb.Incomplete) (*pb.Complete, error) {
+	c, err := g.manager.Create(ctx, NewIncomplete(inc.GetName(), inc.GetDescription()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Complete{
+		Identifier: &pb.Identifier{
+			GUID: c.GUID(),
+		},
+		Incomplete: &pb.Incomplete{
+			Name:        c.Data().Name,
+			Description: c.Data().Description,
+		},
+	}, nil
+}
+
+func (g *grpcServer) Update(ctx context.Context, c *pb.Complete) (*empty.Empty, error) {
+	return &empty.Empty{}, g.manager.Update(ctx, &complete{
+		&identifier{
+			c.GetIdentifier().GetGUID(),
+		},
+		NewIncomplete(
+			c.GetIncomplete().GetName(),
+			c.GetIncomplete().GetDescription(),
+		),
+	})
+}
+
+func (g *grpcServer) Delete(ctx context.Context, id *pb.Identifier) (*empty.Empty, error) {
+	return &empty.Empty{}, g.manager.Delete(ctx, &identifier{id.GetGUID()})
+}
+
+func (g *grpcServer) GetRanks(ctx context.Context, id *pb.Identifier) (*pb.Ranks, error) {
+	ranks, err := g.manager.GetRanks(ctx, &identifier{id.GetGUID()})
+	if err != nil {
+		return nil, err
+	}
+
+	grpcRanks := &pb.Ranks{
+		Ranks: make([]*pb.Rank, 0),
+	}
+	for _, v := range ranks {
+		grpcRanks.Ranks = append(grpcRanks.Ranks, rankToGRPC(v))
+	}
+
+	return grpcRanks, nil
+}
+
+func (g *grpcServer) SetRank(ctx context.Context, req *pb.SetRankRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, g.manager.SetRank(
+		ctx,
+		&identifier{req.GetIdentifier().GetGUID()},
+		rankFromGRPC(req.GetRank()),
+	)
+}
+
+func (g *grpcServer) DeleteRank(ctx context.Context, req *pb.RankRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, g.manager.DeleteRank(
+		ctx,
+		&identifier{req.GetIdentifier().GetGUID()},
+		req.GetRankID(),
+	)
+}
+
+func (g *grpcServer) GetMembers(ctx context.Context, id *pb.Identifier) (*pb.Members, error) {
+	members, err := g.manager.GetMembers(ctx, &identifier{id.GetGUID()})
+	if err != nil {
+		return nil, err
+	}
+
+	grpcMembers := &pb.Members{
+		Members: make([]*pb.Member, 0),
+	}
+	for _, v := range members {
+		grpcMembers.Members = append(grpcMembers.Members, &pb.Member{
+			AccountID: string(v.AccountID),
+			RankID:    v.RankID,
+		})
+	}
+
+	return grpcMembers, nil
+}
+
+func (g *grpcServer) SetMember(ctx context.Context, req *pb.SetMemberRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, g.manager.SetMember(
+		ctx,
+		&identifier{req.GetIdentifier().GetGUID()},
+		&Member{
+			AccountID: rbac.AccountID(req.GetMember().GetAccountID()),
+			RankID:    req.GetMember().GetRankID(),
+		},
+	)
+}
+
+func (g *grpcServer) RemoveMember(ctx context.Context, req *pb.AccountRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, g.manager.RemoveMember(
+		ctx,
+		&identifier{req.GetIdentifier().GetGUID()},
+		rbac.AccountID(req.GetAccountID()),
+	)
+}
+
+func (g *grpcServer) Sync(ctx context.Context, id *pb.Identifier) (*empty.Empty, error) {
+	return &empty.Empty{}, g.manager.Sync(ctx, &identifier{id.GetGUID()})
+}
+
+func (g *grpcServer) Invite(ctx context.Context, req *pb.AccountRequest) (*empty.Empty, error) {
+	ctx, err := memberContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, g.manager.Invite(
+		ctx,
+		&identifier{req.GetIdentifier().GetGUID()},
+		rbac.AccountID(req.GetAccountID()),
+	)
+}
+
+func (g *grpcServer) Promote(ctx context.Context, req *pb.AccountRequest) (*empty.Empty, error) {
+	ctx, err := memberContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, g.manager.Promote(
+		ctx,
+		&identifier{req.GetIdentifier().GetGUID()},
+		rbac.AccountID(req.GetAccountID()),
+	)
+}
+
+func (g *grpcServer) Demote(ctx context.Context, req *pb.AccountRequest) (*empty.Empty, error) {
+	ctx, err := memberContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, g.manager.Demote(
+		ctx,
+		&identifier{req.GetIdentifier().GetGUID()},
+		rbac.AccountID(req.GetAccountID()),
+	)
+}
+
+func (g *grpcServer) Kick(ctx context.Context, req *pb.AccountRequest) (*empty.Empty, error) {
+	ctx, err := memberContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, g.manager.Kick(
+		ctx,
+		&identifier{req.GetIdentifier().GetGUID()},
+		rbac.AccountID(req.GetAccountID()),
+	)
+}
+
+func (g *grpcServer) AcceptInvite(ctx context.Context, id *pb.Identifier) (*empty.Empty, error) {
+	ctx, err := memberContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, g.manager.AcceptInvite(ctx, &identifier{id.GetGUID()})
+}
+
+func (g *grpcServer) Leave(ctx context.Context, id *pb.Identifier) (*empty.Empty, error) {
+	ctx, err := memberContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, g.manager.Leave(ctx, &identifier{id.GetGUID()})
+}
+
+func rankToGRPC(rank *Rank) *pb.Rank {
+	grpcRank := &pb.Rank{
+		ID:          rank.ID,
+		Name:        rank.Name,
+		Level:       int64(rank.Level),
+		RoleID:      string(rank.RoleID),
+		Permissions: make([]string, 0),
+	}
+	for _, v := range rank.Permissions {
+		grpcRank.Permissions = append(grpcRank.Permissions, string(v))
+	}
+
+	return grpcRank
+}
+
+func rankFromGRPC(grpcRank *pb.Rank) *Rank {
+	rank := &Rank{
+		ID:          grpcRank.GetID(),
+		Name:        grpcRank.GetName(),
+		Level:       int(grpcRank.GetLevel()),
+		RoleID:      rbac.RoleID(grpcRank.GetRoleID()),
+		Permissions: make([]Permission, 0),
+	}
+	for _, v := range grpcRank.GetPermissions() {
+		rank.Permissions = append(rank.Permissions, Permission(v))
+	}
+
+	return rank
+}
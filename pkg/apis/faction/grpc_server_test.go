@@ -0,0 +1,51 @@
+package faction_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/faction"
+	"github.com/51st-state/api/pkg/apis/faction/mocks"
+	pb "github.com/51st-state/api/pkg/apis/faction/proto"
+	"github.com/51st-state/api/pkg/rbac"
+	"github.com/51st-state/api/pkg/token"
+	jwt "github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCServerMemberActions(t *testing.T) {
+	m := &mocks.FakeManager{}
+	s := faction.NewGRPCServer(m)
+
+	req := &pb.AccountRequest{
+		Identifier: &pb.Identifier{GUID: "faction"},
+		AccountID:  "user/2",
+	}
+
+	ctx := rbac.ActorToContext(context.Background(), "user/1")
+	if _, err := s.Invite(ctx, req); status.Convert(err).Code() != codes.Unauthenticated {
+		t.Fatal("member actions without token should be rejected")
+	}
+
+	if _, err := s.Leave(ctx, req.GetIdentifier()); status.Convert(err).Code() != codes.Unauthenticated {
+		t.Fatal("member actions without token should be rejected")
+	}
+
+	if m.InviteCallCount() != 0 || m.LeaveCallCount() != 0 {
+		t.Fatal("the manager should not be called without token")
+	}
+
+	ctx = token.ToContext(ctx, token.New(&jwt.StandardClaims{}, &token.User{
+		ID:   "3",
+		Type: "user",
+	}))
+	if _, err := s.Kick(ctx, req); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	ctx, _, accountID := m.KickArgsForCall(0)
+	if rbac.ActorFromContext(ctx) != "user/3" || accountID != "user/2" {
+		t.Fatal("the account of the token should act instead of an explicit actor")
+	}
+}
@@ -0,0 +1,647 @@
+package faction
+
+//go:generate counterfeiter -o ./mocks/manager.go . Manager
+//go:generate protoc -I./../../../../../../ -I ./proto --go_out=plugins=grpc:./proto ./proto/manager.proto
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/51st-state/api/pkg/apis/role"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+// Manager provides methods to manage factions, their ranks and members.
+// Every member is bound to the rbac role of its rank, changes of a membership
+// or a rank are synchronised with the rbac control right away.
+type Manager interface {
+	Get(context.Context, Identifier) (Complete, error)
+	Create(context.Context, Incomplete) (Complete, error)
+	Update(context.Context, Complete) error
+	Delete(context.Context, Identifier) error
+	GetRanks(context.Context, Identifier) ([]*Rank, error)
+	SetRank(context.Context, Identifier, *Rank) error
+	DeleteRank(context.Context, Identifier, string) error
+	GetMembers(context.Context, Identifier) ([]*Member, error)
+	SetMember(context.Context, Identifier, *Member) error
+	RemoveMember(context.Context, Identifier, rbac.AccountID) error
+	// Sync binds every member of a faction to the role of its rank again
+	Sync(context.Context, Identifier) error
+	// Invite, Promote, Demote and Kick are performed by the acting account
+	// and require a rank with the matching permission outranking the target
+	Invite(context.Context, Identifier, rbac.AccountID) error
+	Promote(context.Context, Identifier, rbac.AccountID) error
+	Demote(context.Context, Identifier, rbac.AccountID) error
+	Kick(context.Context, Identifier, rbac.AccountID) error
+	// AcceptInvite joins the acting account into the lowest rank of a faction
+	AcceptInvite(context.Context, Identifier) error
+	// Leave removes the acting account from a faction
+	Leave(context.Context, Identifier) error
+}
+
+type manager struct {
+	repository Repository
+	rbac       rbac.Control
+}
+
+// NewManager creates a new faction manager
+func NewManager(r Repository, rb rbac.Control) Manager {
+	return &manager{r, rb}
+}
+
+var (
+	errInvalidGUID      = errors.New("invalid guid given")
+	errInvalidName      = errors.New("invalid name given")
+	errInvalidAccountID = errors.New("invalid account id given")
+)
+
+func (m *manager) Get(ctx context.Context, id Identifier) (Complete, error) {
+	if id.GUID() == "" {
+		return nil, errInvalidGUID
+	}
+
+	return m.repository.Get(ctx, id)
+}
+
+func (m *manager) Create(ctx context.Context, inc Incomplete) (Complete, error) {
+	if inc.Data().Name == "" {
+		return nil, errInvalidName
+	}
+
+	return m.repository.Create(ctx, inc)
+}
+
+func (m *manager) Update(ctx context.Context, c Complete) error {
+	if c.GUID() == "" {
+		return errInvalidGUID
+	}
+
+	if c.Data().Name == "" {
+		return errInvalidName
+	}
+
+	return m.repository.Update(ctx, c)
+}
+
+func (m *manager) Delete(ctx context.Context, id Identifier) error {
+	if id.GUID() == "" {
+		return errInvalidGUID
+	}
+
+	ranks, err := m.repository.GetRanks(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	members, err := m.repository.GetMembers(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// the roles are removed before the faction so a failure can be retried
+	for _, v := range members {
+		if err := m.bind(ctx, v.AccountID, ownedRoles(id, rankRoles(ranks)), ""); err != nil {
+			return err
+		}
+	}
+
+	return m.repository.Delete(ctx, id)
+}
+
+func (m *manager) GetRanks(ctx context.Context, id Identifier) ([]*Rank, error) {
+	if id.GUID() == "" {
+		return nil, errInvalidGUID
+	}
+
+	return m.repository.GetRanks(ctx, id)
+}
+
+var (
+	errInvalidRankID      = errors.New("invalid rank id given")
+	errInvalidRankName    = errors.New("invalid rank name given")
+	errInvalidRoleID      = errors.New("invalid role id given")
+	errForeignRole        = errors.New("the role of a rank has to belong to the role namespace of its faction")
+	errUnknownPermission  = errors.New("unknown rank permission")
+	errDuplicateRankLevel = errors.New("the rank level is already taken")
+	errUnknownRank        = errors.New("unknown rank")
+	errRankInUse          = errors.New("the rank is still held by members")
+)
+
+// RoleNamespace is the prefix of the ids of the rbac roles owned by a faction.
+// Ranks can only be bound to roles of their faction and members only lose
+// roles of their faction, so ranks neither grant nor revoke other roles.
+func RoleNamespace(id Identifier) string {
+	return "faction/" + id.GUID() + "/"
+}
+
+func validateRank(id Identifier, rank *Rank) error {
+	if rank.ID == "" {
+		return errInvalidRankID
+	}
+
+	if rank.Name == "" {
+		return errInvalidRankName
+	}
+
+	if rank.RoleID == "" {
+		return errInvalidRoleID
+	}
+
+	if !ownsRole(id, rank.RoleID) || role.IsSystemRole(rank.RoleID) {
+		return errForeignRole
+	}
+
+	for _, v := range rank.Permissions {
+		if !containsPermission(permissions, v) {
+			return errUnknownPermission
+		}
+	}
+
+	return nil
+}
+
+func (m *manager) SetRank(ctx context.Context, id Identifier, rank *Rank) error {
+	if id.GUID() == "" {
+		return errInvalidGUID
+	}
+
+	if err := validateRank(id, rank); err != nil {
+		return err
+	}
+
+	if _, err := m.repository.Get(ctx, id); err != nil {
+		return err
+	}
+
+	ranks, err := m.repository.GetRanks(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var old *Rank
+	for _, v := range ranks {
+		if v.ID == rank.ID {
+			old = v
+			continue
+		}
+
+		if v.Level == rank.Level {
+			return errDuplicateRankLevel
+		}
+	}
+
+	if err := m.repository.SetRank(ctx, id, rank); err != nil {
+		return err
+	}
+
+	if old == nil || old.RoleID == rank.RoleID {
+		return nil
+	}
+
+	members, err := m.repository.GetMembers(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range members {
+		if v.RankID != rank.ID {
+			continue
+		}
+
+		if err := m.sync(ctx, id, v.AccountID, old.RoleID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *manager) DeleteRank(ctx context.Context, id Identifier, rankID string) error {
+	if id.GUID() == "" {
+		return errInvalidGUID
+	}
+
+	if rankID == "" {
+		return errInvalidRankID
+	}
+
+	members, err := m.repository.GetMembers(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range members {
+		if v.RankID == rankID {
+			return errRankInUse
+		}
+	}
+
+	return m.repository.DeleteRank(ctx, id, rankID)
+}
+
+func (m *manager) GetMembers(ctx context.Context, id Identifier) ([]*Member, error) {
+	if id.GUID() == "" {
+		return nil, errInvalidGUID
+	}
+
+	return m.repository.GetMembers(ctx, id)
+}
+
+func (m *manager) SetMember(ctx context.Context, id Identifier, member *Member) error {
+	if id.GUID() == "" {
+		return errInvalidGUID
+	}
+
+	if member.AccountID == "" {
+		return errInvalidAccountID
+	}
+
+	ranks, err := m.repository.GetRanks(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if findRank(ranks, member.RankID) == nil {
+		return errUnknownRank
+	}
+
+	return m.join(ctx, id, member)
+}
+
+func (m *manager) RemoveMember(ctx context.Context, id Identifier, accountID rbac.AccountID) error {
+	if id.GUID() == "" {
+		return errInvalidGUID
+	}
+
+	if accountID == "" {
+		return errInvalidAccountID
+	}
+
+	if err := m.repository.RemoveMember(ctx, id, accountID); err != nil {
+		return err
+	}
+
+	return m.sync(ctx, id, accountID)
+}
+
+func (m *manager) Sync(ctx context.Context, id Identifier) error {
+	if id.GUID() == "" {
+		return errInvalidGUID
+	}
+
+	members, err := m.repository.GetMembers(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range members {
+		if err := m.sync(ctx, id, v.AccountID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var (
+	errNotMember         = errors.New("the account is not a member of the faction")
+	errAlreadyMember     = errors.New("the account is already a member of the faction")
+	errMissingPermission = errors.New("the rank lacks the required permission")
+	errInsufficientRank  = errors.New("the rank is not high enough")
+	errHighestRank       = errors.New("the member already holds the highest rank")
+	errLowestRank        = errors.New("the member already holds the lowest rank")
+	errNoInvite          = errors.New("the account has not been invited")
+	errNoRanks           = errors.New("the faction has no ranks")
+)
+
+func (m *manager) Invite(ctx context.Context, id Identifier, accountID rbac.AccountID) error {
+	if id.GUID() == "" {
+		return errInvalidGUID
+	}
+
+	if accountID == "" {
+		return errInvalidAccountID
+	}
+
+	if _, _, err := m.authorize(ctx, id, PermissionInvite); err != nil {
+		return err
+	}
+
+	_, err := m.repository.GetMember(ctx, id, accountID)
+	if err == nil {
+		return errAlreadyMember
+	}
+
+	if err != sql.ErrNoRows {
+		return err
+	}
+
+	return m.repository.AddInvite(ctx, id, accountID)
+}
+
+func (m *manager) Promote(ctx context.Context, id Identifier, accountID rbac.AccountID) error {
+	if id.GUID() == "" {
+		return errInvalidGUID
+	}
+
+	ranks, actor, err := m.authorize(ctx, id, PermissionPromote)
+	if err != nil {
+		return err
+	}
+
+	member, i, err := m.subordinate(ctx, id, ranks, actor, accountID)
+	if err != nil {
+		return err
+	}
+
+	if i+1 == len(ranks) {
+		return errHighestRank
+	}
+
+	if ranks[i+1].Level >= actor.Level {
+		return errInsufficientRank
+	}
+
+	member.RankID = ranks[i+1].ID
+
+	return m.join(ctx, id, member)
+}
+
+func (m *manager) Demote(ctx context.Context, id Identifier, accountID rbac.AccountID) error {
+	if id.GUID() == "" {
+		return errInvalidGUID
+	}
+
+	ranks, actor, err := m.authorize(ctx, id, PermissionDemote)
+	if err != nil {
+		return err
+	}
+
+	member, i, err := m.subordinate(ctx, id, ranks, actor, accountID)
+	if err != nil {
+		return err
+	}
+
+	if i == 0 {
+		return errLowestRank
+	}
+
+	member.RankID = ranks[i-1].ID
+
+	return m.join(ctx, id, member)
+}
+
+func (m *manager) Kick(ctx context.Context, id Identifier, accountID rbac.AccountID) error {
+	if id.GUID() == "" {
+		return errInvalidGUID
+	}
+
+	ranks, actor, err := m.authorize(ctx, id, PermissionKick)
+	if err != nil {
+		return err
+	}
+
+	if _, _, err := m.subordinate(ctx, id, ranks, actor, accountID); err != nil {
+		return err
+	}
+
+	if err := m.repository.RemoveMember(ctx, id, accountID); err != nil {
+		return err
+	}
+
+	return m.sync(ctx, id, accountID)
+}
+
+func (m *manager) AcceptInvite(ctx context.Context, id Identifier) error {
+	if id.GUID() == "" {
+		return errInvalidGUID
+	}
+
+	accountID := rbac.ActorFromContext(ctx)
+
+	invites, err := m.repository.GetInvites(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !containsAccount(invites, accountID) {
+		return errNoInvite
+	}
+
+	ranks, err := m.repository.GetRanks(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(ranks) == 0 {
+		return errNoRanks
+	}
+
+	return m.join(ctx, id, &Member{
+		AccountID: accountID,
+		RankID:    ranks[0].ID,
+	})
+}
+
+func (m *manager) Leave(ctx context.Context, id Identifier) error {
+	if id.GUID() == "" {
+		return errInvalidGUID
+	}
+
+	accountID := rbac.ActorFromContext(ctx)
+
+	if _, err := m.member(ctx, id, accountID); err != nil {
+		return err
+	}
+
+	if err := m.repository.RemoveMember(ctx, id, accountID); err != nil {
+		return err
+	}
+
+	return m.sync(ctx, id, accountID)
+}
+
+// join stores a membership, removes a pending invite of the account
+// and binds it to the role of its rank
+func (m *manager) join(ctx context.Context, id Identifier, member *Member) error {
+	if err := m.repository.SetMember(ctx, id, member); err != nil {
+		return err
+	}
+
+	if err := m.repository.RemoveInvite(ctx, id, member.AccountID); err != nil {
+		return err
+	}
+
+	return m.sync(ctx, id, member.AccountID)
+}
+
+func (m *manager) member(ctx context.Context, id Identifier, accountID rbac.AccountID) (*Member, error) {
+	member, err := m.repository.GetMember(ctx, id, accountID)
+	if err == sql.ErrNoRows {
+		return nil, errNotMember
+	}
+
+	return member, err
+}
+
+// authorize returns the ranks of a faction and the rank of the acting account
+// if it is a member of the faction and its rank grants the permission
+func (m *manager) authorize(ctx context.Context, id Identifier, p Permission) ([]*Rank, *Rank, error) {
+	actor, err := m.member(ctx, id, rbac.ActorFromContext(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ranks, err := m.repository.GetRanks(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rank := findRank(ranks, actor.RankID)
+	if rank == nil || !rank.HasPermission(p) {
+		return nil, nil, errMissingPermission
+	}
+
+	return ranks, rank, nil
+}
+
+// subordinate returns a member outranked by the acting rank
+// together with the index of its rank
+func (m *manager) subordinate(ctx context.Context, id Identifier, ranks []*Rank, actor *Rank, accountID rbac.AccountID) (*Member, int, error) {
+	if accountID == "" {
+		return nil, 0, errInvalidAccountID
+	}
+
+	member, err := m.member(ctx, id, accountID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for i, v := range ranks {
+		if v.ID != member.RankID {
+			continue
+		}
+
+		if v.Level >= actor.Level {
+			return nil, 0, errInsufficientRank
+		}
+
+		return member, i, nil
+	}
+
+	return nil, 0, errUnknownRank
+}
+
+// sync binds an account to the role of its current rank in a faction
+// and removes the roles of all other ranks as well as the stale roles,
+// as long as they belong to the role namespace of the faction
+func (m *manager) sync(ctx context.Context, id Identifier, accountID rbac.AccountID, stale ...rbac.RoleID) error {
+	ranks, err := m.repository.GetRanks(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var grant rbac.RoleID
+	member, err := m.repository.GetMember(ctx, id, accountID)
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return err
+	default:
+		if rank := findRank(ranks, member.RankID); rank != nil {
+			grant = rank.RoleID
+		}
+	}
+
+	return m.bind(ctx, accountID, ownedRoles(id, append(rankRoles(ranks), stale...)), grant)
+}
+
+// bind removes the revoked roles from an account and adds the granted role.
+// The roles are removed and added by the rbac system, so concurrent changes
+// of the other roles of the account are kept. The account is left untouched
+// if its roles do not change.
+func (m *manager) bind(ctx context.Context, accountID rbac.AccountID, revoke []rbac.RoleID, grant rbac.RoleID) error {
+	roles, err := m.rbac.GetAccountRoles(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	revoked := make(rbac.AccountRoles, 0)
+	for _, v := range revoke {
+		if v != grant && roles.Contains(v) {
+			revoked = append(revoked, v)
+		}
+	}
+
+	if len(revoked) > 0 {
+		if err := m.rbac.RemoveAccountRoles(ctx, accountID, revoked); err != nil {
+			return err
+		}
+	}
+
+	if grant == "" || roles.Contains(grant) {
+		return nil
+	}
+
+	return m.rbac.AddAccountRoles(ctx, accountID, rbac.AccountRoles{grant})
+}
+
+func findRank(ranks []*Rank, rankID string) *Rank {
+	for _, v := range ranks {
+		if v.ID == rankID {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func rankRoles(ranks []*Rank) []rbac.RoleID {
+	roles := make([]rbac.RoleID, 0)
+	for _, v := range ranks {
+		roles = append(roles, v.RoleID)
+	}
+
+	return roles
+}
+
+// ownsRole checks whether a role belongs to the role namespace of a faction
+func ownsRole(id Identifier, roleID rbac.RoleID) bool {
+	namespace := RoleNamespace(id)
+
+	return len(roleID) > len(namespace) && strings.HasPrefix(string(roleID), namespace)
+}
+
+// ownedRoles filters the roles belonging to the role namespace of a faction
+func ownedRoles(id Identifier, roles []rbac.RoleID) []rbac.RoleID {
+	owned := make([]rbac.RoleID, 0)
+	for _, v := range roles {
+		if ownsRole(id, v) {
+			owned = append(owned, v)
+		}
+	}
+
+	return owned
+}
+
+func containsPermission(values []Permission, value Permission) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsAccount(values []rbac.AccountID, value rbac.AccountID) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,412 @@
+package faction_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/faction"
+	"github.com/51st-state/api/pkg/apis/faction/memory"
+	"github.com/51st-state/api/pkg/apis/faction/mocks"
+	"github.com/51st-state/api/pkg/event"
+	pubsubMocks "github.com/51st-state/api/pkg/pubsub/mocks"
+	"github.com/51st-state/api/pkg/rbac"
+	rbacMemory "github.com/51st-state/api/pkg/rbac/memory"
+	rbacMocks "github.com/51st-state/api/pkg/rbac/mocks"
+)
+
+type fakeComplete struct {
+	faction.Identifier
+	faction.Incomplete
+}
+
+func TestManagerGet(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	manager := faction.NewManager(repo, &rbacMocks.FakeControl{})
+
+	id := &mocks.FakeIdentifier{}
+
+	if _, err := manager.Get(context.Background(), id); err == nil {
+		t.Fatal("there has to be an error since the guid is invalid")
+	}
+
+	id.GUIDReturns("test")
+
+	if _, err := manager.Get(context.Background(), id); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestManagerCreate(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	manager := faction.NewManager(repo, &rbacMocks.FakeControl{})
+
+	inc := faction.NewIncomplete("", "")
+
+	if _, err := manager.Create(context.Background(), inc); err == nil {
+		t.Fatal("there has to be an error since the name is invalid")
+	}
+
+	inc.Data().Name = "police"
+
+	if _, err := manager.Create(context.Background(), inc); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestManagerUpdate(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	manager := faction.NewManager(repo, &rbacMocks.FakeControl{})
+
+	id := &mocks.FakeIdentifier{}
+	inc := faction.NewIncomplete("", "")
+	complete := &fakeComplete{id, inc}
+
+	if err := manager.Update(context.Background(), complete); err == nil {
+		t.Fatal("the given guid is invalid")
+	}
+
+	id.GUIDReturns("test")
+
+	if err := manager.Update(context.Background(), complete); err == nil {
+		t.Fatal("the name is invalid")
+	}
+
+	inc.Data().Name = "police"
+
+	if err := manager.Update(context.Background(), complete); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestManagerSetRank(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	manager := faction.NewManager(repo, &rbacMocks.FakeControl{})
+
+	id := &mocks.FakeIdentifier{}
+	id.GUIDReturns("test")
+
+	for _, v := range []*faction.Rank{
+		{Name: "Officer", Level: 1, RoleID: "faction/test/officer"},
+		{ID: "officer", Level: 1, RoleID: "faction/test/officer"},
+		{ID: "officer", Name: "Officer", Level: 1},
+		{ID: "officer", Name: "Officer", Level: 1, RoleID: "faction/test/officer", Permissions: []faction.Permission{"fly"}},
+		{ID: "officer", Name: "Officer", Level: 1, RoleID: "police/officer"},
+		{ID: "officer", Name: "Officer", Level: 1, RoleID: "faction/other/officer"},
+		{ID: "officer", Name: "Officer", Level: 1, RoleID: "faction/test/"},
+		{ID: "officer", Name: "Officer", Level: 1, RoleID: "system/superadmin"},
+	} {
+		if err := manager.SetRank(context.Background(), id, v); err == nil {
+			t.Fatal("the rank is invalid")
+		}
+	}
+
+	repo.GetRanksReturns([]*faction.Rank{
+		{ID: "cadet", Name: "Cadet", Level: 1, RoleID: "faction/test/cadet"},
+	}, nil)
+
+	if err := manager.SetRank(context.Background(), id, &faction.Rank{
+		ID:     "officer",
+		Name:   "Officer",
+		Level:  1,
+		RoleID: "faction/test/officer",
+	}); err == nil {
+		t.Fatal("the level is taken by another rank")
+	}
+
+	if err := manager.SetRank(context.Background(), id, &faction.Rank{
+		ID:          "officer",
+		Name:        "Officer",
+		Level:       2,
+		RoleID:      "faction/test/officer",
+		Permissions: []faction.Permission{faction.PermissionInvite},
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if repo.SetRankCallCount() != 1 {
+		t.Fatal("the rank should be stored")
+	}
+}
+
+func TestManagerDeleteRank(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	manager := faction.NewManager(repo, &rbacMocks.FakeControl{})
+
+	id := &mocks.FakeIdentifier{}
+	id.GUIDReturns("test")
+
+	if err := manager.DeleteRank(context.Background(), id, ""); err == nil {
+		t.Fatal("the rank id is invalid")
+	}
+
+	repo.GetMembersReturns([]*faction.Member{
+		{AccountID: "user/1", RankID: "officer"},
+	}, nil)
+
+	if err := manager.DeleteRank(context.Background(), id, "officer"); err == nil {
+		t.Fatal("the rank is still held by a member")
+	}
+
+	if err := manager.DeleteRank(context.Background(), id, "cadet"); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestManagerSyncError(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	rbControl := &rbacMocks.FakeControl{}
+	manager := faction.NewManager(repo, rbControl)
+
+	id := &mocks.FakeIdentifier{}
+	id.GUIDReturns("test")
+
+	repo.GetRanksReturns([]*faction.Rank{
+		{ID: "cadet", Name: "Cadet", Level: 1, RoleID: "faction/test/cadet"},
+	}, nil)
+	repo.GetMembersReturns([]*faction.Member{
+		{AccountID: "user/1", RankID: "cadet"},
+	}, nil)
+	repo.GetMemberReturns(&faction.Member{AccountID: "user/1", RankID: "cadet"}, nil)
+	rbControl.GetAccountRolesReturns(nil, errors.New("fake error"))
+
+	if err := manager.Sync(context.Background(), id); err == nil {
+		t.Fatal("the rbac control returns an error")
+	}
+
+	rbControl.GetAccountRolesReturns(rbac.AccountRoles{"faction/test/cadet"}, nil)
+
+	if err := manager.Sync(context.Background(), id); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if rbControl.AddAccountRolesCallCount() != 0 || rbControl.RemoveAccountRolesCallCount() != 0 {
+		t.Fatal("the roles should not be changed if they are already bound")
+	}
+
+	repo.GetRanksReturns([]*faction.Rank{
+		{ID: "cadet", Name: "Cadet", Level: 1, RoleID: "police/cadet"},
+	}, nil)
+	repo.GetMemberReturns(nil, sql.ErrNoRows)
+	rbControl.GetAccountRolesReturns(rbac.AccountRoles{"police/cadet"}, nil)
+
+	if err := manager.RemoveMember(context.Background(), id, "user/1"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if rbControl.RemoveAccountRolesCallCount() != 0 {
+		t.Fatal("roles outside of the role namespace of the faction should not be revoked")
+	}
+
+	repo.GetRanksReturns([]*faction.Rank{
+		{ID: "cadet", Name: "Cadet", Level: 1, RoleID: "faction/test/cadet"},
+		{ID: "officer", Name: "Officer", Level: 2, RoleID: "faction/test/officer"},
+	}, nil)
+	repo.GetMemberReturns(&faction.Member{AccountID: "user/1", RankID: "officer"}, nil)
+	rbControl.GetAccountRolesReturns(rbac.AccountRoles{"civilian", "faction/test/cadet"}, nil)
+
+	if err := manager.Sync(context.Background(), id); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, _, roles := rbControl.RemoveAccountRolesArgsForCall(0); len(roles) != 1 || roles[0] != "faction/test/cadet" {
+		t.Fatal("only the role of the previous rank should be removed")
+	}
+
+	if _, _, roles := rbControl.AddAccountRolesArgsForCall(0); len(roles) != 1 || roles[0] != "faction/test/officer" {
+		t.Fatal("the role of the rank should be added")
+	}
+
+	if rbControl.SetAccountRolesCallCount() != 0 {
+		t.Fatal("the roles should not be replaced as a whole")
+	}
+}
+
+type factionFixture struct {
+	manager faction.Manager
+	rbac    rbac.Control
+	id      faction.Identifier
+}
+
+func newFactionFixture(t *testing.T) *factionFixture {
+	ctx := context.Background()
+	rb := rbac.NewControl(rbacMemory.NewRepository(), event.NewProducer(&pubsubMocks.FakeProducer{}))
+	manager := faction.NewManager(memory.NewRepository(), rb)
+
+	c, err := manager.Create(ctx, faction.NewIncomplete("police", "los santos police department"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	f := &factionFixture{manager, rb, c}
+	for _, v := range []rbac.RoleID{"civilian", f.role("cadet"), f.role("officer"), f.role("sergeant"), f.role("chief"), f.role("captain")} {
+		if err := rb.SetRoleParents(ctx, v, rbac.RoleParents{}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	for _, v := range []*faction.Rank{
+		{ID: "cadet", Name: "Cadet", Level: 1, RoleID: f.role("cadet")},
+		{ID: "officer", Name: "Officer", Level: 2, RoleID: f.role("officer"), Permissions: []faction.Permission{faction.PermissionInvite}},
+		{ID: "sergeant", Name: "Sergeant", Level: 3, RoleID: f.role("sergeant"), Permissions: []faction.Permission{faction.PermissionPromote, faction.PermissionDemote}},
+		{ID: "chief", Name: "Chief", Level: 4, RoleID: f.role("chief"), Permissions: []faction.Permission{
+			faction.PermissionInvite,
+			faction.PermissionPromote,
+			faction.PermissionDemote,
+			faction.PermissionKick,
+		}},
+	} {
+		if err := manager.SetRank(ctx, c, v); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	return f
+}
+
+func (f *factionFixture) role(name string) rbac.RoleID {
+	return rbac.RoleID(faction.RoleNamespace(f.id) + name)
+}
+
+func (f *factionFixture) as(accountID rbac.AccountID) context.Context {
+	return rbac.ActorToContext(context.Background(), accountID)
+}
+
+func (f *factionFixture) roles(t *testing.T, accountID rbac.AccountID) rbac.AccountRoles {
+	roles, err := f.rbac.GetAccountRoles(context.Background(), accountID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return roles
+}
+
+func TestManagerMembershipFlow(t *testing.T) {
+	f := newFactionFixture(t)
+
+	if err := f.manager.SetMember(context.Background(), f.id, &faction.Member{AccountID: "user/chief", RankID: "unknown"}); err == nil {
+		t.Fatal("the rank is unknown")
+	}
+
+	if err := f.manager.SetMember(context.Background(), f.id, &faction.Member{AccountID: "user/chief", RankID: "chief"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if roles := f.roles(t, "user/chief"); len(roles) != 1 || roles[0] != f.role("chief") {
+		t.Fatal("the chief should be bound to the role of the rank")
+	}
+
+	if err := f.rbac.SetAccountRoles(context.Background(), "user/1", rbac.AccountRoles{"civilian"}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := f.manager.AcceptInvite(f.as("user/1"), f.id); err == nil {
+		t.Fatal("the account has not been invited")
+	}
+
+	if err := f.manager.Invite(f.as("user/2"), f.id, "user/1"); err == nil {
+		t.Fatal("only members are allowed to invite")
+	}
+
+	if err := f.manager.Invite(f.as("user/chief"), f.id, "user/1"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := f.manager.AcceptInvite(f.as("user/1"), f.id); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if roles := f.roles(t, "user/1"); len(roles) != 2 || !roles.Contains("civilian") || !roles.Contains(f.role("cadet")) {
+		t.Fatal("the new member should be bound to the lowest rank and keep its other roles")
+	}
+
+	if err := f.manager.Invite(f.as("user/1"), f.id, "user/2"); err == nil {
+		t.Fatal("the cadet rank lacks the invite permission")
+	}
+
+	if err := f.manager.Invite(f.as("user/chief"), f.id, "user/1"); err == nil {
+		t.Fatal("the account is already a member")
+	}
+
+	if err := f.manager.Promote(f.as("user/chief"), f.id, "user/1"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if roles := f.roles(t, "user/1"); len(roles) != 2 || !roles.Contains(f.role("officer")) || roles.Contains(f.role("cadet")) {
+		t.Fatal("the promoted member should be bound to the role of its new rank only")
+	}
+
+	if err := f.manager.SetMember(context.Background(), f.id, &faction.Member{AccountID: "user/sergeant", RankID: "sergeant"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := f.manager.Promote(f.as("user/sergeant"), f.id, "user/1"); err == nil {
+		t.Fatal("a member can not be promoted to the rank of the actor")
+	}
+
+	if err := f.manager.Demote(f.as("user/sergeant"), f.id, "user/chief"); err == nil {
+		t.Fatal("a higher rank can not be demoted")
+	}
+
+	if err := f.manager.Kick(f.as("user/sergeant"), f.id, "user/1"); err == nil {
+		t.Fatal("the sergeant rank lacks the kick permission")
+	}
+
+	if err := f.manager.Demote(f.as("user/sergeant"), f.id, "user/1"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := f.manager.Demote(f.as("user/sergeant"), f.id, "user/1"); err == nil {
+		t.Fatal("the member already holds the lowest rank")
+	}
+
+	if err := f.manager.Kick(f.as("user/chief"), f.id, "user/1"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if roles := f.roles(t, "user/1"); len(roles) != 1 || roles[0] != "civilian" {
+		t.Fatal("the kicked member should lose the roles of the faction")
+	}
+
+	if err := f.manager.Leave(f.as("user/1"), f.id); err == nil {
+		t.Fatal("the account is no member anymore")
+	}
+
+	if err := f.manager.Leave(f.as("user/sergeant"), f.id); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if roles := f.roles(t, "user/sergeant"); len(roles) != 0 {
+		t.Fatal("the member should lose the roles of the faction after leaving")
+	}
+}
+
+func TestManagerRankRoleChange(t *testing.T) {
+	f := newFactionFixture(t)
+	ctx := context.Background()
+
+	if err := f.manager.SetMember(ctx, f.id, &faction.Member{AccountID: "user/chief", RankID: "chief"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := f.manager.SetRank(ctx, f.id, &faction.Rank{ID: "chief", Name: "Captain", Level: 4, RoleID: f.role("captain")}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if roles := f.roles(t, "user/chief"); len(roles) != 1 || roles[0] != f.role("captain") {
+		t.Fatal("the members of the rank should be bound to its new role")
+	}
+
+	if err := f.manager.Delete(ctx, f.id); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if roles := f.roles(t, "user/chief"); len(roles) != 0 {
+		t.Fatal("the members of a deleted faction should lose its roles")
+	}
+
+	if _, err := f.manager.Get(ctx, f.id); err == nil {
+		t.Fatal("the faction should be deleted")
+	}
+}
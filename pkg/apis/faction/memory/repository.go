@@ -0,0 +1,293 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/faction"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+type complete struct {
+	faction.Identifier
+	faction.Incomplete
+}
+
+func (c *complete) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		GUID        string `json:"guid"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}{
+		c.GUID(),
+		c.Data().Name,
+		c.Data().Description,
+	})
+}
+
+type repository struct {
+	mutex    sync.RWMutex
+	factions map[string]faction.Incomplete
+	ranks    map[string][]*faction.Rank
+	members  map[string][]*faction.Member
+	invites  map[string][]rbac.AccountID
+}
+
+// NewRepository creates a new in memory storage repository
+func NewRepository() faction.Repository {
+	return &repository{
+		factions: make(map[string]faction.Incomplete),
+		ranks:    make(map[string][]*faction.Rank),
+		members:  make(map[string][]*faction.Member),
+		invites:  make(map[string][]rbac.AccountID),
+	}
+}
+
+func stored(inc faction.Incomplete) faction.Incomplete {
+	return faction.NewIncomplete(inc.Data().Name, inc.Data().Description)
+}
+
+func storedRank(rank *faction.Rank) *faction.Rank {
+	r := *rank
+	r.Permissions = append(make([]faction.Permission, 0), rank.Permissions...)
+	return &r
+}
+
+func storedMember(member *faction.Member) *faction.Member {
+	m := *member
+	return &m
+}
+
+func (r *repository) Get(ctx context.Context, id faction.Identifier) (faction.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	inc, ok := r.factions[id.GUID()]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return &complete{
+		id,
+		stored(inc),
+	}, nil
+}
+
+func (r *repository) Create(ctx context.Context, inc faction.Incomplete) (faction.Complete, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.factions[rand.String()] = stored(inc)
+
+	return &complete{
+		faction.NewIdentifier(rand.String()),
+		inc,
+	}, nil
+}
+
+func (r *repository) Update(ctx context.Context, c faction.Complete) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.factions[c.GUID()]; ok {
+		r.factions[c.GUID()] = stored(c)
+	}
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id faction.Identifier) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.factions, id.GUID())
+	delete(r.ranks, id.GUID())
+	delete(r.members, id.GUID())
+	delete(r.invites, id.GUID())
+
+	return nil
+}
+
+func (r *repository) GetRanks(ctx context.Context, id faction.Identifier) ([]*faction.Rank, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	ranks := make([]*faction.Rank, 0)
+	for _, v := range r.ranks[id.GUID()] {
+		ranks = append(ranks, storedRank(v))
+	}
+
+	sort.Slice(ranks, func(i, j int) bool {
+		return ranks[i].Level < ranks[j].Level
+	})
+
+	return ranks, nil
+}
+
+func (r *repository) SetRank(ctx context.Context, id faction.Identifier, rank *faction.Rank) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	ranks := r.ranks[id.GUID()]
+	for i, v := range ranks {
+		if v.ID == rank.ID {
+			ranks[i] = storedRank(rank)
+			return nil
+		}
+	}
+
+	r.ranks[id.GUID()] = append(ranks, storedRank(rank))
+
+	return nil
+}
+
+func (r *repository) DeleteRank(ctx context.Context, id faction.Identifier, rankID string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	ranks := make([]*faction.Rank, 0)
+	for _, v := range r.ranks[id.GUID()] {
+		if v.ID != rankID {
+			ranks = append(ranks, v)
+		}
+	}
+	r.ranks[id.GUID()] = ranks
+
+	return nil
+}
+
+func (r *repository) GetMembers(ctx context.Context, id faction.Identifier) ([]*faction.Member, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	members := make([]*faction.Member, 0)
+	for _, v := range r.members[id.GUID()] {
+		members = append(members, storedMember(v))
+	}
+
+	return members, nil
+}
+
+func (r *repository) GetMember(ctx context.Context, id faction.Identifier, accountID rbac.AccountID) (*faction.Member, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, v := range r.members[id.GUID()] {
+		if v.AccountID == accountID {
+			return storedMember(v), nil
+		}
+	}
+
+	return nil, sql.ErrNoRows
+}
+
+func (r *repository) SetMember(ctx context.Context, id faction.Identifier, member *faction.Member) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	members := r.members[id.GUID()]
+	for i, v := range members {
+		if v.AccountID == member.AccountID {
+			members[i] = storedMember(member)
+			return nil
+		}
+	}
+
+	r.members[id.GUID()] = append(members, storedMember(member))
+
+	return nil
+}
+
+func (r *repository) RemoveMember(ctx context.Context, id faction.Identifier, accountID rbac.AccountID) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	members := make([]*faction.Member, 0)
+	for _, v := range r.members[id.GUID()] {
+		if v.AccountID != accountID {
+			members = append(members, v)
+		}
+	}
+	r.members[id.GUID()] = members
+
+	return nil
+}
+
+func (r *repository) GetInvites(ctx context.Context, id faction.Identifier) ([]rbac.AccountID, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return append(make([]rbac.AccountID, 0), r.invites[id.GUID()]...), nil
+}
+
+func (r *repository) AddInvite(ctx context.Context, id faction.Identifier, accountID rbac.AccountID) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, v := range r.invites[id.GUID()] {
+		if v == accountID {
+			return nil
+		}
+	}
+
+	r.invites[id.GUID()] = append(r.invites[id.GUID()], accountID)
+
+	return nil
+}
+
+func (r *repository) RemoveInvite(ctx context.Context, id faction.Identifier, accountID rbac.AccountID) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	invites := make([]rbac.AccountID, 0)
+	for _, v := range r.invites[id.GUID()] {
+		if v != accountID {
+			invites = append(invites, v)
+		}
+	}
+	r.invites[id.GUID()] = invites
+
+	return nil
+}
+
+func (r *repository) GetMemberships(ctx context.Context, accountID rbac.AccountID) ([]*faction.Membership, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	memberships := make([]*faction.Membership, 0)
+	for guid, members := range r.members {
+		for _, v := range members {
+			if v.AccountID == accountID {
+				memberships = append(memberships, &faction.Membership{FactionGUID: guid, RankID: v.RankID})
+			}
+		}
+	}
+
+	for guid, invites := range r.invites {
+		for _, v := range invites {
+			if v == accountID {
+				memberships = append(memberships, &faction.Membership{FactionGUID: guid, Invited: true})
+			}
+		}
+	}
+
+	sort.Slice(memberships, func(i, j int) bool {
+		if memberships[i].FactionGUID != memberships[j].FactionGUID {
+			return memberships[i].FactionGUID < memberships[j].FactionGUID
+		}
+
+		return !memberships[i].Invited && memberships[j].Invited
+	})
+
+	return memberships, nil
+}
@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/faction"
+	"github.com/51st-state/api/pkg/apis/faction/memory"
+	"github.com/51st-state/api/pkg/apis/faction/repositorytest"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) faction.Repository {
+		return memory.NewRepository()
+	})
+}
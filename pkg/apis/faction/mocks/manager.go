@@ -0,0 +1,1146 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/faction"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+type FakeManager struct {
+	GetStub        func(context.Context, faction.Identifier) (faction.Complete, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}
+	getReturns struct {
+		result1 faction.Complete
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 faction.Complete
+		result2 error
+	}
+	CreateStub        func(context.Context, faction.Incomplete) (faction.Complete, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Incomplete
+	}
+	createReturns struct {
+		result1 faction.Complete
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 faction.Complete
+		result2 error
+	}
+	UpdateStub        func(context.Context, faction.Complete) error
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Complete
+	}
+	updateReturns struct {
+		result1 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteStub        func(context.Context, faction.Identifier) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetRanksStub        func(context.Context, faction.Identifier) ([]*faction.Rank, error)
+	getRanksMutex       sync.RWMutex
+	getRanksArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}
+	getRanksReturns struct {
+		result1 []*faction.Rank
+		result2 error
+	}
+	getRanksReturnsOnCall map[int]struct {
+		result1 []*faction.Rank
+		result2 error
+	}
+	SetRankStub        func(context.Context, faction.Identifier, *faction.Rank) error
+	setRankMutex       sync.RWMutex
+	setRankArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 *faction.Rank
+	}
+	setRankReturns struct {
+		result1 error
+	}
+	setRankReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteRankStub        func(context.Context, faction.Identifier, string) error
+	deleteRankMutex       sync.RWMutex
+	deleteRankArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 string
+	}
+	deleteRankReturns struct {
+		result1 error
+	}
+	deleteRankReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetMembersStub        func(context.Context, faction.Identifier) ([]*faction.Member, error)
+	getMembersMutex       sync.RWMutex
+	getMembersArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}
+	getMembersReturns struct {
+		result1 []*faction.Member
+		result2 error
+	}
+	getMembersReturnsOnCall map[int]struct {
+		result1 []*faction.Member
+		result2 error
+	}
+	SetMemberStub        func(context.Context, faction.Identifier, *faction.Member) error
+	setMemberMutex       sync.RWMutex
+	setMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 *faction.Member
+	}
+	setMemberReturns struct {
+		result1 error
+	}
+	setMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveMemberStub        func(context.Context, faction.Identifier, rbac.AccountID) error
+	removeMemberMutex       sync.RWMutex
+	removeMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}
+	removeMemberReturns struct {
+		result1 error
+	}
+	removeMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SyncStub        func(context.Context, faction.Identifier) error
+	syncMutex       sync.RWMutex
+	syncArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}
+	syncReturns struct {
+		result1 error
+	}
+	syncReturnsOnCall map[int]struct {
+		result1 error
+	}
+	InviteStub        func(context.Context, faction.Identifier, rbac.AccountID) error
+	inviteMutex       sync.RWMutex
+	inviteArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}
+	inviteReturns struct {
+		result1 error
+	}
+	inviteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	PromoteStub        func(context.Context, faction.Identifier, rbac.AccountID) error
+	promoteMutex       sync.RWMutex
+	promoteArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}
+	promoteReturns struct {
+		result1 error
+	}
+	promoteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DemoteStub        func(context.Context, faction.Identifier, rbac.AccountID) error
+	demoteMutex       sync.RWMutex
+	demoteArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}
+	demoteReturns struct {
+		result1 error
+	}
+	demoteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	KickStub        func(context.Context, faction.Identifier, rbac.AccountID) error
+	kickMutex       sync.RWMutex
+	kickArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}
+	kickReturns struct {
+		result1 error
+	}
+	kickReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AcceptInviteStub        func(context.Context, faction.Identifier) error
+	acceptInviteMutex       sync.RWMutex
+	acceptInviteArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}
+	acceptInviteReturns struct {
+		result1 error
+	}
+	acceptInviteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	LeaveStub        func(context.Context, faction.Identifier) error
+	leaveMutex       sync.RWMutex
+	leaveArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}
+	leaveReturns struct {
+		result1 error
+	}
+	leaveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeManager) Get(arg1 context.Context, arg2 faction.Identifier) (faction.Complete, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getReturns.result1, fake.getReturns.result2
+}
+
+func (fake *FakeManager) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeManager) GetArgsForCall(i int) (context.Context, faction.Identifier) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return fake.getArgsForCall[i].arg1, fake.getArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetReturns(result1 faction.Complete, result2 error) {
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 faction.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetReturnsOnCall(i int, result1 faction.Complete, result2 error) {
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 faction.Complete
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 faction.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Create(arg1 context.Context, arg2 faction.Incomplete) (faction.Complete, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Incomplete
+	}{arg1, arg2})
+	fake.recordInvocation("Create", []interface{}{arg1, arg2})
+	fake.createMutex.Unlock()
+	if fake.CreateStub != nil {
+		return fake.CreateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createReturns.result1, fake.createReturns.result2
+}
+
+func (fake *FakeManager) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeManager) CreateArgsForCall(i int) (context.Context, faction.Incomplete) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return fake.createArgsForCall[i].arg1, fake.createArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) CreateReturns(result1 faction.Complete, result2 error) {
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 faction.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) CreateReturnsOnCall(i int, result1 faction.Complete, result2 error) {
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 faction.Complete
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 faction.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Update(arg1 context.Context, arg2 faction.Complete) error {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Complete
+	}{arg1, arg2})
+	fake.recordInvocation("Update", []interface{}{arg1, arg2})
+	fake.updateMutex.Unlock()
+	if fake.UpdateStub != nil {
+		return fake.UpdateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateReturns.result1
+}
+
+func (fake *FakeManager) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeManager) UpdateArgsForCall(i int) (context.Context, faction.Complete) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return fake.updateArgsForCall[i].arg1, fake.updateArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) UpdateReturns(result1 error) {
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) UpdateReturnsOnCall(i int, result1 error) {
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Delete(arg1 context.Context, arg2 faction.Identifier) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteReturns.result1
+}
+
+func (fake *FakeManager) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeManager) DeleteArgsForCall(i int) (context.Context, faction.Identifier) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return fake.deleteArgsForCall[i].arg1, fake.deleteArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) DeleteReturns(result1 error) {
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) DeleteReturnsOnCall(i int, result1 error) {
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) GetRanks(arg1 context.Context, arg2 faction.Identifier) ([]*faction.Rank, error) {
+	fake.getRanksMutex.Lock()
+	ret, specificReturn := fake.getRanksReturnsOnCall[len(fake.getRanksArgsForCall)]
+	fake.getRanksArgsForCall = append(fake.getRanksArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetRanks", []interface{}{arg1, arg2})
+	fake.getRanksMutex.Unlock()
+	if fake.GetRanksStub != nil {
+		return fake.GetRanksStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getRanksReturns.result1, fake.getRanksReturns.result2
+}
+
+func (fake *FakeManager) GetRanksCallCount() int {
+	fake.getRanksMutex.RLock()
+	defer fake.getRanksMutex.RUnlock()
+	return len(fake.getRanksArgsForCall)
+}
+
+func (fake *FakeManager) GetRanksArgsForCall(i int) (context.Context, faction.Identifier) {
+	fake.getRanksMutex.RLock()
+	defer fake.getRanksMutex.RUnlock()
+	return fake.getRanksArgsForCall[i].arg1, fake.getRanksArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetRanksReturns(result1 []*faction.Rank, result2 error) {
+	fake.GetRanksStub = nil
+	fake.getRanksReturns = struct {
+		result1 []*faction.Rank
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetRanksReturnsOnCall(i int, result1 []*faction.Rank, result2 error) {
+	fake.GetRanksStub = nil
+	if fake.getRanksReturnsOnCall == nil {
+		fake.getRanksReturnsOnCall = make(map[int]struct {
+			result1 []*faction.Rank
+			result2 error
+		})
+	}
+	fake.getRanksReturnsOnCall[i] = struct {
+		result1 []*faction.Rank
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) SetRank(arg1 context.Context, arg2 faction.Identifier, arg3 *faction.Rank) error {
+	fake.setRankMutex.Lock()
+	ret, specificReturn := fake.setRankReturnsOnCall[len(fake.setRankArgsForCall)]
+	fake.setRankArgsForCall = append(fake.setRankArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 *faction.Rank
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetRank", []interface{}{arg1, arg2, arg3})
+	fake.setRankMutex.Unlock()
+	if fake.SetRankStub != nil {
+		return fake.SetRankStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setRankReturns.result1
+}
+
+func (fake *FakeManager) SetRankCallCount() int {
+	fake.setRankMutex.RLock()
+	defer fake.setRankMutex.RUnlock()
+	return len(fake.setRankArgsForCall)
+}
+
+func (fake *FakeManager) SetRankArgsForCall(i int) (context.Context, faction.Identifier, *faction.Rank) {
+	fake.setRankMutex.RLock()
+	defer fake.setRankMutex.RUnlock()
+	return fake.setRankArgsForCall[i].arg1, fake.setRankArgsForCall[i].arg2, fake.setRankArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) SetRankReturns(result1 error) {
+	fake.SetRankStub = nil
+	fake.setRankReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) SetRankReturnsOnCall(i int, result1 error) {
+	fake.SetRankStub = nil
+	if fake.setRankReturnsOnCall == nil {
+		fake.setRankReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setRankReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) DeleteRank(arg1 context.Context, arg2 faction.Identifier, arg3 string) error {
+	fake.deleteRankMutex.Lock()
+	ret, specificReturn := fake.deleteRankReturnsOnCall[len(fake.deleteRankArgsForCall)]
+	fake.deleteRankArgsForCall = append(fake.deleteRankArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteRank", []interface{}{arg1, arg2, arg3})
+	fake.deleteRankMutex.Unlock()
+	if fake.DeleteRankStub != nil {
+		return fake.DeleteRankStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteRankReturns.result1
+}
+
+func (fake *FakeManager) DeleteRankCallCount() int {
+	fake.deleteRankMutex.RLock()
+	defer fake.deleteRankMutex.RUnlock()
+	return len(fake.deleteRankArgsForCall)
+}
+
+func (fake *FakeManager) DeleteRankArgsForCall(i int) (context.Context, faction.Identifier, string) {
+	fake.deleteRankMutex.RLock()
+	defer fake.deleteRankMutex.RUnlock()
+	return fake.deleteRankArgsForCall[i].arg1, fake.deleteRankArgsForCall[i].arg2, fake.deleteRankArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) DeleteRankReturns(result1 error) {
+	fake.DeleteRankStub = nil
+	fake.deleteRankReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) DeleteRankReturnsOnCall(i int, result1 error) {
+	fake.DeleteRankStub = nil
+	if fake.deleteRankReturnsOnCall == nil {
+		fake.deleteRankReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRankReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) GetMembers(arg1 context.Context, arg2 faction.Identifier) ([]*faction.Member, error) {
+	fake.getMembersMutex.Lock()
+	ret, specificReturn := fake.getMembersReturnsOnCall[len(fake.getMembersArgsForCall)]
+	fake.getMembersArgsForCall = append(fake.getMembersArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetMembers", []interface{}{arg1, arg2})
+	fake.getMembersMutex.Unlock()
+	if fake.GetMembersStub != nil {
+		return fake.GetMembersStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getMembersReturns.result1, fake.getMembersReturns.result2
+}
+
+func (fake *FakeManager) GetMembersCallCount() int {
+	fake.getMembersMutex.RLock()
+	defer fake.getMembersMutex.RUnlock()
+	return len(fake.getMembersArgsForCall)
+}
+
+func (fake *FakeManager) GetMembersArgsForCall(i int) (context.Context, faction.Identifier) {
+	fake.getMembersMutex.RLock()
+	defer fake.getMembersMutex.RUnlock()
+	return fake.getMembersArgsForCall[i].arg1, fake.getMembersArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetMembersReturns(result1 []*faction.Member, result2 error) {
+	fake.GetMembersStub = nil
+	fake.getMembersReturns = struct {
+		result1 []*faction.Member
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetMembersReturnsOnCall(i int, result1 []*faction.Member, result2 error) {
+	fake.GetMembersStub = nil
+	if fake.getMembersReturnsOnCall == nil {
+		fake.getMembersReturnsOnCall = make(map[int]struct {
+			result1 []*faction.Member
+			result2 error
+		})
+	}
+	fake.getMembersReturnsOnCall[i] = struct {
+		result1 []*faction.Member
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) SetMember(arg1 context.Context, arg2 faction.Identifier, arg3 *faction.Member) error {
+	fake.setMemberMutex.Lock()
+	ret, specificReturn := fake.setMemberReturnsOnCall[len(fake.setMemberArgsForCall)]
+	fake.setMemberArgsForCall = append(fake.setMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 *faction.Member
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetMember", []interface{}{arg1, arg2, arg3})
+	fake.setMemberMutex.Unlock()
+	if fake.SetMemberStub != nil {
+		return fake.SetMemberStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setMemberReturns.result1
+}
+
+func (fake *FakeManager) SetMemberCallCount() int {
+	fake.setMemberMutex.RLock()
+	defer fake.setMemberMutex.RUnlock()
+	return len(fake.setMemberArgsForCall)
+}
+
+func (fake *FakeManager) SetMemberArgsForCall(i int) (context.Context, faction.Identifier, *faction.Member) {
+	fake.setMemberMutex.RLock()
+	defer fake.setMemberMutex.RUnlock()
+	return fake.setMemberArgsForCall[i].arg1, fake.setMemberArgsForCall[i].arg2, fake.setMemberArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) SetMemberReturns(result1 error) {
+	fake.SetMemberStub = nil
+	fake.setMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) SetMemberReturnsOnCall(i int, result1 error) {
+	fake.SetMemberStub = nil
+	if fake.setMemberReturnsOnCall == nil {
+		fake.setMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) RemoveMember(arg1 context.Context, arg2 faction.Identifier, arg3 rbac.AccountID) error {
+	fake.removeMemberMutex.Lock()
+	ret, specificReturn := fake.removeMemberReturnsOnCall[len(fake.removeMemberArgsForCall)]
+	fake.removeMemberArgsForCall = append(fake.removeMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RemoveMember", []interface{}{arg1, arg2, arg3})
+	fake.removeMemberMutex.Unlock()
+	if fake.RemoveMemberStub != nil {
+		return fake.RemoveMemberStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.removeMemberReturns.result1
+}
+
+func (fake *FakeManager) RemoveMemberCallCount() int {
+	fake.removeMemberMutex.RLock()
+	defer fake.removeMemberMutex.RUnlock()
+	return len(fake.removeMemberArgsForCall)
+}
+
+func (fake *FakeManager) RemoveMemberArgsForCall(i int) (context.Context, faction.Identifier, rbac.AccountID) {
+	fake.removeMemberMutex.RLock()
+	defer fake.removeMemberMutex.RUnlock()
+	return fake.removeMemberArgsForCall[i].arg1, fake.removeMemberArgsForCall[i].arg2, fake.removeMemberArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) RemoveMemberReturns(result1 error) {
+	fake.RemoveMemberStub = nil
+	fake.removeMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) RemoveMemberReturnsOnCall(i int, result1 error) {
+	fake.RemoveMemberStub = nil
+	if fake.removeMemberReturnsOnCall == nil {
+		fake.removeMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Sync(arg1 context.Context, arg2 faction.Identifier) error {
+	fake.syncMutex.Lock()
+	ret, specificReturn := fake.syncReturnsOnCall[len(fake.syncArgsForCall)]
+	fake.syncArgsForCall = append(fake.syncArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Sync", []interface{}{arg1, arg2})
+	fake.syncMutex.Unlock()
+	if fake.SyncStub != nil {
+		return fake.SyncStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.syncReturns.result1
+}
+
+func (fake *FakeManager) SyncCallCount() int {
+	fake.syncMutex.RLock()
+	defer fake.syncMutex.RUnlock()
+	return len(fake.syncArgsForCall)
+}
+
+func (fake *FakeManager) SyncArgsForCall(i int) (context.Context, faction.Identifier) {
+	fake.syncMutex.RLock()
+	defer fake.syncMutex.RUnlock()
+	return fake.syncArgsForCall[i].arg1, fake.syncArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) SyncReturns(result1 error) {
+	fake.SyncStub = nil
+	fake.syncReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) SyncReturnsOnCall(i int, result1 error) {
+	fake.SyncStub = nil
+	if fake.syncReturnsOnCall == nil {
+		fake.syncReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.syncReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Invite(arg1 context.Context, arg2 faction.Identifier, arg3 rbac.AccountID) error {
+	fake.inviteMutex.Lock()
+	ret, specificReturn := fake.inviteReturnsOnCall[len(fake.inviteArgsForCall)]
+	fake.inviteArgsForCall = append(fake.inviteArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Invite", []interface{}{arg1, arg2, arg3})
+	fake.inviteMutex.Unlock()
+	if fake.InviteStub != nil {
+		return fake.InviteStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.inviteReturns.result1
+}
+
+func (fake *FakeManager) InviteCallCount() int {
+	fake.inviteMutex.RLock()
+	defer fake.inviteMutex.RUnlock()
+	return len(fake.inviteArgsForCall)
+}
+
+func (fake *FakeManager) InviteArgsForCall(i int) (context.Context, faction.Identifier, rbac.AccountID) {
+	fake.inviteMutex.RLock()
+	defer fake.inviteMutex.RUnlock()
+	return fake.inviteArgsForCall[i].arg1, fake.inviteArgsForCall[i].arg2, fake.inviteArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) InviteReturns(result1 error) {
+	fake.InviteStub = nil
+	fake.inviteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) InviteReturnsOnCall(i int, result1 error) {
+	fake.InviteStub = nil
+	if fake.inviteReturnsOnCall == nil {
+		fake.inviteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.inviteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Promote(arg1 context.Context, arg2 faction.Identifier, arg3 rbac.AccountID) error {
+	fake.promoteMutex.Lock()
+	ret, specificReturn := fake.promoteReturnsOnCall[len(fake.promoteArgsForCall)]
+	fake.promoteArgsForCall = append(fake.promoteArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Promote", []interface{}{arg1, arg2, arg3})
+	fake.promoteMutex.Unlock()
+	if fake.PromoteStub != nil {
+		return fake.PromoteStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.promoteReturns.result1
+}
+
+func (fake *FakeManager) PromoteCallCount() int {
+	fake.promoteMutex.RLock()
+	defer fake.promoteMutex.RUnlock()
+	return len(fake.promoteArgsForCall)
+}
+
+func (fake *FakeManager) PromoteArgsForCall(i int) (context.Context, faction.Identifier, rbac.AccountID) {
+	fake.promoteMutex.RLock()
+	defer fake.promoteMutex.RUnlock()
+	return fake.promoteArgsForCall[i].arg1, fake.promoteArgsForCall[i].arg2, fake.promoteArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) PromoteReturns(result1 error) {
+	fake.PromoteStub = nil
+	fake.promoteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) PromoteReturnsOnCall(i int, result1 error) {
+	fake.PromoteStub = nil
+	if fake.promoteReturnsOnCall == nil {
+		fake.promoteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.promoteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Demote(arg1 context.Context, arg2 faction.Identifier, arg3 rbac.AccountID) error {
+	fake.demoteMutex.Lock()
+	ret, specificReturn := fake.demoteReturnsOnCall[len(fake.demoteArgsForCall)]
+	fake.demoteArgsForCall = append(fake.demoteArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Demote", []interface{}{arg1, arg2, arg3})
+	fake.demoteMutex.Unlock()
+	if fake.DemoteStub != nil {
+		return fake.DemoteStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.demoteReturns.result1
+}
+
+func (fake *FakeManager) DemoteCallCount() int {
+	fake.demoteMutex.RLock()
+	defer fake.demoteMutex.RUnlock()
+	return len(fake.demoteArgsForCall)
+}
+
+func (fake *FakeManager) DemoteArgsForCall(i int) (context.Context, faction.Identifier, rbac.AccountID) {
+	fake.demoteMutex.RLock()
+	defer fake.demoteMutex.RUnlock()
+	return fake.demoteArgsForCall[i].arg1, fake.demoteArgsForCall[i].arg2, fake.demoteArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) DemoteReturns(result1 error) {
+	fake.DemoteStub = nil
+	fake.demoteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) DemoteReturnsOnCall(i int, result1 error) {
+	fake.DemoteStub = nil
+	if fake.demoteReturnsOnCall == nil {
+		fake.demoteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.demoteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Kick(arg1 context.Context, arg2 faction.Identifier, arg3 rbac.AccountID) error {
+	fake.kickMutex.Lock()
+	ret, specificReturn := fake.kickReturnsOnCall[len(fake.kickArgsForCall)]
+	fake.kickArgsForCall = append(fake.kickArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Kick", []interface{}{arg1, arg2, arg3})
+	fake.kickMutex.Unlock()
+	if fake.KickStub != nil {
+		return fake.KickStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.kickReturns.result1
+}
+
+func (fake *FakeManager) KickCallCount() int {
+	fake.kickMutex.RLock()
+	defer fake.kickMutex.RUnlock()
+	return len(fake.kickArgsForCall)
+}
+
+func (fake *FakeManager) KickArgsForCall(i int) (context.Context, faction.Identifier, rbac.AccountID) {
+	fake.kickMutex.RLock()
+	defer fake.kickMutex.RUnlock()
+	return fake.kickArgsForCall[i].arg1, fake.kickArgsForCall[i].arg2, fake.kickArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) KickReturns(result1 error) {
+	fake.KickStub = nil
+	fake.kickReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) KickReturnsOnCall(i int, result1 error) {
+	fake.KickStub = nil
+	if fake.kickReturnsOnCall == nil {
+		fake.kickReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.kickReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) AcceptInvite(arg1 context.Context, arg2 faction.Identifier) error {
+	fake.acceptInviteMutex.Lock()
+	ret, specificReturn := fake.acceptInviteReturnsOnCall[len(fake.acceptInviteArgsForCall)]
+	fake.acceptInviteArgsForCall = append(fake.acceptInviteArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("AcceptInvite", []interface{}{arg1, arg2})
+	fake.acceptInviteMutex.Unlock()
+	if fake.AcceptInviteStub != nil {
+		return fake.AcceptInviteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.acceptInviteReturns.result1
+}
+
+func (fake *FakeManager) AcceptInviteCallCount() int {
+	fake.acceptInviteMutex.RLock()
+	defer fake.acceptInviteMutex.RUnlock()
+	return len(fake.acceptInviteArgsForCall)
+}
+
+func (fake *FakeManager) AcceptInviteArgsForCall(i int) (context.Context, faction.Identifier) {
+	fake.acceptInviteMutex.RLock()
+	defer fake.acceptInviteMutex.RUnlock()
+	return fake.acceptInviteArgsForCall[i].arg1, fake.acceptInviteArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) AcceptInviteReturns(result1 error) {
+	fake.AcceptInviteStub = nil
+	fake.acceptInviteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) AcceptInviteReturnsOnCall(i int, result1 error) {
+	fake.AcceptInviteStub = nil
+	if fake.acceptInviteReturnsOnCall == nil {
+		fake.acceptInviteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.acceptInviteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Leave(arg1 context.Context, arg2 faction.Identifier) error {
+	fake.leaveMutex.Lock()
+	ret, specificReturn := fake.leaveReturnsOnCall[len(fake.leaveArgsForCall)]
+	fake.leaveArgsForCall = append(fake.leaveArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Leave", []interface{}{arg1, arg2})
+	fake.leaveMutex.Unlock()
+	if fake.LeaveStub != nil {
+		return fake.LeaveStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.leaveReturns.result1
+}
+
+func (fake *FakeManager) LeaveCallCount() int {
+	fake.leaveMutex.RLock()
+	defer fake.leaveMutex.RUnlock()
+	return len(fake.leaveArgsForCall)
+}
+
+func (fake *FakeManager) LeaveArgsForCall(i int) (context.Context, faction.Identifier) {
+	fake.leaveMutex.RLock()
+	defer fake.leaveMutex.RUnlock()
+	return fake.leaveArgsForCall[i].arg1, fake.leaveArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) LeaveReturns(result1 error) {
+	fake.LeaveStub = nil
+	fake.leaveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) LeaveReturnsOnCall(i int, result1 error) {
+	fake.LeaveStub = nil
+	if fake.leaveReturnsOnCall == nil {
+		fake.leaveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.leaveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.getRanksMutex.RLock()
+	defer fake.getRanksMutex.RUnlock()
+	fake.setRankMutex.RLock()
+	defer fake.setRankMutex.RUnlock()
+	fake.deleteRankMutex.RLock()
+	defer fake.deleteRankMutex.RUnlock()
+	fake.getMembersMutex.RLock()
+	defer fake.getMembersMutex.RUnlock()
+	fake.setMemberMutex.RLock()
+	defer fake.setMemberMutex.RUnlock()
+	fake.removeMemberMutex.RLock()
+	defer fake.removeMemberMutex.RUnlock()
+	fake.syncMutex.RLock()
+	defer fake.syncMutex.RUnlock()
+	fake.inviteMutex.RLock()
+	defer fake.inviteMutex.RUnlock()
+	fake.promoteMutex.RLock()
+	defer fake.promoteMutex.RUnlock()
+	fake.demoteMutex.RLock()
+	defer fake.demoteMutex.RUnlock()
+	fake.kickMutex.RLock()
+	defer fake.kickMutex.RUnlock()
+	fake.acceptInviteMutex.RLock()
+	defer fake.acceptInviteMutex.RUnlock()
+	fake.leaveMutex.RLock()
+	defer fake.leaveMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeManager) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ faction.Manager = new(FakeManager)
@@ -0,0 +1,1033 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/faction"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+type FakeRepository struct {
+	GetStub        func(context.Context, faction.Identifier) (faction.Complete, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}
+	getReturns struct {
+		result1 faction.Complete
+		result2 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 faction.Complete
+		result2 error
+	}
+	CreateStub        func(context.Context, faction.Incomplete) (faction.Complete, error)
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Incomplete
+	}
+	createReturns struct {
+		result1 faction.Complete
+		result2 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 faction.Complete
+		result2 error
+	}
+	UpdateStub        func(context.Context, faction.Complete) error
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Complete
+	}
+	updateReturns struct {
+		result1 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteStub        func(context.Context, faction.Identifier) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetRanksStub        func(context.Context, faction.Identifier) ([]*faction.Rank, error)
+	getRanksMutex       sync.RWMutex
+	getRanksArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}
+	getRanksReturns struct {
+		result1 []*faction.Rank
+		result2 error
+	}
+	getRanksReturnsOnCall map[int]struct {
+		result1 []*faction.Rank
+		result2 error
+	}
+	SetRankStub        func(context.Context, faction.Identifier, *faction.Rank) error
+	setRankMutex       sync.RWMutex
+	setRankArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 *faction.Rank
+	}
+	setRankReturns struct {
+		result1 error
+	}
+	setRankReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteRankStub        func(context.Context, faction.Identifier, string) error
+	deleteRankMutex       sync.RWMutex
+	deleteRankArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 string
+	}
+	deleteRankReturns struct {
+		result1 error
+	}
+	deleteRankReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetMembersStub        func(context.Context, faction.Identifier) ([]*faction.Member, error)
+	getMembersMutex       sync.RWMutex
+	getMembersArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}
+	getMembersReturns struct {
+		result1 []*faction.Member
+		result2 error
+	}
+	getMembersReturnsOnCall map[int]struct {
+		result1 []*faction.Member
+		result2 error
+	}
+	GetMemberStub        func(context.Context, faction.Identifier, rbac.AccountID) (*faction.Member, error)
+	getMemberMutex       sync.RWMutex
+	getMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}
+	getMemberReturns struct {
+		result1 *faction.Member
+		result2 error
+	}
+	getMemberReturnsOnCall map[int]struct {
+		result1 *faction.Member
+		result2 error
+	}
+	SetMemberStub        func(context.Context, faction.Identifier, *faction.Member) error
+	setMemberMutex       sync.RWMutex
+	setMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 *faction.Member
+	}
+	setMemberReturns struct {
+		result1 error
+	}
+	setMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveMemberStub        func(context.Context, faction.Identifier, rbac.AccountID) error
+	removeMemberMutex       sync.RWMutex
+	removeMemberArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}
+	removeMemberReturns struct {
+		result1 error
+	}
+	removeMemberReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetInvitesStub        func(context.Context, faction.Identifier) ([]rbac.AccountID, error)
+	getInvitesMutex       sync.RWMutex
+	getInvitesArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}
+	getInvitesReturns struct {
+		result1 []rbac.AccountID
+		result2 error
+	}
+	getInvitesReturnsOnCall map[int]struct {
+		result1 []rbac.AccountID
+		result2 error
+	}
+	AddInviteStub        func(context.Context, faction.Identifier, rbac.AccountID) error
+	addInviteMutex       sync.RWMutex
+	addInviteArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}
+	addInviteReturns struct {
+		result1 error
+	}
+	addInviteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveInviteStub        func(context.Context, faction.Identifier, rbac.AccountID) error
+	removeInviteMutex       sync.RWMutex
+	removeInviteArgsForCall []struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}
+	removeInviteReturns struct {
+		result1 error
+	}
+	removeInviteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetMembershipsStub        func(context.Context, rbac.AccountID) ([]*faction.Membership, error)
+	getMembershipsMutex       sync.RWMutex
+	getMembershipsArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.AccountID
+	}
+	getMembershipsReturns struct {
+		result1 []*faction.Membership
+		result2 error
+	}
+	getMembershipsReturnsOnCall map[int]struct {
+		result1 []*faction.Membership
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRepository) Get(arg1 context.Context, arg2 faction.Identifier) (faction.Complete, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Get", []interface{}{arg1, arg2})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getReturns.result1, fake.getReturns.result2
+}
+
+func (fake *FakeRepository) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeRepository) GetArgsForCall(i int) (context.Context, faction.Identifier) {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return fake.getArgsForCall[i].arg1, fake.getArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetReturns(result1 faction.Complete, result2 error) {
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 faction.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetReturnsOnCall(i int, result1 faction.Complete, result2 error) {
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 faction.Complete
+			result2 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 faction.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) Create(arg1 context.Context, arg2 faction.Incomplete) (faction.Complete, error) {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Incomplete
+	}{arg1, arg2})
+	fake.recordInvocation("Create", []interface{}{arg1, arg2})
+	fake.createMutex.Unlock()
+	if fake.CreateStub != nil {
+		return fake.CreateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createReturns.result1, fake.createReturns.result2
+}
+
+func (fake *FakeRepository) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeRepository) CreateArgsForCall(i int) (context.Context, faction.Incomplete) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return fake.createArgsForCall[i].arg1, fake.createArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) CreateReturns(result1 faction.Complete, result2 error) {
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 faction.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) CreateReturnsOnCall(i int, result1 faction.Complete, result2 error) {
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 faction.Complete
+			result2 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 faction.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) Update(arg1 context.Context, arg2 faction.Complete) error {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Complete
+	}{arg1, arg2})
+	fake.recordInvocation("Update", []interface{}{arg1, arg2})
+	fake.updateMutex.Unlock()
+	if fake.UpdateStub != nil {
+		return fake.UpdateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateReturns.result1
+}
+
+func (fake *FakeRepository) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeRepository) UpdateArgsForCall(i int) (context.Context, faction.Complete) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return fake.updateArgsForCall[i].arg1, fake.updateArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) UpdateReturns(result1 error) {
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) UpdateReturnsOnCall(i int, result1 error) {
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) Delete(arg1 context.Context, arg2 faction.Identifier) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteReturns.result1
+}
+
+func (fake *FakeRepository) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeRepository) DeleteArgsForCall(i int) (context.Context, faction.Identifier) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return fake.deleteArgsForCall[i].arg1, fake.deleteArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) DeleteReturns(result1 error) {
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeleteReturnsOnCall(i int, result1 error) {
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetRanks(arg1 context.Context, arg2 faction.Identifier) ([]*faction.Rank, error) {
+	fake.getRanksMutex.Lock()
+	ret, specificReturn := fake.getRanksReturnsOnCall[len(fake.getRanksArgsForCall)]
+	fake.getRanksArgsForCall = append(fake.getRanksArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetRanks", []interface{}{arg1, arg2})
+	fake.getRanksMutex.Unlock()
+	if fake.GetRanksStub != nil {
+		return fake.GetRanksStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getRanksReturns.result1, fake.getRanksReturns.result2
+}
+
+func (fake *FakeRepository) GetRanksCallCount() int {
+	fake.getRanksMutex.RLock()
+	defer fake.getRanksMutex.RUnlock()
+	return len(fake.getRanksArgsForCall)
+}
+
+func (fake *FakeRepository) GetRanksArgsForCall(i int) (context.Context, faction.Identifier) {
+	fake.getRanksMutex.RLock()
+	defer fake.getRanksMutex.RUnlock()
+	return fake.getRanksArgsForCall[i].arg1, fake.getRanksArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetRanksReturns(result1 []*faction.Rank, result2 error) {
+	fake.GetRanksStub = nil
+	fake.getRanksReturns = struct {
+		result1 []*faction.Rank
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetRanksReturnsOnCall(i int, result1 []*faction.Rank, result2 error) {
+	fake.GetRanksStub = nil
+	if fake.getRanksReturnsOnCall == nil {
+		fake.getRanksReturnsOnCall = make(map[int]struct {
+			result1 []*faction.Rank
+			result2 error
+		})
+	}
+	fake.getRanksReturnsOnCall[i] = struct {
+		result1 []*faction.Rank
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) SetRank(arg1 context.Context, arg2 faction.Identifier, arg3 *faction.Rank) error {
+	fake.setRankMutex.Lock()
+	ret, specificReturn := fake.setRankReturnsOnCall[len(fake.setRankArgsForCall)]
+	fake.setRankArgsForCall = append(fake.setRankArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 *faction.Rank
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetRank", []interface{}{arg1, arg2, arg3})
+	fake.setRankMutex.Unlock()
+	if fake.SetRankStub != nil {
+		return fake.SetRankStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setRankReturns.result1
+}
+
+func (fake *FakeRepository) SetRankCallCount() int {
+	fake.setRankMutex.RLock()
+	defer fake.setRankMutex.RUnlock()
+	return len(fake.setRankArgsForCall)
+}
+
+func (fake *FakeRepository) SetRankArgsForCall(i int) (context.Context, faction.Identifier, *faction.Rank) {
+	fake.setRankMutex.RLock()
+	defer fake.setRankMutex.RUnlock()
+	return fake.setRankArgsForCall[i].arg1, fake.setRankArgsForCall[i].arg2, fake.setRankArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) SetRankReturns(result1 error) {
+	fake.SetRankStub = nil
+	fake.setRankReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) SetRankReturnsOnCall(i int, result1 error) {
+	fake.SetRankStub = nil
+	if fake.setRankReturnsOnCall == nil {
+		fake.setRankReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setRankReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeleteRank(arg1 context.Context, arg2 faction.Identifier, arg3 string) error {
+	fake.deleteRankMutex.Lock()
+	ret, specificReturn := fake.deleteRankReturnsOnCall[len(fake.deleteRankArgsForCall)]
+	fake.deleteRankArgsForCall = append(fake.deleteRankArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteRank", []interface{}{arg1, arg2, arg3})
+	fake.deleteRankMutex.Unlock()
+	if fake.DeleteRankStub != nil {
+		return fake.DeleteRankStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteRankReturns.result1
+}
+
+func (fake *FakeRepository) DeleteRankCallCount() int {
+	fake.deleteRankMutex.RLock()
+	defer fake.deleteRankMutex.RUnlock()
+	return len(fake.deleteRankArgsForCall)
+}
+
+func (fake *FakeRepository) DeleteRankArgsForCall(i int) (context.Context, faction.Identifier, string) {
+	fake.deleteRankMutex.RLock()
+	defer fake.deleteRankMutex.RUnlock()
+	return fake.deleteRankArgsForCall[i].arg1, fake.deleteRankArgsForCall[i].arg2, fake.deleteRankArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) DeleteRankReturns(result1 error) {
+	fake.DeleteRankStub = nil
+	fake.deleteRankReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeleteRankReturnsOnCall(i int, result1 error) {
+	fake.DeleteRankStub = nil
+	if fake.deleteRankReturnsOnCall == nil {
+		fake.deleteRankReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRankReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetMembers(arg1 context.Context, arg2 faction.Identifier) ([]*faction.Member, error) {
+	fake.getMembersMutex.Lock()
+	ret, specificReturn := fake.getMembersReturnsOnCall[len(fake.getMembersArgsForCall)]
+	fake.getMembersArgsForCall = append(fake.getMembersArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetMembers", []interface{}{arg1, arg2})
+	fake.getMembersMutex.Unlock()
+	if fake.GetMembersStub != nil {
+		return fake.GetMembersStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getMembersReturns.result1, fake.getMembersReturns.result2
+}
+
+func (fake *FakeRepository) GetMembersCallCount() int {
+	fake.getMembersMutex.RLock()
+	defer fake.getMembersMutex.RUnlock()
+	return len(fake.getMembersArgsForCall)
+}
+
+func (fake *FakeRepository) GetMembersArgsForCall(i int) (context.Context, faction.Identifier) {
+	fake.getMembersMutex.RLock()
+	defer fake.getMembersMutex.RUnlock()
+	return fake.getMembersArgsForCall[i].arg1, fake.getMembersArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetMembersReturns(result1 []*faction.Member, result2 error) {
+	fake.GetMembersStub = nil
+	fake.getMembersReturns = struct {
+		result1 []*faction.Member
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetMembersReturnsOnCall(i int, result1 []*faction.Member, result2 error) {
+	fake.GetMembersStub = nil
+	if fake.getMembersReturnsOnCall == nil {
+		fake.getMembersReturnsOnCall = make(map[int]struct {
+			result1 []*faction.Member
+			result2 error
+		})
+	}
+	fake.getMembersReturnsOnCall[i] = struct {
+		result1 []*faction.Member
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetMember(arg1 context.Context, arg2 faction.Identifier, arg3 rbac.AccountID) (*faction.Member, error) {
+	fake.getMemberMutex.Lock()
+	ret, specificReturn := fake.getMemberReturnsOnCall[len(fake.getMemberArgsForCall)]
+	fake.getMemberArgsForCall = append(fake.getMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetMember", []interface{}{arg1, arg2, arg3})
+	fake.getMemberMutex.Unlock()
+	if fake.GetMemberStub != nil {
+		return fake.GetMemberStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getMemberReturns.result1, fake.getMemberReturns.result2
+}
+
+func (fake *FakeRepository) GetMemberCallCount() int {
+	fake.getMemberMutex.RLock()
+	defer fake.getMemberMutex.RUnlock()
+	return len(fake.getMemberArgsForCall)
+}
+
+func (fake *FakeRepository) GetMemberArgsForCall(i int) (context.Context, faction.Identifier, rbac.AccountID) {
+	fake.getMemberMutex.RLock()
+	defer fake.getMemberMutex.RUnlock()
+	return fake.getMemberArgsForCall[i].arg1, fake.getMemberArgsForCall[i].arg2, fake.getMemberArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) GetMemberReturns(result1 *faction.Member, result2 error) {
+	fake.GetMemberStub = nil
+	fake.getMemberReturns = struct {
+		result1 *faction.Member
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetMemberReturnsOnCall(i int, result1 *faction.Member, result2 error) {
+	fake.GetMemberStub = nil
+	if fake.getMemberReturnsOnCall == nil {
+		fake.getMemberReturnsOnCall = make(map[int]struct {
+			result1 *faction.Member
+			result2 error
+		})
+	}
+	fake.getMemberReturnsOnCall[i] = struct {
+		result1 *faction.Member
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) SetMember(arg1 context.Context, arg2 faction.Identifier, arg3 *faction.Member) error {
+	fake.setMemberMutex.Lock()
+	ret, specificReturn := fake.setMemberReturnsOnCall[len(fake.setMemberArgsForCall)]
+	fake.setMemberArgsForCall = append(fake.setMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 *faction.Member
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetMember", []interface{}{arg1, arg2, arg3})
+	fake.setMemberMutex.Unlock()
+	if fake.SetMemberStub != nil {
+		return fake.SetMemberStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setMemberReturns.result1
+}
+
+func (fake *FakeRepository) SetMemberCallCount() int {
+	fake.setMemberMutex.RLock()
+	defer fake.setMemberMutex.RUnlock()
+	return len(fake.setMemberArgsForCall)
+}
+
+func (fake *FakeRepository) SetMemberArgsForCall(i int) (context.Context, faction.Identifier, *faction.Member) {
+	fake.setMemberMutex.RLock()
+	defer fake.setMemberMutex.RUnlock()
+	return fake.setMemberArgsForCall[i].arg1, fake.setMemberArgsForCall[i].arg2, fake.setMemberArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) SetMemberReturns(result1 error) {
+	fake.SetMemberStub = nil
+	fake.setMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) SetMemberReturnsOnCall(i int, result1 error) {
+	fake.SetMemberStub = nil
+	if fake.setMemberReturnsOnCall == nil {
+		fake.setMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) RemoveMember(arg1 context.Context, arg2 faction.Identifier, arg3 rbac.AccountID) error {
+	fake.removeMemberMutex.Lock()
+	ret, specificReturn := fake.removeMemberReturnsOnCall[len(fake.removeMemberArgsForCall)]
+	fake.removeMemberArgsForCall = append(fake.removeMemberArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RemoveMember", []interface{}{arg1, arg2, arg3})
+	fake.removeMemberMutex.Unlock()
+	if fake.RemoveMemberStub != nil {
+		return fake.RemoveMemberStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.removeMemberReturns.result1
+}
+
+func (fake *FakeRepository) RemoveMemberCallCount() int {
+	fake.removeMemberMutex.RLock()
+	defer fake.removeMemberMutex.RUnlock()
+	return len(fake.removeMemberArgsForCall)
+}
+
+func (fake *FakeRepository) RemoveMemberArgsForCall(i int) (context.Context, faction.Identifier, rbac.AccountID) {
+	fake.removeMemberMutex.RLock()
+	defer fake.removeMemberMutex.RUnlock()
+	return fake.removeMemberArgsForCall[i].arg1, fake.removeMemberArgsForCall[i].arg2, fake.removeMemberArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) RemoveMemberReturns(result1 error) {
+	fake.RemoveMemberStub = nil
+	fake.removeMemberReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) RemoveMemberReturnsOnCall(i int, result1 error) {
+	fake.RemoveMemberStub = nil
+	if fake.removeMemberReturnsOnCall == nil {
+		fake.removeMemberReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeMemberReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetInvites(arg1 context.Context, arg2 faction.Identifier) ([]rbac.AccountID, error) {
+	fake.getInvitesMutex.Lock()
+	ret, specificReturn := fake.getInvitesReturnsOnCall[len(fake.getInvitesArgsForCall)]
+	fake.getInvitesArgsForCall = append(fake.getInvitesArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetInvites", []interface{}{arg1, arg2})
+	fake.getInvitesMutex.Unlock()
+	if fake.GetInvitesStub != nil {
+		return fake.GetInvitesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getInvitesReturns.result1, fake.getInvitesReturns.result2
+}
+
+func (fake *FakeRepository) GetInvitesCallCount() int {
+	fake.getInvitesMutex.RLock()
+	defer fake.getInvitesMutex.RUnlock()
+	return len(fake.getInvitesArgsForCall)
+}
+
+func (fake *FakeRepository) GetInvitesArgsForCall(i int) (context.Context, faction.Identifier) {
+	fake.getInvitesMutex.RLock()
+	defer fake.getInvitesMutex.RUnlock()
+	return fake.getInvitesArgsForCall[i].arg1, fake.getInvitesArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetInvitesReturns(result1 []rbac.AccountID, result2 error) {
+	fake.GetInvitesStub = nil
+	fake.getInvitesReturns = struct {
+		result1 []rbac.AccountID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetInvitesReturnsOnCall(i int, result1 []rbac.AccountID, result2 error) {
+	fake.GetInvitesStub = nil
+	if fake.getInvitesReturnsOnCall == nil {
+		fake.getInvitesReturnsOnCall = make(map[int]struct {
+			result1 []rbac.AccountID
+			result2 error
+		})
+	}
+	fake.getInvitesReturnsOnCall[i] = struct {
+		result1 []rbac.AccountID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) AddInvite(arg1 context.Context, arg2 faction.Identifier, arg3 rbac.AccountID) error {
+	fake.addInviteMutex.Lock()
+	ret, specificReturn := fake.addInviteReturnsOnCall[len(fake.addInviteArgsForCall)]
+	fake.addInviteArgsForCall = append(fake.addInviteArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AddInvite", []interface{}{arg1, arg2, arg3})
+	fake.addInviteMutex.Unlock()
+	if fake.AddInviteStub != nil {
+		return fake.AddInviteStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.addInviteReturns.result1
+}
+
+func (fake *FakeRepository) AddInviteCallCount() int {
+	fake.addInviteMutex.RLock()
+	defer fake.addInviteMutex.RUnlock()
+	return len(fake.addInviteArgsForCall)
+}
+
+func (fake *FakeRepository) AddInviteArgsForCall(i int) (context.Context, faction.Identifier, rbac.AccountID) {
+	fake.addInviteMutex.RLock()
+	defer fake.addInviteMutex.RUnlock()
+	return fake.addInviteArgsForCall[i].arg1, fake.addInviteArgsForCall[i].arg2, fake.addInviteArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) AddInviteReturns(result1 error) {
+	fake.AddInviteStub = nil
+	fake.addInviteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) AddInviteReturnsOnCall(i int, result1 error) {
+	fake.AddInviteStub = nil
+	if fake.addInviteReturnsOnCall == nil {
+		fake.addInviteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addInviteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) RemoveInvite(arg1 context.Context, arg2 faction.Identifier, arg3 rbac.AccountID) error {
+	fake.removeInviteMutex.Lock()
+	ret, specificReturn := fake.removeInviteReturnsOnCall[len(fake.removeInviteArgsForCall)]
+	fake.removeInviteArgsForCall = append(fake.removeInviteArgsForCall, struct {
+		arg1 context.Context
+		arg2 faction.Identifier
+		arg3 rbac.AccountID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RemoveInvite", []interface{}{arg1, arg2, arg3})
+	fake.removeInviteMutex.Unlock()
+	if fake.RemoveInviteStub != nil {
+		return fake.RemoveInviteStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.removeInviteReturns.result1
+}
+
+func (fake *FakeRepository) RemoveInviteCallCount() int {
+	fake.removeInviteMutex.RLock()
+	defer fake.removeInviteMutex.RUnlock()
+	return len(fake.removeInviteArgsForCall)
+}
+
+func (fake *FakeRepository) RemoveInviteArgsForCall(i int) (context.Context, faction.Identifier, rbac.AccountID) {
+	fake.removeInviteMutex.RLock()
+	defer fake.removeInviteMutex.RUnlock()
+	return fake.removeInviteArgsForCall[i].arg1, fake.removeInviteArgsForCall[i].arg2, fake.removeInviteArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) RemoveInviteReturns(result1 error) {
+	fake.RemoveInviteStub = nil
+	fake.removeInviteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) RemoveInviteReturnsOnCall(i int, result1 error) {
+	fake.RemoveInviteStub = nil
+	if fake.removeInviteReturnsOnCall == nil {
+		fake.removeInviteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeInviteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetMemberships(arg1 context.Context, arg2 rbac.AccountID) ([]*faction.Membership, error) {
+	fake.getMembershipsMutex.Lock()
+	ret, specificReturn := fake.getMembershipsReturnsOnCall[len(fake.getMembershipsArgsForCall)]
+	fake.getMembershipsArgsForCall = append(fake.getMembershipsArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.AccountID
+	}{arg1, arg2})
+	fake.recordInvocation("GetMemberships", []interface{}{arg1, arg2})
+	fake.getMembershipsMutex.Unlock()
+	if fake.GetMembershipsStub != nil {
+		return fake.GetMembershipsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getMembershipsReturns.result1, fake.getMembershipsReturns.result2
+}
+
+func (fake *FakeRepository) GetMembershipsCallCount() int {
+	fake.getMembershipsMutex.RLock()
+	defer fake.getMembershipsMutex.RUnlock()
+	return len(fake.getMembershipsArgsForCall)
+}
+
+func (fake *FakeRepository) GetMembershipsArgsForCall(i int) (context.Context, rbac.AccountID) {
+	fake.getMembershipsMutex.RLock()
+	defer fake.getMembershipsMutex.RUnlock()
+	return fake.getMembershipsArgsForCall[i].arg1, fake.getMembershipsArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetMembershipsReturns(result1 []*faction.Membership, result2 error) {
+	fake.GetMembershipsStub = nil
+	fake.getMembershipsReturns = struct {
+		result1 []*faction.Membership
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetMembershipsReturnsOnCall(i int, result1 []*faction.Membership, result2 error) {
+	fake.GetMembershipsStub = nil
+	if fake.getMembershipsReturnsOnCall == nil {
+		fake.getMembershipsReturnsOnCall = make(map[int]struct {
+			result1 []*faction.Membership
+			result2 error
+		})
+	}
+	fake.getMembershipsReturnsOnCall[i] = struct {
+		result1 []*faction.Membership
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	fake.getRanksMutex.RLock()
+	defer fake.getRanksMutex.RUnlock()
+	fake.setRankMutex.RLock()
+	defer fake.setRankMutex.RUnlock()
+	fake.deleteRankMutex.RLock()
+	defer fake.deleteRankMutex.RUnlock()
+	fake.getMembersMutex.RLock()
+	defer fake.getMembersMutex.RUnlock()
+	fake.getMemberMutex.RLock()
+	defer fake.getMemberMutex.RUnlock()
+	fake.setMemberMutex.RLock()
+	defer fake.setMemberMutex.RUnlock()
+	fake.removeMemberMutex.RLock()
+	defer fake.removeMemberMutex.RUnlock()
+	fake.getInvitesMutex.RLock()
+	defer fake.getInvitesMutex.RUnlock()
+	fake.addInviteMutex.RLock()
+	defer fake.addInviteMutex.RUnlock()
+	fake.removeInviteMutex.RLock()
+	defer fake.removeInviteMutex.RUnlock()
+	fake.getMembershipsMutex.RLock()
+	defer fake.getMembershipsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ faction.Repository = new(FakeRepository)
@@ -0,0 +1,62 @@
+package faction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+// PrivacyHandler exports and erases the faction memberships and invites of users.
+// The rbac roles of the ranks are erased by the rbac service.
+type PrivacyHandler struct {
+	repository Repository
+}
+
+// NewPrivacyHandler for the data of the faction service
+func NewPrivacyHandler(r Repository) *PrivacyHandler {
+	return &PrivacyHandler{r}
+}
+
+type privacyExport struct {
+	Memberships []*Membership `json:"memberships"`
+}
+
+func userAccount(userUUID string) rbac.AccountID {
+	return rbac.AccountID(fmt.Sprintf("user/%s", userUUID))
+}
+
+// Export the memberships and invites of a user
+func (h *PrivacyHandler) Export(ctx context.Context, userUUID string) (interface{}, error) {
+	memberships, err := h.repository.GetMemberships(ctx, userAccount(userUUID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &privacyExport{memberships}, nil
+}
+
+// Erase the memberships and invites of a user
+func (h *PrivacyHandler) Erase(ctx context.Context, userUUID string) error {
+	account := userAccount(userUUID)
+
+	memberships, err := h.repository.GetMemberships(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range memberships {
+		id := NewIdentifier(v.FactionGUID)
+		if v.Invited {
+			err = h.repository.RemoveInvite(ctx, id, account)
+		} else {
+			err = h.repository.RemoveMember(ctx, id, account)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,1200 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: manager.proto
+
+package faction
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Identifier struct {
+	GUID                 string   `protobuf:"bytes,1,opt,name=GUID,proto3" json:"GUID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Identifier) Reset()         { *m = Identifier{} }
+func (m *Identifier) String() string { return proto.CompactTextString(m) }
+func (*Identifier) ProtoMessage()    {}
+func (*Identifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{0}
+}
+
+func (m *Identifier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Identifier.Unmarshal(m, b)
+}
+func (m *Identifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Identifier.Marshal(b, m, deterministic)
+}
+func (m *Identifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Identifier.Merge(m, src)
+}
+func (m *Identifier) XXX_Size() int {
+	return xxx_messageInfo_Identifier.Size(m)
+}
+func (m *Identifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_Identifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Identifier proto.InternalMessageInfo
+
+func (m *Identifier) GetGUID() string {
+	if m != nil {
+		return m.GUID
+	}
+	return ""
+}
+
+type Incomplete struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Incomplete) Reset()         { *m = Incomplete{} }
+func (m *Incomplete) String() string { return proto.CompactTextString(m) }
+func (*Incomplete) ProtoMessage()    {}
+func (*Incomplete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{1}
+}
+
+func (m *Incomplete) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Incomplete.Unmarshal(m, b)
+}
+func (m *Incomplete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Incomplete.Marshal(b, m, deterministic)
+}
+func (m *Incomplete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Incomplete.Merge(m, src)
+}
+func (m *Incomplete) XXX_Size() int {
+	return xxx_messageInfo_Incomplete.Size(m)
+}
+func (m *Incomplete) XXX_DiscardUnknown() {
+	xxx_messageInfo_Incomplete.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Incomplete proto.InternalMessageInfo
+
+func (m *Incomplete) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Incomplete) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type Complete struct {
+	Identifier           *Identifier `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Incomplete           *Incomplete `protobuf:"bytes,2,opt,name=Incomplete,proto3" json:"Incomplete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Complete) Reset()         { *m = Complete{} }
+func (m *Complete) String() string { return proto.CompactTextString(m) }
+func (*Complete) ProtoMessage()    {}
+func (*Complete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{2}
+}
+
+func (m *Complete) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Complete.Unmarshal(m, b)
+}
+func (m *Complete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Complete.Marshal(b, m, deterministic)
+}
+func (m *Complete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Complete.Merge(m, src)
+}
+func (m *Complete) XXX_Size() int {
+	return xxx_messageInfo_Complete.Size(m)
+}
+func (m *Complete) XXX_DiscardUnknown() {
+	xxx_messageInfo_Complete.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Complete proto.InternalMessageInfo
+
+func (m *Complete) GetIdentifier() *Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *Complete) GetIncomplete() *Incomplete {
+	if m != nil {
+		return m.Incomplete
+	}
+	return nil
+}
+
+type Rank struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Level                int64    `protobuf:"varint,3,opt,name=Level,proto3" json:"Level,omitempty"`
+	RoleID               string   `protobuf:"bytes,4,opt,name=RoleID,proto3" json:"RoleID,omitempty"`
+	Permissions          []string `protobuf:"bytes,5,rep,name=Permissions,proto3" json:"Permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Rank) Reset()         { *m = Rank{} }
+func (m *Rank) String() string { return proto.CompactTextString(m) }
+func (*Rank) ProtoMessage()    {}
+func (*Rank) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{3}
+}
+
+func (m *Rank) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rank.Unmarshal(m, b)
+}
+func (m *Rank) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rank.Marshal(b, m, deterministic)
+}
+func (m *Rank) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rank.Merge(m, src)
+}
+func (m *Rank) XXX_Size() int {
+	return xxx_messageInfo_Rank.Size(m)
+}
+func (m *Rank) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rank.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rank proto.InternalMessageInfo
+
+func (m *Rank) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Rank) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Rank) GetLevel() int64 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *Rank) GetRoleID() string {
+	if m != nil {
+		return m.RoleID
+	}
+	return ""
+}
+
+func (m *Rank) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+type Ranks struct {
+	Ranks                []*Rank  `protobuf:"bytes,1,rep,name=Ranks,proto3" json:"Ranks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Ranks) Reset()         { *m = Ranks{} }
+func (m *Ranks) String() string { return proto.CompactTextString(m) }
+func (*Ranks) ProtoMessage()    {}
+func (*Ranks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{4}
+}
+
+func (m *Ranks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Ranks.Unmarshal(m, b)
+}
+func (m *Ranks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Ranks.Marshal(b, m, deterministic)
+}
+func (m *Ranks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ranks.Merge(m, src)
+}
+func (m *Ranks) XXX_Size() int {
+	return xxx_messageInfo_Ranks.Size(m)
+}
+func (m *Ranks) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ranks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ranks proto.InternalMessageInfo
+
+func (m *Ranks) GetRanks() []*Rank {
+	if m != nil {
+		return m.Ranks
+	}
+	return nil
+}
+
+type SetRankRequest struct {
+	Identifier           *Identifier `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Rank                 *Rank       `protobuf:"bytes,2,opt,name=Rank,proto3" json:"Rank,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SetRankRequest) Reset()         { *m = SetRankRequest{} }
+func (m *SetRankRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRankRequest) ProtoMessage()    {}
+func (*SetRankRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{5}
+}
+
+func (m *SetRankRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRankRequest.Unmarshal(m, b)
+}
+func (m *SetRankRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRankRequest.Marshal(b, m, deterministic)
+}
+func (m *SetRankRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRankRequest.Merge(m, src)
+}
+func (m *SetRankRequest) XXX_Size() int {
+	return xxx_messageInfo_SetRankRequest.Size(m)
+}
+func (m *SetRankRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRankRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRankRequest proto.InternalMessageInfo
+
+func (m *SetRankRequest) GetIdentifier() *Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *SetRankRequest) GetRank() *Rank {
+	if m != nil {
+		return m.Rank
+	}
+	return nil
+}
+
+type RankRequest struct {
+	Identifier           *Identifier `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	RankID               string      `protobuf:"bytes,2,opt,name=RankID,proto3" json:"RankID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RankRequest) Reset()         { *m = RankRequest{} }
+func (m *RankRequest) String() string { return proto.CompactTextString(m) }
+func (*RankRequest) ProtoMessage()    {}
+func (*RankRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{6}
+}
+
+func (m *RankRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RankRequest.Unmarshal(m, b)
+}
+func (m *RankRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RankRequest.Marshal(b, m, deterministic)
+}
+func (m *RankRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RankRequest.Merge(m, src)
+}
+func (m *RankRequest) XXX_Size() int {
+	return xxx_messageInfo_RankRequest.Size(m)
+}
+func (m *RankRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RankRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RankRequest proto.InternalMessageInfo
+
+func (m *RankRequest) GetIdentifier() *Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *RankRequest) GetRankID() string {
+	if m != nil {
+		return m.RankID
+	}
+	return ""
+}
+
+type Member struct {
+	AccountID            string   `protobuf:"bytes,1,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	RankID               string   `protobuf:"bytes,2,opt,name=RankID,proto3" json:"RankID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Member) Reset()         { *m = Member{} }
+func (m *Member) String() string { return proto.CompactTextString(m) }
+func (*Member) ProtoMessage()    {}
+func (*Member) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{7}
+}
+
+func (m *Member) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Member.Unmarshal(m, b)
+}
+func (m *Member) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Member.Marshal(b, m, deterministic)
+}
+func (m *Member) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Member.Merge(m, src)
+}
+func (m *Member) XXX_Size() int {
+	return xxx_messageInfo_Member.Size(m)
+}
+func (m *Member) XXX_DiscardUnknown() {
+	xxx_messageInfo_Member.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Member proto.InternalMessageInfo
+
+func (m *Member) GetAccountID() string {
+	if m != nil {
+		return m.AccountID
+	}
+	return ""
+}
+
+func (m *Member) GetRankID() string {
+	if m != nil {
+		return m.RankID
+	}
+	return ""
+}
+
+type Members struct {
+	Members              []*Member `protobuf:"bytes,1,rep,name=Members,proto3" json:"Members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Members) Reset()         { *m = Members{} }
+func (m *Members) String() string { return proto.CompactTextString(m) }
+func (*Members) ProtoMessage()    {}
+func (*Members) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{8}
+}
+
+func (m *Members) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Members.Unmarshal(m, b)
+}
+func (m *Members) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Members.Marshal(b, m, deterministic)
+}
+func (m *Members) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Members.Merge(m, src)
+}
+func (m *Members) XXX_Size() int {
+	return xxx_messageInfo_Members.Size(m)
+}
+func (m *Members) XXX_DiscardUnknown() {
+	xxx_messageInfo_Members.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Members proto.InternalMessageInfo
+
+func (m *Members) GetMembers() []*Member {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type SetMemberRequest struct {
+	Identifier           *Identifier `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Member               *Member     `protobuf:"bytes,2,opt,name=Member,proto3" json:"Member,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SetMemberRequest) Reset()         { *m = SetMemberRequest{} }
+func (m *SetMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*SetMemberRequest) ProtoMessage()    {}
+func (*SetMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{9}
+}
+
+func (m *SetMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetMemberRequest.Unmarshal(m, b)
+}
+func (m *SetMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetMemberRequest.Marshal(b, m, deterministic)
+}
+func (m *SetMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMemberRequest.Merge(m, src)
+}
+func (m *SetMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_SetMemberRequest.Size(m)
+}
+func (m *SetMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMemberRequest proto.InternalMessageInfo
+
+func (m *SetMemberRequest) GetIdentifier() *Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *SetMemberRequest) GetMember() *Member {
+	if m != nil {
+		return m.Member
+	}
+	return nil
+}
+
+type AccountRequest struct {
+	Identifier           *Identifier `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	AccountID            string      `protobuf:"bytes,2,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *AccountRequest) Reset()         { *m = AccountRequest{} }
+func (m *AccountRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountRequest) ProtoMessage()    {}
+func (*AccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{10}
+}
+
+func (m *AccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountRequest.Unmarshal(m, b)
+}
+func (m *AccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountRequest.Merge(m, src)
+}
+func (m *AccountRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountRequest.Size(m)
+}
+func (m *AccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountRequest proto.InternalMessageInfo
+
+func (m *AccountRequest) GetIdentifier() *Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *AccountRequest) GetAccountID() string {
+	if m != nil {
+		return m.AccountID
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Identifier)(nil), "faction.Identifier")
+	proto.RegisterType((*Incomplete)(nil), "faction.Incomplete")
+	proto.RegisterType((*Complete)(nil), "faction.Complete")
+	proto.RegisterType((*Rank)(nil), "faction.Rank")
+	proto.RegisterType((*Ranks)(nil), "faction.Ranks")
+	proto.RegisterType((*SetRankRequest)(nil), "faction.SetRankRequest")
+	proto.RegisterType((*RankRequest)(nil), "faction.RankRequest")
+	proto.RegisterType((*Member)(nil), "faction.Member")
+	proto.RegisterType((*Members)(nil), "faction.Members")
+	proto.RegisterType((*SetMemberRequest)(nil), "faction.SetMemberRequest")
+	proto.RegisterType((*AccountRequest)(nil), "faction.AccountRequest")
+}
+
+func init() { proto.RegisterFile("manager.proto", fileDescriptor_cde9ec64f0d2c859) }
+
+var fileDescriptor_cde9ec64f0d2c859 = []byte{
+	// 609 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x6d, 0x9c, 0xd4, 0x6e, 0x27, 0x6d, 0x28, 0x4b, 0x55, 0x4c, 0xe1, 0x60, 0x96, 0x03, 0x45,
+	0x42, 0xae, 0x48, 0x29, 0x08, 0xb5, 0x20, 0x4a, 0x8d, 0x22, 0x0b, 0x8a, 0x2a, 0x57, 0xbd, 0x70,
+	0x73, 0xdd, 0x49, 0xb0, 0x12, 0x7b, 0x8d, 0xbd, 0x89, 0x54, 0x3e, 0x99, 0xaf, 0x40, 0x6b, 0xaf,
+	0x1d, 0x3b, 0x4d, 0x82, 0x92, 0xdc, 0xd6, 0x33, 0xfb, 0xe6, 0xbd, 0x79, 0x99, 0xd9, 0xc0, 0x76,
+	0xe0, 0x86, 0x6e, 0x0f, 0x63, 0x33, 0x8a, 0x19, 0x67, 0x44, 0xeb, 0xba, 0x1e, 0xf7, 0x59, 0xb8,
+	0xff, 0xb4, 0xc7, 0x58, 0x6f, 0x80, 0x87, 0x69, 0xf8, 0x66, 0xd8, 0x3d, 0xc4, 0x20, 0xe2, 0x77,
+	0xd9, 0x2d, 0x6a, 0x00, 0xd8, 0xb7, 0x18, 0x72, 0xbf, 0xeb, 0x63, 0x4c, 0x08, 0x34, 0x3a, 0xd7,
+	0xb6, 0xa5, 0xd7, 0x8c, 0xda, 0xc1, 0xa6, 0x93, 0x9e, 0xe9, 0x17, 0x00, 0x3b, 0xf4, 0x58, 0x10,
+	0x0d, 0x90, 0xa3, 0xb8, 0xf1, 0xc3, 0x0d, 0x30, 0xbf, 0x21, 0xce, 0xc4, 0x80, 0xa6, 0x85, 0x89,
+	0x17, 0xfb, 0x91, 0xe0, 0xd3, 0x95, 0x34, 0x55, 0x0e, 0x51, 0x0e, 0x1b, 0xe7, 0x79, 0x85, 0xa3,
+	0x32, 0x63, 0x5a, 0xa7, 0xd9, 0x7e, 0x64, 0x4a, 0xb1, 0xe6, 0x38, 0xe5, 0x94, 0x85, 0x1d, 0x95,
+	0x45, 0xe8, 0xca, 0x24, 0xa8, 0x48, 0x39, 0xa5, 0x6b, 0xf4, 0x0f, 0x34, 0x1c, 0x37, 0xec, 0x93,
+	0x16, 0x28, 0x45, 0x4f, 0x8a, 0x6d, 0x15, 0x3d, 0x28, 0xa5, 0x1e, 0x76, 0x61, 0xfd, 0x3b, 0x8e,
+	0x70, 0xa0, 0xd7, 0x8d, 0xda, 0x41, 0xdd, 0xc9, 0x3e, 0xc8, 0x1e, 0xa8, 0x0e, 0x1b, 0xa0, 0x6d,
+	0xe9, 0x8d, 0xf4, 0xae, 0xfc, 0x12, 0x1d, 0x5f, 0x62, 0x1c, 0xf8, 0x49, 0xe2, 0xb3, 0x30, 0xd1,
+	0xd7, 0x8d, 0xba, 0xe8, 0xb8, 0x14, 0xa2, 0xaf, 0x61, 0x5d, 0x70, 0x27, 0xe4, 0x85, 0x3c, 0xe8,
+	0x35, 0xa3, 0x7e, 0xd0, 0x6c, 0x6f, 0x17, 0xa2, 0x45, 0xd4, 0xc9, 0x72, 0xf4, 0x17, 0xb4, 0xae,
+	0x90, 0xa7, 0x11, 0xfc, 0x3d, 0xc4, 0x84, 0x2f, 0xe7, 0xd2, 0xf3, 0xac, 0x61, 0xe9, 0xcf, 0x04,
+	0x55, 0x9a, 0xa2, 0x3f, 0xa1, 0xb9, 0x32, 0x8d, 0x70, 0xc5, 0x0d, 0xfb, 0xb6, 0x25, 0x1d, 0x94,
+	0x5f, 0xf4, 0x13, 0xa8, 0x17, 0x18, 0xdc, 0x60, 0x4c, 0x9e, 0xc1, 0xe6, 0x99, 0xe7, 0xb1, 0x61,
+	0xc8, 0x0b, 0xe3, 0xc7, 0x81, 0x99, 0xf8, 0xb7, 0xa0, 0x65, 0xf8, 0x84, 0xbc, 0x2a, 0x8e, 0xd2,
+	0xb7, 0x07, 0x85, 0xa8, 0x2c, 0xee, 0xe4, 0x79, 0x1a, 0xc1, 0xce, 0x15, 0x72, 0x19, 0x5d, 0xa5,
+	0xad, 0x97, 0xb9, 0x7c, 0xe9, 0xdf, 0x3d, 0x4a, 0x99, 0xa6, 0x1e, 0xb4, 0x64, 0x33, 0x2b, 0xf1,
+	0x55, 0x4c, 0x52, 0x26, 0x4c, 0x6a, 0xff, 0xd5, 0x40, 0xbb, 0xc8, 0x16, 0x9a, 0x1c, 0x42, 0xbd,
+	0x83, 0x9c, 0x4c, 0xab, 0xb8, 0xff, 0xb0, 0x08, 0xe6, 0x1b, 0x46, 0xd7, 0x48, 0x1b, 0xd4, 0xf3,
+	0x18, 0x5d, 0x8e, 0x64, 0xda, 0x92, 0x4c, 0xc7, 0x1c, 0x83, 0x7a, 0x1d, 0xdd, 0x0a, 0xcc, 0xfd,
+	0xf4, 0xfe, 0x9e, 0x99, 0x3d, 0x22, 0x66, 0xfe, 0x88, 0x98, 0x5f, 0xc5, 0x23, 0x42, 0xd7, 0xc8,
+	0x7b, 0x50, 0x2d, 0x1c, 0x60, 0x95, 0x6a, 0x2c, 0x6f, 0x36, 0xf0, 0x0d, 0x6c, 0x74, 0xb2, 0x99,
+	0x4f, 0xa6, 0x43, 0x5b, 0x95, 0xf9, 0x4d, 0xe8, 0x1a, 0x39, 0x05, 0x4d, 0xae, 0x09, 0x79, 0x5c,
+	0x24, 0xab, 0x8b, 0x33, 0x87, 0xf0, 0x14, 0x20, 0x53, 0x9a, 0x16, 0xd8, 0xad, 0x6e, 0xc7, 0x7f,
+	0xd1, 0xc7, 0x00, 0x9d, 0x7c, 0xcc, 0x66, 0x08, 0xde, 0x99, 0x18, 0x18, 0x21, 0xf9, 0x33, 0x6c,
+	0x16, 0xd3, 0x49, 0x9e, 0x94, 0x45, 0x57, 0x26, 0x76, 0x0e, 0xf1, 0x19, 0x6c, 0x39, 0x18, 0xb0,
+	0x11, 0xca, 0x22, 0xe3, 0xce, 0xab, 0x43, 0x38, 0x57, 0x7b, 0xe3, 0xea, 0x2e, 0xf4, 0x16, 0xfd,
+	0x85, 0x4e, 0x40, 0xb5, 0xc3, 0x91, 0xcf, 0x71, 0x19, 0xce, 0x53, 0xd0, 0x2e, 0x63, 0x16, 0xb0,
+	0xe5, 0xd0, 0x27, 0x62, 0xaa, 0x96, 0x05, 0x7f, 0x80, 0xc6, 0x37, 0xdf, 0xeb, 0x2f, 0x03, 0xfd,
+	0x08, 0x5b, 0x67, 0x9e, 0x87, 0x11, 0x97, 0x8d, 0x2f, 0xe8, 0xd8, 0x3b, 0xf1, 0x2f, 0xe2, 0x8e,
+	0x16, 0xc5, 0xdd, 0xa8, 0x69, 0xe4, 0xe8, 0xdf, 0x00, 0xd9, 0x40, 0x48, 0x2d, 0xc3, 0x07, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ManagerClient is the client API for Manager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ManagerClient interface {
+	Get(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Complete, error)
+	Create(ctx context.Context, in *Incomplete, opts ...grpc.CallOption) (*Complete, error)
+	Update(ctx context.Context, in *Complete, opts ...grpc.CallOption) (*empty.Empty, error)
+	Delete(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetRanks(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Ranks, error)
+	SetRank(ctx context.Context, in *SetRankRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteRank(ctx context.Context, in *RankRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetMembers(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Members, error)
+	SetMember(ctx context.Context, in *SetMemberRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RemoveMember(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Sync(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*empty.Empty, error)
+	Invite(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Promote(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Demote(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Kick(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	AcceptInvite(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*empty.Empty, error)
+	Leave(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type managerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewManagerClient(cc *grpc.ClientConn) ManagerClient {
+	return &managerClient{cc}
+}
+
+func (c *managerClient) Get(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Complete, error) {
+	out := new(Complete)
+	err := c.cc.Invoke(ctx, "/faction.Manager/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Create(ctx context.Context, in *Incomplete, opts ...grpc.CallOption) (*Complete, error) {
+	out := new(Complete)
+	err := c.cc.Invoke(ctx, "/faction.Manager/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Update(ctx context.Context, in *Complete, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/faction.Manager/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Delete(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/faction.Manager/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetRanks(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Ranks, error) {
+	out := new(Ranks)
+	err := c.cc.Invoke(ctx, "/faction.Manager/GetRanks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) SetRank(ctx context.Context, in *SetRankRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/faction.Manager/SetRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) DeleteRank(ctx context.Context, in *RankRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/faction.Manager/DeleteRank", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetMembers(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Members, error) {
+	out := new(Members)
+	err := c.cc.Invoke(ctx, "/faction.Manager/GetMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) SetMember(ctx context.Context, in *SetMemberRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/faction.Manager/SetMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) RemoveMember(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/faction.Manager/RemoveMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Sync(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/faction.Manager/Sync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Invite(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/faction.Manager/Invite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Promote(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/faction.Manager/Promote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Demote(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/faction.Manager/Demote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Kick(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/faction.Manager/Kick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) AcceptInvite(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/faction.Manager/AcceptInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Leave(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/faction.Manager/Leave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManagerServer is the server API for Manager service.
+type ManagerServer interface {
+	Get(context.Context, *Identifier) (*Complete, error)
+	Create(context.Context, *Incomplete) (*Complete, error)
+	Update(context.Context, *Complete) (*empty.Empty, error)
+	Delete(context.Context, *Identifier) (*empty.Empty, error)
+	GetRanks(context.Context, *Identifier) (*Ranks, error)
+	SetRank(context.Context, *SetRankRequest) (*empty.Empty, error)
+	DeleteRank(context.Context, *RankRequest) (*empty.Empty, error)
+	GetMembers(context.Context, *Identifier) (*Members, error)
+	SetMember(context.Context, *SetMemberRequest) (*empty.Empty, error)
+	RemoveMember(context.Context, *AccountRequest) (*empty.Empty, error)
+	Sync(context.Context, *Identifier) (*empty.Empty, error)
+	Invite(context.Context, *AccountRequest) (*empty.Empty, error)
+	Promote(context.Context, *AccountRequest) (*empty.Empty, error)
+	Demote(context.Context, *AccountRequest) (*empty.Empty, error)
+	Kick(context.Context, *AccountRequest) (*empty.Empty, error)
+	AcceptInvite(context.Context, *Identifier) (*empty.Empty, error)
+	Leave(context.Context, *Identifier) (*empty.Empty, error)
+}
+
+func RegisterManagerServer(s *grpc.Server, srv ManagerServer) {
+	s.RegisterService(&_Manager_serviceDesc, srv)
+}
+
+func _Manager_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Get(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Incomplete)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Create(ctx, req.(*Incomplete))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Complete)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Update(ctx, req.(*Complete))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Delete(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetRanks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetRanks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/GetRanks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetRanks(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_SetRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).SetRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/SetRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).SetRank(ctx, req.(*SetRankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_DeleteRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RankRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).DeleteRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/DeleteRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).DeleteRank(ctx, req.(*RankRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/GetMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetMembers(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_SetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).SetMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/SetMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).SetMember(ctx, req.(*SetMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/RemoveMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).RemoveMember(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/Sync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Sync(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Invite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Invite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/Invite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Invite(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Promote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Promote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/Promote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Promote(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Demote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Demote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/Demote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Demote(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Kick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Kick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/Kick",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Kick(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/AcceptInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).AcceptInvite(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Leave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Leave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/faction.Manager/Leave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Leave(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Manager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "faction.Manager",
+	HandlerType: (*ManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Manager_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _Manager_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Manager_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Manager_Delete_Handler,
+		},
+		{
+			MethodName: "GetRanks",
+			Handler:    _Manager_GetRanks_Handler,
+		},
+		{
+			MethodName: "SetRank",
+			Handler:    _Manager_SetRank_Handler,
+		},
+		{
+			MethodName: "DeleteRank",
+			Handler:    _Manager_DeleteRank_Handler,
+		},
+		{
+			MethodName: "GetMembers",
+			Handler:    _Manager_GetMembers_Handler,
+		},
+		{
+			MethodName: "SetMember",
+			Handler:    _Manager_SetMember_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _Manager_RemoveMember_Handler,
+		},
+		{
+			MethodName: "Sync",
+			Handler:    _Manager_Sync_Handler,
+		},
+		{
+			MethodName: "Invite",
+			Handler:    _Manager_Invite_Handler,
+		},
+		{
+			MethodName: "Promote",
+			Handler:    _Manager_Promote_Handler,
+		},
+		{
+			MethodName: "Demote",
+			Handler:    _Manager_Demote_Handler,
+		},
+		{
+			MethodName: "Kick",
+			Handler:    _Manager_Kick_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _Manager_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "Leave",
+			Handler:    _Manager_Leave_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "manager.proto",
+}
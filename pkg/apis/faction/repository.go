@@ -0,0 +1,31 @@
+package faction
+
+//go:generate counterfeiter -o ./mocks/repository.go . Repository
+
+import (
+	"context"
+
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+// Repository to manage the storage of factions, their ranks, members and invites.
+// Deleting a faction removes its ranks, members and invites as well.
+type Repository interface {
+	Get(context.Context, Identifier) (Complete, error)
+	Create(context.Context, Incomplete) (Complete, error)
+	Update(context.Context, Complete) error
+	Delete(context.Context, Identifier) error
+	// GetRanks returns the ranks of a faction ordered by their level
+	GetRanks(context.Context, Identifier) ([]*Rank, error)
+	SetRank(context.Context, Identifier, *Rank) error
+	DeleteRank(context.Context, Identifier, string) error
+	GetMembers(context.Context, Identifier) ([]*Member, error)
+	GetMember(context.Context, Identifier, rbac.AccountID) (*Member, error)
+	SetMember(context.Context, Identifier, *Member) error
+	RemoveMember(context.Context, Identifier, rbac.AccountID) error
+	GetInvites(context.Context, Identifier) ([]rbac.AccountID, error)
+	AddInvite(context.Context, Identifier, rbac.AccountID) error
+	RemoveInvite(context.Context, Identifier, rbac.AccountID) error
+	// GetMemberships returns the memberships and invites of an account in all factions
+	GetMemberships(context.Context, rbac.AccountID) ([]*Membership, error)
+}
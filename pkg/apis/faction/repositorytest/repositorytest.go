@@ -0,0 +1,373 @@
+// Package repositorytest contains the conformance tests of faction repositories
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/faction"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) faction.Repository) {
+	t.Run("Create", func(t *testing.T) {
+		testCreate(t, newRepository(t))
+	})
+	t.Run("Update", func(t *testing.T) {
+		testUpdate(t, newRepository(t))
+	})
+	t.Run("Delete", func(t *testing.T) {
+		testDelete(t, newRepository(t))
+	})
+	t.Run("Ranks", func(t *testing.T) {
+		testRanks(t, newRepository(t))
+	})
+	t.Run("Members", func(t *testing.T) {
+		testMembers(t, newRepository(t))
+	})
+	t.Run("Invites", func(t *testing.T) {
+		testInvites(t, newRepository(t))
+	})
+	t.Run("Memberships", func(t *testing.T) {
+		testMemberships(t, newRepository(t))
+	})
+}
+
+type complete struct {
+	faction.Identifier
+	faction.Incomplete
+}
+
+func randomIdentifier(t *testing.T) faction.Identifier {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return faction.NewIdentifier(rand.String())
+}
+
+func testCreate(t *testing.T, r faction.Repository) {
+	ctx := context.Background()
+
+	if _, err := r.Get(ctx, randomIdentifier(t)); err != sql.ErrNoRows {
+		t.Fatal("an unknown faction should not be found")
+	}
+
+	first, err := r.Create(ctx, faction.NewIncomplete("police", "description"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	second, err := r.Create(ctx, faction.NewIncomplete("police", "description"))
+	if err != nil {
+		t.Fatal("the name of a faction does not have to be unique")
+	}
+
+	if first.GUID() == "" || first.GUID() == second.GUID() {
+		t.Fatal("the created factions should have distinct ids")
+	}
+
+	c, err := r.Get(ctx, first)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.GUID() != first.GUID() || c.Data().Name != "police" || c.Data().Description != "description" {
+		t.Fatal("the stored data is not equal")
+	}
+}
+
+func testUpdate(t *testing.T, r faction.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, faction.NewIncomplete("police", "description"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Update(ctx, &complete{
+		c,
+		faction.NewIncomplete("medics", "new description"),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	c, err = r.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Data().Name != "medics" || c.Data().Description != "new description" {
+		t.Fatal("the faction should be updated")
+	}
+
+	unknown := randomIdentifier(t)
+	if err := r.Update(ctx, &complete{
+		unknown,
+		faction.NewIncomplete("police", "description"),
+	}); err != nil {
+		t.Fatal("updating an unknown faction should not return an error")
+	}
+
+	if _, err := r.Get(ctx, unknown); err != sql.ErrNoRows {
+		t.Fatal("updating an unknown faction should not create it")
+	}
+}
+
+func testDelete(t *testing.T, r faction.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, faction.NewIncomplete("police", "description"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRank(ctx, c, &faction.Rank{ID: "officer", Name: "Officer", Level: 1, RoleID: "police/officer"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetMember(ctx, c, &faction.Member{AccountID: "user/1", RankID: "officer"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.AddInvite(ctx, c, "user/2"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Delete(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Get(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("the faction should be deleted")
+	}
+
+	ranks, err := r.GetRanks(ctx, c)
+	if err != nil || len(ranks) != 0 {
+		t.Fatal("the ranks of the faction should be deleted")
+	}
+
+	members, err := r.GetMembers(ctx, c)
+	if err != nil || len(members) != 0 {
+		t.Fatal("the members of the faction should be deleted")
+	}
+
+	invites, err := r.GetInvites(ctx, c)
+	if err != nil || len(invites) != 0 {
+		t.Fatal("the invites of the faction should be deleted")
+	}
+
+	if err := r.Delete(ctx, randomIdentifier(t)); err != nil {
+		t.Fatal("deleting an unknown faction should not return an error")
+	}
+}
+
+func testRanks(t *testing.T, r faction.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, faction.NewIncomplete("police", "description"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	for _, v := range []*faction.Rank{
+		{ID: "chief", Name: "Chief", Level: 3, RoleID: "police/chief", Permissions: []faction.Permission{faction.PermissionInvite, faction.PermissionKick}},
+		{ID: "cadet", Name: "Cadet", Level: 1, RoleID: "police/cadet"},
+		{ID: "officer", Name: "Officer", Level: 2, RoleID: "police/officer"},
+	} {
+		if err := r.SetRank(ctx, c, v); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	ranks, err := r.GetRanks(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(ranks) != 3 || ranks[0].ID != "cadet" || ranks[1].ID != "officer" || ranks[2].ID != "chief" {
+		t.Fatal("the ranks should be ordered by their level")
+	}
+
+	if ranks[2].Name != "Chief" || ranks[2].RoleID != "police/chief" || len(ranks[2].Permissions) != 2 ||
+		!ranks[2].HasPermission(faction.PermissionKick) || len(ranks[0].Permissions) != 0 {
+		t.Fatal("the stored rank is not equal")
+	}
+
+	if err := r.SetRank(ctx, c, &faction.Rank{ID: "cadet", Name: "Recruit", Level: 0, RoleID: "police/recruit"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	ranks, err = r.GetRanks(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(ranks) != 3 || ranks[0].Name != "Recruit" || ranks[0].RoleID != "police/recruit" {
+		t.Fatal("setting an existing rank should update it")
+	}
+
+	if err := r.DeleteRank(ctx, c, "officer"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	ranks, err = r.GetRanks(ctx, c)
+	if err != nil || len(ranks) != 2 || ranks[1].ID != "chief" {
+		t.Fatal("the rank should be deleted")
+	}
+
+	if err := r.DeleteRank(ctx, c, "unknown"); err != nil {
+		t.Fatal("deleting an unknown rank should not return an error")
+	}
+
+	other, err := r.GetRanks(ctx, randomIdentifier(t))
+	if err != nil || len(other) != 0 {
+		t.Fatal("the ranks of another faction should be empty")
+	}
+}
+
+func testMembers(t *testing.T, r faction.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, faction.NewIncomplete("police", "description"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.GetMember(ctx, c, "user/1"); err != sql.ErrNoRows {
+		t.Fatal("an unknown member should not be found")
+	}
+
+	if err := r.SetMember(ctx, c, &faction.Member{AccountID: "user/1", RankID: "cadet"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetMember(ctx, c, &faction.Member{AccountID: "user/2", RankID: "cadet"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetMember(ctx, c, &faction.Member{AccountID: "user/1", RankID: "officer"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	member, err := r.GetMember(ctx, c, "user/1")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if member.AccountID != "user/1" || member.RankID != "officer" {
+		t.Fatal("setting an existing member should update its rank")
+	}
+
+	members, err := r.GetMembers(ctx, c)
+	if err != nil || len(members) != 2 {
+		t.Fatal("there should be two members")
+	}
+
+	if err := r.RemoveMember(ctx, c, "user/1"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.GetMember(ctx, c, "user/1"); err != sql.ErrNoRows {
+		t.Fatal("the member should be removed")
+	}
+
+	members, err = r.GetMembers(ctx, c)
+	if err != nil || len(members) != 1 || members[0].AccountID != "user/2" {
+		t.Fatal("the other member should be kept")
+	}
+
+	if err := r.RemoveMember(ctx, c, "user/3"); err != nil {
+		t.Fatal("removing an unknown member should not return an error")
+	}
+}
+
+func testInvites(t *testing.T, r faction.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, faction.NewIncomplete("police", "description"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	for _, v := range []rbac.AccountID{"user/1", "user/2", "user/1"} {
+		if err := r.AddInvite(ctx, c, v); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	invites, err := r.GetInvites(ctx, c)
+	if err != nil || len(invites) != 2 {
+		t.Fatal("an account should only be invited once")
+	}
+
+	if err := r.RemoveInvite(ctx, c, "user/1"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	invites, err = r.GetInvites(ctx, c)
+	if err != nil || len(invites) != 1 || invites[0] != "user/2" {
+		t.Fatal("the invite should be removed")
+	}
+
+	if err := r.RemoveInvite(ctx, c, "user/3"); err != nil {
+		t.Fatal("removing an unknown invite should not return an error")
+	}
+}
+
+func testMemberships(t *testing.T, r faction.Repository) {
+	ctx := context.Background()
+
+	police, err := r.Create(ctx, faction.NewIncomplete("police", "description"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	medics, err := r.Create(ctx, faction.NewIncomplete("medics", "description"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	memberships, err := r.GetMemberships(ctx, "user/1")
+	if err != nil || len(memberships) != 0 {
+		t.Fatal("an account without factions should have no memberships")
+	}
+
+	if err := r.SetMember(ctx, police, &faction.Member{AccountID: "user/1", RankID: "officer"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetMember(ctx, police, &faction.Member{AccountID: "user/2", RankID: "cadet"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.AddInvite(ctx, medics, "user/1"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	memberships, err = r.GetMemberships(ctx, "user/1")
+	if err != nil || len(memberships) != 2 {
+		t.Fatal("the account should have a membership and an invite")
+	}
+
+	for _, v := range memberships {
+		switch v.FactionGUID {
+		case police.GUID():
+			if v.Invited || v.RankID != "officer" {
+				t.Fatal("the account should be a member of the police")
+			}
+		case medics.GUID():
+			if !v.Invited || v.RankID != "" {
+				t.Fatal("the account should be invited to the medics")
+			}
+		default:
+			t.Fatal("the membership belongs to an unknown faction")
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package faction
+
+import "github.com/51st-state/api/pkg/rbac"
+
+// rules enforced by the faction service.
+// Invites, promotions, demotions and kicks are gated by the ranks of a faction instead.
+const (
+	ruleGet           rbac.Rule = "factions.get"
+	ruleCreate        rbac.Rule = "factions.create"
+	ruleUpdate        rbac.Rule = "factions.update"
+	ruleDelete        rbac.Rule = "factions.delete"
+	ruleSync          rbac.Rule = "factions.sync"
+	ruleRanksSet      rbac.Rule = "factions.ranks.set"
+	ruleRanksDelete   rbac.Rule = "factions.ranks.delete"
+	ruleMembersSet    rbac.Rule = "factions.members.set"
+	ruleMembersRemove rbac.Rule = "factions.members.remove"
+)
+
+// Rules enforced by the faction service
+var Rules = rbac.RuleCatalog{
+	{Rule: ruleGet, Description: "Get a faction with its ranks and members", Service: "faction"},
+	{Rule: ruleCreate, Description: "Create a faction", Service: "faction"},
+	{Rule: ruleUpdate, Description: "Update a faction", Service: "faction"},
+	{Rule: ruleDelete, Description: "Delete a faction", Service: "faction"},
+	{Rule: ruleSync, Description: "Bind the members of a faction to the roles of their ranks again", Service: "faction"},
+	{Rule: ruleRanksSet, Description: "Create or update a rank of a faction", Service: "faction"},
+	{Rule: ruleRanksDelete, Description: "Delete a rank of a faction", Service: "faction"},
+	{Rule: ruleMembersSet, Description: "Set the rank of any member of a faction", Service: "faction"},
+	{Rule: ruleMembersRemove, Description: "Remove any member from a faction", Service: "faction"},
+}
@@ -0,0 +1,219 @@
+package faction
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+
+	"github.com/51st-state/api/pkg/api/endpoint"
+	"github.com/51st-state/api/pkg/encode"
+	"github.com/51st-state/api/pkg/rbac"
+	rbacMiddleware "github.com/51st-state/api/pkg/rbac/middleware"
+	"github.com/51st-state/api/pkg/token"
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+)
+
+// MakeGetEndpoint creates a http endpoint to retrieve a faction
+func MakeGetEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.Get(ctx, &identifier{chi.URLParam(r, "guid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleGet)).
+		HandlerFunc(l)
+}
+
+// MakeCreateEndpoint creates a http endpoint to create a faction
+func MakeCreateEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		inc := NewIncomplete("", "")
+
+		if err := json.NewDecoder(r.Body).Decode(&inc); err != nil {
+			return nil, err
+		}
+
+		return m.Create(ctx, inc)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleCreate)).
+		HandlerFunc(l)
+}
+
+// MakeUpdateEndpoint creates a http endpoint to update a faction
+func MakeUpdateEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		inc := NewIncomplete("", "")
+
+		if err := json.NewDecoder(r.Body).Decode(&inc); err != nil {
+			return nil, err
+		}
+
+		return struct{}{}, m.Update(ctx, &complete{
+			&identifier{chi.URLParam(r, "guid")},
+			inc,
+		})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleUpdate)).
+		HandlerFunc(l)
+}
+
+// MakeDeleteEndpoint creates a http endpoint to delete a faction
+func MakeDeleteEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return struct{}{}, m.Delete(ctx, &identifier{chi.URLParam(r, "guid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleDelete)).
+		HandlerFunc(l)
+}
+
+// MakeSyncEndpoint creates a http endpoint to bind the members of a faction to the roles of their ranks again
+func MakeSyncEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return struct{}{}, m.Sync(ctx, &identifier{chi.URLParam(r, "guid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleSync)).
+		HandlerFunc(l)
+}
+
+// MakeGetRanksEndpoint creates a http endpoint to retrieve the ranks of a faction
+func MakeGetRanksEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetRanks(ctx, &identifier{chi.URLParam(r, "guid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleGet)).
+		HandlerFunc(l)
+}
+
+// MakeSetRankEndpoint creates a http endpoint to create or update a rank of a faction
+func MakeSetRankEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		rank := &Rank{
+			Permissions: make([]Permission, 0),
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(rank); err != nil {
+			return nil, err
+		}
+
+		rank.ID = chi.URLParam(r, "rank")
+
+		return struct{}{}, m.SetRank(ctx, &identifier{chi.URLParam(r, "guid")}, rank)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleRanksSet)).
+		HandlerFunc(l)
+}
+
+// MakeDeleteRankEndpoint creates a http endpoint to delete a rank of a faction
+func MakeDeleteRankEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return struct{}{}, m.DeleteRank(ctx, &identifier{chi.URLParam(r, "guid")}, chi.URLParam(r, "rank"))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleRanksDelete)).
+		HandlerFunc(l)
+}
+
+// MakeGetMembersEndpoint creates a http endpoint to retrieve the members of a faction
+func MakeGetMembersEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetMembers(ctx, &identifier{chi.URLParam(r, "guid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleGet)).
+		HandlerFunc(l)
+}
+
+// MakeSetMemberEndpoint creates a http endpoint to set the rank of any member of a faction
+func MakeSetMemberEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		member := &Member{}
+
+		if err := json.NewDecoder(r.Body).Decode(member); err != nil {
+			return nil, err
+		}
+
+		return struct{}{}, m.SetMember(ctx, &identifier{chi.URLParam(r, "guid")}, member)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleMembersSet)).
+		HandlerFunc(l)
+}
+
+// MakeRemoveMemberEndpoint creates a http endpoint to remove any member from a faction
+func MakeRemoveMemberEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return struct{}{}, m.RemoveMember(
+			ctx,
+			&identifier{chi.URLParam(r, "guid")},
+			rbac.AccountID(r.URL.Query().Get("account")),
+		)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleMembersRemove)).
+		HandlerFunc(l)
+}
+
+// MakeInviteEndpoint creates a http endpoint to invite an account into a faction
+func MakeInviteEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return makeAccountEndpoint(l, e, pubKey, m.Invite)
+}
+
+// MakePromoteEndpoint creates a http endpoint to promote a member of a faction to the next rank
+func MakePromoteEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return makeAccountEndpoint(l, e, pubKey, m.Promote)
+}
+
+// MakeDemoteEndpoint creates a http endpoint to demote a member of a faction to the previous rank
+func MakeDemoteEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return makeAccountEndpoint(l, e, pubKey, m.Demote)
+}
+
+// MakeKickEndpoint creates a http endpoint to kick a member out of a faction
+func MakeKickEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return makeAccountEndpoint(l, e, pubKey, m.Kick)
+}
+
+// MakeAcceptInviteEndpoint creates a http endpoint to accept the invite into a faction
+func MakeAcceptInviteEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return struct{}{}, m.AcceptInvite(ctx, &identifier{chi.URLParam(r, "guid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeLeaveEndpoint creates a http endpoint to leave a faction
+func MakeLeaveEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return struct{}{}, m.Leave(ctx, &identifier{chi.URLParam(r, "guid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+type accountRequest struct {
+	AccountID rbac.AccountID `json:"account_id"`
+}
+
+// makeAccountEndpoint creates a http endpoint for an action of the token account
+// on another account. The permission is checked against the ranks of the faction.
+func makeAccountEndpoint(l *zap.Logger, e encode.Encoder, pubKey rsa.PublicKey, action func(context.Context, Identifier, rbac.AccountID) error) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		req := &accountRequest{}
+
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			return nil, err
+		}
+
+		return struct{}{}, action(ctx, &identifier{chi.URLParam(r, "guid")}, req.AccountID)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
@@ -0,0 +1,107 @@
+package faction
+
+import "github.com/51st-state/api/pkg/rbac"
+
+//go:generate counterfeiter -o ./mocks/identifier.go . Identifier
+
+// Identifier of a faction
+type Identifier interface {
+	GUID() string
+}
+
+type identifier struct {
+	guid string
+}
+
+// NewIdentifier creates a new identifier object
+func NewIdentifier(guid string) Identifier {
+	return &identifier{guid}
+}
+
+func (i *identifier) GUID() string {
+	return i.guid
+}
+
+// Provider provides methods for the incomplete faction object
+type Provider interface {
+	Data() *data
+}
+
+// Incomplete represents an incomplete faction object
+type Incomplete interface {
+	Provider
+}
+
+// Complete represents a complete faction object
+type Complete interface {
+	Identifier
+	Incomplete
+}
+
+type complete struct {
+	Identifier
+	Incomplete
+}
+
+type data struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// NewIncomplete creates a new incomplete faction object
+func NewIncomplete(name, description string) Incomplete {
+	return &data{
+		name,
+		description,
+	}
+}
+
+func (d *data) Data() *data {
+	return d
+}
+
+// Permission a rank grants its members inside of the faction
+type Permission string
+
+// permissions of a rank
+const (
+	PermissionInvite  Permission = "invite"
+	PermissionPromote Permission = "promote"
+	PermissionDemote  Permission = "demote"
+	PermissionKick    Permission = "kick"
+)
+
+var permissions = []Permission{
+	PermissionInvite,
+	PermissionPromote,
+	PermissionDemote,
+	PermissionKick,
+}
+
+// Rank of a faction. Ranks are ordered by their level, a higher level
+// outranks a lower one. Every member of a rank is bound to its rbac role.
+type Rank struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Level       int          `json:"level"`
+	RoleID      rbac.RoleID  `json:"role_id"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// HasPermission checks whether the rank grants a permission
+func (r *Rank) HasPermission(p Permission) bool {
+	return containsPermission(r.Permissions, p)
+}
+
+// Member of a faction holding a rank
+type Member struct {
+	AccountID rbac.AccountID `json:"account_id"`
+	RankID    string         `json:"rank_id"`
+}
+
+// Membership of an account in a faction. An invited account holds no rank yet.
+type Membership struct {
+	FactionGUID string `json:"faction_guid"`
+	RankID      string `json:"rank_id,omitempty"`
+	Invited     bool   `json:"invited"`
+}
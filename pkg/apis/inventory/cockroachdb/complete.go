@@ -13,10 +13,20 @@ type complete struct {
 
 func (c *complete) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
-		GUID  string            `json:"guid"`
-		Items []*inventory.Item `json:"items"`
+		GUID      string            `json:"guid"`
+		Items     []*inventory.Item `json:"items"`
+		Capacity  float64           `json:"capacity"`
+		Weight    float64           `json:"weight"`
+		Owner     *inventory.Owner  `json:"owner"`
+		Container string            `json:"container"`
+		Version   uint64            `json:"version"`
 	}{
-		GUID:  c.GUID(),
-		Items: c.Data().Items,
+		GUID:      c.GUID(),
+		Items:     c.Data().Items,
+		Capacity:  c.Data().Capacity,
+		Weight:    c.Data().Weight,
+		Owner:     c.Data().Owner,
+		Container: c.Data().Container,
+		Version:   c.Data().Version,
 	})
 }
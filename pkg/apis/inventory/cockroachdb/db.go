@@ -3,6 +3,7 @@ package cockroachdb
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/google/uuid"
 
@@ -21,6 +22,7 @@ func CreateSchema(ctx context.Context, db *sql.DB) (err error) {
             id UUID PRIMARY KEY
         );
         CREATE UNIQUE INDEX IF NOT EXISTS inventories_idx_id ON inventories (id);
+        ALTER TABLE inventories ADD COLUMN IF NOT EXISTS capacity REAL NOT NULL DEFAULT 0;
         CREATE TABLE IF NOT EXISTS inventory_items (
             inventoryId UUID references inventories (id),
             itemId TEXT NOT NULL,
@@ -29,7 +31,21 @@ func CreateSchema(ctx context.Context, db *sql.DB) (err error) {
             UNIQUE(inventoryId, itemId, subset)
         );
         CREATE UNIQUE INDEX IF NOT EXISTS inventory_items_idx_inventoryId_itemId_subset ON inventory_items (inventoryId, itemId, subset);
-        CREATE INDEX IF NOT EXISTS inventory_items_idx_amount ON inventory_items (amount);`,
+        CREATE INDEX IF NOT EXISTS inventory_items_idx_amount ON inventory_items (amount);
+        ALTER TABLE inventories ADD COLUMN IF NOT EXISTS ownerType TEXT NULL;
+        ALTER TABLE inventories ADD COLUMN IF NOT EXISTS ownerId TEXT NULL;
+        ALTER TABLE inventories ADD COLUMN IF NOT EXISTS container TEXT NOT NULL DEFAULT '';
+        ALTER TABLE inventories ADD COLUMN IF NOT EXISTS createdAt TIMESTAMPTZ NOT NULL DEFAULT now();
+        ALTER TABLE inventories ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;`,
+	)
+	if err != nil {
+		return
+	}
+
+	// the columns have to exist before they can be indexed
+	_, err = db.ExecContext(
+		ctx,
+		`CREATE INDEX IF NOT EXISTS inventories_idx_ownerType_ownerId ON inventories (ownerType, ownerId);`,
 	)
 	return
 }
@@ -39,33 +55,33 @@ func NewRepository(d *sql.DB) inventory.Repository {
 	return &db{d}
 }
 
-func (d *db) inventoryExists(ctx context.Context, id inventory.Identifier) error {
-	var count int
+func (d *db) Get(ctx context.Context, id inventory.Identifier) (inventory.Complete, error) {
+	inc := inventory.NewIncomplete(make([]*inventory.Item, 0))
+
+	var ownerType, ownerID sql.NullString
 	if err := d.database.QueryRowContext(
 		ctx,
-		`SELECT COUNT(*)
+		`SELECT capacity,
+        ownerType,
+        ownerId,
+        container,
+        version
         FROM inventories
         WHERE id = $1`,
 		id.GUID(),
 	).Scan(
-		&count,
+		&inc.Data().Capacity,
+		&ownerType,
+		&ownerID,
+		&inc.Data().Container,
+		&inc.Data().Version,
 	); err != nil {
-		return err
-	}
-
-	if count != 1 {
-		return sql.ErrNoRows
-	}
-
-	return nil
-}
-
-func (d *db) Get(ctx context.Context, id inventory.Identifier) (inventory.Complete, error) {
-	if err := d.inventoryExists(ctx, id); err != nil {
 		return nil, err
 	}
 
-	inc := inventory.NewIncomplete(make([]*inventory.Item, 0))
+	if ownerType.Valid && ownerID.Valid {
+		inc.Data().Owner = inventory.NewOwner(ownerType.String, ownerID.String)
+	}
 
 	rows, err := d.database.QueryContext(
 		ctx,
@@ -80,6 +96,7 @@ func (d *db) Get(ctx context.Context, id inventory.Identifier) (inventory.Comple
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		var item inventory.Item
@@ -94,38 +111,192 @@ func (d *db) Get(ctx context.Context, id inventory.Identifier) (inventory.Comple
 		inc.Data().Items = append(inc.Data().Items, &item)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
 	return &complete{
 		id,
 		inc,
 	}, nil
 }
 
-func (d *db) Create(ctx context.Context) (inventory.Complete, error) {
+func (d *db) GetByOwner(ctx context.Context, owner *inventory.Owner) ([]inventory.Complete, error) {
+	rows, err := d.database.QueryContext(
+		ctx,
+		`SELECT inventories.id,
+        inventories.capacity,
+        inventories.container,
+        inventories.version,
+        inventory_items.itemId,
+        inventory_items.amount,
+        inventory_items.subset
+        FROM inventories
+        LEFT JOIN inventory_items
+        ON inventory_items.inventoryId = inventories.id
+        AND inventory_items.amount > 0
+        WHERE inventories.ownerType = $1
+        AND inventories.ownerId = $2
+        ORDER BY inventories.createdAt, inventories.id`,
+		owner.Type,
+		owner.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	inventories := make([]inventory.Complete, 0)
+	var last *complete
+	for rows.Next() {
+		var (
+			guid   string
+			inc    = inventory.NewIncomplete(make([]*inventory.Item, 0))
+			itemID sql.NullString
+			amount sql.NullInt64
+			subset sql.NullFloat64
+		)
+		if err := rows.Scan(
+			&guid,
+			&inc.Data().Capacity,
+			&inc.Data().Container,
+			&inc.Data().Version,
+			&itemID,
+			&amount,
+			&subset,
+		); err != nil {
+			return nil, err
+		}
+
+		// the rows of an inventory are adjacent, one row per item
+		if last == nil || last.GUID() != guid {
+			inc.Data().Owner = inventory.NewOwner(owner.Type, owner.ID)
+			last = &complete{
+				&identifier{guid},
+				inc,
+			}
+			inventories = append(inventories, last)
+		}
+
+		if itemID.Valid {
+			last.Data().Items = append(last.Data().Items, &inventory.Item{
+				ID:     itemID.String,
+				Amount: uint64(amount.Int64),
+				Subset: subset.Float64,
+			})
+		}
+	}
+
+	return inventories, rows.Err()
+}
+
+func (d *db) Create(ctx context.Context, i inventory.Incomplete) (inventory.Complete, error) {
 	rand, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
 	}
 
-	if _, err := d.database.ExecContext(
-		ctx,
-		`INSERT INTO inventories (
-            id
-        ) VALUES (
-            $1
-        )`,
-		rand.String(),
-	); err != nil {
+	var ownerType, ownerID sql.NullString
+	if owner := i.Data().Owner; owner != nil {
+		ownerType = sql.NullString{String: owner.Type, Valid: true}
+		ownerID = sql.NullString{String: owner.ID, Valid: true}
+	}
+
+	id := &identifier{rand.String()}
+	if err := executeTx(ctx, d.database, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO inventories (
+                id,
+                capacity,
+                ownerType,
+                ownerId,
+                container,
+                version
+            ) VALUES (
+                $1,
+                $2,
+                $3,
+                $4,
+                $5,
+                1
+            )`,
+			id.GUID(),
+			i.Data().Capacity,
+			ownerType,
+			ownerID,
+			i.Data().Container,
+		); err != nil {
+			return err
+		}
+
+		// the items belong to the first version, they do not bump it
+		for _, v := range i.Data().Items {
+			if err := addItem(ctx, tx, id, v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
+	inc := inventory.NewIncomplete(append(make([]*inventory.Item, 0), i.Data().Items...))
+	inc.Data().Capacity = i.Data().Capacity
+	inc.Data().Container = i.Data().Container
+	inc.Data().Version = 1
+	if owner := i.Data().Owner; owner != nil {
+		inc.Data().Owner = inventory.NewOwner(owner.Type, owner.ID)
+	}
+
 	return &complete{
-		&identifier{rand.String()},
-		inventory.NewIncomplete(make([]*inventory.Item, 0)),
+		id,
+		inc,
 	}, nil
 }
 
-func (d *db) AddItem(ctx context.Context, id inventory.Identifier, item *inventory.Item) error {
-	_, err := d.database.ExecContext(
+// querier is implemented by databases and transactions
+type querier interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+// bumpVersion increases the version of an inventory and returns the previous one,
+// returns sql.ErrNoRows if the inventory is unknown
+func bumpVersion(ctx context.Context, q querier, guid string) (uint64, error) {
+	var version uint64
+	err := q.QueryRowContext(
+		ctx,
+		`UPDATE inventories
+        SET version = version + 1
+        WHERE id = $1
+        RETURNING version - 1`,
+		guid,
+	).Scan(
+		&version,
+	)
+	return version, err
+}
+
+// bump the version of an inventory, returns inventory.ErrVersionMismatch
+// if it was not in the expected version. The transaction has to be rolled back then.
+func bump(ctx context.Context, q querier, id inventory.Identifier) error {
+	version, err := bumpVersion(ctx, q, id.GUID())
+	if err != nil {
+		return err
+	}
+
+	if expected := inventory.ExpectedVersion(id); expected != 0 && expected != version {
+		return inventory.ErrVersionMismatch
+	}
+
+	return nil
+}
+
+// addItem to an existing inventory
+func addItem(ctx context.Context, q querier, id inventory.Identifier, item *inventory.Item) error {
+	_, err := q.ExecContext(
 		ctx,
 		`INSERT INTO inventory_items (
             inventoryId,
@@ -151,9 +322,10 @@ func (d *db) AddItem(ctx context.Context, id inventory.Identifier, item *invento
 	return err
 }
 
-func (d *db) RemoveItem(ctx context.Context, id inventory.Identifier, item *inventory.Item) error {
+// removeItem from an inventory, returns sql.ErrNoRows if the amount of the item would go negative
+func removeItem(ctx context.Context, q querier, id inventory.Identifier, item *inventory.Item) error {
 	var guid string
-	return d.database.QueryRowContext(
+	return q.QueryRowContext(
 		ctx,
 		`UPDATE inventory_items
         SET amount = amount - $1
@@ -172,12 +344,166 @@ func (d *db) RemoveItem(ctx context.Context, id inventory.Identifier, item *inve
 	)
 }
 
+func (d *db) AddItem(ctx context.Context, id inventory.Identifier, item *inventory.Item) error {
+	return executeTx(ctx, d.database, func(tx *sql.Tx) error {
+		if err := bump(ctx, tx, id); err != nil {
+			return err
+		}
+
+		return addItem(ctx, tx, id, item)
+	})
+}
+
+func (d *db) RemoveItem(ctx context.Context, id inventory.Identifier, item *inventory.Item) error {
+	return executeTx(ctx, d.database, func(tx *sql.Tx) error {
+		if err := bump(ctx, tx, id); err != nil {
+			return err
+		}
+
+		return removeItem(ctx, tx, id, item)
+	})
+}
+
+// Transfer an item between inventories in a single serializable transaction
+func (d *db) Transfer(ctx context.Context, from, to inventory.Identifier, item *inventory.Item) error {
+	return executeTx(ctx, d.database, func(tx *sql.Tx) error {
+		for _, v := range []inventory.Identifier{from, to} {
+			if err := bump(ctx, tx, v); err != nil {
+				return err
+			}
+		}
+
+		if err := removeItem(ctx, tx, from, item); err != nil {
+			return err
+		}
+
+		return addItem(ctx, tx, to, item)
+	})
+}
+
+// Apply the operations in a single serializable transaction
+func (d *db) Apply(ctx context.Context, ops []*inventory.Operation) error {
+	return executeTx(ctx, d.database, func(tx *sql.Tx) error {
+		// the versions of the inventories before the batch
+		versions := make(map[string]uint64)
+		for i, v := range ops {
+			version, ok := versions[v.InventoryGUID]
+			if !ok {
+				var err error
+				version, err = bumpVersion(ctx, tx, v.InventoryGUID)
+				if err == sql.ErrNoRows {
+					return &inventory.OperationError{Index: i, Operation: v, Err: err}
+				} else if err != nil {
+					return err
+				}
+
+				versions[v.InventoryGUID] = version
+			}
+
+			if v.Version != 0 && v.Version != version {
+				return &inventory.OperationError{Index: i, Operation: v, Err: inventory.ErrVersionMismatch}
+			}
+
+			var err error
+			switch v.Kind {
+			case inventory.OperationAdd:
+				err = addItem(ctx, tx, &identifier{v.InventoryGUID}, v.Item)
+			case inventory.OperationRemove:
+				err = removeItem(ctx, tx, &identifier{v.InventoryGUID}, v.Item)
+			default:
+				err = fmt.Errorf("unknown kind of operation %s", v.Kind)
+			}
+
+			if err == sql.ErrNoRows {
+				return &inventory.OperationError{
+					Index:     i,
+					Operation: v,
+					Err:       err,
+				}
+			} else if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Delete an inventory including its items
 func (d *db) Delete(ctx context.Context, id inventory.Identifier) error {
-	_, err := d.database.ExecContext(
+	tx, err := d.database.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := bump(ctx, tx, id); err == sql.ErrNoRows {
+		// there is nothing to delete
+		return tx.Rollback()
+	} else if err != nil {
+		return txError(tx, err)
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		`DELETE FROM inventory_items
+        WHERE inventoryId = $1`,
+		id.GUID(),
+	); err != nil {
+		return txError(tx, err)
+	}
+
+	if _, err := tx.ExecContext(
 		ctx,
 		`DELETE FROM inventories
         WHERE id = $1`,
 		id.GUID(),
-	)
+	); err != nil {
+		return txError(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// DeleteByOwner deletes the inventories of an owner including their items
+func (d *db) DeleteByOwner(ctx context.Context, owner *inventory.Owner) error {
+	tx, err := d.database.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		`DELETE FROM inventory_items
+        WHERE inventoryId IN (
+            SELECT id
+            FROM inventories
+            WHERE ownerType = $1
+            AND ownerId = $2
+        )`,
+		owner.Type,
+		owner.ID,
+	); err != nil {
+		return txError(tx, err)
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		`DELETE FROM inventories
+        WHERE ownerType = $1
+        AND ownerId = $2`,
+		owner.Type,
+		owner.ID,
+	); err != nil {
+		return txError(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+func txError(tx *sql.Tx, err error) error {
+	if err := tx.Rollback(); err != nil {
+		return err
+	}
+
 	return err
 }
@@ -0,0 +1,16 @@
+package cockroachdb_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/inventory"
+	"github.com/51st-state/api/pkg/apis/inventory/cockroachdb"
+	"github.com/51st-state/api/pkg/apis/inventory/repositorytest"
+	"github.com/51st-state/api/test"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) inventory.Repository {
+		return cockroachdb.NewRepository(test.NewCockroachDB(t, cockroachdb.CreateSchema))
+	})
+}
@@ -0,0 +1,46 @@
+package cockroachdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+)
+
+// serializationFailure is the error code of transactions, which have to be retried
+const serializationFailure = "40001"
+
+// executeTx runs fn in a serializable transaction and retries it
+// using the client-side retry protocol of cockroachdb until it is committed
+func executeTx(ctx context.Context, d *sql.DB, fn func(*sql.Tx) error) error {
+	tx, err := d.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `SAVEPOINT cockroach_restart`); err != nil {
+		return txError(tx, err)
+	}
+
+	for {
+		err := fn(tx)
+		if err == nil {
+			if _, err = tx.ExecContext(ctx, `RELEASE SAVEPOINT cockroach_restart`); err == nil {
+				return tx.Commit()
+			}
+		}
+
+		if !retryable(err) {
+			return txError(tx, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `ROLLBACK TO SAVEPOINT cockroach_restart`); err != nil {
+			return txError(tx, err)
+		}
+	}
+}
+
+func retryable(err error) bool {
+	e, ok := err.(*pq.Error)
+	return ok && e.Code == serializationFailure
+}
@@ -0,0 +1,45 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/51st-state/api/pkg/event"
+)
+
+// OwnerEvents maps the ids of the events produced by other services once
+// an owner of inventories is deleted to the type of the owner,
+// e.g. character_purged to characters
+type OwnerEvents map[event.ID]string
+
+// ownerDeletedEvent produced by the service managing an owner
+type ownerDeletedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data struct {
+		GUID string `json:"guid"`
+	} `json:"data"`
+}
+
+// NewEventHandler deletes the inventories of deleted owners
+func NewEventHandler(m Manager, owners OwnerEvents) event.HandlerFunc {
+	return func(ctx context.Context, e *event.Event) error {
+		typ, ok := owners[e.Meta.ID]
+		if !ok {
+			return nil
+		}
+
+		var deleted ownerDeletedEvent
+		if err := json.Unmarshal(e.Payload, &deleted); err != nil {
+			return err
+		}
+
+		owner := NewOwner(typ, deleted.Data.GUID)
+
+		// a redelivery of an event without a valid owner would fail again
+		if validateOwner(owner) != nil {
+			return nil
+		}
+
+		return m.DeleteByOwner(ctx, owner)
+	}
+}
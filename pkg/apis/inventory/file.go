@@ -0,0 +1,21 @@
+package inventory
+
+import (
+	"encoding/json"
+	"io/ioutil"
+)
+
+// ContainersFromConfig parses the container types and their default capacities from a given file
+func ContainersFromConfig(filepath string) (Containers, error) {
+	b, err := ioutil.ReadFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	var containers Containers
+	if err := json.Unmarshal(b, &containers); err != nil {
+		return nil, err
+	}
+
+	return containers, nil
+}
@@ -2,9 +2,13 @@ package inventory
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
 	pb "github.com/51st-state/api/pkg/apis/inventory/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type grpcClient struct {
@@ -26,6 +30,10 @@ func (g *grpcClient) Get(ctx context.Context, id Identifier) (Complete, error) {
 		return nil, err
 	}
 
+	return completeFromGRPC(c), nil
+}
+
+func completeFromGRPC(c *pb.Complete) Complete {
 	items := make([]*Item, 0)
 	for _, v := range c.GetIncomplete().GetItems() {
 		items = append(items, &Item{
@@ -35,10 +43,31 @@ func (g *grpcClient) Get(ctx context.Context, id Identifier) (Complete, error) {
 		})
 	}
 
+	inc := NewIncomplete(items)
+	inc.Data().Capacity = c.GetIncomplete().GetCapacity()
+	inc.Data().Weight = c.GetIncomplete().GetWeight()
+	inc.Data().Owner = ownerFromGRPC(c.GetIncomplete().GetOwner())
+	inc.Data().Container = c.GetIncomplete().GetContainer()
+	inc.Data().Version = c.GetIncomplete().GetVersion()
+
 	return &complete{
-		id,
-		NewIncomplete(items),
-	}, nil
+		&identifier{c.GetIdentifier().GetGUID()},
+		inc,
+	}
+}
+
+func (g *grpcClient) GetByOwner(ctx context.Context, o *Owner) ([]Complete, error) {
+	resp, err := g.client.GetByOwner(ctx, ownerToGRPC(o))
+	if err != nil {
+		return nil, err
+	}
+
+	inventories := make([]Complete, 0)
+	for _, v := range resp.GetInventories() {
+		inventories = append(inventories, completeFromGRPC(v))
+	}
+
+	return inventories, nil
 }
 
 func (g *grpcClient) Create(ctx context.Context, inc Incomplete) (Complete, error) {
@@ -51,49 +80,142 @@ func (g *grpcClient) Create(ctx context.Context, inc Incomplete) (Complete, erro
 		})
 	}
 	c, err := g.client.Create(ctx, &pb.Incomplete{
-		Items: items,
+		Items:     items,
+		Capacity:  inc.Data().Capacity,
+		Owner:     ownerToGRPC(inc.Data().Owner),
+		Container: inc.Data().Container,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	inc.Data().Capacity = c.GetIncomplete().GetCapacity()
+	inc.Data().Weight = c.GetIncomplete().GetWeight()
+	inc.Data().Version = c.GetIncomplete().GetVersion()
+
 	return &complete{
 		&identifier{c.GetIdentifier().GetGUID()},
 		inc,
 	}, nil
 }
 
+// identifierToGRPC includes the version the inventory is expected to be in
+func identifierToGRPC(id Identifier) *pb.Identifier {
+	return &pb.Identifier{
+		GUID:            id.GUID(),
+		ExpectedVersion: ExpectedVersion(id),
+	}
+}
+
+// versionFromError restores a version mismatch returned as failed precondition
+func versionFromError(err error) error {
+	if st, ok := status.FromError(err); ok && st.Code() == codes.FailedPrecondition && st.Message() == ErrVersionMismatch.Error() {
+		return ErrVersionMismatch
+	}
+
+	return err
+}
+
 func (g *grpcClient) AddItem(ctx context.Context, id Identifier, item *Item) error {
 	_, err := g.client.AddItem(ctx, &pb.AddItemRequest{
-		Identifier: &pb.Identifier{
-			GUID: id.GUID(),
-		},
+		Identifier: identifierToGRPC(id),
 		Item: &pb.Item{
 			ID:     item.ID,
 			Amount: item.Amount,
 			Subset: item.Subset,
 		},
 	})
-	return err
+	return versionFromError(err)
 }
 
 func (g *grpcClient) RemoveItem(ctx context.Context, id Identifier, item *Item) error {
 	_, err := g.client.RemoveItem(ctx, &pb.RemoveItemRequest{
-		Identifier: &pb.Identifier{
-			GUID: id.GUID(),
+		Identifier: identifierToGRPC(id),
+		Item: &pb.Item{
+			ID:     item.ID,
+			Amount: item.Amount,
+			Subset: item.Subset,
 		},
+	})
+	return versionFromError(err)
+}
+
+func (g *grpcClient) Transfer(ctx context.Context, from, to Identifier, item *Item) error {
+	_, err := g.client.Transfer(ctx, &pb.TransferRequest{
+		From: identifierToGRPC(from),
+		To:   identifierToGRPC(to),
 		Item: &pb.Item{
 			ID:     item.ID,
 			Amount: item.Amount,
 			Subset: item.Subset,
 		},
 	})
+	return versionFromError(err)
+}
+
+func (g *grpcClient) Apply(ctx context.Context, ops []*Operation) error {
+	req := &pb.ApplyRequest{
+		Operations: make([]*pb.Operation, 0),
+	}
+	for i, v := range ops {
+		if v.Item == nil {
+			return &OperationError{Index: i, Operation: v, Err: errInvalidItemID}
+		}
+
+		req.Operations = append(req.Operations, &pb.Operation{
+			Kind: v.Kind,
+			Identifier: &pb.Identifier{
+				GUID:            v.InventoryGUID,
+				ExpectedVersion: v.Version,
+			},
+			Item: &pb.Item{
+				ID:     v.Item.ID,
+				Amount: v.Item.Amount,
+				Subset: v.Item.Subset,
+			},
+		})
+	}
+
+	_, err := g.client.Apply(ctx, req)
+	return operationFromError(err, ops)
+}
+
+// operationFromError restores the failed operation attached to the status
+func operationFromError(err error, ops []*Operation) error {
+	st, ok := status.FromError(err)
+	if !ok || err == nil {
+		return err
+	}
+
+	for _, v := range st.Details() {
+		failed, ok := v.(*pb.FailedOperation)
+		if !ok || failed.GetIndex() < 0 || failed.GetIndex() >= int64(len(ops)) {
+			continue
+		}
+
+		e := errors.New(st.Message())
+		if st.Code() == codes.NotFound {
+			e = sql.ErrNoRows
+		} else if st.Message() == ErrVersionMismatch.Error() {
+			e = ErrVersionMismatch
+		}
+
+		return &OperationError{
+			Index:     int(failed.GetIndex()),
+			Operation: ops[failed.GetIndex()],
+			Err:       e,
+		}
+	}
+
 	return err
 }
 
 func (g *grpcClient) Delete(ctx context.Context, id Identifier) error {
-	_, err := g.client.Delete(ctx, &pb.Identifier{
-		GUID: id.GUID(),
-	})
+	_, err := g.client.Delete(ctx, identifierToGRPC(id))
+	return versionFromError(err)
+}
+
+func (g *grpcClient) DeleteByOwner(ctx context.Context, o *Owner) error {
+	_, err := g.client.DeleteByOwner(ctx, ownerToGRPC(o))
 	return err
 }
@@ -2,9 +2,14 @@ package inventory
 
 import (
 	"context"
+	"database/sql"
+	"net/http"
 
 	pb "github.com/51st-state/api/pkg/apis/inventory/proto"
+	"github.com/51st-state/api/pkg/problems"
 	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type grpcServer struct {
@@ -24,6 +29,10 @@ func (s *grpcServer) Get(ctx context.Context, id *pb.Identifier) (*pb.Complete,
 		return nil, err
 	}
 
+	return completeToGRPC(c), nil
+}
+
+func completeToGRPC(c Complete) *pb.Complete {
 	items := make([]*pb.Item, 0)
 	for _, v := range c.Data().Items {
 		items = append(items, &pb.Item{
@@ -38,9 +47,63 @@ func (s *grpcServer) Get(ctx context.Context, id *pb.Identifier) (*pb.Complete,
 			GUID: c.GUID(),
 		},
 		Incomplete: &pb.Incomplete{
-			Items: items,
+			Items:     items,
+			Capacity:  c.Data().Capacity,
+			Weight:    c.Data().Weight,
+			Owner:     ownerToGRPC(c.Data().Owner),
+			Container: c.Data().Container,
+			Version:   c.Data().Version,
 		},
-	}, nil
+	}
+}
+
+// identifierFromGRPC expects the inventory to be in the given version
+func identifierFromGRPC(id *pb.Identifier) Identifier {
+	return NewVersionedIdentifier(id.GetGUID(), id.GetExpectedVersion())
+}
+
+// versionError returns a version mismatch as failed precondition
+func versionError(err error) error {
+	if err == ErrVersionMismatch {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return err
+}
+
+func ownerToGRPC(o *Owner) *pb.Owner {
+	if o == nil {
+		return nil
+	}
+
+	return &pb.Owner{
+		Type: o.Type,
+		ID:   o.ID,
+	}
+}
+
+func ownerFromGRPC(o *pb.Owner) *Owner {
+	if o == nil {
+		return nil
+	}
+
+	return NewOwner(o.GetType(), o.GetID())
+}
+
+func (s *grpcServer) GetByOwner(ctx context.Context, o *pb.Owner) (*pb.Inventories, error) {
+	inventories, err := s.manager.GetByOwner(ctx, ownerFromGRPC(o))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.Inventories{
+		Inventories: make([]*pb.Complete, 0),
+	}
+	for _, v := range inventories {
+		resp.Inventories = append(resp.Inventories, completeToGRPC(v))
+	}
+
+	return resp, nil
 }
 
 func (s *grpcServer) Create(ctx context.Context, inc *pb.Incomplete) (*pb.Complete, error) {
@@ -53,7 +116,12 @@ func (s *grpcServer) Create(ctx context.Context, inc *pb.Incomplete) (*pb.Comple
 		})
 	}
 
-	c, err := s.manager.Create(ctx, NewIncomplete(items))
+	i := NewIncomplete(items)
+	i.Data().Capacity = inc.GetCapacity()
+	i.Data().Owner = ownerFromGRPC(inc.GetOwner())
+	i.Data().Container = inc.GetContainer()
+
+	c, err := s.manager.Create(ctx, i)
 	if err != nil {
 		return nil, err
 	}
@@ -63,38 +131,107 @@ func (s *grpcServer) Create(ctx context.Context, inc *pb.Incomplete) (*pb.Comple
 			GUID: c.GUID(),
 		},
 		Incomplete: &pb.Incomplete{
-			Items: inc.GetItems(),
+			Items:     inc.GetItems(),
+			Capacity:  c.Data().Capacity,
+			Weight:    c.Data().Weight,
+			Owner:     ownerToGRPC(c.Data().Owner),
+			Container: c.Data().Container,
+			Version:   c.Data().Version,
 		},
 	}, nil
 }
 
 func (s *grpcServer) AddItem(ctx context.Context, req *pb.AddItemRequest) (*empty.Empty, error) {
-	return &empty.Empty{}, s.manager.AddItem(
+	return &empty.Empty{}, versionError(s.manager.AddItem(
 		ctx,
-		&identifier{req.GetIdentifier().GetGUID()},
+		identifierFromGRPC(req.GetIdentifier()),
 		&Item{
 			ID:     req.GetItem().GetID(),
 			Amount: req.GetItem().GetAmount(),
 			Subset: req.GetItem().GetSubset(),
 		},
-	)
+	))
 }
 
 func (s *grpcServer) RemoveItem(ctx context.Context, req *pb.RemoveItemRequest) (*empty.Empty, error) {
-	return &empty.Empty{}, s.manager.RemoveItem(
+	return &empty.Empty{}, versionError(s.manager.RemoveItem(
 		ctx,
-		&identifier{req.GetIdentifier().GetGUID()},
+		identifierFromGRPC(req.GetIdentifier()),
 		&Item{
 			ID:     req.GetItem().GetID(),
 			Amount: req.GetItem().GetAmount(),
 			Subset: req.GetItem().GetSubset(),
 		},
-	)
+	))
+}
+
+func (s *grpcServer) Transfer(ctx context.Context, req *pb.TransferRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, versionError(s.manager.Transfer(
+		ctx,
+		identifierFromGRPC(req.GetFrom()),
+		identifierFromGRPC(req.GetTo()),
+		&Item{
+			ID:     req.GetItem().GetID(),
+			Amount: req.GetItem().GetAmount(),
+			Subset: req.GetItem().GetSubset(),
+		},
+	))
+}
+
+func (s *grpcServer) Apply(ctx context.Context, req *pb.ApplyRequest) (*empty.Empty, error) {
+	ops := make([]*Operation, 0)
+	for _, v := range req.GetOperations() {
+		ops = append(ops, &Operation{
+			Kind:          v.GetKind(),
+			InventoryGUID: v.GetIdentifier().GetGUID(),
+			Item: &Item{
+				ID:     v.GetItem().GetID(),
+				Amount: v.GetItem().GetAmount(),
+				Subset: v.GetItem().GetSubset(),
+			},
+			Version: v.GetIdentifier().GetExpectedVersion(),
+		})
+	}
+
+	return &empty.Empty{}, operationError(s.manager.Apply(ctx, ops))
+}
+
+// operationError attaches the index of a failed operation to the status
+func operationError(err error) error {
+	e, ok := err.(*OperationError)
+	if !ok {
+		return err
+	}
+
+	p, ok := e.Err.(*problems.Problem)
+	code := codes.InvalidArgument
+	switch {
+	case e.Err == sql.ErrNoRows:
+		code = codes.NotFound
+	case ok && p.Status != http.StatusBadRequest:
+		code = codes.FailedPrecondition
+	}
+
+	st, err := status.New(code, e.Err.Error()).WithDetails(&pb.FailedOperation{
+		Index: int64(e.Index),
+	})
+	if err != nil {
+		return err
+	}
+
+	return st.Err()
 }
 
 func (s *grpcServer) Delete(ctx context.Context, id *pb.Identifier) (*empty.Empty, error) {
-	return &empty.Empty{}, s.manager.Delete(
+	return &empty.Empty{}, versionError(s.manager.Delete(
+		ctx,
+		identifierFromGRPC(id),
+	))
+}
+
+func (s *grpcServer) DeleteByOwner(ctx context.Context, o *pb.Owner) (*empty.Empty, error) {
+	return &empty.Empty{}, s.manager.DeleteByOwner(
 		ctx,
-		&identifier{id.GetGUID()},
+		ownerFromGRPC(o),
 	)
 }
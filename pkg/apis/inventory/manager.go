@@ -6,25 +6,51 @@ package inventory
 import (
 	"context"
 	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/51st-state/api/pkg/item"
+	"github.com/51st-state/api/pkg/problems"
 )
 
-// Manager is a manager for inventory objects
+// Manager is a manager for inventory objects. Changes of inventories identified
+// by a VersionedIdentifier fail with ErrVersionMismatch if they are in another version.
+// Changes checking the capacity of an inventory expect the version it was checked in
+// and are checked again if the inventory has been changed concurrently.
 type Manager interface {
 	Get(context.Context, Identifier) (Complete, error)
+	// GetByOwner returns the inventories of an owner ordered by their creation
+	GetByOwner(context.Context, *Owner) ([]Complete, error)
 	Create(context.Context, Incomplete) (Complete, error)
 	AddItem(context.Context, Identifier, *Item) error
 	RemoveItem(context.Context, Identifier, *Item) error
+	// Transfer an item from the first to the second inventory atomically
+	Transfer(ctx context.Context, from, to Identifier, item *Item) error
+	// Apply the operations in their order atomically. Returns an *OperationError
+	// naming the failed operation if an operation is invalid or can not be applied.
+	Apply(context.Context, []*Operation) error
 	Delete(context.Context, Identifier) error
+	// DeleteByOwner deletes the inventories of an owner, e.g. once the owner is deleted
+	DeleteByOwner(context.Context, *Owner) error
 }
 
 type manager struct {
 	repository Repository
+	items      item.Registry
+	containers Containers
+	capacity   float64
 }
 
-// NewManager creates a new manager for managing inventory objects
-func NewManager(r Repository) Manager {
+// NewManager creates a new manager for managing inventory objects.
+// Only items of the registry can be added to inventories. Inventories
+// without a capacity of their own can carry items up to the default capacity
+// of their container type, or up to the given capacity if they have no container type.
+func NewManager(r Repository, items item.Registry, containers Containers, capacity float64) Manager {
 	return &manager{
 		r,
+		items,
+		containers,
+		capacity,
 	}
 }
 
@@ -35,42 +61,203 @@ func (m *manager) Get(ctx context.Context, id Identifier) (Complete, error) {
 		return nil, errInvalidGUID
 	}
 
-	return m.repository.Get(ctx, id)
+	c, err := m.repository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	m.fill(c)
+
+	return c, nil
+}
+
+// fill in the capacity and the weight of a stored inventory
+func (m *manager) fill(c Complete) {
+	if c.Data().Capacity == 0 {
+		c.Data().Capacity = m.defaultCapacity(c.Data().Container)
+	}
+	c.Data().Weight = m.weight(c.Data().Items)
+}
+
+// defaultCapacity of a container type
+func (m *manager) defaultCapacity(container string) float64 {
+	if capacity := m.containers[container]; capacity > 0 {
+		return capacity
+	}
+
+	return m.capacity
+}
+
+func validateOwner(o *Owner) error {
+	if o == nil || o.Type == "" || o.ID == "" || strings.Contains(o.Type, "/") {
+		return errInvalidOwner
+	}
+
+	return nil
+}
+
+func (m *manager) GetByOwner(ctx context.Context, o *Owner) ([]Complete, error) {
+	if err := validateOwner(o); err != nil {
+		return nil, err
+	}
+
+	inventories, err := m.repository.GetByOwner(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range inventories {
+		m.fill(v)
+	}
+
+	return inventories, nil
 }
 
 var (
 	errInvalidItemID     = errors.New("invalid item id")
 	errInvalidItemAmount = errors.New("invalid item amount")
 	errInvalidItemSubset = errors.New("invalid item subset")
+	errInvalidCapacity   = errors.New("invalid capacity")
+	errInvalidOwner      = errors.New("invalid owner")
+	errSameInventory     = errors.New("items can not be transferred to the same inventory")
+	errEmptyBatch        = errors.New("at least one operation has to be given")
+	errInvalidKind       = errors.New("invalid kind of operation")
+	errUnknownItem       = problems.New("unknown item", "the item is not defined", http.StatusBadRequest)
+	errUnknownContainer  = problems.New("unknown container", "the container type is not defined", http.StatusBadRequest)
+	errCapacityExceeded  = problems.New("capacity exceeded", "the items exceed the capacity of the inventory", http.StatusConflict)
 )
 
-func (m *manager) Create(ctx context.Context, inc Incomplete) (Complete, error) {
-	for _, v := range inc.Data().Items {
-		if v.ID == "" {
-			return nil, errInvalidItemID
-		}
+func validateItem(item *Item) error {
+	if item.ID == "" {
+		return errInvalidItemID
+	}
 
-		if v.Amount == 0 {
-			return nil, errInvalidItemAmount
-		}
+	if item.Amount == 0 {
+		return errInvalidItemAmount
+	}
 
-		if v.Subset != -1 && !(v.Subset > 0) {
-			return nil, errInvalidItemSubset
+	if item.Subset != -1 && !(item.Subset > 0) {
+		return errInvalidItemSubset
+	}
+
+	return nil
+}
+
+// entry validates an item against its definition in the registry
+func (m *manager) entry(i *Item) (*item.ConfigEntry, error) {
+	if err := validateItem(i); err != nil {
+		return nil, err
+	}
+
+	entry, err := m.items.Get(i.ID)
+	if err == item.ErrUnknown {
+		return nil, errUnknownItem
+	} else if err != nil {
+		return nil, err
+	}
+
+	if i.Subset != -1 && i.Subset > entry.MaxSubset {
+		return nil, errInvalidItemSubset
+	}
+
+	return entry, nil
+}
+
+// weight of items. Items unknown to the registry do not weigh anything,
+// so inventories containing items defined earlier can still be used.
+func (m *manager) weight(items []*Item) float64 {
+	var weight float64
+	for _, v := range items {
+		if entry, err := m.items.Get(v.ID); err == nil {
+			weight += entry.Weight * float64(v.Amount)
 		}
 	}
 
-	c, err := m.repository.Create(ctx)
+	return weight
+}
+
+// checkAttempts is the number of times a change checking the capacity
+// of an inventory is attempted if the inventory is changed concurrently
+const checkAttempts = 3
+
+// fits checks whether an amount of an item fits into the capacity of an inventory
+// and returns the identifier of the inventory expecting the version it was checked in
+func (m *manager) fits(ctx context.Context, id Identifier, entry *item.ConfigEntry, amount uint64) (Identifier, error) {
+	c, err := m.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if c.Data().Weight+entry.Weight*float64(amount) > c.Data().Capacity {
+		return nil, errCapacityExceeded
+	}
+
+	if ExpectedVersion(id) != 0 {
+		return id, nil
+	}
+
+	return NewVersionedIdentifier(id.GUID(), c.Data().Version), nil
+}
+
+// retry a change checking the capacity of an inventory, if the inventory has been
+// changed since it was checked and the caller did not expect a version of its own
+func retry(conditional bool, change func() error) error {
+	for i := 1; ; i++ {
+		err := change()
+		if conditional || i == checkAttempts || !isVersionMismatch(err) {
+			return err
+		}
+	}
+}
+
+func isVersionMismatch(err error) bool {
+	if oErr, ok := err.(*OperationError); ok {
+		err = oErr.Err
+	}
+
+	return err == ErrVersionMismatch
+}
+
+func (m *manager) Create(ctx context.Context, inc Incomplete) (Complete, error) {
+	if inc.Data().Capacity < 0 {
+		return nil, errInvalidCapacity
+	}
+
+	if inc.Data().Owner != nil {
+		if err := validateOwner(inc.Data().Owner); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := m.containers[inc.Data().Container]; inc.Data().Container != "" && !ok {
+		return nil, errUnknownContainer
+	}
+
 	for _, v := range inc.Data().Items {
-		if err := m.repository.AddItem(ctx, c, v); err != nil {
+		if _, err := m.entry(v); err != nil {
 			return nil, err
 		}
 	}
 
+	capacity := inc.Data().Capacity
+	if capacity == 0 {
+		capacity = m.defaultCapacity(inc.Data().Container)
+	}
+
+	weight := m.weight(inc.Data().Items)
+	if weight > capacity {
+		return nil, errCapacityExceeded
+	}
+
+	// the items are stored with the inventory, so that the returned version includes them
+	c, err := m.repository.Create(ctx, inc)
+	if err != nil {
+		return nil, err
+	}
+
 	c.Data().Items = inc.Data().Items
+	c.Data().Capacity = capacity
+	c.Data().Weight = weight
 
 	return c, nil
 }
@@ -80,39 +267,156 @@ func (m *manager) AddItem(ctx context.Context, id Identifier, item *Item) error
 		return errInvalidGUID
 	}
 
-	if item.ID == "" {
-		return errInvalidItemID
+	entry, err := m.entry(item)
+	if err != nil {
+		return err
 	}
 
-	if item.Amount == 0 {
-		return errInvalidItemAmount
+	return retry(ExpectedVersion(id) != 0, func() error {
+		checked, err := m.fits(ctx, id, entry, item.Amount)
+		if err != nil {
+			return err
+		}
+
+		return m.repository.AddItem(ctx, checked, item)
+	})
+}
+
+func (m *manager) RemoveItem(ctx context.Context, id Identifier, item *Item) error {
+	if id.GUID() == "" {
+		return errInvalidGUID
 	}
 
-	if item.Subset != -1 && !(item.Subset > 0) {
-		return errInvalidItemSubset
+	if err := validateItem(item); err != nil {
+		return err
 	}
 
-	return m.repository.AddItem(ctx, id, item)
+	return m.repository.RemoveItem(ctx, id, item)
 }
 
-func (m *manager) RemoveItem(ctx context.Context, id Identifier, item *Item) error {
-	if id.GUID() == "" {
+func (m *manager) Transfer(ctx context.Context, from, to Identifier, item *Item) error {
+	if from.GUID() == "" || to.GUID() == "" {
 		return errInvalidGUID
 	}
 
-	if item.ID == "" {
-		return errInvalidItemID
+	if from.GUID() == to.GUID() {
+		return errSameInventory
 	}
 
-	if item.Amount == 0 {
-		return errInvalidItemAmount
+	entry, err := m.entry(item)
+	if err != nil {
+		return err
 	}
 
-	if item.Subset != -1 && !(item.Subset > 0) {
-		return errInvalidItemSubset
+	// a version expected of either inventory must not be overridden by a retry
+	return retry(ExpectedVersion(from) != 0 || ExpectedVersion(to) != 0, func() error {
+		checked, err := m.fits(ctx, to, entry, item.Amount)
+		if err != nil {
+			return err
+		}
+
+		return m.repository.Transfer(ctx, from, checked, item)
+	})
+}
+
+// validateOperation returns the weight the operation adds to its inventory
+func (m *manager) validateOperation(op *Operation) (float64, error) {
+	if op.InventoryGUID == "" {
+		return 0, errInvalidGUID
 	}
 
-	return m.repository.RemoveItem(ctx, id, item)
+	if op.Item == nil {
+		return 0, errInvalidItemID
+	}
+
+	switch op.Kind {
+	case OperationAdd:
+		entry, err := m.entry(op.Item)
+		if err != nil {
+			return 0, err
+		}
+
+		return entry.Weight * float64(op.Item.Amount), nil
+	case OperationRemove:
+		if err := validateItem(op.Item); err != nil {
+			return 0, err
+		}
+
+		return -m.weight([]*Item{op.Item}), nil
+	default:
+		return 0, errInvalidKind
+	}
+}
+
+func (m *manager) Apply(ctx context.Context, ops []*Operation) error {
+	if len(ops) == 0 {
+		return errEmptyBatch
+	}
+
+	weights := make([]float64, len(ops))
+	for i, v := range ops {
+		weight, err := m.validateOperation(v)
+		if err != nil {
+			return &OperationError{Index: i, Operation: v, Err: err}
+		}
+
+		weights[i] = weight
+	}
+
+	conditional := false
+	for _, v := range ops {
+		conditional = conditional || v.Version != 0
+	}
+
+	return retry(conditional, func() error {
+		checked, err := m.check(ctx, ops, weights)
+		if err != nil {
+			return err
+		}
+
+		err = m.repository.Apply(ctx, checked)
+		if oErr, ok := err.(*OperationError); ok {
+			// name the operation of the caller instead of its checked copy
+			oErr.Operation = ops[oErr.Index]
+		}
+
+		return err
+	})
+}
+
+// check the capacities of the inventories after each operation and return
+// the operations expecting the versions the inventories were checked in
+func (m *manager) check(ctx context.Context, ops []*Operation, weights []float64) ([]*Operation, error) {
+	inventories := make(map[string]Complete)
+	for i, v := range ops {
+		c, ok := inventories[v.InventoryGUID]
+		if !ok {
+			var err error
+			c, err = m.Get(ctx, &identifier{v.InventoryGUID})
+			if err != nil {
+				return nil, &OperationError{Index: i, Operation: v, Err: err}
+			}
+
+			inventories[v.InventoryGUID] = c
+		}
+
+		c.Data().Weight += weights[i]
+		if weights[i] > 0 && c.Data().Weight > c.Data().Capacity {
+			return nil, &OperationError{Index: i, Operation: v, Err: errCapacityExceeded}
+		}
+	}
+
+	checked := make([]*Operation, 0, len(ops))
+	for _, v := range ops {
+		op := *v
+		if op.Version == 0 {
+			op.Version = inventories[v.InventoryGUID].Data().Version
+		}
+
+		checked = append(checked, &op)
+	}
+
+	return checked, nil
 }
 
 func (m *manager) Delete(ctx context.Context, id Identifier) error {
@@ -122,3 +426,11 @@ func (m *manager) Delete(ctx context.Context, id Identifier) error {
 
 	return m.repository.Delete(ctx, id)
 }
+
+func (m *manager) DeleteByOwner(ctx context.Context, o *Owner) error {
+	if err := validateOwner(o); err != nil {
+		return err
+	}
+
+	return m.repository.DeleteByOwner(ctx, o)
+}
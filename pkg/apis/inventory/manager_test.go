@@ -3,15 +3,26 @@ package inventory_test
 import (
 	"context"
 	"errors"
+	"net/http/httptest"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/51st-state/api/pkg/apis/inventory"
+	"github.com/51st-state/api/pkg/apis/inventory/memory"
 	"github.com/51st-state/api/pkg/apis/inventory/mocks"
+	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/item"
 )
 
+var items = item.Config{
+	"testName": &item.ConfigEntry{Weight: 2, MaxSubset: 1},
+	"water":    &item.ConfigEntry{Weight: 0.5, MaxSubset: 0.75},
+}
+
 func TestManagerGet(t *testing.T) {
 	repo := &mocks.FakeRepository{}
-	m := inventory.NewManager(repo)
+	m := inventory.NewManager(repo, items, nil, 10)
 
 	id := &mocks.FakeIdentifier{}
 	id.GUIDReturns("")
@@ -22,9 +33,40 @@ func TestManagerGet(t *testing.T) {
 
 	id.GUIDReturns("test")
 
-	if _, err := m.Get(context.Background(), id); err != nil {
+	repo.GetReturns(&fakeComplete{
+		id,
+		inventory.NewIncomplete([]*inventory.Item{
+			&inventory.Item{ID: "testName", Amount: 2, Subset: -1},
+			&inventory.Item{ID: "water", Amount: 3, Subset: 0.5},
+			&inventory.Item{ID: "removed", Amount: 1, Subset: -1},
+		}),
+	}, nil)
+
+	c, err := m.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Data().Weight != 5.5 {
+		t.Fatal("the weight should be computed from the item definitions")
+	}
+
+	if c.Data().Capacity != 10 {
+		t.Fatal("an inventory without a capacity should have the default capacity")
+	}
+
+	inc := inventory.NewIncomplete(nil)
+	inc.Data().Capacity = 20
+	repo.GetReturns(&fakeComplete{id, inc}, nil)
+
+	c, err = m.Get(context.Background(), id)
+	if err != nil {
 		t.Fatal("there should be no error")
 	}
+
+	if c.Data().Capacity != 20 {
+		t.Fatal("the capacity of the inventory should be kept")
+	}
 }
 
 type fakeComplete struct {
@@ -34,7 +76,7 @@ type fakeComplete struct {
 
 func TestManagerCreate(t *testing.T) {
 	repo := &mocks.FakeRepository{}
-	m := inventory.NewManager(repo)
+	m := inventory.NewManager(repo, items, nil, 10)
 
 	inc := inventory.NewIncomplete([]*inventory.Item{
 		&inventory.Item{
@@ -85,22 +127,103 @@ func TestManagerCreate(t *testing.T) {
 	id.GUIDReturns("testName")
 	repo.CreateReturns(&fakeComplete{
 		id,
-		inc,
+		inventory.NewIncomplete(nil),
 	}, nil)
-	repo.AddItemReturns(errors.New("fake error"))
+	c, err := m.Create(context.Background(), inc)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, stored := repo.CreateArgsForCall(repo.CreateCallCount() - 1); len(stored.Data().Items) != 1 || repo.AddItemCallCount() != 0 {
+		t.Fatal("the items should be stored with the inventory")
+	}
+
+	if c.Data().Weight != 2 || c.Data().Capacity != 10 {
+		t.Fatal("the created inventory should have its weight and the default capacity")
+	}
+
+	if _, stored := repo.CreateArgsForCall(repo.CreateCallCount() - 1); stored.Data().Capacity != 0 {
+		t.Fatal("the default capacity should not be stored")
+	}
+
+	inc = inventory.NewIncomplete([]*inventory.Item{
+		&inventory.Item{
+			ID:     "unknown",
+			Amount: 1,
+			Subset: -1,
+		},
+	})
 	if _, err := m.Create(context.Background(), inc); err == nil {
-		t.Fatal("an item could not be added")
+		t.Fatal("the item is unknown")
 	}
 
-	repo.AddItemReturns(nil)
+	inc = inventory.NewIncomplete([]*inventory.Item{
+		&inventory.Item{
+			ID:     "testName",
+			Amount: 6,
+			Subset: -1,
+		},
+	})
+	if _, err := m.Create(context.Background(), inc); err == nil {
+		t.Fatal("the items exceed the default capacity")
+	}
+
+	inc.Data().Capacity = 12
 	if _, err := m.Create(context.Background(), inc); err != nil {
+		t.Fatal("the items fit into the capacity")
+	}
+
+	inc.Data().Capacity = -1
+	if _, err := m.Create(context.Background(), inc); err == nil {
+		t.Fatal("the capacity is invalid")
+	}
+}
+
+func TestManagerCreateETag(t *testing.T) {
+	ctx := context.Background()
+	m := inventory.NewManager(memory.NewRepository(), items, nil, 100)
+
+	c, err := m.Create(ctx, inventory.NewIncomplete([]*inventory.Item{
+		&inventory.Item{ID: "testName", Amount: 1, Subset: -1},
+		&inventory.Item{ID: "water", Amount: 2, Subset: 0.5},
+	}))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	w := httptest.NewRecorder()
+	if err := inventory.NewETagEncoder().Encode(w, c); err != nil {
 		t.Fatal("there should be no error")
 	}
+
+	// the ETag of the created inventory is sent back as If-Match
+	match, err := strconv.Unquote(w.Header().Get("ETag"))
+	if err != nil {
+		t.Fatal("the ETag should be quoted")
+	}
+
+	version, err := strconv.ParseUint(match, 10, 64)
+	if err != nil {
+		t.Fatal("the ETag should be the version")
+	}
+
+	stored, err := m.Get(ctx, c)
+	if err != nil || stored.Data().Version != version {
+		t.Fatal("the created inventory should be returned in its stored version")
+	}
+
+	if err := m.AddItem(ctx, inventory.NewVersionedIdentifier(c.GUID(), version), &inventory.Item{
+		ID:     "testName",
+		Amount: 1,
+		Subset: -1,
+	}); err != nil {
+		t.Fatal("the inventory should be changeable in the version it was created in")
+	}
 }
 
 func TestManagerAddItem(t *testing.T) {
 	repo := &mocks.FakeRepository{}
-	m := inventory.NewManager(repo)
+	m := inventory.NewManager(repo, items, nil, 10)
 
 	id := &mocks.FakeIdentifier{}
 	id.GUIDReturns("")
@@ -130,15 +253,53 @@ func TestManagerAddItem(t *testing.T) {
 		t.Fatal("the item subset is invalid")
 	}
 
+	repo.GetReturns(&fakeComplete{
+		id,
+		inventory.NewIncomplete([]*inventory.Item{
+			&inventory.Item{ID: "testName", Amount: 4, Subset: -1},
+		}),
+	}, nil)
+
 	item.Subset = -1
 	if err := m.AddItem(context.Background(), id, item); err != nil {
 		t.Fatal("there should be no error")
 	}
+
+	item.Amount = 2
+	if err := m.AddItem(context.Background(), id, item); err == nil {
+		t.Fatal("the item exceeds the capacity")
+	}
+
+	if repo.AddItemCallCount() != 1 {
+		t.Fatal("an item exceeding the capacity should not be added")
+	}
+
+	item.ID = "unknown"
+	if err := m.AddItem(context.Background(), id, item); err == nil {
+		t.Fatal("the item is unknown")
+	}
+
+	item.ID = "water"
+	item.Amount = 1
+	item.Subset = 1
+	if err := m.AddItem(context.Background(), id, item); err == nil {
+		t.Fatal("the subset exceeds the max subset of the item")
+	}
+
+	item.Subset = 0.75
+	if err := m.AddItem(context.Background(), id, item); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	repo.GetReturns(nil, errors.New("fake error"))
+	if err := m.AddItem(context.Background(), id, item); err == nil {
+		t.Fatal("the inventory could not be retrieved")
+	}
 }
 
 func TestManagerRemoveItem(t *testing.T) {
 	repo := &mocks.FakeRepository{}
-	m := inventory.NewManager(repo)
+	m := inventory.NewManager(repo, items, nil, 10)
 
 	id := &mocks.FakeIdentifier{}
 	id.GUIDReturns("")
@@ -172,9 +333,224 @@ func TestManagerRemoveItem(t *testing.T) {
 	}
 }
 
+func TestManagerTransfer(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	m := inventory.NewManager(repo, items, nil, 10)
+
+	from := &mocks.FakeIdentifier{}
+	to := &mocks.FakeIdentifier{}
+	item := &inventory.Item{
+		ID:     "testName",
+		Amount: 1,
+		Subset: -1,
+	}
+
+	if err := m.Transfer(context.Background(), from, to, item); err == nil {
+		t.Fatal("the guids are invalid")
+	}
+
+	from.GUIDReturns("from")
+	to.GUIDReturns("from")
+	if err := m.Transfer(context.Background(), from, to, item); err == nil {
+		t.Fatal("items can not be transferred to the same inventory")
+	}
+
+	to.GUIDReturns("to")
+	item.ID = "unknown"
+	if err := m.Transfer(context.Background(), from, to, item); err == nil {
+		t.Fatal("the item is unknown")
+	}
+
+	item.ID = "testName"
+	repo.GetReturns(&fakeComplete{
+		to,
+		inventory.NewIncomplete([]*inventory.Item{
+			&inventory.Item{ID: "testName", Amount: 5, Subset: -1},
+		}),
+	}, nil)
+	if err := m.Transfer(context.Background(), from, to, item); err == nil {
+		t.Fatal("the item exceeds the capacity of the target inventory")
+	}
+
+	if repo.TransferCallCount() != 0 {
+		t.Fatal("invalid transfers should not reach the repository")
+	}
+
+	repo.GetReturns(&fakeComplete{to, inventory.NewIncomplete(nil)}, nil)
+	if err := m.Transfer(context.Background(), from, to, item); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, gotFrom, gotTo, _ := repo.TransferArgsForCall(0); gotFrom.GUID() != "from" || gotTo.GUID() != "to" {
+		t.Fatal("the item should be transferred from the first to the second inventory")
+	}
+
+	repo.TransferReturns(inventory.ErrVersionMismatch)
+	if err := m.Transfer(context.Background(), inventory.NewVersionedIdentifier("from", 1), to, item); err != inventory.ErrVersionMismatch {
+		t.Fatal("the version mismatch of the source inventory should be returned")
+	}
+
+	if repo.TransferCallCount() != 2 {
+		t.Fatal("a transfer expecting a version of the source inventory should not be retried")
+	}
+}
+
+func TestManagerConcurrentTransfer(t *testing.T) {
+	ctx := context.Background()
+	m := inventory.NewManager(memory.NewRepository(), items, nil, 100)
+
+	inventories := make([]inventory.Complete, 3)
+	for i := range inventories {
+		c, err := m.Create(ctx, inventory.NewIncomplete([]*inventory.Item{
+			&inventory.Item{ID: "testName", Amount: 5, Subset: -1},
+		}))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		inventories[i] = c
+	}
+
+	// some transfers fail since an inventory runs out of items, which must not lose any item
+	var wg sync.WaitGroup
+	for i := 0; i < 60; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			m.Transfer(ctx, inventories[i%3], inventories[(i*7+1)%3], &inventory.Item{
+				ID:     "testName",
+				Amount: uint64(i%4 + 1),
+				Subset: -1,
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	var total uint64
+	for _, v := range inventories {
+		c, err := m.Get(ctx, v)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		for _, item := range c.Data().Items {
+			total += item.Amount
+		}
+	}
+
+	if total != 15 {
+		t.Fatal("the total amount of items should be conserved")
+	}
+}
+
+func TestManagerApply(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	m := inventory.NewManager(repo, items, nil, 10)
+
+	if err := m.Apply(context.Background(), nil); err == nil {
+		t.Fatal("at least one operation has to be given")
+	}
+
+	failedAt := func(err error) int {
+		e, ok := err.(*inventory.OperationError)
+		if !ok {
+			return -1
+		}
+
+		return e.Index
+	}
+
+	id := &mocks.FakeIdentifier{}
+	id.GUIDReturns("player")
+	repo.GetReturns(&fakeComplete{
+		id,
+		inventory.NewIncomplete([]*inventory.Item{
+			&inventory.Item{ID: "testName", Amount: 4, Subset: -1},
+		}),
+	}, nil)
+
+	remove := &inventory.Operation{Kind: inventory.OperationRemove, InventoryGUID: "player", Item: &inventory.Item{ID: "testName", Amount: 2, Subset: -1}}
+	add := &inventory.Operation{Kind: inventory.OperationAdd, InventoryGUID: "player", Item: &inventory.Item{ID: "testName", Amount: 3, Subset: -1}}
+
+	for i, ops := range [][]*inventory.Operation{
+		{remove, &inventory.Operation{Kind: "craft", InventoryGUID: "player", Item: add.Item}},
+		{remove, &inventory.Operation{Kind: inventory.OperationAdd, InventoryGUID: "", Item: add.Item}},
+		{remove, &inventory.Operation{Kind: inventory.OperationAdd, InventoryGUID: "player"}},
+		{remove, &inventory.Operation{Kind: inventory.OperationAdd, InventoryGUID: "player", Item: &inventory.Item{ID: "unknown", Amount: 1, Subset: -1}}},
+		{remove, &inventory.Operation{Kind: inventory.OperationAdd, InventoryGUID: "player", Item: &inventory.Item{ID: "water", Amount: 1, Subset: 1}}},
+		{remove, &inventory.Operation{Kind: inventory.OperationRemove, InventoryGUID: "player", Item: &inventory.Item{ID: "testName", Amount: 0, Subset: -1}}},
+	} {
+		if failedAt(m.Apply(context.Background(), ops)) != 1 {
+			t.Fatalf("the invalid operation of batch %d should be named", i)
+		}
+	}
+
+	// the weight of 8 drops to 4 and exceeds the capacity of 10 with the second addition
+	if failedAt(m.Apply(context.Background(), []*inventory.Operation{remove, add, add})) != 2 {
+		t.Fatal("the operation exceeding the capacity should be named")
+	}
+
+	if failedAt(m.Apply(context.Background(), []*inventory.Operation{add, remove})) != 0 {
+		t.Fatal("the operations should be checked in their order")
+	}
+
+	if repo.ApplyCallCount() != 0 {
+		t.Fatal("invalid batches should not reach the repository")
+	}
+
+	repo.GetReturns(nil, errors.New("fake error"))
+	if failedAt(m.Apply(context.Background(), []*inventory.Operation{remove})) != 0 {
+		t.Fatal("the operation on the inventory which could not be retrieved should be named")
+	}
+
+	stored := inventory.NewIncomplete(nil)
+	stored.Data().Version = 3
+	repo.GetReturns(&fakeComplete{id, stored}, nil)
+	if err := m.Apply(context.Background(), []*inventory.Operation{remove, add}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, ops := repo.ApplyArgsForCall(0); len(ops) != 2 || ops[0].Item != remove.Item || ops[1].Item != add.Item {
+		t.Fatal("the operations should be applied in their order")
+	}
+
+	if _, ops := repo.ApplyArgsForCall(0); ops[0].Version != 3 || ops[1].Version != 3 || remove.Version != 0 {
+		t.Fatal("the operations should expect the versions the capacities were checked in")
+	}
+}
+
+func TestManagerApplyAtomic(t *testing.T) {
+	ctx := context.Background()
+	m := inventory.NewManager(memory.NewRepository(), items, nil, 100)
+
+	c, err := m.Create(ctx, inventory.NewIncomplete([]*inventory.Item{
+		&inventory.Item{ID: "testName", Amount: 3, Subset: -1},
+	}))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	err = m.Apply(ctx, []*inventory.Operation{
+		{Kind: inventory.OperationAdd, InventoryGUID: c.GUID(), Item: &inventory.Item{ID: "water", Amount: 1, Subset: 0.5}},
+		{Kind: inventory.OperationRemove, InventoryGUID: c.GUID(), Item: &inventory.Item{ID: "testName", Amount: 4, Subset: -1}},
+	})
+	if e, ok := err.(*inventory.OperationError); !ok || e.Index != 1 {
+		t.Fatal("the removal exceeding the amount should be named")
+	}
+
+	got, err := m.Get(ctx, c)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(got.Data().Items) != 1 || got.Data().Items[0].Amount != 3 {
+		t.Fatal("no operation of the failed batch should be applied")
+	}
+}
+
 func TestManagerDelete(t *testing.T) {
 	repo := &mocks.FakeRepository{}
-	m := inventory.NewManager(repo)
+	m := inventory.NewManager(repo, items, nil, 10)
 
 	id := &mocks.FakeIdentifier{}
 	id.GUIDReturns("")
@@ -187,3 +563,275 @@ func TestManagerDelete(t *testing.T) {
 		t.Fatal("there should be no error")
 	}
 }
+
+func TestManagerContainers(t *testing.T) {
+	ctx := context.Background()
+	m := inventory.NewManager(memory.NewRepository(), items, inventory.Containers{
+		"trunk": 20,
+	}, 10)
+
+	inc := inventory.NewIncomplete(nil)
+	inc.Data().Container = "unknown"
+	if _, err := m.Create(ctx, inc); err == nil {
+		t.Fatal("the container type is unknown")
+	}
+
+	inc.Data().Container = "trunk"
+	inc.Data().Owner = inventory.NewOwner("", "42")
+	if _, err := m.Create(ctx, inc); err == nil {
+		t.Fatal("the owner is invalid")
+	}
+
+	inc = inventory.NewIncomplete([]*inventory.Item{
+		&inventory.Item{ID: "testName", Amount: 8, Subset: -1},
+	})
+	inc.Data().Container = "trunk"
+	inc.Data().Owner = inventory.NewOwner(inventory.OwnerVehicle, "42")
+
+	c, err := m.Create(ctx, inc)
+	if err != nil {
+		t.Fatal("the items fit into the default capacity of the container")
+	}
+
+	if c.Data().Capacity != 20 {
+		t.Fatal("the created inventory should have the default capacity of the container")
+	}
+
+	c, err = m.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Data().Capacity != 20 || c.Data().Container != "trunk" || c.Data().Owner.String() != "vehicle/42" {
+		t.Fatal("the container and the owner should be kept")
+	}
+
+	c, err = m.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Data().Capacity != 10 {
+		t.Fatal("an inventory without a container should have the default capacity")
+	}
+}
+
+func TestManagerOwner(t *testing.T) {
+	ctx := context.Background()
+	m := inventory.NewManager(memory.NewRepository(), items, nil, 10)
+
+	if _, err := m.GetByOwner(ctx, inventory.NewOwner(inventory.OwnerProperty, "")); err == nil {
+		t.Fatal("the owner is invalid")
+	}
+
+	if err := m.DeleteByOwner(ctx, inventory.NewOwner("", "7")); err == nil {
+		t.Fatal("the owner is invalid")
+	}
+
+	owner := inventory.NewOwner(inventory.OwnerProperty, "7")
+	for i := 0; i < 2; i++ {
+		inc := inventory.NewIncomplete([]*inventory.Item{
+			&inventory.Item{ID: "water", Amount: 2, Subset: 0.5},
+		})
+		inc.Data().Owner = owner
+
+		if _, err := m.Create(ctx, inc); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	inventories, err := m.GetByOwner(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(inventories) != 2 || inventories[0].Data().Weight != 1 || inventories[0].Data().Capacity != 10 {
+		t.Fatal("the inventories of the owner should be returned with their weight and capacity")
+	}
+
+	if err := m.DeleteByOwner(ctx, owner); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	inventories, err = m.GetByOwner(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(inventories) != 0 {
+		t.Fatal("the inventories of the owner should be deleted")
+	}
+}
+
+func TestEventHandler(t *testing.T) {
+	ctx := context.Background()
+	m := &mocks.FakeManager{}
+	handler := inventory.NewEventHandler(m, inventory.OwnerEvents{
+		event.ID("character_purged"): inventory.OwnerCharacter,
+	})
+
+	payload := []byte(`{"meta":{"version":"1"},"data":{"guid":"42","first_name":"John"}}`)
+
+	if err := handler(ctx, &event.Event{
+		Meta:    &event.Meta{ID: event.ID("character_created")},
+		Payload: payload,
+	}); err != nil || m.DeleteByOwnerCallCount() != 0 {
+		t.Fatal("other events should be ignored")
+	}
+
+	if err := handler(ctx, &event.Event{
+		Meta:    &event.Meta{ID: event.ID("character_purged")},
+		Payload: payload,
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, owner := m.DeleteByOwnerArgsForCall(0); owner.String() != "character/42" {
+		t.Fatal("the inventories of the deleted owner should be deleted")
+	}
+
+	if err := handler(ctx, &event.Event{
+		Meta:    &event.Meta{ID: event.ID("character_purged")},
+		Payload: []byte(`{"data":{}}`),
+	}); err != nil || m.DeleteByOwnerCallCount() != 1 {
+		t.Fatal("events without a valid owner should be skipped")
+	}
+
+	if err := handler(ctx, &event.Event{
+		Meta:    &event.Meta{ID: event.ID("character_purged")},
+		Payload: []byte("invalid"),
+	}); err == nil {
+		t.Fatal("the payload is invalid")
+	}
+
+	m.DeleteByOwnerReturns(errors.New("fake error"))
+	if err := handler(ctx, &event.Event{
+		Meta:    &event.Meta{ID: event.ID("character_purged")},
+		Payload: payload,
+	}); err == nil {
+		t.Fatal("the error should be returned for a redelivery")
+	}
+}
+
+func TestManagerVersion(t *testing.T) {
+	ctx := context.Background()
+	m := inventory.NewManager(memory.NewRepository(), items, nil, 10)
+
+	c, err := m.Create(ctx, inventory.NewIncomplete([]*inventory.Item{
+		&inventory.Item{ID: "testName", Amount: 1, Subset: -1},
+	}))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	c, err = m.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	read := inventory.NewVersionedIdentifier(c.GUID(), c.Data().Version)
+	if inventory.ExpectedVersion(read) != c.Data().Version || inventory.ExpectedVersion(c) != 0 {
+		t.Fatal("only versioned identifiers should expect a version")
+	}
+
+	if err := m.AddItem(ctx, read, &inventory.Item{ID: "testName", Amount: 1, Subset: -1}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := m.RemoveItem(ctx, read, &inventory.Item{ID: "testName", Amount: 1, Subset: -1}); err != inventory.ErrVersionMismatch {
+		t.Fatal("the inventory has been changed since it was read")
+	}
+
+	err = m.Apply(ctx, []*inventory.Operation{
+		{Kind: inventory.OperationRemove, InventoryGUID: c.GUID(), Item: &inventory.Item{ID: "testName", Amount: 1, Subset: -1}, Version: read.Version()},
+	})
+	if e, ok := err.(*inventory.OperationError); !ok || e.Err != inventory.ErrVersionMismatch {
+		t.Fatal("the inventory has been changed since it was read")
+	}
+
+	if err := m.Delete(ctx, read); err != inventory.ErrVersionMismatch {
+		t.Fatal("the inventory has been changed since it was read")
+	}
+
+	if err := m.Delete(ctx, c); err != nil {
+		t.Fatal("an inventory should be deleted regardless of its version without an expected version")
+	}
+}
+
+// racingRepository adds an item concurrently right before the next change is applied
+type racingRepository struct {
+	inventory.Repository
+	races int
+}
+
+func (r *racingRepository) race(guid string) {
+	if r.races > 0 {
+		r.races--
+		r.Repository.AddItem(context.Background(), inventory.NewIdentifier(guid), &inventory.Item{ID: "testName", Amount: 1, Subset: -1})
+	}
+}
+
+func (r *racingRepository) AddItem(ctx context.Context, id inventory.Identifier, item *inventory.Item) error {
+	r.race(id.GUID())
+	return r.Repository.AddItem(ctx, id, item)
+}
+
+func (r *racingRepository) Apply(ctx context.Context, ops []*inventory.Operation) error {
+	r.race(ops[0].InventoryGUID)
+	return r.Repository.Apply(ctx, ops)
+}
+
+func TestManagerCapacityRace(t *testing.T) {
+	ctx := context.Background()
+	repo := &racingRepository{Repository: memory.NewRepository()}
+	m := inventory.NewManager(repo, items, nil, 10)
+
+	c, err := m.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	// the weight of 2 added concurrently is noticed by a second check
+	repo.races = 1
+	if err := m.AddItem(ctx, c, &inventory.Item{ID: "testName", Amount: 3, Subset: -1}); err != nil {
+		t.Fatal("the change should be checked again")
+	}
+
+	// the weight of 8 exceeds the capacity of 10 with the concurrent addition
+	repo.races = 1
+	if err := m.AddItem(ctx, c, &inventory.Item{ID: "testName", Amount: 1, Subset: -1}); err == nil {
+		t.Fatal("the concurrent addition should be checked against the capacity")
+	}
+
+	if stored, err := m.Get(ctx, c); err != nil || stored.Data().Weight != 10 {
+		t.Fatal("the capacity should not be exceeded")
+	}
+
+	repo.races = 0
+	if err := m.RemoveItem(ctx, c, &inventory.Item{ID: "testName", Amount: 1, Subset: -1}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	repo.races = 1
+	err = m.Apply(ctx, []*inventory.Operation{
+		{Kind: inventory.OperationAdd, InventoryGUID: c.GUID(), Item: &inventory.Item{ID: "testName", Amount: 1, Subset: -1}},
+	})
+	if _, ok := err.(*inventory.OperationError); !ok {
+		t.Fatal("the concurrent addition should be checked against the capacity")
+	}
+
+	if stored, err := m.Get(ctx, c); err != nil || stored.Data().Weight != 10 {
+		t.Fatal("the capacity should not be exceeded")
+	}
+
+	repo.races = 0
+	if err := m.RemoveItem(ctx, c, &inventory.Item{ID: "testName", Amount: 4, Subset: -1}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	// every attempt is raced by a concurrent addition
+	repo.races = 3
+	if err := m.AddItem(ctx, c, &inventory.Item{ID: "water", Amount: 1, Subset: -1}); err != inventory.ErrVersionMismatch {
+		t.Fatal("the change should fail if the inventory keeps changing")
+	}
+}
@@ -0,0 +1,359 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/inventory"
+)
+
+type identifier struct {
+	guid string
+}
+
+func (i *identifier) GUID() string {
+	return i.guid
+}
+
+type complete struct {
+	inventory.Identifier
+	inventory.Incomplete
+}
+
+func (c *complete) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		GUID      string            `json:"guid"`
+		Items     []*inventory.Item `json:"items"`
+		Capacity  float64           `json:"capacity"`
+		Weight    float64           `json:"weight"`
+		Owner     *inventory.Owner  `json:"owner"`
+		Container string            `json:"container"`
+		Version   uint64            `json:"version"`
+	}{
+		GUID:      c.GUID(),
+		Items:     c.Data().Items,
+		Capacity:  c.Data().Capacity,
+		Weight:    c.Data().Weight,
+		Owner:     c.Data().Owner,
+		Container: c.Data().Container,
+		Version:   c.Data().Version,
+	})
+}
+
+// properties of a stored inventory besides its items
+type properties struct {
+	capacity  float64
+	owner     *inventory.Owner
+	container string
+	version   uint64
+}
+
+type repository struct {
+	mutex       sync.RWMutex
+	inventories map[string][]*inventory.Item
+	properties  map[string]*properties
+	// guids of the inventories in the order of their creation
+	created []string
+}
+
+// NewRepository creates a new storage layer in memory
+func NewRepository() inventory.Repository {
+	return &repository{
+		inventories: make(map[string][]*inventory.Item),
+		properties:  make(map[string]*properties),
+	}
+}
+
+// get a stored inventory, the lock has to be held by the caller
+func (r *repository) get(id inventory.Identifier) (inventory.Complete, error) {
+	stored, ok := r.inventories[id.GUID()]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	items := make([]*inventory.Item, 0)
+	for _, v := range stored {
+		if v.Amount > 0 {
+			item := *v
+			items = append(items, &item)
+		}
+	}
+
+	props := r.properties[id.GUID()]
+
+	inc := inventory.NewIncomplete(items)
+	inc.Data().Capacity = props.capacity
+	inc.Data().Container = props.container
+	inc.Data().Version = props.version
+	if props.owner != nil {
+		owner := *props.owner
+		inc.Data().Owner = &owner
+	}
+
+	return &complete{
+		id,
+		inc,
+	}, nil
+}
+
+func (r *repository) Get(ctx context.Context, id inventory.Identifier) (inventory.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.get(id)
+}
+
+// owned checks whether an inventory belongs to the owner
+func (r *repository) owned(guid string, owner *inventory.Owner) bool {
+	o := r.properties[guid].owner
+	return o != nil && *o == *owner
+}
+
+func (r *repository) GetByOwner(ctx context.Context, owner *inventory.Owner) ([]inventory.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	inventories := make([]inventory.Complete, 0)
+	for _, guid := range r.created {
+		if !r.owned(guid, owner) {
+			continue
+		}
+
+		c, err := r.get(&identifier{guid})
+		if err != nil {
+			return nil, err
+		}
+
+		inventories = append(inventories, c)
+	}
+
+	return inventories, nil
+}
+
+func (r *repository) Create(ctx context.Context, inc inventory.Incomplete) (inventory.Complete, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	props := &properties{
+		capacity:  inc.Data().Capacity,
+		container: inc.Data().Container,
+		version:   1,
+	}
+	if inc.Data().Owner != nil {
+		owner := *inc.Data().Owner
+		props.owner = &owner
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.inventories[rand.String()] = make([]*inventory.Item, 0)
+	r.properties[rand.String()] = props
+	for _, v := range inc.Data().Items {
+		r.add(rand.String(), v)
+	}
+	r.created = append(r.created, rand.String())
+
+	return r.get(&identifier{rand.String()})
+}
+
+// find the stored item with the id and subset of the given item
+func find(items []*inventory.Item, item *inventory.Item) *inventory.Item {
+	for _, v := range items {
+		if v.ID == item.ID && v.Subset == item.Subset {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// check whether an inventory exists in the expected version, the lock has to be held by the caller
+func (r *repository) check(id inventory.Identifier) error {
+	props, ok := r.properties[id.GUID()]
+	if !ok {
+		return sql.ErrNoRows
+	}
+
+	if expected := inventory.ExpectedVersion(id); expected != 0 && expected != props.version {
+		return inventory.ErrVersionMismatch
+	}
+
+	return nil
+}
+
+// add an item to a stored inventory, the lock has to be held by the caller
+func (r *repository) add(guid string, item *inventory.Item) {
+	items := r.inventories[guid]
+	if stored := find(items, item); stored != nil {
+		stored.Amount += item.Amount
+		return
+	}
+
+	added := *item
+	r.inventories[guid] = append(items, &added)
+}
+
+func (r *repository) AddItem(ctx context.Context, id inventory.Identifier, item *inventory.Item) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.check(id); err != nil {
+		return err
+	}
+
+	r.add(id.GUID(), item)
+	r.properties[id.GUID()].version++
+
+	return nil
+}
+
+func (r *repository) RemoveItem(ctx context.Context, id inventory.Identifier, item *inventory.Item) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.check(id); err != nil {
+		return err
+	}
+
+	stored := find(r.inventories[id.GUID()], item)
+	if stored == nil || stored.Amount < item.Amount {
+		return sql.ErrNoRows
+	}
+
+	stored.Amount -= item.Amount
+	r.properties[id.GUID()].version++
+
+	return nil
+}
+
+func (r *repository) Transfer(ctx context.Context, from, to inventory.Identifier, item *inventory.Item) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, v := range []inventory.Identifier{from, to} {
+		if err := r.check(v); err != nil {
+			return err
+		}
+	}
+
+	stored := find(r.inventories[from.GUID()], item)
+	if stored == nil || stored.Amount < item.Amount {
+		return sql.ErrNoRows
+	}
+
+	stored.Amount -= item.Amount
+	r.add(to.GUID(), item)
+
+	r.properties[from.GUID()].version++
+	r.properties[to.GUID()].version++
+
+	return nil
+}
+
+func (r *repository) Apply(ctx context.Context, ops []*inventory.Operation) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// the operations are applied to copies, which replace the stored inventories on success
+	applied := make(map[string][]*inventory.Item)
+	for i, v := range ops {
+		// the versions are compared to the ones before the batch
+		if err := r.check(inventory.NewVersionedIdentifier(v.InventoryGUID, v.Version)); err != nil {
+			return &inventory.OperationError{Index: i, Operation: v, Err: err}
+		}
+
+		items, ok := applied[v.InventoryGUID]
+		if !ok {
+			stored := r.inventories[v.InventoryGUID]
+			items = make([]*inventory.Item, 0, len(stored))
+			for _, item := range stored {
+				c := *item
+				items = append(items, &c)
+			}
+		}
+
+		switch v.Kind {
+		case inventory.OperationAdd:
+			if stored := find(items, v.Item); stored != nil {
+				stored.Amount += v.Item.Amount
+			} else {
+				added := *v.Item
+				items = append(items, &added)
+			}
+		case inventory.OperationRemove:
+			stored := find(items, v.Item)
+			if stored == nil || stored.Amount < v.Item.Amount {
+				return &inventory.OperationError{Index: i, Operation: v, Err: sql.ErrNoRows}
+			}
+
+			stored.Amount -= v.Item.Amount
+		default:
+			return fmt.Errorf("unknown kind of operation %s", v.Kind)
+		}
+
+		applied[v.InventoryGUID] = items
+	}
+
+	for guid, items := range applied {
+		r.inventories[guid] = items
+		r.properties[guid].version++
+	}
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id inventory.Identifier) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.check(id); err == inventory.ErrVersionMismatch {
+		return err
+	}
+
+	r.delete(id.GUID())
+
+	return nil
+}
+
+// delete an inventory, the lock has to be held by the caller
+func (r *repository) delete(guid string) {
+	if _, ok := r.inventories[guid]; !ok {
+		return
+	}
+
+	delete(r.inventories, guid)
+	delete(r.properties, guid)
+
+	for i, v := range r.created {
+		if v == guid {
+			r.created = append(r.created[:i], r.created[i+1:]...)
+			break
+		}
+	}
+}
+
+func (r *repository) DeleteByOwner(ctx context.Context, owner *inventory.Owner) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	owned := make([]string, 0)
+	for _, guid := range r.created {
+		if r.owned(guid, owner) {
+			owned = append(owned, guid)
+		}
+	}
+
+	for _, guid := range owned {
+		r.delete(guid)
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/inventory"
+	"github.com/51st-state/api/pkg/apis/inventory/memory"
+	"github.com/51st-state/api/pkg/apis/inventory/repositorytest"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) inventory.Repository {
+		return memory.NewRepository()
+	})
+}
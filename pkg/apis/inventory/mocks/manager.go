@@ -23,6 +23,20 @@ type FakeManager struct {
 		result1 inventory.Complete
 		result2 error
 	}
+	GetByOwnerStub        func(context.Context, *inventory.Owner) ([]inventory.Complete, error)
+	getByOwnerMutex       sync.RWMutex
+	getByOwnerArgsForCall []struct {
+		arg1 context.Context
+		arg2 *inventory.Owner
+	}
+	getByOwnerReturns struct {
+		result1 []inventory.Complete
+		result2 error
+	}
+	getByOwnerReturnsOnCall map[int]struct {
+		result1 []inventory.Complete
+		result2 error
+	}
 	CreateStub        func(context.Context, inventory.Incomplete) (inventory.Complete, error)
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
@@ -63,6 +77,32 @@ type FakeManager struct {
 	removeItemReturnsOnCall map[int]struct {
 		result1 error
 	}
+	TransferStub        func(ctx context.Context, from inventory.Identifier, to inventory.Identifier, item *inventory.Item) error
+	transferMutex       sync.RWMutex
+	transferArgsForCall []struct {
+		ctx  context.Context
+		from inventory.Identifier
+		to   inventory.Identifier
+		item *inventory.Item
+	}
+	transferReturns struct {
+		result1 error
+	}
+	transferReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ApplyStub        func(context.Context, []*inventory.Operation) error
+	applyMutex       sync.RWMutex
+	applyArgsForCall []struct {
+		arg1 context.Context
+		arg2 []*inventory.Operation
+	}
+	applyReturns struct {
+		result1 error
+	}
+	applyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteStub        func(context.Context, inventory.Identifier) error
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -75,6 +115,18 @@ type FakeManager struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteByOwnerStub        func(context.Context, *inventory.Owner) error
+	deleteByOwnerMutex       sync.RWMutex
+	deleteByOwnerArgsForCall []struct {
+		arg1 context.Context
+		arg2 *inventory.Owner
+	}
+	deleteByOwnerReturns struct {
+		result1 error
+	}
+	deleteByOwnerReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -131,6 +183,58 @@ func (fake *FakeManager) GetReturnsOnCall(i int, result1 inventory.Complete, res
 	}{result1, result2}
 }
 
+func (fake *FakeManager) GetByOwner(arg1 context.Context, arg2 *inventory.Owner) ([]inventory.Complete, error) {
+	fake.getByOwnerMutex.Lock()
+	ret, specificReturn := fake.getByOwnerReturnsOnCall[len(fake.getByOwnerArgsForCall)]
+	fake.getByOwnerArgsForCall = append(fake.getByOwnerArgsForCall, struct {
+		arg1 context.Context
+		arg2 *inventory.Owner
+	}{arg1, arg2})
+	fake.recordInvocation("GetByOwner", []interface{}{arg1, arg2})
+	fake.getByOwnerMutex.Unlock()
+	if fake.GetByOwnerStub != nil {
+		return fake.GetByOwnerStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getByOwnerReturns.result1, fake.getByOwnerReturns.result2
+}
+
+func (fake *FakeManager) GetByOwnerCallCount() int {
+	fake.getByOwnerMutex.RLock()
+	defer fake.getByOwnerMutex.RUnlock()
+	return len(fake.getByOwnerArgsForCall)
+}
+
+func (fake *FakeManager) GetByOwnerArgsForCall(i int) (context.Context, *inventory.Owner) {
+	fake.getByOwnerMutex.RLock()
+	defer fake.getByOwnerMutex.RUnlock()
+	return fake.getByOwnerArgsForCall[i].arg1, fake.getByOwnerArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetByOwnerReturns(result1 []inventory.Complete, result2 error) {
+	fake.GetByOwnerStub = nil
+	fake.getByOwnerReturns = struct {
+		result1 []inventory.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetByOwnerReturnsOnCall(i int, result1 []inventory.Complete, result2 error) {
+	fake.GetByOwnerStub = nil
+	if fake.getByOwnerReturnsOnCall == nil {
+		fake.getByOwnerReturnsOnCall = make(map[int]struct {
+			result1 []inventory.Complete
+			result2 error
+		})
+	}
+	fake.getByOwnerReturnsOnCall[i] = struct {
+		result1 []inventory.Complete
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeManager) Create(arg1 context.Context, arg2 inventory.Incomplete) (inventory.Complete, error) {
 	fake.createMutex.Lock()
 	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
@@ -283,6 +387,111 @@ func (fake *FakeManager) RemoveItemReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeManager) Transfer(ctx context.Context, from inventory.Identifier, to inventory.Identifier, item *inventory.Item) error {
+	fake.transferMutex.Lock()
+	ret, specificReturn := fake.transferReturnsOnCall[len(fake.transferArgsForCall)]
+	fake.transferArgsForCall = append(fake.transferArgsForCall, struct {
+		ctx  context.Context
+		from inventory.Identifier
+		to   inventory.Identifier
+		item *inventory.Item
+	}{ctx, from, to, item})
+	fake.recordInvocation("Transfer", []interface{}{ctx, from, to, item})
+	fake.transferMutex.Unlock()
+	if fake.TransferStub != nil {
+		return fake.TransferStub(ctx, from, to, item)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.transferReturns.result1
+}
+
+func (fake *FakeManager) TransferCallCount() int {
+	fake.transferMutex.RLock()
+	defer fake.transferMutex.RUnlock()
+	return len(fake.transferArgsForCall)
+}
+
+func (fake *FakeManager) TransferArgsForCall(i int) (context.Context, inventory.Identifier, inventory.Identifier, *inventory.Item) {
+	fake.transferMutex.RLock()
+	defer fake.transferMutex.RUnlock()
+	return fake.transferArgsForCall[i].ctx, fake.transferArgsForCall[i].from, fake.transferArgsForCall[i].to, fake.transferArgsForCall[i].item
+}
+
+func (fake *FakeManager) TransferReturns(result1 error) {
+	fake.TransferStub = nil
+	fake.transferReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) TransferReturnsOnCall(i int, result1 error) {
+	fake.TransferStub = nil
+	if fake.transferReturnsOnCall == nil {
+		fake.transferReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.transferReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Apply(arg1 context.Context, arg2 []*inventory.Operation) error {
+	var arg2Copy []*inventory.Operation
+	if arg2 != nil {
+		arg2Copy = make([]*inventory.Operation, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.applyMutex.Lock()
+	ret, specificReturn := fake.applyReturnsOnCall[len(fake.applyArgsForCall)]
+	fake.applyArgsForCall = append(fake.applyArgsForCall, struct {
+		arg1 context.Context
+		arg2 []*inventory.Operation
+	}{arg1, arg2Copy})
+	fake.recordInvocation("Apply", []interface{}{arg1, arg2Copy})
+	fake.applyMutex.Unlock()
+	if fake.ApplyStub != nil {
+		return fake.ApplyStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.applyReturns.result1
+}
+
+func (fake *FakeManager) ApplyCallCount() int {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return len(fake.applyArgsForCall)
+}
+
+func (fake *FakeManager) ApplyArgsForCall(i int) (context.Context, []*inventory.Operation) {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return fake.applyArgsForCall[i].arg1, fake.applyArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) ApplyReturns(result1 error) {
+	fake.ApplyStub = nil
+	fake.applyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) ApplyReturnsOnCall(i int, result1 error) {
+	fake.ApplyStub = nil
+	if fake.applyReturnsOnCall == nil {
+		fake.applyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.applyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeManager) Delete(arg1 context.Context, arg2 inventory.Identifier) error {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -332,19 +541,76 @@ func (fake *FakeManager) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeManager) DeleteByOwner(arg1 context.Context, arg2 *inventory.Owner) error {
+	fake.deleteByOwnerMutex.Lock()
+	ret, specificReturn := fake.deleteByOwnerReturnsOnCall[len(fake.deleteByOwnerArgsForCall)]
+	fake.deleteByOwnerArgsForCall = append(fake.deleteByOwnerArgsForCall, struct {
+		arg1 context.Context
+		arg2 *inventory.Owner
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteByOwner", []interface{}{arg1, arg2})
+	fake.deleteByOwnerMutex.Unlock()
+	if fake.DeleteByOwnerStub != nil {
+		return fake.DeleteByOwnerStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteByOwnerReturns.result1
+}
+
+func (fake *FakeManager) DeleteByOwnerCallCount() int {
+	fake.deleteByOwnerMutex.RLock()
+	defer fake.deleteByOwnerMutex.RUnlock()
+	return len(fake.deleteByOwnerArgsForCall)
+}
+
+func (fake *FakeManager) DeleteByOwnerArgsForCall(i int) (context.Context, *inventory.Owner) {
+	fake.deleteByOwnerMutex.RLock()
+	defer fake.deleteByOwnerMutex.RUnlock()
+	return fake.deleteByOwnerArgsForCall[i].arg1, fake.deleteByOwnerArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) DeleteByOwnerReturns(result1 error) {
+	fake.DeleteByOwnerStub = nil
+	fake.deleteByOwnerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) DeleteByOwnerReturnsOnCall(i int, result1 error) {
+	fake.DeleteByOwnerStub = nil
+	if fake.deleteByOwnerReturnsOnCall == nil {
+		fake.deleteByOwnerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteByOwnerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getMutex.RLock()
 	defer fake.getMutex.RUnlock()
+	fake.getByOwnerMutex.RLock()
+	defer fake.getByOwnerMutex.RUnlock()
 	fake.createMutex.RLock()
 	defer fake.createMutex.RUnlock()
 	fake.addItemMutex.RLock()
 	defer fake.addItemMutex.RUnlock()
 	fake.removeItemMutex.RLock()
 	defer fake.removeItemMutex.RUnlock()
+	fake.transferMutex.RLock()
+	defer fake.transferMutex.RUnlock()
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.deleteByOwnerMutex.RLock()
+	defer fake.deleteByOwnerMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -24,7 +24,9 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type Identifier struct {
-	GUID                 string   `protobuf:"bytes,1,opt,name=GUID,proto3" json:"GUID,omitempty"`
+	GUID string `protobuf:"bytes,1,opt,name=GUID,proto3" json:"GUID,omitempty"`
+	// ExpectedVersion of the inventory to change, zero means any version
+	ExpectedVersion      uint64   `protobuf:"varint,2,opt,name=ExpectedVersion,proto3" json:"ExpectedVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -62,6 +64,13 @@ func (m *Identifier) GetGUID() string {
 	return ""
 }
 
+func (m *Identifier) GetExpectedVersion() uint64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
 type Item struct {
 	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	Amount               uint64   `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
@@ -117,8 +126,60 @@ func (m *Item) GetSubset() float64 {
 	return 0
 }
 
+type Owner struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	ID                   string   `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Owner) Reset()         { *m = Owner{} }
+func (m *Owner) String() string { return proto.CompactTextString(m) }
+func (*Owner) ProtoMessage()    {}
+func (*Owner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{2}
+}
+
+func (m *Owner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Owner.Unmarshal(m, b)
+}
+func (m *Owner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Owner.Marshal(b, m, deterministic)
+}
+func (m *Owner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Owner.Merge(m, src)
+}
+func (m *Owner) XXX_Size() int {
+	return xxx_messageInfo_Owner.Size(m)
+}
+func (m *Owner) XXX_DiscardUnknown() {
+	xxx_messageInfo_Owner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Owner proto.InternalMessageInfo
+
+func (m *Owner) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Owner) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
 type Incomplete struct {
 	Items                []*Item  `protobuf:"bytes,1,rep,name=Items,proto3" json:"Items,omitempty"`
+	Capacity             float64  `protobuf:"fixed64,2,opt,name=Capacity,proto3" json:"Capacity,omitempty"`
+	Weight               float64  `protobuf:"fixed64,3,opt,name=Weight,proto3" json:"Weight,omitempty"`
+	Owner                *Owner   `protobuf:"bytes,4,opt,name=Owner,proto3" json:"Owner,omitempty"`
+	Container            string   `protobuf:"bytes,5,opt,name=Container,proto3" json:"Container,omitempty"`
+	Version              uint64   `protobuf:"varint,6,opt,name=Version,proto3" json:"Version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -128,7 +189,7 @@ func (m *Incomplete) Reset()         { *m = Incomplete{} }
 func (m *Incomplete) String() string { return proto.CompactTextString(m) }
 func (*Incomplete) ProtoMessage()    {}
 func (*Incomplete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cde9ec64f0d2c859, []int{2}
+	return fileDescriptor_cde9ec64f0d2c859, []int{3}
 }
 
 func (m *Incomplete) XXX_Unmarshal(b []byte) error {
@@ -156,6 +217,41 @@ func (m *Incomplete) GetItems() []*Item {
 	return nil
 }
 
+func (m *Incomplete) GetCapacity() float64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *Incomplete) GetWeight() float64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *Incomplete) GetOwner() *Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *Incomplete) GetContainer() string {
+	if m != nil {
+		return m.Container
+	}
+	return ""
+}
+
+func (m *Incomplete) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type Complete struct {
 	Identifier           *Identifier `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
 	Incomplete           *Incomplete `protobuf:"bytes,2,opt,name=Incomplete,proto3" json:"Incomplete,omitempty"`
@@ -168,7 +264,7 @@ func (m *Complete) Reset()         { *m = Complete{} }
 func (m *Complete) String() string { return proto.CompactTextString(m) }
 func (*Complete) ProtoMessage()    {}
 func (*Complete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cde9ec64f0d2c859, []int{3}
+	return fileDescriptor_cde9ec64f0d2c859, []int{4}
 }
 
 func (m *Complete) XXX_Unmarshal(b []byte) error {
@@ -203,6 +299,45 @@ func (m *Complete) GetIncomplete() *Incomplete {
 	return nil
 }
 
+type Inventories struct {
+	Inventories          []*Complete `protobuf:"bytes,1,rep,name=Inventories,proto3" json:"Inventories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Inventories) Reset()         { *m = Inventories{} }
+func (m *Inventories) String() string { return proto.CompactTextString(m) }
+func (*Inventories) ProtoMessage()    {}
+func (*Inventories) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{5}
+}
+
+func (m *Inventories) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Inventories.Unmarshal(m, b)
+}
+func (m *Inventories) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Inventories.Marshal(b, m, deterministic)
+}
+func (m *Inventories) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Inventories.Merge(m, src)
+}
+func (m *Inventories) XXX_Size() int {
+	return xxx_messageInfo_Inventories.Size(m)
+}
+func (m *Inventories) XXX_DiscardUnknown() {
+	xxx_messageInfo_Inventories.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Inventories proto.InternalMessageInfo
+
+func (m *Inventories) GetInventories() []*Complete {
+	if m != nil {
+		return m.Inventories
+	}
+	return nil
+}
+
 type AddItemRequest struct {
 	Identifier           *Identifier `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
 	Item                 *Item       `protobuf:"bytes,2,opt,name=Item,proto3" json:"Item,omitempty"`
@@ -215,7 +350,7 @@ func (m *AddItemRequest) Reset()         { *m = AddItemRequest{} }
 func (m *AddItemRequest) String() string { return proto.CompactTextString(m) }
 func (*AddItemRequest) ProtoMessage()    {}
 func (*AddItemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cde9ec64f0d2c859, []int{4}
+	return fileDescriptor_cde9ec64f0d2c859, []int{6}
 }
 
 func (m *AddItemRequest) XXX_Unmarshal(b []byte) error {
@@ -262,7 +397,7 @@ func (m *RemoveItemRequest) Reset()         { *m = RemoveItemRequest{} }
 func (m *RemoveItemRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveItemRequest) ProtoMessage()    {}
 func (*RemoveItemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cde9ec64f0d2c859, []int{5}
+	return fileDescriptor_cde9ec64f0d2c859, []int{7}
 }
 
 func (m *RemoveItemRequest) XXX_Unmarshal(b []byte) error {
@@ -297,42 +432,255 @@ func (m *RemoveItemRequest) GetItem() *Item {
 	return nil
 }
 
+type TransferRequest struct {
+	From                 *Identifier `protobuf:"bytes,1,opt,name=From,proto3" json:"From,omitempty"`
+	To                   *Identifier `protobuf:"bytes,2,opt,name=To,proto3" json:"To,omitempty"`
+	Item                 *Item       `protobuf:"bytes,3,opt,name=Item,proto3" json:"Item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TransferRequest) Reset()         { *m = TransferRequest{} }
+func (m *TransferRequest) String() string { return proto.CompactTextString(m) }
+func (*TransferRequest) ProtoMessage()    {}
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{8}
+}
+
+func (m *TransferRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferRequest.Unmarshal(m, b)
+}
+func (m *TransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferRequest.Marshal(b, m, deterministic)
+}
+func (m *TransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferRequest.Merge(m, src)
+}
+func (m *TransferRequest) XXX_Size() int {
+	return xxx_messageInfo_TransferRequest.Size(m)
+}
+func (m *TransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferRequest proto.InternalMessageInfo
+
+func (m *TransferRequest) GetFrom() *Identifier {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *TransferRequest) GetTo() *Identifier {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *TransferRequest) GetItem() *Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type Operation struct {
+	Kind                 string      `protobuf:"bytes,1,opt,name=Kind,proto3" json:"Kind,omitempty"`
+	Identifier           *Identifier `protobuf:"bytes,2,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Item                 *Item       `protobuf:"bytes,3,opt,name=Item,proto3" json:"Item,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Operation) Reset()         { *m = Operation{} }
+func (m *Operation) String() string { return proto.CompactTextString(m) }
+func (*Operation) ProtoMessage()    {}
+func (*Operation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{9}
+}
+
+func (m *Operation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Operation.Unmarshal(m, b)
+}
+func (m *Operation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Operation.Marshal(b, m, deterministic)
+}
+func (m *Operation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation.Merge(m, src)
+}
+func (m *Operation) XXX_Size() int {
+	return xxx_messageInfo_Operation.Size(m)
+}
+func (m *Operation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Operation proto.InternalMessageInfo
+
+func (m *Operation) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *Operation) GetIdentifier() *Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *Operation) GetItem() *Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+type ApplyRequest struct {
+	Operations           []*Operation `protobuf:"bytes,1,rep,name=Operations,proto3" json:"Operations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ApplyRequest) Reset()         { *m = ApplyRequest{} }
+func (m *ApplyRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplyRequest) ProtoMessage()    {}
+func (*ApplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{10}
+}
+
+func (m *ApplyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyRequest.Unmarshal(m, b)
+}
+func (m *ApplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyRequest.Merge(m, src)
+}
+func (m *ApplyRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplyRequest.Size(m)
+}
+func (m *ApplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyRequest proto.InternalMessageInfo
+
+func (m *ApplyRequest) GetOperations() []*Operation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+// FailedOperation is attached to the status of failed batches
+type FailedOperation struct {
+	Index                int64    `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FailedOperation) Reset()         { *m = FailedOperation{} }
+func (m *FailedOperation) String() string { return proto.CompactTextString(m) }
+func (*FailedOperation) ProtoMessage()    {}
+func (*FailedOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{11}
+}
+
+func (m *FailedOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FailedOperation.Unmarshal(m, b)
+}
+func (m *FailedOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FailedOperation.Marshal(b, m, deterministic)
+}
+func (m *FailedOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedOperation.Merge(m, src)
+}
+func (m *FailedOperation) XXX_Size() int {
+	return xxx_messageInfo_FailedOperation.Size(m)
+}
+func (m *FailedOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedOperation proto.InternalMessageInfo
+
+func (m *FailedOperation) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Identifier)(nil), "inventory.Identifier")
 	proto.RegisterType((*Item)(nil), "inventory.Item")
+	proto.RegisterType((*Owner)(nil), "inventory.Owner")
 	proto.RegisterType((*Incomplete)(nil), "inventory.Incomplete")
 	proto.RegisterType((*Complete)(nil), "inventory.Complete")
+	proto.RegisterType((*Inventories)(nil), "inventory.Inventories")
 	proto.RegisterType((*AddItemRequest)(nil), "inventory.AddItemRequest")
 	proto.RegisterType((*RemoveItemRequest)(nil), "inventory.RemoveItemRequest")
+	proto.RegisterType((*TransferRequest)(nil), "inventory.TransferRequest")
+	proto.RegisterType((*Operation)(nil), "inventory.Operation")
+	proto.RegisterType((*ApplyRequest)(nil), "inventory.ApplyRequest")
+	proto.RegisterType((*FailedOperation)(nil), "inventory.FailedOperation")
 }
 
 func init() { proto.RegisterFile("manager.proto", fileDescriptor_cde9ec64f0d2c859) }
 
 var fileDescriptor_cde9ec64f0d2c859 = []byte{
-	// 357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0x41, 0x4f, 0xf2, 0x40,
-	0x10, 0xa5, 0x85, 0xaf, 0x7c, 0x0c, 0x11, 0xe3, 0x18, 0x49, 0x45, 0x0f, 0xcd, 0x1a, 0x93, 0x9e,
-	0x4a, 0x02, 0xc1, 0xc4, 0x8b, 0x09, 0xa1, 0x4a, 0x7a, 0xf0, 0xb2, 0xc6, 0x1f, 0x00, 0x32, 0x10,
-	0x12, 0xda, 0xc5, 0xb2, 0x25, 0xf2, 0xcb, 0xfc, 0x7b, 0xa6, 0xdb, 0x52, 0x57, 0x01, 0x0f, 0x1e,
-	0xbc, 0x75, 0xe7, 0xcd, 0x9b, 0x79, 0xf3, 0xfa, 0xe0, 0x28, 0x1c, 0x45, 0xa3, 0x19, 0xc5, 0xde,
-	0x32, 0x16, 0x52, 0x60, 0x6d, 0x1e, 0xad, 0x29, 0x92, 0x22, 0xde, 0xb4, 0x2e, 0x66, 0x42, 0xcc,
-	0x16, 0xd4, 0x56, 0xc0, 0x38, 0x99, 0xb6, 0x29, 0x5c, 0xca, 0x4d, 0xd6, 0xc7, 0x1c, 0x80, 0x60,
-	0x42, 0x91, 0x9c, 0x4f, 0xe7, 0x14, 0x23, 0x42, 0x65, 0xf8, 0x1c, 0xf8, 0xb6, 0xe1, 0x18, 0x6e,
-	0x8d, 0xab, 0x6f, 0xf6, 0x00, 0x95, 0x40, 0x52, 0x88, 0x0d, 0x30, 0x0b, 0xc4, 0x0c, 0x7c, 0x6c,
-	0x82, 0xd5, 0x0f, 0x45, 0x12, 0x49, 0xdb, 0x74, 0x0c, 0xb7, 0xc2, 0xf3, 0x57, 0x5a, 0x7f, 0x4a,
-	0xc6, 0x2b, 0x92, 0x76, 0xd9, 0x31, 0x5c, 0x83, 0xe7, 0x2f, 0xd6, 0x05, 0x08, 0xa2, 0x17, 0x11,
-	0x2e, 0x17, 0x24, 0x09, 0xaf, 0xe1, 0x5f, 0x3a, 0x75, 0x65, 0x1b, 0x4e, 0xd9, 0xad, 0x77, 0x8e,
-	0xbd, 0x42, 0xaf, 0x97, 0xd6, 0x79, 0x86, 0xb2, 0x37, 0xf8, 0x3f, 0xd8, 0x52, 0x7a, 0xba, 0x54,
-	0x25, 0xa4, 0xde, 0x39, 0xd3, 0x79, 0x05, 0xc8, 0xf5, 0x9b, 0x7a, 0xfa, 0x5e, 0xa5, 0xf5, 0x1b,
-	0xad, 0x00, 0xb9, 0xd6, 0xc8, 0x16, 0xd0, 0xe8, 0x4f, 0x26, 0x4a, 0x0b, 0xbd, 0x26, 0xb4, 0x92,
-	0xbf, 0xdd, 0x7f, 0x95, 0xf9, 0x97, 0x6f, 0xde, 0x39, 0x54, 0x81, 0x4c, 0xc0, 0x09, 0xa7, 0x50,
-	0xac, 0xe9, 0x8f, 0x16, 0x76, 0xde, 0x4d, 0xa8, 0x3e, 0x66, 0x89, 0xc1, 0x2e, 0x94, 0x87, 0x24,
-	0x71, 0xff, 0xe8, 0xd6, 0xa9, 0x56, 0xde, 0xfe, 0x0b, 0x56, 0xc2, 0x1b, 0xb0, 0x06, 0x31, 0x8d,
-	0x24, 0xe1, 0x7e, 0x33, 0x0f, 0xf1, 0xee, 0xa0, 0x9a, 0xfb, 0x8a, 0xe7, 0x5a, 0xc7, 0x57, 0xaf,
-	0x5b, 0x4d, 0x2f, 0x0b, 0xad, 0xb7, 0x0d, 0xad, 0x77, 0x9f, 0x86, 0x96, 0x95, 0xd0, 0x07, 0xf8,
-	0x74, 0x0a, 0x2f, 0xb5, 0x11, 0x3b, 0x06, 0xfe, 0x30, 0xe5, 0x16, 0x2c, 0x9f, 0x54, 0xaa, 0x0e,
-	0x5c, 0x7d, 0x90, 0x3a, 0xb6, 0x54, 0xa5, 0xfb, 0x11, 0x00, 0x00, 0xff, 0xff, 0x49, 0x2c, 0x55,
-	0x61, 0x71, 0x03, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xdb, 0x6e, 0xd3, 0x40,
+	0x10, 0xad, 0x9d, 0x4b, 0x9b, 0x09, 0x6d, 0x60, 0x29, 0xc5, 0x84, 0x3e, 0x44, 0x46, 0x85, 0x20,
+	0xa4, 0x54, 0x4a, 0x29, 0xe2, 0x22, 0x21, 0x4a, 0xd2, 0x56, 0x06, 0xa1, 0x4a, 0x4b, 0x80, 0x67,
+	0xb7, 0x9e, 0x06, 0x4b, 0xf1, 0xae, 0xb1, 0x37, 0xa5, 0x16, 0x3f, 0xc0, 0xc7, 0xf0, 0x1f, 0xfc,
+	0x16, 0xf2, 0xda, 0xeb, 0x6c, 0x93, 0xc6, 0x12, 0x3c, 0xf0, 0xb6, 0x73, 0x3f, 0x73, 0x66, 0x66,
+	0x61, 0x3d, 0x70, 0x99, 0x3b, 0xc6, 0xa8, 0x17, 0x46, 0x5c, 0x70, 0xd2, 0xf0, 0xd9, 0x05, 0x32,
+	0xc1, 0xa3, 0xa4, 0x7d, 0x7f, 0xcc, 0xf9, 0x78, 0x82, 0xbb, 0xd2, 0x70, 0x3a, 0x3d, 0xdf, 0xc5,
+	0x20, 0x14, 0x49, 0xe6, 0x67, 0xbf, 0x03, 0x70, 0x3c, 0x64, 0xc2, 0x3f, 0xf7, 0x31, 0x22, 0x04,
+	0xaa, 0xc7, 0x9f, 0x9c, 0xa1, 0x65, 0x74, 0x8c, 0x6e, 0x83, 0xca, 0x37, 0xe9, 0x42, 0xeb, 0xf0,
+	0x32, 0xc4, 0x33, 0x81, 0xde, 0x67, 0x8c, 0x62, 0x9f, 0x33, 0xcb, 0xec, 0x18, 0xdd, 0x2a, 0x9d,
+	0x57, 0xdb, 0x47, 0x50, 0x75, 0x04, 0x06, 0x64, 0x03, 0xcc, 0x22, 0x87, 0xe9, 0x0c, 0xc9, 0x16,
+	0xd4, 0x0f, 0x02, 0x3e, 0x65, 0x22, 0x0f, 0xcc, 0xa5, 0x54, 0xff, 0x71, 0x7a, 0x1a, 0xa3, 0xb0,
+	0x2a, 0x1d, 0xa3, 0x6b, 0xd0, 0x5c, 0xb2, 0x9f, 0x40, 0xed, 0xe4, 0x3b, 0xcb, 0xe0, 0x8c, 0x92,
+	0x10, 0x15, 0x9c, 0xf4, 0x9d, 0x27, 0x37, 0x55, 0x72, 0xfb, 0xb7, 0x01, 0xe0, 0xb0, 0x33, 0x1e,
+	0x84, 0x13, 0x14, 0x48, 0x76, 0xa0, 0x96, 0x62, 0x88, 0x2d, 0xa3, 0x53, 0xe9, 0x36, 0xfb, 0xad,
+	0x5e, 0xc1, 0x43, 0x2f, 0xd5, 0xd3, 0xcc, 0x4a, 0xda, 0xb0, 0x36, 0x70, 0x43, 0xf7, 0xcc, 0x17,
+	0x89, 0xcc, 0x65, 0xd0, 0x42, 0x4e, 0x61, 0x7d, 0x41, 0x7f, 0xfc, 0xb5, 0x80, 0x95, 0x49, 0xe4,
+	0x61, 0x0e, 0xcb, 0xaa, 0x76, 0x8c, 0x6e, 0xb3, 0x7f, 0x53, 0x4b, 0x2d, 0xf5, 0x34, 0x47, 0xbd,
+	0x0d, 0x8d, 0x01, 0x67, 0xc2, 0xf5, 0x53, 0xdf, 0x9a, 0x04, 0x3a, 0x53, 0x10, 0x0b, 0x56, 0x15,
+	0x8d, 0x75, 0xc9, 0x86, 0x12, 0xed, 0x4b, 0x58, 0x1b, 0xa8, 0x36, 0xf6, 0xf5, 0xb1, 0xc8, 0xfe,
+	0x9b, 0xfd, 0x3b, 0x7a, 0x2f, 0x85, 0x91, 0xea, 0xf3, 0xdb, 0xd7, 0xb9, 0xb0, 0xcc, 0xc5, 0xb0,
+	0xc2, 0x48, 0x35, 0x47, 0x7b, 0x08, 0x4d, 0x27, 0xf7, 0xf1, 0x31, 0x26, 0xfb, 0x57, 0xc4, 0x9c,
+	0xc9, 0xdb, 0x5a, 0x1a, 0x05, 0x93, 0xea, 0x7e, 0xf6, 0x04, 0x36, 0x0e, 0x3c, 0x4f, 0xb2, 0x8c,
+	0xdf, 0xa6, 0x18, 0x8b, 0x7f, 0xed, 0xe2, 0x41, 0xb6, 0x47, 0x39, 0xfe, 0x85, 0x11, 0x4a, 0xa3,
+	0xcd, 0xe1, 0x16, 0xc5, 0x80, 0x5f, 0xe0, 0xff, 0x2a, 0xf8, 0xd3, 0x80, 0xd6, 0x28, 0x72, 0x59,
+	0x7c, 0x8e, 0x91, 0xaa, 0xf7, 0x18, 0xaa, 0x47, 0x11, 0x0f, 0xca, 0x2b, 0x49, 0x17, 0xb2, 0x03,
+	0xe6, 0x88, 0x5b, 0x66, 0x99, 0xa3, 0x39, 0xe2, 0x05, 0x94, 0x4a, 0x19, 0x94, 0x1f, 0xd0, 0x38,
+	0x09, 0x31, 0x72, 0x85, 0xcf, 0x59, 0x7a, 0x24, 0xef, 0x7d, 0xe6, 0xa9, 0x23, 0x49, 0xdf, 0x73,
+	0x3c, 0x98, 0x7f, 0xcb, 0x43, 0x69, 0xf1, 0x21, 0xdc, 0x38, 0x08, 0xc3, 0x49, 0xa2, 0x38, 0x78,
+	0x0a, 0x50, 0x80, 0x51, 0xcb, 0xb2, 0xa9, 0xdf, 0x86, 0x32, 0x52, 0xcd, 0xcf, 0x7e, 0x04, 0xad,
+	0x23, 0xd7, 0x9f, 0xa0, 0x37, 0x6b, 0x64, 0x13, 0x6a, 0x0e, 0xf3, 0xf0, 0x52, 0x76, 0x52, 0xa1,
+	0x99, 0xd0, 0xff, 0x55, 0x85, 0xd5, 0x0f, 0xd9, 0xd7, 0x46, 0xf6, 0xa0, 0x72, 0x8c, 0x82, 0x5c,
+	0xdf, 0x49, 0xfb, 0xba, 0x0d, 0xb5, 0x57, 0xc8, 0x73, 0x80, 0x63, 0x14, 0x6f, 0x93, 0xec, 0x38,
+	0x17, 0xae, 0xb6, 0xbd, 0x75, 0xe5, 0x3e, 0x66, 0xeb, 0xbc, 0x42, 0x9e, 0x41, 0x7d, 0x10, 0xa1,
+	0x2b, 0x90, 0x5c, 0x7f, 0x43, 0xcb, 0x2a, 0xbe, 0x86, 0xd5, 0xfc, 0x10, 0xc8, 0x3d, 0xcd, 0xe3,
+	0xea, 0x71, 0xb4, 0xb7, 0x7a, 0xd9, 0xbf, 0xdc, 0x53, 0xff, 0x72, 0xef, 0x30, 0xfd, 0x97, 0xed,
+	0x15, 0x32, 0x04, 0x98, 0xad, 0x36, 0xd9, 0xd6, 0x52, 0x2c, 0x6c, 0x7c, 0x49, 0x96, 0x37, 0xb0,
+	0xa6, 0xd6, 0x95, 0xb4, 0xb5, 0x1c, 0x73, 0x3b, 0x5c, 0x92, 0xe1, 0x25, 0xd4, 0xe4, 0xa4, 0xc9,
+	0x5d, 0xbd, 0x0b, 0x6d, 0xf6, 0x25, 0xb1, 0x2f, 0xa0, 0x3e, 0xc4, 0x09, 0xce, 0x73, 0x37, 0x9b,
+	0xd6, 0xf2, 0xd0, 0x57, 0xb0, 0x9e, 0x85, 0x96, 0xcd, 0x6c, 0x49, 0xf0, 0x69, 0x5d, 0x6a, 0xf6,
+	0xfe, 0x0c, 0x00, 0x41, 0xe3, 0x14, 0x2b, 0x0f, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -348,10 +696,14 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ManagerClient interface {
 	Get(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Complete, error)
+	GetByOwner(ctx context.Context, in *Owner, opts ...grpc.CallOption) (*Inventories, error)
 	Create(ctx context.Context, in *Incomplete, opts ...grpc.CallOption) (*Complete, error)
 	AddItem(ctx context.Context, in *AddItemRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	RemoveItem(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	Delete(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteByOwner(ctx context.Context, in *Owner, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type managerClient struct {
@@ -371,6 +723,15 @@ func (c *managerClient) Get(ctx context.Context, in *Identifier, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *managerClient) GetByOwner(ctx context.Context, in *Owner, opts ...grpc.CallOption) (*Inventories, error) {
+	out := new(Inventories)
+	err := c.cc.Invoke(ctx, "/inventory.Manager/GetByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managerClient) Create(ctx context.Context, in *Incomplete, opts ...grpc.CallOption) (*Complete, error) {
 	out := new(Complete)
 	err := c.cc.Invoke(ctx, "/inventory.Manager/Create", in, out, opts...)
@@ -398,6 +759,24 @@ func (c *managerClient) RemoveItem(ctx context.Context, in *RemoveItemRequest, o
 	return out, nil
 }
 
+func (c *managerClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/inventory.Manager/Transfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/inventory.Manager/Apply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managerClient) Delete(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/inventory.Manager/Delete", in, out, opts...)
@@ -407,13 +786,26 @@ func (c *managerClient) Delete(ctx context.Context, in *Identifier, opts ...grpc
 	return out, nil
 }
 
+func (c *managerClient) DeleteByOwner(ctx context.Context, in *Owner, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/inventory.Manager/DeleteByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ManagerServer is the server API for Manager service.
 type ManagerServer interface {
 	Get(context.Context, *Identifier) (*Complete, error)
+	GetByOwner(context.Context, *Owner) (*Inventories, error)
 	Create(context.Context, *Incomplete) (*Complete, error)
 	AddItem(context.Context, *AddItemRequest) (*empty.Empty, error)
 	RemoveItem(context.Context, *RemoveItemRequest) (*empty.Empty, error)
+	Transfer(context.Context, *TransferRequest) (*empty.Empty, error)
+	Apply(context.Context, *ApplyRequest) (*empty.Empty, error)
 	Delete(context.Context, *Identifier) (*empty.Empty, error)
+	DeleteByOwner(context.Context, *Owner) (*empty.Empty, error)
 }
 
 func RegisterManagerServer(s *grpc.Server, srv ManagerServer) {
@@ -438,6 +830,24 @@ func _Manager_Get_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Manager_GetByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Owner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inventory.Manager/GetByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetByOwner(ctx, req.(*Owner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Manager_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Incomplete)
 	if err := dec(in); err != nil {
@@ -492,6 +902,42 @@ func _Manager_RemoveItem_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Manager_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inventory.Manager/Transfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Apply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inventory.Manager/Apply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Apply(ctx, req.(*ApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Manager_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Identifier)
 	if err := dec(in); err != nil {
@@ -510,6 +956,24 @@ func _Manager_Delete_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Manager_DeleteByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Owner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).DeleteByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inventory.Manager/DeleteByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).DeleteByOwner(ctx, req.(*Owner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Manager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inventory.Manager",
 	HandlerType: (*ManagerServer)(nil),
@@ -518,6 +982,10 @@ var _Manager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _Manager_Get_Handler,
 		},
+		{
+			MethodName: "GetByOwner",
+			Handler:    _Manager_GetByOwner_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _Manager_Create_Handler,
@@ -530,10 +998,22 @@ var _Manager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveItem",
 			Handler:    _Manager_RemoveItem_Handler,
 		},
+		{
+			MethodName: "Transfer",
+			Handler:    _Manager_Transfer_Handler,
+		},
+		{
+			MethodName: "Apply",
+			Handler:    _Manager_Apply_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _Manager_Delete_Handler,
 		},
+		{
+			MethodName: "DeleteByOwner",
+			Handler:    _Manager_DeleteByOwner_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "manager.proto",
@@ -4,11 +4,26 @@ package inventory
 
 import "context"
 
-// Repository to store inventory information
+// Repository to store inventory information.
+// Every change of an inventory increases its version. Changes of inventories identified
+// by a VersionedIdentifier fail with ErrVersionMismatch if they are in another version.
 type Repository interface {
 	Get(context.Context, Identifier) (Complete, error)
-	Create(context.Context) (Complete, error)
+	// GetByOwner returns the inventories of an owner ordered by their creation
+	GetByOwner(context.Context, *Owner) ([]Complete, error)
+	// Create an inventory in version 1 with the items, capacity, owner and
+	// container of the incomplete inventory
+	Create(context.Context, Incomplete) (Complete, error)
 	AddItem(context.Context, Identifier, *Item) error
 	RemoveItem(context.Context, Identifier, *Item) error
+	// Transfer an item from the first to the second inventory atomically.
+	// Returns sql.ErrNoRows if an inventory is unknown or the first one lacks the item.
+	Transfer(ctx context.Context, from, to Identifier, item *Item) error
+	// Apply the operations in their order atomically, the version of every changed inventory
+	// is increased once. Returns an *OperationError wrapping sql.ErrNoRows if an inventory
+	// is unknown or an item can not be removed, or wrapping ErrVersionMismatch.
+	Apply(context.Context, []*Operation) error
 	Delete(context.Context, Identifier) error
+	// DeleteByOwner deletes the inventories of an owner including their items
+	DeleteByOwner(context.Context, *Owner) error
 }
@@ -0,0 +1,704 @@
+// Package repositorytest contains the conformance tests of inventory repositories
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/inventory"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) inventory.Repository) {
+	t.Run("Create", func(t *testing.T) {
+		testCreate(t, newRepository(t))
+	})
+	t.Run("Items", func(t *testing.T) {
+		testItems(t, newRepository(t))
+	})
+	t.Run("Delete", func(t *testing.T) {
+		testDelete(t, newRepository(t))
+	})
+	t.Run("GetByOwner", func(t *testing.T) {
+		testGetByOwner(t, newRepository(t))
+	})
+	t.Run("DeleteByOwner", func(t *testing.T) {
+		testDeleteByOwner(t, newRepository(t))
+	})
+	t.Run("ConcurrentAddItem", func(t *testing.T) {
+		testConcurrentAddItem(t, newRepository(t))
+	})
+	t.Run("Transfer", func(t *testing.T) {
+		testTransfer(t, newRepository(t))
+	})
+	t.Run("ConcurrentTransfer", func(t *testing.T) {
+		testConcurrentTransfer(t, newRepository(t))
+	})
+	t.Run("Apply", func(t *testing.T) {
+		testApply(t, newRepository(t))
+	})
+	t.Run("Version", func(t *testing.T) {
+		testVersion(t, newRepository(t))
+	})
+	t.Run("ApplyVersion", func(t *testing.T) {
+		testApplyVersion(t, newRepository(t))
+	})
+}
+
+type identifier struct {
+	guid string
+}
+
+func (i *identifier) GUID() string {
+	return i.guid
+}
+
+func randomIdentifier(t *testing.T) inventory.Identifier {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return &identifier{rand.String()}
+}
+
+func amountOf(c inventory.Complete, id string, subset float64) uint64 {
+	for _, v := range c.Data().Items {
+		if v.ID == id && v.Subset == subset {
+			return v.Amount
+		}
+	}
+
+	return 0
+}
+
+func testCreate(t *testing.T, r inventory.Repository) {
+	ctx := context.Background()
+
+	if _, err := r.Get(ctx, randomIdentifier(t)); err != sql.ErrNoRows {
+		t.Fatal("an unknown inventory should not be found")
+	}
+
+	c, err := r.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.GUID() == "" {
+		t.Fatal("the created inventory should have an id")
+	}
+
+	got, err := r.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if got.GUID() != c.GUID() || got.Data().Items == nil || len(got.Data().Items) != 0 {
+		t.Fatal("a created inventory should be empty")
+	}
+
+	if got.Data().Capacity != 0 || got.Data().Owner != nil || got.Data().Container != "" {
+		t.Fatal("an inventory created without a capacity, owner and container should have none")
+	}
+
+	inc := inventory.NewIncomplete(nil)
+	inc.Data().Capacity = 12.5
+	inc.Data().Owner = inventory.NewOwner(inventory.OwnerVehicle, "42")
+	inc.Data().Container = "trunk"
+
+	c, err = r.Create(ctx, inc)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Data().Capacity != 12.5 || *c.Data().Owner != *inc.Data().Owner || c.Data().Container != "trunk" {
+		t.Fatal("the created inventory should have the capacity, owner and container")
+	}
+
+	got, err = r.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if got.Data().Capacity != 12.5 {
+		t.Fatal("the capacity should be stored")
+	}
+
+	if got.Data().Owner == nil || *got.Data().Owner != *inc.Data().Owner || got.Data().Container != "trunk" {
+		t.Fatal("the owner and the container should be stored")
+	}
+
+	c, err = r.Create(ctx, inventory.NewIncomplete([]*inventory.Item{
+		{ID: "apple", Amount: 2, Subset: -1},
+		{ID: "pear", Amount: 1, Subset: -1},
+	}))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	got, err = r.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(got.Data().Items) != 2 || got.Data().Version != 1 || c.Data().Version != 1 {
+		t.Fatal("the items should be stored in the first version")
+	}
+}
+
+// createOwned creates an inventory of an owner
+func createOwned(t *testing.T, r inventory.Repository, owner *inventory.Owner) inventory.Complete {
+	inc := inventory.NewIncomplete(nil)
+	inc.Data().Owner = owner
+
+	c, err := r.Create(context.Background(), inc)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return c
+}
+
+func testGetByOwner(t *testing.T, r inventory.Repository) {
+	ctx := context.Background()
+
+	owner := inventory.NewOwner(inventory.OwnerProperty, randomIdentifier(t).GUID())
+
+	inventories, err := r.GetByOwner(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(inventories) != 0 {
+		t.Fatal("an owner without inventories should have none")
+	}
+
+	first := createOwned(t, r, owner)
+	createOwned(t, r, inventory.NewOwner(inventory.OwnerVehicle, owner.ID))
+	if _, err := r.Create(ctx, inventory.NewIncomplete(nil)); err != nil {
+		t.Fatal(err.Error())
+	}
+	second := createOwned(t, r, owner)
+
+	if err := r.AddItem(ctx, second, &inventory.Item{ID: "apple", Amount: 2, Subset: 1}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	inventories, err = r.GetByOwner(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(inventories) != 2 || inventories[0].GUID() != first.GUID() || inventories[1].GUID() != second.GUID() {
+		t.Fatal("the inventories of the owner should be ordered by their creation")
+	}
+
+	if amountOf(inventories[1], "apple", 1) != 2 || *inventories[1].Data().Owner != *owner {
+		t.Fatal("the inventories should be returned including their items and owner")
+	}
+}
+
+func testDeleteByOwner(t *testing.T, r inventory.Repository) {
+	ctx := context.Background()
+
+	owner := inventory.NewOwner(inventory.OwnerVehicle, randomIdentifier(t).GUID())
+	other := inventory.NewOwner(inventory.OwnerVehicle, randomIdentifier(t).GUID())
+
+	if err := r.DeleteByOwner(ctx, owner); err != nil {
+		t.Fatal("deleting the inventories of an owner without any should not fail")
+	}
+
+	trunk := createOwned(t, r, owner)
+	glovebox := createOwned(t, r, owner)
+	kept := createOwned(t, r, other)
+
+	if err := r.AddItem(ctx, trunk, &inventory.Item{ID: "apple", Amount: 1, Subset: 1}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if err := r.DeleteByOwner(ctx, owner); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	for _, v := range []inventory.Identifier{trunk, glovebox} {
+		if _, err := r.Get(ctx, v); err != sql.ErrNoRows {
+			t.Fatal("the inventories of the owner should be deleted")
+		}
+	}
+
+	if _, err := r.Get(ctx, kept); err != nil {
+		t.Fatal("the inventories of other owners should be kept")
+	}
+
+	inventories, err := r.GetByOwner(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(inventories) != 0 {
+		t.Fatal("a deleted inventory should not be listed")
+	}
+}
+
+func testItems(t *testing.T, r inventory.Repository) {
+	ctx := context.Background()
+
+	if err := r.AddItem(ctx, randomIdentifier(t), &inventory.Item{ID: "apple", Amount: 1, Subset: 1}); err == nil {
+		t.Fatal("items can not be added to an unknown inventory")
+	}
+
+	c, err := r.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	for _, v := range []*inventory.Item{
+		{ID: "apple", Amount: 2, Subset: 1},
+		{ID: "apple", Amount: 3, Subset: 1},
+		{ID: "apple", Amount: 1, Subset: 0.5},
+		{ID: "pear", Amount: 4, Subset: 1},
+	} {
+		if err := r.AddItem(ctx, c, v); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	got, err := r.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(got.Data().Items) != 3 ||
+		amountOf(got, "apple", 1) != 5 ||
+		amountOf(got, "apple", 0.5) != 1 ||
+		amountOf(got, "pear", 1) != 4 {
+		t.Fatal("the amounts of items with the same id and subset should be added up")
+	}
+
+	if err := r.RemoveItem(ctx, c, &inventory.Item{ID: "apple", Amount: 6, Subset: 1}); err != sql.ErrNoRows {
+		t.Fatal("more items than available can not be removed")
+	}
+
+	if err := r.RemoveItem(ctx, c, &inventory.Item{ID: "cherry", Amount: 1, Subset: 1}); err != sql.ErrNoRows {
+		t.Fatal("unknown items can not be removed")
+	}
+
+	if err := r.RemoveItem(ctx, c, &inventory.Item{ID: "apple", Amount: 2, Subset: 1}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.RemoveItem(ctx, c, &inventory.Item{ID: "pear", Amount: 4, Subset: 1}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	got, err = r.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(got.Data().Items) != 2 || amountOf(got, "apple", 1) != 3 || amountOf(got, "apple", 0.5) != 1 {
+		t.Fatal("the items should be removed and items without amount should be hidden")
+	}
+
+	other, err := r.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if got, err := r.Get(ctx, other); err != nil || len(got.Data().Items) != 0 {
+		t.Fatal("the items of an inventory should not be part of other inventories")
+	}
+}
+
+func testDelete(t *testing.T, r inventory.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.AddItem(ctx, c, &inventory.Item{ID: "apple", Amount: 1, Subset: 1}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Delete(ctx, c); err != nil {
+		t.Fatal("an inventory containing items should be deleted")
+	}
+
+	if _, err := r.Get(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("the inventory should be deleted")
+	}
+
+	if err := r.Delete(ctx, randomIdentifier(t)); err != nil {
+		t.Fatal("deleting an unknown inventory should not return an error")
+	}
+}
+
+func testConcurrentAddItem(t *testing.T, r inventory.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- r.AddItem(ctx, c, &inventory.Item{ID: "apple", Amount: 1, Subset: 1})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	got, err := r.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if amountOf(got, "apple", 1) != 10 {
+		t.Fatal("no concurrently added item should be lost")
+	}
+}
+
+func testTransfer(t *testing.T, r inventory.Repository) {
+	ctx := context.Background()
+
+	from, err := r.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	to, err := r.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.AddItem(ctx, from, &inventory.Item{ID: "apple", Amount: 5, Subset: 1}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Transfer(ctx, from, to, &inventory.Item{ID: "apple", Amount: 6, Subset: 1}); err != sql.ErrNoRows {
+		t.Fatal("more items than available can not be transferred")
+	}
+
+	if err := r.Transfer(ctx, from, to, &inventory.Item{ID: "apple", Amount: 1, Subset: 0.5}); err != sql.ErrNoRows {
+		t.Fatal("unknown items can not be transferred")
+	}
+
+	if err := r.Transfer(ctx, from, randomIdentifier(t), &inventory.Item{ID: "apple", Amount: 1, Subset: 1}); err != sql.ErrNoRows {
+		t.Fatal("items can not be transferred to an unknown inventory")
+	}
+
+	if err := r.Transfer(ctx, from, to, &inventory.Item{ID: "apple", Amount: 2, Subset: 1}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Transfer(ctx, from, to, &inventory.Item{ID: "apple", Amount: 1, Subset: 1}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	gotFrom, err := r.Get(ctx, from)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	gotTo, err := r.Get(ctx, to)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if amountOf(gotFrom, "apple", 1) != 2 || amountOf(gotTo, "apple", 1) != 3 {
+		t.Fatal("the items should be moved and failed transfers should not change any inventory")
+	}
+}
+
+func testConcurrentTransfer(t *testing.T, r inventory.Repository) {
+	ctx := context.Background()
+
+	inventories := make([]inventory.Complete, 2)
+	for i := range inventories {
+		c, err := r.Create(ctx, inventory.NewIncomplete(nil))
+		if err != nil {
+			t.Fatal("there should be no error")
+		}
+
+		if err := r.AddItem(ctx, c, &inventory.Item{ID: "apple", Amount: 10, Subset: 1}); err != nil {
+			t.Fatal("there should be no error")
+		}
+
+		inventories[i] = c
+	}
+
+	// every inventory gives away at most as many items as it contains initially
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(from, to inventory.Identifier) {
+			defer wg.Done()
+			errs <- r.Transfer(ctx, from, to, &inventory.Item{ID: "apple", Amount: 1, Subset: 1})
+		}(inventories[i%2], inventories[(i+1)%2])
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	var total uint64
+	for _, v := range inventories {
+		got, err := r.Get(ctx, v)
+		if err != nil {
+			t.Fatal("there should be no error")
+		}
+
+		if amountOf(got, "apple", 1) != 10 {
+			t.Fatal("every inventory should receive as many items as it gave away")
+		}
+
+		total += amountOf(got, "apple", 1)
+	}
+
+	if total != 20 {
+		t.Fatal("the total amount of items should be conserved")
+	}
+}
+
+func testApply(t *testing.T, r inventory.Repository) {
+	ctx := context.Background()
+
+	player, err := r.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	trunk, err := r.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.AddItem(ctx, player, &inventory.Item{ID: "iron", Amount: 3, Subset: -1}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.AddItem(ctx, player, &inventory.Item{ID: "wood", Amount: 1, Subset: -1}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	craft := []*inventory.Operation{
+		{Kind: inventory.OperationRemove, InventoryGUID: player.GUID(), Item: &inventory.Item{ID: "iron", Amount: 3, Subset: -1}},
+		{Kind: inventory.OperationRemove, InventoryGUID: player.GUID(), Item: &inventory.Item{ID: "wood", Amount: 1, Subset: -1}},
+		{Kind: inventory.OperationAdd, InventoryGUID: player.GUID(), Item: &inventory.Item{ID: "pickaxe", Amount: 1, Subset: -1}},
+		{Kind: inventory.OperationAdd, InventoryGUID: trunk.GUID(), Item: &inventory.Item{ID: "iron", Amount: 1, Subset: -1}},
+	}
+	if err := r.Apply(ctx, craft); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	gotPlayer, err := r.Get(ctx, player)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	gotTrunk, err := r.Get(ctx, trunk)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(gotPlayer.Data().Items) != 1 || amountOf(gotPlayer, "pickaxe", -1) != 1 || amountOf(gotTrunk, "iron", -1) != 1 {
+		t.Fatal("all operations should be applied")
+	}
+
+	// the removal of the trunk fails after the pickaxe was added to the player
+	err = r.Apply(ctx, []*inventory.Operation{
+		{Kind: inventory.OperationAdd, InventoryGUID: player.GUID(), Item: &inventory.Item{ID: "pickaxe", Amount: 1, Subset: -1}},
+		{Kind: inventory.OperationRemove, InventoryGUID: trunk.GUID(), Item: &inventory.Item{ID: "iron", Amount: 1, Subset: -1}},
+		{Kind: inventory.OperationRemove, InventoryGUID: trunk.GUID(), Item: &inventory.Item{ID: "iron", Amount: 1, Subset: -1}},
+	})
+	if e, ok := err.(*inventory.OperationError); !ok || e.Index != 2 || e.Err != sql.ErrNoRows {
+		t.Fatal("the failed removal should be named")
+	}
+
+	err = r.Apply(ctx, []*inventory.Operation{
+		{Kind: inventory.OperationAdd, InventoryGUID: player.GUID(), Item: &inventory.Item{ID: "pickaxe", Amount: 1, Subset: -1}},
+		{Kind: inventory.OperationAdd, InventoryGUID: randomIdentifier(t).GUID(), Item: &inventory.Item{ID: "pickaxe", Amount: 1, Subset: -1}},
+	})
+	if e, ok := err.(*inventory.OperationError); !ok || e.Index != 1 || e.Err != sql.ErrNoRows {
+		t.Fatal("the operation on the unknown inventory should be named")
+	}
+
+	gotPlayer, err = r.Get(ctx, player)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	gotTrunk, err = r.Get(ctx, trunk)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if amountOf(gotPlayer, "pickaxe", -1) != 1 || amountOf(gotTrunk, "iron", -1) != 1 {
+		t.Fatal("no operation of a failed batch should be applied")
+	}
+}
+
+// versionOf an inventory
+func versionOf(t *testing.T, r inventory.Repository, id inventory.Identifier) uint64 {
+	c, err := r.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return c.Data().Version
+}
+
+func testVersion(t *testing.T, r inventory.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Data().Version != 1 || versionOf(t, r, c) != 1 {
+		t.Fatal("a created inventory should be in version 1")
+	}
+
+	other, err := r.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	apple := &inventory.Item{ID: "apple", Amount: 2, Subset: 1}
+
+	if err := r.AddItem(ctx, inventory.NewVersionedIdentifier(c.GUID(), 1), apple); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if versionOf(t, r, c) != 2 {
+		t.Fatal("adding an item should increase the version")
+	}
+
+	if err := r.AddItem(ctx, inventory.NewVersionedIdentifier(c.GUID(), 1), apple); err != inventory.ErrVersionMismatch {
+		t.Fatal("an item should not be added to an inventory in another version")
+	}
+
+	if err := r.RemoveItem(ctx, inventory.NewVersionedIdentifier(c.GUID(), 1), apple); err != inventory.ErrVersionMismatch {
+		t.Fatal("an item should not be removed from an inventory in another version")
+	}
+
+	if versionOf(t, r, c) != 2 {
+		t.Fatal("failed changes should not increase the version")
+	}
+
+	if err := r.RemoveItem(ctx, c, &inventory.Item{ID: "apple", Amount: 3, Subset: 1}); err != sql.ErrNoRows {
+		t.Fatal("the inventory lacks the amount of the item")
+	}
+
+	if versionOf(t, r, c) != 2 {
+		t.Fatal("failed changes should not increase the version")
+	}
+
+	if err := r.RemoveItem(ctx, inventory.NewVersionedIdentifier(c.GUID(), 2), &inventory.Item{ID: "apple", Amount: 1, Subset: 1}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Transfer(ctx, c, inventory.NewVersionedIdentifier(other.GUID(), 2), &inventory.Item{ID: "apple", Amount: 1, Subset: 1}); err != inventory.ErrVersionMismatch {
+		t.Fatal("an item should not be transferred to an inventory in another version")
+	}
+
+	if amountOf(getInventory(t, r, c), "apple", 1) != 1 {
+		t.Fatal("a failed transfer should not remove the item")
+	}
+
+	if err := r.Transfer(ctx, inventory.NewVersionedIdentifier(c.GUID(), 3), inventory.NewVersionedIdentifier(other.GUID(), 1), &inventory.Item{ID: "apple", Amount: 1, Subset: 1}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if versionOf(t, r, c) != 4 || versionOf(t, r, other) != 2 {
+		t.Fatal("a transfer should increase the versions of both inventories")
+	}
+
+	if err := r.Delete(ctx, inventory.NewVersionedIdentifier(c.GUID(), 3)); err != inventory.ErrVersionMismatch {
+		t.Fatal("an inventory in another version should not be deleted")
+	}
+
+	if err := r.Delete(ctx, inventory.NewVersionedIdentifier(c.GUID(), 4)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Get(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("the inventory should be deleted")
+	}
+}
+
+func getInventory(t *testing.T, r inventory.Repository, id inventory.Identifier) inventory.Complete {
+	c, err := r.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return c
+}
+
+func testApplyVersion(t *testing.T, r inventory.Repository) {
+	ctx := context.Background()
+
+	player, err := r.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	trunk, err := r.Create(ctx, inventory.NewIncomplete(nil))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	apple := &inventory.Item{ID: "apple", Amount: 1, Subset: 1}
+
+	err = r.Apply(ctx, []*inventory.Operation{
+		{Kind: inventory.OperationAdd, InventoryGUID: player.GUID(), Item: apple, Version: 1},
+		{Kind: inventory.OperationAdd, InventoryGUID: trunk.GUID(), Item: apple},
+		{Kind: inventory.OperationAdd, InventoryGUID: player.GUID(), Item: apple, Version: 1},
+	})
+	if err != nil {
+		t.Fatal("the versions should be compared to the ones before the batch")
+	}
+
+	if versionOf(t, r, player) != 2 || versionOf(t, r, trunk) != 2 {
+		t.Fatal("a batch should increase the version of every changed inventory once")
+	}
+
+	err = r.Apply(ctx, []*inventory.Operation{
+		{Kind: inventory.OperationRemove, InventoryGUID: player.GUID(), Item: apple},
+		{Kind: inventory.OperationAdd, InventoryGUID: trunk.GUID(), Item: apple, Version: 1},
+	})
+
+	e, ok := err.(*inventory.OperationError)
+	if !ok || e.Index != 1 || e.Err != inventory.ErrVersionMismatch {
+		t.Fatal("the operation on the inventory in another version should fail")
+	}
+
+	if amountOf(getInventory(t, r, player), "apple", 1) != 2 || versionOf(t, r, player) != 2 {
+		t.Fatal("a failed batch should not change any inventory")
+	}
+}
@@ -0,0 +1,27 @@
+package inventory
+
+import "github.com/51st-state/api/pkg/rbac"
+
+// rules enforced by the inventory service
+const (
+	ruleGet          rbac.Rule = "inventory.get"
+	ruleGetByOwner   rbac.Rule = "inventory.get.owner"
+	ruleCreate       rbac.Rule = "inventory.create"
+	ruleItemAdd      rbac.Rule = "inventory.item.add"
+	ruleItemRemove   rbac.Rule = "inventory.item.remove"
+	ruleItemTransfer rbac.Rule = "inventory.item.transfer"
+	ruleItemBatch    rbac.Rule = "inventory.item.batch"
+	ruleDelete       rbac.Rule = "inventory.delete"
+)
+
+// Rules enforced by the inventory service
+var Rules = rbac.RuleCatalog{
+	{Rule: ruleGet, Description: "Get an inventory", Service: "inventory"},
+	{Rule: ruleGetByOwner, Description: "List the inventories of an owner", Service: "inventory"},
+	{Rule: ruleCreate, Description: "Create an inventory", Service: "inventory"},
+	{Rule: ruleItemAdd, Description: "Add an item to an inventory", Service: "inventory"},
+	{Rule: ruleItemRemove, Description: "Remove an item from an inventory", Service: "inventory"},
+	{Rule: ruleItemTransfer, Description: "Transfer an item between inventories", Service: "inventory"},
+	{Rule: ruleItemBatch, Description: "Add and remove items of inventories in a batch", Service: "inventory"},
+	{Rule: ruleDelete, Description: "Delete an inventory", Service: "inventory"},
+}
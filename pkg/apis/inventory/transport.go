@@ -3,11 +3,15 @@ package inventory
 import (
 	"context"
 	"crypto/rsa"
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/51st-state/api/pkg/api/endpoint"
 	"github.com/51st-state/api/pkg/encode"
+	"github.com/51st-state/api/pkg/problems"
 	"github.com/51st-state/api/pkg/rbac"
 	rbacMiddleware "github.com/51st-state/api/pkg/rbac/middleware"
 	"github.com/51st-state/api/pkg/token"
@@ -15,13 +19,68 @@ import (
 	"go.uber.org/zap"
 )
 
-// MakeGetEndpoint creates a http endpoint to retrieve an inventory object
+type etagEncoder struct {
+	encode.Encoder
+}
+
+// NewETagEncoder encodes everything as json and sets the version
+// of encoded inventories as ETag, see MakeGetEndpoint
+func NewETagEncoder() encode.Encoder {
+	return &etagEncoder{
+		encode.NewJSONEncoder(),
+	}
+}
+
+func (e *etagEncoder) Encode(w http.ResponseWriter, v interface{}) error {
+	if c, ok := v.(Complete); ok {
+		w.Header().Set("ETag", strconv.Quote(strconv.FormatUint(c.Data().Version, 10)))
+	}
+
+	return e.Encoder.Encode(w, v)
+}
+
+// requestIdentifier identifies the inventory of the url. It is expected to be
+// in the version of the If-Match header, an ETag returned by MakeGetEndpoint.
+func requestIdentifier(r *http.Request) (Identifier, error) {
+	guid := chi.URLParam(r, "guid")
+
+	match := r.Header.Get("If-Match")
+	if match == "" || match == "*" {
+		return &identifier{guid}, nil
+	}
+
+	// weak and unknown tags never match
+	unquoted, err := strconv.Unquote(match)
+	if err != nil || match[0] != '"' {
+		return nil, ErrVersionMismatch
+	}
+
+	version, err := strconv.ParseUint(unquoted, 10, 64)
+	if err != nil || version == 0 {
+		return nil, ErrVersionMismatch
+	}
+
+	return NewVersionedIdentifier(guid, version), nil
+}
+
+// MakeGetEndpoint creates a http endpoint to retrieve an inventory object.
+// Its version is set as ETag if the encoder is created by NewETagEncoder.
 func MakeGetEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
 	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
 		return m.Get(ctx, &identifier{chi.URLParam(r, "guid")})
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("inventory.get"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleGet)).
+		HandlerFunc(l)
+}
+
+// MakeGetByOwnerEndpoint creates a http endpoint to list the inventory objects of an owner
+func MakeGetByOwnerEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetByOwner(ctx, NewOwner(chi.URLParam(r, "type"), chi.URLParam(r, "id")))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleGetByOwner)).
 		HandlerFunc(l)
 }
 
@@ -37,14 +96,18 @@ func MakeCreateEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Cont
 		return m.Create(ctx, inc)
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("inventory.create"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleCreate)).
 		HandlerFunc(l)
 }
 
-// MakeAddItemEndpoint creates a http endpoint to add an item to an inventory object
+// MakeAddItemEndpoint creates a http endpoint to add an item to an inventory object.
+// It fails with a version mismatch if the inventory is not in the version of the If-Match header.
 func MakeAddItemEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
 	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
-		id := &identifier{chi.URLParam(r, "guid")}
+		id, err := requestIdentifier(r)
+		if err != nil {
+			return nil, err
+		}
 
 		var item Item
 		if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
@@ -54,14 +117,18 @@ func MakeAddItemEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Con
 		return struct{}{}, m.AddItem(ctx, id, &item)
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("inventory.item.add"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleItemAdd)).
 		HandlerFunc(l)
 }
 
-// MakeRemoveItemEndpoint creates a http endpoint to remove an item from an inventory object
+// MakeRemoveItemEndpoint creates a http endpoint to remove an item from an inventory object.
+// It fails with a version mismatch if the inventory is not in the version of the If-Match header.
 func MakeRemoveItemEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
 	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
-		id := &identifier{chi.URLParam(r, "guid")}
+		id, err := requestIdentifier(r)
+		if err != nil {
+			return nil, err
+		}
 
 		var item Item
 		if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
@@ -71,16 +138,92 @@ func MakeRemoveItemEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.
 		return struct{}{}, m.RemoveItem(ctx, id, &item)
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("inventory.item.remove"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleItemRemove)).
 		HandlerFunc(l)
 }
 
-// MakeDeleteEndpoint creates a http endpoint to delete an inventory object
+type transferRequest struct {
+	To   string `json:"to"`
+	Item *Item  `json:"item"`
+}
+
+// MakeTransferEndpoint creates a http endpoint to transfer an item to another inventory object.
+// It fails with a version mismatch if the source inventory is not in the version of the If-Match header.
+func MakeTransferEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := requestIdentifier(r)
+		if err != nil {
+			return nil, err
+		}
+
+		var req transferRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, err
+		}
+
+		if req.Item == nil {
+			return nil, errInvalidItemID
+		}
+
+		return struct{}{}, m.Transfer(ctx, id, &identifier{req.To}, req.Item)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleItemTransfer)).
+		HandlerFunc(l)
+}
+
+type applyRequest struct {
+	Operations []*Operation `json:"operations"`
+}
+
+// operationProblem describes a failed operation of a batch, the instance points to the operation in the request
+func operationProblem(err error) error {
+	e, ok := err.(*OperationError)
+	if !ok {
+		return err
+	}
+
+	p, ok := e.Err.(*problems.Problem)
+	switch {
+	case ok:
+	case e.Err == sql.ErrNoRows:
+		p = problems.New("operation failed", "the inventory is unknown or lacks the item to remove", http.StatusConflict)
+	default:
+		p = problems.New("invalid operation", e.Err.Error(), http.StatusBadRequest)
+	}
+
+	return p.
+		SetDetail(fmt.Sprintf("operation %d failed: %s", e.Index, p.Detail)).
+		SetInstance(fmt.Sprintf("#/operations/%d", e.Index))
+}
+
+// MakeApplyEndpoint creates a http endpoint to apply a batch of operations to inventory objects
+func MakeApplyEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		var req applyRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			return nil, err
+		}
+
+		return struct{}{}, operationProblem(m.Apply(ctx, req.Operations))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleItemBatch)).
+		HandlerFunc(l)
+}
+
+// MakeDeleteEndpoint creates a http endpoint to delete an inventory object.
+// It fails with a version mismatch if the inventory is not in the version of the If-Match header.
 func MakeDeleteEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
 	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
-		return struct{}{}, m.Delete(ctx, &identifier{chi.URLParam(r, "guid")})
+		id, err := requestIdentifier(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return struct{}{}, m.Delete(ctx, id)
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("inventory.delete"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleDelete)).
 		HandlerFunc(l)
 }
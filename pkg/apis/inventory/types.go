@@ -2,6 +2,13 @@ package inventory
 
 //go:generate counterfeiter -o ./mocks/identifier.go . Identifier
 
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/51st-state/api/pkg/problems"
+)
+
 // Identifier of an inventory
 type Identifier interface {
 	GUID() string
@@ -11,10 +18,53 @@ type identifier struct {
 	guid string
 }
 
+// NewIdentifier creates a new identifier object
+func NewIdentifier(guid string) Identifier {
+	return &identifier{guid}
+}
+
 func (i *identifier) GUID() string {
 	return i.guid
 }
 
+// VersionedIdentifier of an inventory which is expected to be in a version.
+// Changes of the inventory fail with ErrVersionMismatch if it is in another version.
+type VersionedIdentifier interface {
+	Identifier
+	Version() uint64
+}
+
+type versionedIdentifier struct {
+	guid    string
+	version uint64
+}
+
+// NewVersionedIdentifier creates a new identifier object expecting the inventory
+// to be in the version, zero means any version
+func NewVersionedIdentifier(guid string, version uint64) VersionedIdentifier {
+	return &versionedIdentifier{guid, version}
+}
+
+func (i *versionedIdentifier) GUID() string {
+	return i.guid
+}
+
+func (i *versionedIdentifier) Version() uint64 {
+	return i.version
+}
+
+// ExpectedVersion of the inventory an identifier identifies, zero if any version is expected
+func ExpectedVersion(id Identifier) uint64 {
+	if v, ok := id.(VersionedIdentifier); ok {
+		return v.Version()
+	}
+
+	return 0
+}
+
+// ErrVersionMismatch is returned if an inventory is changed which is not in the expected version
+var ErrVersionMismatch = problems.New("version mismatch", "the inventory has been changed in the meantime", http.StatusPreconditionFailed)
+
 // Provider of inventory information
 type Provider interface {
 	Data() *data
@@ -43,15 +93,80 @@ type Item struct {
 	Subset float64 `json:"subset"`
 }
 
+// Types of owners
+const (
+	OwnerCharacter = "character"
+	OwnerVehicle   = "vehicle"
+	OwnerProperty  = "property"
+)
+
+// Owner of an inventory, e.g. the vehicle a trunk belongs to
+type Owner struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// NewOwner creates a new owner object
+func NewOwner(typ, id string) *Owner {
+	return &Owner{typ, id}
+}
+
+// String returns the owner in the format type/id, e.g. vehicle/42
+func (o *Owner) String() string {
+	return o.Type + "/" + o.ID
+}
+
 type data struct {
 	Items []*Item `json:"items"`
+	// Capacity is the max weight of the items, the default capacity
+	// of the container is used if it is zero
+	Capacity float64 `json:"capacity"`
+	// Weight of the items, which is computed from the item definitions
+	Weight float64 `json:"weight"`
+	// Owner of the inventory, nil if it has none
+	Owner *Owner `json:"owner"`
+	// Container type of the inventory, e.g. trunk or stash. It is empty for generic inventories.
+	Container string `json:"container"`
+	// Version of the inventory, which is increased by every change of it
+	Version uint64 `json:"version"`
 }
 
+// Containers maps container types to their default capacities
+type Containers map[string]float64
+
 // NewIncomplete returns a new incomplete inventory object instance
 func NewIncomplete(items []*Item) Incomplete {
-	return &data{items}
+	return &data{Items: items}
 }
 
 func (d *data) Data() *data {
 	return d
 }
+
+// Kinds of operations
+const (
+	OperationAdd    = "add"
+	OperationRemove = "remove"
+)
+
+// Operation adding an item to or removing an item from an inventory as part of a batch
+type Operation struct {
+	Kind          string `json:"kind"`
+	InventoryGUID string `json:"inventory_guid"`
+	Item          *Item  `json:"item"`
+	// Version the inventory is expected to be in before the batch, zero means any version
+	Version uint64 `json:"version"`
+}
+
+// OperationError is returned if an operation of a batch failed,
+// none of the operations of the batch are applied then
+type OperationError struct {
+	// Index of the failed operation in the batch
+	Index     int
+	Operation *Operation
+	Err       error
+}
+
+func (e *OperationError) Error() string {
+	return fmt.Sprintf("operation %d failed: %s", e.Index, e.Err.Error())
+}
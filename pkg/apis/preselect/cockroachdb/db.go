@@ -86,6 +86,33 @@ func NewRepository(db *sql.DB) preselect.Repository {
 	return &repository{db}
 }
 
+func (r *repository) Get(ctx context.Context, id preselect.Identifier) (preselect.Complete, error) {
+	inc := preselect.NewIncomplete(0)
+
+	if err := r.database.QueryRowContext(
+		ctx,
+		`SELECT accepted
+        FROM preselections
+        WHERE sex = $1
+        AND componentId = $2
+        AND drawableId = $3
+        AND textureId = $4`,
+		id.Sex(),
+		id.ComponentID(),
+		id.DrawableID(),
+		id.TextureID(),
+	).Scan(
+		&inc.Data().Accepted,
+	); err != nil {
+		return nil, err
+	}
+
+	return newComplete(
+		newIdentifier(id.Sex(), id.ComponentID(), id.DrawableID(), id.TextureID()),
+		inc,
+	), nil
+}
+
 func (r *repository) GetLeft(ctx context.Context) (uint64, error) {
 	var count uint64
 	if err := r.database.QueryRowContext(
@@ -0,0 +1,43 @@
+package preselect
+
+import (
+	"context"
+	"database/sql"
+
+	pb "github.com/51st-state/api/pkg/apis/preselect/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcClient struct {
+	client pb.ManagerClient
+}
+
+// NewGRPCClient creates a new grpc client to get pre-selections
+func NewGRPCClient(c *grpc.ClientConn) Getter {
+	return &grpcClient{
+		pb.NewManagerClient(c),
+	}
+}
+
+func (g *grpcClient) Get(ctx context.Context, id Identifier) (Complete, error) {
+	resp, err := g.client.Get(ctx, &pb.Identifier{
+		Sex:         id.Sex(),
+		ComponentID: id.ComponentID(),
+		DrawableID:  id.DrawableID(),
+		TextureID:   id.TextureID(),
+	})
+	if err != nil {
+		if status.Convert(err).Code() == codes.NotFound {
+			return nil, sql.ErrNoRows
+		}
+
+		return nil, err
+	}
+
+	return newComplete(
+		NewIdentifier(id.Sex(), id.ComponentID(), id.DrawableID(), id.TextureID()),
+		NewIncomplete(uint8(resp.GetAccepted())),
+	), nil
+}
@@ -0,0 +1,38 @@
+package preselect
+
+import (
+	"context"
+	"database/sql"
+
+	pb "github.com/51st-state/api/pkg/apis/preselect/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcServer struct {
+	manager *Manager
+}
+
+// NewGRPCServer creates a new instance of a grpc server for pre-selections
+func NewGRPCServer(m *Manager) pb.ManagerServer {
+	return &grpcServer{m}
+}
+
+func (g *grpcServer) Get(ctx context.Context, id *pb.Identifier) (*pb.Complete, error) {
+	c, err := g.manager.Get(ctx, NewIdentifier(
+		id.GetSex(),
+		id.GetComponentID(),
+		id.GetDrawableID(),
+		id.GetTextureID(),
+	))
+	if err == sql.ErrNoRows {
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &pb.Complete{
+		Identifier: id,
+		Accepted:   uint32(c.Data().Accepted),
+	}, nil
+}
@@ -1,5 +1,14 @@
 package preselect
 
+//go:generate protoc -I./../../../../../../ -I ./proto --go_out=plugins=grpc:./proto ./proto/manager.proto
+
+import "context"
+
+// Getter of pre-selections
+type Getter interface {
+	Get(context.Context, Identifier) (Complete, error)
+}
+
 // Manager for pre-selection management
 type Manager struct {
 	repository Repository
@@ -12,6 +21,11 @@ func NewManager(r Repository) *Manager {
 	}
 }
 
+// Get a pre-selection
+func (m *Manager) Get(ctx context.Context, id Identifier) (Complete, error) {
+	return m.repository.Get(ctx, id)
+}
+
 /*// GetLeft preselect objects
 func (m *Manager) GetLeft(ctx context.Context) (uint64, error) {
 	return m.repository.GetLeft(ctx)
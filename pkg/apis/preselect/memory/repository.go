@@ -0,0 +1,160 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/preselect"
+)
+
+type complete struct {
+	preselect.Identifier
+	preselect.Incomplete
+}
+
+func (c *complete) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Sex         uint64 `json:"sex"`
+		ComponentID uint64 `json:"component_id"`
+		DrawableID  uint64 `json:"drawable_id"`
+		TextureID   uint64 `json:"texture_id"`
+		Accepted    uint8  `json:"accepted"`
+	}{
+		c.Sex(),
+		c.ComponentID(),
+		c.DrawableID(),
+		c.TextureID(),
+		c.Data().Accepted,
+	})
+}
+
+type identifier struct {
+	sex, componentID, drawableID, textureID uint64
+}
+
+func (i *identifier) Sex() uint64 {
+	return i.sex
+}
+
+func (i *identifier) ComponentID() uint64 {
+	return i.componentID
+}
+
+func (i *identifier) DrawableID() uint64 {
+	return i.drawableID
+}
+
+func (i *identifier) TextureID() uint64 {
+	return i.textureID
+}
+
+func identifierOf(id preselect.Identifier) identifier {
+	return identifier{id.Sex(), id.ComponentID(), id.DrawableID(), id.TextureID()}
+}
+
+type preselection struct {
+	id       identifier
+	accepted uint8
+}
+
+type repository struct {
+	mutex sync.RWMutex
+	// preselections in the order of their creation
+	preselections []*preselection
+}
+
+// NewRepository for a preselect service in memory
+func NewRepository() preselect.Repository {
+	return &repository{
+		preselections: make([]*preselection, 0),
+	}
+}
+
+func (r *repository) find(id preselect.Identifier) *preselection {
+	for _, v := range r.preselections {
+		if v.id == identifierOf(id) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) Get(ctx context.Context, id preselect.Identifier) (preselect.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	p := r.find(id)
+	if p == nil {
+		return nil, sql.ErrNoRows
+	}
+
+	pid := p.id
+	return &complete{
+		&pid,
+		preselect.NewIncomplete(p.accepted),
+	}, nil
+}
+
+func (r *repository) GetLeft(ctx context.Context) (uint64, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var count uint64
+	for _, v := range r.preselections {
+		if v.accepted == 0 {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *repository) GetNext(ctx context.Context) (preselect.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, v := range r.preselections {
+		if v.accepted == 0 {
+			id := v.id
+			return &complete{
+				&id,
+				preselect.NewIncomplete(0),
+			}, nil
+		}
+	}
+
+	return nil, sql.ErrNoRows
+}
+
+// Create preselections, existing preselections are left untouched
+func (r *repository) Create(ctx context.Context, c ...preselect.Complete) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, v := range c {
+		if r.find(v) == nil {
+			r.preselections = append(r.preselections, &preselection{
+				identifierOf(v),
+				v.Data().Accepted,
+			})
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) Update(ctx context.Context, c ...preselect.Complete) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, v := range c {
+		if p := r.find(v); p != nil {
+			p.accepted = v.Data().Accepted
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/preselect"
+	"github.com/51st-state/api/pkg/apis/preselect/memory"
+	"github.com/51st-state/api/pkg/apis/preselect/repositorytest"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) preselect.Repository {
+		return memory.NewRepository()
+	})
+}
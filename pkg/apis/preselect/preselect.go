@@ -14,7 +14,8 @@ type identifier struct {
 	sex, componentID, drawableID, textureID uint64
 }
 
-func newIdentifier(s, c, d, t uint64) Identifier {
+// NewIdentifier of a pre-selection
+func NewIdentifier(s, c, d, t uint64) Identifier {
 	return &identifier{s, c, d, t}
 }
 
@@ -72,12 +73,19 @@ func (c *complete) UnmarshalJSON(b []byte) error {
 		return err
 	}
 
-	c.Identifier = newIdentifier(compl.Sex, compl.ComponentID, compl.DrawableID, compl.TextureID)
+	c.Identifier = NewIdentifier(compl.Sex, compl.ComponentID, compl.DrawableID, compl.TextureID)
 	c.Incomplete = NewIncomplete(compl.Accepted)
 
 	return nil
 }
 
+// review states of a pre-selection
+const (
+	StatePending uint8 = iota
+	StateAccepted
+	StateDeclined
+)
+
 type data struct {
 	Accepted uint8 `json:"accepted"`
 }
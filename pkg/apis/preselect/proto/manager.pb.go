@@ -0,0 +1,230 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: manager.proto
+
+package preselect
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Identifier struct {
+	Sex                  uint64   `protobuf:"varint,1,opt,name=Sex,proto3" json:"Sex,omitempty"`
+	ComponentID          uint64   `protobuf:"varint,2,opt,name=ComponentID,proto3" json:"ComponentID,omitempty"`
+	DrawableID           uint64   `protobuf:"varint,3,opt,name=DrawableID,proto3" json:"DrawableID,omitempty"`
+	TextureID            uint64   `protobuf:"varint,4,opt,name=TextureID,proto3" json:"TextureID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Identifier) Reset()         { *m = Identifier{} }
+func (m *Identifier) String() string { return proto.CompactTextString(m) }
+func (*Identifier) ProtoMessage()    {}
+func (*Identifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{0}
+}
+
+func (m *Identifier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Identifier.Unmarshal(m, b)
+}
+func (m *Identifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Identifier.Marshal(b, m, deterministic)
+}
+func (m *Identifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Identifier.Merge(m, src)
+}
+func (m *Identifier) XXX_Size() int {
+	return xxx_messageInfo_Identifier.Size(m)
+}
+func (m *Identifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_Identifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Identifier proto.InternalMessageInfo
+
+func (m *Identifier) GetSex() uint64 {
+	if m != nil {
+		return m.Sex
+	}
+	return 0
+}
+
+func (m *Identifier) GetComponentID() uint64 {
+	if m != nil {
+		return m.ComponentID
+	}
+	return 0
+}
+
+func (m *Identifier) GetDrawableID() uint64 {
+	if m != nil {
+		return m.DrawableID
+	}
+	return 0
+}
+
+func (m *Identifier) GetTextureID() uint64 {
+	if m != nil {
+		return m.TextureID
+	}
+	return 0
+}
+
+type Complete struct {
+	Identifier           *Identifier `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Accepted             uint32      `protobuf:"varint,2,opt,name=Accepted,proto3" json:"Accepted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Complete) Reset()         { *m = Complete{} }
+func (m *Complete) String() string { return proto.CompactTextString(m) }
+func (*Complete) ProtoMessage()    {}
+func (*Complete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{1}
+}
+
+func (m *Complete) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Complete.Unmarshal(m, b)
+}
+func (m *Complete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Complete.Marshal(b, m, deterministic)
+}
+func (m *Complete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Complete.Merge(m, src)
+}
+func (m *Complete) XXX_Size() int {
+	return xxx_messageInfo_Complete.Size(m)
+}
+func (m *Complete) XXX_DiscardUnknown() {
+	xxx_messageInfo_Complete.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Complete proto.InternalMessageInfo
+
+func (m *Complete) GetIdentifier() *Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *Complete) GetAccepted() uint32 {
+	if m != nil {
+		return m.Accepted
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Identifier)(nil), "preselect.Identifier")
+	proto.RegisterType((*Complete)(nil), "preselect.Complete")
+}
+
+func init() { proto.RegisterFile("manager.proto", fileDescriptor_cde9ec64f0d2c859) }
+
+var fileDescriptor_cde9ec64f0d2c859 = []byte{
+	// 211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x41, 0x4b, 0x86, 0x40,
+	0x10, 0x40, 0xfb, 0x52, 0x4a, 0x47, 0x84, 0x98, 0x08, 0x44, 0x22, 0xc4, 0x53, 0x27, 0x0f, 0x4a,
+	0xd7, 0x20, 0x12, 0xc2, 0x43, 0x17, 0xeb, 0xda, 0x61, 0xd5, 0x29, 0x04, 0xdd, 0x5d, 0xb6, 0x89,
+	0x3c, 0xf5, 0xdb, 0xc3, 0x8d, 0x74, 0x0f, 0xdf, 0x6d, 0xf7, 0xbd, 0x65, 0x78, 0x3b, 0x10, 0xcf,
+	0x42, 0x8a, 0x0f, 0x32, 0x85, 0x36, 0x8a, 0x15, 0x86, 0xda, 0xd0, 0x27, 0x4d, 0xd4, 0x73, 0xfe,
+	0x03, 0xd0, 0x0c, 0x24, 0x79, 0x7c, 0x1f, 0xc9, 0xe0, 0x05, 0x78, 0x2f, 0xb4, 0x24, 0x87, 0xec,
+	0x70, 0xeb, 0xb7, 0xeb, 0x11, 0x33, 0x88, 0x1e, 0xd5, 0xac, 0x95, 0x24, 0xc9, 0x4d, 0x9d, 0x9c,
+	0x5a, 0xe3, 0x22, 0xbc, 0x01, 0xa8, 0x8d, 0xf8, 0x16, 0xdd, 0x44, 0x4d, 0x9d, 0x78, 0xf6, 0x81,
+	0x43, 0xf0, 0x1a, 0xc2, 0x57, 0x5a, 0xf8, 0xcb, 0xac, 0xda, 0xb7, 0x7a, 0x07, 0xf9, 0x1b, 0x04,
+	0xeb, 0xb0, 0x89, 0x98, 0xf0, 0xce, 0x6d, 0xb1, 0x11, 0x51, 0x79, 0x55, 0x6c, 0xad, 0xc5, 0x2e,
+	0x5b, 0x37, 0x3a, 0x85, 0xe0, 0xa1, 0xef, 0x49, 0x33, 0x0d, 0xb6, 0x2f, 0x6e, 0xb7, 0x7b, 0x79,
+	0x0f, 0xe7, 0xcf, 0x7f, 0x5f, 0xc7, 0x0a, 0xbc, 0x27, 0x62, 0x3c, 0x3e, 0x30, 0xbd, 0x74, 0xf0,
+	0x7f, 0x50, 0x7e, 0xd2, 0x9d, 0xd9, 0x85, 0x55, 0xbf, 0x03, 0x00, 0xe1, 0x52, 0x02, 0xff, 0x41,
+	0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ManagerClient is the client API for Manager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ManagerClient interface {
+	Get(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Complete, error)
+}
+
+type managerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewManagerClient(cc *grpc.ClientConn) ManagerClient {
+	return &managerClient{cc}
+}
+
+func (c *managerClient) Get(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Complete, error) {
+	out := new(Complete)
+	err := c.cc.Invoke(ctx, "/preselect.Manager/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManagerServer is the server API for Manager service.
+type ManagerServer interface {
+	Get(context.Context, *Identifier) (*Complete, error)
+}
+
+func RegisterManagerServer(s *grpc.Server, srv ManagerServer) {
+	s.RegisterService(&_Manager_serviceDesc, srv)
+}
+
+func _Manager_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/preselect.Manager/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Get(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Manager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "preselect.Manager",
+	HandlerType: (*ManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Manager_Get_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "manager.proto",
+}
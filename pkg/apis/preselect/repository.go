@@ -4,6 +4,7 @@ import "context"
 
 // Repository for preselect objects
 type Repository interface {
+	Get(context.Context, Identifier) (Complete, error)
 	GetNext(context.Context) (Complete, error)
 	GetLeft(context.Context) (uint64, error)
 	Create(context.Context, ...Complete) error
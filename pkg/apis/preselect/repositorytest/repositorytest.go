@@ -0,0 +1,129 @@
+// Package repositorytest contains the conformance tests of preselect repositories
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/preselect"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) preselect.Repository) {
+	t.Run("Preselections", func(t *testing.T) {
+		testPreselections(t, newRepository(t))
+	})
+}
+
+type identifier struct {
+	sex, componentID, drawableID, textureID uint64
+}
+
+func (i *identifier) Sex() uint64 {
+	return i.sex
+}
+
+func (i *identifier) ComponentID() uint64 {
+	return i.componentID
+}
+
+func (i *identifier) DrawableID() uint64 {
+	return i.drawableID
+}
+
+func (i *identifier) TextureID() uint64 {
+	return i.textureID
+}
+
+type complete struct {
+	preselect.Identifier
+	preselect.Incomplete
+}
+
+func newComplete(textureID uint64, accepted uint8) preselect.Complete {
+	return &complete{
+		&identifier{0, 11, 1, textureID},
+		preselect.NewIncomplete(accepted),
+	}
+}
+
+func testPreselections(t *testing.T, r preselect.Repository) {
+	ctx := context.Background()
+
+	if _, err := r.GetNext(ctx); err != sql.ErrNoRows {
+		t.Fatal("there should be no preselection left")
+	}
+
+	if left, err := r.GetLeft(ctx); err != nil || left != 0 {
+		t.Fatal("there should be no preselection left")
+	}
+
+	if err := r.Create(ctx, newComplete(1, 0), newComplete(2, 0), newComplete(3, 1)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Create(ctx, newComplete(1, 1)); err != nil {
+		t.Fatal("creating an existing preselection should not return an error")
+	}
+
+	if _, err := r.Get(ctx, &identifier{0, 11, 1, 4}); err != sql.ErrNoRows {
+		t.Fatal("an unknown preselection should not be found")
+	}
+
+	accepted, err := r.Get(ctx, &identifier{0, 11, 1, 3})
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if accepted.Sex() != 0 || accepted.ComponentID() != 11 || accepted.DrawableID() != 1 || accepted.TextureID() != 3 {
+		t.Fatal("the ids are not equal")
+	}
+
+	if accepted.Data().Accepted != preselect.StateAccepted {
+		t.Fatal("the stored data is not equal")
+	}
+
+	if left, err := r.GetLeft(ctx); err != nil || left != 2 {
+		t.Fatal("creating an existing preselection should not change it")
+	}
+
+	first, err := r.GetNext(ctx)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if first.Sex() != 0 || first.ComponentID() != 11 || first.DrawableID() != 1 || first.TextureID() == 3 {
+		t.Fatal("the next preselection should not be accepted yet")
+	}
+
+	if first.Data().Accepted != 0 {
+		t.Fatal("the next preselection should not be accepted yet")
+	}
+
+	if err := r.Update(ctx, newComplete(first.TextureID(), 1)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if left, err := r.GetLeft(ctx); err != nil || left != 1 {
+		t.Fatal("the accepted preselection should not be left")
+	}
+
+	second, err := r.GetNext(ctx)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if second.TextureID() == first.TextureID() || second.TextureID() == 3 {
+		t.Fatal("the next preselection should not be accepted yet")
+	}
+
+	if err := r.Update(ctx, newComplete(second.TextureID(), 2), newComplete(4, 0)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.GetNext(ctx); err != sql.ErrNoRows {
+		t.Fatal("updating an unknown preselection should not create it")
+	}
+}
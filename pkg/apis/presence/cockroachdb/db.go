@@ -0,0 +1,217 @@
+package cockroachdb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/51st-state/api/pkg/apis/presence"
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+// CreateSchema creates a new cockroachdb schema in a cockroachdb database for the presence service
+func CreateSchema(ctx context.Context, db *sql.DB) (err error) {
+	_, err = db.ExecContext(
+		ctx,
+		`CREATE TABLE IF NOT EXISTS presence_sessions (
+            id UUID PRIMARY KEY,
+            userUUID UUID NOT NULL,
+            connectedAt TIMESTAMPTZ NOT NULL,
+            lastSeenAt TIMESTAMPTZ NOT NULL,
+            disconnectedAt TIMESTAMPTZ NULL
+        );
+        CREATE INDEX IF NOT EXISTS presence_sessions_idx_user ON presence_sessions (userUUID, connectedAt);
+        CREATE INDEX IF NOT EXISTS presence_sessions_idx_open ON presence_sessions (disconnectedAt, connectedAt);
+        CREATE UNIQUE INDEX IF NOT EXISTS presence_sessions_idx_user_open ON presence_sessions (userUUID) WHERE disconnectedAt IS NULL;`,
+	)
+	return
+}
+
+type db struct {
+	db *sql.DB
+}
+
+// NewRepository creates a new cockroachdb db storage repository
+func NewRepository(d *sql.DB) presence.Repository {
+	return &db{d}
+}
+
+type scanner interface {
+	Scan(...interface{}) error
+}
+
+func scanSession(s scanner) (*presence.Session, error) {
+	session := &presence.Session{}
+	if err := s.Scan(
+		&session.ID,
+		&session.UserUUID,
+		&session.ConnectedAt,
+		&session.LastSeenAt,
+		&session.DisconnectedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (d *db) query(ctx context.Context, query string, args ...interface{}) ([]*presence.Session, error) {
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]*presence.Session, 0)
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, s)
+	}
+
+	return sessions, rows.Err()
+}
+
+func (d *db) GetOpen(ctx context.Context, id user.Identifier) (*presence.Session, error) {
+	return scanSession(d.db.QueryRowContext(
+		ctx,
+		`SELECT id,
+        userUUID,
+        connectedAt,
+        lastSeenAt,
+        disconnectedAt
+        FROM presence_sessions
+        WHERE userUUID = $1
+        AND disconnectedAt IS NULL
+        ORDER BY connectedAt DESC, id DESC
+        LIMIT 1`,
+		id.UUID(),
+	))
+}
+
+func (d *db) GetOnline(ctx context.Context) ([]*presence.Session, error) {
+	return d.query(
+		ctx,
+		`SELECT id,
+        userUUID,
+        connectedAt,
+        lastSeenAt,
+        disconnectedAt
+        FROM presence_sessions
+        WHERE disconnectedAt IS NULL
+        ORDER BY connectedAt, id`,
+	)
+}
+
+func (d *db) GetSessions(ctx context.Context, id user.Identifier) ([]*presence.Session, error) {
+	return d.query(
+		ctx,
+		`SELECT id,
+        userUUID,
+        connectedAt,
+        lastSeenAt,
+        disconnectedAt
+        FROM presence_sessions
+        WHERE userUUID = $1
+        ORDER BY connectedAt, id`,
+		id.UUID(),
+	)
+}
+
+func (d *db) Open(ctx context.Context, s *presence.Session) (*presence.Session, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := d.db.ExecContext(
+		ctx,
+		`INSERT INTO presence_sessions (
+            id,
+            userUUID,
+            connectedAt,
+            lastSeenAt
+        ) SELECT $1,
+        $2,
+        $3,
+        $4`,
+		rand.String(),
+		s.UserUUID,
+		s.ConnectedAt,
+		s.LastSeenAt,
+	); isUniqueViolation(err) {
+		return nil, presence.ErrSessionOpen
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &presence.Session{
+		ID:          rand.String(),
+		UserUUID:    s.UserUUID,
+		ConnectedAt: s.ConnectedAt,
+		LastSeenAt:  s.LastSeenAt,
+	}, nil
+}
+
+// uniqueViolation is the error code of statements violating a unique constraint
+const uniqueViolation = "23505"
+
+// isUniqueViolation checks whether the error violates the open session index,
+// the only unique constraint besides the random id
+func isUniqueViolation(err error) bool {
+	e, ok := err.(*pq.Error)
+	return ok && e.Code == uniqueViolation
+}
+
+func (d *db) Touch(ctx context.Context, id string, at time.Time) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`UPDATE presence_sessions
+        SET lastSeenAt = $1
+        WHERE id = $2
+        AND disconnectedAt IS NULL`,
+		at,
+		id,
+	)
+	return err
+}
+
+func (d *db) Close(ctx context.Context, id string, at time.Time) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`UPDATE presence_sessions
+        SET disconnectedAt = $1
+        WHERE id = $2
+        AND disconnectedAt IS NULL`,
+		at,
+		id,
+	)
+	return err
+}
+
+func (d *db) CloseStale(ctx context.Context, before time.Time) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`UPDATE presence_sessions
+        SET disconnectedAt = lastSeenAt
+        WHERE disconnectedAt IS NULL
+        AND lastSeenAt < $1`,
+		before,
+	)
+	return err
+}
+
+func (d *db) DeleteByUser(ctx context.Context, id user.Identifier) error {
+	_, err := d.db.ExecContext(
+		ctx,
+		`DELETE FROM presence_sessions WHERE userUUID = $1`,
+		id.UUID(),
+	)
+	return err
+}
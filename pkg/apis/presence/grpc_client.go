@@ -0,0 +1,123 @@
+package presence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	pb "github.com/51st-state/api/pkg/apis/presence/proto"
+	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcClient struct {
+	client pb.ManagerClient
+}
+
+// NewGRPCClient creates a new grpc client for the presence manager
+func NewGRPCClient(c *grpc.ClientConn) Manager {
+	return &grpcClient{
+		pb.NewManagerClient(c),
+	}
+}
+
+func fromUnixNano(nsec int64) *time.Time {
+	if nsec == 0 {
+		return nil
+	}
+
+	t := time.Unix(0, nsec)
+	return &t
+}
+
+func sessionsFromGRPC(s *pb.Sessions) []*Session {
+	sessions := make([]*Session, 0)
+	for _, v := range s.GetSessions() {
+		sessions = append(sessions, &Session{
+			ID:             v.GetID(),
+			UserUUID:       v.GetUserUUID(),
+			ConnectedAt:    time.Unix(0, v.GetConnectedAt()),
+			LastSeenAt:     time.Unix(0, v.GetLastSeenAt()),
+			DisconnectedAt: fromUnixNano(v.GetDisconnectedAt()),
+		})
+	}
+
+	return sessions
+}
+
+func playerToGRPC(p *Player) *pb.Player {
+	return &pb.Player{
+		UserUUID:       p.UserUUID,
+		GameSerialHash: p.GameSerialHash,
+	}
+}
+
+// playerFromError maps unknown game serial hashes back
+func playerFromError(err error) error {
+	if err != nil && status.Convert(err).Code() == codes.NotFound {
+		return sql.ErrNoRows
+	}
+
+	return err
+}
+
+func (g *grpcClient) Connect(ctx context.Context, p *Player) error {
+	_, err := g.client.Connect(ctx, playerToGRPC(p))
+	return playerFromError(err)
+}
+
+func (g *grpcClient) Disconnect(ctx context.Context, p *Player) error {
+	_, err := g.client.Disconnect(ctx, playerToGRPC(p))
+	return playerFromError(err)
+}
+
+func (g *grpcClient) Heartbeat(ctx context.Context, p *Player) error {
+	_, err := g.client.Heartbeat(ctx, playerToGRPC(p))
+	return playerFromError(err)
+}
+
+func (g *grpcClient) GetOnline(ctx context.Context) ([]*Session, error) {
+	resp, err := g.client.GetOnline(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionsFromGRPC(resp), nil
+}
+
+func (g *grpcClient) GetSessions(ctx context.Context, id user.Identifier) ([]*Session, error) {
+	resp, err := g.client.GetSessions(ctx, &pb.UserRequest{
+		UserUUID: id.UUID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionsFromGRPC(resp), nil
+}
+
+func (g *grpcClient) GetPlaytime(ctx context.Context, id user.Identifier) (*Playtime, error) {
+	resp, err := g.client.GetPlaytime(ctx, &pb.UserRequest{
+		UserUUID: id.UUID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Playtime{
+		UserUUID:    resp.GetUserUUID(),
+		Online:      resp.GetOnline(),
+		Sessions:    int(resp.GetSessions()),
+		Seconds:     resp.GetSeconds(),
+		FirstSeenAt: fromUnixNano(resp.GetFirstSeenAt()),
+		LastSeenAt:  fromUnixNano(resp.GetLastSeenAt()),
+	}, nil
+}
+
+func (g *grpcClient) Expire(ctx context.Context) error {
+	_, err := g.client.Expire(ctx, &empty.Empty{})
+	return err
+}
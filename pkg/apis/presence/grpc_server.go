@@ -0,0 +1,112 @@
+package presence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	pb "github.com/51st-state/api/pkg/apis/presence/proto"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcServer struct {
+	manager Manager
+}
+
+// NewGRPCServer creates a new instance of a grpc server for the game server to report players
+func NewGRPCServer(m Manager) pb.ManagerServer {
+	return &grpcServer{m}
+}
+
+func toUnixNano(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+
+	return t.UnixNano()
+}
+
+func sessionsToGRPC(sessions []*Session) *pb.Sessions {
+	grpcSessions := &pb.Sessions{
+		Sessions: make([]*pb.Session, 0),
+	}
+	for _, v := range sessions {
+		grpcSessions.Sessions = append(grpcSessions.Sessions, &pb.Session{
+			ID:             v.ID,
+			UserUUID:       v.UserUUID,
+			ConnectedAt:    v.ConnectedAt.UnixNano(),
+			LastSeenAt:     v.LastSeenAt.UnixNano(),
+			DisconnectedAt: toUnixNano(v.DisconnectedAt),
+		})
+	}
+
+	return grpcSessions
+}
+
+func playerFromGRPC(p *pb.Player) *Player {
+	return &Player{
+		UserUUID:       p.GetUserUUID(),
+		GameSerialHash: p.GetGameSerialHash(),
+	}
+}
+
+// playerError reports unknown game serial hashes as not found
+func playerError(err error) error {
+	if err == sql.ErrNoRows {
+		return status.New(codes.NotFound, err.Error()).Err()
+	}
+
+	return err
+}
+
+func (g *grpcServer) Connect(ctx context.Context, p *pb.Player) (*empty.Empty, error) {
+	return &empty.Empty{}, playerError(g.manager.Connect(ctx, playerFromGRPC(p)))
+}
+
+func (g *grpcServer) Disconnect(ctx context.Context, p *pb.Player) (*empty.Empty, error) {
+	return &empty.Empty{}, playerError(g.manager.Disconnect(ctx, playerFromGRPC(p)))
+}
+
+func (g *grpcServer) Heartbeat(ctx context.Context, p *pb.Player) (*empty.Empty, error) {
+	return &empty.Empty{}, playerError(g.manager.Heartbeat(ctx, playerFromGRPC(p)))
+}
+
+func (g *grpcServer) GetOnline(ctx context.Context, _ *empty.Empty) (*pb.Sessions, error) {
+	sessions, err := g.manager.GetOnline(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionsToGRPC(sessions), nil
+}
+
+func (g *grpcServer) GetSessions(ctx context.Context, req *pb.UserRequest) (*pb.Sessions, error) {
+	sessions, err := g.manager.GetSessions(ctx, &userIdentifier{req.GetUserUUID()})
+	if err != nil {
+		return nil, err
+	}
+
+	return sessionsToGRPC(sessions), nil
+}
+
+func (g *grpcServer) GetPlaytime(ctx context.Context, req *pb.UserRequest) (*pb.Playtime, error) {
+	p, err := g.manager.GetPlaytime(ctx, &userIdentifier{req.GetUserUUID()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Playtime{
+		UserUUID:    p.UserUUID,
+		Online:      p.Online,
+		Sessions:    int64(p.Sessions),
+		Seconds:     p.Seconds,
+		FirstSeenAt: toUnixNano(p.FirstSeenAt),
+		LastSeenAt:  toUnixNano(p.LastSeenAt),
+	}, nil
+}
+
+func (g *grpcServer) Expire(ctx context.Context, _ *empty.Empty) (*empty.Empty, error) {
+	return &empty.Empty{}, g.manager.Expire(ctx)
+}
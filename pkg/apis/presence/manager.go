@@ -0,0 +1,235 @@
+package presence
+
+//go:generate counterfeiter -o ./mocks/manager.go . Manager
+//go:generate protoc -I./../../../../../../ -I ./proto --go_out=plugins=grpc:./proto ./proto/manager.proto
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+// Manager tracks the users online on the game server and their playtime.
+// The game server reports connections, disconnections and heartbeats. Sessions
+// without a heartbeat for longer than the timeout are considered offline and
+// end at their last heartbeat, so a crashed game server does not count playtime.
+type Manager interface {
+	// Connect opens a new session, an open session of the player is closed
+	Connect(context.Context, *Player) error
+	// Disconnect closes the open session of a player
+	Disconnect(context.Context, *Player) error
+	// Heartbeat keeps the session of a player open or opens a new one
+	// if the player has been considered offline
+	Heartbeat(context.Context, *Player) error
+	// GetOnline returns the sessions of the users online ordered by their connection
+	GetOnline(context.Context) ([]*Session, error)
+	GetSessions(context.Context, user.Identifier) ([]*Session, error)
+	GetPlaytime(context.Context, user.Identifier) (*Playtime, error)
+	// Expire closes the sessions which missed their heartbeats
+	Expire(context.Context) error
+}
+
+type manager struct {
+	repository Repository
+	users      user.Manager
+	timeout    time.Duration
+}
+
+// NewManager creates a new presence manager considering sessions
+// without a heartbeat for longer than the timeout as offline
+func NewManager(r Repository, u user.Manager, timeout time.Duration) Manager {
+	return &manager{r, u, timeout}
+}
+
+var (
+	errInvalidPlayer   = errors.New("either the uuid or the game serial hash of the player has to be given")
+	errInvalidUserUUID = errors.New("invalid user uuid given")
+)
+
+// resolve the user of a player
+func (m *manager) resolve(ctx context.Context, p *Player) (user.Identifier, error) {
+	if p.UserUUID != "" {
+		return &userIdentifier{p.UserUUID}, nil
+	}
+
+	if p.GameSerialHash == "" {
+		return nil, errInvalidPlayer
+	}
+
+	return m.users.GetByGameSerialHash(ctx, p.GameSerialHash)
+}
+
+// stale reports whether a session missed its heartbeats
+func (m *manager) stale(s *Session, now time.Time) bool {
+	return s.DisconnectedAt == nil && s.LastSeenAt.Before(now.Add(-m.timeout))
+}
+
+// end of a session. Open sessions last until now and stale sessions until their last heartbeat.
+func (m *manager) end(s *Session, now time.Time) time.Time {
+	switch {
+	case s.DisconnectedAt != nil:
+		return *s.DisconnectedAt
+	case m.stale(s, now):
+		return s.LastSeenAt
+	default:
+		return now
+	}
+}
+
+// open returns the open session of a user, which has not missed its heartbeats.
+// A stale session is closed at its last heartbeat.
+func (m *manager) open(ctx context.Context, id user.Identifier, now time.Time) (*Session, error) {
+	s, err := m.repository.GetOpen(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.stale(s, now) {
+		return s, nil
+	}
+
+	if err := m.repository.Close(ctx, s.ID, s.LastSeenAt); err != nil {
+		return nil, err
+	}
+
+	return nil, sql.ErrNoRows
+}
+
+func (m *manager) connect(ctx context.Context, id user.Identifier, now time.Time) error {
+	_, err := m.repository.Open(ctx, &Session{
+		UserUUID:    id.UUID(),
+		ConnectedAt: now,
+		LastSeenAt:  now,
+	})
+	if err == ErrSessionOpen {
+		// a concurrent report of the game server opened the session in the meantime
+		return nil
+	}
+
+	return err
+}
+
+func (m *manager) Connect(ctx context.Context, p *Player) error {
+	id, err := m.resolve(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	s, err := m.open(ctx, id, now)
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return err
+	default:
+		// the game server missed the disconnection, so the session ends at its last heartbeat
+		if err := m.repository.Close(ctx, s.ID, s.LastSeenAt); err != nil {
+			return err
+		}
+	}
+
+	return m.connect(ctx, id, now)
+}
+
+func (m *manager) Disconnect(ctx context.Context, p *Player) error {
+	id, err := m.resolve(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	s, err := m.open(ctx, id, now)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return m.repository.Close(ctx, s.ID, now)
+}
+
+func (m *manager) Heartbeat(ctx context.Context, p *Player) error {
+	id, err := m.resolve(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	s, err := m.open(ctx, id, now)
+	if err == sql.ErrNoRows {
+		return m.connect(ctx, id, now)
+	} else if err != nil {
+		return err
+	}
+
+	return m.repository.Touch(ctx, s.ID, now)
+}
+
+func (m *manager) GetOnline(ctx context.Context) ([]*Session, error) {
+	sessions, err := m.repository.GetOnline(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	online := make([]*Session, 0)
+	for _, v := range sessions {
+		if !m.stale(v, now) {
+			online = append(online, v)
+		}
+	}
+
+	return online, nil
+}
+
+func (m *manager) GetSessions(ctx context.Context, id user.Identifier) ([]*Session, error) {
+	if id.UUID() == "" {
+		return nil, errInvalidUserUUID
+	}
+
+	return m.repository.GetSessions(ctx, id)
+}
+
+func (m *manager) GetPlaytime(ctx context.Context, id user.Identifier) (*Playtime, error) {
+	sessions, err := m.GetSessions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	p := &Playtime{
+		UserUUID: id.UUID(),
+		Sessions: len(sessions),
+	}
+	for _, v := range sessions {
+		end := m.end(v, now)
+		p.Seconds += int64(end.Sub(v.ConnectedAt) / time.Second)
+
+		if v.DisconnectedAt == nil && !m.stale(v, now) {
+			p.Online = true
+		}
+
+		if p.FirstSeenAt == nil {
+			connectedAt := v.ConnectedAt
+			p.FirstSeenAt = &connectedAt
+		}
+
+		if p.LastSeenAt == nil || end.After(*p.LastSeenAt) {
+			p.LastSeenAt = &end
+		}
+	}
+
+	return p, nil
+}
+
+func (m *manager) Expire(ctx context.Context) error {
+	return m.repository.CloseStale(ctx, time.Now().Add(-m.timeout))
+}
@@ -0,0 +1,290 @@
+package presence_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/presence"
+	"github.com/51st-state/api/pkg/apis/presence/memory"
+	"github.com/51st-state/api/pkg/apis/presence/mocks"
+	"github.com/51st-state/api/pkg/apis/user"
+	userMocks "github.com/51st-state/api/pkg/apis/user/mocks"
+)
+
+type userIdentifier string
+
+func (i userIdentifier) UUID() string {
+	return string(i)
+}
+
+type completeUser struct {
+	userIdentifier
+	user.Incomplete
+}
+
+const player = userIdentifier("0b6f7a1c-3e2d-4f5a-9c8b-7d6e5f4a3b21")
+
+func TestManagerConnectAndDisconnect(t *testing.T) {
+	ctx := context.Background()
+	users := &userMocks.FakeManager{}
+	users.GetByGameSerialHashReturns(&completeUser{userIdentifier: player}, nil)
+
+	manager := presence.NewManager(memory.NewRepository(), users, time.Minute)
+
+	if err := manager.Connect(ctx, &presence.Player{}); err == nil {
+		t.Fatal("either the uuid or the game serial hash has to be given")
+	}
+
+	if err := manager.Connect(ctx, &presence.Player{GameSerialHash: "hash"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, hash := users.GetByGameSerialHashArgsForCall(0); hash != "hash" {
+		t.Fatal("the player should be resolved by its game serial hash")
+	}
+
+	online, err := manager.GetOnline(ctx)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(online) != 1 || online[0].UserUUID != player.UUID() {
+		t.Fatal("the player should be online")
+	}
+
+	if err := manager.Heartbeat(ctx, &presence.Player{UserUUID: player.UUID()}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := manager.Disconnect(ctx, &presence.Player{UserUUID: player.UUID()}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := manager.Disconnect(ctx, &presence.Player{UserUUID: player.UUID()}); err != nil {
+		t.Fatal("disconnecting an offline player should not fail")
+	}
+
+	online, err = manager.GetOnline(ctx)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(online) != 0 {
+		t.Fatal("the player should be offline")
+	}
+
+	sessions, err := manager.GetSessions(ctx, player)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(sessions) != 1 || sessions[0].DisconnectedAt == nil {
+		t.Fatal("the session should be closed")
+	}
+}
+
+func TestManagerConnectUnknownPlayer(t *testing.T) {
+	users := &userMocks.FakeManager{}
+	users.GetByGameSerialHashReturns(nil, errors.New("unknown"))
+
+	manager := presence.NewManager(&mocks.FakeRepository{}, users, time.Minute)
+
+	if err := manager.Connect(context.Background(), &presence.Player{GameSerialHash: "hash"}); err == nil {
+		t.Fatal("an unknown player should not be connected")
+	}
+}
+
+func TestManagerReconnect(t *testing.T) {
+	ctx := context.Background()
+	manager := presence.NewManager(memory.NewRepository(), &userMocks.FakeManager{}, time.Minute)
+
+	if err := manager.Connect(ctx, &presence.Player{UserUUID: player.UUID()}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := manager.Connect(ctx, &presence.Player{UserUUID: player.UUID()}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	sessions, err := manager.GetSessions(ctx, player)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(sessions) != 2 || sessions[0].DisconnectedAt == nil || sessions[1].DisconnectedAt != nil {
+		t.Fatal("a reconnection should close the previous session")
+	}
+
+	if !sessions[0].DisconnectedAt.Equal(sessions[0].LastSeenAt) {
+		t.Fatal("the previous session should end at its last heartbeat")
+	}
+}
+
+func TestManagerConcurrentConnect(t *testing.T) {
+	ctx := context.Background()
+	repo := &mocks.FakeRepository{}
+	repo.GetOpenReturns(nil, sql.ErrNoRows)
+	repo.OpenReturns(nil, presence.ErrSessionOpen)
+	manager := presence.NewManager(repo, &userMocks.FakeManager{}, time.Minute)
+
+	if err := manager.Connect(ctx, &presence.Player{UserUUID: player.UUID()}); err != nil {
+		t.Fatal("a session opened by a concurrent connection should be kept")
+	}
+
+	if err := manager.Heartbeat(ctx, &presence.Player{UserUUID: player.UUID()}); err != nil {
+		t.Fatal("a session opened by a concurrent heartbeat should be kept")
+	}
+
+	repo.OpenReturns(nil, errors.New("test error"))
+	if err := manager.Connect(ctx, &presence.Player{UserUUID: player.UUID()}); err == nil {
+		t.Fatal("the error of the repository should be returned")
+	}
+}
+
+func TestManagerTimeout(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRepository()
+	manager := presence.NewManager(repo, &userMocks.FakeManager{}, 20*time.Millisecond)
+
+	if err := manager.Connect(ctx, &presence.Player{UserUUID: player.UUID()}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	time.Sleep(40 * time.Millisecond)
+
+	online, err := manager.GetOnline(ctx)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(online) != 0 {
+		t.Fatal("a player which missed its heartbeats should be offline")
+	}
+
+	playtime, err := manager.GetPlaytime(ctx, player)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if playtime.Online || playtime.Seconds != 0 {
+		t.Fatal("a stale session should end at its last heartbeat")
+	}
+
+	if err := manager.Expire(ctx); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := repo.GetOpen(ctx, player); err == nil {
+		t.Fatal("the stale session should be closed")
+	}
+
+	if err := manager.Heartbeat(ctx, &presence.Player{UserUUID: player.UUID()}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	sessions, err := manager.GetSessions(ctx, player)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(sessions) != 2 || sessions[1].DisconnectedAt != nil {
+		t.Fatal("a heartbeat of an offline player should open a new session")
+	}
+}
+
+func TestManagerGetPlaytime(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRepository()
+	manager := presence.NewManager(repo, &userMocks.FakeManager{}, time.Minute)
+
+	if _, err := manager.GetPlaytime(ctx, userIdentifier("")); err == nil {
+		t.Fatal("the user uuid is invalid")
+	}
+
+	playtime, err := manager.GetPlaytime(ctx, player)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if playtime.Sessions != 0 || playtime.Seconds != 0 || playtime.FirstSeenAt != nil {
+		t.Fatal("a user without sessions has no playtime")
+	}
+
+	connectedAt := time.Date(2018, time.June, 1, 20, 0, 0, 0, time.UTC)
+	for i := 0; i < 2; i++ {
+		s, err := repo.Open(ctx, &presence.Session{
+			UserUUID:    player.UUID(),
+			ConnectedAt: connectedAt.Add(time.Duration(i) * time.Hour),
+			LastSeenAt:  connectedAt.Add(time.Duration(i) * time.Hour),
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if err := repo.Close(ctx, s.ID, s.ConnectedAt.Add(30*time.Minute)); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	playtime, err = manager.GetPlaytime(ctx, player)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if playtime.Online || playtime.Sessions != 2 || playtime.Seconds != 3600 {
+		t.Fatal("the playtime should be the sum of the sessions")
+	}
+
+	if !playtime.FirstSeenAt.Equal(connectedAt) || !playtime.LastSeenAt.Equal(connectedAt.Add(90*time.Minute)) {
+		t.Fatal("the playtime should span the sessions")
+	}
+}
+
+func TestPrivacyHandler(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRepository()
+	manager := presence.NewManager(repo, &userMocks.FakeManager{}, time.Minute)
+	handler := presence.NewPrivacyHandler(repo)
+
+	if err := manager.Connect(ctx, &presence.Player{UserUUID: player.UUID()}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	export, err := handler.Export(ctx, player.UUID())
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	b, err := json.Marshal(export)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var data struct {
+		Sessions []*presence.Session `json:"sessions"`
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(data.Sessions) != 1 {
+		t.Fatal("the sessions should be exported")
+	}
+
+	if err := handler.Erase(ctx, player.UUID()); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	sessions, err := manager.GetSessions(ctx, player)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(sessions) != 0 {
+		t.Fatal("the sessions should be erased")
+	}
+}
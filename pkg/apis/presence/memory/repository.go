@@ -0,0 +1,163 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/presence"
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+type repository struct {
+	mutex sync.RWMutex
+	// sessions in the order of their connection
+	sessions []*presence.Session
+}
+
+// NewRepository creates a new in memory storage repository
+func NewRepository() presence.Repository {
+	return &repository{
+		sessions: make([]*presence.Session, 0),
+	}
+}
+
+func stored(s *presence.Session) *presence.Session {
+	c := *s
+	if s.DisconnectedAt != nil {
+		disconnectedAt := *s.DisconnectedAt
+		c.DisconnectedAt = &disconnectedAt
+	}
+
+	return &c
+}
+
+func (r *repository) filter(match func(*presence.Session) bool) []*presence.Session {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	sessions := make([]*presence.Session, 0)
+	for _, v := range r.sessions {
+		if match(v) {
+			sessions = append(sessions, stored(v))
+		}
+	}
+
+	return sessions
+}
+
+func (r *repository) GetOpen(ctx context.Context, id user.Identifier) (*presence.Session, error) {
+	sessions := r.filter(func(s *presence.Session) bool {
+		return s.UserUUID == id.UUID() && s.DisconnectedAt == nil
+	})
+	if len(sessions) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return sessions[len(sessions)-1], nil
+}
+
+func (r *repository) GetOnline(ctx context.Context) ([]*presence.Session, error) {
+	return r.filter(func(s *presence.Session) bool {
+		return s.DisconnectedAt == nil
+	}), nil
+}
+
+func (r *repository) GetSessions(ctx context.Context, id user.Identifier) ([]*presence.Session, error) {
+	return r.filter(func(s *presence.Session) bool {
+		return s.UserUUID == id.UUID()
+	}), nil
+}
+
+func (r *repository) Open(ctx context.Context, s *presence.Session) (*presence.Session, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, v := range r.sessions {
+		if v.UserUUID == s.UserUUID && v.DisconnectedAt == nil {
+			return nil, presence.ErrSessionOpen
+		}
+	}
+
+	c := stored(s)
+	c.ID = rand.String()
+	c.DisconnectedAt = nil
+
+	// sessions are kept in the order of their connection
+	i := len(r.sessions)
+	for i > 0 && r.sessions[i-1].ConnectedAt.After(c.ConnectedAt) {
+		i--
+	}
+	r.sessions = append(r.sessions[:i], append([]*presence.Session{c}, r.sessions[i:]...)...)
+
+	return stored(c), nil
+}
+
+func (r *repository) find(id string) *presence.Session {
+	for _, v := range r.sessions {
+		if v.ID == id {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) Touch(ctx context.Context, id string, at time.Time) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if s := r.find(id); s != nil && s.DisconnectedAt == nil {
+		s.LastSeenAt = at
+	}
+
+	return nil
+}
+
+func (r *repository) Close(ctx context.Context, id string, at time.Time) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if s := r.find(id); s != nil && s.DisconnectedAt == nil {
+		s.DisconnectedAt = &at
+	}
+
+	return nil
+}
+
+func (r *repository) CloseStale(ctx context.Context, before time.Time) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, v := range r.sessions {
+		if v.DisconnectedAt == nil && v.LastSeenAt.Before(before) {
+			lastSeenAt := v.LastSeenAt
+			v.DisconnectedAt = &lastSeenAt
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteByUser(ctx context.Context, id user.Identifier) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	sessions := make([]*presence.Session, 0)
+	for _, v := range r.sessions {
+		if v.UserUUID != id.UUID() {
+			sessions = append(sessions, v)
+		}
+	}
+	r.sessions = sessions
+
+	return nil
+}
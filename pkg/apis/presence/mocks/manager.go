@@ -0,0 +1,491 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/presence"
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+type FakeManager struct {
+	ConnectStub        func(context.Context, *presence.Player) error
+	connectMutex       sync.RWMutex
+	connectArgsForCall []struct {
+		arg1 context.Context
+		arg2 *presence.Player
+	}
+	connectReturns struct {
+		result1 error
+	}
+	connectReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DisconnectStub        func(context.Context, *presence.Player) error
+	disconnectMutex       sync.RWMutex
+	disconnectArgsForCall []struct {
+		arg1 context.Context
+		arg2 *presence.Player
+	}
+	disconnectReturns struct {
+		result1 error
+	}
+	disconnectReturnsOnCall map[int]struct {
+		result1 error
+	}
+	HeartbeatStub        func(context.Context, *presence.Player) error
+	heartbeatMutex       sync.RWMutex
+	heartbeatArgsForCall []struct {
+		arg1 context.Context
+		arg2 *presence.Player
+	}
+	heartbeatReturns struct {
+		result1 error
+	}
+	heartbeatReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetOnlineStub        func(context.Context) ([]*presence.Session, error)
+	getOnlineMutex       sync.RWMutex
+	getOnlineArgsForCall []struct {
+		arg1 context.Context
+	}
+	getOnlineReturns struct {
+		result1 []*presence.Session
+		result2 error
+	}
+	getOnlineReturnsOnCall map[int]struct {
+		result1 []*presence.Session
+		result2 error
+	}
+	GetSessionsStub        func(context.Context, user.Identifier) ([]*presence.Session, error)
+	getSessionsMutex       sync.RWMutex
+	getSessionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	getSessionsReturns struct {
+		result1 []*presence.Session
+		result2 error
+	}
+	getSessionsReturnsOnCall map[int]struct {
+		result1 []*presence.Session
+		result2 error
+	}
+	GetPlaytimeStub        func(context.Context, user.Identifier) (*presence.Playtime, error)
+	getPlaytimeMutex       sync.RWMutex
+	getPlaytimeArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	getPlaytimeReturns struct {
+		result1 *presence.Playtime
+		result2 error
+	}
+	getPlaytimeReturnsOnCall map[int]struct {
+		result1 *presence.Playtime
+		result2 error
+	}
+	ExpireStub        func(context.Context) error
+	expireMutex       sync.RWMutex
+	expireArgsForCall []struct {
+		arg1 context.Context
+	}
+	expireReturns struct {
+		result1 error
+	}
+	expireReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeManager) Connect(arg1 context.Context, arg2 *presence.Player) error {
+	fake.connectMutex.Lock()
+	ret, specificReturn := fake.connectReturnsOnCall[len(fake.connectArgsForCall)]
+	fake.connectArgsForCall = append(fake.connectArgsForCall, struct {
+		arg1 context.Context
+		arg2 *presence.Player
+	}{arg1, arg2})
+	fake.recordInvocation("Connect", []interface{}{arg1, arg2})
+	fake.connectMutex.Unlock()
+	if fake.ConnectStub != nil {
+		return fake.ConnectStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.connectReturns.result1
+}
+
+func (fake *FakeManager) ConnectCallCount() int {
+	fake.connectMutex.RLock()
+	defer fake.connectMutex.RUnlock()
+	return len(fake.connectArgsForCall)
+}
+
+func (fake *FakeManager) ConnectArgsForCall(i int) (context.Context, *presence.Player) {
+	fake.connectMutex.RLock()
+	defer fake.connectMutex.RUnlock()
+	return fake.connectArgsForCall[i].arg1, fake.connectArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) ConnectReturns(result1 error) {
+	fake.ConnectStub = nil
+	fake.connectReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) ConnectReturnsOnCall(i int, result1 error) {
+	fake.ConnectStub = nil
+	if fake.connectReturnsOnCall == nil {
+		fake.connectReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.connectReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Disconnect(arg1 context.Context, arg2 *presence.Player) error {
+	fake.disconnectMutex.Lock()
+	ret, specificReturn := fake.disconnectReturnsOnCall[len(fake.disconnectArgsForCall)]
+	fake.disconnectArgsForCall = append(fake.disconnectArgsForCall, struct {
+		arg1 context.Context
+		arg2 *presence.Player
+	}{arg1, arg2})
+	fake.recordInvocation("Disconnect", []interface{}{arg1, arg2})
+	fake.disconnectMutex.Unlock()
+	if fake.DisconnectStub != nil {
+		return fake.DisconnectStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.disconnectReturns.result1
+}
+
+func (fake *FakeManager) DisconnectCallCount() int {
+	fake.disconnectMutex.RLock()
+	defer fake.disconnectMutex.RUnlock()
+	return len(fake.disconnectArgsForCall)
+}
+
+func (fake *FakeManager) DisconnectArgsForCall(i int) (context.Context, *presence.Player) {
+	fake.disconnectMutex.RLock()
+	defer fake.disconnectMutex.RUnlock()
+	return fake.disconnectArgsForCall[i].arg1, fake.disconnectArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) DisconnectReturns(result1 error) {
+	fake.DisconnectStub = nil
+	fake.disconnectReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) DisconnectReturnsOnCall(i int, result1 error) {
+	fake.DisconnectStub = nil
+	if fake.disconnectReturnsOnCall == nil {
+		fake.disconnectReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.disconnectReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Heartbeat(arg1 context.Context, arg2 *presence.Player) error {
+	fake.heartbeatMutex.Lock()
+	ret, specificReturn := fake.heartbeatReturnsOnCall[len(fake.heartbeatArgsForCall)]
+	fake.heartbeatArgsForCall = append(fake.heartbeatArgsForCall, struct {
+		arg1 context.Context
+		arg2 *presence.Player
+	}{arg1, arg2})
+	fake.recordInvocation("Heartbeat", []interface{}{arg1, arg2})
+	fake.heartbeatMutex.Unlock()
+	if fake.HeartbeatStub != nil {
+		return fake.HeartbeatStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.heartbeatReturns.result1
+}
+
+func (fake *FakeManager) HeartbeatCallCount() int {
+	fake.heartbeatMutex.RLock()
+	defer fake.heartbeatMutex.RUnlock()
+	return len(fake.heartbeatArgsForCall)
+}
+
+func (fake *FakeManager) HeartbeatArgsForCall(i int) (context.Context, *presence.Player) {
+	fake.heartbeatMutex.RLock()
+	defer fake.heartbeatMutex.RUnlock()
+	return fake.heartbeatArgsForCall[i].arg1, fake.heartbeatArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) HeartbeatReturns(result1 error) {
+	fake.HeartbeatStub = nil
+	fake.heartbeatReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) HeartbeatReturnsOnCall(i int, result1 error) {
+	fake.HeartbeatStub = nil
+	if fake.heartbeatReturnsOnCall == nil {
+		fake.heartbeatReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.heartbeatReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) GetOnline(arg1 context.Context) ([]*presence.Session, error) {
+	fake.getOnlineMutex.Lock()
+	ret, specificReturn := fake.getOnlineReturnsOnCall[len(fake.getOnlineArgsForCall)]
+	fake.getOnlineArgsForCall = append(fake.getOnlineArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("GetOnline", []interface{}{arg1})
+	fake.getOnlineMutex.Unlock()
+	if fake.GetOnlineStub != nil {
+		return fake.GetOnlineStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOnlineReturns.result1, fake.getOnlineReturns.result2
+}
+
+func (fake *FakeManager) GetOnlineCallCount() int {
+	fake.getOnlineMutex.RLock()
+	defer fake.getOnlineMutex.RUnlock()
+	return len(fake.getOnlineArgsForCall)
+}
+
+func (fake *FakeManager) GetOnlineArgsForCall(i int) context.Context {
+	fake.getOnlineMutex.RLock()
+	defer fake.getOnlineMutex.RUnlock()
+	return fake.getOnlineArgsForCall[i].arg1
+}
+
+func (fake *FakeManager) GetOnlineReturns(result1 []*presence.Session, result2 error) {
+	fake.GetOnlineStub = nil
+	fake.getOnlineReturns = struct {
+		result1 []*presence.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetOnlineReturnsOnCall(i int, result1 []*presence.Session, result2 error) {
+	fake.GetOnlineStub = nil
+	if fake.getOnlineReturnsOnCall == nil {
+		fake.getOnlineReturnsOnCall = make(map[int]struct {
+			result1 []*presence.Session
+			result2 error
+		})
+	}
+	fake.getOnlineReturnsOnCall[i] = struct {
+		result1 []*presence.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetSessions(arg1 context.Context, arg2 user.Identifier) ([]*presence.Session, error) {
+	fake.getSessionsMutex.Lock()
+	ret, specificReturn := fake.getSessionsReturnsOnCall[len(fake.getSessionsArgsForCall)]
+	fake.getSessionsArgsForCall = append(fake.getSessionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetSessions", []interface{}{arg1, arg2})
+	fake.getSessionsMutex.Unlock()
+	if fake.GetSessionsStub != nil {
+		return fake.GetSessionsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSessionsReturns.result1, fake.getSessionsReturns.result2
+}
+
+func (fake *FakeManager) GetSessionsCallCount() int {
+	fake.getSessionsMutex.RLock()
+	defer fake.getSessionsMutex.RUnlock()
+	return len(fake.getSessionsArgsForCall)
+}
+
+func (fake *FakeManager) GetSessionsArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getSessionsMutex.RLock()
+	defer fake.getSessionsMutex.RUnlock()
+	return fake.getSessionsArgsForCall[i].arg1, fake.getSessionsArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetSessionsReturns(result1 []*presence.Session, result2 error) {
+	fake.GetSessionsStub = nil
+	fake.getSessionsReturns = struct {
+		result1 []*presence.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetSessionsReturnsOnCall(i int, result1 []*presence.Session, result2 error) {
+	fake.GetSessionsStub = nil
+	if fake.getSessionsReturnsOnCall == nil {
+		fake.getSessionsReturnsOnCall = make(map[int]struct {
+			result1 []*presence.Session
+			result2 error
+		})
+	}
+	fake.getSessionsReturnsOnCall[i] = struct {
+		result1 []*presence.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetPlaytime(arg1 context.Context, arg2 user.Identifier) (*presence.Playtime, error) {
+	fake.getPlaytimeMutex.Lock()
+	ret, specificReturn := fake.getPlaytimeReturnsOnCall[len(fake.getPlaytimeArgsForCall)]
+	fake.getPlaytimeArgsForCall = append(fake.getPlaytimeArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetPlaytime", []interface{}{arg1, arg2})
+	fake.getPlaytimeMutex.Unlock()
+	if fake.GetPlaytimeStub != nil {
+		return fake.GetPlaytimeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getPlaytimeReturns.result1, fake.getPlaytimeReturns.result2
+}
+
+func (fake *FakeManager) GetPlaytimeCallCount() int {
+	fake.getPlaytimeMutex.RLock()
+	defer fake.getPlaytimeMutex.RUnlock()
+	return len(fake.getPlaytimeArgsForCall)
+}
+
+func (fake *FakeManager) GetPlaytimeArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getPlaytimeMutex.RLock()
+	defer fake.getPlaytimeMutex.RUnlock()
+	return fake.getPlaytimeArgsForCall[i].arg1, fake.getPlaytimeArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) GetPlaytimeReturns(result1 *presence.Playtime, result2 error) {
+	fake.GetPlaytimeStub = nil
+	fake.getPlaytimeReturns = struct {
+		result1 *presence.Playtime
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetPlaytimeReturnsOnCall(i int, result1 *presence.Playtime, result2 error) {
+	fake.GetPlaytimeStub = nil
+	if fake.getPlaytimeReturnsOnCall == nil {
+		fake.getPlaytimeReturnsOnCall = make(map[int]struct {
+			result1 *presence.Playtime
+			result2 error
+		})
+	}
+	fake.getPlaytimeReturnsOnCall[i] = struct {
+		result1 *presence.Playtime
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Expire(arg1 context.Context) error {
+	fake.expireMutex.Lock()
+	ret, specificReturn := fake.expireReturnsOnCall[len(fake.expireArgsForCall)]
+	fake.expireArgsForCall = append(fake.expireArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("Expire", []interface{}{arg1})
+	fake.expireMutex.Unlock()
+	if fake.ExpireStub != nil {
+		return fake.ExpireStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.expireReturns.result1
+}
+
+func (fake *FakeManager) ExpireCallCount() int {
+	fake.expireMutex.RLock()
+	defer fake.expireMutex.RUnlock()
+	return len(fake.expireArgsForCall)
+}
+
+func (fake *FakeManager) ExpireArgsForCall(i int) context.Context {
+	fake.expireMutex.RLock()
+	defer fake.expireMutex.RUnlock()
+	return fake.expireArgsForCall[i].arg1
+}
+
+func (fake *FakeManager) ExpireReturns(result1 error) {
+	fake.ExpireStub = nil
+	fake.expireReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) ExpireReturnsOnCall(i int, result1 error) {
+	fake.ExpireStub = nil
+	if fake.expireReturnsOnCall == nil {
+		fake.expireReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.expireReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.connectMutex.RLock()
+	defer fake.connectMutex.RUnlock()
+	fake.disconnectMutex.RLock()
+	defer fake.disconnectMutex.RUnlock()
+	fake.heartbeatMutex.RLock()
+	defer fake.heartbeatMutex.RUnlock()
+	fake.getOnlineMutex.RLock()
+	defer fake.getOnlineMutex.RUnlock()
+	fake.getSessionsMutex.RLock()
+	defer fake.getSessionsMutex.RUnlock()
+	fake.getPlaytimeMutex.RLock()
+	defer fake.getPlaytimeMutex.RUnlock()
+	fake.expireMutex.RLock()
+	defer fake.expireMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeManager) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ presence.Manager = new(FakeManager)
@@ -0,0 +1,566 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/presence"
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+type FakeRepository struct {
+	GetOpenStub        func(context.Context, user.Identifier) (*presence.Session, error)
+	getOpenMutex       sync.RWMutex
+	getOpenArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	getOpenReturns struct {
+		result1 *presence.Session
+		result2 error
+	}
+	getOpenReturnsOnCall map[int]struct {
+		result1 *presence.Session
+		result2 error
+	}
+	GetOnlineStub        func(context.Context) ([]*presence.Session, error)
+	getOnlineMutex       sync.RWMutex
+	getOnlineArgsForCall []struct {
+		arg1 context.Context
+	}
+	getOnlineReturns struct {
+		result1 []*presence.Session
+		result2 error
+	}
+	getOnlineReturnsOnCall map[int]struct {
+		result1 []*presence.Session
+		result2 error
+	}
+	GetSessionsStub        func(context.Context, user.Identifier) ([]*presence.Session, error)
+	getSessionsMutex       sync.RWMutex
+	getSessionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	getSessionsReturns struct {
+		result1 []*presence.Session
+		result2 error
+	}
+	getSessionsReturnsOnCall map[int]struct {
+		result1 []*presence.Session
+		result2 error
+	}
+	OpenStub        func(context.Context, *presence.Session) (*presence.Session, error)
+	openMutex       sync.RWMutex
+	openArgsForCall []struct {
+		arg1 context.Context
+		arg2 *presence.Session
+	}
+	openReturns struct {
+		result1 *presence.Session
+		result2 error
+	}
+	openReturnsOnCall map[int]struct {
+		result1 *presence.Session
+		result2 error
+	}
+	TouchStub        func(context.Context, string, time.Time) error
+	touchMutex       sync.RWMutex
+	touchArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+	}
+	touchReturns struct {
+		result1 error
+	}
+	touchReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CloseStub        func(context.Context, string, time.Time) error
+	closeMutex       sync.RWMutex
+	closeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+	}
+	closeReturns struct {
+		result1 error
+	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CloseStaleStub        func(context.Context, time.Time) error
+	closeStaleMutex       sync.RWMutex
+	closeStaleArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	closeStaleReturns struct {
+		result1 error
+	}
+	closeStaleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteByUserStub        func(context.Context, user.Identifier) error
+	deleteByUserMutex       sync.RWMutex
+	deleteByUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	deleteByUserReturns struct {
+		result1 error
+	}
+	deleteByUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRepository) GetOpen(arg1 context.Context, arg2 user.Identifier) (*presence.Session, error) {
+	fake.getOpenMutex.Lock()
+	ret, specificReturn := fake.getOpenReturnsOnCall[len(fake.getOpenArgsForCall)]
+	fake.getOpenArgsForCall = append(fake.getOpenArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetOpen", []interface{}{arg1, arg2})
+	fake.getOpenMutex.Unlock()
+	if fake.GetOpenStub != nil {
+		return fake.GetOpenStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOpenReturns.result1, fake.getOpenReturns.result2
+}
+
+func (fake *FakeRepository) GetOpenCallCount() int {
+	fake.getOpenMutex.RLock()
+	defer fake.getOpenMutex.RUnlock()
+	return len(fake.getOpenArgsForCall)
+}
+
+func (fake *FakeRepository) GetOpenArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getOpenMutex.RLock()
+	defer fake.getOpenMutex.RUnlock()
+	return fake.getOpenArgsForCall[i].arg1, fake.getOpenArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetOpenReturns(result1 *presence.Session, result2 error) {
+	fake.GetOpenStub = nil
+	fake.getOpenReturns = struct {
+		result1 *presence.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetOpenReturnsOnCall(i int, result1 *presence.Session, result2 error) {
+	fake.GetOpenStub = nil
+	if fake.getOpenReturnsOnCall == nil {
+		fake.getOpenReturnsOnCall = make(map[int]struct {
+			result1 *presence.Session
+			result2 error
+		})
+	}
+	fake.getOpenReturnsOnCall[i] = struct {
+		result1 *presence.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetOnline(arg1 context.Context) ([]*presence.Session, error) {
+	fake.getOnlineMutex.Lock()
+	ret, specificReturn := fake.getOnlineReturnsOnCall[len(fake.getOnlineArgsForCall)]
+	fake.getOnlineArgsForCall = append(fake.getOnlineArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("GetOnline", []interface{}{arg1})
+	fake.getOnlineMutex.Unlock()
+	if fake.GetOnlineStub != nil {
+		return fake.GetOnlineStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getOnlineReturns.result1, fake.getOnlineReturns.result2
+}
+
+func (fake *FakeRepository) GetOnlineCallCount() int {
+	fake.getOnlineMutex.RLock()
+	defer fake.getOnlineMutex.RUnlock()
+	return len(fake.getOnlineArgsForCall)
+}
+
+func (fake *FakeRepository) GetOnlineArgsForCall(i int) context.Context {
+	fake.getOnlineMutex.RLock()
+	defer fake.getOnlineMutex.RUnlock()
+	return fake.getOnlineArgsForCall[i].arg1
+}
+
+func (fake *FakeRepository) GetOnlineReturns(result1 []*presence.Session, result2 error) {
+	fake.GetOnlineStub = nil
+	fake.getOnlineReturns = struct {
+		result1 []*presence.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetOnlineReturnsOnCall(i int, result1 []*presence.Session, result2 error) {
+	fake.GetOnlineStub = nil
+	if fake.getOnlineReturnsOnCall == nil {
+		fake.getOnlineReturnsOnCall = make(map[int]struct {
+			result1 []*presence.Session
+			result2 error
+		})
+	}
+	fake.getOnlineReturnsOnCall[i] = struct {
+		result1 []*presence.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetSessions(arg1 context.Context, arg2 user.Identifier) ([]*presence.Session, error) {
+	fake.getSessionsMutex.Lock()
+	ret, specificReturn := fake.getSessionsReturnsOnCall[len(fake.getSessionsArgsForCall)]
+	fake.getSessionsArgsForCall = append(fake.getSessionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetSessions", []interface{}{arg1, arg2})
+	fake.getSessionsMutex.Unlock()
+	if fake.GetSessionsStub != nil {
+		return fake.GetSessionsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSessionsReturns.result1, fake.getSessionsReturns.result2
+}
+
+func (fake *FakeRepository) GetSessionsCallCount() int {
+	fake.getSessionsMutex.RLock()
+	defer fake.getSessionsMutex.RUnlock()
+	return len(fake.getSessionsArgsForCall)
+}
+
+func (fake *FakeRepository) GetSessionsArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getSessionsMutex.RLock()
+	defer fake.getSessionsMutex.RUnlock()
+	return fake.getSessionsArgsForCall[i].arg1, fake.getSessionsArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetSessionsReturns(result1 []*presence.Session, result2 error) {
+	fake.GetSessionsStub = nil
+	fake.getSessionsReturns = struct {
+		result1 []*presence.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetSessionsReturnsOnCall(i int, result1 []*presence.Session, result2 error) {
+	fake.GetSessionsStub = nil
+	if fake.getSessionsReturnsOnCall == nil {
+		fake.getSessionsReturnsOnCall = make(map[int]struct {
+			result1 []*presence.Session
+			result2 error
+		})
+	}
+	fake.getSessionsReturnsOnCall[i] = struct {
+		result1 []*presence.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) Open(arg1 context.Context, arg2 *presence.Session) (*presence.Session, error) {
+	fake.openMutex.Lock()
+	ret, specificReturn := fake.openReturnsOnCall[len(fake.openArgsForCall)]
+	fake.openArgsForCall = append(fake.openArgsForCall, struct {
+		arg1 context.Context
+		arg2 *presence.Session
+	}{arg1, arg2})
+	fake.recordInvocation("Open", []interface{}{arg1, arg2})
+	fake.openMutex.Unlock()
+	if fake.OpenStub != nil {
+		return fake.OpenStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.openReturns.result1, fake.openReturns.result2
+}
+
+func (fake *FakeRepository) OpenCallCount() int {
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	return len(fake.openArgsForCall)
+}
+
+func (fake *FakeRepository) OpenArgsForCall(i int) (context.Context, *presence.Session) {
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	return fake.openArgsForCall[i].arg1, fake.openArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) OpenReturns(result1 *presence.Session, result2 error) {
+	fake.OpenStub = nil
+	fake.openReturns = struct {
+		result1 *presence.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) OpenReturnsOnCall(i int, result1 *presence.Session, result2 error) {
+	fake.OpenStub = nil
+	if fake.openReturnsOnCall == nil {
+		fake.openReturnsOnCall = make(map[int]struct {
+			result1 *presence.Session
+			result2 error
+		})
+	}
+	fake.openReturnsOnCall[i] = struct {
+		result1 *presence.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) Touch(arg1 context.Context, arg2 string, arg3 time.Time) error {
+	fake.touchMutex.Lock()
+	ret, specificReturn := fake.touchReturnsOnCall[len(fake.touchArgsForCall)]
+	fake.touchArgsForCall = append(fake.touchArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Touch", []interface{}{arg1, arg2, arg3})
+	fake.touchMutex.Unlock()
+	if fake.TouchStub != nil {
+		return fake.TouchStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.touchReturns.result1
+}
+
+func (fake *FakeRepository) TouchCallCount() int {
+	fake.touchMutex.RLock()
+	defer fake.touchMutex.RUnlock()
+	return len(fake.touchArgsForCall)
+}
+
+func (fake *FakeRepository) TouchArgsForCall(i int) (context.Context, string, time.Time) {
+	fake.touchMutex.RLock()
+	defer fake.touchMutex.RUnlock()
+	return fake.touchArgsForCall[i].arg1, fake.touchArgsForCall[i].arg2, fake.touchArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) TouchReturns(result1 error) {
+	fake.TouchStub = nil
+	fake.touchReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) TouchReturnsOnCall(i int, result1 error) {
+	fake.TouchStub = nil
+	if fake.touchReturnsOnCall == nil {
+		fake.touchReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.touchReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) Close(arg1 context.Context, arg2 string, arg3 time.Time) error {
+	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Close", []interface{}{arg1, arg2, arg3})
+	fake.closeMutex.Unlock()
+	if fake.CloseStub != nil {
+		return fake.CloseStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.closeReturns.result1
+}
+
+func (fake *FakeRepository) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *FakeRepository) CloseArgsForCall(i int) (context.Context, string, time.Time) {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return fake.closeArgsForCall[i].arg1, fake.closeArgsForCall[i].arg2, fake.closeArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) CloseReturns(result1 error) {
+	fake.CloseStub = nil
+	fake.closeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) CloseReturnsOnCall(i int, result1 error) {
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) CloseStale(arg1 context.Context, arg2 time.Time) error {
+	fake.closeStaleMutex.Lock()
+	ret, specificReturn := fake.closeStaleReturnsOnCall[len(fake.closeStaleArgsForCall)]
+	fake.closeStaleArgsForCall = append(fake.closeStaleArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	fake.recordInvocation("CloseStale", []interface{}{arg1, arg2})
+	fake.closeStaleMutex.Unlock()
+	if fake.CloseStaleStub != nil {
+		return fake.CloseStaleStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.closeStaleReturns.result1
+}
+
+func (fake *FakeRepository) CloseStaleCallCount() int {
+	fake.closeStaleMutex.RLock()
+	defer fake.closeStaleMutex.RUnlock()
+	return len(fake.closeStaleArgsForCall)
+}
+
+func (fake *FakeRepository) CloseStaleArgsForCall(i int) (context.Context, time.Time) {
+	fake.closeStaleMutex.RLock()
+	defer fake.closeStaleMutex.RUnlock()
+	return fake.closeStaleArgsForCall[i].arg1, fake.closeStaleArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) CloseStaleReturns(result1 error) {
+	fake.CloseStaleStub = nil
+	fake.closeStaleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) CloseStaleReturnsOnCall(i int, result1 error) {
+	fake.CloseStaleStub = nil
+	if fake.closeStaleReturnsOnCall == nil {
+		fake.closeStaleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeStaleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeleteByUser(arg1 context.Context, arg2 user.Identifier) error {
+	fake.deleteByUserMutex.Lock()
+	ret, specificReturn := fake.deleteByUserReturnsOnCall[len(fake.deleteByUserArgsForCall)]
+	fake.deleteByUserArgsForCall = append(fake.deleteByUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteByUser", []interface{}{arg1, arg2})
+	fake.deleteByUserMutex.Unlock()
+	if fake.DeleteByUserStub != nil {
+		return fake.DeleteByUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteByUserReturns.result1
+}
+
+func (fake *FakeRepository) DeleteByUserCallCount() int {
+	fake.deleteByUserMutex.RLock()
+	defer fake.deleteByUserMutex.RUnlock()
+	return len(fake.deleteByUserArgsForCall)
+}
+
+func (fake *FakeRepository) DeleteByUserArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.deleteByUserMutex.RLock()
+	defer fake.deleteByUserMutex.RUnlock()
+	return fake.deleteByUserArgsForCall[i].arg1, fake.deleteByUserArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) DeleteByUserReturns(result1 error) {
+	fake.DeleteByUserStub = nil
+	fake.deleteByUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeleteByUserReturnsOnCall(i int, result1 error) {
+	fake.DeleteByUserStub = nil
+	if fake.deleteByUserReturnsOnCall == nil {
+		fake.deleteByUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteByUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getOpenMutex.RLock()
+	defer fake.getOpenMutex.RUnlock()
+	fake.getOnlineMutex.RLock()
+	defer fake.getOnlineMutex.RUnlock()
+	fake.getSessionsMutex.RLock()
+	defer fake.getSessionsMutex.RUnlock()
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	fake.touchMutex.RLock()
+	defer fake.touchMutex.RUnlock()
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	fake.closeStaleMutex.RLock()
+	defer fake.closeStaleMutex.RUnlock()
+	fake.deleteByUserMutex.RLock()
+	defer fake.deleteByUserMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ presence.Repository = new(FakeRepository)
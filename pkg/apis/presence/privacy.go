@@ -0,0 +1,32 @@
+package presence
+
+import "context"
+
+// PrivacyHandler exports and erases the sessions of users
+type PrivacyHandler struct {
+	repository Repository
+}
+
+// NewPrivacyHandler for the data of the presence service
+func NewPrivacyHandler(r Repository) *PrivacyHandler {
+	return &PrivacyHandler{r}
+}
+
+type privacyExport struct {
+	Sessions []*Session `json:"sessions"`
+}
+
+// Export the sessions of a user
+func (h *PrivacyHandler) Export(ctx context.Context, userUUID string) (interface{}, error) {
+	sessions, err := h.repository.GetSessions(ctx, &userIdentifier{userUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &privacyExport{sessions}, nil
+}
+
+// Erase the sessions of a user
+func (h *PrivacyHandler) Erase(ctx context.Context, userUUID string) error {
+	return h.repository.DeleteByUser(ctx, &userIdentifier{userUUID})
+}
@@ -0,0 +1,610 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: manager.proto
+
+package presence
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Player struct {
+	UserUUID             string   `protobuf:"bytes,1,opt,name=UserUUID,proto3" json:"UserUUID,omitempty"`
+	GameSerialHash       string   `protobuf:"bytes,2,opt,name=GameSerialHash,proto3" json:"GameSerialHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Player) Reset()         { *m = Player{} }
+func (m *Player) String() string { return proto.CompactTextString(m) }
+func (*Player) ProtoMessage()    {}
+func (*Player) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{0}
+}
+
+func (m *Player) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Player.Unmarshal(m, b)
+}
+func (m *Player) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Player.Marshal(b, m, deterministic)
+}
+func (m *Player) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Player.Merge(m, src)
+}
+func (m *Player) XXX_Size() int {
+	return xxx_messageInfo_Player.Size(m)
+}
+func (m *Player) XXX_DiscardUnknown() {
+	xxx_messageInfo_Player.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Player proto.InternalMessageInfo
+
+func (m *Player) GetUserUUID() string {
+	if m != nil {
+		return m.UserUUID
+	}
+	return ""
+}
+
+func (m *Player) GetGameSerialHash() string {
+	if m != nil {
+		return m.GameSerialHash
+	}
+	return ""
+}
+
+type UserRequest struct {
+	UserUUID             string   `protobuf:"bytes,1,opt,name=UserUUID,proto3" json:"UserUUID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UserRequest) Reset()         { *m = UserRequest{} }
+func (m *UserRequest) String() string { return proto.CompactTextString(m) }
+func (*UserRequest) ProtoMessage()    {}
+func (*UserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{1}
+}
+
+func (m *UserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserRequest.Unmarshal(m, b)
+}
+func (m *UserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserRequest.Marshal(b, m, deterministic)
+}
+func (m *UserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserRequest.Merge(m, src)
+}
+func (m *UserRequest) XXX_Size() int {
+	return xxx_messageInfo_UserRequest.Size(m)
+}
+func (m *UserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserRequest proto.InternalMessageInfo
+
+func (m *UserRequest) GetUserUUID() string {
+	if m != nil {
+		return m.UserUUID
+	}
+	return ""
+}
+
+type Session struct {
+	ID          string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserUUID    string `protobuf:"bytes,2,opt,name=UserUUID,proto3" json:"UserUUID,omitempty"`
+	ConnectedAt int64  `protobuf:"varint,3,opt,name=ConnectedAt,proto3" json:"ConnectedAt,omitempty"`
+	LastSeenAt  int64  `protobuf:"varint,4,opt,name=LastSeenAt,proto3" json:"LastSeenAt,omitempty"`
+	// DisconnectedAt is 0 for open sessions
+	DisconnectedAt       int64    `protobuf:"varint,5,opt,name=DisconnectedAt,proto3" json:"DisconnectedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Session) Reset()         { *m = Session{} }
+func (m *Session) String() string { return proto.CompactTextString(m) }
+func (*Session) ProtoMessage()    {}
+func (*Session) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{2}
+}
+
+func (m *Session) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Session.Unmarshal(m, b)
+}
+func (m *Session) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Session.Marshal(b, m, deterministic)
+}
+func (m *Session) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Session.Merge(m, src)
+}
+func (m *Session) XXX_Size() int {
+	return xxx_messageInfo_Session.Size(m)
+}
+func (m *Session) XXX_DiscardUnknown() {
+	xxx_messageInfo_Session.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Session proto.InternalMessageInfo
+
+func (m *Session) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Session) GetUserUUID() string {
+	if m != nil {
+		return m.UserUUID
+	}
+	return ""
+}
+
+func (m *Session) GetConnectedAt() int64 {
+	if m != nil {
+		return m.ConnectedAt
+	}
+	return 0
+}
+
+func (m *Session) GetLastSeenAt() int64 {
+	if m != nil {
+		return m.LastSeenAt
+	}
+	return 0
+}
+
+func (m *Session) GetDisconnectedAt() int64 {
+	if m != nil {
+		return m.DisconnectedAt
+	}
+	return 0
+}
+
+type Sessions struct {
+	Sessions             []*Session `protobuf:"bytes,1,rep,name=Sessions,proto3" json:"Sessions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *Sessions) Reset()         { *m = Sessions{} }
+func (m *Sessions) String() string { return proto.CompactTextString(m) }
+func (*Sessions) ProtoMessage()    {}
+func (*Sessions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{3}
+}
+
+func (m *Sessions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Sessions.Unmarshal(m, b)
+}
+func (m *Sessions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Sessions.Marshal(b, m, deterministic)
+}
+func (m *Sessions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sessions.Merge(m, src)
+}
+func (m *Sessions) XXX_Size() int {
+	return xxx_messageInfo_Sessions.Size(m)
+}
+func (m *Sessions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sessions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sessions proto.InternalMessageInfo
+
+func (m *Sessions) GetSessions() []*Session {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+type Playtime struct {
+	UserUUID             string   `protobuf:"bytes,1,opt,name=UserUUID,proto3" json:"UserUUID,omitempty"`
+	Online               bool     `protobuf:"varint,2,opt,name=Online,proto3" json:"Online,omitempty"`
+	Sessions             int64    `protobuf:"varint,3,opt,name=Sessions,proto3" json:"Sessions,omitempty"`
+	Seconds              int64    `protobuf:"varint,4,opt,name=Seconds,proto3" json:"Seconds,omitempty"`
+	FirstSeenAt          int64    `protobuf:"varint,5,opt,name=FirstSeenAt,proto3" json:"FirstSeenAt,omitempty"`
+	LastSeenAt           int64    `protobuf:"varint,6,opt,name=LastSeenAt,proto3" json:"LastSeenAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Playtime) Reset()         { *m = Playtime{} }
+func (m *Playtime) String() string { return proto.CompactTextString(m) }
+func (*Playtime) ProtoMessage()    {}
+func (*Playtime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{4}
+}
+
+func (m *Playtime) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Playtime.Unmarshal(m, b)
+}
+func (m *Playtime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Playtime.Marshal(b, m, deterministic)
+}
+func (m *Playtime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Playtime.Merge(m, src)
+}
+func (m *Playtime) XXX_Size() int {
+	return xxx_messageInfo_Playtime.Size(m)
+}
+func (m *Playtime) XXX_DiscardUnknown() {
+	xxx_messageInfo_Playtime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Playtime proto.InternalMessageInfo
+
+func (m *Playtime) GetUserUUID() string {
+	if m != nil {
+		return m.UserUUID
+	}
+	return ""
+}
+
+func (m *Playtime) GetOnline() bool {
+	if m != nil {
+		return m.Online
+	}
+	return false
+}
+
+func (m *Playtime) GetSessions() int64 {
+	if m != nil {
+		return m.Sessions
+	}
+	return 0
+}
+
+func (m *Playtime) GetSeconds() int64 {
+	if m != nil {
+		return m.Seconds
+	}
+	return 0
+}
+
+func (m *Playtime) GetFirstSeenAt() int64 {
+	if m != nil {
+		return m.FirstSeenAt
+	}
+	return 0
+}
+
+func (m *Playtime) GetLastSeenAt() int64 {
+	if m != nil {
+		return m.LastSeenAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Player)(nil), "presence.Player")
+	proto.RegisterType((*UserRequest)(nil), "presence.UserRequest")
+	proto.RegisterType((*Session)(nil), "presence.Session")
+	proto.RegisterType((*Sessions)(nil), "presence.Sessions")
+	proto.RegisterType((*Playtime)(nil), "presence.Playtime")
+}
+
+func init() { proto.RegisterFile("manager.proto", fileDescriptor_cde9ec64f0d2c859) }
+
+var fileDescriptor_cde9ec64f0d2c859 = []byte{
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xdb, 0x0a, 0xd3, 0x40,
+	0x14, 0x6c, 0x12, 0x4d, 0xd3, 0x13, 0x2c, 0xba, 0x60, 0x09, 0x11, 0x24, 0xe4, 0x41, 0xea, 0x83,
+	0x29, 0x54, 0x44, 0xeb, 0x5b, 0xb1, 0xb5, 0x2d, 0x54, 0x94, 0x94, 0x7e, 0xc0, 0x36, 0x3d, 0xd6,
+	0x40, 0xb2, 0x89, 0xbb, 0x5b, 0xb0, 0x5f, 0x23, 0xf8, 0x11, 0x7e, 0x9f, 0xe4, 0x1e, 0x23, 0xad,
+	0xf4, 0x2d, 0x67, 0x32, 0xb3, 0x7b, 0x66, 0x76, 0xe0, 0x51, 0x4c, 0x19, 0x3d, 0x21, 0xf7, 0x52,
+	0x9e, 0xc8, 0x84, 0x18, 0x29, 0x47, 0x81, 0x2c, 0x40, 0xfb, 0xd9, 0x29, 0x49, 0x4e, 0x11, 0x4e,
+	0x72, 0xfc, 0x70, 0xfe, 0x3a, 0xc1, 0x38, 0x95, 0x97, 0x82, 0xe6, 0x6e, 0x41, 0xff, 0x12, 0xd1,
+	0x0b, 0x72, 0x62, 0x83, 0xb1, 0x17, 0xc8, 0xf7, 0xfb, 0xcd, 0xc2, 0x52, 0x1c, 0x65, 0x3c, 0xf0,
+	0xeb, 0x99, 0xbc, 0x80, 0xe1, 0x8a, 0xc6, 0xb8, 0x43, 0x1e, 0xd2, 0x68, 0x4d, 0xc5, 0x37, 0x4b,
+	0xcd, 0x19, 0x1d, 0xd4, 0x7d, 0x09, 0x66, 0xa6, 0xf1, 0xf1, 0xfb, 0x19, 0x85, 0xbc, 0x75, 0xa4,
+	0xfb, 0x53, 0x81, 0xfe, 0x0e, 0x85, 0x08, 0x13, 0x46, 0x86, 0xa0, 0xd6, 0x0c, 0x75, 0xb3, 0xf8,
+	0x4b, 0xa7, 0x76, 0x56, 0x71, 0xc0, 0xfc, 0x90, 0x30, 0x86, 0x81, 0xc4, 0xe3, 0x5c, 0x5a, 0x9a,
+	0xa3, 0x8c, 0x35, 0xbf, 0x0d, 0x91, 0xe7, 0x00, 0x5b, 0x2a, 0xe4, 0x0e, 0x91, 0xcd, 0xa5, 0xf5,
+	0x20, 0x27, 0xb4, 0x90, 0xcc, 0xcc, 0x22, 0x14, 0x41, 0xeb, 0x90, 0x87, 0x39, 0xa7, 0x83, 0xba,
+	0x33, 0x30, 0xca, 0x05, 0x05, 0x79, 0xd5, 0x7c, 0x5b, 0x8a, 0xa3, 0x8d, 0xcd, 0xe9, 0x13, 0xaf,
+	0x0a, 0xd8, 0x2b, 0xff, 0xf8, 0x35, 0xc5, 0xfd, 0xad, 0x80, 0x91, 0xc5, 0x2a, 0xc3, 0x18, 0x6f,
+	0x06, 0x3b, 0x02, 0xfd, 0x33, 0x8b, 0x42, 0x86, 0xb9, 0x4f, 0xc3, 0x2f, 0xa7, 0x4c, 0x53, 0xdf,
+	0x57, 0x58, 0x6c, 0x76, 0xb1, 0xb2, 0xe0, 0x82, 0x84, 0x1d, 0x45, 0x69, 0xae, 0x1a, 0xb3, 0x6c,
+	0x3e, 0x86, 0xbc, 0xb6, 0x5e, 0xd8, 0x6a, 0x43, 0x9d, 0x6c, 0xf4, 0x6e, 0x36, 0xd3, 0x5f, 0x1a,
+	0xf4, 0x3f, 0x15, 0x3d, 0x22, 0x6f, 0xa0, 0x5f, 0xc6, 0x4a, 0x1e, 0x37, 0x66, 0x8b, 0xb6, 0xd8,
+	0x23, 0xaf, 0x68, 0x95, 0x57, 0xb5, 0xca, 0x5b, 0x66, 0xad, 0x72, 0x7b, 0xe4, 0x1d, 0x40, 0x13,
+	0xe4, 0x5d, 0xca, 0xb7, 0x30, 0x58, 0x23, 0xe5, 0xf2, 0x80, 0xf4, 0x3e, 0xe1, 0x0c, 0x06, 0x2b,
+	0x94, 0x65, 0x74, 0x57, 0x68, 0x36, 0xf9, 0xe7, 0xc1, 0x84, 0xdb, 0x23, 0xef, 0xc1, 0x5c, 0xa1,
+	0xac, 0xb3, 0x7d, 0xda, 0x90, 0x5a, 0x45, 0xbe, 0xa9, 0xad, 0xdf, 0xf9, 0xff, 0xda, 0x8a, 0x9a,
+	0x6b, 0xf5, 0xe5, 0x8f, 0x34, 0xe4, 0xd7, 0xf7, 0xbd, 0x6a, 0xf7, 0xa0, 0xe7, 0xc8, 0xeb, 0x3f,
+	0x03, 0x00, 0xe5, 0x8f, 0x39, 0x6b, 0xf2, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ManagerClient is the client API for Manager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ManagerClient interface {
+	Connect(ctx context.Context, in *Player, opts ...grpc.CallOption) (*empty.Empty, error)
+	Disconnect(ctx context.Context, in *Player, opts ...grpc.CallOption) (*empty.Empty, error)
+	Heartbeat(ctx context.Context, in *Player, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetOnline(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Sessions, error)
+	GetSessions(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Sessions, error)
+	GetPlaytime(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Playtime, error)
+	Expire(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type managerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewManagerClient(cc *grpc.ClientConn) ManagerClient {
+	return &managerClient{cc}
+}
+
+func (c *managerClient) Connect(ctx context.Context, in *Player, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/presence.Manager/Connect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Disconnect(ctx context.Context, in *Player, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/presence.Manager/Disconnect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Heartbeat(ctx context.Context, in *Player, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/presence.Manager/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetOnline(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Sessions, error) {
+	out := new(Sessions)
+	err := c.cc.Invoke(ctx, "/presence.Manager/GetOnline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetSessions(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Sessions, error) {
+	out := new(Sessions)
+	err := c.cc.Invoke(ctx, "/presence.Manager/GetSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetPlaytime(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Playtime, error) {
+	out := new(Playtime)
+	err := c.cc.Invoke(ctx, "/presence.Manager/GetPlaytime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) Expire(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/presence.Manager/Expire", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManagerServer is the server API for Manager service.
+type ManagerServer interface {
+	Connect(context.Context, *Player) (*empty.Empty, error)
+	Disconnect(context.Context, *Player) (*empty.Empty, error)
+	Heartbeat(context.Context, *Player) (*empty.Empty, error)
+	GetOnline(context.Context, *empty.Empty) (*Sessions, error)
+	GetSessions(context.Context, *UserRequest) (*Sessions, error)
+	GetPlaytime(context.Context, *UserRequest) (*Playtime, error)
+	Expire(context.Context, *empty.Empty) (*empty.Empty, error)
+}
+
+func RegisterManagerServer(s *grpc.Server, srv ManagerServer) {
+	s.RegisterService(&_Manager_serviceDesc, srv)
+}
+
+func _Manager_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Player)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Connect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/presence.Manager/Connect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Connect(ctx, req.(*Player))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Disconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Player)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Disconnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/presence.Manager/Disconnect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Disconnect(ctx, req.(*Player))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Player)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/presence.Manager/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Heartbeat(ctx, req.(*Player))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetOnline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetOnline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/presence.Manager/GetOnline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetOnline(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/presence.Manager/GetSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetSessions(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetPlaytime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetPlaytime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/presence.Manager/GetPlaytime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetPlaytime(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_Expire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Expire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/presence.Manager/Expire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Expire(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Manager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "presence.Manager",
+	HandlerType: (*ManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Connect",
+			Handler:    _Manager_Connect_Handler,
+		},
+		{
+			MethodName: "Disconnect",
+			Handler:    _Manager_Disconnect_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Manager_Heartbeat_Handler,
+		},
+		{
+			MethodName: "GetOnline",
+			Handler:    _Manager_GetOnline_Handler,
+		},
+		{
+			MethodName: "GetSessions",
+			Handler:    _Manager_GetSessions_Handler,
+		},
+		{
+			MethodName: "GetPlaytime",
+			Handler:    _Manager_GetPlaytime_Handler,
+		},
+		{
+			MethodName: "Expire",
+			Handler:    _Manager_Expire_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "manager.proto",
+}
@@ -0,0 +1,35 @@
+package presence
+
+//go:generate counterfeiter -o ./mocks/repository.go . Repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+// ErrSessionOpen is returned by a repository if the user has an open session already
+var ErrSessionOpen = errors.New("session open")
+
+// Repository to manage the storage of the sessions of users
+type Repository interface {
+	// GetOpen returns the session of a user without a disconnection
+	GetOpen(context.Context, user.Identifier) (*Session, error)
+	// GetOnline returns all open sessions ordered by their connection
+	GetOnline(context.Context) ([]*Session, error)
+	// GetSessions returns the sessions of a user ordered by their connection
+	GetSessions(context.Context, user.Identifier) ([]*Session, error)
+	// Open stores a new session with a new id.
+	// A user has at most one open session, see ErrSessionOpen.
+	Open(context.Context, *Session) (*Session, error)
+	// Touch sets the time an open session was last seen at
+	Touch(context.Context, string, time.Time) error
+	// Close an open session at the time of the disconnection
+	Close(context.Context, string, time.Time) error
+	// CloseStale closes the open sessions last seen before a time.
+	// The sessions are closed at the time they were last seen at.
+	CloseStale(context.Context, time.Time) error
+	DeleteByUser(context.Context, user.Identifier) error
+}
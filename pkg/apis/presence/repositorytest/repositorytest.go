@@ -0,0 +1,261 @@
+// Package repositorytest contains the conformance tests of presence repositories
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/presence"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) presence.Repository) {
+	t.Run("Open", func(t *testing.T) {
+		testOpen(t, newRepository(t))
+	})
+	t.Run("GetOnline", func(t *testing.T) {
+		testGetOnline(t, newRepository(t))
+	})
+	t.Run("TouchAndClose", func(t *testing.T) {
+		testTouchAndClose(t, newRepository(t))
+	})
+	t.Run("CloseStale", func(t *testing.T) {
+		testCloseStale(t, newRepository(t))
+	})
+	t.Run("DeleteByUser", func(t *testing.T) {
+		testDeleteByUser(t, newRepository(t))
+	})
+}
+
+type userIdentifier string
+
+func (i userIdentifier) UUID() string {
+	return string(i)
+}
+
+func randomUUID(t *testing.T) string {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return rand.String()
+}
+
+var connectedAt = time.Date(2018, time.June, 1, 20, 0, 0, 0, time.UTC)
+
+// open a session of a user, later offsets are connected later
+func open(t *testing.T, r presence.Repository, userUUID string, offset int) *presence.Session {
+	at := connectedAt.Add(time.Duration(offset) * time.Minute)
+	s, err := r.Open(context.Background(), &presence.Session{
+		UserUUID:    userUUID,
+		ConnectedAt: at,
+		LastSeenAt:  at,
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return s
+}
+
+func testOpen(t *testing.T, r presence.Repository) {
+	ctx := context.Background()
+
+	owner := userIdentifier(randomUUID(t))
+
+	if _, err := r.GetOpen(ctx, owner); err != sql.ErrNoRows {
+		t.Fatal("a user without sessions should have no open session")
+	}
+
+	first := open(t, r, owner.UUID(), 0)
+
+	if _, err := r.Open(ctx, &presence.Session{
+		UserUUID:    owner.UUID(),
+		ConnectedAt: connectedAt,
+		LastSeenAt:  connectedAt,
+	}); err != presence.ErrSessionOpen {
+		t.Fatal("a user should not have a second open session")
+	}
+
+	if err := r.Close(ctx, first.ID, connectedAt); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	second := open(t, r, owner.UUID(), 1)
+
+	if first.ID == "" || first.ID == second.ID {
+		t.Fatal("the opened sessions should have distinct ids")
+	}
+
+	s, err := r.GetOpen(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if s.ID != second.ID {
+		t.Fatal("the open session should be returned")
+	}
+
+	sessions, err := r.GetSessions(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(sessions) != 2 || sessions[0].ID != first.ID || sessions[1].ID != second.ID {
+		t.Fatal("the sessions of the user should be ordered by their connection")
+	}
+
+	if sessions[1].UserUUID != owner.UUID() ||
+		!sessions[1].ConnectedAt.Equal(connectedAt.Add(time.Minute)) ||
+		!sessions[1].LastSeenAt.Equal(connectedAt.Add(time.Minute)) ||
+		sessions[1].DisconnectedAt != nil {
+		t.Fatal("the stored data is not equal")
+	}
+}
+
+func testGetOnline(t *testing.T, r presence.Repository) {
+	ctx := context.Background()
+
+	sessions, err := r.GetOnline(ctx)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(sessions) != 0 {
+		t.Fatal("there should be no session online")
+	}
+
+	second := open(t, r, randomUUID(t), 1)
+	first := open(t, r, randomUUID(t), 0)
+	closed := open(t, r, randomUUID(t), 2)
+
+	if err := r.Close(ctx, closed.ID, connectedAt.Add(time.Hour)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	sessions, err = r.GetOnline(ctx)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(sessions) != 2 || sessions[0].ID != first.ID || sessions[1].ID != second.ID {
+		t.Fatal("the open sessions should be ordered by their connection")
+	}
+}
+
+func testTouchAndClose(t *testing.T, r presence.Repository) {
+	ctx := context.Background()
+
+	owner := userIdentifier(randomUUID(t))
+	s := open(t, r, owner.UUID(), 0)
+
+	if err := r.Touch(ctx, s.ID, connectedAt.Add(time.Minute)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Close(ctx, s.ID, connectedAt.Add(2*time.Minute)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Touch(ctx, s.ID, connectedAt.Add(3*time.Minute)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Close(ctx, s.ID, connectedAt.Add(4*time.Minute)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.GetOpen(ctx, owner); err != sql.ErrNoRows {
+		t.Fatal("a closed session should not be open")
+	}
+
+	sessions, err := r.GetSessions(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(sessions) != 1 ||
+		!sessions[0].LastSeenAt.Equal(connectedAt.Add(time.Minute)) ||
+		sessions[0].DisconnectedAt == nil ||
+		!sessions[0].DisconnectedAt.Equal(connectedAt.Add(2*time.Minute)) {
+		t.Fatal("a closed session should not change anymore")
+	}
+}
+
+func testCloseStale(t *testing.T, r presence.Repository) {
+	ctx := context.Background()
+
+	stale := userIdentifier(randomUUID(t))
+	alive := userIdentifier(randomUUID(t))
+
+	open(t, r, stale.UUID(), 0)
+	s := open(t, r, alive.UUID(), 0)
+
+	if err := r.Touch(ctx, s.ID, connectedAt.Add(time.Hour)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.CloseStale(ctx, connectedAt.Add(time.Minute)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	sessions, err := r.GetSessions(ctx, stale)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(sessions) != 1 || sessions[0].DisconnectedAt == nil || !sessions[0].DisconnectedAt.Equal(connectedAt) {
+		t.Fatal("a stale session should be closed at the time it was last seen at")
+	}
+
+	if _, err := r.GetOpen(ctx, alive); err != nil {
+		t.Fatal("a session seen recently should be kept open")
+	}
+}
+
+func testDeleteByUser(t *testing.T, r presence.Repository) {
+	ctx := context.Background()
+
+	owner := userIdentifier(randomUUID(t))
+	other := userIdentifier(randomUUID(t))
+
+	if err := r.DeleteByUser(ctx, owner); err != nil {
+		t.Fatal("deleting the sessions of an unknown user should not fail")
+	}
+
+	closed := open(t, r, owner.UUID(), 0)
+	if err := r.Close(ctx, closed.ID, connectedAt); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	open(t, r, owner.UUID(), 1)
+	open(t, r, other.UUID(), 0)
+
+	if err := r.DeleteByUser(ctx, owner); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	sessions, err := r.GetSessions(ctx, owner)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(sessions) != 0 {
+		t.Fatal("the sessions of the user should be deleted")
+	}
+
+	sessions, err = r.GetSessions(ctx, other)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(sessions) != 1 {
+		t.Fatal("the sessions of other users should be kept")
+	}
+}
@@ -0,0 +1,16 @@
+package presence
+
+import "github.com/51st-state/api/pkg/rbac"
+
+// rules enforced by the presence service.
+// Users may always get their own playtime.
+const (
+	ruleOnline rbac.Rule = "presence.online"
+	ruleGet    rbac.Rule = "presence.get"
+)
+
+// Rules enforced by the presence service
+var Rules = rbac.RuleCatalog{
+	{Rule: ruleOnline, Description: "List the users online on the game server", Service: "presence"},
+	{Rule: ruleGet, Description: "Get the sessions and the playtime of any user", Service: "presence"},
+}
@@ -0,0 +1,66 @@
+package presence
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"net/http"
+
+	"github.com/51st-state/api/pkg/api/endpoint"
+	"github.com/51st-state/api/pkg/encode"
+	"github.com/51st-state/api/pkg/rbac"
+	rbacMiddleware "github.com/51st-state/api/pkg/rbac/middleware"
+	"github.com/51st-state/api/pkg/token"
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+)
+
+var errNoUserToken = errors.New("the token does not belong to a user")
+
+// MakeGetOnlineEndpoint creates a http endpoint to retrieve the sessions of the users online
+func MakeGetOnlineEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetOnline(ctx)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleOnline)).
+		HandlerFunc(l)
+}
+
+// MakeGetOwnPlaytimeEndpoint creates a http endpoint to retrieve the playtime of the user of the token
+func MakeGetOwnPlaytimeEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		tok, err := token.FromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if tok.Data().User == nil || tok.Data().User.Type != "user" {
+			return nil, errNoUserToken
+		}
+
+		return m.GetPlaytime(ctx, &userIdentifier{tok.Data().User.ID})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeGetPlaytimeEndpoint creates a http endpoint to retrieve the playtime of any user
+func MakeGetPlaytimeEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetPlaytime(ctx, &userIdentifier{chi.URLParam(r, "uuid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleGet)).
+		HandlerFunc(l)
+}
+
+// MakeGetSessionsEndpoint creates a http endpoint to retrieve the sessions of any user
+func MakeGetSessionsEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetSessions(ctx, &userIdentifier{chi.URLParam(r, "uuid")})
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleGet)).
+		HandlerFunc(l)
+}
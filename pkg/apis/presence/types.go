@@ -0,0 +1,39 @@
+package presence
+
+import "time"
+
+// Player reported by the game server. The game serial hash is resolved
+// to the user if the uuid is unknown to the game server.
+type Player struct {
+	UserUUID       string `json:"user_uuid"`
+	GameSerialHash string `json:"game_serial_hash"`
+}
+
+// Session of a user on the game server. A session without a disconnection is open.
+type Session struct {
+	ID          string    `json:"id"`
+	UserUUID    string    `json:"user_uuid"`
+	ConnectedAt time.Time `json:"connected_at"`
+	// LastSeenAt is the time of the last heartbeat
+	LastSeenAt     time.Time  `json:"last_seen_at"`
+	DisconnectedAt *time.Time `json:"disconnected_at,omitempty"`
+}
+
+// Playtime statistics of a user
+type Playtime struct {
+	UserUUID string `json:"user_uuid"`
+	Online   bool   `json:"online"`
+	Sessions int    `json:"sessions"`
+	// Seconds played in all sessions, an online session counts until now
+	Seconds     int64      `json:"seconds"`
+	FirstSeenAt *time.Time `json:"first_seen_at,omitempty"`
+	LastSeenAt  *time.Time `json:"last_seen_at,omitempty"`
+}
+
+type userIdentifier struct {
+	uuid string
+}
+
+func (i *userIdentifier) UUID() string {
+	return i.uuid
+}
@@ -0,0 +1,80 @@
+package privacy
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/51st-state/api/pkg/encode"
+)
+
+// Archive of the data exported by a job
+type Archive struct {
+	Job *Job
+}
+
+// write the archive as zip file containing the job
+// and a json file with the data of every service
+func (a *Archive) write(w *zip.Writer) error {
+	job := *a.Job
+	job.Reports = make([]*Report, 0, len(a.Job.Reports))
+	for _, v := range a.Job.Reports {
+		r := *v
+		r.Data = nil
+		job.Reports = append(job.Reports, &r)
+	}
+
+	if err := writeJSON(w, "job.json", &job); err != nil {
+		return err
+	}
+
+	for _, v := range a.Job.Services {
+		r := a.Job.report(v)
+		if r == nil {
+			continue
+		}
+
+		if err := writeJSON(w, fmt.Sprintf("%s.json", v), r.Data); err != nil {
+			return err
+		}
+	}
+
+	return w.Close()
+}
+
+func writeJSON(w *zip.Writer, name string, v interface{}) error {
+	f, err := w.Create(name)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+type archiveEncoder struct {
+	encode.Encoder
+}
+
+// NewArchiveEncoder encodes archives as zip files and everything else,
+// like the problems of failed requests, as json
+func NewArchiveEncoder() encode.Encoder {
+	return &archiveEncoder{
+		encode.NewJSONEncoder(),
+	}
+}
+
+func (e *archiveEncoder) Encode(w http.ResponseWriter, v interface{}) error {
+	a, ok := v.(*Archive)
+	if !ok {
+		return e.Encoder.Encode(w, v)
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"privacy-%s.zip\"", a.Job.ID))
+
+	return a.write(zip.NewWriter(w))
+}
@@ -0,0 +1,229 @@
+package cockroachdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/privacy"
+)
+
+// CreateSchema for the cockroachdb repository
+func CreateSchema(ctx context.Context, db *sql.DB) (err error) {
+	_, err = db.ExecContext(
+		ctx,
+		`CREATE TABLE IF NOT EXISTS privacy_jobs (
+            id UUID PRIMARY KEY,
+            userId UUID NOT NULL,
+            kind text NOT NULL,
+            services JSONB NOT NULL DEFAULT '[]',
+            createdAt TIMESTAMPTZ NOT NULL
+        );
+        CREATE INDEX IF NOT EXISTS privacy_jobs_idx_userId ON privacy_jobs (userId);
+
+        CREATE TABLE IF NOT EXISTS privacy_reports (
+            jobId UUID NOT NULL,
+            service text NOT NULL,
+            data JSONB NULL,
+            reportedAt TIMESTAMPTZ NOT NULL,
+            PRIMARY KEY (jobId, service)
+        );`,
+	)
+	return
+}
+
+type repository struct {
+	database *sql.DB
+}
+
+// NewRepository for privacy jobs in cockroachdb
+func NewRepository(db *sql.DB) privacy.Repository {
+	return &repository{db}
+}
+
+func (r *repository) AddJob(ctx context.Context, job *privacy.Job) error {
+	services, err := json.Marshal(append(make([]string, 0), job.Services...))
+	if err != nil {
+		return err
+	}
+
+	_, err = r.database.ExecContext(
+		ctx,
+		`INSERT INTO privacy_jobs (
+            id,
+            userId,
+            kind,
+            services,
+            createdAt
+        ) VALUES (
+            $1,
+            $2,
+            $3,
+            $4,
+            $5
+        )
+        ON CONFLICT
+        DO NOTHING`,
+		job.ID,
+		job.UserUUID,
+		job.Kind,
+		services,
+		job.CreatedAt,
+	)
+	return err
+}
+
+type scanner interface {
+	Scan(...interface{}) error
+}
+
+func scanJob(row scanner) (*privacy.Job, error) {
+	job := &privacy.Job{}
+	var services []byte
+
+	if err := row.Scan(
+		&job.ID,
+		&job.UserUUID,
+		&job.Kind,
+		&services,
+		&job.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return job, json.Unmarshal(services, &job.Services)
+}
+
+func (r *repository) getReports(ctx context.Context, job *privacy.Job) error {
+	rows, err := r.database.QueryContext(
+		ctx,
+		`SELECT jobId,
+        service,
+        data,
+        reportedAt
+        FROM privacy_reports
+        WHERE jobId = $1
+        ORDER BY service`,
+		job.ID,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	job.Reports = make([]*privacy.Report, 0)
+	for rows.Next() {
+		report := &privacy.Report{}
+		var data []byte
+		if err := rows.Scan(
+			&report.JobID,
+			&report.Service,
+			&data,
+			&report.ReportedAt,
+		); err != nil {
+			return err
+		}
+
+		report.Data = data
+		job.Reports = append(job.Reports, report)
+	}
+
+	return rows.Err()
+}
+
+func (r *repository) GetJob(ctx context.Context, id string) (*privacy.Job, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, sql.ErrNoRows
+	}
+
+	job, err := scanJob(r.database.QueryRowContext(
+		ctx,
+		`SELECT id,
+        userId,
+        kind,
+        services,
+        createdAt
+        FROM privacy_jobs
+        WHERE id = $1`,
+		id,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return job, r.getReports(ctx, job)
+}
+
+func (r *repository) GetJobs(ctx context.Context, userUUID string) ([]*privacy.Job, error) {
+	if _, err := uuid.Parse(userUUID); err != nil {
+		return make([]*privacy.Job, 0), nil
+	}
+
+	rows, err := r.database.QueryContext(
+		ctx,
+		`SELECT id,
+        userId,
+        kind,
+        services,
+        createdAt
+        FROM privacy_jobs
+        WHERE userId = $1
+        ORDER BY createdAt, id`,
+		userUUID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	jobs := make([]*privacy.Job, 0)
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, job)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, v := range jobs {
+		if err := r.getReports(ctx, v); err != nil {
+			return nil, err
+		}
+	}
+
+	return jobs, nil
+}
+
+func (r *repository) AddReport(ctx context.Context, report *privacy.Report) error {
+	var data interface{}
+	if report.Data != nil {
+		data = []byte(report.Data)
+	}
+
+	_, err := r.database.ExecContext(
+		ctx,
+		`UPSERT INTO privacy_reports (
+            jobId,
+            service,
+            data,
+            reportedAt
+        ) VALUES (
+            $1,
+            $2,
+            $3,
+            $4
+        )`,
+		report.JobID,
+		report.Service,
+		data,
+		report.ReportedAt,
+	)
+	return err
+}
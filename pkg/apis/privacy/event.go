@@ -0,0 +1,30 @@
+package privacy
+
+import (
+	"github.com/51st-state/api/pkg/event"
+)
+
+// Request of a job sent to the services
+type Request struct {
+	JobID    string `json:"job_id"`
+	UserUUID string `json:"user_uuid"`
+}
+
+// ExportRequestedEventID of a privacy job
+const ExportRequestedEventID event.ID = "privacy_export_requested"
+
+// ExportRequestedEvent of a privacy job
+type ExportRequestedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data *Request           `json:"data"`
+}
+
+// ReportedEventID of a service.
+// Produced by every service after it exported or erased the data of a user.
+const ReportedEventID event.ID = "privacy_reported"
+
+// ReportedEvent of a service
+type ReportedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data *Report            `json:"data"`
+}
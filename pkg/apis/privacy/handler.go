@@ -0,0 +1,92 @@
+package privacy
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/event"
+)
+
+// Handler of the personal data a service stores about users
+//go:generate counterfeiter -o ./mocks/handler.go . Handler
+type Handler interface {
+	// Export returns the data of a user, nil if there is none
+	Export(ctx context.Context, userUUID string) (interface{}, error)
+	// Erase deletes or anonymises the data of a user
+	Erase(ctx context.Context, userUUID string) error
+}
+
+// NewEventHandler runs the handler of a service on privacy requests and deleted users
+// and reports back once the data of the user is exported or erased.
+// Erasure jobs are identified by the event of the deleted user.
+func NewEventHandler(service string, h Handler, prod *event.Producer) event.HandlerFunc {
+	return func(ctx context.Context, e *event.Event) error {
+		switch e.Meta.ID {
+		case ExportRequestedEventID:
+			var req ExportRequestedEvent
+			if err := json.Unmarshal(e.Payload, &req); err != nil {
+				return err
+			}
+
+			data, err := h.Export(ctx, req.Data.UserUUID)
+			if err != nil {
+				return err
+			}
+
+			b, err := json.Marshal(data)
+			if err != nil {
+				return err
+			}
+
+			return report(ctx, prod, &Report{
+				JobID:   req.Data.JobID,
+				Service: service,
+				Data:    b,
+			})
+		case user.DeletedEventID:
+			userUUID, err := deletedUserUUID(e)
+			if err != nil || userUUID == "" {
+				return err
+			}
+
+			if err := h.Erase(ctx, userUUID); err != nil {
+				return err
+			}
+
+			return report(ctx, prod, &Report{
+				JobID:   e.Meta.UUID,
+				Service: service,
+			})
+		}
+
+		return nil
+	}
+}
+
+// deletedUserUUID returns the uuid of a deleted user. It is empty for
+// events produced before the uuid was part of the payload.
+func deletedUserUUID(e *event.Event) (string, error) {
+	var deleted struct {
+		Data struct {
+			UUID string `json:"uuid"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(e.Payload, &deleted); err != nil {
+		return "", err
+	}
+
+	return deleted.Data.UUID, nil
+}
+
+func report(ctx context.Context, prod *event.Producer, r *Report) error {
+	r.ReportedAt = time.Now()
+
+	return prod.Produce(ctx, ReportedEventID, &ReportedEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		r,
+	})
+}
@@ -0,0 +1,146 @@
+package privacy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/problems"
+)
+
+// Manager of privacy jobs
+//go:generate counterfeiter -o ./mocks/manager.go . Manager
+type Manager interface {
+	RequestExport(ctx context.Context, userUUID string) (*Job, error)
+	GetJob(ctx context.Context, id string) (*Job, error)
+	GetJobs(ctx context.Context, userUUID string) ([]*Job, error)
+	GetArchive(ctx context.Context, id string) (*Archive, error)
+	// HandleEvent creates the erasure jobs of deleted users and
+	// stores the reports of the services
+	HandleEvent(ctx context.Context, e *event.Event) error
+}
+
+type manager struct {
+	repository Repository
+	event      *event.Producer
+	services   []string
+}
+
+// NewManager of privacy jobs. Every job waits for the reports of the given services.
+func NewManager(r Repository, prod *event.Producer, services []string) Manager {
+	return &manager{
+		r,
+		prod,
+		services,
+	}
+}
+
+var (
+	errInvalidUUID   = errors.New("invalid user uuid given")
+	errNoExport      = problems.New("no export", "only export jobs have an archive", http.StatusBadRequest)
+	errJobIncomplete = problems.New("job incomplete", "not every service has exported its data yet", http.StatusConflict)
+)
+
+// RequestExport of the data of a user. The archive is available
+// as soon as every service has exported its data.
+func (m *manager) RequestExport(ctx context.Context, userUUID string) (*Job, error) {
+	if userUUID == "" {
+		return nil, errInvalidUUID
+	}
+
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	job := &Job{
+		ID:        rand.String(),
+		UserUUID:  userUUID,
+		Kind:      KindExport,
+		Services:  m.services,
+		Reports:   make([]*Report, 0),
+		CreatedAt: time.Now(),
+	}
+
+	if err := m.repository.AddJob(ctx, job); err != nil {
+		return nil, err
+	}
+
+	return job, m.event.Produce(ctx, ExportRequestedEventID, &ExportRequestedEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		&Request{
+			JobID:    job.ID,
+			UserUUID: userUUID,
+		},
+	})
+}
+
+// GetJob including the reports of the services
+func (m *manager) GetJob(ctx context.Context, id string) (*Job, error) {
+	return m.repository.GetJob(ctx, id)
+}
+
+// GetJobs of a user
+func (m *manager) GetJobs(ctx context.Context, userUUID string) ([]*Job, error) {
+	if userUUID == "" {
+		return nil, errInvalidUUID
+	}
+
+	return m.repository.GetJobs(ctx, userUUID)
+}
+
+// GetArchive of a complete export job
+func (m *manager) GetArchive(ctx context.Context, id string) (*Archive, error) {
+	job, err := m.repository.GetJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Kind != KindExport {
+		return nil, errNoExport
+	}
+
+	if !job.Complete() {
+		return nil, errJobIncomplete
+	}
+
+	return &Archive{job}, nil
+}
+
+// HandleEvent creates the erasure jobs of deleted users and
+// stores the reports of the services
+func (m *manager) HandleEvent(ctx context.Context, e *event.Event) error {
+	switch e.Meta.ID {
+	case user.DeletedEventID:
+		userUUID, err := deletedUserUUID(e)
+		if err != nil || userUUID == "" {
+			return err
+		}
+
+		return m.repository.AddJob(ctx, &Job{
+			ID:        e.Meta.UUID,
+			UserUUID:  userUUID,
+			Kind:      KindErasure,
+			Services:  m.services,
+			Reports:   make([]*Report, 0),
+			CreatedAt: e.Meta.CreatedAt,
+		})
+	case ReportedEventID:
+		var reported ReportedEvent
+		if err := json.Unmarshal(e.Payload, &reported); err != nil {
+			return err
+		}
+
+		return m.repository.AddReport(ctx, reported.Data)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package privacy_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/privacy"
+	"github.com/51st-state/api/pkg/apis/privacy/memory"
+	"github.com/51st-state/api/pkg/apis/privacy/mocks"
+	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/event"
+	pubsubMocks "github.com/51st-state/api/pkg/pubsub/mocks"
+)
+
+const userUUID = "6b3c1e2a-0c7e-4a4e-9a3b-2a9d0f1c5e11"
+
+// produced returns the last event of a producer
+func produced(t *testing.T, p *pubsubMocks.FakeProducer) *event.Event {
+	if p.ProduceCallCount() == 0 {
+		t.Fatal("an event should be produced")
+	}
+
+	_, b := p.ProduceArgsForCall(p.ProduceCallCount() - 1)
+	e, err := event.Decode(b)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	return e
+}
+
+func deletedEvent(id string) *event.Event {
+	return &event.Event{
+		Meta: &event.Meta{
+			UUID: "2f0a6c9e-7a3d-4b6e-8c1f-5d2e9b0a4c77",
+			ID:   user.DeletedEventID,
+		},
+		Payload: []byte(`{"meta":{"version":"1"},"data":{"uuid":"` + id + `"}}`),
+	}
+}
+
+func TestManagerExport(t *testing.T) {
+	ctx := context.Background()
+	producer := &pubsubMocks.FakeProducer{}
+	m := privacy.NewManager(memory.NewRepository(), event.NewProducer(producer), []string{"user", "auth"})
+
+	if _, err := m.RequestExport(ctx, ""); err == nil {
+		t.Fatal("the given uuid is empty")
+	}
+
+	job, err := m.RequestExport(ctx, userUUID)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if job.Kind != privacy.KindExport || job.UserUUID != userUUID || job.Complete() {
+		t.Fatal("the export should wait for the services")
+	}
+
+	requested := produced(t, producer)
+	if requested.Meta.ID != privacy.ExportRequestedEventID {
+		t.Fatal("an export requested event should be produced")
+	}
+
+	userHandler := &mocks.FakeHandler{}
+	userHandler.ExportReturns(map[string]string{"name": "test"}, nil)
+	authHandler := &mocks.FakeHandler{}
+
+	reports := &pubsubMocks.FakeProducer{}
+	if err := privacy.NewEventHandler("user", userHandler, event.NewProducer(reports))(ctx, requested); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, id := userHandler.ExportArgsForCall(0); id != userUUID {
+		t.Fatal("the data of the user should be exported")
+	}
+
+	if err := m.HandleEvent(ctx, produced(t, reports)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := m.GetArchive(ctx, job.ID); err == nil {
+		t.Fatal("the archive is not complete yet")
+	}
+
+	if err := privacy.NewEventHandler("auth", authHandler, event.NewProducer(reports))(ctx, requested); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := m.HandleEvent(ctx, produced(t, reports)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	archive, err := m.GetArchive(ctx, job.ID)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	w := httptest.NewRecorder()
+	if err := privacy.NewArchiveEncoder().Encode(w, archive); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if w.Header().Get("Content-Type") != "application/zip" {
+		t.Fatal("the archive should be a zip file")
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(r.File) != 3 || r.File[0].Name != "job.json" || r.File[1].Name != "user.json" || r.File[2].Name != "auth.json" {
+		t.Fatal("the archive should contain the job and the data of every service")
+	}
+
+	f, err := r.File[1].Open()
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+	defer f.Close()
+
+	var data map[string]string
+	if err := json.NewDecoder(f).Decode(&data); err != nil || data["name"] != "test" {
+		t.Fatal("the exported data of the service is not equal")
+	}
+}
+
+func TestManagerErasure(t *testing.T) {
+	ctx := context.Background()
+	m := privacy.NewManager(memory.NewRepository(), event.NewProducer(&pubsubMocks.FakeProducer{}), []string{"user", "auth"})
+
+	deleted := deletedEvent(userUUID)
+	reports := &pubsubMocks.FakeProducer{}
+	for _, v := range []string{"user", "auth"} {
+		h := &mocks.FakeHandler{}
+		if err := privacy.NewEventHandler(v, h, event.NewProducer(reports))(ctx, deleted); err != nil {
+			t.Fatal("there should be no error")
+		}
+
+		if _, id := h.EraseArgsForCall(0); h.EraseCallCount() != 1 || id != userUUID {
+			t.Fatal("the data of the deleted user should be erased")
+		}
+
+		// the reports may arrive before the job is created
+		if err := m.HandleEvent(ctx, produced(t, reports)); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	if err := m.HandleEvent(ctx, deleted); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	jobs, err := m.GetJobs(ctx, userUUID)
+	if err != nil || len(jobs) != 1 {
+		t.Fatal("an erasure job should be created for the deleted user")
+	}
+
+	if jobs[0].ID != deleted.Meta.UUID || jobs[0].Kind != privacy.KindErasure || !jobs[0].Complete() {
+		t.Fatal("every service should have erased the data of the user")
+	}
+
+	if _, err := m.GetArchive(ctx, jobs[0].ID); err == nil {
+		t.Fatal("an erasure job has no archive")
+	}
+
+	h := &mocks.FakeHandler{}
+	if err := privacy.NewEventHandler("user", h, event.NewProducer(reports))(ctx, deletedEvent("")); err != nil || h.EraseCallCount() != 0 {
+		t.Fatal("a deleted event without uuid should be ignored")
+	}
+}
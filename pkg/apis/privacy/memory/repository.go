@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/privacy"
+)
+
+type repository struct {
+	mutex sync.RWMutex
+	jobs  map[string]*privacy.Job
+	// reports by job id and service
+	reports map[string]map[string]*privacy.Report
+}
+
+// NewRepository for privacy jobs in memory
+func NewRepository() privacy.Repository {
+	return &repository{
+		jobs:    make(map[string]*privacy.Job),
+		reports: make(map[string]map[string]*privacy.Report),
+	}
+}
+
+func copyReport(r *privacy.Report) *privacy.Report {
+	c := *r
+	c.Data = append([]byte(nil), r.Data...)
+	return &c
+}
+
+// get a copy of a job including its reports ordered by service
+func (r *repository) get(id string) *privacy.Job {
+	job := *r.jobs[id]
+	job.Services = append(make([]string, 0), job.Services...)
+	job.Reports = make([]*privacy.Report, 0)
+	for _, v := range r.reports[id] {
+		job.Reports = append(job.Reports, copyReport(v))
+	}
+
+	sort.Slice(job.Reports, func(i, j int) bool {
+		return job.Reports[i].Service < job.Reports[j].Service
+	})
+
+	return &job
+}
+
+func (r *repository) AddJob(ctx context.Context, job *privacy.Job) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.jobs[job.ID]; ok {
+		return nil
+	}
+
+	j := *job
+	j.Services = append(make([]string, 0), job.Services...)
+	j.Reports = nil
+	r.jobs[job.ID] = &j
+
+	return nil
+}
+
+func (r *repository) GetJob(ctx context.Context, id string) (*privacy.Job, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if _, ok := r.jobs[id]; !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return r.get(id), nil
+}
+
+func (r *repository) GetJobs(ctx context.Context, userUUID string) ([]*privacy.Job, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	jobs := make([]*privacy.Job, 0)
+	for id, v := range r.jobs {
+		if v.UserUUID == userUUID {
+			jobs = append(jobs, r.get(id))
+		}
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		if jobs[i].CreatedAt.Equal(jobs[j].CreatedAt) {
+			return jobs[i].ID < jobs[j].ID
+		}
+
+		return jobs[i].CreatedAt.Before(jobs[j].CreatedAt)
+	})
+
+	return jobs, nil
+}
+
+func (r *repository) AddReport(ctx context.Context, report *privacy.Report) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.reports[report.JobID] == nil {
+		r.reports[report.JobID] = make(map[string]*privacy.Report)
+	}
+	r.reports[report.JobID][report.Service] = copyReport(report)
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/privacy"
+	"github.com/51st-state/api/pkg/apis/privacy/memory"
+	"github.com/51st-state/api/pkg/apis/privacy/repositorytest"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) privacy.Repository {
+		return memory.NewRepository()
+	})
+}
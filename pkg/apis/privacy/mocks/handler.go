@@ -0,0 +1,169 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/privacy"
+)
+
+type FakeHandler struct {
+	ExportStub        func(ctx context.Context, userUUID string) (interface{}, error)
+	exportMutex       sync.RWMutex
+	exportArgsForCall []struct {
+		ctx      context.Context
+		userUUID string
+	}
+	exportReturns struct {
+		result1 interface{}
+		result2 error
+	}
+	exportReturnsOnCall map[int]struct {
+		result1 interface{}
+		result2 error
+	}
+	EraseStub        func(ctx context.Context, userUUID string) error
+	eraseMutex       sync.RWMutex
+	eraseArgsForCall []struct {
+		ctx      context.Context
+		userUUID string
+	}
+	eraseReturns struct {
+		result1 error
+	}
+	eraseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeHandler) Export(ctx context.Context, userUUID string) (interface{}, error) {
+	fake.exportMutex.Lock()
+	ret, specificReturn := fake.exportReturnsOnCall[len(fake.exportArgsForCall)]
+	fake.exportArgsForCall = append(fake.exportArgsForCall, struct {
+		ctx      context.Context
+		userUUID string
+	}{ctx, userUUID})
+	fake.recordInvocation("Export", []interface{}{ctx, userUUID})
+	fake.exportMutex.Unlock()
+	if fake.ExportStub != nil {
+		return fake.ExportStub(ctx, userUUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.exportReturns.result1, fake.exportReturns.result2
+}
+
+func (fake *FakeHandler) ExportCallCount() int {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return len(fake.exportArgsForCall)
+}
+
+func (fake *FakeHandler) ExportArgsForCall(i int) (context.Context, string) {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return fake.exportArgsForCall[i].ctx, fake.exportArgsForCall[i].userUUID
+}
+
+func (fake *FakeHandler) ExportReturns(result1 interface{}, result2 error) {
+	fake.ExportStub = nil
+	fake.exportReturns = struct {
+		result1 interface{}
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeHandler) ExportReturnsOnCall(i int, result1 interface{}, result2 error) {
+	fake.ExportStub = nil
+	if fake.exportReturnsOnCall == nil {
+		fake.exportReturnsOnCall = make(map[int]struct {
+			result1 interface{}
+			result2 error
+		})
+	}
+	fake.exportReturnsOnCall[i] = struct {
+		result1 interface{}
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeHandler) Erase(ctx context.Context, userUUID string) error {
+	fake.eraseMutex.Lock()
+	ret, specificReturn := fake.eraseReturnsOnCall[len(fake.eraseArgsForCall)]
+	fake.eraseArgsForCall = append(fake.eraseArgsForCall, struct {
+		ctx      context.Context
+		userUUID string
+	}{ctx, userUUID})
+	fake.recordInvocation("Erase", []interface{}{ctx, userUUID})
+	fake.eraseMutex.Unlock()
+	if fake.EraseStub != nil {
+		return fake.EraseStub(ctx, userUUID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.eraseReturns.result1
+}
+
+func (fake *FakeHandler) EraseCallCount() int {
+	fake.eraseMutex.RLock()
+	defer fake.eraseMutex.RUnlock()
+	return len(fake.eraseArgsForCall)
+}
+
+func (fake *FakeHandler) EraseArgsForCall(i int) (context.Context, string) {
+	fake.eraseMutex.RLock()
+	defer fake.eraseMutex.RUnlock()
+	return fake.eraseArgsForCall[i].ctx, fake.eraseArgsForCall[i].userUUID
+}
+
+func (fake *FakeHandler) EraseReturns(result1 error) {
+	fake.EraseStub = nil
+	fake.eraseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandler) EraseReturnsOnCall(i int, result1 error) {
+	fake.EraseStub = nil
+	if fake.eraseReturnsOnCall == nil {
+		fake.eraseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.eraseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandler) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	fake.eraseMutex.RLock()
+	defer fake.eraseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeHandler) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ privacy.Handler = new(FakeHandler)
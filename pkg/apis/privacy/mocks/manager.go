@@ -0,0 +1,374 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/privacy"
+	"github.com/51st-state/api/pkg/event"
+)
+
+type FakeManager struct {
+	RequestExportStub        func(ctx context.Context, userUUID string) (*privacy.Job, error)
+	requestExportMutex       sync.RWMutex
+	requestExportArgsForCall []struct {
+		ctx      context.Context
+		userUUID string
+	}
+	requestExportReturns struct {
+		result1 *privacy.Job
+		result2 error
+	}
+	requestExportReturnsOnCall map[int]struct {
+		result1 *privacy.Job
+		result2 error
+	}
+	GetJobStub        func(ctx context.Context, id string) (*privacy.Job, error)
+	getJobMutex       sync.RWMutex
+	getJobArgsForCall []struct {
+		ctx context.Context
+		id  string
+	}
+	getJobReturns struct {
+		result1 *privacy.Job
+		result2 error
+	}
+	getJobReturnsOnCall map[int]struct {
+		result1 *privacy.Job
+		result2 error
+	}
+	GetJobsStub        func(ctx context.Context, userUUID string) ([]*privacy.Job, error)
+	getJobsMutex       sync.RWMutex
+	getJobsArgsForCall []struct {
+		ctx      context.Context
+		userUUID string
+	}
+	getJobsReturns struct {
+		result1 []*privacy.Job
+		result2 error
+	}
+	getJobsReturnsOnCall map[int]struct {
+		result1 []*privacy.Job
+		result2 error
+	}
+	GetArchiveStub        func(ctx context.Context, id string) (*privacy.Archive, error)
+	getArchiveMutex       sync.RWMutex
+	getArchiveArgsForCall []struct {
+		ctx context.Context
+		id  string
+	}
+	getArchiveReturns struct {
+		result1 *privacy.Archive
+		result2 error
+	}
+	getArchiveReturnsOnCall map[int]struct {
+		result1 *privacy.Archive
+		result2 error
+	}
+	HandleEventStub        func(ctx context.Context, e *event.Event) error
+	handleEventMutex       sync.RWMutex
+	handleEventArgsForCall []struct {
+		ctx context.Context
+		e   *event.Event
+	}
+	handleEventReturns struct {
+		result1 error
+	}
+	handleEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeManager) RequestExport(ctx context.Context, userUUID string) (*privacy.Job, error) {
+	fake.requestExportMutex.Lock()
+	ret, specificReturn := fake.requestExportReturnsOnCall[len(fake.requestExportArgsForCall)]
+	fake.requestExportArgsForCall = append(fake.requestExportArgsForCall, struct {
+		ctx      context.Context
+		userUUID string
+	}{ctx, userUUID})
+	fake.recordInvocation("RequestExport", []interface{}{ctx, userUUID})
+	fake.requestExportMutex.Unlock()
+	if fake.RequestExportStub != nil {
+		return fake.RequestExportStub(ctx, userUUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.requestExportReturns.result1, fake.requestExportReturns.result2
+}
+
+func (fake *FakeManager) RequestExportCallCount() int {
+	fake.requestExportMutex.RLock()
+	defer fake.requestExportMutex.RUnlock()
+	return len(fake.requestExportArgsForCall)
+}
+
+func (fake *FakeManager) RequestExportArgsForCall(i int) (context.Context, string) {
+	fake.requestExportMutex.RLock()
+	defer fake.requestExportMutex.RUnlock()
+	return fake.requestExportArgsForCall[i].ctx, fake.requestExportArgsForCall[i].userUUID
+}
+
+func (fake *FakeManager) RequestExportReturns(result1 *privacy.Job, result2 error) {
+	fake.RequestExportStub = nil
+	fake.requestExportReturns = struct {
+		result1 *privacy.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) RequestExportReturnsOnCall(i int, result1 *privacy.Job, result2 error) {
+	fake.RequestExportStub = nil
+	if fake.requestExportReturnsOnCall == nil {
+		fake.requestExportReturnsOnCall = make(map[int]struct {
+			result1 *privacy.Job
+			result2 error
+		})
+	}
+	fake.requestExportReturnsOnCall[i] = struct {
+		result1 *privacy.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetJob(ctx context.Context, id string) (*privacy.Job, error) {
+	fake.getJobMutex.Lock()
+	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
+	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
+		ctx context.Context
+		id  string
+	}{ctx, id})
+	fake.recordInvocation("GetJob", []interface{}{ctx, id})
+	fake.getJobMutex.Unlock()
+	if fake.GetJobStub != nil {
+		return fake.GetJobStub(ctx, id)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getJobReturns.result1, fake.getJobReturns.result2
+}
+
+func (fake *FakeManager) GetJobCallCount() int {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return len(fake.getJobArgsForCall)
+}
+
+func (fake *FakeManager) GetJobArgsForCall(i int) (context.Context, string) {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return fake.getJobArgsForCall[i].ctx, fake.getJobArgsForCall[i].id
+}
+
+func (fake *FakeManager) GetJobReturns(result1 *privacy.Job, result2 error) {
+	fake.GetJobStub = nil
+	fake.getJobReturns = struct {
+		result1 *privacy.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetJobReturnsOnCall(i int, result1 *privacy.Job, result2 error) {
+	fake.GetJobStub = nil
+	if fake.getJobReturnsOnCall == nil {
+		fake.getJobReturnsOnCall = make(map[int]struct {
+			result1 *privacy.Job
+			result2 error
+		})
+	}
+	fake.getJobReturnsOnCall[i] = struct {
+		result1 *privacy.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetJobs(ctx context.Context, userUUID string) ([]*privacy.Job, error) {
+	fake.getJobsMutex.Lock()
+	ret, specificReturn := fake.getJobsReturnsOnCall[len(fake.getJobsArgsForCall)]
+	fake.getJobsArgsForCall = append(fake.getJobsArgsForCall, struct {
+		ctx      context.Context
+		userUUID string
+	}{ctx, userUUID})
+	fake.recordInvocation("GetJobs", []interface{}{ctx, userUUID})
+	fake.getJobsMutex.Unlock()
+	if fake.GetJobsStub != nil {
+		return fake.GetJobsStub(ctx, userUUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getJobsReturns.result1, fake.getJobsReturns.result2
+}
+
+func (fake *FakeManager) GetJobsCallCount() int {
+	fake.getJobsMutex.RLock()
+	defer fake.getJobsMutex.RUnlock()
+	return len(fake.getJobsArgsForCall)
+}
+
+func (fake *FakeManager) GetJobsArgsForCall(i int) (context.Context, string) {
+	fake.getJobsMutex.RLock()
+	defer fake.getJobsMutex.RUnlock()
+	return fake.getJobsArgsForCall[i].ctx, fake.getJobsArgsForCall[i].userUUID
+}
+
+func (fake *FakeManager) GetJobsReturns(result1 []*privacy.Job, result2 error) {
+	fake.GetJobsStub = nil
+	fake.getJobsReturns = struct {
+		result1 []*privacy.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetJobsReturnsOnCall(i int, result1 []*privacy.Job, result2 error) {
+	fake.GetJobsStub = nil
+	if fake.getJobsReturnsOnCall == nil {
+		fake.getJobsReturnsOnCall = make(map[int]struct {
+			result1 []*privacy.Job
+			result2 error
+		})
+	}
+	fake.getJobsReturnsOnCall[i] = struct {
+		result1 []*privacy.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetArchive(ctx context.Context, id string) (*privacy.Archive, error) {
+	fake.getArchiveMutex.Lock()
+	ret, specificReturn := fake.getArchiveReturnsOnCall[len(fake.getArchiveArgsForCall)]
+	fake.getArchiveArgsForCall = append(fake.getArchiveArgsForCall, struct {
+		ctx context.Context
+		id  string
+	}{ctx, id})
+	fake.recordInvocation("GetArchive", []interface{}{ctx, id})
+	fake.getArchiveMutex.Unlock()
+	if fake.GetArchiveStub != nil {
+		return fake.GetArchiveStub(ctx, id)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getArchiveReturns.result1, fake.getArchiveReturns.result2
+}
+
+func (fake *FakeManager) GetArchiveCallCount() int {
+	fake.getArchiveMutex.RLock()
+	defer fake.getArchiveMutex.RUnlock()
+	return len(fake.getArchiveArgsForCall)
+}
+
+func (fake *FakeManager) GetArchiveArgsForCall(i int) (context.Context, string) {
+	fake.getArchiveMutex.RLock()
+	defer fake.getArchiveMutex.RUnlock()
+	return fake.getArchiveArgsForCall[i].ctx, fake.getArchiveArgsForCall[i].id
+}
+
+func (fake *FakeManager) GetArchiveReturns(result1 *privacy.Archive, result2 error) {
+	fake.GetArchiveStub = nil
+	fake.getArchiveReturns = struct {
+		result1 *privacy.Archive
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetArchiveReturnsOnCall(i int, result1 *privacy.Archive, result2 error) {
+	fake.GetArchiveStub = nil
+	if fake.getArchiveReturnsOnCall == nil {
+		fake.getArchiveReturnsOnCall = make(map[int]struct {
+			result1 *privacy.Archive
+			result2 error
+		})
+	}
+	fake.getArchiveReturnsOnCall[i] = struct {
+		result1 *privacy.Archive
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) HandleEvent(ctx context.Context, e *event.Event) error {
+	fake.handleEventMutex.Lock()
+	ret, specificReturn := fake.handleEventReturnsOnCall[len(fake.handleEventArgsForCall)]
+	fake.handleEventArgsForCall = append(fake.handleEventArgsForCall, struct {
+		ctx context.Context
+		e   *event.Event
+	}{ctx, e})
+	fake.recordInvocation("HandleEvent", []interface{}{ctx, e})
+	fake.handleEventMutex.Unlock()
+	if fake.HandleEventStub != nil {
+		return fake.HandleEventStub(ctx, e)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.handleEventReturns.result1
+}
+
+func (fake *FakeManager) HandleEventCallCount() int {
+	fake.handleEventMutex.RLock()
+	defer fake.handleEventMutex.RUnlock()
+	return len(fake.handleEventArgsForCall)
+}
+
+func (fake *FakeManager) HandleEventArgsForCall(i int) (context.Context, *event.Event) {
+	fake.handleEventMutex.RLock()
+	defer fake.handleEventMutex.RUnlock()
+	return fake.handleEventArgsForCall[i].ctx, fake.handleEventArgsForCall[i].e
+}
+
+func (fake *FakeManager) HandleEventReturns(result1 error) {
+	fake.HandleEventStub = nil
+	fake.handleEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) HandleEventReturnsOnCall(i int, result1 error) {
+	fake.HandleEventStub = nil
+	if fake.handleEventReturnsOnCall == nil {
+		fake.handleEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.handleEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.requestExportMutex.RLock()
+	defer fake.requestExportMutex.RUnlock()
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	fake.getJobsMutex.RLock()
+	defer fake.getJobsMutex.RUnlock()
+	fake.getArchiveMutex.RLock()
+	defer fake.getArchiveMutex.RUnlock()
+	fake.handleEventMutex.RLock()
+	defer fake.handleEventMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeManager) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ privacy.Manager = new(FakeManager)
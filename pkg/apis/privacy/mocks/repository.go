@@ -0,0 +1,300 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/privacy"
+)
+
+type FakeRepository struct {
+	AddJobStub        func(context.Context, *privacy.Job) error
+	addJobMutex       sync.RWMutex
+	addJobArgsForCall []struct {
+		arg1 context.Context
+		arg2 *privacy.Job
+	}
+	addJobReturns struct {
+		result1 error
+	}
+	addJobReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetJobStub        func(ctx context.Context, id string) (*privacy.Job, error)
+	getJobMutex       sync.RWMutex
+	getJobArgsForCall []struct {
+		ctx context.Context
+		id  string
+	}
+	getJobReturns struct {
+		result1 *privacy.Job
+		result2 error
+	}
+	getJobReturnsOnCall map[int]struct {
+		result1 *privacy.Job
+		result2 error
+	}
+	GetJobsStub        func(ctx context.Context, userUUID string) ([]*privacy.Job, error)
+	getJobsMutex       sync.RWMutex
+	getJobsArgsForCall []struct {
+		ctx      context.Context
+		userUUID string
+	}
+	getJobsReturns struct {
+		result1 []*privacy.Job
+		result2 error
+	}
+	getJobsReturnsOnCall map[int]struct {
+		result1 []*privacy.Job
+		result2 error
+	}
+	AddReportStub        func(context.Context, *privacy.Report) error
+	addReportMutex       sync.RWMutex
+	addReportArgsForCall []struct {
+		arg1 context.Context
+		arg2 *privacy.Report
+	}
+	addReportReturns struct {
+		result1 error
+	}
+	addReportReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRepository) AddJob(arg1 context.Context, arg2 *privacy.Job) error {
+	fake.addJobMutex.Lock()
+	ret, specificReturn := fake.addJobReturnsOnCall[len(fake.addJobArgsForCall)]
+	fake.addJobArgsForCall = append(fake.addJobArgsForCall, struct {
+		arg1 context.Context
+		arg2 *privacy.Job
+	}{arg1, arg2})
+	fake.recordInvocation("AddJob", []interface{}{arg1, arg2})
+	fake.addJobMutex.Unlock()
+	if fake.AddJobStub != nil {
+		return fake.AddJobStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.addJobReturns.result1
+}
+
+func (fake *FakeRepository) AddJobCallCount() int {
+	fake.addJobMutex.RLock()
+	defer fake.addJobMutex.RUnlock()
+	return len(fake.addJobArgsForCall)
+}
+
+func (fake *FakeRepository) AddJobArgsForCall(i int) (context.Context, *privacy.Job) {
+	fake.addJobMutex.RLock()
+	defer fake.addJobMutex.RUnlock()
+	return fake.addJobArgsForCall[i].arg1, fake.addJobArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) AddJobReturns(result1 error) {
+	fake.AddJobStub = nil
+	fake.addJobReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) AddJobReturnsOnCall(i int, result1 error) {
+	fake.AddJobStub = nil
+	if fake.addJobReturnsOnCall == nil {
+		fake.addJobReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addJobReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetJob(ctx context.Context, id string) (*privacy.Job, error) {
+	fake.getJobMutex.Lock()
+	ret, specificReturn := fake.getJobReturnsOnCall[len(fake.getJobArgsForCall)]
+	fake.getJobArgsForCall = append(fake.getJobArgsForCall, struct {
+		ctx context.Context
+		id  string
+	}{ctx, id})
+	fake.recordInvocation("GetJob", []interface{}{ctx, id})
+	fake.getJobMutex.Unlock()
+	if fake.GetJobStub != nil {
+		return fake.GetJobStub(ctx, id)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getJobReturns.result1, fake.getJobReturns.result2
+}
+
+func (fake *FakeRepository) GetJobCallCount() int {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return len(fake.getJobArgsForCall)
+}
+
+func (fake *FakeRepository) GetJobArgsForCall(i int) (context.Context, string) {
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	return fake.getJobArgsForCall[i].ctx, fake.getJobArgsForCall[i].id
+}
+
+func (fake *FakeRepository) GetJobReturns(result1 *privacy.Job, result2 error) {
+	fake.GetJobStub = nil
+	fake.getJobReturns = struct {
+		result1 *privacy.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetJobReturnsOnCall(i int, result1 *privacy.Job, result2 error) {
+	fake.GetJobStub = nil
+	if fake.getJobReturnsOnCall == nil {
+		fake.getJobReturnsOnCall = make(map[int]struct {
+			result1 *privacy.Job
+			result2 error
+		})
+	}
+	fake.getJobReturnsOnCall[i] = struct {
+		result1 *privacy.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetJobs(ctx context.Context, userUUID string) ([]*privacy.Job, error) {
+	fake.getJobsMutex.Lock()
+	ret, specificReturn := fake.getJobsReturnsOnCall[len(fake.getJobsArgsForCall)]
+	fake.getJobsArgsForCall = append(fake.getJobsArgsForCall, struct {
+		ctx      context.Context
+		userUUID string
+	}{ctx, userUUID})
+	fake.recordInvocation("GetJobs", []interface{}{ctx, userUUID})
+	fake.getJobsMutex.Unlock()
+	if fake.GetJobsStub != nil {
+		return fake.GetJobsStub(ctx, userUUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getJobsReturns.result1, fake.getJobsReturns.result2
+}
+
+func (fake *FakeRepository) GetJobsCallCount() int {
+	fake.getJobsMutex.RLock()
+	defer fake.getJobsMutex.RUnlock()
+	return len(fake.getJobsArgsForCall)
+}
+
+func (fake *FakeRepository) GetJobsArgsForCall(i int) (context.Context, string) {
+	fake.getJobsMutex.RLock()
+	defer fake.getJobsMutex.RUnlock()
+	return fake.getJobsArgsForCall[i].ctx, fake.getJobsArgsForCall[i].userUUID
+}
+
+func (fake *FakeRepository) GetJobsReturns(result1 []*privacy.Job, result2 error) {
+	fake.GetJobsStub = nil
+	fake.getJobsReturns = struct {
+		result1 []*privacy.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetJobsReturnsOnCall(i int, result1 []*privacy.Job, result2 error) {
+	fake.GetJobsStub = nil
+	if fake.getJobsReturnsOnCall == nil {
+		fake.getJobsReturnsOnCall = make(map[int]struct {
+			result1 []*privacy.Job
+			result2 error
+		})
+	}
+	fake.getJobsReturnsOnCall[i] = struct {
+		result1 []*privacy.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) AddReport(arg1 context.Context, arg2 *privacy.Report) error {
+	fake.addReportMutex.Lock()
+	ret, specificReturn := fake.addReportReturnsOnCall[len(fake.addReportArgsForCall)]
+	fake.addReportArgsForCall = append(fake.addReportArgsForCall, struct {
+		arg1 context.Context
+		arg2 *privacy.Report
+	}{arg1, arg2})
+	fake.recordInvocation("AddReport", []interface{}{arg1, arg2})
+	fake.addReportMutex.Unlock()
+	if fake.AddReportStub != nil {
+		return fake.AddReportStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.addReportReturns.result1
+}
+
+func (fake *FakeRepository) AddReportCallCount() int {
+	fake.addReportMutex.RLock()
+	defer fake.addReportMutex.RUnlock()
+	return len(fake.addReportArgsForCall)
+}
+
+func (fake *FakeRepository) AddReportArgsForCall(i int) (context.Context, *privacy.Report) {
+	fake.addReportMutex.RLock()
+	defer fake.addReportMutex.RUnlock()
+	return fake.addReportArgsForCall[i].arg1, fake.addReportArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) AddReportReturns(result1 error) {
+	fake.AddReportStub = nil
+	fake.addReportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) AddReportReturnsOnCall(i int, result1 error) {
+	fake.AddReportStub = nil
+	if fake.addReportReturnsOnCall == nil {
+		fake.addReportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addReportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.addJobMutex.RLock()
+	defer fake.addJobMutex.RUnlock()
+	fake.getJobMutex.RLock()
+	defer fake.getJobMutex.RUnlock()
+	fake.getJobsMutex.RLock()
+	defer fake.getJobsMutex.RUnlock()
+	fake.addReportMutex.RLock()
+	defer fake.addReportMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ privacy.Repository = new(FakeRepository)
@@ -0,0 +1,82 @@
+// Package privacy exports and erases the personal data of users across all services.
+// Every service storing data keyed by a user runs an event handler for its data
+// and reports back to the privacy manager, which tracks the jobs until every
+// service has reported.
+package privacy
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Kind of a privacy job
+type Kind string
+
+// kinds of privacy jobs
+const (
+	// KindExport gathers the data of a user into a downloadable archive
+	KindExport Kind = "export"
+	// KindErasure deletes or anonymises the data of a deleted user
+	KindErasure Kind = "erasure"
+)
+
+// Job exporting or erasing the data of a user.
+// A job is complete as soon as every service of the job has reported.
+type Job struct {
+	ID        string    `json:"id"`
+	UserUUID  string    `json:"user_uuid"`
+	Kind      Kind      `json:"kind"`
+	Services  []string  `json:"services"`
+	Reports   []*Report `json:"reports"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Report of a service on a job.
+// The data of a report is only set for exports.
+type Report struct {
+	JobID      string          `json:"job_id"`
+	Service    string          `json:"service"`
+	Data       json.RawMessage `json:"data,omitempty"`
+	ReportedAt time.Time       `json:"reported_at"`
+}
+
+// Pending returns the services which did not report yet
+func (j *Job) Pending() []string {
+	pending := make([]string, 0)
+	for _, v := range j.Services {
+		if j.report(v) == nil {
+			pending = append(pending, v)
+		}
+	}
+
+	return pending
+}
+
+// Complete checks whether every service of the job has reported
+func (j *Job) Complete() bool {
+	return len(j.Pending()) == 0
+}
+
+func (j *Job) report(service string) *Report {
+	for _, v := range j.Reports {
+		if v.Service == service {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON adds the state of the job
+func (j *Job) MarshalJSON() ([]byte, error) {
+	type job Job
+	return json.Marshal(&struct {
+		*job
+		Pending  []string `json:"pending"`
+		Complete bool     `json:"complete"`
+	}{
+		(*job)(j),
+		j.Pending(),
+		j.Complete(),
+	})
+}
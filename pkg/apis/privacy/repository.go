@@ -0,0 +1,17 @@
+package privacy
+
+import "context"
+
+// Repository of privacy jobs and the reports of the services
+//go:generate counterfeiter -o ./mocks/repository.go . Repository
+type Repository interface {
+	// AddJob unless a job with the same id exists
+	AddJob(context.Context, *Job) error
+	// GetJob including its reports
+	GetJob(ctx context.Context, id string) (*Job, error)
+	// GetJobs of a user ordered by their creation
+	GetJobs(ctx context.Context, userUUID string) ([]*Job, error)
+	// AddReport replaces an earlier report of the same service.
+	// Reports may be added before their job.
+	AddReport(context.Context, *Report) error
+}
@@ -0,0 +1,156 @@
+// Package repositorytest contains the conformance tests of privacy repositories
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/privacy"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) privacy.Repository) {
+	t.Run("Jobs", func(t *testing.T) {
+		testJobs(t, newRepository(t))
+	})
+	t.Run("Reports", func(t *testing.T) {
+		testReports(t, newRepository(t))
+	})
+}
+
+func randomUUID(t *testing.T) string {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	return rand.String()
+}
+
+func testJobs(t *testing.T, r privacy.Repository) {
+	ctx := context.Background()
+	userUUID := randomUUID(t)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	if _, err := r.GetJob(ctx, randomUUID(t)); err != sql.ErrNoRows {
+		t.Fatal("an unknown job should not be found")
+	}
+
+	erasure := &privacy.Job{
+		ID:        randomUUID(t),
+		UserUUID:  userUUID,
+		Kind:      privacy.KindErasure,
+		Services:  []string{"user", "auth"},
+		CreatedAt: now,
+	}
+	export := &privacy.Job{
+		ID:        randomUUID(t),
+		UserUUID:  userUUID,
+		Kind:      privacy.KindExport,
+		Services:  []string{"user"},
+		CreatedAt: now.Add(-time.Minute),
+	}
+
+	for _, v := range []*privacy.Job{erasure, export, erasure} {
+		if err := r.AddJob(ctx, v); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	if err := r.AddJob(ctx, &privacy.Job{
+		ID:        randomUUID(t),
+		UserUUID:  randomUUID(t),
+		Kind:      privacy.KindExport,
+		Services:  []string{"user"},
+		CreatedAt: now,
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	job, err := r.GetJob(ctx, erasure.ID)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if job.UserUUID != userUUID || job.Kind != privacy.KindErasure || !job.CreatedAt.Equal(now) ||
+		len(job.Services) != 2 || job.Services[1] != "auth" || len(job.Reports) != 0 {
+		t.Fatal("the stored job is not equal")
+	}
+
+	jobs, err := r.GetJobs(ctx, userUUID)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(jobs) != 2 || jobs[0].ID != export.ID || jobs[1].ID != erasure.ID {
+		t.Fatal("the jobs of the user should be ordered by their creation")
+	}
+}
+
+func testReports(t *testing.T, r privacy.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+	job := &privacy.Job{
+		ID:        randomUUID(t),
+		UserUUID:  randomUUID(t),
+		Kind:      privacy.KindExport,
+		Services:  []string{"user", "auth"},
+		CreatedAt: now,
+	}
+
+	if err := r.AddReport(ctx, &privacy.Report{
+		JobID:      job.ID,
+		Service:    "user",
+		Data:       []byte(`{"old":true}`),
+		ReportedAt: now,
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.AddJob(ctx, job); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	stored, err := r.GetJob(ctx, job.ID)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(stored.Reports) != 1 || stored.Complete() {
+		t.Fatal("a report added before its job should belong to the job")
+	}
+
+	for _, v := range []*privacy.Report{
+		{JobID: job.ID, Service: "user", Data: []byte(`{"new":true}`), ReportedAt: now},
+		{JobID: job.ID, Service: "auth", ReportedAt: now},
+		{JobID: randomUUID(t), Service: "auth", ReportedAt: now},
+	} {
+		if err := r.AddReport(ctx, v); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	stored, err = r.GetJob(ctx, job.ID)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(stored.Reports) != 2 || !stored.Complete() {
+		t.Fatal("every service should have reported")
+	}
+
+	if stored.Reports[0].Service != "auth" || stored.Reports[0].Data != nil || !stored.Reports[0].ReportedAt.Equal(now) {
+		t.Fatal("the reports should be ordered by service")
+	}
+
+	var data map[string]bool
+	if err := json.Unmarshal(stored.Reports[1].Data, &data); err != nil || !data["new"] {
+		t.Fatal("a report should replace the earlier report of the service")
+	}
+}
@@ -0,0 +1,15 @@
+package privacy
+
+import "github.com/51st-state/api/pkg/rbac"
+
+// rules enforced by the privacy service
+const (
+	ruleExportsCreate rbac.Rule = "privacy.exports.create"
+	ruleJobsGet       rbac.Rule = "privacy.jobs.get"
+)
+
+// Rules enforced by the privacy service
+var Rules = rbac.RuleCatalog{
+	{Rule: ruleExportsCreate, Description: "Request an export of the data of a user", Service: "privacy"},
+	{Rule: ruleJobsGet, Description: "Get the privacy jobs and export archives of users", Service: "privacy"},
+}
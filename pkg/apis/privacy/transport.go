@@ -0,0 +1,60 @@
+package privacy
+
+import (
+	"context"
+	"crypto/rsa"
+	"net/http"
+
+	"github.com/51st-state/api/pkg/api/endpoint"
+	"github.com/51st-state/api/pkg/encode"
+	"github.com/51st-state/api/pkg/rbac"
+	rbacMiddleware "github.com/51st-state/api/pkg/rbac/middleware"
+	"github.com/51st-state/api/pkg/token"
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+)
+
+// MakeRequestExportEndpoint for the privacy service
+// API-Endpoint: POST /privacy/users/{uuid}/exports
+func MakeRequestExportEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.RequestExport(ctx, chi.URLParam(r, "uuid"))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleExportsCreate)).
+		HandlerFunc(l)
+}
+
+// MakeGetJobsEndpoint for the privacy service
+// API-Endpoint: GET /privacy/users/{uuid}/jobs
+func MakeGetJobsEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetJobs(ctx, chi.URLParam(r, "uuid"))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleJobsGet)).
+		HandlerFunc(l)
+}
+
+// MakeGetJobEndpoint for the privacy service
+// API-Endpoint: GET /privacy/jobs/{id}
+func MakeGetJobEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetJob(ctx, chi.URLParam(r, "id"))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleJobsGet)).
+		HandlerFunc(l)
+}
+
+// MakeGetArchiveEndpoint for the privacy service.
+// The archive is encoded by the given encoder, see NewArchiveEncoder.
+// API-Endpoint: GET /privacy/jobs/{id}/archive
+func MakeGetArchiveEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.GetArchive(ctx, chi.URLParam(r, "id"))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleJobsGet)).
+		HandlerFunc(l)
+}
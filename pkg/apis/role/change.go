@@ -0,0 +1,32 @@
+package role
+
+import (
+	"context"
+
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+// Change of the rules of a role which has to be applied to the rbac system.
+// Changes are stored by the repository together with the role information
+// and applied in the order they were stored, so both converge.
+type Change struct {
+	ID        string
+	Actor     rbac.AccountID
+	RoleID    rbac.RoleID
+	Rules     rbac.RoleRules
+	DenyRules rbac.RoleRules
+	Parents   rbac.RoleParents
+	// Attempts is the number of failed attempts to apply the change
+	Attempts uint64
+}
+
+// NewChange of the rules of a role made by the account acting in the context
+func NewChange(ctx context.Context, id rbac.RoleID, rules, denyRules rbac.RoleRules, parents rbac.RoleParents) Change {
+	return Change{
+		Actor:     rbac.ActorFromContext(ctx),
+		RoleID:    id,
+		Rules:     append(make(rbac.RoleRules, 0), rules...),
+		DenyRules: append(make(rbac.RoleRules, 0), denyRules...),
+		Parents:   append(make(rbac.RoleParents, 0), parents...),
+	}
+}
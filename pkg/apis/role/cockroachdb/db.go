@@ -4,8 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"strconv"
+	"time"
 
 	"github.com/51st-state/api/pkg/apis/role"
+	"github.com/51st-state/api/pkg/pagination"
 	"github.com/51st-state/api/pkg/rbac"
 )
 
@@ -18,7 +21,25 @@ func CreateSchema(ctx context.Context, db *sql.DB) (err error) {
             title TEXT NOT NULL DEFAULT '',
             description TEXT NOT NULL DEFAULT ''
         );
-        CREATE UNIQUE INDEX IF NOT EXISTS role_info_idx_id ON role_info (id);`,
+        CREATE UNIQUE INDEX IF NOT EXISTS role_info_idx_id ON role_info (id);
+
+        CREATE TABLE IF NOT EXISTS role_changes (
+            id SERIAL PRIMARY KEY,
+            actor TEXT NOT NULL DEFAULT '',
+            roleId TEXT NOT NULL DEFAULT '',
+            rules JSONB NOT NULL DEFAULT '[]',
+            denyRules JSONB NOT NULL DEFAULT '[]',
+            parents JSONB NOT NULL DEFAULT '[]'
+        );
+        ALTER TABLE role_changes ADD COLUMN IF NOT EXISTS attempts INT NOT NULL DEFAULT 0;
+        ALTER TABLE role_changes ADD COLUMN IF NOT EXISTS lastError TEXT NOT NULL DEFAULT '';
+        ALTER TABLE role_changes ADD COLUMN IF NOT EXISTS deadLetter BOOL NOT NULL DEFAULT false;
+
+        CREATE TABLE IF NOT EXISTS role_sync_lease (
+            id INT PRIMARY KEY,
+            holder TEXT NOT NULL DEFAULT '',
+            expiresAt TIMESTAMPTZ NOT NULL
+        );`,
 	)
 	return
 }
@@ -45,20 +66,24 @@ func newComplete(id role.Identifier, inc role.Incomplete) role.Complete {
 
 func (c *complete) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&struct {
-		ID          rbac.RoleID    `json:"id"`
-		Title       string         `json:"title"`
-		Description string         `json:"description"`
-		Rules       rbac.RoleRules `json:"rules"`
+		ID          rbac.RoleID      `json:"id"`
+		Title       string           `json:"title"`
+		Description string           `json:"description"`
+		Rules       rbac.RoleRules   `json:"rules"`
+		DenyRules   rbac.RoleRules   `json:"deny_rules"`
+		Parents     rbac.RoleParents `json:"parents"`
 	}{
 		c.ID(),
 		c.Data().Title,
 		c.Data().Description,
 		c.Data().Rules,
+		c.Data().DenyRules,
+		c.Data().Parents,
 	})
 }
 
 func (d *db) Get(ctx context.Context, id role.Identifier) (role.Complete, error) {
-	inc := role.NewIncomplete("", "", make(rbac.RoleRules, 0))
+	inc := role.NewIncomplete("", "", make(rbac.RoleRules, 0), make(rbac.RoleRules, 0), make(rbac.RoleParents, 0))
 
 	if err := d.database.QueryRowContext(
 		ctx,
@@ -77,8 +102,70 @@ func (d *db) Get(ctx context.Context, id role.Identifier) (role.Complete, error)
 	return newComplete(id, inc), nil
 }
 
+func txError(tx *sql.Tx, err error) error {
+	if rErr := tx.Rollback(); rErr != nil {
+		return rErr
+	}
+
+	return err
+}
+
+// rowAffected returns sql.ErrNoRows if the statement did not affect a row
+func rowAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// addChange stores a change of the rules of a role in a transaction
+func addChange(ctx context.Context, tx *sql.Tx, change role.Change) error {
+	rules, err := json.Marshal(change.Rules)
+	if err != nil {
+		return err
+	}
+
+	denyRules, err := json.Marshal(change.DenyRules)
+	if err != nil {
+		return err
+	}
+
+	parents, err := json.Marshal(change.Parents)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO role_changes (
+            actor,
+            roleId,
+            rules,
+            denyRules,
+            parents
+        ) VALUES ($1, $2, $3, $4, $5)`,
+		change.Actor,
+		change.RoleID,
+		string(rules),
+		string(denyRules),
+		string(parents),
+	)
+	return err
+}
+
 func (d *db) Update(ctx context.Context, c role.Complete) error {
-	_, err := d.database.ExecContext(
+	tx, err := d.database.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(
 		ctx,
 		`UPDATE role_info
         SET title = $1,
@@ -88,11 +175,28 @@ func (d *db) Update(ctx context.Context, c role.Complete) error {
 		c.Data().Description,
 		c.ID(),
 	)
-	return err
+	if err != nil {
+		return txError(tx, err)
+	}
+
+	if err := rowAffected(res); err != nil {
+		return txError(tx, err)
+	}
+
+	if err := addChange(ctx, tx, role.NewChange(ctx, c.ID(), c.Data().Rules, c.Data().DenyRules, c.Data().Parents)); err != nil {
+		return txError(tx, err)
+	}
+
+	return tx.Commit()
 }
 
 func (d *db) Create(ctx context.Context, c role.Complete) error {
-	_, err := d.database.ExecContext(
+	tx, err := d.database.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(
 		ctx,
 		`INSERT INTO role_info (
             id,
@@ -104,16 +208,220 @@ func (d *db) Create(ctx context.Context, c role.Complete) error {
 		c.ID(),
 		c.Data().Title,
 		c.Data().Description,
-	)
-	return err
+	); err != nil {
+		return txError(tx, err)
+	}
+
+	if err := addChange(ctx, tx, role.NewChange(ctx, c.ID(), c.Data().Rules, c.Data().DenyRules, c.Data().Parents)); err != nil {
+		return txError(tx, err)
+	}
+
+	return tx.Commit()
 }
 
 func (d *db) Delete(ctx context.Context, id role.Identifier) error {
-	_, err := d.database.ExecContext(
+	tx, err := d.database.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(
 		ctx,
 		`DELETE FROM role_info
         WHERE id = $1`,
 		id.ID(),
+	); err != nil {
+		return txError(tx, err)
+	}
+
+	if err := addChange(ctx, tx, role.NewChange(ctx, id.ID(), nil, nil, nil)); err != nil {
+		return txError(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+func (d *db) List(ctx context.Context, page pagination.Page) ([]role.Complete, error) {
+	rows, err := d.database.QueryContext(
+		ctx,
+		`SELECT id,
+        title,
+        description
+        FROM role_info
+        WHERE id > $1
+        ORDER BY id
+        LIMIT $2`,
+		page.Cursor,
+		page.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := make([]role.Complete, 0)
+	for rows.Next() {
+		var id rbac.RoleID
+		inc := role.NewIncomplete("", "", make(rbac.RoleRules, 0), make(rbac.RoleRules, 0), make(rbac.RoleParents, 0))
+
+		if err := rows.Scan(
+			&id,
+			&inc.Data().Title,
+			&inc.Data().Description,
+		); err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, newComplete(role.NewIdentifier(id), inc))
+	}
+
+	return roles, rows.Err()
+}
+
+func (d *db) GetChanges(ctx context.Context, limit uint64) ([]role.Change, error) {
+	rows, err := d.database.QueryContext(
+		ctx,
+		`SELECT id,
+        actor,
+        roleId,
+        rules,
+        denyRules,
+        parents,
+        attempts
+        FROM role_changes
+        WHERE NOT deadLetter
+        ORDER BY id
+        LIMIT $1`,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := make([]role.Change, 0)
+	for rows.Next() {
+		var (
+			id        int64
+			change    role.Change
+			rules     []byte
+			denyRules []byte
+			parents   []byte
+		)
+		if err := rows.Scan(
+			&id,
+			&change.Actor,
+			&change.RoleID,
+			&rules,
+			&denyRules,
+			&parents,
+			&change.Attempts,
+		); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(rules, &change.Rules); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(denyRules, &change.DenyRules); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(parents, &change.Parents); err != nil {
+			return nil, err
+		}
+
+		change.ID = strconv.FormatInt(id, 10)
+		changes = append(changes, change)
+	}
+
+	return changes, rows.Err()
+}
+
+func (d *db) RemoveChange(ctx context.Context, id string) error {
+	changeID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.database.ExecContext(
+		ctx,
+		`DELETE FROM role_changes
+        WHERE id = $1`,
+		changeID,
+	)
+	return err
+}
+
+func (d *db) FailChange(ctx context.Context, id string, reason string) error {
+	changeID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.database.ExecContext(
+		ctx,
+		`UPDATE role_changes
+        SET attempts = attempts + 1,
+        lastError = $1
+        WHERE id = $2`,
+		reason,
+		changeID,
+	)
+	return err
+}
+
+func (d *db) DeadLetterChange(ctx context.Context, id string, reason string) error {
+	changeID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.database.ExecContext(
+		ctx,
+		`UPDATE role_changes
+        SET deadLetter = true,
+        lastError = $1
+        WHERE id = $2`,
+		reason,
+		changeID,
+	)
+	return err
+}
+
+// syncLeaseID is the id of the only row of the role_sync_lease table
+const syncLeaseID = 1
+
+func (d *db) AcquireSyncLease(ctx context.Context, holder string, ttl time.Duration) (bool, error) {
+	err := d.database.QueryRowContext(
+		ctx,
+		`INSERT INTO role_sync_lease (id, holder, expiresAt)
+        VALUES ($1, $2, now() + $3 * INTERVAL '1 millisecond')
+        ON CONFLICT (id) DO UPDATE
+        SET holder = excluded.holder,
+        expiresAt = excluded.expiresAt
+        WHERE role_sync_lease.expiresAt < now()
+        OR role_sync_lease.holder = excluded.holder
+        RETURNING holder`,
+		syncLeaseID,
+		holder,
+		ttl.Nanoseconds()/int64(time.Millisecond),
+	).Scan(&holder)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (d *db) ReleaseSyncLease(ctx context.Context, holder string) error {
+	_, err := d.database.ExecContext(
+		ctx,
+		`DELETE FROM role_sync_lease
+        WHERE id = $1 AND holder = $2`,
+		syncLeaseID,
+		holder,
 	)
 	return err
 }
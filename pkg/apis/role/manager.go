@@ -2,13 +2,20 @@ package role
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"regexp"
+	"time"
 
+	"github.com/51st-state/api/pkg/pagination"
 	"github.com/51st-state/api/pkg/rbac"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-var idRegexp = regexp.MustCompile(`^([a-z0-9-_]+\/)?[a-z0-9-_]+$`)
+var idRegexp = regexp.MustCompile(`^([a-z0-9-_]+\/)*[a-z0-9-_]+$`)
 
 // Manager for managing role informations
 //go:generate counterfeiter -o ./mocks/manager.go . Manager
@@ -17,25 +24,52 @@ type Manager interface {
 	Set(context.Context, Complete) error
 	Create(context.Context, Complete) error
 	Delete(context.Context, Identifier) error
+	List(context.Context, pagination.Page) ([]Complete, error)
+	SeedSystemRoles(context.Context) error
+	Bootstrap(context.Context, rbac.AccountID) error
+	Sync(context.Context) error
 }
 
 type manager struct {
+	logger     *zap.Logger
 	repository Repository
 	rbac       rbac.Control
 }
 
 // NewManager creates a new instance of a manager for role information
-func NewManager(repo Repository, rb rbac.Control) Manager {
+func NewManager(l *zap.Logger, repo Repository, rb rbac.Control) Manager {
 	return &manager{
-		repo,
-		rb,
+		logger:     l,
+		repository: repo,
+		rbac:       rb,
 	}
 }
 
 var (
-	errInvalidID = errors.New("invalid id format")
+	errInvalidID      = errors.New("invalid id format")
+	errSystemRole     = errors.New("system roles can not be created, changed or deleted")
+	errEmptyAccountID = errors.New("empty account id")
+	errUnknownRule    = errors.New("unknown rule")
+	errInvalidParent  = errors.New("invalid parent id format")
+	errUnknownParent  = errors.New("unknown parent role")
+	errRoleCycle      = errors.New("role inheritance must not contain cycles")
 )
 
+const (
+	// syncBatchSize is the number of changes fetched at once by a sync
+	syncBatchSize = 100
+	// syncMaxAttempts is the number of failed attempts after which
+	// a change is dead-lettered
+	syncMaxAttempts = 10
+	// syncLeaseTTL is the duration a sync holds its lease on the changes
+	// without renewing it
+	syncLeaseTTL = 30 * time.Second
+)
+
+// ErrAlreadyBootstrapped is returned by Bootstrap if
+// an account already holds the superadmin role
+var ErrAlreadyBootstrapped = errors.New("an account already holds the superadmin role")
+
 // Get role information
 func (m *manager) Get(ctx context.Context, id Identifier) (Complete, error) {
 	if !idRegexp.MatchString(string(id.ID())) {
@@ -47,14 +81,49 @@ func (m *manager) Get(ctx context.Context, id Identifier) (Complete, error) {
 		return nil, err
 	}
 
-	rules, err := m.rbac.GetRoleRules(ctx, id.ID())
+	if err := m.getRules(ctx, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// List a page of roles ordered by their id
+func (m *manager) List(ctx context.Context, page pagination.Page) ([]Complete, error) {
+	roles, err := m.repository.List(ctx, pagination.New(page.Cursor, page.Limit))
 	if err != nil {
 		return nil, err
 	}
 
-	c.Data().SetRules(rules)
+	for _, v := range roles {
+		if err := m.getRules(ctx, v); err != nil {
+			return nil, err
+		}
+	}
 
-	return c, nil
+	return roles, nil
+}
+
+// getRules fills the rules, deny rules and parents of a role from the rbac system
+func (m *manager) getRules(ctx context.Context, c Complete) error {
+	rules, err := m.rbac.GetRoleRules(ctx, c.ID())
+	if err != nil {
+		return err
+	}
+
+	denyRules, err := m.rbac.GetRoleDenyRules(ctx, c.ID())
+	if err != nil {
+		return err
+	}
+
+	parents, err := m.rbac.GetRoleParents(ctx, c.ID())
+	if err != nil {
+		return err
+	}
+
+	c.Data().SetRules(rules).SetDenyRules(denyRules).SetParents(parents)
+
+	return nil
 }
 
 // Set role information
@@ -63,11 +132,25 @@ func (m *manager) Set(ctx context.Context, c Complete) error {
 		return errInvalidID
 	}
 
+	if IsSystemRole(c.ID()) {
+		return errSystemRole
+	}
+
+	if err := m.validateRules(ctx, c.Data()); err != nil {
+		return err
+	}
+
+	if err := m.validateParents(ctx, c.ID(), c.Data().Parents); err != nil {
+		return err
+	}
+
 	if err := m.repository.Update(ctx, c); err != nil {
 		return err
 	}
 
-	return m.rbac.SetRoleRules(ctx, c.ID(), c.Data().Rules)
+	m.trySync(ctx)
+
+	return nil
 }
 
 // Create a role with role information
@@ -76,11 +159,25 @@ func (m *manager) Create(ctx context.Context, c Complete) error {
 		return errInvalidID
 	}
 
+	if IsSystemRole(c.ID()) {
+		return errSystemRole
+	}
+
+	if err := m.validateRules(ctx, c.Data()); err != nil {
+		return err
+	}
+
+	if err := m.validateParents(ctx, c.ID(), c.Data().Parents); err != nil {
+		return err
+	}
+
 	if err := m.repository.Create(ctx, c); err != nil {
 		return err
 	}
 
-	return m.rbac.SetRoleRules(ctx, c.ID(), c.Data().Rules)
+	m.trySync(ctx)
+
+	return nil
 }
 
 // Delete role information
@@ -89,9 +186,254 @@ func (m *manager) Delete(ctx context.Context, id Identifier) error {
 		return errInvalidID
 	}
 
-	if err := m.rbac.SetRoleRules(ctx, id.ID(), make(rbac.RoleRules, 0)); err != nil {
+	if IsSystemRole(id.ID()) {
+		return errSystemRole
+	}
+
+	if err := m.repository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	m.trySync(ctx)
+
+	return nil
+}
+
+// validateRules checks whether the rules of a role are known to the rbac system,
+// so the stored change can be applied by a sync
+func (m *manager) validateRules(ctx context.Context, d *data) error {
+	rules := append(append(make(rbac.RoleRules, 0), d.Rules...), d.DenyRules...)
+	if len(rules) == 0 {
+		return nil
+	}
+
+	catalog, err := m.rbac.GetRuleCatalog(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range rules {
+		if !catalog.Knows(v) {
+			return errUnknownRule
+		}
+	}
+
+	return nil
+}
+
+// validateParents checks whether the parents of a role are existing roles
+// and whether inheriting from them would create a cycle,
+// so the stored change can be applied by a sync
+func (m *manager) validateParents(ctx context.Context, id rbac.RoleID, parents rbac.RoleParents) error {
+	for _, v := range parents {
+		if !idRegexp.MatchString(string(v)) {
+			return errInvalidParent
+		}
+
+		if _, err := m.repository.Get(ctx, NewIdentifier(v)); err == sql.ErrNoRows {
+			return errUnknownParent
+		} else if err != nil {
+			return err
+		}
+	}
+
+	visited := make(rbac.RoleParents, 0)
+	for len(parents) > 0 {
+		current := parents[0]
+		parents = parents[1:]
+
+		if current == id {
+			return errRoleCycle
+		}
+
+		if visited.Contains(current) {
+			continue
+		}
+		visited = append(visited, current)
+
+		inherited, err := m.rbac.GetRoleParents(ctx, current)
+		if err != nil {
+			return err
+		}
+
+		parents = append(parents, inherited...)
+	}
+
+	return nil
+}
+
+// trySync applies the stored changes right away. Changes which can not be
+// applied, e.g. because the rbac service is unavailable, are kept by the
+// repository and applied by a later sync.
+func (m *manager) trySync(ctx context.Context) {
+	if err := m.Sync(ctx); err != nil {
+		m.logger.Error("applying role changes", zap.Error(err))
+	}
+}
+
+// Sync applies the changes stored by the repository to the rbac system
+// in the order they were stored. Applying a change is idempotent, so a change
+// is only removed after it has been applied completely. A change which keeps
+// failing is dead-lettered, so it does not block the changes stored after it.
+// Only the sync holding the lease of the repository applies changes,
+// any concurrent sync of this or another instance returns right away.
+func (m *manager) Sync(ctx context.Context) error {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+
+	holder := rand.String()
+	for {
+		ok, err := m.repository.AcquireSyncLease(ctx, holder, syncLeaseTTL)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return nil
+		}
+
+		done, err := m.syncBatch(ctx)
+		if err != nil || done {
+			m.releaseSyncLease(ctx, holder)
+			return err
+		}
+	}
+}
+
+// releaseSyncLease releases the lease of a sync, a lease which
+// can not be released expires after syncLeaseTTL
+func (m *manager) releaseSyncLease(ctx context.Context, holder string) {
+	if err := m.repository.ReleaseSyncLease(ctx, holder); err != nil {
+		m.logger.Error("releasing role sync lease", zap.Error(err))
+	}
+}
+// syncBatch applies the oldest batch of changes,
+// returns true if there are no changes left
+func (m *manager) syncBatch(ctx context.Context) (bool, error) {
+	changes, err := m.repository.GetChanges(ctx, syncBatchSize)
+	if err != nil {
+		return false, err
+	}
+
+	if len(changes) == 0 {
+		return true, nil
+	}
+
+	for _, v := range changes {
+		if err := m.applyChange(ctx, v); err != nil {
+			if err := m.failChange(ctx, v, err); err != nil {
+				return false, err
+			}
+
+			continue
+		}
+
+		if err := m.repository.RemoveChange(ctx, v.ID); err != nil {
+			return false, err
+		}
+	}
+
+	return false, nil
+}
+
+// applyChange stores the rules, deny rules and parents of a role in the rbac system
+func (m *manager) applyChange(ctx context.Context, change Change) error {
+	ctx = rbac.ActorToContext(ctx, change.Actor)
+
+	if err := m.rbac.SetRoleRules(ctx, change.RoleID, change.Rules); err != nil {
+		return err
+	}
+
+	if err := m.rbac.SetRoleDenyRules(ctx, change.RoleID, change.DenyRules); err != nil {
+		return err
+	}
+
+	return m.rbac.SetRoleParents(ctx, change.RoleID, change.Parents)
+}
+
+// failChange records a failed attempt to apply a change and returns the error
+// as long as the change is retried. Failures to reach the rbac system do not
+// count as an attempt, a change failing for syncMaxAttempts times is
+// dead-lettered instead.
+func (m *manager) failChange(ctx context.Context, change Change, err error) error {
+	if unavailable(ctx, err) {
+		return err
+	}
+
+	if change.Attempts+1 < syncMaxAttempts {
+		if fErr := m.repository.FailChange(ctx, change.ID, err.Error()); fErr != nil {
+			return fErr
+		}
+
+		return err
+	}
+
+	m.logger.Error(
+		"dead-lettering role change",
+		zap.String("change", change.ID),
+		zap.String("role", string(change.RoleID)),
+		zap.Error(err),
+	)
+
+	return m.repository.DeadLetterChange(ctx, change.ID, err.Error())
+}
+
+// unavailable checks whether an error is caused by the rbac system
+// not being reachable instead of by the change itself
+func unavailable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return true
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.Aborted, codes.ResourceExhausted:
+		return true
+	}
+
+	return false
+}
+
+// SeedSystemRoles creates the system roles or resets them to their definition
+// and applies their rules to the rbac system
+func (m *manager) SeedSystemRoles(ctx context.Context) error {
+	for _, v := range SystemRoles {
+		_, err := m.repository.Get(ctx, v)
+		switch {
+		case err == sql.ErrNoRows:
+			err = m.repository.Create(ctx, v)
+		case err == nil:
+			err = m.repository.Update(ctx, v)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.Sync(ctx)
+}
+
+// Bootstrap grants the superadmin role to an account
+// as long as no account holds it yet
+func (m *manager) Bootstrap(ctx context.Context, accountID rbac.AccountID) error {
+	if accountID == "" {
+		return errEmptyAccountID
+	}
+
+	superadmins, err := m.rbac.ListRoleAccounts(ctx, SuperadminID, pagination.New("", 1))
+	if err != nil {
+		return err
+	}
+
+	if len(superadmins) > 0 {
+		return ErrAlreadyBootstrapped
+	}
+
+	roles, err := m.rbac.GetAccountRoles(ctx, accountID)
+	if err != nil {
 		return err
 	}
 
-	return m.repository.Delete(ctx, id)
+	return m.rbac.SetAccountRoles(ctx, accountID, append(roles, SuperadminID))
 }
@@ -2,14 +2,22 @@ package role_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 
 	"github.com/51st-state/api/pkg/apis/role"
+	"github.com/51st-state/api/pkg/apis/role/memory"
 	"github.com/51st-state/api/pkg/apis/role/mocks"
+	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/pagination"
+	pubsubMocks "github.com/51st-state/api/pkg/pubsub/mocks"
 	"github.com/51st-state/api/pkg/rbac"
-
+	rbacMemory "github.com/51st-state/api/pkg/rbac/memory"
 	rbacMocks "github.com/51st-state/api/pkg/rbac/mocks"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type fakeComplete struct {
@@ -20,7 +28,7 @@ type fakeComplete struct {
 func TestManagerGet(t *testing.T) {
 	control := &rbacMocks.FakeControl{}
 	repo := &mocks.FakeRepository{}
-	m := role.NewManager(repo, control)
+	m := role.NewManager(zap.NewNop(), repo, control)
 
 	id := &mocks.FakeIdentifier{}
 	id.IDReturns("")
@@ -37,7 +45,7 @@ func TestManagerGet(t *testing.T) {
 
 	repo.GetReturns(&fakeComplete{
 		id,
-		role.NewIncomplete("title", "description", rbac.RoleRules{}),
+		role.NewIncomplete("title", "description", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{}),
 	}, nil)
 	control.GetRoleRulesReturns(nil, errors.New("fake error"))
 
@@ -48,6 +56,24 @@ func TestManagerGet(t *testing.T) {
 	control.GetRoleRulesReturns(rbac.RoleRules{
 		"testRule",
 	}, nil)
+	control.GetRoleDenyRulesReturns(nil, errors.New("fake error"))
+
+	if _, err := m.Get(context.Background(), id); err == nil {
+		t.Fatal("the rbac service returns an error")
+	}
+
+	control.GetRoleDenyRulesReturns(rbac.RoleRules{
+		"testDenyRule",
+	}, nil)
+	control.GetRoleParentsReturns(nil, errors.New("fake error"))
+
+	if _, err := m.Get(context.Background(), id); err == nil {
+		t.Fatal("the rbac service returns an error")
+	}
+
+	control.GetRoleParentsReturns(rbac.RoleParents{
+		"testParent",
+	}, nil)
 
 	c, err := m.Get(context.Background(), id)
 	if err != nil {
@@ -57,95 +83,121 @@ func TestManagerGet(t *testing.T) {
 	if c.Data().Rules[0] != "testRule" {
 		t.Fatal("the returned rules are not equal")
 	}
+
+	if c.Data().DenyRules[0] != "testDenyRule" {
+		t.Fatal("the returned deny rules are not equal")
+	}
+
+	if c.Data().Parents[0] != "testParent" {
+		t.Fatal("the returned parents are not equal")
+	}
 }
 
 func TestManagerSet(t *testing.T) {
 	control := &rbacMocks.FakeControl{}
 	repo := &mocks.FakeRepository{}
-	m := role.NewManager(repo, control)
+	m := role.NewManager(zap.NewNop(), repo, control)
 
 	id := &mocks.FakeIdentifier{}
 	id.IDReturns("")
 	if err := m.Set(context.Background(), &fakeComplete{
 		id,
-		role.NewIncomplete("title", "description", rbac.RoleRules{}),
+		role.NewIncomplete("title", "description", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{}),
 	}); err == nil {
 		t.Fatal("the id of the role is empty")
 	}
 
 	id.IDReturns("testid")
-	repo.UpdateReturns(errors.New("fake error"))
+	control.GetRuleCatalogReturns(rbac.RuleCatalog{{Rule: "users.get", Service: "user"}}, nil)
 	if err := m.Set(context.Background(), &fakeComplete{
 		id,
-		role.NewIncomplete("title", "description", rbac.RoleRules{}),
+		role.NewIncomplete("title", "description", rbac.RoleRules{"users.gte"}, rbac.RoleRules{}, rbac.RoleParents{}),
 	}); err == nil {
-		t.Fatal("the repository returns an error")
+		t.Fatal("the rule is unknown")
 	}
 
-	repo.UpdateReturns(nil)
-	control.SetRoleRulesReturns(errors.New("fake error"))
+	if repo.UpdateCallCount() != 0 {
+		t.Fatal("roles with unknown rules should not be stored")
+	}
+
+	repo.UpdateReturns(errors.New("fake error"))
 	if err := m.Set(context.Background(), &fakeComplete{
 		id,
-		role.NewIncomplete("title", "description", rbac.RoleRules{}),
+		role.NewIncomplete("title", "description", rbac.RoleRules{"users.get"}, rbac.RoleRules{}, rbac.RoleParents{}),
 	}); err == nil {
-		t.Fatal("the rbac repository returns an error")
+		t.Fatal("the repository returns an error")
 	}
 
-	control.SetRoleRulesReturns(nil)
+	if control.SetRoleRulesCallCount() != 0 {
+		t.Fatal("the rules should not be applied if the role was not stored")
+	}
+
+	repo.UpdateReturns(nil)
+	repo.GetChangesReturnsOnCall(0, []role.Change{{ID: "1", RoleID: "testid"}}, nil)
+	control.SetRoleRulesReturns(errors.New("fake error"))
 	if err := m.Set(context.Background(), &fakeComplete{
 		id,
-		role.NewIncomplete("title", "description", rbac.RoleRules{}),
+		role.NewIncomplete("title", "description", rbac.RoleRules{"users.get"}, rbac.RoleRules{}, rbac.RoleParents{}),
 	}); err != nil {
-		t.Fatal("there should be no error")
+		t.Fatal("the stored change is applied by a later sync")
+	}
+
+	if repo.RemoveChangeCallCount() != 0 {
+		t.Fatal("the change has not been applied")
 	}
 }
 
 func TestManagerCreate(t *testing.T) {
 	control := &rbacMocks.FakeControl{}
 	repo := &mocks.FakeRepository{}
-	m := role.NewManager(repo, control)
+	repo.AcquireSyncLeaseReturns(true, nil)
+	m := role.NewManager(zap.NewNop(), repo, control)
 
 	id := &mocks.FakeIdentifier{}
 	id.IDReturns("")
 	if err := m.Create(context.Background(), &fakeComplete{
 		id,
-		role.NewIncomplete("title", "description", rbac.RoleRules{}),
+		role.NewIncomplete("title", "description", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{}),
 	}); err == nil {
 		t.Fatal("the id of the role is empty")
 	}
 
 	id.IDReturns("testid")
-	repo.CreateReturns(errors.New("fake error"))
+	control.GetRuleCatalogReturns(nil, errors.New("fake error"))
 	if err := m.Create(context.Background(), &fakeComplete{
 		id,
-		role.NewIncomplete("title", "description", rbac.RoleRules{}),
+		role.NewIncomplete("title", "description", rbac.RoleRules{}, rbac.RoleRules{"users.get"}, rbac.RoleParents{}),
 	}); err == nil {
-		t.Fatal("the repository returns an error")
+		t.Fatal("the rbac control returns an error")
 	}
 
-	repo.CreateReturns(nil)
-	control.SetRoleRulesReturns(errors.New("fake error"))
+	control.GetRuleCatalogReturns(rbac.RuleCatalog{{Rule: "users.get", Service: "user"}}, nil)
+	repo.CreateReturns(errors.New("fake error"))
 	if err := m.Create(context.Background(), &fakeComplete{
 		id,
-		role.NewIncomplete("title", "description", rbac.RoleRules{}),
+		role.NewIncomplete("title", "description", rbac.RoleRules{}, rbac.RoleRules{"users.get"}, rbac.RoleParents{}),
 	}); err == nil {
-		t.Fatal("the rbac control returns an error")
+		t.Fatal("the repository returns an error")
 	}
 
-	control.SetRoleRulesReturns(nil)
-
+	repo.CreateReturns(nil)
+	repo.GetChangesReturnsOnCall(0, []role.Change{{ID: "1", RoleID: "testid"}}, nil)
 	if err := m.Create(context.Background(), &fakeComplete{
 		id,
-		role.NewIncomplete("title", "description", rbac.RoleRules{}),
+		role.NewIncomplete("title", "description", rbac.RoleRules{}, rbac.RoleRules{"users.get"}, rbac.RoleParents{}),
 	}); err != nil {
 		t.Fatal("there should be no error")
 	}
+
+	if control.SetRoleRulesCallCount() != 1 || repo.RemoveChangeCallCount() != 1 {
+		t.Fatal("the change should be applied right away")
+	}
 }
 
 func TestManagerDelete(t *testing.T) {
 	control := &rbacMocks.FakeControl{}
 	repo := &mocks.FakeRepository{}
-	m := role.NewManager(repo, control)
+	m := role.NewManager(zap.NewNop(), repo, control)
 
 	id := &mocks.FakeIdentifier{}
 	id.IDReturns("")
@@ -154,21 +206,444 @@ func TestManagerDelete(t *testing.T) {
 	}
 
 	id.IDReturns("testid")
-
-	control.SetRoleRulesReturns(errors.New("fake error"))
+	repo.DeleteReturns(errors.New("fake error"))
 	if err := m.Delete(context.Background(), id); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+
+	repo.DeleteReturns(nil)
+	repo.GetChangesReturns(nil, errors.New("fake error"))
+	if err := m.Delete(context.Background(), id); err != nil {
+		t.Fatal("the stored change is applied by a later sync")
+	}
+}
+
+func TestManagerSync(t *testing.T) {
+	control := &rbacMocks.FakeControl{}
+	repo := &mocks.FakeRepository{}
+	repo.AcquireSyncLeaseReturns(true, nil)
+	m := role.NewManager(zap.NewNop(), repo, control)
+
+	repo.GetChangesReturns(nil, errors.New("fake error"))
+	if err := m.Sync(context.Background()); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+
+	changes := []role.Change{
+		{ID: "1", Actor: "user/1", RoleID: "first", Rules: rbac.RoleRules{"users.get"}},
+		{ID: "2", Actor: "user/2", RoleID: "second"},
+	}
+	repo.GetChangesReturns([]role.Change{}, nil)
+	repo.GetChangesReturnsOnCall(1, changes, nil)
+	control.SetRoleParentsReturnsOnCall(0, errors.New("fake error"))
+	if err := m.Sync(context.Background()); err == nil {
 		t.Fatal("the rbac control returns an error")
 	}
 
-	control.SetRoleRulesReturns(nil)
-	repo.DeleteReturns(errors.New("fake error"))
+	if repo.RemoveChangeCallCount() != 0 {
+		t.Fatal("partially applied changes should be kept")
+	}
 
-	if err := m.Delete(context.Background(), id); err == nil {
+	repo.GetChangesReturnsOnCall(2, changes, nil)
+	repo.RemoveChangeReturnsOnCall(1, errors.New("fake error"))
+	if err := m.Sync(context.Background()); err == nil {
 		t.Fatal("the repository returns an error")
 	}
 
-	repo.DeleteReturns(nil)
-	if err := m.Delete(context.Background(), id); err != nil {
+	repo.GetChangesReturnsOnCall(3, changes[1:], nil)
+	if err := m.Sync(context.Background()); err != nil {
 		t.Fatal("there should be no error")
 	}
+
+	if repo.RemoveChangeCallCount() != 3 {
+		t.Fatal("applied changes should be removed")
+	}
+
+	ctx, roleID, rules := control.SetRoleRulesArgsForCall(1)
+	if roleID != "first" || !rules.Contains("users.get") || rbac.ActorFromContext(ctx) != "user/1" {
+		t.Fatal("the change should be applied on behalf of its actor")
+	}
+
+	if repo.ReleaseSyncLeaseCallCount() != 4 {
+		t.Fatal("the lease should be released after every sync")
+	}
+
+	_, first, _ := repo.AcquireSyncLeaseArgsForCall(repo.AcquireSyncLeaseCallCount() - 2)
+	_, renewed, _ := repo.AcquireSyncLeaseArgsForCall(repo.AcquireSyncLeaseCallCount() - 1)
+	if _, released := repo.ReleaseSyncLeaseArgsForCall(3); first == "" || renewed != first || released != first {
+		t.Fatal("a sync should renew and release its own lease")
+	}
+
+	calls := repo.GetChangesCallCount()
+	repo.AcquireSyncLeaseReturns(false, nil)
+	if err := m.Sync(context.Background()); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if repo.GetChangesCallCount() != calls {
+		t.Fatal("a sync without the lease should not apply changes")
+	}
+
+	repo.AcquireSyncLeaseReturns(false, errors.New("fake error"))
+	if err := m.Sync(context.Background()); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+}
+
+func TestManagerSyncDeadLetter(t *testing.T) {
+	control := &rbacMocks.FakeControl{}
+	repo := &mocks.FakeRepository{}
+	repo.AcquireSyncLeaseReturns(true, nil)
+	m := role.NewManager(zap.NewNop(), repo, control)
+
+	changes := []role.Change{
+		{ID: "1", RoleID: "first"},
+		{ID: "2", RoleID: "second"},
+	}
+	repo.GetChangesReturns([]role.Change{}, nil)
+	repo.GetChangesReturnsOnCall(0, changes, nil)
+	control.SetRoleRulesReturnsOnCall(0, status.Error(codes.Unavailable, "fake error"))
+	if err := m.Sync(context.Background()); err == nil {
+		t.Fatal("the rbac service is unavailable")
+	}
+
+	if repo.FailChangeCallCount() != 0 {
+		t.Fatal("an unavailable rbac service should not count as a failed attempt")
+	}
+
+	repo.GetChangesReturnsOnCall(1, changes, nil)
+	control.SetRoleRulesReturnsOnCall(1, errors.New("fake error"))
+	repo.FailChangeReturns(errors.New("fake error"))
+	if err := m.Sync(context.Background()); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+
+	repo.GetChangesReturnsOnCall(2, changes, nil)
+	control.SetRoleRulesReturnsOnCall(2, errors.New("fake error"))
+	repo.FailChangeReturns(nil)
+	if err := m.Sync(context.Background()); err == nil {
+		t.Fatal("the failed change should be retried")
+	}
+
+	if _, id, reason := repo.FailChangeArgsForCall(1); id != "1" || reason != "fake error" {
+		t.Fatal("the failed attempt should be recorded")
+	}
+
+	if repo.RemoveChangeCallCount() != 0 || repo.DeadLetterChangeCallCount() != 0 {
+		t.Fatal("the failed change should block the later changes while it is retried")
+	}
+
+	changes[0].Attempts = 9
+	repo.GetChangesReturnsOnCall(3, changes, nil)
+	control.SetRoleRulesReturnsOnCall(3, errors.New("fake error"))
+	if err := m.Sync(context.Background()); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, id, _ := repo.DeadLetterChangeArgsForCall(0); id != "1" {
+		t.Fatal("the change should be dead-lettered after too many attempts")
+	}
+
+	if _, id := repo.RemoveChangeArgsForCall(0); repo.RemoveChangeCallCount() != 1 || id != "2" {
+		t.Fatal("the later changes should be applied")
+	}
+}
+
+func TestManagerParents(t *testing.T) {
+	ctx := context.Background()
+	control := rbac.NewControl(rbacMemory.NewRepository(), event.NewProducer(&pubsubMocks.FakeProducer{}))
+	repo := memory.NewRepository()
+	m := role.NewManager(zap.NewNop(), repo, control)
+
+	if err := m.Create(ctx, &fakeComplete{
+		role.NewIdentifier("member"),
+		role.NewIncomplete("Member", "", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{}),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := m.Create(ctx, &fakeComplete{
+		role.NewIdentifier("admin"),
+		role.NewIncomplete("Admin", "", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{"Member"}),
+	}); err == nil {
+		t.Fatal("the id of the parent is invalid")
+	}
+
+	if err := m.Create(ctx, &fakeComplete{
+		role.NewIdentifier("admin"),
+		role.NewIncomplete("Admin", "", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{"unknown"}),
+	}); err == nil {
+		t.Fatal("the parent does not exist")
+	}
+
+	if err := m.Create(ctx, &fakeComplete{
+		role.NewIdentifier("admin"),
+		role.NewIncomplete("Admin", "", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{"member"}),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := m.Set(ctx, &fakeComplete{
+		role.NewIdentifier("member"),
+		role.NewIncomplete("Member", "", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{"admin"}),
+	}); err == nil {
+		t.Fatal("the inheritance contains a cycle")
+	}
+
+	if err := m.Set(ctx, &fakeComplete{
+		role.NewIdentifier("member"),
+		role.NewIncomplete("Member", "", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{"member"}),
+	}); err == nil {
+		t.Fatal("a role can not inherit from itself")
+	}
+
+	changes, err := repo.GetChanges(ctx, 10)
+	if err != nil || len(changes) != 0 {
+		t.Fatal("invalid parents should not be stored as changes")
+	}
+
+	if err := m.Create(ctx, &fakeComplete{
+		role.NewIdentifier("faction/test/officer"),
+		role.NewIncomplete("Officer", "", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{"member"}),
+	}); err != nil {
+		t.Fatal("roles can be nested in namespaces")
+	}
+
+	parents, err := control.GetRoleParents(ctx, "member")
+	if err != nil || len(parents) != 0 {
+		t.Fatal("the parents of the role should not be changed")
+	}
+}
+
+// flakyControl fails to set rules while failures are left
+type flakyControl struct {
+	rbac.Control
+	failures int
+}
+
+func (c *flakyControl) SetRoleDenyRules(ctx context.Context, roleID rbac.RoleID, rules rbac.RoleRules) error {
+	if c.failures > 0 {
+		c.failures--
+		return errors.New("rbac service unavailable")
+	}
+
+	return c.Control.SetRoleDenyRules(ctx, roleID, rules)
+}
+
+func TestManagerSyncConvergence(t *testing.T) {
+	ctx := context.Background()
+	rbacRepo := rbacMemory.NewRepository()
+	if err := rbacRepo.RegisterRules(ctx, rbac.RuleCatalog{
+		{Rule: "users.get", Service: "user"},
+		{Rule: "users.delete", Service: "user"},
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	control := &flakyControl{
+		Control: rbac.NewControl(rbacRepo, event.NewProducer(&pubsubMocks.FakeProducer{})),
+	}
+	repo := memory.NewRepository()
+	m := role.NewManager(zap.NewNop(), repo, control)
+
+	control.failures = 100
+	if err := m.Create(ctx, &fakeComplete{
+		role.NewIdentifier("admin"),
+		role.NewIncomplete("Admin", "", rbac.RoleRules{"users.get"}, rbac.RoleRules{}, rbac.RoleParents{}),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := m.Set(ctx, &fakeComplete{
+		role.NewIdentifier("admin"),
+		role.NewIncomplete("Admin", "", rbac.RoleRules{"users.get", "users.delete"}, rbac.RoleRules{}, rbac.RoleParents{}),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := m.Create(ctx, &fakeComplete{
+		role.NewIdentifier("orphan"),
+		role.NewIncomplete("Orphan", "", rbac.RoleRules{"users.get"}, rbac.RoleRules{}, rbac.RoleParents{}),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := m.Delete(ctx, role.NewIdentifier("orphan")); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	changes, err := repo.GetChanges(ctx, 10)
+	if err != nil || len(changes) == 0 {
+		t.Fatal("the changes should be kept while the rbac service fails")
+	}
+
+	control.failures = 1
+	if err := m.Sync(ctx); err == nil {
+		t.Fatal("the rbac service fails")
+	}
+
+	if err := m.Sync(ctx); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	changes, err = repo.GetChanges(ctx, 10)
+	if err != nil || len(changes) != 0 {
+		t.Fatal("all changes should be applied")
+	}
+
+	rules, err := rbacRepo.GetRoleRules(ctx, "admin")
+	if err != nil || len(rules) != 2 || !rules.Contains("users.delete") {
+		t.Fatal("the rules of the role should match the last change")
+	}
+
+	rules, err = rbacRepo.GetRoleRules(ctx, "orphan")
+	if err != nil || len(rules) != 0 {
+		t.Fatal("the rules of a deleted role should be removed")
+	}
+}
+
+func TestManagerList(t *testing.T) {
+	control := &rbacMocks.FakeControl{}
+	repo := &mocks.FakeRepository{}
+	m := role.NewManager(zap.NewNop(), repo, control)
+
+	repo.ListReturns(nil, errors.New("fake error"))
+	if _, err := m.List(context.Background(), pagination.Page{}); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+
+	id := &mocks.FakeIdentifier{}
+	id.IDReturns("testid")
+	repo.ListReturns([]role.Complete{
+		&fakeComplete{
+			id,
+			role.NewIncomplete("title", "description", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{}),
+		},
+	}, nil)
+	control.GetRoleRulesReturns(nil, errors.New("fake error"))
+
+	if _, err := m.List(context.Background(), pagination.Page{}); err == nil {
+		t.Fatal("the rbac service returns an error")
+	}
+
+	control.GetRoleRulesReturns(rbac.RoleRules{
+		"testRule",
+	}, nil)
+
+	roles, err := m.List(context.Background(), pagination.Page{})
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(roles) != 1 || roles[0].Data().Rules[0] != "testRule" {
+		t.Fatal("the rules of the listed roles should be filled")
+	}
+
+	if _, page := repo.ListArgsForCall(0); page.Limit != pagination.DefaultLimit {
+		t.Fatal("an empty limit should be replaced by the default limit")
+	}
+}
+
+func TestManagerSystemRoles(t *testing.T) {
+	control := &rbacMocks.FakeControl{}
+	repo := &mocks.FakeRepository{}
+	m := role.NewManager(zap.NewNop(), repo, control)
+
+	superadmin := &fakeComplete{
+		role.NewIdentifier(role.SuperadminID),
+		role.NewIncomplete("title", "description", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{}),
+	}
+
+	if err := m.Create(context.Background(), superadmin); err == nil {
+		t.Fatal("system roles can not be created")
+	}
+
+	if err := m.Set(context.Background(), superadmin); err == nil {
+		t.Fatal("system roles can not be changed")
+	}
+
+	if err := m.Delete(context.Background(), superadmin); err == nil {
+		t.Fatal("system roles can not be deleted")
+	}
+
+	if repo.CreateCallCount() != 0 ||
+		repo.UpdateCallCount() != 0 ||
+		repo.DeleteCallCount() != 0 ||
+		control.SetRoleRulesCallCount() != 0 {
+		t.Fatal("system roles should not be touched")
+	}
+}
+
+func TestManagerSeedSystemRoles(t *testing.T) {
+	control := &rbacMocks.FakeControl{}
+	repo := &mocks.FakeRepository{}
+	repo.AcquireSyncLeaseReturns(true, nil)
+	m := role.NewManager(zap.NewNop(), repo, control)
+
+	repo.GetReturns(nil, errors.New("fake error"))
+	if err := m.SeedSystemRoles(context.Background()); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+
+	repo.GetReturns(nil, sql.ErrNoRows)
+	repo.GetChangesReturnsOnCall(0, []role.Change{
+		role.NewChange(context.Background(), role.SuperadminID, rbac.RoleRules{rbac.Wildcard}, nil, nil),
+	}, nil)
+	if err := m.SeedSystemRoles(context.Background()); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if repo.CreateCallCount() != len(role.SystemRoles) {
+		t.Fatal("missing system roles should be created")
+	}
+
+	_, roleID, rules := control.SetRoleRulesArgsForCall(0)
+	if roleID != role.SuperadminID || !rules.Contains(rbac.Wildcard) {
+		t.Fatal("the superadmin should be granted every rule")
+	}
+
+	repo.GetReturns(role.SystemRoles[0], nil)
+	if err := m.SeedSystemRoles(context.Background()); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if repo.UpdateCallCount() != len(role.SystemRoles) {
+		t.Fatal("existing system roles should be reset")
+	}
+
+	repo.GetChangesReturns(nil, errors.New("fake error"))
+	if err := m.SeedSystemRoles(context.Background()); err == nil {
+		t.Fatal("the rbac service returns an error")
+	}
+}
+
+func TestManagerBootstrap(t *testing.T) {
+	control := &rbacMocks.FakeControl{}
+	repo := &mocks.FakeRepository{}
+	m := role.NewManager(zap.NewNop(), repo, control)
+
+	if err := m.Bootstrap(context.Background(), ""); err == nil {
+		t.Fatal("empty account id")
+	}
+
+	control.ListRoleAccountsReturns(nil, errors.New("fake error"))
+	if err := m.Bootstrap(context.Background(), "user/1"); err == nil {
+		t.Fatal("the rbac service returns an error")
+	}
+
+	control.ListRoleAccountsReturns([]rbac.AccountID{"user/2"}, nil)
+	if err := m.Bootstrap(context.Background(), "user/1"); err != role.ErrAlreadyBootstrapped {
+		t.Fatal("the superadmin role is already held by an account")
+	}
+
+	control.ListRoleAccountsReturns([]rbac.AccountID{}, nil)
+	control.GetAccountRolesReturns(rbac.AccountRoles{"member"}, nil)
+	if err := m.Bootstrap(context.Background(), "user/1"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	_, accountID, roles := control.SetAccountRolesArgsForCall(0)
+	if accountID != "user/1" || !roles.Contains(role.SuperadminID) || !roles.Contains("member") {
+		t.Fatal("the superadmin role should be added to the roles of the account")
+	}
 }
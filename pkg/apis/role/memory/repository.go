@@ -0,0 +1,244 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/role"
+	"github.com/51st-state/api/pkg/pagination"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+type complete struct {
+	role.Identifier
+	role.Incomplete
+}
+
+func (c *complete) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		ID          rbac.RoleID      `json:"id"`
+		Title       string           `json:"title"`
+		Description string           `json:"description"`
+		Rules       rbac.RoleRules   `json:"rules"`
+		DenyRules   rbac.RoleRules   `json:"deny_rules"`
+		Parents     rbac.RoleParents `json:"parents"`
+	}{
+		c.ID(),
+		c.Data().Title,
+		c.Data().Description,
+		c.Data().Rules,
+		c.Data().DenyRules,
+		c.Data().Parents,
+	})
+}
+
+var errDuplicateID = errors.New("duplicate role id")
+
+// info of a role stored by the repository,
+// the rules of a role are stored by the rbac system
+type info struct {
+	title       string
+	description string
+}
+
+type repository struct {
+	mutex sync.RWMutex
+	roles map[rbac.RoleID]info
+	// changes not yet applied to the rbac system in the order they were added
+	changes  []role.Change
+	changeID int
+	// deadLetters are changes which could not be applied
+	deadLetters []role.Change
+	// leaseHolder applies the changes until leaseExpiry
+	leaseHolder string
+	leaseExpiry time.Time
+}
+
+// NewRepository for storage of role information in memory
+func NewRepository() role.Repository {
+	return &repository{
+		roles: make(map[rbac.RoleID]info),
+	}
+}
+
+func (r *repository) get(id rbac.RoleID) role.Complete {
+	return &complete{
+		role.NewIdentifier(id),
+		role.NewIncomplete(
+			r.roles[id].title,
+			r.roles[id].description,
+			make(rbac.RoleRules, 0),
+			make(rbac.RoleRules, 0),
+			make(rbac.RoleParents, 0),
+		),
+	}
+}
+
+func (r *repository) Get(ctx context.Context, id role.Identifier) (role.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if _, ok := r.roles[id.ID()]; !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return r.get(id.ID()), nil
+}
+
+func (r *repository) Update(ctx context.Context, c role.Complete) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.roles[c.ID()]; !ok {
+		return sql.ErrNoRows
+	}
+
+	r.roles[c.ID()] = info{c.Data().Title, c.Data().Description}
+	r.addChange(role.NewChange(ctx, c.ID(), c.Data().Rules, c.Data().DenyRules, c.Data().Parents))
+
+	return nil
+}
+
+func (r *repository) addChange(change role.Change) {
+	r.changeID++
+	change.ID = strconv.Itoa(r.changeID)
+	r.changes = append(r.changes, change)
+}
+
+func (r *repository) Create(ctx context.Context, c role.Complete) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.roles[c.ID()]; ok {
+		return errDuplicateID
+	}
+
+	r.roles[c.ID()] = info{c.Data().Title, c.Data().Description}
+	r.addChange(role.NewChange(ctx, c.ID(), c.Data().Rules, c.Data().DenyRules, c.Data().Parents))
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id role.Identifier) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.roles, id.ID())
+	r.addChange(role.NewChange(ctx, id.ID(), nil, nil, nil))
+
+	return nil
+}
+
+func (r *repository) List(ctx context.Context, page pagination.Page) ([]role.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	ids := make([]string, 0)
+	for v := range r.roles {
+		ids = append(ids, string(v))
+	}
+
+	roles := make([]role.Complete, 0)
+	for _, v := range page.Apply(ids) {
+		roles = append(roles, r.get(rbac.RoleID(v)))
+	}
+
+	return roles, nil
+}
+
+func (r *repository) GetChanges(ctx context.Context, limit uint64) ([]role.Change, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	changes := make([]role.Change, 0)
+	for _, v := range r.changes {
+		if uint64(len(changes)) >= limit {
+			break
+		}
+
+		changes = append(changes, role.Change{
+			ID:        v.ID,
+			Actor:     v.Actor,
+			RoleID:    v.RoleID,
+			Rules:     append(make(rbac.RoleRules, 0), v.Rules...),
+			DenyRules: append(make(rbac.RoleRules, 0), v.DenyRules...),
+			Parents:   append(make(rbac.RoleParents, 0), v.Parents...),
+			Attempts:  v.Attempts,
+		})
+	}
+
+	return changes, nil
+}
+
+func (r *repository) RemoveChange(ctx context.Context, id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, v := range r.changes {
+		if v.ID == id {
+			r.changes = append(r.changes[:i], r.changes[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) FailChange(ctx context.Context, id string, reason string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, v := range r.changes {
+		if v.ID == id {
+			r.changes[i].Attempts++
+			break
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) DeadLetterChange(ctx context.Context, id string, reason string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, v := range r.changes {
+		if v.ID == id {
+			r.deadLetters = append(r.deadLetters, v)
+			r.changes = append(r.changes[:i], r.changes[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) AcquireSyncLease(ctx context.Context, holder string, ttl time.Duration) (bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.leaseHolder != "" && r.leaseHolder != holder && time.Now().Before(r.leaseExpiry) {
+		return false, nil
+	}
+
+	r.leaseHolder = holder
+	r.leaseExpiry = time.Now().Add(ttl)
+
+	return true, nil
+}
+
+func (r *repository) ReleaseSyncLease(ctx context.Context, holder string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.leaseHolder == holder {
+		r.leaseHolder = ""
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/role"
+	"github.com/51st-state/api/pkg/apis/role/memory"
+	"github.com/51st-state/api/pkg/apis/role/repositorytest"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) role.Repository {
+		return memory.NewRepository()
+	})
+}
@@ -6,6 +6,8 @@ import (
 	"sync"
 
 	"github.com/51st-state/api/pkg/apis/role"
+	"github.com/51st-state/api/pkg/pagination"
+	"github.com/51st-state/api/pkg/rbac"
 )
 
 type FakeManager struct {
@@ -59,6 +61,54 @@ type FakeManager struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ListStub        func(context.Context, pagination.Page) ([]role.Complete, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 context.Context
+		arg2 pagination.Page
+	}
+	listReturns struct {
+		result1 []role.Complete
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []role.Complete
+		result2 error
+	}
+	SeedSystemRolesStub        func(context.Context) error
+	seedSystemRolesMutex       sync.RWMutex
+	seedSystemRolesArgsForCall []struct {
+		arg1 context.Context
+	}
+	seedSystemRolesReturns struct {
+		result1 error
+	}
+	seedSystemRolesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	BootstrapStub        func(context.Context, rbac.AccountID) error
+	bootstrapMutex       sync.RWMutex
+	bootstrapArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.AccountID
+	}
+	bootstrapReturns struct {
+		result1 error
+	}
+	bootstrapReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SyncStub        func(context.Context) error
+	syncMutex       sync.RWMutex
+	syncArgsForCall []struct {
+		arg1 context.Context
+	}
+	syncReturns struct {
+		result1 error
+	}
+	syncReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -262,6 +312,203 @@ func (fake *FakeManager) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeManager) List(arg1 context.Context, arg2 pagination.Page) ([]role.Complete, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 context.Context
+		arg2 pagination.Page
+	}{arg1, arg2})
+	fake.recordInvocation("List", []interface{}{arg1, arg2})
+	fake.listMutex.Unlock()
+	if fake.ListStub != nil {
+		return fake.ListStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listReturns.result1, fake.listReturns.result2
+}
+
+func (fake *FakeManager) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeManager) ListArgsForCall(i int) (context.Context, pagination.Page) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return fake.listArgsForCall[i].arg1, fake.listArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) ListReturns(result1 []role.Complete, result2 error) {
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []role.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) ListReturnsOnCall(i int, result1 []role.Complete, result2 error) {
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []role.Complete
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []role.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) SeedSystemRoles(arg1 context.Context) error {
+	fake.seedSystemRolesMutex.Lock()
+	ret, specificReturn := fake.seedSystemRolesReturnsOnCall[len(fake.seedSystemRolesArgsForCall)]
+	fake.seedSystemRolesArgsForCall = append(fake.seedSystemRolesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("SeedSystemRoles", []interface{}{arg1})
+	fake.seedSystemRolesMutex.Unlock()
+	if fake.SeedSystemRolesStub != nil {
+		return fake.SeedSystemRolesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.seedSystemRolesReturns.result1
+}
+
+func (fake *FakeManager) SeedSystemRolesCallCount() int {
+	fake.seedSystemRolesMutex.RLock()
+	defer fake.seedSystemRolesMutex.RUnlock()
+	return len(fake.seedSystemRolesArgsForCall)
+}
+
+func (fake *FakeManager) SeedSystemRolesArgsForCall(i int) context.Context {
+	fake.seedSystemRolesMutex.RLock()
+	defer fake.seedSystemRolesMutex.RUnlock()
+	return fake.seedSystemRolesArgsForCall[i].arg1
+}
+
+func (fake *FakeManager) SeedSystemRolesReturns(result1 error) {
+	fake.SeedSystemRolesStub = nil
+	fake.seedSystemRolesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) SeedSystemRolesReturnsOnCall(i int, result1 error) {
+	fake.SeedSystemRolesStub = nil
+	if fake.seedSystemRolesReturnsOnCall == nil {
+		fake.seedSystemRolesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.seedSystemRolesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Bootstrap(arg1 context.Context, arg2 rbac.AccountID) error {
+	fake.bootstrapMutex.Lock()
+	ret, specificReturn := fake.bootstrapReturnsOnCall[len(fake.bootstrapArgsForCall)]
+	fake.bootstrapArgsForCall = append(fake.bootstrapArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.AccountID
+	}{arg1, arg2})
+	fake.recordInvocation("Bootstrap", []interface{}{arg1, arg2})
+	fake.bootstrapMutex.Unlock()
+	if fake.BootstrapStub != nil {
+		return fake.BootstrapStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.bootstrapReturns.result1
+}
+
+func (fake *FakeManager) BootstrapCallCount() int {
+	fake.bootstrapMutex.RLock()
+	defer fake.bootstrapMutex.RUnlock()
+	return len(fake.bootstrapArgsForCall)
+}
+
+func (fake *FakeManager) BootstrapArgsForCall(i int) (context.Context, rbac.AccountID) {
+	fake.bootstrapMutex.RLock()
+	defer fake.bootstrapMutex.RUnlock()
+	return fake.bootstrapArgsForCall[i].arg1, fake.bootstrapArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) BootstrapReturns(result1 error) {
+	fake.BootstrapStub = nil
+	fake.bootstrapReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) BootstrapReturnsOnCall(i int, result1 error) {
+	fake.BootstrapStub = nil
+	if fake.bootstrapReturnsOnCall == nil {
+		fake.bootstrapReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.bootstrapReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Sync(arg1 context.Context) error {
+	fake.syncMutex.Lock()
+	ret, specificReturn := fake.syncReturnsOnCall[len(fake.syncArgsForCall)]
+	fake.syncArgsForCall = append(fake.syncArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("Sync", []interface{}{arg1})
+	fake.syncMutex.Unlock()
+	if fake.SyncStub != nil {
+		return fake.SyncStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.syncReturns.result1
+}
+
+func (fake *FakeManager) SyncCallCount() int {
+	fake.syncMutex.RLock()
+	defer fake.syncMutex.RUnlock()
+	return len(fake.syncArgsForCall)
+}
+
+func (fake *FakeManager) SyncArgsForCall(i int) context.Context {
+	fake.syncMutex.RLock()
+	defer fake.syncMutex.RUnlock()
+	return fake.syncArgsForCall[i].arg1
+}
+
+func (fake *FakeManager) SyncReturns(result1 error) {
+	fake.SyncStub = nil
+	fake.syncReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) SyncReturnsOnCall(i int, result1 error) {
+	fake.SyncStub = nil
+	if fake.syncReturnsOnCall == nil {
+		fake.syncReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.syncReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -273,6 +520,14 @@ func (fake *FakeManager) Invocations() map[string][][]interface{} {
 	defer fake.createMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.seedSystemRolesMutex.RLock()
+	defer fake.seedSystemRolesMutex.RUnlock()
+	fake.bootstrapMutex.RLock()
+	defer fake.bootstrapMutex.RUnlock()
+	fake.syncMutex.RLock()
+	defer fake.syncMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
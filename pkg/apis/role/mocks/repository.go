@@ -4,8 +4,10 @@ package mocks
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/51st-state/api/pkg/apis/role"
+	"github.com/51st-state/api/pkg/pagination"
 )
 
 type FakeRepository struct {
@@ -59,6 +61,99 @@ type FakeRepository struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ListStub        func(context.Context, pagination.Page) ([]role.Complete, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 context.Context
+		arg2 pagination.Page
+	}
+	listReturns struct {
+		result1 []role.Complete
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []role.Complete
+		result2 error
+	}
+	GetChangesStub        func(context.Context, uint64) ([]role.Change, error)
+	getChangesMutex       sync.RWMutex
+	getChangesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uint64
+	}
+	getChangesReturns struct {
+		result1 []role.Change
+		result2 error
+	}
+	getChangesReturnsOnCall map[int]struct {
+		result1 []role.Change
+		result2 error
+	}
+	RemoveChangeStub        func(context.Context, string) error
+	removeChangeMutex       sync.RWMutex
+	removeChangeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	removeChangeReturns struct {
+		result1 error
+	}
+	removeChangeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FailChangeStub        func(context.Context, string, string) error
+	failChangeMutex       sync.RWMutex
+	failChangeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	failChangeReturns struct {
+		result1 error
+	}
+	failChangeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeadLetterChangeStub        func(context.Context, string, string) error
+	deadLetterChangeMutex       sync.RWMutex
+	deadLetterChangeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	deadLetterChangeReturns struct {
+		result1 error
+	}
+	deadLetterChangeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AcquireSyncLeaseStub        func(context.Context, string, time.Duration) (bool, error)
+	acquireSyncLeaseMutex       sync.RWMutex
+	acquireSyncLeaseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Duration
+	}
+	acquireSyncLeaseReturns struct {
+		result1 bool
+		result2 error
+	}
+	acquireSyncLeaseReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ReleaseSyncLeaseStub        func(context.Context, string) error
+	releaseSyncLeaseMutex       sync.RWMutex
+	releaseSyncLeaseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	releaseSyncLeaseReturns struct {
+		result1 error
+	}
+	releaseSyncLeaseReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -262,6 +357,361 @@ func (fake *FakeRepository) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeRepository) List(arg1 context.Context, arg2 pagination.Page) ([]role.Complete, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 context.Context
+		arg2 pagination.Page
+	}{arg1, arg2})
+	fake.recordInvocation("List", []interface{}{arg1, arg2})
+	fake.listMutex.Unlock()
+	if fake.ListStub != nil {
+		return fake.ListStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listReturns.result1, fake.listReturns.result2
+}
+
+func (fake *FakeRepository) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeRepository) ListArgsForCall(i int) (context.Context, pagination.Page) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return fake.listArgsForCall[i].arg1, fake.listArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) ListReturns(result1 []role.Complete, result2 error) {
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []role.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListReturnsOnCall(i int, result1 []role.Complete, result2 error) {
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []role.Complete
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []role.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetChanges(arg1 context.Context, arg2 uint64) ([]role.Change, error) {
+	fake.getChangesMutex.Lock()
+	ret, specificReturn := fake.getChangesReturnsOnCall[len(fake.getChangesArgsForCall)]
+	fake.getChangesArgsForCall = append(fake.getChangesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uint64
+	}{arg1, arg2})
+	fake.recordInvocation("GetChanges", []interface{}{arg1, arg2})
+	fake.getChangesMutex.Unlock()
+	if fake.GetChangesStub != nil {
+		return fake.GetChangesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getChangesReturns.result1, fake.getChangesReturns.result2
+}
+
+func (fake *FakeRepository) GetChangesCallCount() int {
+	fake.getChangesMutex.RLock()
+	defer fake.getChangesMutex.RUnlock()
+	return len(fake.getChangesArgsForCall)
+}
+
+func (fake *FakeRepository) GetChangesArgsForCall(i int) (context.Context, uint64) {
+	fake.getChangesMutex.RLock()
+	defer fake.getChangesMutex.RUnlock()
+	return fake.getChangesArgsForCall[i].arg1, fake.getChangesArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetChangesReturns(result1 []role.Change, result2 error) {
+	fake.GetChangesStub = nil
+	fake.getChangesReturns = struct {
+		result1 []role.Change
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetChangesReturnsOnCall(i int, result1 []role.Change, result2 error) {
+	fake.GetChangesStub = nil
+	if fake.getChangesReturnsOnCall == nil {
+		fake.getChangesReturnsOnCall = make(map[int]struct {
+			result1 []role.Change
+			result2 error
+		})
+	}
+	fake.getChangesReturnsOnCall[i] = struct {
+		result1 []role.Change
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) RemoveChange(arg1 context.Context, arg2 string) error {
+	fake.removeChangeMutex.Lock()
+	ret, specificReturn := fake.removeChangeReturnsOnCall[len(fake.removeChangeArgsForCall)]
+	fake.removeChangeArgsForCall = append(fake.removeChangeArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RemoveChange", []interface{}{arg1, arg2})
+	fake.removeChangeMutex.Unlock()
+	if fake.RemoveChangeStub != nil {
+		return fake.RemoveChangeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.removeChangeReturns.result1
+}
+
+func (fake *FakeRepository) RemoveChangeCallCount() int {
+	fake.removeChangeMutex.RLock()
+	defer fake.removeChangeMutex.RUnlock()
+	return len(fake.removeChangeArgsForCall)
+}
+
+func (fake *FakeRepository) RemoveChangeArgsForCall(i int) (context.Context, string) {
+	fake.removeChangeMutex.RLock()
+	defer fake.removeChangeMutex.RUnlock()
+	return fake.removeChangeArgsForCall[i].arg1, fake.removeChangeArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) RemoveChangeReturns(result1 error) {
+	fake.RemoveChangeStub = nil
+	fake.removeChangeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) RemoveChangeReturnsOnCall(i int, result1 error) {
+	fake.RemoveChangeStub = nil
+	if fake.removeChangeReturnsOnCall == nil {
+		fake.removeChangeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeChangeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) FailChange(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.failChangeMutex.Lock()
+	ret, specificReturn := fake.failChangeReturnsOnCall[len(fake.failChangeArgsForCall)]
+	fake.failChangeArgsForCall = append(fake.failChangeArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("FailChange", []interface{}{arg1, arg2, arg3})
+	fake.failChangeMutex.Unlock()
+	if fake.FailChangeStub != nil {
+		return fake.FailChangeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.failChangeReturns.result1
+}
+
+func (fake *FakeRepository) FailChangeCallCount() int {
+	fake.failChangeMutex.RLock()
+	defer fake.failChangeMutex.RUnlock()
+	return len(fake.failChangeArgsForCall)
+}
+
+func (fake *FakeRepository) FailChangeArgsForCall(i int) (context.Context, string, string) {
+	fake.failChangeMutex.RLock()
+	defer fake.failChangeMutex.RUnlock()
+	return fake.failChangeArgsForCall[i].arg1, fake.failChangeArgsForCall[i].arg2, fake.failChangeArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) FailChangeReturns(result1 error) {
+	fake.FailChangeStub = nil
+	fake.failChangeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) FailChangeReturnsOnCall(i int, result1 error) {
+	fake.FailChangeStub = nil
+	if fake.failChangeReturnsOnCall == nil {
+		fake.failChangeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.failChangeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeadLetterChange(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.deadLetterChangeMutex.Lock()
+	ret, specificReturn := fake.deadLetterChangeReturnsOnCall[len(fake.deadLetterChangeArgsForCall)]
+	fake.deadLetterChangeArgsForCall = append(fake.deadLetterChangeArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeadLetterChange", []interface{}{arg1, arg2, arg3})
+	fake.deadLetterChangeMutex.Unlock()
+	if fake.DeadLetterChangeStub != nil {
+		return fake.DeadLetterChangeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deadLetterChangeReturns.result1
+}
+
+func (fake *FakeRepository) DeadLetterChangeCallCount() int {
+	fake.deadLetterChangeMutex.RLock()
+	defer fake.deadLetterChangeMutex.RUnlock()
+	return len(fake.deadLetterChangeArgsForCall)
+}
+
+func (fake *FakeRepository) DeadLetterChangeArgsForCall(i int) (context.Context, string, string) {
+	fake.deadLetterChangeMutex.RLock()
+	defer fake.deadLetterChangeMutex.RUnlock()
+	return fake.deadLetterChangeArgsForCall[i].arg1, fake.deadLetterChangeArgsForCall[i].arg2, fake.deadLetterChangeArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) DeadLetterChangeReturns(result1 error) {
+	fake.DeadLetterChangeStub = nil
+	fake.deadLetterChangeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeadLetterChangeReturnsOnCall(i int, result1 error) {
+	fake.DeadLetterChangeStub = nil
+	if fake.deadLetterChangeReturnsOnCall == nil {
+		fake.deadLetterChangeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deadLetterChangeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) AcquireSyncLease(arg1 context.Context, arg2 string, arg3 time.Duration) (bool, error) {
+	fake.acquireSyncLeaseMutex.Lock()
+	ret, specificReturn := fake.acquireSyncLeaseReturnsOnCall[len(fake.acquireSyncLeaseArgsForCall)]
+	fake.acquireSyncLeaseArgsForCall = append(fake.acquireSyncLeaseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 time.Duration
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AcquireSyncLease", []interface{}{arg1, arg2, arg3})
+	fake.acquireSyncLeaseMutex.Unlock()
+	if fake.AcquireSyncLeaseStub != nil {
+		return fake.AcquireSyncLeaseStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.acquireSyncLeaseReturns.result1, fake.acquireSyncLeaseReturns.result2
+}
+
+func (fake *FakeRepository) AcquireSyncLeaseCallCount() int {
+	fake.acquireSyncLeaseMutex.RLock()
+	defer fake.acquireSyncLeaseMutex.RUnlock()
+	return len(fake.acquireSyncLeaseArgsForCall)
+}
+
+func (fake *FakeRepository) AcquireSyncLeaseArgsForCall(i int) (context.Context, string, time.Duration) {
+	fake.acquireSyncLeaseMutex.RLock()
+	defer fake.acquireSyncLeaseMutex.RUnlock()
+	return fake.acquireSyncLeaseArgsForCall[i].arg1, fake.acquireSyncLeaseArgsForCall[i].arg2, fake.acquireSyncLeaseArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) AcquireSyncLeaseReturns(result1 bool, result2 error) {
+	fake.AcquireSyncLeaseStub = nil
+	fake.acquireSyncLeaseReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) AcquireSyncLeaseReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.AcquireSyncLeaseStub = nil
+	if fake.acquireSyncLeaseReturnsOnCall == nil {
+		fake.acquireSyncLeaseReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.acquireSyncLeaseReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ReleaseSyncLease(arg1 context.Context, arg2 string) error {
+	fake.releaseSyncLeaseMutex.Lock()
+	ret, specificReturn := fake.releaseSyncLeaseReturnsOnCall[len(fake.releaseSyncLeaseArgsForCall)]
+	fake.releaseSyncLeaseArgsForCall = append(fake.releaseSyncLeaseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ReleaseSyncLease", []interface{}{arg1, arg2})
+	fake.releaseSyncLeaseMutex.Unlock()
+	if fake.ReleaseSyncLeaseStub != nil {
+		return fake.ReleaseSyncLeaseStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.releaseSyncLeaseReturns.result1
+}
+
+func (fake *FakeRepository) ReleaseSyncLeaseCallCount() int {
+	fake.releaseSyncLeaseMutex.RLock()
+	defer fake.releaseSyncLeaseMutex.RUnlock()
+	return len(fake.releaseSyncLeaseArgsForCall)
+}
+
+func (fake *FakeRepository) ReleaseSyncLeaseArgsForCall(i int) (context.Context, string) {
+	fake.releaseSyncLeaseMutex.RLock()
+	defer fake.releaseSyncLeaseMutex.RUnlock()
+	return fake.releaseSyncLeaseArgsForCall[i].arg1, fake.releaseSyncLeaseArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) ReleaseSyncLeaseReturns(result1 error) {
+	fake.ReleaseSyncLeaseStub = nil
+	fake.releaseSyncLeaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) ReleaseSyncLeaseReturnsOnCall(i int, result1 error) {
+	fake.ReleaseSyncLeaseStub = nil
+	if fake.releaseSyncLeaseReturnsOnCall == nil {
+		fake.releaseSyncLeaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseSyncLeaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -273,6 +723,20 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.createMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.getChangesMutex.RLock()
+	defer fake.getChangesMutex.RUnlock()
+	fake.removeChangeMutex.RLock()
+	defer fake.removeChangeMutex.RUnlock()
+	fake.failChangeMutex.RLock()
+	defer fake.failChangeMutex.RUnlock()
+	fake.deadLetterChangeMutex.RLock()
+	defer fake.deadLetterChangeMutex.RUnlock()
+	fake.acquireSyncLeaseMutex.RLock()
+	defer fake.acquireSyncLeaseMutex.RUnlock()
+	fake.releaseSyncLeaseMutex.RLock()
+	defer fake.releaseSyncLeaseMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -1,12 +1,39 @@
 package role
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/51st-state/api/pkg/pagination"
+)
 
 // Repository for storage of role information
 //go:generate counterfeiter -o ./mocks/repository.go . Repository
 type Repository interface {
 	Get(context.Context, Identifier) (Complete, error)
+	// Update the information of an existing role and store the change
+	// of its rules in the same transaction, returns sql.ErrNoRows
+	// if the role does not exist
 	Update(context.Context, Complete) error
+	// Create a role and store the change of its rules in the same transaction
 	Create(context.Context, Complete) error
+	// Delete a role and store the removal of its rules in the same transaction
 	Delete(context.Context, Identifier) error
+	List(context.Context, pagination.Page) ([]Complete, error)
+	// GetChanges returns the oldest changes not yet applied to the rbac system
+	GetChanges(context.Context, uint64) ([]Change, error)
+	// RemoveChange removes a change after it has been applied
+	RemoveChange(context.Context, string) error
+	// FailChange records a failed attempt to apply a change with its reason
+	FailChange(context.Context, string, string) error
+	// DeadLetterChange keeps a change which can not be applied with its reason,
+	// but no longer returns it as a change to apply
+	DeadLetterChange(context.Context, string, string) error
+	// AcquireSyncLease claims the application of the changes for a holder
+	// for the given duration, returns false if another holder claimed it
+	// and its lease did not expire yet. A holder renews its lease by
+	// acquiring it again.
+	AcquireSyncLease(context.Context, string, time.Duration) (bool, error)
+	// ReleaseSyncLease releases the lease of a holder
+	ReleaseSyncLease(context.Context, string) error
 }
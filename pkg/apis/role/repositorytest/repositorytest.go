@@ -0,0 +1,301 @@
+// Package repositorytest contains the conformance tests of role repositories
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/role"
+	"github.com/51st-state/api/pkg/pagination"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) role.Repository) {
+	t.Run("Create", func(t *testing.T) {
+		testCreate(t, newRepository(t))
+	})
+	t.Run("Update", func(t *testing.T) {
+		testUpdate(t, newRepository(t))
+	})
+	t.Run("Delete", func(t *testing.T) {
+		testDelete(t, newRepository(t))
+	})
+	t.Run("List", func(t *testing.T) {
+		testList(t, newRepository(t))
+	})
+	t.Run("Changes", func(t *testing.T) {
+		testChanges(t, newRepository(t))
+	})
+	t.Run("SyncLease", func(t *testing.T) {
+		testSyncLease(t, newRepository(t))
+	})
+}
+
+type complete struct {
+	role.Identifier
+	role.Incomplete
+}
+
+func newComplete(id rbac.RoleID, title, description string) role.Complete {
+	return &complete{
+		role.NewIdentifier(id),
+		role.NewIncomplete(title, description, rbac.RoleRules{"users.get"}, rbac.RoleRules{}, rbac.RoleParents{}),
+	}
+}
+
+func testCreate(t *testing.T, r role.Repository) {
+	ctx := context.Background()
+
+	if _, err := r.Get(ctx, role.NewIdentifier("unknown")); err != sql.ErrNoRows {
+		t.Fatal("an unknown role should not be found")
+	}
+
+	if err := r.Create(ctx, newComplete("system/admin", "Admin", "All permissions")); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Create(ctx, newComplete("system/admin", "Other", "")); err == nil {
+		t.Fatal("the role id is already used")
+	}
+
+	c, err := r.Get(ctx, role.NewIdentifier("system/admin"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.ID() != "system/admin" || c.Data().Title != "Admin" || c.Data().Description != "All permissions" {
+		t.Fatal("the stored data is not equal")
+	}
+
+	if c.Data().Rules == nil || len(c.Data().Rules) != 0 {
+		t.Fatal("the rules of a role are not stored by the repository")
+	}
+}
+
+func testUpdate(t *testing.T, r role.Repository) {
+	ctx := context.Background()
+
+	if err := r.Create(ctx, newComplete("role", "title", "description")); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Update(ctx, newComplete("role", "new title", "new description")); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	c, err := r.Get(ctx, role.NewIdentifier("role"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Data().Title != "new title" || c.Data().Description != "new description" {
+		t.Fatal("the role should be updated")
+	}
+
+	if err := r.Update(ctx, newComplete("unknown", "title", "description")); err != sql.ErrNoRows {
+		t.Fatal("updating an unknown role should return sql.ErrNoRows")
+	}
+
+	if _, err := r.Get(ctx, role.NewIdentifier("unknown")); err != sql.ErrNoRows {
+		t.Fatal("updating an unknown role should not create it")
+	}
+}
+
+func testDelete(t *testing.T, r role.Repository) {
+	ctx := context.Background()
+
+	if err := r.Create(ctx, newComplete("role", "title", "description")); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Delete(ctx, role.NewIdentifier("role")); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Get(ctx, role.NewIdentifier("role")); err != sql.ErrNoRows {
+		t.Fatal("the role should be deleted")
+	}
+
+	if err := r.Delete(ctx, role.NewIdentifier("unknown")); err != nil {
+		t.Fatal("deleting an unknown role should not return an error")
+	}
+
+	if err := r.Create(ctx, newComplete("role", "title", "description")); err != nil {
+		t.Fatal("the id of a deleted role should be available again")
+	}
+}
+
+func testList(t *testing.T, r role.Repository) {
+	ctx := context.Background()
+
+	roles, err := r.List(ctx, pagination.New("", 2))
+	if err != nil || roles == nil || len(roles) != 0 {
+		t.Fatal("there should be no roles")
+	}
+
+	for _, v := range []rbac.RoleID{"c", "a", "b"} {
+		if err := r.Create(ctx, newComplete(v, "title "+string(v), "")); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	roles, err = r.List(ctx, pagination.New("", 2))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(roles) != 2 || roles[0].ID() != "a" || roles[1].ID() != "b" {
+		t.Fatal("the first page should contain the first roles ordered by their id")
+	}
+
+	if roles[0].Data().Title != "title a" {
+		t.Fatal("the listed roles should contain their data")
+	}
+
+	roles, err = r.List(ctx, pagination.New("b", 2))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(roles) != 1 || roles[0].ID() != "c" {
+		t.Fatal("the page should start after the cursor")
+	}
+}
+
+func testChanges(t *testing.T, r role.Repository) {
+	ctx := rbac.ActorToContext(context.Background(), "user/1")
+
+	changes, err := r.GetChanges(ctx, 10)
+	if err != nil || changes == nil || len(changes) != 0 {
+		t.Fatal("there should be no changes")
+	}
+
+	if err := r.Create(ctx, newComplete("admin", "Admin", "")); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Create(ctx, newComplete("admin", "Admin", "")); err == nil {
+		t.Fatal("a failed creation should not store a change")
+	}
+
+	if err := r.Update(ctx, newComplete("unknown", "Unknown", "")); err != sql.ErrNoRows {
+		t.Fatal("a failed update should not store a change")
+	}
+
+	if err := r.Update(ctx, &complete{
+		role.NewIdentifier("admin"),
+		role.NewIncomplete("Admin", "", rbac.RoleRules{"users.get", "users.delete"}, rbac.RoleRules{"users.create"}, rbac.RoleParents{"member"}),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Delete(ctx, role.NewIdentifier("admin")); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	changes, err = r.GetChanges(ctx, 10)
+	if err != nil || len(changes) != 3 {
+		t.Fatal("the creation, the update and the deletion should be stored as changes")
+	}
+
+	created, updated, deleted := changes[0], changes[1], changes[2]
+	if created.ID == "" ||
+		created.Actor != "user/1" ||
+		created.RoleID != "admin" ||
+		len(created.Rules) != 1 ||
+		created.Rules[0] != "users.get" {
+		t.Fatal("the creation should store the rules of the role")
+	}
+
+	if len(updated.Rules) != 2 ||
+		len(updated.DenyRules) != 1 ||
+		updated.DenyRules[0] != "users.create" ||
+		len(updated.Parents) != 1 ||
+		updated.Parents[0] != "member" {
+		t.Fatal("the update should store the new rules of the role")
+	}
+
+	if deleted.RoleID != "admin" ||
+		deleted.Rules == nil ||
+		len(deleted.Rules) != 0 ||
+		len(deleted.DenyRules) != 0 ||
+		len(deleted.Parents) != 0 {
+		t.Fatal("the deletion should remove all rules of the role")
+	}
+
+	changes, err = r.GetChanges(ctx, 1)
+	if err != nil || len(changes) != 1 || changes[0].ID != created.ID {
+		t.Fatal("the oldest changes should be returned first")
+	}
+
+	if err := r.RemoveChange(ctx, created.ID); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.RemoveChange(ctx, created.ID); err != nil {
+		t.Fatal("removing an unknown change should not fail")
+	}
+
+	changes, err = r.GetChanges(ctx, 10)
+	if err != nil || len(changes) != 2 || changes[0].ID != updated.ID {
+		t.Fatal("the removed change should not be returned")
+	}
+
+	if err := r.FailChange(ctx, updated.ID, "fake error"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	changes, err = r.GetChanges(ctx, 10)
+	if err != nil || len(changes) != 2 || changes[0].Attempts != 1 || changes[1].Attempts != 0 {
+		t.Fatal("the failed attempt should be counted")
+	}
+
+	if err := r.DeadLetterChange(ctx, updated.ID, "fake error"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	changes, err = r.GetChanges(ctx, 10)
+	if err != nil || len(changes) != 1 || changes[0].ID != deleted.ID {
+		t.Fatal("the dead-lettered change should not be returned")
+	}
+}
+
+func testSyncLease(t *testing.T, r role.Repository) {
+	ctx := context.Background()
+
+	if ok, err := r.AcquireSyncLease(ctx, "first", time.Minute); err != nil || !ok {
+		t.Fatal("the lease should be acquired")
+	}
+
+	if ok, err := r.AcquireSyncLease(ctx, "first", time.Minute); err != nil || !ok {
+		t.Fatal("the holder should renew its lease")
+	}
+
+	if ok, err := r.AcquireSyncLease(ctx, "second", time.Minute); err != nil || ok {
+		t.Fatal("the lease should not be acquired while it is held")
+	}
+
+	if err := r.ReleaseSyncLease(ctx, "second"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if ok, err := r.AcquireSyncLease(ctx, "second", time.Minute); err != nil || ok {
+		t.Fatal("only the holder should release its lease")
+	}
+
+	if err := r.ReleaseSyncLease(ctx, "first"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if ok, err := r.AcquireSyncLease(ctx, "second", -time.Minute); err != nil || !ok {
+		t.Fatal("the released lease should be acquired")
+	}
+
+	if ok, err := r.AcquireSyncLease(ctx, "first", time.Minute); err != nil || !ok {
+		t.Fatal("the expired lease should be acquired")
+	}
+}
@@ -0,0 +1,21 @@
+package role
+
+import "github.com/51st-state/api/pkg/rbac"
+
+// rules enforced by the role service
+const (
+	ruleGet    rbac.Rule = "roles.get"
+	ruleSet    rbac.Rule = "roles.set"
+	ruleCreate rbac.Rule = "roles.create"
+	ruleDelete rbac.Rule = "roles.delete"
+	ruleList   rbac.Rule = "roles.list"
+)
+
+// Rules enforced by the role service
+var Rules = rbac.RuleCatalog{
+	{Rule: ruleGet, Description: "Get a role including its rules", Service: "role"},
+	{Rule: ruleSet, Description: "Update a role including its rules", Service: "role"},
+	{Rule: ruleCreate, Description: "Create a role", Service: "role"},
+	{Rule: ruleDelete, Description: "Delete a role", Service: "role"},
+	{Rule: ruleList, Description: "List all roles including their rules", Service: "role"},
+}
@@ -0,0 +1,31 @@
+package role
+
+import "github.com/51st-state/api/pkg/rbac"
+
+// SuperadminID of the system role granting every rule
+const SuperadminID rbac.RoleID = "system/superadmin"
+
+// SystemRoles are seeded at startup. They can neither be changed nor deleted.
+var SystemRoles = []Complete{
+	&complete{
+		NewIdentifier(SuperadminID),
+		NewIncomplete(
+			"Superadmin",
+			"Grants every rule of the api",
+			rbac.RoleRules{rbac.Wildcard},
+			rbac.RoleRules{},
+			rbac.RoleParents{},
+		),
+	},
+}
+
+// IsSystemRole checks whether a role id belongs to one of the system roles
+func IsSystemRole(id rbac.RoleID) bool {
+	for _, v := range SystemRoles {
+		if v.ID() == id {
+			return true
+		}
+	}
+
+	return false
+}
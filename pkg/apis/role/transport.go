@@ -5,11 +5,11 @@ import (
 	"crypto/rsa"
 	"encoding/json"
 	"net/http"
-	"regexp"
 
 	"github.com/go-chi/chi"
 
 	"github.com/51st-state/api/pkg/api/endpoint"
+	"github.com/51st-state/api/pkg/pagination"
 	"github.com/51st-state/api/pkg/rbac"
 	rbacMiddleware "github.com/51st-state/api/pkg/rbac/middleware"
 	"github.com/51st-state/api/pkg/token"
@@ -22,10 +22,10 @@ import (
 func MakeGetEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey, rb rbac.Control) http.HandlerFunc {
 	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
 		id := chi.URLParam(r, "id")
-		return m.Get(ctx, newIdentifier(rbac.RoleID(id)))
+		return m.Get(ctx, NewIdentifier(rbac.RoleID(id)))
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("roles.get"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleGet)).
 		HandlerFunc(l)
 }
 
@@ -33,7 +33,7 @@ func MakeGetEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.Publ
 func MakeSetEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey, rb rbac.Control) http.HandlerFunc {
 	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
 		id := chi.URLParam(r, "id")
-		inc := NewIncomplete("", "", make(rbac.RoleRules, 0))
+		inc := NewIncomplete("", "", make(rbac.RoleRules, 0), make(rbac.RoleRules, 0), make(rbac.RoleParents, 0))
 
 		if err := json.NewDecoder(r.Body).Decode(&inc); err != nil {
 			return nil, err
@@ -42,13 +42,13 @@ func MakeSetEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.Publ
 		return struct{}{}, m.Set(
 			ctx,
 			&complete{
-				newIdentifier(rbac.RoleID(id)),
+				NewIdentifier(rbac.RoleID(id)),
 				inc,
 			},
 		)
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("roles.set"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleSet)).
 		HandlerFunc(l)
 }
 
@@ -56,31 +56,57 @@ func MakeSetEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.Publ
 func MakeCreateEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey, rb rbac.Control) http.HandlerFunc {
 	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
 		id := chi.URLParam(r, "id")
-		inc := NewIncomplete("", "", rbac.RoleRules{})
+		inc := NewIncomplete("", "", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{})
 
 		if err := json.NewDecoder(r.Body).Decode(&inc); err != nil {
 			return nil, err
 		}
 
 		return struct{}{}, m.Create(ctx, &complete{
-			newIdentifier(rbac.RoleID(id)),
+			NewIdentifier(rbac.RoleID(id)),
 			inc,
 		})
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("roles.create"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleCreate)).
 		HandlerFunc(l)
 }
 
-var systemRoleRegexp = regexp.MustCompile(`^(system\/)?[a-z0-9-_]+$`)
-
 // MakeDeleteEndpoint for the role service
 func MakeDeleteEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey, rb rbac.Control) http.HandlerFunc {
 	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
 		id := chi.URLParam(r, "id")
-		return struct{}{}, m.Delete(ctx, newIdentifier(rbac.RoleID(id)))
+		return struct{}{}, m.Delete(ctx, NewIdentifier(rbac.RoleID(id)))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleDelete)).
+		HandlerFunc(l)
+}
+
+// MakeListEndpoint for the role service
+func MakeListEndpoint(l *zap.Logger, m Manager, e encode.Encoder, pubKey rsa.PublicKey, rb rbac.Control) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		page, err := pagination.FromRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		roles, err := m.List(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+
+		var last string
+		if len(roles) > 0 {
+			last = string(roles[len(roles)-1].ID())
+		}
+
+		return &pagination.List{
+			Items: roles,
+			Next:  page.Next(len(roles), last),
+		}, nil
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("roles.delete"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleList)).
 		HandlerFunc(l)
 }
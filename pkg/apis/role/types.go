@@ -20,7 +20,8 @@ func (i *identifier) ID() rbac.RoleID {
 	return i.id
 }
 
-func newIdentifier(id rbac.RoleID) Identifier {
+// NewIdentifier creates a new role identifier
+func NewIdentifier(id rbac.RoleID) Identifier {
 	return &identifier{id}
 }
 
@@ -47,30 +48,38 @@ type complete struct {
 
 func (c *complete) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
-		ID          rbac.RoleID    `json:"id"`
-		Title       string         `json:"title"`
-		Description string         `json:"description"`
-		Rules       rbac.RoleRules `json:"rules"`
+		ID          rbac.RoleID      `json:"id"`
+		Title       string           `json:"title"`
+		Description string           `json:"description"`
+		Rules       rbac.RoleRules   `json:"rules"`
+		DenyRules   rbac.RoleRules   `json:"deny_rules"`
+		Parents     rbac.RoleParents `json:"parents"`
 	}{
 		c.ID(),
 		c.Data().Title,
 		c.Data().Description,
 		c.Data().Rules,
+		c.Data().DenyRules,
+		c.Data().Parents,
 	})
 }
 
 type data struct {
-	Title       string         `json:"title"`
-	Description string         `json:"description"`
-	Rules       rbac.RoleRules `json:"rules"`
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	Rules       rbac.RoleRules   `json:"rules"`
+	DenyRules   rbac.RoleRules   `json:"deny_rules"`
+	Parents     rbac.RoleParents `json:"parents"`
 }
 
 // NewIncomplete creates a new incomplete role object
-func NewIncomplete(title, description string, rules rbac.RoleRules) Incomplete {
+func NewIncomplete(title, description string, rules, denyRules rbac.RoleRules, parents rbac.RoleParents) Incomplete {
 	return &data{
 		title,
 		description,
 		rules,
+		denyRules,
+		parents,
 	}
 }
 
@@ -92,3 +101,13 @@ func (d *data) SetRules(to rbac.RoleRules) *data {
 	d.Rules = to
 	return d
 }
+
+func (d *data) SetDenyRules(to rbac.RoleRules) *data {
+	d.DenyRules = to
+	return d
+}
+
+func (d *data) SetParents(to rbac.RoleParents) *data {
+	d.Parents = to
+	return d
+}
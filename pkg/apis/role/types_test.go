@@ -8,7 +8,7 @@ import (
 )
 
 func TestNewIncomplete(t *testing.T) {
-	inc := role.NewIncomplete("title", "description", rbac.RoleRules{})
+	inc := role.NewIncomplete("title", "description", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{})
 
 	if inc.Data() == nil {
 		t.Fatal("the data should not be null")
@@ -16,7 +16,7 @@ func TestNewIncomplete(t *testing.T) {
 }
 
 func TestIncompleteSetTitle(t *testing.T) {
-	inc := role.NewIncomplete("title", "description", rbac.RoleRules{})
+	inc := role.NewIncomplete("title", "description", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{})
 
 	inc.Data().SetTitle("anotherTitle")
 
@@ -26,7 +26,7 @@ func TestIncompleteSetTitle(t *testing.T) {
 }
 
 func TestIncompleteSetDescription(t *testing.T) {
-	inc := role.NewIncomplete("title", "description", rbac.RoleRules{})
+	inc := role.NewIncomplete("title", "description", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{})
 
 	inc.Data().SetDescription("anotherDescription")
 
@@ -38,7 +38,7 @@ func TestIncompleteSetDescription(t *testing.T) {
 func TestIncompleteSetRules(t *testing.T) {
 	inc := role.NewIncomplete("title", "description", rbac.RoleRules{
 		"testRule",
-	})
+	}, rbac.RoleRules{}, rbac.RoleParents{})
 
 	inc.Data().SetRules(rbac.RoleRules{
 		"testRule1",
@@ -48,3 +48,29 @@ func TestIncompleteSetRules(t *testing.T) {
 		t.Fatal("the rules were not set")
 	}
 }
+
+func TestIncompleteSetDenyRules(t *testing.T) {
+	inc := role.NewIncomplete("title", "description", rbac.RoleRules{}, rbac.RoleRules{
+		"testRule",
+	}, rbac.RoleParents{})
+
+	inc.Data().SetDenyRules(rbac.RoleRules{
+		"testRule1",
+		"testRule2",
+	})
+	if len(inc.Data().DenyRules) != 2 {
+		t.Fatal("the deny rules were not set")
+	}
+}
+
+func TestIncompleteSetParents(t *testing.T) {
+	inc := role.NewIncomplete("title", "description", rbac.RoleRules{}, rbac.RoleRules{}, rbac.RoleParents{})
+
+	inc.Data().SetParents(rbac.RoleParents{
+		"parent1",
+		"parent2",
+	})
+	if len(inc.Data().Parents) != 2 {
+		t.Fatal("the parents were not set")
+	}
+}
@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/serviceaccount/key"
+)
+
+type complete struct {
+	key.Identifier
+	key.Incomplete
+}
+
+func (c *complete) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		GUID               string `json:"guid"`
+		ServiceAccountGUID string `json:"service_account_guid"`
+		Name               string `json:"name"`
+		Description        string `json:"description"`
+	}{
+		c.GUID(),
+		c.Data().ServiceAccountGUID,
+		c.Data().Name,
+		c.Data().Description,
+	})
+}
+
+type repository struct {
+	mutex sync.RWMutex
+	keys  map[string]key.Incomplete
+}
+
+// NewRepository creates a new repository storing keys in memory
+func NewRepository() key.Repository {
+	return &repository{
+		keys: make(map[string]key.Incomplete),
+	}
+}
+
+func stored(inc key.Incomplete) key.Incomplete {
+	s := key.NewIncomplete(inc.Data().Name, inc.Data().Description)
+	s.Data().ServiceAccountGUID = inc.Data().ServiceAccountGUID
+
+	if inc.Data().PublicKey != nil {
+		publicKey := *inc.Data().PublicKey
+		s.Data().PublicKey = &publicKey
+	}
+
+	return s
+}
+
+func (r *repository) Get(ctx context.Context, id key.Identifier) (key.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	inc, ok := r.keys[id.GUID()]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return &complete{
+		id,
+		stored(inc),
+	}, nil
+}
+
+// Update the name and description of a key
+func (r *repository) Update(ctx context.Context, c key.Complete) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	inc, ok := r.keys[c.GUID()]
+	if !ok {
+		return nil
+	}
+
+	inc.Data().SetName(c.Data().Name).SetDescription(c.Data().Description)
+
+	return nil
+}
+
+func (r *repository) Create(ctx context.Context, inc key.Incomplete) (key.Complete, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.keys[rand.String()] = stored(inc)
+
+	return &complete{
+		key.NewIdentifier(rand.String()),
+		inc,
+	}, nil
+}
+
+func (r *repository) Delete(ctx context.Context, id key.Identifier) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.keys, id.GUID())
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/serviceaccount/key"
+	"github.com/51st-state/api/pkg/apis/serviceaccount/key/memory"
+	"github.com/51st-state/api/pkg/apis/serviceaccount/key/repositorytest"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) key.Repository {
+		return memory.NewRepository()
+	})
+}
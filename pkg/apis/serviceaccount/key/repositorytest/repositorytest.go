@@ -0,0 +1,150 @@
+// Package repositorytest contains the conformance tests of service account key repositories
+package repositorytest
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/serviceaccount/key"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) key.Repository) {
+	t.Run("Create", func(t *testing.T) {
+		testCreate(t, newRepository(t))
+	})
+	t.Run("Update", func(t *testing.T) {
+		testUpdate(t, newRepository(t))
+	})
+	t.Run("Delete", func(t *testing.T) {
+		testDelete(t, newRepository(t))
+	})
+}
+
+type complete struct {
+	key.Identifier
+	key.Incomplete
+}
+
+func randomGUID(t *testing.T) string {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return rand.String()
+}
+
+func newIncomplete(t *testing.T, name, description string) key.Incomplete {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	inc := key.NewIncomplete(name, description)
+	inc.Data().PublicKey = &privateKey.PublicKey
+	inc.Data().ServiceAccountGUID = randomGUID(t)
+
+	return inc
+}
+
+func testCreate(t *testing.T, r key.Repository) {
+	ctx := context.Background()
+
+	if _, err := r.Get(ctx, key.NewIdentifier(randomGUID(t))); err != sql.ErrNoRows {
+		t.Fatal("an unknown key should not be found")
+	}
+
+	inc := newIncomplete(t, "name", "description")
+	c, err := r.Create(ctx, inc)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.GUID() == "" {
+		t.Fatal("the created key should have an id")
+	}
+
+	got, err := r.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if got.GUID() != c.GUID() ||
+		got.Data().Name != "name" ||
+		got.Data().Description != "description" ||
+		got.Data().ServiceAccountGUID != inc.Data().ServiceAccountGUID {
+		t.Fatal("the stored data is not equal")
+	}
+
+	if got.Data().PublicKey == nil ||
+		got.Data().PublicKey.E != inc.Data().PublicKey.E ||
+		got.Data().PublicKey.N.Cmp(inc.Data().PublicKey.N) != 0 {
+		t.Fatal("the stored public key is not equal")
+	}
+}
+
+func testUpdate(t *testing.T, r key.Repository) {
+	ctx := context.Background()
+
+	inc := newIncomplete(t, "name", "description")
+	c, err := r.Create(ctx, inc)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	update := newIncomplete(t, "new name", "new description")
+	if err := r.Update(ctx, &complete{c, update}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	got, err := r.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if got.Data().Name != "new name" || got.Data().Description != "new description" {
+		t.Fatal("the name and description should be updated")
+	}
+
+	if got.Data().ServiceAccountGUID != inc.Data().ServiceAccountGUID ||
+		got.Data().PublicKey.N.Cmp(inc.Data().PublicKey.N) != 0 {
+		t.Fatal("the service account and public key of a key should not be updated")
+	}
+
+	unknown := key.NewIdentifier(randomGUID(t))
+	if err := r.Update(ctx, &complete{unknown, update}); err != nil {
+		t.Fatal("updating an unknown key should not return an error")
+	}
+
+	if _, err := r.Get(ctx, unknown); err != sql.ErrNoRows {
+		t.Fatal("updating an unknown key should not create it")
+	}
+}
+
+func testDelete(t *testing.T, r key.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, newIncomplete(t, "name", "description"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Delete(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Get(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("the key should be deleted")
+	}
+
+	if err := r.Delete(ctx, key.NewIdentifier(randomGUID(t))); err != nil {
+		t.Fatal("deleting an unknown key should not return an error")
+	}
+}
@@ -0,0 +1,19 @@
+package key
+
+import "github.com/51st-state/api/pkg/rbac"
+
+// rules enforced by the service account key service
+const (
+	ruleGet    rbac.Rule = "serviceaccounts.keys.get"
+	ruleCreate rbac.Rule = "serviceaccounts.keys.create"
+	ruleSet    rbac.Rule = "serviceaccounts.keys.set"
+	ruleDelete rbac.Rule = "serviceaccounts.keys.delete"
+)
+
+// Rules enforced by the service account key service
+var Rules = rbac.RuleCatalog{
+	{Rule: ruleGet, Description: "Get a key of a service account", Service: "serviceaccount"},
+	{Rule: ruleCreate, Description: "Create a key for a service account", Service: "serviceaccount"},
+	{Rule: ruleSet, Description: "Update a key of a service account", Service: "serviceaccount"},
+	{Rule: ruleDelete, Description: "Delete a key of a service account", Service: "serviceaccount"},
+}
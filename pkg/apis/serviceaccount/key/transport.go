@@ -22,7 +22,7 @@ func MakeGetEndpoint(l *zap.Logger, e encode.Encoder, publicKey *rsa.PublicKey,
 		return m.Get(ctx, &identifier{guid})
 	}).
 		WithBefore(token.NewMiddleware(*publicKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("serviceaccounts.keys.get"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleGet)).
 		HandlerFunc(l)
 }
 
@@ -40,7 +40,7 @@ func MakeCreateEndpoint(l *zap.Logger, e encode.Encoder, publicKey *rsa.PublicKe
 		return m.Create(ctx, inc)
 	}).
 		WithBefore(token.NewMiddleware(*publicKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("serviceaccounts.keys.create"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleCreate)).
 		HandlerFunc(l)
 }
 
@@ -62,7 +62,7 @@ func MakeSetEndpoint(l *zap.Logger, e encode.Encoder, publicKey *rsa.PublicKey,
 		})
 	}).
 		WithBefore(token.NewMiddleware(*publicKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("serviceaccounts.keys.set"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleSet)).
 		HandlerFunc(l)
 }
 
@@ -73,6 +73,6 @@ func MakeDeleteEndpoint(l *zap.Logger, e encode.Encoder, publicKey *rsa.PublicKe
 		return struct{}{}, m.Delete(ctx, &identifier{guid})
 	}).
 		WithBefore(token.NewMiddleware(*publicKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("serviceaccounts.keys.delete"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleDelete)).
 		HandlerFunc(l)
 }
@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/serviceaccount"
+)
+
+type complete struct {
+	serviceaccount.Identifier
+	serviceaccount.Incomplete
+}
+
+func (c *complete) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		GUID        string `json:"guid"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}{
+		c.GUID(),
+		c.Data().Name,
+		c.Data().Description,
+	})
+}
+
+type repository struct {
+	mutex           sync.RWMutex
+	serviceAccounts map[string]serviceaccount.Incomplete
+}
+
+// NewRepository creates a new in memory storage repository
+func NewRepository() serviceaccount.Repository {
+	return &repository{
+		serviceAccounts: make(map[string]serviceaccount.Incomplete),
+	}
+}
+
+func stored(inc serviceaccount.Incomplete) serviceaccount.Incomplete {
+	return serviceaccount.NewIncomplete(inc.Data().Name, inc.Data().Description)
+}
+
+func (r *repository) Get(ctx context.Context, id serviceaccount.Identifier) (serviceaccount.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	inc, ok := r.serviceAccounts[id.GUID()]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return &complete{
+		id,
+		stored(inc),
+	}, nil
+}
+
+func (r *repository) Create(ctx context.Context, inc serviceaccount.Incomplete) (serviceaccount.Complete, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.serviceAccounts[rand.String()] = stored(inc)
+
+	return &complete{
+		serviceaccount.NewIdentifier(rand.String()),
+		inc,
+	}, nil
+}
+
+func (r *repository) Update(ctx context.Context, c serviceaccount.Complete) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.serviceAccounts[c.GUID()]; ok {
+		r.serviceAccounts[c.GUID()] = stored(c)
+	}
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id serviceaccount.Identifier) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.serviceAccounts, id.GUID())
+
+	return nil
+}
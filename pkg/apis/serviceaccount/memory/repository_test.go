@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/serviceaccount"
+	"github.com/51st-state/api/pkg/apis/serviceaccount/memory"
+	"github.com/51st-state/api/pkg/apis/serviceaccount/repositorytest"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) serviceaccount.Repository {
+		return memory.NewRepository()
+	})
+}
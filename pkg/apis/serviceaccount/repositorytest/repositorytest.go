@@ -0,0 +1,129 @@
+// Package repositorytest contains the conformance tests of service account repositories
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/serviceaccount"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) serviceaccount.Repository) {
+	t.Run("Create", func(t *testing.T) {
+		testCreate(t, newRepository(t))
+	})
+	t.Run("Update", func(t *testing.T) {
+		testUpdate(t, newRepository(t))
+	})
+	t.Run("Delete", func(t *testing.T) {
+		testDelete(t, newRepository(t))
+	})
+}
+
+type complete struct {
+	serviceaccount.Identifier
+	serviceaccount.Incomplete
+}
+
+func randomIdentifier(t *testing.T) serviceaccount.Identifier {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return serviceaccount.NewIdentifier(rand.String())
+}
+
+func testCreate(t *testing.T, r serviceaccount.Repository) {
+	ctx := context.Background()
+
+	if _, err := r.Get(ctx, randomIdentifier(t)); err != sql.ErrNoRows {
+		t.Fatal("an unknown service account should not be found")
+	}
+
+	first, err := r.Create(ctx, serviceaccount.NewIncomplete("name", "description"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	second, err := r.Create(ctx, serviceaccount.NewIncomplete("name", "description"))
+	if err != nil {
+		t.Fatal("the name of a service account does not have to be unique")
+	}
+
+	if first.GUID() == "" || first.GUID() == second.GUID() {
+		t.Fatal("the created service accounts should have distinct ids")
+	}
+
+	c, err := r.Get(ctx, first)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.GUID() != first.GUID() || c.Data().Name != "name" || c.Data().Description != "description" {
+		t.Fatal("the stored data is not equal")
+	}
+}
+
+func testUpdate(t *testing.T, r serviceaccount.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, serviceaccount.NewIncomplete("name", "description"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Update(ctx, &complete{
+		c,
+		serviceaccount.NewIncomplete("new name", "new description"),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	c, err = r.Get(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Data().Name != "new name" || c.Data().Description != "new description" {
+		t.Fatal("the service account should be updated")
+	}
+
+	unknown := randomIdentifier(t)
+	if err := r.Update(ctx, &complete{
+		unknown,
+		serviceaccount.NewIncomplete("name", "description"),
+	}); err != nil {
+		t.Fatal("updating an unknown service account should not return an error")
+	}
+
+	if _, err := r.Get(ctx, unknown); err != sql.ErrNoRows {
+		t.Fatal("updating an unknown service account should not create it")
+	}
+}
+
+func testDelete(t *testing.T, r serviceaccount.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, serviceaccount.NewIncomplete("name", "description"))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Delete(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Get(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("the service account should be deleted")
+	}
+
+	if err := r.Delete(ctx, randomIdentifier(t)); err != nil {
+		t.Fatal("deleting an unknown service account should not return an error")
+	}
+}
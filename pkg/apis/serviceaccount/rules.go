@@ -0,0 +1,23 @@
+package serviceaccount
+
+import "github.com/51st-state/api/pkg/rbac"
+
+// rules enforced by the serviceaccount service
+const (
+	ruleGet      rbac.Rule = "serviceaccounts.get"
+	ruleSet      rbac.Rule = "serviceaccounts.set"
+	ruleCreate   rbac.Rule = "serviceaccounts.create"
+	ruleDelete   rbac.Rule = "serviceaccounts.delete"
+	ruleRolesGet rbac.Rule = "serviceaccounts.roles.get"
+	ruleRolesSet rbac.Rule = "serviceaccounts.roles.set"
+)
+
+// Rules enforced by the serviceaccount service
+var Rules = rbac.RuleCatalog{
+	{Rule: ruleGet, Description: "Get a service account", Service: "serviceaccount"},
+	{Rule: ruleSet, Description: "Update a service account", Service: "serviceaccount"},
+	{Rule: ruleCreate, Description: "Create a service account", Service: "serviceaccount"},
+	{Rule: ruleDelete, Description: "Delete a service account", Service: "serviceaccount"},
+	{Rule: ruleRolesGet, Description: "Get the roles of a service account", Service: "serviceaccount"},
+	{Rule: ruleRolesSet, Description: "Set the roles of a service account", Service: "serviceaccount"},
+}
@@ -25,7 +25,7 @@ func MakeGetEndpoint(l *zap.Logger, e encode.Encoder, publicKey *rsa.PublicKey,
 		return m.Get(ctx, &identifier{guid})
 	}).
 		WithBefore(token.NewMiddleware(*publicKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("serviceaccounts.get"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleGet)).
 		HandlerFunc(l)
 }
 
@@ -46,7 +46,7 @@ func MakeUpdateEndpoint(l *zap.Logger, e encode.Encoder, publicKey *rsa.PublicKe
 		})
 	}).
 		WithBefore(token.NewMiddleware(*publicKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("serviceaccounts.set"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleSet)).
 		HandlerFunc(l)
 }
 
@@ -62,7 +62,7 @@ func MakeCreateEndpoint(l *zap.Logger, e encode.Encoder, publicKey *rsa.PublicKe
 		return m.Create(ctx, inc)
 	}).
 		WithBefore(token.NewMiddleware(*publicKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("serviceaccounts.create"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleCreate)).
 		HandlerFunc(l)
 }
 
@@ -73,7 +73,7 @@ func MakeDeleteEndpoint(l *zap.Logger, e encode.Encoder, publicKey *rsa.PublicKe
 		return struct{}{}, m.Delete(ctx, &identifier{guid})
 	}).
 		WithBefore(token.NewMiddleware(*publicKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("serviceaccounts.delete"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleDelete)).
 		HandlerFunc(l)
 }
 
@@ -84,7 +84,7 @@ func MakeGetRolesEndpoint(l *zap.Logger, e encode.Encoder, publicKey *rsa.Public
 		return m.GetRoles(ctx, &identifier{guid})
 	}).
 		WithBefore(token.NewMiddleware(*publicKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("serviceaccounts.roles.get"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleRolesGet)).
 		HandlerFunc(l)
 }
 
@@ -100,6 +100,6 @@ func MakeSetRolesEndpoint(l *zap.Logger, e encode.Encoder, publicKey *rsa.Public
 		return struct{}{}, m.SetRoles(ctx, &identifier{guid}, roles)
 	}).
 		WithBefore(token.NewMiddleware(*publicKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("serviceaccounts.roles.set"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleRolesSet)).
 		HandlerFunc(l)
 }
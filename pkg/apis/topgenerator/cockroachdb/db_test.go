@@ -0,0 +1,16 @@
+package cockroachdb_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/topgenerator"
+	"github.com/51st-state/api/pkg/apis/topgenerator/cockroachdb"
+	"github.com/51st-state/api/pkg/apis/topgenerator/repositorytest"
+	"github.com/51st-state/api/test"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) topgenerator.Repository {
+		return cockroachdb.New(test.NewCockroachDB(t, cockroachdb.CreateSchema))
+	})
+}
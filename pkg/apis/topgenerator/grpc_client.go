@@ -0,0 +1,57 @@
+package topgenerator
+
+import (
+	"context"
+
+	pb "github.com/51st-state/api/pkg/apis/topgenerator/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcClient struct {
+	client pb.ManagerClient
+}
+
+// NewGRPCClient creates a new grpc client to get tops
+func NewGRPCClient(c *grpc.ClientConn) Getter {
+	return &grpcClient{
+		pb.NewManagerClient(c),
+	}
+}
+
+type grpcComplete struct {
+	Identifier
+	Incomplete
+}
+
+func (g *grpcClient) Get(ctx context.Context, id Identifier) (Complete, error) {
+	resp, err := g.client.Get(ctx, &pb.Identifier{
+		Sex:          id.Sex(),
+		UndershirtID: id.UndershirtID(),
+		TopID:        id.TopID(),
+	})
+	if err != nil {
+		if status.Convert(err).Code() == codes.NotFound {
+			return nil, ErrTopNotFound
+		}
+
+		return nil, err
+	}
+
+	inc := resp.GetIncomplete()
+	return &grpcComplete{
+		NewIdentifier(id.Sex(), id.UndershirtID(), id.TopID()),
+		NewIncomplete(
+			uint8(inc.GetStatus()),
+			uint8(inc.GetClothingType()),
+			uint8(inc.GetValencyType()),
+			uint(inc.GetTorsoID()),
+			uint(inc.GetPolyesterPercentage()),
+			uint(inc.GetCottonPercentage()),
+			uint(inc.GetLeatherPercentage()),
+			uint(inc.GetSilkPercentage()),
+			uint(inc.GetRelativeAmount()),
+		),
+	}, nil
+}
@@ -0,0 +1,46 @@
+package topgenerator
+
+import (
+	"context"
+
+	pb "github.com/51st-state/api/pkg/apis/topgenerator/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcServer struct {
+	manager *Manager
+}
+
+// NewGRPCServer creates a new instance of a grpc server for tops
+func NewGRPCServer(m *Manager) pb.ManagerServer {
+	return &grpcServer{m}
+}
+
+func (g *grpcServer) Get(ctx context.Context, id *pb.Identifier) (*pb.Complete, error) {
+	c, err := g.manager.Get(ctx, NewIdentifier(
+		id.GetSex(),
+		id.GetUndershirtID(),
+		id.GetTopID(),
+	))
+	if err == ErrTopNotFound {
+		return nil, status.New(codes.NotFound, err.Error()).Err()
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &pb.Complete{
+		Identifier: id,
+		Incomplete: &pb.Incomplete{
+			TorsoID:             uint64(c.Data().TorsoID),
+			ClothingType:        uint32(c.Data().ClothingType),
+			ValencyType:         uint32(c.Data().ValencyType),
+			Status:              uint32(c.Data().Status),
+			PolyesterPercentage: uint64(c.Data().PolyesterPercentage),
+			CottonPercentage:    uint64(c.Data().CottonPercentage),
+			LeatherPercentage:   uint64(c.Data().LeatherPercentage),
+			SilkPercentage:      uint64(c.Data().SilkPercentage),
+			RelativeAmount:      uint64(c.Data().RelativeAmount),
+		},
+	}, nil
+}
@@ -1,5 +1,7 @@
 package topgenerator
 
+//go:generate protoc -I./../../../../../../ -I ./proto --go_out=plugins=grpc:./proto ./proto/manager.proto
+
 import (
 	"context"
 	"database/sql"
@@ -8,6 +10,11 @@ import (
 	"github.com/51st-state/api/pkg/problems"
 )
 
+// Getter of tops
+type Getter interface {
+	Get(context.Context, Identifier) (Complete, error)
+}
+
 // Manager for tops
 type Manager struct {
 	repository Repository
@@ -20,14 +27,15 @@ func NewManager(r Repository) *Manager {
 	}
 }
 
-var errTopNotFound = problems.New("top not found", "the given ids are not linked to a top", http.StatusNotFound)
+// ErrTopNotFound is returned if no top is linked to an undershirt and a top
+var ErrTopNotFound = problems.New("top not found", "the given ids are not linked to a top", http.StatusNotFound)
 
 // Get top information
 func (m *Manager) Get(ctx context.Context, id Identifier) (Complete, error) {
 	c, err := m.repository.Get(ctx, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, errTopNotFound
+			return nil, ErrTopNotFound
 		}
 
 		return nil, err
@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/topgenerator"
+)
+
+// key of a top
+type key struct {
+	sex                 bool
+	undershirtID, topID uint64
+}
+
+func keyOf(id topgenerator.Identifier) key {
+	return key{id.Sex(), id.UndershirtID(), id.TopID()}
+}
+
+type repository struct {
+	mutex sync.RWMutex
+	tops  map[key]topgenerator.Incomplete
+}
+
+// New memory repository
+func New() topgenerator.Repository {
+	return &repository{
+		tops: make(map[key]topgenerator.Incomplete),
+	}
+}
+
+func stored(inc topgenerator.Incomplete) topgenerator.Incomplete {
+	s := topgenerator.NewIncomplete(0, 0, 0, 0, 0, 0, 0, 0, 0)
+	*s.Data() = *inc.Data()
+
+	return s
+}
+
+func (r *repository) Get(ctx context.Context, id topgenerator.Identifier) (topgenerator.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	inc, ok := r.tops[keyOf(id)]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return &complete{
+		id,
+		stored(inc),
+	}, nil
+}
+
+func (r *repository) Upsert(ctx context.Context, c topgenerator.Complete) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.tops[keyOf(c)] = stored(c)
+
+	return nil
+}
+
+type complete struct {
+	topgenerator.Identifier
+	topgenerator.Incomplete
+}
+
+func (c *complete) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		Sex                 bool   `json:"sex"`
+		UndershirtID        uint64 `json:"undershirt_id"`
+		TopID               uint64 `json:"top_id"`
+		ClothingType        uint8  `json:"clothing_type"`
+		ValencyType         uint8  `json:"valency_type"`
+		Status              uint8  `json:"status"`
+		TorsoID             uint   `json:"torso_id"`
+		PolyesterPercentage uint   `json:"polyester_percentage"`
+		CottonPercentage    uint   `json:"cotton_percentage"`
+		LeatherPercentage   uint   `json:"leather_percentage"`
+		SilkPercentage      uint   `json:"silk_percentage"`
+		RelativeAmount      uint   `json:"relative_amount"`
+	}{
+		c.Sex(),
+		c.UndershirtID(),
+		c.TopID(),
+		c.Data().ClothingType,
+		c.Data().ValencyType,
+		c.Data().Status,
+		c.Data().TorsoID,
+		c.Data().PolyesterPercentage,
+		c.Data().CottonPercentage,
+		c.Data().LeatherPercentage,
+		c.Data().SilkPercentage,
+		c.Data().RelativeAmount,
+	})
+}
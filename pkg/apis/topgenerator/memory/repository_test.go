@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/topgenerator"
+	"github.com/51st-state/api/pkg/apis/topgenerator/memory"
+	"github.com/51st-state/api/pkg/apis/topgenerator/repositorytest"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) topgenerator.Repository {
+		return memory.New()
+	})
+}
@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: manager.proto
+
+package topgenerator
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Identifier struct {
+	Sex                  bool     `protobuf:"varint,1,opt,name=Sex,proto3" json:"Sex,omitempty"`
+	UndershirtID         uint64   `protobuf:"varint,2,opt,name=UndershirtID,proto3" json:"UndershirtID,omitempty"`
+	TopID                uint64   `protobuf:"varint,3,opt,name=TopID,proto3" json:"TopID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Identifier) Reset()         { *m = Identifier{} }
+func (m *Identifier) String() string { return proto.CompactTextString(m) }
+func (*Identifier) ProtoMessage()    {}
+func (*Identifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{0}
+}
+
+func (m *Identifier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Identifier.Unmarshal(m, b)
+}
+func (m *Identifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Identifier.Marshal(b, m, deterministic)
+}
+func (m *Identifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Identifier.Merge(m, src)
+}
+func (m *Identifier) XXX_Size() int {
+	return xxx_messageInfo_Identifier.Size(m)
+}
+func (m *Identifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_Identifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Identifier proto.InternalMessageInfo
+
+func (m *Identifier) GetSex() bool {
+	if m != nil {
+		return m.Sex
+	}
+	return false
+}
+
+func (m *Identifier) GetUndershirtID() uint64 {
+	if m != nil {
+		return m.UndershirtID
+	}
+	return 0
+}
+
+func (m *Identifier) GetTopID() uint64 {
+	if m != nil {
+		return m.TopID
+	}
+	return 0
+}
+
+type Incomplete struct {
+	TorsoID              uint64   `protobuf:"varint,1,opt,name=TorsoID,proto3" json:"TorsoID,omitempty"`
+	ClothingType         uint32   `protobuf:"varint,2,opt,name=ClothingType,proto3" json:"ClothingType,omitempty"`
+	ValencyType          uint32   `protobuf:"varint,3,opt,name=ValencyType,proto3" json:"ValencyType,omitempty"`
+	Status               uint32   `protobuf:"varint,4,opt,name=Status,proto3" json:"Status,omitempty"`
+	PolyesterPercentage  uint64   `protobuf:"varint,5,opt,name=PolyesterPercentage,proto3" json:"PolyesterPercentage,omitempty"`
+	CottonPercentage     uint64   `protobuf:"varint,6,opt,name=CottonPercentage,proto3" json:"CottonPercentage,omitempty"`
+	LeatherPercentage    uint64   `protobuf:"varint,7,opt,name=LeatherPercentage,proto3" json:"LeatherPercentage,omitempty"`
+	SilkPercentage       uint64   `protobuf:"varint,8,opt,name=SilkPercentage,proto3" json:"SilkPercentage,omitempty"`
+	RelativeAmount       uint64   `protobuf:"varint,9,opt,name=RelativeAmount,proto3" json:"RelativeAmount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Incomplete) Reset()         { *m = Incomplete{} }
+func (m *Incomplete) String() string { return proto.CompactTextString(m) }
+func (*Incomplete) ProtoMessage()    {}
+func (*Incomplete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{1}
+}
+
+func (m *Incomplete) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Incomplete.Unmarshal(m, b)
+}
+func (m *Incomplete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Incomplete.Marshal(b, m, deterministic)
+}
+func (m *Incomplete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Incomplete.Merge(m, src)
+}
+func (m *Incomplete) XXX_Size() int {
+	return xxx_messageInfo_Incomplete.Size(m)
+}
+func (m *Incomplete) XXX_DiscardUnknown() {
+	xxx_messageInfo_Incomplete.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Incomplete proto.InternalMessageInfo
+
+func (m *Incomplete) GetTorsoID() uint64 {
+	if m != nil {
+		return m.TorsoID
+	}
+	return 0
+}
+
+func (m *Incomplete) GetClothingType() uint32 {
+	if m != nil {
+		return m.ClothingType
+	}
+	return 0
+}
+
+func (m *Incomplete) GetValencyType() uint32 {
+	if m != nil {
+		return m.ValencyType
+	}
+	return 0
+}
+
+func (m *Incomplete) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Incomplete) GetPolyesterPercentage() uint64 {
+	if m != nil {
+		return m.PolyesterPercentage
+	}
+	return 0
+}
+
+func (m *Incomplete) GetCottonPercentage() uint64 {
+	if m != nil {
+		return m.CottonPercentage
+	}
+	return 0
+}
+
+func (m *Incomplete) GetLeatherPercentage() uint64 {
+	if m != nil {
+		return m.LeatherPercentage
+	}
+	return 0
+}
+
+func (m *Incomplete) GetSilkPercentage() uint64 {
+	if m != nil {
+		return m.SilkPercentage
+	}
+	return 0
+}
+
+func (m *Incomplete) GetRelativeAmount() uint64 {
+	if m != nil {
+		return m.RelativeAmount
+	}
+	return 0
+}
+
+type Complete struct {
+	Identifier           *Identifier `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Incomplete           *Incomplete `protobuf:"bytes,2,opt,name=Incomplete,proto3" json:"Incomplete,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Complete) Reset()         { *m = Complete{} }
+func (m *Complete) String() string { return proto.CompactTextString(m) }
+func (*Complete) ProtoMessage()    {}
+func (*Complete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{2}
+}
+
+func (m *Complete) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Complete.Unmarshal(m, b)
+}
+func (m *Complete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Complete.Marshal(b, m, deterministic)
+}
+func (m *Complete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Complete.Merge(m, src)
+}
+func (m *Complete) XXX_Size() int {
+	return xxx_messageInfo_Complete.Size(m)
+}
+func (m *Complete) XXX_DiscardUnknown() {
+	xxx_messageInfo_Complete.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Complete proto.InternalMessageInfo
+
+func (m *Complete) GetIdentifier() *Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *Complete) GetIncomplete() *Incomplete {
+	if m != nil {
+		return m.Incomplete
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Identifier)(nil), "topgenerator.Identifier")
+	proto.RegisterType((*Incomplete)(nil), "topgenerator.Incomplete")
+	proto.RegisterType((*Complete)(nil), "topgenerator.Complete")
+}
+
+func init() { proto.RegisterFile("manager.proto", fileDescriptor_cde9ec64f0d2c859) }
+
+var fileDescriptor_cde9ec64f0d2c859 = []byte{
+	// 349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x4f, 0xf2, 0x40,
+	0x10, 0xc6, 0x5f, 0x28, 0xff, 0xde, 0x01, 0x0c, 0xae, 0x86, 0x6c, 0x3c, 0x91, 0x1e, 0x0c, 0x31,
+	0x86, 0x18, 0xbc, 0xe0, 0xd1, 0xd0, 0xc4, 0x34, 0xd1, 0x84, 0x2c, 0x68, 0xbc, 0xae, 0x30, 0x96,
+	0xc6, 0xb2, 0xdb, 0x6c, 0x07, 0x23, 0x17, 0x3f, 0xab, 0x1f, 0xc5, 0xb0, 0x88, 0x6e, 0x05, 0x6f,
+	0x9d, 0xe7, 0xf9, 0x75, 0x67, 0x32, 0xcf, 0x40, 0x73, 0x21, 0x95, 0x8c, 0xd0, 0xf4, 0x52, 0xa3,
+	0x49, 0xb3, 0x06, 0xe9, 0x34, 0x42, 0x85, 0x46, 0x92, 0x36, 0xfe, 0x23, 0x40, 0x38, 0x43, 0x45,
+	0xf1, 0x73, 0x8c, 0x86, 0xb5, 0xc0, 0x1b, 0xe3, 0x1b, 0x2f, 0x74, 0x0a, 0xdd, 0x9a, 0x58, 0x7f,
+	0x32, 0x1f, 0x1a, 0xf7, 0x6a, 0x86, 0x26, 0x9b, 0xc7, 0x86, 0xc2, 0x80, 0x17, 0x3b, 0x85, 0x6e,
+	0x49, 0xe4, 0x34, 0x76, 0x0c, 0xe5, 0x89, 0x4e, 0xc3, 0x80, 0x7b, 0xd6, 0xdc, 0x14, 0xfe, 0x47,
+	0x11, 0x20, 0x54, 0x53, 0xbd, 0x48, 0x13, 0x24, 0x64, 0x1c, 0xaa, 0x13, 0x6d, 0x32, 0x1d, 0x06,
+	0xf6, 0xf9, 0x92, 0xd8, 0x96, 0xeb, 0x16, 0xc3, 0x44, 0xd3, 0x3c, 0x56, 0xd1, 0x64, 0x95, 0xa2,
+	0x6d, 0xd1, 0x14, 0x39, 0x8d, 0x75, 0xa0, 0xfe, 0x20, 0x13, 0x54, 0xd3, 0x95, 0x45, 0x3c, 0x8b,
+	0xb8, 0x12, 0x6b, 0x43, 0x65, 0x4c, 0x92, 0x96, 0x19, 0x2f, 0x59, 0xf3, 0xab, 0x62, 0x17, 0x70,
+	0x34, 0xd2, 0xc9, 0x0a, 0x33, 0x42, 0x33, 0x42, 0x33, 0x45, 0x45, 0x32, 0x42, 0x5e, 0xb6, 0x33,
+	0xec, 0xb3, 0xd8, 0x19, 0xb4, 0x86, 0x9a, 0x48, 0x2b, 0x07, 0xaf, 0x58, 0x7c, 0x47, 0x67, 0xe7,
+	0x70, 0x78, 0x8b, 0x92, 0xe6, 0xb9, 0xb7, 0xab, 0x16, 0xde, 0x35, 0xd8, 0x29, 0x1c, 0x8c, 0xe3,
+	0xe4, 0xc5, 0x41, 0x6b, 0x16, 0xfd, 0xa5, 0xae, 0x39, 0x81, 0x89, 0xa4, 0xf8, 0x15, 0xaf, 0x17,
+	0x7a, 0xa9, 0x88, 0xff, 0xdf, 0x70, 0x79, 0xd5, 0x7f, 0x87, 0xda, 0x70, 0xbb, 0xdf, 0x81, 0x1b,
+	0xa4, 0x5d, 0x71, 0xbd, 0xcf, 0x7b, 0x6e, 0xd6, 0xbd, 0x1f, 0x5f, 0xb8, 0xa1, 0x0f, 0xdc, 0x9c,
+	0x78, 0x71, 0xef, 0x9f, 0xdf, 0xbe, 0x70, 0xd8, 0x7e, 0x00, 0xd5, 0xbb, 0xcd, 0x6d, 0xb1, 0x2b,
+	0xf0, 0x6e, 0x90, 0xd8, 0x9f, 0x1d, 0x4f, 0xda, 0x79, 0x67, 0x3b, 0xb7, 0xff, 0xef, 0xa9, 0x62,
+	0xef, 0xf2, 0xf2, 0x73, 0x00, 0xb2, 0xdc, 0xe8, 0x1e, 0xa8, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ManagerClient is the client API for Manager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ManagerClient interface {
+	Get(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Complete, error)
+}
+
+type managerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewManagerClient(cc *grpc.ClientConn) ManagerClient {
+	return &managerClient{cc}
+}
+
+func (c *managerClient) Get(ctx context.Context, in *Identifier, opts ...grpc.CallOption) (*Complete, error) {
+	out := new(Complete)
+	err := c.cc.Invoke(ctx, "/topgenerator.Manager/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ManagerServer is the server API for Manager service.
+type ManagerServer interface {
+	Get(context.Context, *Identifier) (*Complete, error)
+}
+
+func RegisterManagerServer(s *grpc.Server, srv ManagerServer) {
+	s.RegisterService(&_Manager_serviceDesc, srv)
+}
+
+func _Manager_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Identifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/topgenerator.Manager/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).Get(ctx, req.(*Identifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Manager_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "topgenerator.Manager",
+	HandlerType: (*ManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Manager_Get_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "manager.proto",
+}
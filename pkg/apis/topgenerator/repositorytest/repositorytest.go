@@ -0,0 +1,89 @@
+// Package repositorytest contains the conformance tests of top repositories
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/topgenerator"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) topgenerator.Repository) {
+	t.Run("Upsert", func(t *testing.T) {
+		testUpsert(t, newRepository(t))
+	})
+}
+
+type identifier struct {
+	sex                 bool
+	undershirtID, topID uint64
+}
+
+func (i *identifier) Sex() bool {
+	return i.sex
+}
+
+func (i *identifier) UndershirtID() uint64 {
+	return i.undershirtID
+}
+
+func (i *identifier) TopID() uint64 {
+	return i.topID
+}
+
+type complete struct {
+	topgenerator.Identifier
+	topgenerator.Incomplete
+}
+
+func testUpsert(t *testing.T, r topgenerator.Repository) {
+	ctx := context.Background()
+	id := &identifier{true, 1, 2}
+
+	if _, err := r.Get(ctx, id); err != sql.ErrNoRows {
+		t.Fatal("an unknown top should not be found")
+	}
+
+	if err := r.Upsert(ctx, &complete{
+		id,
+		topgenerator.NewIncomplete(1, 2, 3, 4, 10, 20, 30, 40, 5),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	c, err := r.Get(ctx, id)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Sex() != true || c.UndershirtID() != 1 || c.TopID() != 2 {
+		t.Fatal("the ids are not equal")
+	}
+
+	if *c.Data() != *topgenerator.NewIncomplete(1, 2, 3, 4, 10, 20, 30, 40, 5).Data() {
+		t.Fatal("the stored data is not equal")
+	}
+
+	if _, err := r.Get(ctx, &identifier{false, 1, 2}); err != sql.ErrNoRows {
+		t.Fatal("the sex is part of the id of a top")
+	}
+
+	if err := r.Upsert(ctx, &complete{
+		id,
+		topgenerator.NewIncomplete(2, 3, 4, 5, 25, 25, 25, 25, 1),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	c, err = r.Get(ctx, id)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if *c.Data() != *topgenerator.NewIncomplete(2, 3, 4, 5, 25, 25, 25, 25, 1).Data() {
+		t.Fatal("the top should be updated")
+	}
+}
@@ -20,7 +20,7 @@ func MakeHTTPGetEndpoint(l *zap.Logger, e encode.Encoder, m *Manager) http.Handl
 			return nil, err
 		}
 
-		return m.Get(ctx, newIdentifier(
+		return m.Get(ctx, NewIdentifier(
 			p.sex,
 			p.undershirtID,
 			p.topID,
@@ -47,7 +47,7 @@ func MakeHTTPUpsertEndpoint(l *zap.Logger, e encode.Encoder, m *Manager) http.Ha
 		}
 
 		return struct{}{}, m.Upsert(ctx, &httpComplete{
-			newIdentifier(
+			NewIdentifier(
 				p.sex,
 				p.undershirtID,
 				p.topID,
@@ -12,7 +12,8 @@ type identifier struct {
 	undershirtID, topID uint64
 }
 
-func newIdentifier(sex bool, undershirtID, topID uint64) Identifier {
+// NewIdentifier of a top
+func NewIdentifier(sex bool, undershirtID, topID uint64) Identifier {
 	return &identifier{sex, undershirtID, topID}
 }
 
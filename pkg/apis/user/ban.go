@@ -0,0 +1,61 @@
+package user
+
+import (
+	"time"
+
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+// BanScope describes where a ban is enforced
+type BanScope string
+
+// scopes of a ban
+const (
+	// BanScopeGame is enforced by the game server login
+	BanScopeGame BanScope = "game"
+	// BanScopeForum is recorded for the moderators of the forum,
+	// the forum account itself is not banned by this service
+	BanScopeForum BanScope = "forum"
+	// BanScopeAPI is enforced by the api login and token refresh
+	BanScopeAPI BanScope = "api"
+)
+
+var banScopes = []BanScope{
+	BanScopeGame,
+	BanScopeForum,
+	BanScopeAPI,
+}
+
+// Ban of a user. A ban without an end is permanent.
+// Revoked bans are kept as the ban history of a user.
+type Ban struct {
+	ID        string         `json:"id"`
+	UserUUID  string         `json:"user_uuid"`
+	Reason    string         `json:"reason"`
+	Issuer    rbac.AccountID `json:"issuer"`
+	Scopes    []BanScope     `json:"scopes"`
+	StartsAt  time.Time      `json:"starts_at"`
+	EndsAt    *time.Time     `json:"ends_at,omitempty"`
+	RevokedAt *time.Time     `json:"revoked_at,omitempty"`
+	RevokedBy rbac.AccountID `json:"revoked_by,omitempty"`
+}
+
+// Covers checks whether the ban is enforced in a scope
+func (b *Ban) Covers(scope BanScope) bool {
+	for _, v := range b.Scopes {
+		if v == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ActiveAt checks whether the ban is in effect at a point in time
+func (b *Ban) ActiveAt(t time.Time) bool {
+	if b.RevokedAt != nil || t.Before(b.StartsAt) {
+		return false
+	}
+
+	return b.EndsAt == nil || t.Before(*b.EndsAt)
+}
@@ -4,10 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 
 	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/pagination"
+	"github.com/51st-state/api/pkg/rbac"
 )
 
 // CreateSchema for the cockroachdb repository
@@ -17,7 +23,7 @@ func CreateSchema(ctx context.Context, db *sql.DB) (err error) {
 		`CREATE TABLE IF NOT EXISTS users (
             id UUID PRIMARY KEY,
             wcfUserId integer NOT NULL DEFAULT 0,
-            gameSerialHash text NOT NULL DEFAULT '',
+            gameSerialHash text NULL,
             banned boolean NOT NULL DEFAULT false,
             UNIQUE(id),
             UNIQUE(wcfUserId),
@@ -25,7 +31,38 @@ func CreateSchema(ctx context.Context, db *sql.DB) (err error) {
         );
         CREATE UNIQUE INDEX IF NOT EXISTS users_idx_id ON users (id);
         CREATE UNIQUE INDEX IF NOT EXISTS users_idx_wcfUserId ON users (wcfUserId);
-        CREATE UNIQUE INDEX IF NOT EXISTS users_idx_gameSerialHash ON users (gameSerialHash);`,
+        CREATE UNIQUE INDEX IF NOT EXISTS users_idx_gameSerialHash ON users (gameSerialHash);
+        ALTER TABLE users ALTER COLUMN gameSerialHash DROP NOT NULL;
+        ALTER TABLE users ALTER COLUMN gameSerialHash DROP DEFAULT;
+        UPDATE users SET gameSerialHash = NULL WHERE gameSerialHash = '';
+        ALTER TABLE users ADD COLUMN IF NOT EXISTS deletedAt TIMESTAMPTZ NULL;
+
+        CREATE TABLE IF NOT EXISTS user_bans (
+            id UUID PRIMARY KEY,
+            userId UUID NOT NULL,
+            reason text NOT NULL DEFAULT '',
+            issuer text NOT NULL DEFAULT '',
+            scopes JSONB NOT NULL DEFAULT '[]',
+            startsAt TIMESTAMPTZ NOT NULL,
+            endsAt TIMESTAMPTZ NULL,
+            revokedAt TIMESTAMPTZ NULL,
+            revokedBy text NOT NULL DEFAULT ''
+        );
+        CREATE INDEX IF NOT EXISTS user_bans_idx_userId ON user_bans (userId);
+
+        CREATE TABLE IF NOT EXISTS user_link_codes (
+            code text PRIMARY KEY,
+            gameSerialHash text NOT NULL,
+            expiresAt TIMESTAMPTZ NOT NULL
+        );
+
+        CREATE TABLE IF NOT EXISTS user_game_links (
+            id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+            userId UUID NOT NULL,
+            gameSerialHash text NOT NULL,
+            linkedAt TIMESTAMPTZ NOT NULL
+        );
+        CREATE INDEX IF NOT EXISTS user_game_links_idx_userId ON user_game_links (userId);`,
 	)
 	return
 }
@@ -90,10 +127,11 @@ func (r *repository) Get(ctx context.Context, id user.Identifier) (user.Complete
 	if err := r.database.QueryRowContext(
 		ctx,
 		`SELECT wcfUserId,
-        gameSerialHash,
+        COALESCE(gameSerialHash, ''),
         banned
         FROM users
-        WHERE id = $1`,
+        WHERE id = $1
+        AND deletedAt IS NULL`,
 		id.UUID(),
 	).Scan(
 		&inc.Data().WCFUserID,
@@ -114,9 +152,10 @@ func (r *repository) GetByWCFUserID(ctx context.Context, wcfUserID user.WCFUserI
 		ctx,
 		`SELECT id,
         banned,
-        gameSerialHash
+        COALESCE(gameSerialHash, '')
         FROM users
-        WHERE wcfUserId = $1`,
+        WHERE wcfUserId = $1
+        AND deletedAt IS NULL`,
 		wcfUserID,
 	).Scan(
 		&id,
@@ -142,7 +181,8 @@ func (r *repository) GetByGameSerialHash(ctx context.Context, hash string) (user
         wcfUserId,
         banned
         FROM users
-        WHERE gameSerialHash = $1`,
+        WHERE gameSerialHash = $1
+        AND deletedAt IS NULL`,
 		hash,
 	).Scan(
 		&id,
@@ -175,7 +215,7 @@ func (r *repository) Create(ctx context.Context, inc user.Incomplete) (user.Comp
         ) VALUES (
             $1,
             $2,
-            $3,
+            NULLIF($3, ''),
             $4
         )`,
 		rand.String(),
@@ -197,23 +237,518 @@ func (r *repository) Update(ctx context.Context, c user.Complete) error {
 		ctx,
 		`UPDATE users
         SET wcfUserId = $1,
-        gameSerialHash = $2,
+        gameSerialHash = NULLIF($2, ''),
         banned = $3
-        WHERE id = $4`,
+        WHERE id = $4
+        AND deletedAt IS NULL`,
 		c.Data().WCFUserID,
 		c.Data().GameSerialHash,
 		c.Data().Banned,
 		c.UUID(),
 	)
+	if isUniqueViolation(err, "gameserialhash") {
+		return user.ErrDuplicateGameSerialHash
+	}
+
 	return err
 }
 
+// uniqueViolation is the error code of statements violating a unique constraint
+const uniqueViolation = "23505"
+
+// isUniqueViolation checks whether the error violates a unique constraint of a column
+func isUniqueViolation(err error, column string) bool {
+	e, ok := err.(*pq.Error)
+	if !ok || e.Code != uniqueViolation {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(e.Constraint+e.Message), column)
+}
+
 func (r *repository) Delete(ctx context.Context, id user.Identifier) error {
-	_, err := r.database.ExecContext(
-		ctx,
+	tx, err := r.database.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, query := range []string{
 		`DELETE FROM users
         WHERE id = $1`,
+		`DELETE FROM user_bans
+        WHERE userId = $1`,
+		`DELETE FROM user_game_links
+        WHERE userId = $1`,
+	} {
+		if _, err := tx.ExecContext(ctx, query, id.UUID()); err != nil {
+			return txError(tx, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *repository) SoftDelete(ctx context.Context, id user.Identifier, at time.Time) error {
+	res, err := r.database.ExecContext(
+		ctx,
+		`UPDATE users
+        SET deletedAt = $1
+        WHERE id = $2
+        AND deletedAt IS NULL`,
+		at,
 		id.UUID(),
 	)
+	if err != nil {
+		return err
+	}
+
+	return rowAffected(res)
+}
+
+func (r *repository) Restore(ctx context.Context, id user.Identifier) error {
+	res, err := r.database.ExecContext(
+		ctx,
+		`UPDATE users
+        SET deletedAt = NULL
+        WHERE id = $1
+        AND deletedAt IS NOT NULL`,
+		id.UUID(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return rowAffected(res)
+}
+
+// rowAffected returns sql.ErrNoRows if no row was affected
+func rowAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *repository) getDeletion(ctx context.Context, condition string, arg interface{}) (*user.Deletion, error) {
+	d := &user.Deletion{}
+	if err := r.database.QueryRowContext(
+		ctx,
+		`SELECT id,
+        wcfUserId,
+        deletedAt
+        FROM users
+        WHERE `+condition+`
+        AND deletedAt IS NOT NULL`,
+		arg,
+	).Scan(
+		&d.UserUUID,
+		&d.WCFUserID,
+		&d.DeletedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (r *repository) GetDeletion(ctx context.Context, id user.Identifier) (*user.Deletion, error) {
+	return r.getDeletion(ctx, "id = $1", id.UUID())
+}
+
+func (r *repository) GetDeletionByWCFUserID(ctx context.Context, wcfUserID user.WCFUserID) (*user.Deletion, error) {
+	return r.getDeletion(ctx, "wcfUserId = $1", wcfUserID)
+}
+
+func (r *repository) GetDeletions(ctx context.Context, before time.Time) ([]*user.Deletion, error) {
+	rows, err := r.database.QueryContext(
+		ctx,
+		`SELECT id,
+        wcfUserId,
+        deletedAt
+        FROM users
+        WHERE deletedAt < $1
+        ORDER BY deletedAt`,
+		before,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deletions := make([]*user.Deletion, 0)
+	for rows.Next() {
+		d := &user.Deletion{}
+		if err := rows.Scan(
+			&d.UserUUID,
+			&d.WCFUserID,
+			&d.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		deletions = append(deletions, d)
+	}
+
+	return deletions, rows.Err()
+}
+
+func txError(tx *sql.Tx, err error) error {
+	if rErr := tx.Rollback(); rErr != nil {
+		return rErr
+	}
+
 	return err
 }
+
+func (r *repository) List(ctx context.Context, opts *user.ListOptions, page pagination.Page) ([]user.Complete, error) {
+	args := []interface{}{time.Now(), string(user.BanScopeGame)}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	conditions := []string{"true"}
+	if page.Cursor != "" {
+		conditions = append(conditions, "id > "+arg(page.Cursor))
+	}
+
+	if opts.Banned != nil {
+		conditions = append(conditions, "isBanned = "+arg(*opts.Banned))
+	}
+
+	if opts.WCFUserIDs != nil {
+		if len(opts.WCFUserIDs) == 0 {
+			return make([]user.Complete, 0), nil
+		}
+
+		placeholders := make([]string, 0, len(opts.WCFUserIDs))
+		for _, v := range opts.WCFUserIDs {
+			placeholders = append(placeholders, arg(v))
+		}
+
+		conditions = append(conditions, "wcfUserId IN ("+strings.Join(placeholders, ", ")+")")
+	}
+
+	rows, err := r.database.QueryContext(
+		ctx,
+		`SELECT id,
+        wcfUserId,
+        gameSerialHash,
+        isBanned
+        FROM (
+            SELECT u.id,
+            u.wcfUserId,
+            COALESCE(u.gameSerialHash, '') AS gameSerialHash,
+            u.banned OR EXISTS (
+                SELECT 1
+                FROM user_bans AS b
+                WHERE b.userId = u.id
+                AND b.revokedAt IS NULL
+                AND b.startsAt <= $1
+                AND (b.endsAt IS NULL OR b.endsAt > $1)
+                AND b.scopes ? $2
+            ) AS isBanned
+            FROM users AS u
+            WHERE u.deletedAt IS NULL
+        ) AS listing
+        WHERE `+strings.Join(conditions, " AND ")+`
+        ORDER BY id
+        LIMIT `+arg(page.Limit),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]user.Complete, 0)
+	for rows.Next() {
+		inc := user.NewIncomplete(0, "", "", "", false)
+		var id string
+		if err := rows.Scan(
+			&id,
+			&inc.Data().WCFUserID,
+			&inc.Data().GameSerialHash,
+			&inc.Data().Banned,
+		); err != nil {
+			return nil, err
+		}
+
+		users = append(users, newComplete(newIdentifier(id), inc))
+	}
+
+	return users, rows.Err()
+}
+
+type scanner interface {
+	Scan(...interface{}) error
+}
+
+func scanBan(row scanner) (*user.Ban, error) {
+	b := &user.Ban{}
+	var (
+		scopes    []byte
+		endsAt    *time.Time
+		revokedAt *time.Time
+	)
+
+	if err := row.Scan(
+		&b.ID,
+		&b.UserUUID,
+		&b.Reason,
+		&b.Issuer,
+		&scopes,
+		&b.StartsAt,
+		&endsAt,
+		&revokedAt,
+		&b.RevokedBy,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(scopes, &b.Scopes); err != nil {
+		return nil, err
+	}
+
+	b.EndsAt = endsAt
+	b.RevokedAt = revokedAt
+
+	return b, nil
+}
+
+func (r *repository) GetBan(ctx context.Context, banID string) (*user.Ban, error) {
+	if _, err := uuid.Parse(banID); err != nil {
+		return nil, sql.ErrNoRows
+	}
+
+	return scanBan(r.database.QueryRowContext(
+		ctx,
+		`SELECT id,
+        userId,
+        reason,
+        issuer,
+        scopes,
+        startsAt,
+        endsAt,
+        revokedAt,
+        revokedBy
+        FROM user_bans
+        WHERE id = $1`,
+		banID,
+	))
+}
+
+func (r *repository) GetBans(ctx context.Context, id user.Identifier) ([]*user.Ban, error) {
+	rows, err := r.database.QueryContext(
+		ctx,
+		`SELECT id,
+        userId,
+        reason,
+        issuer,
+        scopes,
+        startsAt,
+        endsAt,
+        revokedAt,
+        revokedBy
+        FROM user_bans
+        WHERE userId = $1
+        ORDER BY startsAt, id`,
+		id.UUID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bans := make([]*user.Ban, 0)
+	for rows.Next() {
+		b, err := scanBan(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		bans = append(bans, b)
+	}
+
+	return bans, rows.Err()
+}
+
+func (r *repository) AddBan(ctx context.Context, b *user.Ban) (*user.Ban, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := json.Marshal(append(make([]user.BanScope, 0), b.Scopes...))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.database.ExecContext(
+		ctx,
+		`INSERT INTO user_bans (
+            id,
+            userId,
+            reason,
+            issuer,
+            scopes,
+            startsAt,
+            endsAt,
+            revokedAt,
+            revokedBy
+        ) VALUES (
+            $1,
+            $2,
+            $3,
+            $4,
+            $5,
+            $6,
+            $7,
+            $8,
+            $9
+        )`,
+		rand.String(),
+		b.UserUUID,
+		b.Reason,
+		b.Issuer,
+		scopes,
+		b.StartsAt,
+		b.EndsAt,
+		b.RevokedAt,
+		b.RevokedBy,
+	); err != nil {
+		return nil, err
+	}
+
+	c := *b
+	c.ID = rand.String()
+
+	return &c, nil
+}
+
+func (r *repository) RevokeBan(ctx context.Context, banID string, by rbac.AccountID, at time.Time) error {
+	if _, err := uuid.Parse(banID); err != nil {
+		return nil
+	}
+
+	_, err := r.database.ExecContext(
+		ctx,
+		`UPDATE user_bans
+        SET revokedAt = $1,
+        revokedBy = $2
+        WHERE id = $3`,
+		at,
+		by,
+		banID,
+	)
+	return err
+}
+
+// AddLinkCode stores a link code. Expired link codes are removed on the way.
+func (r *repository) AddLinkCode(ctx context.Context, c *user.LinkCode) error {
+	if _, err := r.database.ExecContext(
+		ctx,
+		`DELETE FROM user_link_codes
+        WHERE expiresAt <= $1`,
+		time.Now(),
+	); err != nil {
+		return err
+	}
+
+	_, err := r.database.ExecContext(
+		ctx,
+		`INSERT INTO user_link_codes (
+            code,
+            gameSerialHash,
+            expiresAt
+        ) VALUES (
+            $1,
+            $2,
+            $3
+        )`,
+		c.Code,
+		c.GameSerialHash,
+		c.ExpiresAt,
+	)
+	return err
+}
+
+func (r *repository) TakeLinkCode(ctx context.Context, code string) (*user.LinkCode, error) {
+	c := &user.LinkCode{}
+
+	if err := r.database.QueryRowContext(
+		ctx,
+		`DELETE FROM user_link_codes
+        WHERE code = $1
+        RETURNING code,
+        gameSerialHash,
+        expiresAt`,
+		code,
+	).Scan(
+		&c.Code,
+		&c.GameSerialHash,
+		&c.ExpiresAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (r *repository) AddGameLink(ctx context.Context, l *user.GameLink) error {
+	_, err := r.database.ExecContext(
+		ctx,
+		`INSERT INTO user_game_links (
+            userId,
+            gameSerialHash,
+            linkedAt
+        ) VALUES (
+            $1,
+            $2,
+            $3
+        )`,
+		l.UserUUID,
+		l.GameSerialHash,
+		l.LinkedAt,
+	)
+	return err
+}
+
+func (r *repository) GetGameLinks(ctx context.Context, id user.Identifier) ([]*user.GameLink, error) {
+	rows, err := r.database.QueryContext(
+		ctx,
+		`SELECT userId,
+        gameSerialHash,
+        linkedAt
+        FROM user_game_links
+        WHERE userId = $1
+        ORDER BY linkedAt, id`,
+		id.UUID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	links := make([]*user.GameLink, 0)
+	for rows.Next() {
+		l := &user.GameLink{}
+		if err := rows.Scan(
+			&l.UserUUID,
+			&l.GameSerialHash,
+			&l.LinkedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		links = append(links, l)
+	}
+
+	return links, rows.Err()
+}
@@ -0,0 +1,28 @@
+package user
+
+import (
+	"errors"
+	"time"
+)
+
+// Deletion of a user. Deleted users are kept for the deletion retention,
+// so that they can be restored, and purged afterwards.
+type Deletion struct {
+	UserUUID  string    `json:"user_uuid"`
+	WCFUserID WCFUserID `json:"wcf_user_id"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// PurgeAt returns the time the deleted user is purged
+func (d *Deletion) PurgeAt() time.Time {
+	return d.DeletedAt.Add(deletionRetention)
+}
+
+// deletionRetention until deleted users are purged
+const deletionRetention = 30 * 24 * time.Hour
+
+// ErrDeleted is returned on creating a user for the wcf user of a deleted user.
+// The deleted user has to be restored instead.
+var ErrDeleted = errors.New("user deleted")
+
+var errNotDeleted = errors.New("user not deleted")
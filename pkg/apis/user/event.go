@@ -22,7 +22,7 @@ type UpdatedEvent struct {
 	Data Complete           `json:"data"`
 }
 
-// DeletedEventID of an user object
+// DeletedEventID of an user object, produced once a soft deleted user is purged
 const DeletedEventID event.ID = "user_deleted"
 
 // DeletedEvent of an user object
@@ -31,6 +31,25 @@ type DeletedEvent struct {
 	Data Identifier         `json:"data"`
 }
 
+// SoftDeletedEventID of an user object. The user is kept for the
+// deletion retention and the deleted event is produced once it is purged.
+const SoftDeletedEventID event.ID = "user_soft_deleted"
+
+// SoftDeletedEvent of an user object
+type SoftDeletedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data *Deletion          `json:"data"`
+}
+
+// RestoredEventID of a soft deleted user object
+const RestoredEventID event.ID = "user_restored"
+
+// RestoredEvent of an user object
+type RestoredEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data Complete           `json:"data"`
+}
+
 // PasswordSetEventID of an user object
 const PasswordSetEventID event.ID = "user_password_set"
 
@@ -39,3 +58,40 @@ type PasswordSetEvent struct {
 	Meta *event.PayloadMeta `json:"meta"`
 	Data CompletePassword   `json:"data"`
 }
+
+// BannedEventID of an user object
+const BannedEventID event.ID = "user_banned"
+
+// BannedEvent of an user object
+type BannedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data *Ban               `json:"data"`
+}
+
+// UnbannedEventID of an user object.
+// Only produced if a ban is revoked, bans running out do not produce an event.
+const UnbannedEventID event.ID = "user_unbanned"
+
+// UnbannedEvent of an user object
+type UnbannedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data *Ban               `json:"data"`
+}
+
+// GameLinkedEventID of an user object
+const GameLinkedEventID event.ID = "user_game_linked"
+
+// GameLinkedEvent of an user object
+type GameLinkedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data *GameLink          `json:"data"`
+}
+
+// GameUnlinkedEventID of an user object
+const GameUnlinkedEventID event.ID = "user_game_unlinked"
+
+// GameUnlinkedEvent of an user object
+type GameUnlinkedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data *GameLink          `json:"data"`
+}
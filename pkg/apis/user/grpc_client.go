@@ -2,14 +2,17 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 
 	"google.golang.org/grpc/status"
 
 	pb "github.com/51st-state/api/pkg/apis/user/proto"
+	"github.com/51st-state/api/pkg/pagination"
 	"github.com/51st-state/api/pkg/rbac"
 	proto1 "github.com/51st-state/api/pkg/rbac/proto"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 	grpc "google.golang.org/grpc"
 )
@@ -75,6 +78,43 @@ func (cli *grpcClient) GetByWCFUserID(ctx context.Context, wcfUserID WCFUserID)
 	), nil
 }
 
+// List a page of the users matching a filter
+func (cli *grpcClient) List(ctx context.Context, f *ListFilter, page pagination.Page) ([]Complete, error) {
+	req := &pb.ListUsersRequest{
+		Query: f.Query,
+		Page: &proto1.Page{
+			Cursor: page.Cursor,
+			Limit:  page.Limit,
+		},
+	}
+
+	if f.Banned != nil {
+		req.FilterBanned = true
+		req.Banned = *f.Banned
+	}
+
+	resp, err := cli.client.ListUsers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]Complete, 0)
+	for _, v := range resp.GetUsers() {
+		users = append(users, newComplete(
+			newIdentifier(v.GetUUID().GetUUID()),
+			NewIncomplete(
+				WCFUserID(v.GetData().GetWCFUserID()),
+				v.GetData().GetUsername(),
+				v.GetData().GetEmail(),
+				v.GetData().GetGameHash(),
+				v.GetData().GetBanned(),
+			),
+		))
+	}
+
+	return users, nil
+}
+
 // ErrNotFound is returned if an user object was not found
 var ErrNotFound = errors.New("user not found")
 
@@ -114,6 +154,10 @@ func (cli *grpcClient) Create(ctx context.Context, inc Incomplete) (Complete, er
 		},
 	})
 	if err != nil {
+		if status.Convert(err).Code() == codes.FailedPrecondition {
+			return nil, ErrDeleted
+		}
+
 		return nil, err
 	}
 
@@ -150,6 +194,33 @@ func (cli *grpcClient) Delete(ctx context.Context, id Identifier) error {
 	return err
 }
 
+// Restore a soft deleted user object
+func (cli *grpcClient) Restore(ctx context.Context, id Identifier) (Complete, error) {
+	resp, err := cli.client.RestoreUser(ctx, &pb.UUID{
+		UUID: id.UUID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newComplete(
+		newIdentifier(resp.GetUUID().GetUUID()),
+		NewIncomplete(
+			WCFUserID(resp.GetData().GetWCFUserID()),
+			resp.GetData().GetUsername(),
+			resp.GetData().GetEmail(),
+			resp.GetData().GetGameHash(),
+			resp.GetData().GetBanned(),
+		),
+	), nil
+}
+
+// Purge the users deleted longer than the deletion retention ago
+func (cli *grpcClient) Purge(ctx context.Context) error {
+	_, err := cli.client.PurgeUsers(ctx, &empty.Empty{})
+	return err
+}
+
 // CheckPassword of an user
 func (cli *grpcClient) CheckPassword(ctx context.Context, id Identifier, pw IncompletePassword) error {
 	_, err := cli.client.CheckUserPassword(ctx, &pb.CheckUserPasswordRequest{
@@ -177,7 +248,6 @@ func (cli *grpcClient) GetWCFInfo(ctx context.Context, name string) (*WCFUserInf
 		WCFUserID(resp.GetUserID()),
 		resp.GetUsername(),
 		resp.GetEmail(),
-		newCompletePassword(resp.GetPassword()),
 	}, nil
 }
 
@@ -215,3 +285,172 @@ func (cli *grpcClient) SetRoles(ctx context.Context, id Identifier, roles rbac.A
 	})
 	return err
 }
+
+func fromUnixNano(nsec int64) *time.Time {
+	if nsec == 0 {
+		return nil
+	}
+
+	t := time.Unix(0, nsec)
+	return &t
+}
+
+func banFromGRPC(b *pb.Ban) *Ban {
+	scopes := make([]BanScope, 0)
+	for _, v := range b.GetScopes() {
+		scopes = append(scopes, BanScope(v))
+	}
+
+	ban := &Ban{
+		ID:        b.GetID(),
+		UserUUID:  b.GetUserUUID(),
+		Reason:    b.GetReason(),
+		Issuer:    rbac.AccountID(b.GetIssuer()),
+		Scopes:    scopes,
+		EndsAt:    fromUnixNano(b.GetEndsAt()),
+		RevokedAt: fromUnixNano(b.GetRevokedAt()),
+		RevokedBy: rbac.AccountID(b.GetRevokedBy()),
+	}
+
+	if startsAt := fromUnixNano(b.GetStartsAt()); startsAt != nil {
+		ban.StartsAt = *startsAt
+	}
+
+	return ban
+}
+
+// GetBans returns the ban history of a user
+func (cli *grpcClient) GetBans(ctx context.Context, id Identifier) ([]*Ban, error) {
+	resp, err := cli.client.GetUserBans(ctx, &pb.UUID{
+		UUID: id.UUID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bans := make([]*Ban, 0)
+	for _, v := range resp.GetBans() {
+		bans = append(bans, banFromGRPC(v))
+	}
+
+	return bans, nil
+}
+
+// GetActiveBan returns the ban in effect for a user in a scope
+func (cli *grpcClient) GetActiveBan(ctx context.Context, id Identifier, scope BanScope) (*Ban, error) {
+	resp, err := cli.client.GetActiveUserBan(ctx, &pb.GetActiveUserBanRequest{
+		UUID: &pb.UUID{
+			UUID: id.UUID(),
+		},
+		Scope: string(scope),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !resp.GetBanned() {
+		return nil, nil
+	}
+
+	return banFromGRPC(resp.GetBan()), nil
+}
+
+// Ban a user
+func (cli *grpcClient) Ban(ctx context.Context, id Identifier, b *Ban) (*Ban, error) {
+	resp, err := cli.client.BanUser(rbac.ActorToOutgoingContext(ctx), &pb.BanUserRequest{
+		UUID: &pb.UUID{
+			UUID: id.UUID(),
+		},
+		Ban: grpcBan(b),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return banFromGRPC(resp), nil
+}
+
+// Unban a user by revoking one of its bans
+func (cli *grpcClient) Unban(ctx context.Context, id Identifier, banID string) error {
+	_, err := cli.client.UnbanUser(rbac.ActorToOutgoingContext(ctx), &pb.UnbanUserRequest{
+		UUID: &pb.UUID{
+			UUID: id.UUID(),
+		},
+		BanID: banID,
+	})
+	return err
+}
+
+// RequestLinkCode for a game account
+func (cli *grpcClient) RequestLinkCode(ctx context.Context, hash string) (*LinkCode, error) {
+	resp, err := cli.client.RequestLinkCode(ctx, &pb.RequestLinkCodeRequest{
+		GameSerialHash: hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &LinkCode{
+		Code:           resp.GetCode(),
+		GameSerialHash: hash,
+		ExpiresAt:      time.Unix(0, resp.GetExpiresAt()),
+	}, nil
+}
+
+// RedeemLinkCode links the game account of a link code to a user
+func (cli *grpcClient) RedeemLinkCode(ctx context.Context, id Identifier, code string) (Complete, error) {
+	resp, err := cli.client.RedeemLinkCode(ctx, &pb.RedeemLinkCodeRequest{
+		UUID: &pb.UUID{
+			UUID: id.UUID(),
+		},
+		Code: code,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newComplete(
+		id,
+		NewIncomplete(
+			WCFUserID(resp.GetData().GetWCFUserID()),
+			resp.GetData().GetUsername(),
+			resp.GetData().GetEmail(),
+			resp.GetData().GetGameHash(),
+			resp.GetData().GetBanned(),
+		),
+	), nil
+}
+
+// UnlinkGame removes the linked game account of a user
+func (cli *grpcClient) UnlinkGame(ctx context.Context, id Identifier) error {
+	_, err := cli.client.UnlinkGame(ctx, &pb.UUID{
+		UUID: id.UUID(),
+	})
+	return err
+}
+
+// GetGameLinks returns the link history of a user
+func (cli *grpcClient) GetGameLinks(ctx context.Context, id Identifier) ([]*GameLink, error) {
+	resp, err := cli.client.GetGameLinks(ctx, &pb.UUID{
+		UUID: id.UUID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]*GameLink, 0)
+	for _, v := range resp.GetGameLinks() {
+		link := &GameLink{
+			UserUUID:       v.GetUserUUID(),
+			GameSerialHash: v.GetGameSerialHash(),
+		}
+
+		if linkedAt := fromUnixNano(v.GetLinkedAt()); linkedAt != nil {
+			link.LinkedAt = *linkedAt
+		}
+
+		links = append(links, link)
+	}
+
+	return links, nil
+}
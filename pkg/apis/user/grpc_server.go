@@ -3,11 +3,13 @@ package user
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/51st-state/api/pkg/apis/user/proto"
+	"github.com/51st-state/api/pkg/pagination"
 	"github.com/51st-state/api/pkg/rbac"
 	proto1 "github.com/51st-state/api/pkg/rbac/proto"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -84,6 +86,43 @@ func (s *GRPCServer) GetUserByGameSerialHash(ctx context.Context, req *pb.GetUse
 	}, nil
 }
 
+// ListUsers returns a page of the users matching a filter
+func (s *GRPCServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.Users, error) {
+	f := &ListFilter{
+		Query: req.GetQuery(),
+	}
+
+	if req.GetFilterBanned() {
+		banned := req.GetBanned()
+		f.Banned = &banned
+	}
+
+	users, err := s.manager.List(ctx, f, pagination.New(req.GetPage().GetCursor(), req.GetPage().GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	grpcUsers := &pb.Users{
+		Users: make([]*pb.User, 0),
+	}
+	for _, c := range users {
+		grpcUsers.Users = append(grpcUsers.Users, &pb.User{
+			UUID: &pb.UUID{
+				UUID: c.UUID(),
+			},
+			Data: &pb.Data{
+				WCFUserID: uint64(c.Data().WCFUserID),
+				Username:  c.Data().WCFUsername,
+				Email:     c.Data().WCFEmail,
+				GameHash:  c.Data().GameSerialHash,
+				Banned:    c.Data().Banned,
+			},
+		})
+	}
+
+	return grpcUsers, nil
+}
+
 // CreateUser in the database
 func (s *GRPCServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
 	c, err := s.manager.Create(ctx, NewIncomplete(
@@ -93,7 +132,9 @@ func (s *GRPCServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 		req.GetData().GetGameHash(),
 		req.GetData().GetBanned(),
 	))
-	if err != nil {
+	if err == ErrDeleted {
+		return nil, status.New(codes.FailedPrecondition, err.Error()).Err()
+	} else if err != nil {
 		return nil, err
 	}
 
@@ -119,6 +160,32 @@ func (s *GRPCServer) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest)
 	)
 }
 
+// RestoreUser which was deleted softly
+func (s *GRPCServer) RestoreUser(ctx context.Context, id *pb.UUID) (*pb.User, error) {
+	c, err := s.manager.Restore(ctx, newIdentifier(id.GetUUID()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.User{
+		UUID: &pb.UUID{
+			UUID: c.UUID(),
+		},
+		Data: &pb.Data{
+			WCFUserID: uint64(c.Data().WCFUserID),
+			Username:  c.Data().WCFUsername,
+			Email:     c.Data().WCFEmail,
+			GameHash:  c.Data().GameSerialHash,
+			Banned:    c.Data().Banned,
+		},
+	}, nil
+}
+
+// PurgeUsers deleted longer than the deletion retention ago
+func (s *GRPCServer) PurgeUsers(ctx context.Context, _ *empty.Empty) (*empty.Empty, error) {
+	return &empty.Empty{}, s.manager.Purge(ctx)
+}
+
 // UpdateUser credentials in the database
 func (s *GRPCServer) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*empty.Empty, error) {
 	return &empty.Empty{}, s.manager.Update(ctx, newComplete(
@@ -157,8 +224,8 @@ func (s *GRPCServer) GetWCFInfo(ctx context.Context, req *pb.GetWCFInfoRequest)
 
 	return &pb.WCFUserInfo{
 		UserID:   uint64(info.UserID),
+		Username: info.Username,
 		Email:    info.Email,
-		Password: info.Password.Hash(),
 	}, nil
 }
 
@@ -188,3 +255,147 @@ func (s *GRPCServer) SetUserRoles(ctx context.Context, req *pb.SetUserRolesReque
 
 	return &empty.Empty{}, s.manager.SetRoles(ctx, newIdentifier(req.GetUUID().GetUUID()), roles)
 }
+
+func unixNano(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+
+	return t.UnixNano()
+}
+
+func grpcBan(b *Ban) *pb.Ban {
+	scopes := make([]string, 0)
+	for _, v := range b.Scopes {
+		scopes = append(scopes, string(v))
+	}
+
+	return &pb.Ban{
+		ID:        b.ID,
+		UserUUID:  b.UserUUID,
+		Reason:    b.Reason,
+		Issuer:    string(b.Issuer),
+		Scopes:    scopes,
+		StartsAt:  unixNano(&b.StartsAt),
+		EndsAt:    unixNano(b.EndsAt),
+		RevokedAt: unixNano(b.RevokedAt),
+		RevokedBy: string(b.RevokedBy),
+	}
+}
+
+// GetUserBans returns the ban history of a user
+func (s *GRPCServer) GetUserBans(ctx context.Context, id *pb.UUID) (*pb.Bans, error) {
+	bans, err := s.manager.GetBans(ctx, newIdentifier(id.GetUUID()))
+	if err != nil {
+		return nil, err
+	}
+
+	grpcBans := &pb.Bans{
+		Bans: make([]*pb.Ban, 0),
+	}
+	for _, v := range bans {
+		grpcBans.Bans = append(grpcBans.Bans, grpcBan(v))
+	}
+
+	return grpcBans, nil
+}
+
+// GetActiveUserBan returns the ban in effect for a user in a scope
+func (s *GRPCServer) GetActiveUserBan(ctx context.Context, req *pb.GetActiveUserBanRequest) (*pb.ActiveUserBan, error) {
+	ban, err := s.manager.GetActiveBan(ctx, newIdentifier(req.GetUUID().GetUUID()), BanScope(req.GetScope()))
+	if err != nil {
+		return nil, err
+	}
+
+	if ban == nil {
+		return &pb.ActiveUserBan{}, nil
+	}
+
+	return &pb.ActiveUserBan{
+		Banned: true,
+		Ban:    grpcBan(ban),
+	}, nil
+}
+
+// BanUser with the actor of the request as issuer
+func (s *GRPCServer) BanUser(ctx context.Context, req *pb.BanUserRequest) (*pb.Ban, error) {
+	ban, err := s.manager.Ban(
+		ctx,
+		newIdentifier(req.GetUUID().GetUUID()),
+		banFromGRPC(req.GetBan()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return grpcBan(ban), nil
+}
+
+// UnbanUser by revoking one of its bans
+func (s *GRPCServer) UnbanUser(ctx context.Context, req *pb.UnbanUserRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, s.manager.Unban(
+		ctx,
+		newIdentifier(req.GetUUID().GetUUID()),
+		req.GetBanID(),
+	)
+}
+
+// RequestLinkCode for a game account
+func (s *GRPCServer) RequestLinkCode(ctx context.Context, req *pb.RequestLinkCodeRequest) (*pb.LinkCode, error) {
+	c, err := s.manager.RequestLinkCode(ctx, req.GetGameSerialHash())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LinkCode{
+		Code:      c.Code,
+		ExpiresAt: c.ExpiresAt.UnixNano(),
+	}, nil
+}
+
+// RedeemLinkCode links the game account of a link code to a user
+func (s *GRPCServer) RedeemLinkCode(ctx context.Context, req *pb.RedeemLinkCodeRequest) (*pb.User, error) {
+	c, err := s.manager.RedeemLinkCode(ctx, newIdentifier(req.GetUUID().GetUUID()), req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.User{
+		UUID: &pb.UUID{
+			UUID: c.UUID(),
+		},
+		Data: &pb.Data{
+			WCFUserID: uint64(c.Data().WCFUserID),
+			Username:  c.Data().WCFUsername,
+			Email:     c.Data().WCFEmail,
+			GameHash:  c.Data().GameSerialHash,
+			Banned:    c.Data().Banned,
+		},
+	}, nil
+}
+
+// UnlinkGame removes the linked game account of a user
+func (s *GRPCServer) UnlinkGame(ctx context.Context, id *pb.UUID) (*empty.Empty, error) {
+	return &empty.Empty{}, s.manager.UnlinkGame(ctx, newIdentifier(id.GetUUID()))
+}
+
+// GetGameLinks returns the link history of a user
+func (s *GRPCServer) GetGameLinks(ctx context.Context, id *pb.UUID) (*pb.GameLinks, error) {
+	links, err := s.manager.GetGameLinks(ctx, newIdentifier(id.GetUUID()))
+	if err != nil {
+		return nil, err
+	}
+
+	grpcLinks := &pb.GameLinks{
+		GameLinks: make([]*pb.GameLink, 0),
+	}
+	for _, v := range links {
+		grpcLinks.GameLinks = append(grpcLinks.GameLinks, &pb.GameLink{
+			UserUUID:       v.UserUUID,
+			GameSerialHash: v.GameSerialHash,
+			LinkedAt:       unixNano(&v.LinkedAt),
+		})
+	}
+
+	return grpcLinks, nil
+}
@@ -0,0 +1,60 @@
+package user
+
+import (
+	"crypto/rand"
+	"errors"
+	"strings"
+	"time"
+)
+
+// LinkCode is a one-time code linking a game account to a user.
+// It is requested by the game server and redeemed by the player on the website.
+type LinkCode struct {
+	Code           string    `json:"code"`
+	GameSerialHash string    `json:"-"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// GameLink of a user, kept as the link history of a user
+type GameLink struct {
+	UserUUID       string    `json:"user_uuid"`
+	GameSerialHash string    `json:"game_serial_hash"`
+	LinkedAt       time.Time `json:"linked_at"`
+}
+
+const (
+	linkCodeLength   = 8
+	linkCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	linkCodeTTL      = 10 * time.Minute
+
+	// maxGameLinks of a user within the game link period
+	maxGameLinks   = 3
+	gameLinkPeriod = 30 * 24 * time.Hour
+)
+
+var (
+	errInvalidLinkCode      = errors.New("invalid or expired link code")
+	errGameAlreadyLinked    = errors.New("a game account is already linked")
+	errGameSerialHashLinked = errors.New("the game account is linked to another user")
+	errGameNotLinked        = errors.New("no game account is linked")
+	errTooManyGameLinks     = errors.New("too many game accounts linked recently")
+)
+
+// newLinkCode returns a random link code without ambiguous characters
+func newLinkCode() (string, error) {
+	b := make([]byte, linkCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	for i, v := range b {
+		b[i] = linkCodeAlphabet[int(v)%len(linkCodeAlphabet)]
+	}
+
+	return string(b), nil
+}
+
+// normalizeLinkCode the way a player may have typed it
+func normalizeLinkCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
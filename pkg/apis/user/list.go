@@ -0,0 +1,28 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/51st-state/api/pkg/problems"
+)
+
+// ListFilter of a user listing
+type ListFilter struct {
+	// Query matched against the wcf username and email, empty matches everyone
+	Query string
+	// Banned filters for banned or not banned users, nil matches everyone
+	Banned *bool
+}
+
+// ListOptions of a repository listing
+type ListOptions struct {
+	// WCFUserIDs to list, nil matches every user
+	WCFUserIDs []WCFUserID
+	// Banned filters for users with an active game ban or the stored banned flag
+	Banned *bool
+}
+
+// maxSearchMatches of wcf users a query is resolved to
+const maxSearchMatches = 1000
+
+var errTooManyMatches = problems.New("too many matches", "the query matches more than 1000 users, please refine it", http.StatusBadRequest)
@@ -2,10 +2,13 @@ package user
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/pagination"
 	"github.com/51st-state/api/pkg/rbac"
 
 	"github.com/pkg/errors"
@@ -21,11 +24,22 @@ type Manager interface {
 	GetByWCFUserID(ctx context.Context, wcfUserID WCFUserID) (Complete, error)
 	Create(ctx context.Context, inc Incomplete) (Complete, error)
 	Delete(ctx context.Context, id Identifier) error
+	Restore(ctx context.Context, id Identifier) (Complete, error)
+	Purge(ctx context.Context) error
 	GetWCFInfo(ctx context.Context, name string) (*WCFUserInfo, error)
 	Update(ctx context.Context, c Complete) error
 	CheckPassword(ctx context.Context, id Identifier, incPw IncompletePassword) error
 	GetRoles(ctx context.Context, id Identifier) (rbac.AccountRoles, error)
 	SetRoles(ctx context.Context, id Identifier, roles rbac.AccountRoles) error
+	List(ctx context.Context, f *ListFilter, page pagination.Page) ([]Complete, error)
+	GetBans(ctx context.Context, id Identifier) ([]*Ban, error)
+	GetActiveBan(ctx context.Context, id Identifier, scope BanScope) (*Ban, error)
+	Ban(ctx context.Context, id Identifier, b *Ban) (*Ban, error)
+	Unban(ctx context.Context, id Identifier, banID string) error
+	RequestLinkCode(ctx context.Context, hash string) (*LinkCode, error)
+	RedeemLinkCode(ctx context.Context, id Identifier, code string) (Complete, error)
+	UnlinkGame(ctx context.Context, id Identifier) error
+	GetGameLinks(ctx context.Context, id Identifier) ([]*GameLink, error)
 }
 
 type manager struct {
@@ -67,7 +81,7 @@ func (m *manager) Get(ctx context.Context, id Identifier) (Complete, error) {
 	c.Data().WCFUsername = wcfInfo.Username
 	c.Data().WCFEmail = wcfInfo.Email
 
-	return c, nil
+	return c, m.applyBans(ctx, c)
 }
 
 // GetByGameSerialHash returns a user filtered by its unique game serial hash
@@ -89,7 +103,7 @@ func (m *manager) GetByGameSerialHash(ctx context.Context, hash string) (Complet
 	c.Data().WCFUsername = wcfInfo.Username
 	c.Data().WCFEmail = wcfInfo.Email
 
-	return c, nil
+	return c, m.applyBans(ctx, c)
 }
 
 // GetByWCFUserID returns an user filtered by its wcf user id
@@ -111,7 +125,56 @@ func (m *manager) GetByWCFUserID(ctx context.Context, wcfUserID WCFUserID) (Comp
 	c.Data().WCFUsername = wcfInfo.Username
 	c.Data().WCFEmail = wcfInfo.Email
 
-	return c, nil
+	return c, m.applyBans(ctx, c)
+}
+
+// List a page of the users matching a filter ordered by their uuid.
+// The wcf info of a page is fetched in one batch.
+func (m *manager) List(ctx context.Context, f *ListFilter, page pagination.Page) ([]Complete, error) {
+	opts := &ListOptions{
+		Banned: f.Banned,
+	}
+
+	if f.Query != "" {
+		ids, err := m.wcfRepository.SearchUserIDs(ctx, f.Query, maxSearchMatches+1)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ids) > maxSearchMatches {
+			return nil, errTooManyMatches
+		}
+
+		opts.WCFUserIDs = ids
+	}
+
+	users, err := m.repository.List(ctx, opts, pagination.New(page.Cursor, page.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) == 0 {
+		return users, nil
+	}
+
+	ids := make([]WCFUserID, 0, len(users))
+	for _, v := range users {
+		ids = append(ids, v.Data().WCFUserID)
+	}
+
+	infos, err := m.wcfRepository.GetInfoMany(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range users {
+		if info, ok := infos[v.Data().WCFUserID]; ok {
+			v.Data().WCFUsername = info.Username
+			v.Data().WCFEmail = info.Email
+		}
+	}
+
+	return users, nil
 }
 
 var errInvalidWCFUserID = errors.New("invalid woltlab community framework user id")
@@ -127,6 +190,12 @@ func (m *manager) Create(ctx context.Context, inc Incomplete) (Complete, error)
 		return nil, err
 	}
 
+	if _, err := m.repository.GetDeletionByWCFUserID(ctx, inc.Data().WCFUserID); err == nil {
+		return nil, ErrDeleted
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+
 	c, err := m.repository.Create(ctx, inc)
 	if err != nil {
 		return nil, err
@@ -156,24 +225,86 @@ func (m *manager) checkEmail(ctx context.Context, email string) error {
 	return nil
 }
 
-// Delete an user object
+// Delete an user object softly. The user can be restored until it is
+// purged after the deletion retention.
 func (m *manager) Delete(ctx context.Context, id Identifier) error {
 	if id.UUID() == "" {
 		return errInvalidUUID
 	}
 
-	if err := m.repository.Delete(ctx, id); err != nil {
+	now := time.Now()
+	if err := m.repository.SoftDelete(ctx, id, now); err != nil {
 		return err
 	}
 
-	return m.event.Produce(ctx, DeletedEventID, &DeletedEvent{
+	d, err := m.repository.GetDeletion(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return m.event.Produce(ctx, SoftDeletedEventID, &SoftDeletedEvent{
 		&event.PayloadMeta{
 			Version: "1",
 		},
-		id,
+		d,
 	})
 }
 
+// Restore a soft deleted user object
+func (m *manager) Restore(ctx context.Context, id Identifier) (Complete, error) {
+	if id.UUID() == "" {
+		return nil, errInvalidUUID
+	}
+
+	if err := m.repository.Restore(ctx, id); err == sql.ErrNoRows {
+		return nil, errNotDeleted
+	} else if err != nil {
+		return nil, err
+	}
+
+	c, err := m.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, m.event.Produce(ctx, RestoredEventID, &RestoredEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		c,
+	})
+}
+
+// Purge the users deleted longer than the deletion retention ago including
+// their bans and game links. The data of the users in other services is
+// erased by the services themselves on the deleted event. The event is
+// produced before the user is deleted, so it is produced again by the next
+// purge if the deletion fails. Erasing the data of a user is idempotent.
+func (m *manager) Purge(ctx context.Context) error {
+	deletions, err := m.repository.GetDeletions(ctx, time.Now().Add(-deletionRetention))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range deletions {
+		id := newIdentifier(v.UserUUID)
+		if err := m.event.Produce(ctx, DeletedEventID, &DeletedEvent{
+			&event.PayloadMeta{
+				Version: "1",
+			},
+			id,
+		}); err != nil {
+			return err
+		}
+
+		if err := m.repository.Delete(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetWCFInfo returns the info of a wcf user object
 func (m *manager) GetWCFInfo(ctx context.Context, name string) (*WCFUserInfo, error) {
 	if emailRegexp.MatchString(name) {
@@ -217,22 +348,22 @@ func (m *manager) CheckPassword(ctx context.Context, id Identifier, incPw Incomp
 		return errInvalidUUID
 	}
 
-	compl, err := m.Get(ctx, id)
+	compl, err := m.repository.Get(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	wcfInfo, err := m.wcfRepository.GetInfo(ctx, compl.Data().WCFUserID)
+	pw, err := m.wcfRepository.GetPassword(ctx, compl.Data().WCFUserID)
 	if err != nil {
 		return err
 	}
 
-	pwFirstHash, err := getFirstPasswordHash(wcfInfo.Password.Hash(), []byte(incPw.Password()))
+	pwFirstHash, err := getFirstPasswordHash(pw.Hash(), []byte(incPw.Password()))
 	if err != nil {
 		return err
 	}
 
-	return bcrypt.CompareHashAndPassword(wcfInfo.Password.Hash(), pwFirstHash)
+	return bcrypt.CompareHashAndPassword(pw.Hash(), pwFirstHash)
 }
 
 const (
@@ -288,3 +419,333 @@ func (m *manager) SetRoles(ctx context.Context, id Identifier, roles rbac.Accoun
 		id.UUID(),
 	)), roles)
 }
+
+// applyBans marks a user as banned if any of its bans is active in the game.
+// The banned flag is sent to the game server, so bans of other scopes are
+// left out. The stored banned flag is kept for users banned before bans were recorded.
+func (m *manager) applyBans(ctx context.Context, c Complete) error {
+	if c.Data().Banned {
+		return nil
+	}
+
+	bans, err := m.repository.GetBans(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, v := range bans {
+		if v.ActiveAt(now) && v.Covers(BanScopeGame) {
+			c.Data().Banned = true
+			break
+		}
+	}
+
+	return nil
+}
+
+// GetBans returns the ban history of a user
+func (m *manager) GetBans(ctx context.Context, id Identifier) ([]*Ban, error) {
+	if id.UUID() == "" {
+		return nil, errInvalidUUID
+	}
+
+	return m.repository.GetBans(ctx, id)
+}
+
+// GetActiveBan returns the ban in effect for a user in a scope.
+// If the user is not banned, no ban and no error is returned.
+// Of multiple active bans the one running the longest is returned.
+func (m *manager) GetActiveBan(ctx context.Context, id Identifier, scope BanScope) (*Ban, error) {
+	if id.UUID() == "" {
+		return nil, errInvalidUUID
+	}
+
+	bans, err := m.repository.GetBans(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var active *Ban
+	now := time.Now()
+	for _, v := range bans {
+		if !v.ActiveAt(now) || !v.Covers(scope) {
+			continue
+		}
+
+		if active == nil || v.EndsAt == nil || (active.EndsAt != nil && v.EndsAt.After(*active.EndsAt)) {
+			active = v
+		}
+
+		if active.EndsAt == nil {
+			break
+		}
+	}
+
+	return active, nil
+}
+
+var (
+	errBanReasonMissing = errors.New("the reason of a ban is missing")
+	errBanScopeMissing  = errors.New("a ban needs at least one scope")
+	errInvalidBanScope  = errors.New("invalid ban scope given")
+	errInvalidBanEnd    = errors.New("a ban has to end after it starts")
+	errBanRevoked       = errors.New("the ban is already revoked")
+)
+
+func validBanScope(scope BanScope) bool {
+	for _, v := range banScopes {
+		if v == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ban a user. The issuer of the ban is the actor of the context.
+// A ban without a start begins immediately, a ban without an end is permanent.
+func (m *manager) Ban(ctx context.Context, id Identifier, b *Ban) (*Ban, error) {
+	if id.UUID() == "" {
+		return nil, errInvalidUUID
+	}
+
+	if b.Reason == "" {
+		return nil, errBanReasonMissing
+	}
+
+	if len(b.Scopes) == 0 {
+		return nil, errBanScopeMissing
+	}
+
+	for _, v := range b.Scopes {
+		if !validBanScope(v) {
+			return nil, errInvalidBanScope
+		}
+	}
+
+	if _, err := m.repository.Get(ctx, id); err != nil {
+		return nil, err
+	}
+
+	ban := &Ban{
+		UserUUID: id.UUID(),
+		Reason:   b.Reason,
+		Issuer:   rbac.ActorFromContext(ctx),
+		Scopes:   b.Scopes,
+		StartsAt: b.StartsAt,
+		EndsAt:   b.EndsAt,
+	}
+
+	if ban.StartsAt.IsZero() {
+		ban.StartsAt = time.Now()
+	}
+
+	if ban.EndsAt != nil && !ban.EndsAt.After(ban.StartsAt) {
+		return nil, errInvalidBanEnd
+	}
+
+	ban, err := m.repository.AddBan(ctx, ban)
+	if err != nil {
+		return nil, err
+	}
+
+	return ban, m.event.Produce(ctx, BannedEventID, &BannedEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		ban,
+	})
+}
+
+// Unban a user by revoking one of its bans.
+// The actor of the context is recorded as revoker.
+func (m *manager) Unban(ctx context.Context, id Identifier, banID string) error {
+	if id.UUID() == "" {
+		return errInvalidUUID
+	}
+
+	ban, err := m.repository.GetBan(ctx, banID)
+	if err != nil {
+		return err
+	}
+
+	if ban.UserUUID != id.UUID() {
+		return sql.ErrNoRows
+	}
+
+	if ban.RevokedAt != nil {
+		return errBanRevoked
+	}
+
+	now := time.Now()
+	ban.RevokedAt = &now
+	ban.RevokedBy = rbac.ActorFromContext(ctx)
+
+	if err := m.repository.RevokeBan(ctx, ban.ID, ban.RevokedBy, now); err != nil {
+		return err
+	}
+
+	return m.event.Produce(ctx, UnbannedEventID, &UnbannedEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		ban,
+	})
+}
+
+// RequestLinkCode for a game account not linked to any user yet.
+// The code is redeemed by the player within the link code ttl.
+func (m *manager) RequestLinkCode(ctx context.Context, hash string) (*LinkCode, error) {
+	if hash == "" {
+		return nil, errInvalidGameSerialHash
+	}
+
+	if _, err := m.repository.GetByGameSerialHash(ctx, hash); err == nil {
+		return nil, errGameSerialHashLinked
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	code, err := newLinkCode()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &LinkCode{
+		Code:           code,
+		GameSerialHash: hash,
+		ExpiresAt:      time.Now().Add(linkCodeTTL),
+	}
+
+	return c, m.repository.AddLinkCode(ctx, c)
+}
+
+// RedeemLinkCode links the game account of a link code to a user.
+// A user can link at most maxGameLinks game accounts within the game link period.
+func (m *manager) RedeemLinkCode(ctx context.Context, id Identifier, code string) (Complete, error) {
+	if id.UUID() == "" {
+		return nil, errInvalidUUID
+	}
+
+	c, err := m.repository.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Data().GameSerialHash != "" {
+		return nil, errGameAlreadyLinked
+	}
+
+	now := time.Now()
+	links, err := m.repository.GetGameLinks(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	recent := 0
+	for _, v := range links {
+		if v.LinkedAt.After(now.Add(-gameLinkPeriod)) {
+			recent++
+		}
+	}
+
+	if recent >= maxGameLinks {
+		return nil, errTooManyGameLinks
+	}
+
+	linkCode, err := m.repository.TakeLinkCode(ctx, normalizeLinkCode(code))
+	if err == sql.ErrNoRows {
+		return nil, errInvalidLinkCode
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !now.Before(linkCode.ExpiresAt) {
+		return nil, errInvalidLinkCode
+	}
+
+	// the unique game serial hash of the repository rejects accounts linked in the meantime
+	c.Data().GameSerialHash = linkCode.GameSerialHash
+	if err := m.repository.Update(ctx, c); err == ErrDuplicateGameSerialHash {
+		return nil, errGameSerialHashLinked
+	} else if err != nil {
+		return nil, err
+	}
+
+	link := &GameLink{
+		UserUUID:       id.UUID(),
+		GameSerialHash: linkCode.GameSerialHash,
+		LinkedAt:       now,
+	}
+
+	if err := m.repository.AddGameLink(ctx, link); err != nil {
+		return nil, err
+	}
+
+	if err := m.event.Produce(ctx, GameLinkedEventID, &GameLinkedEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		link,
+	}); err != nil {
+		return nil, err
+	}
+
+	return m.Get(ctx, id)
+}
+
+// UnlinkGame removes the linked game account of a user.
+// Unlinking does not reset the link limit of the user.
+func (m *manager) UnlinkGame(ctx context.Context, id Identifier) error {
+	if id.UUID() == "" {
+		return errInvalidUUID
+	}
+
+	c, err := m.repository.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	hash := c.Data().GameSerialHash
+	if hash == "" {
+		return errGameNotLinked
+	}
+
+	links, err := m.repository.GetGameLinks(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// game accounts set before the link flow existed have no link history
+	link := &GameLink{
+		UserUUID:       id.UUID(),
+		GameSerialHash: hash,
+	}
+	for _, v := range links {
+		if v.GameSerialHash == hash {
+			link = v
+		}
+	}
+
+	c.Data().GameSerialHash = ""
+	if err := m.repository.Update(ctx, c); err != nil {
+		return err
+	}
+
+	return m.event.Produce(ctx, GameUnlinkedEventID, &GameUnlinkedEvent{
+		&event.PayloadMeta{
+			Version: "1",
+		},
+		link,
+	})
+}
+
+// GetGameLinks returns the link history of a user ordered by the time the game accounts were linked
+func (m *manager) GetGameLinks(ctx context.Context, id Identifier) ([]*GameLink, error) {
+	if id.UUID() == "" {
+		return nil, errInvalidUUID
+	}
+
+	return m.repository.GetGameLinks(ctx, id)
+}
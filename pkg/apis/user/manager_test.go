@@ -2,13 +2,19 @@ package user_test
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/pagination"
 	"github.com/51st-state/api/pkg/rbac"
 
 	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/apis/user/memory"
 	"github.com/51st-state/api/pkg/apis/user/mocks"
 	pubsubMocks "github.com/51st-state/api/pkg/pubsub/mocks"
 	rbacMocks "github.com/51st-state/api/pkg/rbac/mocks"
@@ -31,13 +37,8 @@ func TestManagerGet(t *testing.T) {
 
 	m := user.NewManager(repo, wcfRepo, event.NewProducer(&pubsubMocks.FakeProducer{}), rbControl)
 
-	fComplPassword := &mocks.FakeCompletePassword{}
-	fComplPassword.HashReturns([]byte("testHash"))
-	wcfRepo.GetInfoReturns(&user.WCFUserInfo{}, nil)
-
 	wcfRepo.GetInfoReturns(&user.WCFUserInfo{
-		Email:    "test@test.com",
-		Password: fComplPassword,
+		Email: "test@test.com",
 	}, nil)
 	repo.GetReturns(nil, errors.New("fake error"))
 
@@ -144,6 +145,15 @@ func TestManagerCreate(t *testing.T) {
 	}
 
 	wcfRepo.GetInfoReturns(&user.WCFUserInfo{}, nil)
+	repo.GetDeletionByWCFUserIDReturns(&user.Deletion{UserUUID: "test", WCFUserID: 1}, nil)
+	if _, err := m.Create(
+		context.Background(),
+		validIncomplete,
+	); err != user.ErrDeleted {
+		t.Fatal("the wcf user belongs to a deleted user")
+	}
+
+	repo.GetDeletionByWCFUserIDReturns(nil, sql.ErrNoRows)
 	repo.CreateReturns(nil, errors.New("fake error"))
 	if _, err := m.Create(
 		context.Background(),
@@ -178,7 +188,9 @@ func TestManagerDelete(t *testing.T) {
 	wcfRepo := &mocks.FakeWCFRepository{}
 	rbControl := &rbacMocks.FakeControl{}
 
-	m := user.NewManager(repo, wcfRepo, event.NewProducer(&pubsubMocks.FakeProducer{}), rbControl)
+	producer := &pubsubMocks.FakeProducer{}
+
+	m := user.NewManager(repo, wcfRepo, event.NewProducer(producer), rbControl)
 
 	id := &fakeIdentifier{""}
 
@@ -187,17 +199,135 @@ func TestManagerDelete(t *testing.T) {
 	}
 
 	id.UUIDStr = "test"
+	repo.GetDeletionReturns(&user.Deletion{UserUUID: "test", WCFUserID: 1}, nil)
 	if err := m.Delete(context.Background(), id); err != nil {
 		t.Fatal("given request is correct")
 	}
 
-	repo.DeleteReturns(errors.New("fake error"))
+	if repo.SoftDeleteCallCount() != 1 || repo.DeleteCallCount() != 0 {
+		t.Fatal("the user should only be deleted softly")
+	}
+
+	_, b := producer.ProduceArgsForCall(0)
+	e, err := event.Decode(b)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if e.Meta.ID != user.SoftDeletedEventID {
+		t.Fatal("a soft deleted event should be produced")
+	}
+
+	var deleted struct {
+		Data struct {
+			UUID string `json:"user_uuid"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(e.Payload, &deleted); err != nil || deleted.Data.UUID != "test" {
+		t.Fatal("the soft deleted event should contain the uuid of the user")
+	}
+
+	repo.SoftDeleteReturns(errors.New("fake error"))
 
 	if err := m.Delete(context.Background(), id); err == nil {
 		t.Fatal("the repository returns an error")
 	}
 }
 
+func TestManagerRestoreAndPurge(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRepository()
+	wcfRepo := &mocks.FakeWCFRepository{}
+	wcfRepo.GetInfoReturns(&user.WCFUserInfo{}, nil)
+	producer := &pubsubMocks.FakeProducer{}
+
+	m := user.NewManager(repo, wcfRepo, event.NewProducer(producer), &rbacMocks.FakeControl{})
+
+	c, err := m.Create(ctx, user.NewIncomplete(1, "", "", "hash", false))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := m.Restore(ctx, c); err == nil {
+		t.Fatal("an active user can not be restored")
+	}
+
+	if err := m.Delete(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := m.Get(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("a deleted user should not be found")
+	}
+
+	if _, err := m.Create(ctx, user.NewIncomplete(1, "", "", "", false)); err != user.ErrDeleted {
+		t.Fatal("a deleted user should not be created again")
+	}
+
+	if err := m.Purge(ctx); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := repo.GetDeletion(ctx, c); err != nil {
+		t.Fatal("a recently deleted user should not be purged")
+	}
+
+	restored, err := m.Restore(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if restored.UUID() != c.UUID() || restored.Data().GameSerialHash != "hash" {
+		t.Fatal("the user should be restored with its uuid and game account")
+	}
+
+	_, b := producer.ProduceArgsForCall(producer.ProduceCallCount() - 1)
+	e, err := event.Decode(b)
+	if err != nil || e.Meta.ID != user.RestoredEventID {
+		t.Fatal("a restored event should be produced")
+	}
+
+	if err := repo.SoftDelete(ctx, c, time.Now().Add(-31*24*time.Hour)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	producer.ProduceReturnsOnCall(producer.ProduceCallCount(), errors.New("fake error"))
+	if err := m.Purge(ctx); err == nil {
+		t.Fatal("the producer returns an error")
+	}
+
+	if _, err := repo.GetDeletion(ctx, c); err != nil {
+		t.Fatal("the user should be kept until the deleted event is produced")
+	}
+
+	if err := m.Purge(ctx); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := repo.GetDeletion(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("the user should be purged after the deletion retention")
+	}
+
+	_, b = producer.ProduceArgsForCall(producer.ProduceCallCount() - 1)
+	e, err = event.Decode(b)
+	if err != nil || e.Meta.ID != user.DeletedEventID {
+		t.Fatal("a deleted event should be produced once the user is purged")
+	}
+
+	var deleted struct {
+		Data struct {
+			UUID string `json:"uuid"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(e.Payload, &deleted); err != nil || deleted.Data.UUID != c.UUID() {
+		t.Fatal("the deleted event should contain the uuid of the user")
+	}
+
+	if _, err := m.Create(ctx, user.NewIncomplete(1, "", "", "", false)); err != nil {
+		t.Fatal("the wcf user of a purged user can be used again")
+	}
+}
+
 func TestManagerUpdate(t *testing.T) {
 	repo := &mocks.FakeRepository{}
 	wcfRepo := &mocks.FakeWCFRepository{}
@@ -286,7 +416,7 @@ func TestManagerCheckPassword(t *testing.T) {
 		id,
 		user.NewIncomplete(1, "", "", "testSerialHash", false),
 	}, nil)
-	wcfRepo.GetInfoReturns(nil, errors.New("fake error"))
+	wcfRepo.GetPasswordReturns(nil, errors.New("fake error"))
 
 	if err := m.CheckPassword(context.Background(), id, &mocks.FakeIncompletePassword{}); err == nil {
 		t.Fatal("the wcf repository returns an error")
@@ -295,10 +425,7 @@ func TestManagerCheckPassword(t *testing.T) {
 	fakePw := &mocks.FakeCompletePassword{}
 	fakePw.HashReturns([]byte(""))
 
-	wcfRepo.GetInfoReturns(&user.WCFUserInfo{
-		Email:    "test@example.com",
-		Password: fakePw,
-	}, nil)
+	wcfRepo.GetPasswordReturns(fakePw, nil)
 
 	if err := m.CheckPassword(context.Background(), id, &mocks.FakeIncompletePassword{}); err == nil {
 		t.Fatal("the first password hash generation returned an error")
@@ -315,9 +442,14 @@ func TestManagerCheckPassword(t *testing.T) {
 
 	fakeInc.PasswordReturns("roo")
 
+	calls := wcfRepo.GetPasswordCallCount()
 	if err := m.CheckPassword(context.Background(), id, fakeInc); err == nil {
 		t.Fatal("the password is definetely invalid")
 	}
+
+	if wcfRepo.GetPasswordCallCount() != calls+1 || wcfRepo.GetInfoCallCount() != 0 {
+		t.Fatal("only the password should be fetched once")
+	}
 }
 
 func TestManagerGetRoles(t *testing.T) {
@@ -367,3 +499,379 @@ func TestManagerSetRoles(t *testing.T) {
 		t.Fatal("there should be no error")
 	}
 }
+
+func TestManagerBans(t *testing.T) {
+	ctx := rbac.ActorToContext(context.Background(), "user/moderator")
+	wcfRepo := &mocks.FakeWCFRepository{}
+	wcfRepo.GetInfoReturns(&user.WCFUserInfo{}, nil)
+	producer := &pubsubMocks.FakeProducer{}
+
+	m := user.NewManager(memory.NewRepository(), wcfRepo, event.NewProducer(producer), &rbacMocks.FakeControl{})
+
+	c, err := m.Create(ctx, user.NewIncomplete(1, "", "", "testSerialHash", false))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := m.Ban(ctx, c, &user.Ban{Scopes: []user.BanScope{user.BanScopeGame}}); err == nil {
+		t.Fatal("a ban without a reason should be invalid")
+	}
+
+	if _, err := m.Ban(ctx, c, &user.Ban{Reason: "cheating"}); err == nil {
+		t.Fatal("a ban without a scope should be invalid")
+	}
+
+	if _, err := m.Ban(ctx, c, &user.Ban{Reason: "cheating", Scopes: []user.BanScope{"unknown"}}); err == nil {
+		t.Fatal("a ban with an unknown scope should be invalid")
+	}
+
+	past := time.Now().Add(-time.Hour)
+	if _, err := m.Ban(ctx, c, &user.Ban{Reason: "cheating", Scopes: []user.BanScope{user.BanScopeGame}, EndsAt: &past}); err == nil {
+		t.Fatal("a ban ending before its start should be invalid")
+	}
+
+	if _, err := m.Ban(ctx, &fakeIdentifier{"unknown"}, &user.Ban{Reason: "cheating", Scopes: []user.BanScope{user.BanScopeGame}}); err != sql.ErrNoRows {
+		t.Fatal("an unknown user should not be banned")
+	}
+
+	ban, err := m.GetActiveBan(ctx, c, user.BanScopeGame)
+	if err != nil || ban != nil {
+		t.Fatal("the user should not be banned yet")
+	}
+
+	forum, err := m.Ban(ctx, c, &user.Ban{Reason: "spam", Scopes: []user.BanScope{user.BanScopeForum}})
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	got, err := m.Get(ctx, c)
+	if err != nil || got.Data().Banned {
+		t.Fatal("a forum ban should not mark the user as banned in the game")
+	}
+
+	if err := m.Unban(ctx, c, forum.ID); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	events := producer.ProduceCallCount()
+	future := time.Now().Add(time.Hour)
+	temporary, err := m.Ban(ctx, c, &user.Ban{Reason: "insults", Scopes: []user.BanScope{user.BanScopeGame}, EndsAt: &future})
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if temporary.ID == "" || temporary.UserUUID != c.UUID() || temporary.Issuer != "user/moderator" || temporary.StartsAt.IsZero() {
+		t.Fatal("the ban should be issued by the actor and start immediately")
+	}
+
+	if producer.ProduceCallCount() != events+1 {
+		t.Fatal("a banned event should be produced")
+	}
+
+	permanent, err := m.Ban(ctx, c, &user.Ban{Reason: "cheating", Scopes: []user.BanScope{user.BanScopeGame, user.BanScopeAPI}})
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	ban, err = m.GetActiveBan(ctx, c, user.BanScopeGame)
+	if err != nil || ban == nil || ban.ID != permanent.ID {
+		t.Fatal("the permanent ban should take precedence")
+	}
+
+	ban, err = m.GetActiveBan(ctx, c, user.BanScopeForum)
+	if err != nil || ban != nil {
+		t.Fatal("the user should not be banned from the forum")
+	}
+
+	got, err = m.Get(ctx, c)
+	if err != nil || !got.Data().Banned {
+		t.Fatal("the user should be marked as banned")
+	}
+
+	if err := m.Unban(ctx, &fakeIdentifier{"other"}, permanent.ID); err != sql.ErrNoRows {
+		t.Fatal("a ban of another user should not be revoked")
+	}
+
+	events = producer.ProduceCallCount()
+	if err := m.Unban(rbac.ActorToContext(ctx, "user/admin"), c, permanent.ID); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if producer.ProduceCallCount() != events+1 {
+		t.Fatal("an unbanned event should be produced")
+	}
+
+	if err := m.Unban(ctx, c, permanent.ID); err == nil {
+		t.Fatal("a revoked ban should not be revoked again")
+	}
+
+	ban, err = m.GetActiveBan(ctx, c, user.BanScopeGame)
+	if err != nil || ban == nil || ban.ID != temporary.ID {
+		t.Fatal("the temporary ban should still be active")
+	}
+
+	ban, err = m.GetActiveBan(ctx, c, user.BanScopeAPI)
+	if err != nil || ban != nil {
+		t.Fatal("the revoked ban should not be active")
+	}
+
+	bans, err := m.GetBans(ctx, c)
+	if err != nil || len(bans) != 3 {
+		t.Fatal("the revoked ban should be kept in the history")
+	}
+
+	for _, v := range bans {
+		if v.ID == permanent.ID && (v.RevokedAt == nil || v.RevokedBy != "user/admin") {
+			t.Fatal("the revoker should be recorded")
+		}
+	}
+
+	if err := m.Unban(ctx, c, temporary.ID); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	got, err = m.Get(ctx, c)
+	if err != nil || got.Data().Banned {
+		t.Fatal("the user should not be marked as banned anymore")
+	}
+}
+
+func TestManagerList(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRepository()
+	wcfRepo := &mocks.FakeWCFRepository{}
+	wcfRepo.GetInfoManyStub = func(ctx context.Context, ids []user.WCFUserID) (map[user.WCFUserID]*user.WCFUserInfo, error) {
+		infos := make(map[user.WCFUserID]*user.WCFUserInfo)
+		for _, v := range ids {
+			infos[v] = &user.WCFUserInfo{UserID: v, Username: fmt.Sprintf("user%d", v)}
+		}
+
+		return infos, nil
+	}
+
+	m := user.NewManager(repo, wcfRepo, event.NewProducer(&pubsubMocks.FakeProducer{}), &rbacMocks.FakeControl{})
+
+	for i := user.WCFUserID(1); i <= 5; i++ {
+		if _, err := repo.Create(ctx, user.NewIncomplete(i, "", "", fmt.Sprintf("hash%d", i), i == 5)); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	seen := make(map[string]bool)
+	page := pagination.New("", 2)
+	for pages := 0; ; pages++ {
+		if pages == 3 {
+			t.Fatal("the listing should end after three pages")
+		}
+
+		users, err := m.List(ctx, &user.ListFilter{}, page)
+		if err != nil {
+			t.Fatal("there should be no error")
+		}
+
+		for _, v := range users {
+			if seen[v.UUID()] {
+				t.Fatal("a user should only be listed once")
+			}
+			seen[v.UUID()] = true
+
+			if v.Data().WCFUsername != fmt.Sprintf("user%d", v.Data().WCFUserID) {
+				t.Fatal("the listed users should be enriched with their wcf info")
+			}
+		}
+
+		if len(users) < 2 {
+			break
+		}
+		page.Cursor = users[len(users)-1].UUID()
+	}
+
+	if len(seen) != 5 {
+		t.Fatal("every user should be listed")
+	}
+
+	if wcfRepo.GetInfoManyCallCount() != 3 || wcfRepo.GetInfoCallCount() != 0 {
+		t.Fatal("the wcf info should be fetched once per page")
+	}
+
+	banned := true
+	users, err := m.List(ctx, &user.ListFilter{Banned: &banned}, pagination.Page{})
+	if err != nil || len(users) != 1 || users[0].Data().WCFUserID != 5 {
+		t.Fatal("only the banned user should be listed")
+	}
+
+	wcfRepo.SearchUserIDsReturns([]user.WCFUserID{2, 3}, nil)
+	users, err = m.List(ctx, &user.ListFilter{Query: "user"}, pagination.Page{})
+	if err != nil || len(users) != 2 {
+		t.Fatal("only the users matching the query should be listed")
+	}
+
+	if _, query, _ := wcfRepo.SearchUserIDsArgsForCall(0); query != "user" {
+		t.Fatal("the query should be passed to the wcf search")
+	}
+
+	wcfRepo.SearchUserIDsReturns([]user.WCFUserID{}, nil)
+	users, err = m.List(ctx, &user.ListFilter{Query: "nobody"}, pagination.Page{})
+	if err != nil || len(users) != 0 {
+		t.Fatal("no user should be listed if the query matches no one")
+	}
+
+	wcfRepo.SearchUserIDsReturns(make([]user.WCFUserID, 1001), nil)
+	if _, err := m.List(ctx, &user.ListFilter{Query: "u"}, pagination.Page{}); err == nil {
+		t.Fatal("a query matching too many users should not be truncated")
+	}
+
+	wcfRepo.SearchUserIDsReturns(nil, errors.New("fake error"))
+	if _, err := m.List(ctx, &user.ListFilter{Query: "user"}, pagination.Page{}); err == nil {
+		t.Fatal("the wcf search returns an error")
+	}
+}
+
+func TestManagerGameLinks(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRepository()
+	wcfRepo := &mocks.FakeWCFRepository{}
+	wcfRepo.GetInfoReturns(&user.WCFUserInfo{}, nil)
+	producer := &pubsubMocks.FakeProducer{}
+
+	m := user.NewManager(repo, wcfRepo, event.NewProducer(producer), &rbacMocks.FakeControl{})
+
+	c, err := m.Create(ctx, user.NewIncomplete(1, "", "", "", false))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	other, err := m.Create(ctx, user.NewIncomplete(2, "", "", "otherHash", false))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := m.RequestLinkCode(ctx, ""); err == nil {
+		t.Fatal("a link code needs a game serial hash")
+	}
+
+	if _, err := m.RequestLinkCode(ctx, "otherHash"); err == nil {
+		t.Fatal("a linked game account should not get a link code")
+	}
+
+	if err := m.UnlinkGame(ctx, c); err == nil {
+		t.Fatal("there is no linked game account")
+	}
+
+	code, err := m.RequestLinkCode(ctx, "hash")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(code.Code) != 8 || !code.ExpiresAt.After(time.Now()) {
+		t.Fatal("the link code should be valid for a while")
+	}
+
+	if _, err := m.RedeemLinkCode(ctx, c, "unknown"); err == nil {
+		t.Fatal("an unknown link code should be invalid")
+	}
+
+	events := producer.ProduceCallCount()
+	linked, err := m.RedeemLinkCode(ctx, c, " "+strings.ToLower(code.Code)+" ")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if linked.Data().GameSerialHash != "hash" {
+		t.Fatal("the game account should be linked")
+	}
+
+	if producer.ProduceCallCount() != events+1 {
+		t.Fatal("a game linked event should be produced")
+	}
+
+	if _, err := m.RedeemLinkCode(ctx, other, code.Code); err == nil {
+		t.Fatal("a link code should only be redeemed once")
+	}
+
+	code, err = m.RequestLinkCode(ctx, "secondHash")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := m.RedeemLinkCode(ctx, c, code.Code); err == nil {
+		t.Fatal("the user already has a linked game account")
+	}
+
+	if err := m.UnlinkGame(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	stored, err := m.Get(ctx, c)
+	if err != nil || stored.Data().GameSerialHash != "" {
+		t.Fatal("the game account should be unlinked")
+	}
+
+	if err := repo.AddLinkCode(ctx, &user.LinkCode{
+		Code:           "EXPIRED2",
+		GameSerialHash: "expiredHash",
+		ExpiresAt:      time.Now().Add(-time.Minute),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := m.RedeemLinkCode(ctx, c, "EXPIRED2"); err == nil {
+		t.Fatal("an expired link code should be invalid")
+	}
+
+	code, err = m.RequestLinkCode(ctx, "takenHash")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	other.Data().GameSerialHash = "takenHash"
+	if err := repo.Update(ctx, other); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := m.RedeemLinkCode(ctx, c, code.Code); err == nil {
+		t.Fatal("the game account has been linked to another user in the meantime")
+	}
+
+	stored, err = m.Get(ctx, c)
+	if err != nil || stored.Data().GameSerialHash != "" {
+		t.Fatal("the game account should not be linked")
+	}
+
+	for i := 0; i < 2; i++ {
+		code, err := m.RequestLinkCode(ctx, fmt.Sprintf("relinkHash%d", i))
+		if err != nil {
+			t.Fatal("there should be no error")
+		}
+
+		if _, err := m.RedeemLinkCode(ctx, c, code.Code); err != nil {
+			t.Fatal("there should be no error")
+		}
+
+		if err := m.UnlinkGame(ctx, c); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	code, err = m.RequestLinkCode(ctx, "relinkHash2")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := m.RedeemLinkCode(ctx, c, code.Code); err == nil {
+		t.Fatal("the user linked too many game accounts recently")
+	}
+
+	links, err := m.GetGameLinks(ctx, c)
+	if err != nil || len(links) != 3 {
+		t.Fatal("the game links should be kept as history")
+	}
+
+	if links[0].GameSerialHash != "hash" || links[2].GameSerialHash != "relinkHash1" {
+		t.Fatal("the game links should be ordered by the time they were linked")
+	}
+
+	if _, err := m.GetGameLinks(ctx, &fakeIdentifier{""}); err == nil {
+		t.Fatal("the uuid is invalid")
+	}
+}
@@ -0,0 +1,493 @@
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/pagination"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+type identifier struct {
+	uuid string
+}
+
+func (i *identifier) UUID() string {
+	return i.uuid
+}
+
+type complete struct {
+	user.Identifier
+	user.Incomplete
+}
+
+// MarshalJSON information of a user
+func (c *complete) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		UUID           string         `json:"uuid"`
+		WCFUserID      user.WCFUserID `json:"wcf_user_id"`
+		WCFUsername    string         `json:"wcf_username"`
+		WCFEmail       string         `json:"wcf_email"`
+		GameSerialHash string         `json:"game_serial_hash"`
+		Banned         bool           `json:"banned"`
+	}{
+		c.UUID(),
+		c.Data().WCFUserID,
+		c.Data().WCFUsername,
+		c.Data().WCFEmail,
+		c.Data().GameSerialHash,
+		c.Data().Banned,
+	})
+}
+
+var (
+	errDuplicateWCFUserID      = errors.New("duplicate wcf user id")
+)
+
+type repository struct {
+	mutex     sync.RWMutex
+	users     map[string]user.Incomplete
+	deleted   map[string]time.Time
+	bans      map[string]*user.Ban
+	linkCodes map[string]*user.LinkCode
+	gameLinks []*user.GameLink
+}
+
+// NewRepository for the user service in memory
+func NewRepository() user.Repository {
+	return &repository{
+		users:     make(map[string]user.Incomplete),
+		deleted:   make(map[string]time.Time),
+		bans:      make(map[string]*user.Ban),
+		linkCodes: make(map[string]*user.LinkCode),
+		gameLinks: make([]*user.GameLink, 0),
+	}
+}
+
+// copyBan so that stored bans can not be modified by the caller
+func copyBan(b *user.Ban) *user.Ban {
+	c := *b
+	c.Scopes = append(make([]user.BanScope, 0), b.Scopes...)
+
+	if b.EndsAt != nil {
+		endsAt := *b.EndsAt
+		c.EndsAt = &endsAt
+	}
+
+	if b.RevokedAt != nil {
+		revokedAt := *b.RevokedAt
+		c.RevokedAt = &revokedAt
+	}
+
+	return &c
+}
+
+// stored copies the persisted fields of a user
+func stored(inc user.Incomplete) user.Incomplete {
+	return user.NewIncomplete(inc.Data().WCFUserID, "", "", inc.Data().GameSerialHash, inc.Data().Banned)
+}
+
+// active checks whether a user exists and is not soft deleted
+func (r *repository) active(uuid string) bool {
+	if _, ok := r.users[uuid]; !ok {
+		return false
+	}
+
+	_, deleted := r.deleted[uuid]
+	return !deleted
+}
+
+func (r *repository) get(uuid string) user.Complete {
+	return &complete{
+		&identifier{uuid},
+		stored(r.users[uuid]),
+	}
+}
+
+func (r *repository) Get(ctx context.Context, id user.Identifier) (user.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if !r.active(id.UUID()) {
+		return nil, sql.ErrNoRows
+	}
+
+	return r.get(id.UUID()), nil
+}
+
+func (r *repository) GetByWCFUserID(ctx context.Context, wcfUserID user.WCFUserID) (user.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for uuid, v := range r.users {
+		if v.Data().WCFUserID == wcfUserID && r.active(uuid) {
+			return r.get(uuid), nil
+		}
+	}
+
+	return nil, sql.ErrNoRows
+}
+
+func (r *repository) GetByGameSerialHash(ctx context.Context, hash string) (user.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for uuid, v := range r.users {
+		if v.Data().GameSerialHash == hash && r.active(uuid) {
+			return r.get(uuid), nil
+		}
+	}
+
+	return nil, sql.ErrNoRows
+}
+
+// checkUnique checks whether the unique fields of a user are not used by another user
+func (r *repository) checkUnique(uuid string, inc user.Incomplete) error {
+	for id, v := range r.users {
+		if id == uuid {
+			continue
+		}
+
+		if v.Data().WCFUserID == inc.Data().WCFUserID {
+			return errDuplicateWCFUserID
+		}
+
+		// users without a linked game account do not conflict
+		if inc.Data().GameSerialHash != "" && v.Data().GameSerialHash == inc.Data().GameSerialHash {
+			return user.ErrDuplicateGameSerialHash
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) Create(ctx context.Context, inc user.Incomplete) (user.Complete, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.checkUnique(rand.String(), inc); err != nil {
+		return nil, err
+	}
+
+	r.users[rand.String()] = stored(inc)
+
+	return &complete{
+		&identifier{rand.String()},
+		inc,
+	}, nil
+}
+
+func (r *repository) Update(ctx context.Context, c user.Complete) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.active(c.UUID()) {
+		return nil
+	}
+
+	if err := r.checkUnique(c.UUID(), c); err != nil {
+		return err
+	}
+
+	r.users[c.UUID()] = stored(c)
+
+	return nil
+}
+
+func (r *repository) Delete(ctx context.Context, id user.Identifier) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.users, id.UUID())
+	delete(r.deleted, id.UUID())
+
+	for banID, v := range r.bans {
+		if v.UserUUID == id.UUID() {
+			delete(r.bans, banID)
+		}
+	}
+
+	gameLinks := make([]*user.GameLink, 0)
+	for _, v := range r.gameLinks {
+		if v.UserUUID != id.UUID() {
+			gameLinks = append(gameLinks, v)
+		}
+	}
+	r.gameLinks = gameLinks
+
+	return nil
+}
+
+func (r *repository) SoftDelete(ctx context.Context, id user.Identifier, at time.Time) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if !r.active(id.UUID()) {
+		return sql.ErrNoRows
+	}
+
+	r.deleted[id.UUID()] = at
+
+	return nil
+}
+
+func (r *repository) Restore(ctx context.Context, id user.Identifier) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.deleted[id.UUID()]; !ok {
+		return sql.ErrNoRows
+	}
+
+	delete(r.deleted, id.UUID())
+
+	return nil
+}
+
+func (r *repository) deletion(uuid string) *user.Deletion {
+	return &user.Deletion{
+		UserUUID:  uuid,
+		WCFUserID: r.users[uuid].Data().WCFUserID,
+		DeletedAt: r.deleted[uuid],
+	}
+}
+
+func (r *repository) GetDeletion(ctx context.Context, id user.Identifier) (*user.Deletion, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if _, ok := r.deleted[id.UUID()]; !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return r.deletion(id.UUID()), nil
+}
+
+func (r *repository) GetDeletionByWCFUserID(ctx context.Context, wcfUserID user.WCFUserID) (*user.Deletion, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for uuid := range r.deleted {
+		if r.users[uuid].Data().WCFUserID == wcfUserID {
+			return r.deletion(uuid), nil
+		}
+	}
+
+	return nil, sql.ErrNoRows
+}
+
+func (r *repository) GetDeletions(ctx context.Context, before time.Time) ([]*user.Deletion, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	deletions := make([]*user.Deletion, 0)
+	for uuid, v := range r.deleted {
+		if v.Before(before) {
+			deletions = append(deletions, r.deletion(uuid))
+		}
+	}
+
+	sort.Slice(deletions, func(i, j int) bool {
+		return deletions[i].DeletedAt.Before(deletions[j].DeletedAt)
+	})
+
+	return deletions, nil
+}
+
+// banned checks whether a user has the banned flag or an active game ban
+func (r *repository) banned(uuid string, now time.Time) bool {
+	if r.users[uuid].Data().Banned {
+		return true
+	}
+
+	for _, v := range r.bans {
+		if v.UserUUID == uuid && v.ActiveAt(now) && v.Covers(user.BanScopeGame) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *repository) List(ctx context.Context, opts *user.ListOptions, page pagination.Page) ([]user.Complete, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	var wcfUserIDs map[user.WCFUserID]bool
+	if opts.WCFUserIDs != nil {
+		wcfUserIDs = make(map[user.WCFUserID]bool)
+		for _, v := range opts.WCFUserIDs {
+			wcfUserIDs[v] = true
+		}
+	}
+
+	uuids := make([]string, 0)
+	for uuid, v := range r.users {
+		if uuid <= page.Cursor || !r.active(uuid) {
+			continue
+		}
+
+		if wcfUserIDs != nil && !wcfUserIDs[v.Data().WCFUserID] {
+			continue
+		}
+
+		uuids = append(uuids, uuid)
+	}
+	sort.Strings(uuids)
+
+	now := time.Now()
+	users := make([]user.Complete, 0)
+	for _, uuid := range uuids {
+		if uint64(len(users)) >= page.Limit {
+			break
+		}
+
+		banned := r.banned(uuid, now)
+		if opts.Banned != nil && *opts.Banned != banned {
+			continue
+		}
+
+		c := r.get(uuid)
+		c.Data().Banned = banned
+		users = append(users, c)
+	}
+
+	return users, nil
+}
+
+func (r *repository) GetBan(ctx context.Context, banID string) (*user.Ban, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	b, ok := r.bans[banID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	return copyBan(b), nil
+}
+
+func (r *repository) GetBans(ctx context.Context, id user.Identifier) ([]*user.Ban, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	bans := make([]*user.Ban, 0)
+	for _, v := range r.bans {
+		if v.UserUUID == id.UUID() {
+			bans = append(bans, copyBan(v))
+		}
+	}
+
+	sort.Slice(bans, func(i, j int) bool {
+		if bans[i].StartsAt.Equal(bans[j].StartsAt) {
+			return bans[i].ID < bans[j].ID
+		}
+
+		return bans[i].StartsAt.Before(bans[j].StartsAt)
+	})
+
+	return bans, nil
+}
+
+func (r *repository) AddBan(ctx context.Context, b *user.Ban) (*user.Ban, error) {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	c := copyBan(b)
+	c.ID = rand.String()
+	r.bans[c.ID] = c
+
+	return copyBan(c), nil
+}
+
+func (r *repository) RevokeBan(ctx context.Context, banID string, by rbac.AccountID, at time.Time) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	b, ok := r.bans[banID]
+	if !ok {
+		return nil
+	}
+
+	b.RevokedAt = &at
+	b.RevokedBy = by
+
+	return nil
+}
+
+var errDuplicateLinkCode = errors.New("duplicate link code")
+
+func (r *repository) AddLinkCode(ctx context.Context, c *user.LinkCode) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.linkCodes[c.Code]; ok {
+		return errDuplicateLinkCode
+	}
+
+	code := *c
+	r.linkCodes[c.Code] = &code
+
+	return nil
+}
+
+func (r *repository) TakeLinkCode(ctx context.Context, code string) (*user.LinkCode, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	c, ok := r.linkCodes[code]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+
+	delete(r.linkCodes, code)
+
+	return c, nil
+}
+
+func (r *repository) AddGameLink(ctx context.Context, l *user.GameLink) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	link := *l
+	r.gameLinks = append(r.gameLinks, &link)
+
+	return nil
+}
+
+func (r *repository) GetGameLinks(ctx context.Context, id user.Identifier) ([]*user.GameLink, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	links := make([]*user.GameLink, 0)
+	for _, v := range r.gameLinks {
+		if v.UserUUID == id.UUID() {
+			link := *v
+			links = append(links, &link)
+		}
+	}
+
+	sort.SliceStable(links, func(i, j int) bool {
+		return links[i].LinkedAt.Before(links[j].LinkedAt)
+	})
+
+	return links, nil
+}
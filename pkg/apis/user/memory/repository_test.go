@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/apis/user/memory"
+	"github.com/51st-state/api/pkg/apis/user/repositorytest"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) user.Repository {
+		return memory.NewRepository()
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/pagination"
 	"github.com/51st-state/api/pkg/rbac"
 )
 
@@ -78,6 +79,31 @@ type FakeManager struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RestoreStub        func(ctx context.Context, id user.Identifier) (user.Complete, error)
+	restoreMutex       sync.RWMutex
+	restoreArgsForCall []struct {
+		ctx context.Context
+		id  user.Identifier
+	}
+	restoreReturns struct {
+		result1 user.Complete
+		result2 error
+	}
+	restoreReturnsOnCall map[int]struct {
+		result1 user.Complete
+		result2 error
+	}
+	PurgeStub        func(ctx context.Context) error
+	purgeMutex       sync.RWMutex
+	purgeArgsForCall []struct {
+		ctx context.Context
+	}
+	purgeReturns struct {
+		result1 error
+	}
+	purgeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetWCFInfoStub        func(ctx context.Context, name string) (*user.WCFUserInfo, error)
 	getWCFInfoMutex       sync.RWMutex
 	getWCFInfoArgsForCall []struct {
@@ -144,6 +170,133 @@ type FakeManager struct {
 	setRolesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ListStub        func(ctx context.Context, f *user.ListFilter, page pagination.Page) ([]user.Complete, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		ctx  context.Context
+		f    *user.ListFilter
+		page pagination.Page
+	}
+	listReturns struct {
+		result1 []user.Complete
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []user.Complete
+		result2 error
+	}
+	GetBansStub        func(ctx context.Context, id user.Identifier) ([]*user.Ban, error)
+	getBansMutex       sync.RWMutex
+	getBansArgsForCall []struct {
+		ctx context.Context
+		id  user.Identifier
+	}
+	getBansReturns struct {
+		result1 []*user.Ban
+		result2 error
+	}
+	getBansReturnsOnCall map[int]struct {
+		result1 []*user.Ban
+		result2 error
+	}
+	GetActiveBanStub        func(ctx context.Context, id user.Identifier, scope user.BanScope) (*user.Ban, error)
+	getActiveBanMutex       sync.RWMutex
+	getActiveBanArgsForCall []struct {
+		ctx   context.Context
+		id    user.Identifier
+		scope user.BanScope
+	}
+	getActiveBanReturns struct {
+		result1 *user.Ban
+		result2 error
+	}
+	getActiveBanReturnsOnCall map[int]struct {
+		result1 *user.Ban
+		result2 error
+	}
+	BanStub        func(ctx context.Context, id user.Identifier, b *user.Ban) (*user.Ban, error)
+	banMutex       sync.RWMutex
+	banArgsForCall []struct {
+		ctx context.Context
+		id  user.Identifier
+		b   *user.Ban
+	}
+	banReturns struct {
+		result1 *user.Ban
+		result2 error
+	}
+	banReturnsOnCall map[int]struct {
+		result1 *user.Ban
+		result2 error
+	}
+	UnbanStub        func(ctx context.Context, id user.Identifier, banID string) error
+	unbanMutex       sync.RWMutex
+	unbanArgsForCall []struct {
+		ctx   context.Context
+		id    user.Identifier
+		banID string
+	}
+	unbanReturns struct {
+		result1 error
+	}
+	unbanReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RequestLinkCodeStub        func(ctx context.Context, hash string) (*user.LinkCode, error)
+	requestLinkCodeMutex       sync.RWMutex
+	requestLinkCodeArgsForCall []struct {
+		ctx  context.Context
+		hash string
+	}
+	requestLinkCodeReturns struct {
+		result1 *user.LinkCode
+		result2 error
+	}
+	requestLinkCodeReturnsOnCall map[int]struct {
+		result1 *user.LinkCode
+		result2 error
+	}
+	RedeemLinkCodeStub        func(ctx context.Context, id user.Identifier, code string) (user.Complete, error)
+	redeemLinkCodeMutex       sync.RWMutex
+	redeemLinkCodeArgsForCall []struct {
+		ctx  context.Context
+		id   user.Identifier
+		code string
+	}
+	redeemLinkCodeReturns struct {
+		result1 user.Complete
+		result2 error
+	}
+	redeemLinkCodeReturnsOnCall map[int]struct {
+		result1 user.Complete
+		result2 error
+	}
+	UnlinkGameStub        func(ctx context.Context, id user.Identifier) error
+	unlinkGameMutex       sync.RWMutex
+	unlinkGameArgsForCall []struct {
+		ctx context.Context
+		id  user.Identifier
+	}
+	unlinkGameReturns struct {
+		result1 error
+	}
+	unlinkGameReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetGameLinksStub        func(ctx context.Context, id user.Identifier) ([]*user.GameLink, error)
+	getGameLinksMutex       sync.RWMutex
+	getGameLinksArgsForCall []struct {
+		ctx context.Context
+		id  user.Identifier
+	}
+	getGameLinksReturns struct {
+		result1 []*user.GameLink
+		result2 error
+	}
+	getGameLinksReturnsOnCall map[int]struct {
+		result1 []*user.GameLink
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -405,6 +558,106 @@ func (fake *FakeManager) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeManager) Restore(ctx context.Context, id user.Identifier) (user.Complete, error) {
+	fake.restoreMutex.Lock()
+	ret, specificReturn := fake.restoreReturnsOnCall[len(fake.restoreArgsForCall)]
+	fake.restoreArgsForCall = append(fake.restoreArgsForCall, struct {
+		ctx context.Context
+		id  user.Identifier
+	}{ctx, id})
+	fake.recordInvocation("Restore", []interface{}{ctx, id})
+	fake.restoreMutex.Unlock()
+	if fake.RestoreStub != nil {
+		return fake.RestoreStub(ctx, id)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.restoreReturns.result1, fake.restoreReturns.result2
+}
+
+func (fake *FakeManager) RestoreCallCount() int {
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	return len(fake.restoreArgsForCall)
+}
+
+func (fake *FakeManager) RestoreArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	return fake.restoreArgsForCall[i].ctx, fake.restoreArgsForCall[i].id
+}
+
+func (fake *FakeManager) RestoreReturns(result1 user.Complete, result2 error) {
+	fake.RestoreStub = nil
+	fake.restoreReturns = struct {
+		result1 user.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) RestoreReturnsOnCall(i int, result1 user.Complete, result2 error) {
+	fake.RestoreStub = nil
+	if fake.restoreReturnsOnCall == nil {
+		fake.restoreReturnsOnCall = make(map[int]struct {
+			result1 user.Complete
+			result2 error
+		})
+	}
+	fake.restoreReturnsOnCall[i] = struct {
+		result1 user.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Purge(ctx context.Context) error {
+	fake.purgeMutex.Lock()
+	ret, specificReturn := fake.purgeReturnsOnCall[len(fake.purgeArgsForCall)]
+	fake.purgeArgsForCall = append(fake.purgeArgsForCall, struct {
+		ctx context.Context
+	}{ctx})
+	fake.recordInvocation("Purge", []interface{}{ctx})
+	fake.purgeMutex.Unlock()
+	if fake.PurgeStub != nil {
+		return fake.PurgeStub(ctx)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.purgeReturns.result1
+}
+
+func (fake *FakeManager) PurgeCallCount() int {
+	fake.purgeMutex.RLock()
+	defer fake.purgeMutex.RUnlock()
+	return len(fake.purgeArgsForCall)
+}
+
+func (fake *FakeManager) PurgeArgsForCall(i int) context.Context {
+	fake.purgeMutex.RLock()
+	defer fake.purgeMutex.RUnlock()
+	return fake.purgeArgsForCall[i].ctx
+}
+
+func (fake *FakeManager) PurgeReturns(result1 error) {
+	fake.PurgeStub = nil
+	fake.purgeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) PurgeReturnsOnCall(i int, result1 error) {
+	fake.PurgeStub = nil
+	if fake.purgeReturnsOnCall == nil {
+		fake.purgeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.purgeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeManager) GetWCFInfo(ctx context.Context, name string) (*user.WCFUserInfo, error) {
 	fake.getWCFInfoMutex.Lock()
 	ret, specificReturn := fake.getWCFInfoReturnsOnCall[len(fake.getWCFInfoArgsForCall)]
@@ -658,6 +911,473 @@ func (fake *FakeManager) SetRolesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeManager) List(ctx context.Context, f *user.ListFilter, page pagination.Page) ([]user.Complete, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		ctx  context.Context
+		f    *user.ListFilter
+		page pagination.Page
+	}{ctx, f, page})
+	fake.recordInvocation("List", []interface{}{ctx, f, page})
+	fake.listMutex.Unlock()
+	if fake.ListStub != nil {
+		return fake.ListStub(ctx, f, page)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listReturns.result1, fake.listReturns.result2
+}
+
+func (fake *FakeManager) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeManager) ListArgsForCall(i int) (context.Context, *user.ListFilter, pagination.Page) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return fake.listArgsForCall[i].ctx, fake.listArgsForCall[i].f, fake.listArgsForCall[i].page
+}
+
+func (fake *FakeManager) ListReturns(result1 []user.Complete, result2 error) {
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []user.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) ListReturnsOnCall(i int, result1 []user.Complete, result2 error) {
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []user.Complete
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []user.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetBans(ctx context.Context, id user.Identifier) ([]*user.Ban, error) {
+	fake.getBansMutex.Lock()
+	ret, specificReturn := fake.getBansReturnsOnCall[len(fake.getBansArgsForCall)]
+	fake.getBansArgsForCall = append(fake.getBansArgsForCall, struct {
+		ctx context.Context
+		id  user.Identifier
+	}{ctx, id})
+	fake.recordInvocation("GetBans", []interface{}{ctx, id})
+	fake.getBansMutex.Unlock()
+	if fake.GetBansStub != nil {
+		return fake.GetBansStub(ctx, id)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getBansReturns.result1, fake.getBansReturns.result2
+}
+
+func (fake *FakeManager) GetBansCallCount() int {
+	fake.getBansMutex.RLock()
+	defer fake.getBansMutex.RUnlock()
+	return len(fake.getBansArgsForCall)
+}
+
+func (fake *FakeManager) GetBansArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getBansMutex.RLock()
+	defer fake.getBansMutex.RUnlock()
+	return fake.getBansArgsForCall[i].ctx, fake.getBansArgsForCall[i].id
+}
+
+func (fake *FakeManager) GetBansReturns(result1 []*user.Ban, result2 error) {
+	fake.GetBansStub = nil
+	fake.getBansReturns = struct {
+		result1 []*user.Ban
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetBansReturnsOnCall(i int, result1 []*user.Ban, result2 error) {
+	fake.GetBansStub = nil
+	if fake.getBansReturnsOnCall == nil {
+		fake.getBansReturnsOnCall = make(map[int]struct {
+			result1 []*user.Ban
+			result2 error
+		})
+	}
+	fake.getBansReturnsOnCall[i] = struct {
+		result1 []*user.Ban
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetActiveBan(ctx context.Context, id user.Identifier, scope user.BanScope) (*user.Ban, error) {
+	fake.getActiveBanMutex.Lock()
+	ret, specificReturn := fake.getActiveBanReturnsOnCall[len(fake.getActiveBanArgsForCall)]
+	fake.getActiveBanArgsForCall = append(fake.getActiveBanArgsForCall, struct {
+		ctx   context.Context
+		id    user.Identifier
+		scope user.BanScope
+	}{ctx, id, scope})
+	fake.recordInvocation("GetActiveBan", []interface{}{ctx, id, scope})
+	fake.getActiveBanMutex.Unlock()
+	if fake.GetActiveBanStub != nil {
+		return fake.GetActiveBanStub(ctx, id, scope)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getActiveBanReturns.result1, fake.getActiveBanReturns.result2
+}
+
+func (fake *FakeManager) GetActiveBanCallCount() int {
+	fake.getActiveBanMutex.RLock()
+	defer fake.getActiveBanMutex.RUnlock()
+	return len(fake.getActiveBanArgsForCall)
+}
+
+func (fake *FakeManager) GetActiveBanArgsForCall(i int) (context.Context, user.Identifier, user.BanScope) {
+	fake.getActiveBanMutex.RLock()
+	defer fake.getActiveBanMutex.RUnlock()
+	return fake.getActiveBanArgsForCall[i].ctx, fake.getActiveBanArgsForCall[i].id, fake.getActiveBanArgsForCall[i].scope
+}
+
+func (fake *FakeManager) GetActiveBanReturns(result1 *user.Ban, result2 error) {
+	fake.GetActiveBanStub = nil
+	fake.getActiveBanReturns = struct {
+		result1 *user.Ban
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetActiveBanReturnsOnCall(i int, result1 *user.Ban, result2 error) {
+	fake.GetActiveBanStub = nil
+	if fake.getActiveBanReturnsOnCall == nil {
+		fake.getActiveBanReturnsOnCall = make(map[int]struct {
+			result1 *user.Ban
+			result2 error
+		})
+	}
+	fake.getActiveBanReturnsOnCall[i] = struct {
+		result1 *user.Ban
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Ban(ctx context.Context, id user.Identifier, b *user.Ban) (*user.Ban, error) {
+	fake.banMutex.Lock()
+	ret, specificReturn := fake.banReturnsOnCall[len(fake.banArgsForCall)]
+	fake.banArgsForCall = append(fake.banArgsForCall, struct {
+		ctx context.Context
+		id  user.Identifier
+		b   *user.Ban
+	}{ctx, id, b})
+	fake.recordInvocation("Ban", []interface{}{ctx, id, b})
+	fake.banMutex.Unlock()
+	if fake.BanStub != nil {
+		return fake.BanStub(ctx, id, b)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.banReturns.result1, fake.banReturns.result2
+}
+
+func (fake *FakeManager) BanCallCount() int {
+	fake.banMutex.RLock()
+	defer fake.banMutex.RUnlock()
+	return len(fake.banArgsForCall)
+}
+
+func (fake *FakeManager) BanArgsForCall(i int) (context.Context, user.Identifier, *user.Ban) {
+	fake.banMutex.RLock()
+	defer fake.banMutex.RUnlock()
+	return fake.banArgsForCall[i].ctx, fake.banArgsForCall[i].id, fake.banArgsForCall[i].b
+}
+
+func (fake *FakeManager) BanReturns(result1 *user.Ban, result2 error) {
+	fake.BanStub = nil
+	fake.banReturns = struct {
+		result1 *user.Ban
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) BanReturnsOnCall(i int, result1 *user.Ban, result2 error) {
+	fake.BanStub = nil
+	if fake.banReturnsOnCall == nil {
+		fake.banReturnsOnCall = make(map[int]struct {
+			result1 *user.Ban
+			result2 error
+		})
+	}
+	fake.banReturnsOnCall[i] = struct {
+		result1 *user.Ban
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Unban(ctx context.Context, id user.Identifier, banID string) error {
+	fake.unbanMutex.Lock()
+	ret, specificReturn := fake.unbanReturnsOnCall[len(fake.unbanArgsForCall)]
+	fake.unbanArgsForCall = append(fake.unbanArgsForCall, struct {
+		ctx   context.Context
+		id    user.Identifier
+		banID string
+	}{ctx, id, banID})
+	fake.recordInvocation("Unban", []interface{}{ctx, id, banID})
+	fake.unbanMutex.Unlock()
+	if fake.UnbanStub != nil {
+		return fake.UnbanStub(ctx, id, banID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.unbanReturns.result1
+}
+
+func (fake *FakeManager) UnbanCallCount() int {
+	fake.unbanMutex.RLock()
+	defer fake.unbanMutex.RUnlock()
+	return len(fake.unbanArgsForCall)
+}
+
+func (fake *FakeManager) UnbanArgsForCall(i int) (context.Context, user.Identifier, string) {
+	fake.unbanMutex.RLock()
+	defer fake.unbanMutex.RUnlock()
+	return fake.unbanArgsForCall[i].ctx, fake.unbanArgsForCall[i].id, fake.unbanArgsForCall[i].banID
+}
+
+func (fake *FakeManager) UnbanReturns(result1 error) {
+	fake.UnbanStub = nil
+	fake.unbanReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) UnbanReturnsOnCall(i int, result1 error) {
+	fake.UnbanStub = nil
+	if fake.unbanReturnsOnCall == nil {
+		fake.unbanReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unbanReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) RequestLinkCode(ctx context.Context, hash string) (*user.LinkCode, error) {
+	fake.requestLinkCodeMutex.Lock()
+	ret, specificReturn := fake.requestLinkCodeReturnsOnCall[len(fake.requestLinkCodeArgsForCall)]
+	fake.requestLinkCodeArgsForCall = append(fake.requestLinkCodeArgsForCall, struct {
+		ctx  context.Context
+		hash string
+	}{ctx, hash})
+	fake.recordInvocation("RequestLinkCode", []interface{}{ctx, hash})
+	fake.requestLinkCodeMutex.Unlock()
+	if fake.RequestLinkCodeStub != nil {
+		return fake.RequestLinkCodeStub(ctx, hash)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.requestLinkCodeReturns.result1, fake.requestLinkCodeReturns.result2
+}
+
+func (fake *FakeManager) RequestLinkCodeCallCount() int {
+	fake.requestLinkCodeMutex.RLock()
+	defer fake.requestLinkCodeMutex.RUnlock()
+	return len(fake.requestLinkCodeArgsForCall)
+}
+
+func (fake *FakeManager) RequestLinkCodeArgsForCall(i int) (context.Context, string) {
+	fake.requestLinkCodeMutex.RLock()
+	defer fake.requestLinkCodeMutex.RUnlock()
+	return fake.requestLinkCodeArgsForCall[i].ctx, fake.requestLinkCodeArgsForCall[i].hash
+}
+
+func (fake *FakeManager) RequestLinkCodeReturns(result1 *user.LinkCode, result2 error) {
+	fake.RequestLinkCodeStub = nil
+	fake.requestLinkCodeReturns = struct {
+		result1 *user.LinkCode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) RequestLinkCodeReturnsOnCall(i int, result1 *user.LinkCode, result2 error) {
+	fake.RequestLinkCodeStub = nil
+	if fake.requestLinkCodeReturnsOnCall == nil {
+		fake.requestLinkCodeReturnsOnCall = make(map[int]struct {
+			result1 *user.LinkCode
+			result2 error
+		})
+	}
+	fake.requestLinkCodeReturnsOnCall[i] = struct {
+		result1 *user.LinkCode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) RedeemLinkCode(ctx context.Context, id user.Identifier, code string) (user.Complete, error) {
+	fake.redeemLinkCodeMutex.Lock()
+	ret, specificReturn := fake.redeemLinkCodeReturnsOnCall[len(fake.redeemLinkCodeArgsForCall)]
+	fake.redeemLinkCodeArgsForCall = append(fake.redeemLinkCodeArgsForCall, struct {
+		ctx  context.Context
+		id   user.Identifier
+		code string
+	}{ctx, id, code})
+	fake.recordInvocation("RedeemLinkCode", []interface{}{ctx, id, code})
+	fake.redeemLinkCodeMutex.Unlock()
+	if fake.RedeemLinkCodeStub != nil {
+		return fake.RedeemLinkCodeStub(ctx, id, code)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.redeemLinkCodeReturns.result1, fake.redeemLinkCodeReturns.result2
+}
+
+func (fake *FakeManager) RedeemLinkCodeCallCount() int {
+	fake.redeemLinkCodeMutex.RLock()
+	defer fake.redeemLinkCodeMutex.RUnlock()
+	return len(fake.redeemLinkCodeArgsForCall)
+}
+
+func (fake *FakeManager) RedeemLinkCodeArgsForCall(i int) (context.Context, user.Identifier, string) {
+	fake.redeemLinkCodeMutex.RLock()
+	defer fake.redeemLinkCodeMutex.RUnlock()
+	return fake.redeemLinkCodeArgsForCall[i].ctx, fake.redeemLinkCodeArgsForCall[i].id, fake.redeemLinkCodeArgsForCall[i].code
+}
+
+func (fake *FakeManager) RedeemLinkCodeReturns(result1 user.Complete, result2 error) {
+	fake.RedeemLinkCodeStub = nil
+	fake.redeemLinkCodeReturns = struct {
+		result1 user.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) RedeemLinkCodeReturnsOnCall(i int, result1 user.Complete, result2 error) {
+	fake.RedeemLinkCodeStub = nil
+	if fake.redeemLinkCodeReturnsOnCall == nil {
+		fake.redeemLinkCodeReturnsOnCall = make(map[int]struct {
+			result1 user.Complete
+			result2 error
+		})
+	}
+	fake.redeemLinkCodeReturnsOnCall[i] = struct {
+		result1 user.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) UnlinkGame(ctx context.Context, id user.Identifier) error {
+	fake.unlinkGameMutex.Lock()
+	ret, specificReturn := fake.unlinkGameReturnsOnCall[len(fake.unlinkGameArgsForCall)]
+	fake.unlinkGameArgsForCall = append(fake.unlinkGameArgsForCall, struct {
+		ctx context.Context
+		id  user.Identifier
+	}{ctx, id})
+	fake.recordInvocation("UnlinkGame", []interface{}{ctx, id})
+	fake.unlinkGameMutex.Unlock()
+	if fake.UnlinkGameStub != nil {
+		return fake.UnlinkGameStub(ctx, id)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.unlinkGameReturns.result1
+}
+
+func (fake *FakeManager) UnlinkGameCallCount() int {
+	fake.unlinkGameMutex.RLock()
+	defer fake.unlinkGameMutex.RUnlock()
+	return len(fake.unlinkGameArgsForCall)
+}
+
+func (fake *FakeManager) UnlinkGameArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.unlinkGameMutex.RLock()
+	defer fake.unlinkGameMutex.RUnlock()
+	return fake.unlinkGameArgsForCall[i].ctx, fake.unlinkGameArgsForCall[i].id
+}
+
+func (fake *FakeManager) UnlinkGameReturns(result1 error) {
+	fake.UnlinkGameStub = nil
+	fake.unlinkGameReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) UnlinkGameReturnsOnCall(i int, result1 error) {
+	fake.UnlinkGameStub = nil
+	if fake.unlinkGameReturnsOnCall == nil {
+		fake.unlinkGameReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unlinkGameReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) GetGameLinks(ctx context.Context, id user.Identifier) ([]*user.GameLink, error) {
+	fake.getGameLinksMutex.Lock()
+	ret, specificReturn := fake.getGameLinksReturnsOnCall[len(fake.getGameLinksArgsForCall)]
+	fake.getGameLinksArgsForCall = append(fake.getGameLinksArgsForCall, struct {
+		ctx context.Context
+		id  user.Identifier
+	}{ctx, id})
+	fake.recordInvocation("GetGameLinks", []interface{}{ctx, id})
+	fake.getGameLinksMutex.Unlock()
+	if fake.GetGameLinksStub != nil {
+		return fake.GetGameLinksStub(ctx, id)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getGameLinksReturns.result1, fake.getGameLinksReturns.result2
+}
+
+func (fake *FakeManager) GetGameLinksCallCount() int {
+	fake.getGameLinksMutex.RLock()
+	defer fake.getGameLinksMutex.RUnlock()
+	return len(fake.getGameLinksArgsForCall)
+}
+
+func (fake *FakeManager) GetGameLinksArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getGameLinksMutex.RLock()
+	defer fake.getGameLinksMutex.RUnlock()
+	return fake.getGameLinksArgsForCall[i].ctx, fake.getGameLinksArgsForCall[i].id
+}
+
+func (fake *FakeManager) GetGameLinksReturns(result1 []*user.GameLink, result2 error) {
+	fake.GetGameLinksStub = nil
+	fake.getGameLinksReturns = struct {
+		result1 []*user.GameLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) GetGameLinksReturnsOnCall(i int, result1 []*user.GameLink, result2 error) {
+	fake.GetGameLinksStub = nil
+	if fake.getGameLinksReturnsOnCall == nil {
+		fake.getGameLinksReturnsOnCall = make(map[int]struct {
+			result1 []*user.GameLink
+			result2 error
+		})
+	}
+	fake.getGameLinksReturnsOnCall[i] = struct {
+		result1 []*user.GameLink
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -671,6 +1391,10 @@ func (fake *FakeManager) Invocations() map[string][][]interface{} {
 	defer fake.createMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	fake.purgeMutex.RLock()
+	defer fake.purgeMutex.RUnlock()
 	fake.getWCFInfoMutex.RLock()
 	defer fake.getWCFInfoMutex.RUnlock()
 	fake.updateMutex.RLock()
@@ -681,6 +1405,24 @@ func (fake *FakeManager) Invocations() map[string][][]interface{} {
 	defer fake.getRolesMutex.RUnlock()
 	fake.setRolesMutex.RLock()
 	defer fake.setRolesMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.getBansMutex.RLock()
+	defer fake.getBansMutex.RUnlock()
+	fake.getActiveBanMutex.RLock()
+	defer fake.getActiveBanMutex.RUnlock()
+	fake.banMutex.RLock()
+	defer fake.banMutex.RUnlock()
+	fake.unbanMutex.RLock()
+	defer fake.unbanMutex.RUnlock()
+	fake.requestLinkCodeMutex.RLock()
+	defer fake.requestLinkCodeMutex.RUnlock()
+	fake.redeemLinkCodeMutex.RLock()
+	defer fake.redeemLinkCodeMutex.RUnlock()
+	fake.unlinkGameMutex.RLock()
+	defer fake.unlinkGameMutex.RUnlock()
+	fake.getGameLinksMutex.RLock()
+	defer fake.getGameLinksMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
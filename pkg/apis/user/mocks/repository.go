@@ -4,8 +4,11 @@ package mocks
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/pagination"
+	"github.com/51st-state/api/pkg/rbac"
 )
 
 type FakeRepository struct {
@@ -89,6 +92,196 @@ type FakeRepository struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SoftDeleteStub        func(ctx context.Context, id user.Identifier, at time.Time) error
+	softDeleteMutex       sync.RWMutex
+	softDeleteArgsForCall []struct {
+		ctx context.Context
+		id  user.Identifier
+		at  time.Time
+	}
+	softDeleteReturns struct {
+		result1 error
+	}
+	softDeleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RestoreStub        func(context.Context, user.Identifier) error
+	restoreMutex       sync.RWMutex
+	restoreArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	restoreReturns struct {
+		result1 error
+	}
+	restoreReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetDeletionStub        func(context.Context, user.Identifier) (*user.Deletion, error)
+	getDeletionMutex       sync.RWMutex
+	getDeletionArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	getDeletionReturns struct {
+		result1 *user.Deletion
+		result2 error
+	}
+	getDeletionReturnsOnCall map[int]struct {
+		result1 *user.Deletion
+		result2 error
+	}
+	GetDeletionByWCFUserIDStub        func(context.Context, user.WCFUserID) (*user.Deletion, error)
+	getDeletionByWCFUserIDMutex       sync.RWMutex
+	getDeletionByWCFUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.WCFUserID
+	}
+	getDeletionByWCFUserIDReturns struct {
+		result1 *user.Deletion
+		result2 error
+	}
+	getDeletionByWCFUserIDReturnsOnCall map[int]struct {
+		result1 *user.Deletion
+		result2 error
+	}
+	GetDeletionsStub        func(ctx context.Context, before time.Time) ([]*user.Deletion, error)
+	getDeletionsMutex       sync.RWMutex
+	getDeletionsArgsForCall []struct {
+		ctx    context.Context
+		before time.Time
+	}
+	getDeletionsReturns struct {
+		result1 []*user.Deletion
+		result2 error
+	}
+	getDeletionsReturnsOnCall map[int]struct {
+		result1 []*user.Deletion
+		result2 error
+	}
+	ListStub        func(context.Context, *user.ListOptions, pagination.Page) ([]user.Complete, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 context.Context
+		arg2 *user.ListOptions
+		arg3 pagination.Page
+	}
+	listReturns struct {
+		result1 []user.Complete
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []user.Complete
+		result2 error
+	}
+	GetBanStub        func(ctx context.Context, banID string) (*user.Ban, error)
+	getBanMutex       sync.RWMutex
+	getBanArgsForCall []struct {
+		ctx   context.Context
+		banID string
+	}
+	getBanReturns struct {
+		result1 *user.Ban
+		result2 error
+	}
+	getBanReturnsOnCall map[int]struct {
+		result1 *user.Ban
+		result2 error
+	}
+	GetBansStub        func(context.Context, user.Identifier) ([]*user.Ban, error)
+	getBansMutex       sync.RWMutex
+	getBansArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	getBansReturns struct {
+		result1 []*user.Ban
+		result2 error
+	}
+	getBansReturnsOnCall map[int]struct {
+		result1 []*user.Ban
+		result2 error
+	}
+	AddBanStub        func(context.Context, *user.Ban) (*user.Ban, error)
+	addBanMutex       sync.RWMutex
+	addBanArgsForCall []struct {
+		arg1 context.Context
+		arg2 *user.Ban
+	}
+	addBanReturns struct {
+		result1 *user.Ban
+		result2 error
+	}
+	addBanReturnsOnCall map[int]struct {
+		result1 *user.Ban
+		result2 error
+	}
+	RevokeBanStub        func(ctx context.Context, banID string, by rbac.AccountID, at time.Time) error
+	revokeBanMutex       sync.RWMutex
+	revokeBanArgsForCall []struct {
+		ctx   context.Context
+		banID string
+		by    rbac.AccountID
+		at    time.Time
+	}
+	revokeBanReturns struct {
+		result1 error
+	}
+	revokeBanReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AddLinkCodeStub        func(context.Context, *user.LinkCode) error
+	addLinkCodeMutex       sync.RWMutex
+	addLinkCodeArgsForCall []struct {
+		arg1 context.Context
+		arg2 *user.LinkCode
+	}
+	addLinkCodeReturns struct {
+		result1 error
+	}
+	addLinkCodeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	TakeLinkCodeStub        func(ctx context.Context, code string) (*user.LinkCode, error)
+	takeLinkCodeMutex       sync.RWMutex
+	takeLinkCodeArgsForCall []struct {
+		ctx  context.Context
+		code string
+	}
+	takeLinkCodeReturns struct {
+		result1 *user.LinkCode
+		result2 error
+	}
+	takeLinkCodeReturnsOnCall map[int]struct {
+		result1 *user.LinkCode
+		result2 error
+	}
+	AddGameLinkStub        func(context.Context, *user.GameLink) error
+	addGameLinkMutex       sync.RWMutex
+	addGameLinkArgsForCall []struct {
+		arg1 context.Context
+		arg2 *user.GameLink
+	}
+	addGameLinkReturns struct {
+		result1 error
+	}
+	addGameLinkReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetGameLinksStub        func(context.Context, user.Identifier) ([]*user.GameLink, error)
+	getGameLinksMutex       sync.RWMutex
+	getGameLinksArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}
+	getGameLinksReturns struct {
+		result1 []*user.GameLink
+		result2 error
+	}
+	getGameLinksReturnsOnCall map[int]struct {
+		result1 []*user.GameLink
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -399,6 +592,723 @@ func (fake *FakeRepository) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeRepository) SoftDelete(ctx context.Context, id user.Identifier, at time.Time) error {
+	fake.softDeleteMutex.Lock()
+	ret, specificReturn := fake.softDeleteReturnsOnCall[len(fake.softDeleteArgsForCall)]
+	fake.softDeleteArgsForCall = append(fake.softDeleteArgsForCall, struct {
+		ctx context.Context
+		id  user.Identifier
+		at  time.Time
+	}{ctx, id, at})
+	fake.recordInvocation("SoftDelete", []interface{}{ctx, id, at})
+	fake.softDeleteMutex.Unlock()
+	if fake.SoftDeleteStub != nil {
+		return fake.SoftDeleteStub(ctx, id, at)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.softDeleteReturns.result1
+}
+
+func (fake *FakeRepository) SoftDeleteCallCount() int {
+	fake.softDeleteMutex.RLock()
+	defer fake.softDeleteMutex.RUnlock()
+	return len(fake.softDeleteArgsForCall)
+}
+
+func (fake *FakeRepository) SoftDeleteArgsForCall(i int) (context.Context, user.Identifier, time.Time) {
+	fake.softDeleteMutex.RLock()
+	defer fake.softDeleteMutex.RUnlock()
+	return fake.softDeleteArgsForCall[i].ctx, fake.softDeleteArgsForCall[i].id, fake.softDeleteArgsForCall[i].at
+}
+
+func (fake *FakeRepository) SoftDeleteReturns(result1 error) {
+	fake.SoftDeleteStub = nil
+	fake.softDeleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) SoftDeleteReturnsOnCall(i int, result1 error) {
+	fake.SoftDeleteStub = nil
+	if fake.softDeleteReturnsOnCall == nil {
+		fake.softDeleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.softDeleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) Restore(arg1 context.Context, arg2 user.Identifier) error {
+	fake.restoreMutex.Lock()
+	ret, specificReturn := fake.restoreReturnsOnCall[len(fake.restoreArgsForCall)]
+	fake.restoreArgsForCall = append(fake.restoreArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("Restore", []interface{}{arg1, arg2})
+	fake.restoreMutex.Unlock()
+	if fake.RestoreStub != nil {
+		return fake.RestoreStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.restoreReturns.result1
+}
+
+func (fake *FakeRepository) RestoreCallCount() int {
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	return len(fake.restoreArgsForCall)
+}
+
+func (fake *FakeRepository) RestoreArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	return fake.restoreArgsForCall[i].arg1, fake.restoreArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) RestoreReturns(result1 error) {
+	fake.RestoreStub = nil
+	fake.restoreReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) RestoreReturnsOnCall(i int, result1 error) {
+	fake.RestoreStub = nil
+	if fake.restoreReturnsOnCall == nil {
+		fake.restoreReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restoreReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetDeletion(arg1 context.Context, arg2 user.Identifier) (*user.Deletion, error) {
+	fake.getDeletionMutex.Lock()
+	ret, specificReturn := fake.getDeletionReturnsOnCall[len(fake.getDeletionArgsForCall)]
+	fake.getDeletionArgsForCall = append(fake.getDeletionArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetDeletion", []interface{}{arg1, arg2})
+	fake.getDeletionMutex.Unlock()
+	if fake.GetDeletionStub != nil {
+		return fake.GetDeletionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getDeletionReturns.result1, fake.getDeletionReturns.result2
+}
+
+func (fake *FakeRepository) GetDeletionCallCount() int {
+	fake.getDeletionMutex.RLock()
+	defer fake.getDeletionMutex.RUnlock()
+	return len(fake.getDeletionArgsForCall)
+}
+
+func (fake *FakeRepository) GetDeletionArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getDeletionMutex.RLock()
+	defer fake.getDeletionMutex.RUnlock()
+	return fake.getDeletionArgsForCall[i].arg1, fake.getDeletionArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetDeletionReturns(result1 *user.Deletion, result2 error) {
+	fake.GetDeletionStub = nil
+	fake.getDeletionReturns = struct {
+		result1 *user.Deletion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetDeletionReturnsOnCall(i int, result1 *user.Deletion, result2 error) {
+	fake.GetDeletionStub = nil
+	if fake.getDeletionReturnsOnCall == nil {
+		fake.getDeletionReturnsOnCall = make(map[int]struct {
+			result1 *user.Deletion
+			result2 error
+		})
+	}
+	fake.getDeletionReturnsOnCall[i] = struct {
+		result1 *user.Deletion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetDeletionByWCFUserID(arg1 context.Context, arg2 user.WCFUserID) (*user.Deletion, error) {
+	fake.getDeletionByWCFUserIDMutex.Lock()
+	ret, specificReturn := fake.getDeletionByWCFUserIDReturnsOnCall[len(fake.getDeletionByWCFUserIDArgsForCall)]
+	fake.getDeletionByWCFUserIDArgsForCall = append(fake.getDeletionByWCFUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.WCFUserID
+	}{arg1, arg2})
+	fake.recordInvocation("GetDeletionByWCFUserID", []interface{}{arg1, arg2})
+	fake.getDeletionByWCFUserIDMutex.Unlock()
+	if fake.GetDeletionByWCFUserIDStub != nil {
+		return fake.GetDeletionByWCFUserIDStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getDeletionByWCFUserIDReturns.result1, fake.getDeletionByWCFUserIDReturns.result2
+}
+
+func (fake *FakeRepository) GetDeletionByWCFUserIDCallCount() int {
+	fake.getDeletionByWCFUserIDMutex.RLock()
+	defer fake.getDeletionByWCFUserIDMutex.RUnlock()
+	return len(fake.getDeletionByWCFUserIDArgsForCall)
+}
+
+func (fake *FakeRepository) GetDeletionByWCFUserIDArgsForCall(i int) (context.Context, user.WCFUserID) {
+	fake.getDeletionByWCFUserIDMutex.RLock()
+	defer fake.getDeletionByWCFUserIDMutex.RUnlock()
+	return fake.getDeletionByWCFUserIDArgsForCall[i].arg1, fake.getDeletionByWCFUserIDArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetDeletionByWCFUserIDReturns(result1 *user.Deletion, result2 error) {
+	fake.GetDeletionByWCFUserIDStub = nil
+	fake.getDeletionByWCFUserIDReturns = struct {
+		result1 *user.Deletion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetDeletionByWCFUserIDReturnsOnCall(i int, result1 *user.Deletion, result2 error) {
+	fake.GetDeletionByWCFUserIDStub = nil
+	if fake.getDeletionByWCFUserIDReturnsOnCall == nil {
+		fake.getDeletionByWCFUserIDReturnsOnCall = make(map[int]struct {
+			result1 *user.Deletion
+			result2 error
+		})
+	}
+	fake.getDeletionByWCFUserIDReturnsOnCall[i] = struct {
+		result1 *user.Deletion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetDeletions(ctx context.Context, before time.Time) ([]*user.Deletion, error) {
+	fake.getDeletionsMutex.Lock()
+	ret, specificReturn := fake.getDeletionsReturnsOnCall[len(fake.getDeletionsArgsForCall)]
+	fake.getDeletionsArgsForCall = append(fake.getDeletionsArgsForCall, struct {
+		ctx    context.Context
+		before time.Time
+	}{ctx, before})
+	fake.recordInvocation("GetDeletions", []interface{}{ctx, before})
+	fake.getDeletionsMutex.Unlock()
+	if fake.GetDeletionsStub != nil {
+		return fake.GetDeletionsStub(ctx, before)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getDeletionsReturns.result1, fake.getDeletionsReturns.result2
+}
+
+func (fake *FakeRepository) GetDeletionsCallCount() int {
+	fake.getDeletionsMutex.RLock()
+	defer fake.getDeletionsMutex.RUnlock()
+	return len(fake.getDeletionsArgsForCall)
+}
+
+func (fake *FakeRepository) GetDeletionsArgsForCall(i int) (context.Context, time.Time) {
+	fake.getDeletionsMutex.RLock()
+	defer fake.getDeletionsMutex.RUnlock()
+	return fake.getDeletionsArgsForCall[i].ctx, fake.getDeletionsArgsForCall[i].before
+}
+
+func (fake *FakeRepository) GetDeletionsReturns(result1 []*user.Deletion, result2 error) {
+	fake.GetDeletionsStub = nil
+	fake.getDeletionsReturns = struct {
+		result1 []*user.Deletion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetDeletionsReturnsOnCall(i int, result1 []*user.Deletion, result2 error) {
+	fake.GetDeletionsStub = nil
+	if fake.getDeletionsReturnsOnCall == nil {
+		fake.getDeletionsReturnsOnCall = make(map[int]struct {
+			result1 []*user.Deletion
+			result2 error
+		})
+	}
+	fake.getDeletionsReturnsOnCall[i] = struct {
+		result1 []*user.Deletion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) List(arg1 context.Context, arg2 *user.ListOptions, arg3 pagination.Page) ([]user.Complete, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 context.Context
+		arg2 *user.ListOptions
+		arg3 pagination.Page
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("List", []interface{}{arg1, arg2, arg3})
+	fake.listMutex.Unlock()
+	if fake.ListStub != nil {
+		return fake.ListStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listReturns.result1, fake.listReturns.result2
+}
+
+func (fake *FakeRepository) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeRepository) ListArgsForCall(i int) (context.Context, *user.ListOptions, pagination.Page) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return fake.listArgsForCall[i].arg1, fake.listArgsForCall[i].arg2, fake.listArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) ListReturns(result1 []user.Complete, result2 error) {
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []user.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListReturnsOnCall(i int, result1 []user.Complete, result2 error) {
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []user.Complete
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []user.Complete
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetBan(ctx context.Context, banID string) (*user.Ban, error) {
+	fake.getBanMutex.Lock()
+	ret, specificReturn := fake.getBanReturnsOnCall[len(fake.getBanArgsForCall)]
+	fake.getBanArgsForCall = append(fake.getBanArgsForCall, struct {
+		ctx   context.Context
+		banID string
+	}{ctx, banID})
+	fake.recordInvocation("GetBan", []interface{}{ctx, banID})
+	fake.getBanMutex.Unlock()
+	if fake.GetBanStub != nil {
+		return fake.GetBanStub(ctx, banID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getBanReturns.result1, fake.getBanReturns.result2
+}
+
+func (fake *FakeRepository) GetBanCallCount() int {
+	fake.getBanMutex.RLock()
+	defer fake.getBanMutex.RUnlock()
+	return len(fake.getBanArgsForCall)
+}
+
+func (fake *FakeRepository) GetBanArgsForCall(i int) (context.Context, string) {
+	fake.getBanMutex.RLock()
+	defer fake.getBanMutex.RUnlock()
+	return fake.getBanArgsForCall[i].ctx, fake.getBanArgsForCall[i].banID
+}
+
+func (fake *FakeRepository) GetBanReturns(result1 *user.Ban, result2 error) {
+	fake.GetBanStub = nil
+	fake.getBanReturns = struct {
+		result1 *user.Ban
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetBanReturnsOnCall(i int, result1 *user.Ban, result2 error) {
+	fake.GetBanStub = nil
+	if fake.getBanReturnsOnCall == nil {
+		fake.getBanReturnsOnCall = make(map[int]struct {
+			result1 *user.Ban
+			result2 error
+		})
+	}
+	fake.getBanReturnsOnCall[i] = struct {
+		result1 *user.Ban
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetBans(arg1 context.Context, arg2 user.Identifier) ([]*user.Ban, error) {
+	fake.getBansMutex.Lock()
+	ret, specificReturn := fake.getBansReturnsOnCall[len(fake.getBansArgsForCall)]
+	fake.getBansArgsForCall = append(fake.getBansArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetBans", []interface{}{arg1, arg2})
+	fake.getBansMutex.Unlock()
+	if fake.GetBansStub != nil {
+		return fake.GetBansStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getBansReturns.result1, fake.getBansReturns.result2
+}
+
+func (fake *FakeRepository) GetBansCallCount() int {
+	fake.getBansMutex.RLock()
+	defer fake.getBansMutex.RUnlock()
+	return len(fake.getBansArgsForCall)
+}
+
+func (fake *FakeRepository) GetBansArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getBansMutex.RLock()
+	defer fake.getBansMutex.RUnlock()
+	return fake.getBansArgsForCall[i].arg1, fake.getBansArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetBansReturns(result1 []*user.Ban, result2 error) {
+	fake.GetBansStub = nil
+	fake.getBansReturns = struct {
+		result1 []*user.Ban
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetBansReturnsOnCall(i int, result1 []*user.Ban, result2 error) {
+	fake.GetBansStub = nil
+	if fake.getBansReturnsOnCall == nil {
+		fake.getBansReturnsOnCall = make(map[int]struct {
+			result1 []*user.Ban
+			result2 error
+		})
+	}
+	fake.getBansReturnsOnCall[i] = struct {
+		result1 []*user.Ban
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) AddBan(arg1 context.Context, arg2 *user.Ban) (*user.Ban, error) {
+	fake.addBanMutex.Lock()
+	ret, specificReturn := fake.addBanReturnsOnCall[len(fake.addBanArgsForCall)]
+	fake.addBanArgsForCall = append(fake.addBanArgsForCall, struct {
+		arg1 context.Context
+		arg2 *user.Ban
+	}{arg1, arg2})
+	fake.recordInvocation("AddBan", []interface{}{arg1, arg2})
+	fake.addBanMutex.Unlock()
+	if fake.AddBanStub != nil {
+		return fake.AddBanStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.addBanReturns.result1, fake.addBanReturns.result2
+}
+
+func (fake *FakeRepository) AddBanCallCount() int {
+	fake.addBanMutex.RLock()
+	defer fake.addBanMutex.RUnlock()
+	return len(fake.addBanArgsForCall)
+}
+
+func (fake *FakeRepository) AddBanArgsForCall(i int) (context.Context, *user.Ban) {
+	fake.addBanMutex.RLock()
+	defer fake.addBanMutex.RUnlock()
+	return fake.addBanArgsForCall[i].arg1, fake.addBanArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) AddBanReturns(result1 *user.Ban, result2 error) {
+	fake.AddBanStub = nil
+	fake.addBanReturns = struct {
+		result1 *user.Ban
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) AddBanReturnsOnCall(i int, result1 *user.Ban, result2 error) {
+	fake.AddBanStub = nil
+	if fake.addBanReturnsOnCall == nil {
+		fake.addBanReturnsOnCall = make(map[int]struct {
+			result1 *user.Ban
+			result2 error
+		})
+	}
+	fake.addBanReturnsOnCall[i] = struct {
+		result1 *user.Ban
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) RevokeBan(ctx context.Context, banID string, by rbac.AccountID, at time.Time) error {
+	fake.revokeBanMutex.Lock()
+	ret, specificReturn := fake.revokeBanReturnsOnCall[len(fake.revokeBanArgsForCall)]
+	fake.revokeBanArgsForCall = append(fake.revokeBanArgsForCall, struct {
+		ctx   context.Context
+		banID string
+		by    rbac.AccountID
+		at    time.Time
+	}{ctx, banID, by, at})
+	fake.recordInvocation("RevokeBan", []interface{}{ctx, banID, by, at})
+	fake.revokeBanMutex.Unlock()
+	if fake.RevokeBanStub != nil {
+		return fake.RevokeBanStub(ctx, banID, by, at)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.revokeBanReturns.result1
+}
+
+func (fake *FakeRepository) RevokeBanCallCount() int {
+	fake.revokeBanMutex.RLock()
+	defer fake.revokeBanMutex.RUnlock()
+	return len(fake.revokeBanArgsForCall)
+}
+
+func (fake *FakeRepository) RevokeBanArgsForCall(i int) (context.Context, string, rbac.AccountID, time.Time) {
+	fake.revokeBanMutex.RLock()
+	defer fake.revokeBanMutex.RUnlock()
+	return fake.revokeBanArgsForCall[i].ctx, fake.revokeBanArgsForCall[i].banID, fake.revokeBanArgsForCall[i].by, fake.revokeBanArgsForCall[i].at
+}
+
+func (fake *FakeRepository) RevokeBanReturns(result1 error) {
+	fake.RevokeBanStub = nil
+	fake.revokeBanReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) RevokeBanReturnsOnCall(i int, result1 error) {
+	fake.RevokeBanStub = nil
+	if fake.revokeBanReturnsOnCall == nil {
+		fake.revokeBanReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeBanReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) AddLinkCode(arg1 context.Context, arg2 *user.LinkCode) error {
+	fake.addLinkCodeMutex.Lock()
+	ret, specificReturn := fake.addLinkCodeReturnsOnCall[len(fake.addLinkCodeArgsForCall)]
+	fake.addLinkCodeArgsForCall = append(fake.addLinkCodeArgsForCall, struct {
+		arg1 context.Context
+		arg2 *user.LinkCode
+	}{arg1, arg2})
+	fake.recordInvocation("AddLinkCode", []interface{}{arg1, arg2})
+	fake.addLinkCodeMutex.Unlock()
+	if fake.AddLinkCodeStub != nil {
+		return fake.AddLinkCodeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.addLinkCodeReturns.result1
+}
+
+func (fake *FakeRepository) AddLinkCodeCallCount() int {
+	fake.addLinkCodeMutex.RLock()
+	defer fake.addLinkCodeMutex.RUnlock()
+	return len(fake.addLinkCodeArgsForCall)
+}
+
+func (fake *FakeRepository) AddLinkCodeArgsForCall(i int) (context.Context, *user.LinkCode) {
+	fake.addLinkCodeMutex.RLock()
+	defer fake.addLinkCodeMutex.RUnlock()
+	return fake.addLinkCodeArgsForCall[i].arg1, fake.addLinkCodeArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) AddLinkCodeReturns(result1 error) {
+	fake.AddLinkCodeStub = nil
+	fake.addLinkCodeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) AddLinkCodeReturnsOnCall(i int, result1 error) {
+	fake.AddLinkCodeStub = nil
+	if fake.addLinkCodeReturnsOnCall == nil {
+		fake.addLinkCodeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addLinkCodeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) TakeLinkCode(ctx context.Context, code string) (*user.LinkCode, error) {
+	fake.takeLinkCodeMutex.Lock()
+	ret, specificReturn := fake.takeLinkCodeReturnsOnCall[len(fake.takeLinkCodeArgsForCall)]
+	fake.takeLinkCodeArgsForCall = append(fake.takeLinkCodeArgsForCall, struct {
+		ctx  context.Context
+		code string
+	}{ctx, code})
+	fake.recordInvocation("TakeLinkCode", []interface{}{ctx, code})
+	fake.takeLinkCodeMutex.Unlock()
+	if fake.TakeLinkCodeStub != nil {
+		return fake.TakeLinkCodeStub(ctx, code)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.takeLinkCodeReturns.result1, fake.takeLinkCodeReturns.result2
+}
+
+func (fake *FakeRepository) TakeLinkCodeCallCount() int {
+	fake.takeLinkCodeMutex.RLock()
+	defer fake.takeLinkCodeMutex.RUnlock()
+	return len(fake.takeLinkCodeArgsForCall)
+}
+
+func (fake *FakeRepository) TakeLinkCodeArgsForCall(i int) (context.Context, string) {
+	fake.takeLinkCodeMutex.RLock()
+	defer fake.takeLinkCodeMutex.RUnlock()
+	return fake.takeLinkCodeArgsForCall[i].ctx, fake.takeLinkCodeArgsForCall[i].code
+}
+
+func (fake *FakeRepository) TakeLinkCodeReturns(result1 *user.LinkCode, result2 error) {
+	fake.TakeLinkCodeStub = nil
+	fake.takeLinkCodeReturns = struct {
+		result1 *user.LinkCode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) TakeLinkCodeReturnsOnCall(i int, result1 *user.LinkCode, result2 error) {
+	fake.TakeLinkCodeStub = nil
+	if fake.takeLinkCodeReturnsOnCall == nil {
+		fake.takeLinkCodeReturnsOnCall = make(map[int]struct {
+			result1 *user.LinkCode
+			result2 error
+		})
+	}
+	fake.takeLinkCodeReturnsOnCall[i] = struct {
+		result1 *user.LinkCode
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) AddGameLink(arg1 context.Context, arg2 *user.GameLink) error {
+	fake.addGameLinkMutex.Lock()
+	ret, specificReturn := fake.addGameLinkReturnsOnCall[len(fake.addGameLinkArgsForCall)]
+	fake.addGameLinkArgsForCall = append(fake.addGameLinkArgsForCall, struct {
+		arg1 context.Context
+		arg2 *user.GameLink
+	}{arg1, arg2})
+	fake.recordInvocation("AddGameLink", []interface{}{arg1, arg2})
+	fake.addGameLinkMutex.Unlock()
+	if fake.AddGameLinkStub != nil {
+		return fake.AddGameLinkStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.addGameLinkReturns.result1
+}
+
+func (fake *FakeRepository) AddGameLinkCallCount() int {
+	fake.addGameLinkMutex.RLock()
+	defer fake.addGameLinkMutex.RUnlock()
+	return len(fake.addGameLinkArgsForCall)
+}
+
+func (fake *FakeRepository) AddGameLinkArgsForCall(i int) (context.Context, *user.GameLink) {
+	fake.addGameLinkMutex.RLock()
+	defer fake.addGameLinkMutex.RUnlock()
+	return fake.addGameLinkArgsForCall[i].arg1, fake.addGameLinkArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) AddGameLinkReturns(result1 error) {
+	fake.AddGameLinkStub = nil
+	fake.addGameLinkReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) AddGameLinkReturnsOnCall(i int, result1 error) {
+	fake.AddGameLinkStub = nil
+	if fake.addGameLinkReturnsOnCall == nil {
+		fake.addGameLinkReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addGameLinkReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetGameLinks(arg1 context.Context, arg2 user.Identifier) ([]*user.GameLink, error) {
+	fake.getGameLinksMutex.Lock()
+	ret, specificReturn := fake.getGameLinksReturnsOnCall[len(fake.getGameLinksArgsForCall)]
+	fake.getGameLinksArgsForCall = append(fake.getGameLinksArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.Identifier
+	}{arg1, arg2})
+	fake.recordInvocation("GetGameLinks", []interface{}{arg1, arg2})
+	fake.getGameLinksMutex.Unlock()
+	if fake.GetGameLinksStub != nil {
+		return fake.GetGameLinksStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getGameLinksReturns.result1, fake.getGameLinksReturns.result2
+}
+
+func (fake *FakeRepository) GetGameLinksCallCount() int {
+	fake.getGameLinksMutex.RLock()
+	defer fake.getGameLinksMutex.RUnlock()
+	return len(fake.getGameLinksArgsForCall)
+}
+
+func (fake *FakeRepository) GetGameLinksArgsForCall(i int) (context.Context, user.Identifier) {
+	fake.getGameLinksMutex.RLock()
+	defer fake.getGameLinksMutex.RUnlock()
+	return fake.getGameLinksArgsForCall[i].arg1, fake.getGameLinksArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetGameLinksReturns(result1 []*user.GameLink, result2 error) {
+	fake.GetGameLinksStub = nil
+	fake.getGameLinksReturns = struct {
+		result1 []*user.GameLink
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetGameLinksReturnsOnCall(i int, result1 []*user.GameLink, result2 error) {
+	fake.GetGameLinksStub = nil
+	if fake.getGameLinksReturnsOnCall == nil {
+		fake.getGameLinksReturnsOnCall = make(map[int]struct {
+			result1 []*user.GameLink
+			result2 error
+		})
+	}
+	fake.getGameLinksReturnsOnCall[i] = struct {
+		result1 []*user.GameLink
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -414,6 +1324,34 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.updateMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.softDeleteMutex.RLock()
+	defer fake.softDeleteMutex.RUnlock()
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	fake.getDeletionMutex.RLock()
+	defer fake.getDeletionMutex.RUnlock()
+	fake.getDeletionByWCFUserIDMutex.RLock()
+	defer fake.getDeletionByWCFUserIDMutex.RUnlock()
+	fake.getDeletionsMutex.RLock()
+	defer fake.getDeletionsMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.getBanMutex.RLock()
+	defer fake.getBanMutex.RUnlock()
+	fake.getBansMutex.RLock()
+	defer fake.getBansMutex.RUnlock()
+	fake.addBanMutex.RLock()
+	defer fake.addBanMutex.RUnlock()
+	fake.revokeBanMutex.RLock()
+	defer fake.revokeBanMutex.RUnlock()
+	fake.addLinkCodeMutex.RLock()
+	defer fake.addLinkCodeMutex.RUnlock()
+	fake.takeLinkCodeMutex.RLock()
+	defer fake.takeLinkCodeMutex.RUnlock()
+	fake.addGameLinkMutex.RLock()
+	defer fake.addGameLinkMutex.RUnlock()
+	fake.getGameLinksMutex.RLock()
+	defer fake.getGameLinksMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
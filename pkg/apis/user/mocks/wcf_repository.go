@@ -51,6 +51,49 @@ type FakeWCFRepository struct {
 		result1 *user.WCFUserInfo
 		result2 error
 	}
+	GetPasswordStub        func(context.Context, user.WCFUserID) (user.CompletePassword, error)
+	getPasswordMutex       sync.RWMutex
+	getPasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 user.WCFUserID
+	}
+	getPasswordReturns struct {
+		result1 user.CompletePassword
+		result2 error
+	}
+	getPasswordReturnsOnCall map[int]struct {
+		result1 user.CompletePassword
+		result2 error
+	}
+	GetInfoManyStub        func(context.Context, []user.WCFUserID) (map[user.WCFUserID]*user.WCFUserInfo, error)
+	getInfoManyMutex       sync.RWMutex
+	getInfoManyArgsForCall []struct {
+		arg1 context.Context
+		arg2 []user.WCFUserID
+	}
+	getInfoManyReturns struct {
+		result1 map[user.WCFUserID]*user.WCFUserInfo
+		result2 error
+	}
+	getInfoManyReturnsOnCall map[int]struct {
+		result1 map[user.WCFUserID]*user.WCFUserInfo
+		result2 error
+	}
+	SearchUserIDsStub        func(ctx context.Context, query string, limit int) ([]user.WCFUserID, error)
+	searchUserIDsMutex       sync.RWMutex
+	searchUserIDsArgsForCall []struct {
+		ctx   context.Context
+		query string
+		limit int
+	}
+	searchUserIDsReturns struct {
+		result1 []user.WCFUserID
+		result2 error
+	}
+	searchUserIDsReturnsOnCall map[int]struct {
+		result1 []user.WCFUserID
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -211,6 +254,168 @@ func (fake *FakeWCFRepository) GetInfoByUsernameReturnsOnCall(i int, result1 *us
 	}{result1, result2}
 }
 
+func (fake *FakeWCFRepository) GetPassword(arg1 context.Context, arg2 user.WCFUserID) (user.CompletePassword, error) {
+	fake.getPasswordMutex.Lock()
+	ret, specificReturn := fake.getPasswordReturnsOnCall[len(fake.getPasswordArgsForCall)]
+	fake.getPasswordArgsForCall = append(fake.getPasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 user.WCFUserID
+	}{arg1, arg2})
+	fake.recordInvocation("GetPassword", []interface{}{arg1, arg2})
+	fake.getPasswordMutex.Unlock()
+	if fake.GetPasswordStub != nil {
+		return fake.GetPasswordStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getPasswordReturns.result1, fake.getPasswordReturns.result2
+}
+
+func (fake *FakeWCFRepository) GetPasswordCallCount() int {
+	fake.getPasswordMutex.RLock()
+	defer fake.getPasswordMutex.RUnlock()
+	return len(fake.getPasswordArgsForCall)
+}
+
+func (fake *FakeWCFRepository) GetPasswordArgsForCall(i int) (context.Context, user.WCFUserID) {
+	fake.getPasswordMutex.RLock()
+	defer fake.getPasswordMutex.RUnlock()
+	return fake.getPasswordArgsForCall[i].arg1, fake.getPasswordArgsForCall[i].arg2
+}
+
+func (fake *FakeWCFRepository) GetPasswordReturns(result1 user.CompletePassword, result2 error) {
+	fake.GetPasswordStub = nil
+	fake.getPasswordReturns = struct {
+		result1 user.CompletePassword
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWCFRepository) GetPasswordReturnsOnCall(i int, result1 user.CompletePassword, result2 error) {
+	fake.GetPasswordStub = nil
+	if fake.getPasswordReturnsOnCall == nil {
+		fake.getPasswordReturnsOnCall = make(map[int]struct {
+			result1 user.CompletePassword
+			result2 error
+		})
+	}
+	fake.getPasswordReturnsOnCall[i] = struct {
+		result1 user.CompletePassword
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWCFRepository) GetInfoMany(arg1 context.Context, arg2 []user.WCFUserID) (map[user.WCFUserID]*user.WCFUserInfo, error) {
+	var arg2Copy []user.WCFUserID
+	if arg2 != nil {
+		arg2Copy = make([]user.WCFUserID, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.getInfoManyMutex.Lock()
+	ret, specificReturn := fake.getInfoManyReturnsOnCall[len(fake.getInfoManyArgsForCall)]
+	fake.getInfoManyArgsForCall = append(fake.getInfoManyArgsForCall, struct {
+		arg1 context.Context
+		arg2 []user.WCFUserID
+	}{arg1, arg2Copy})
+	fake.recordInvocation("GetInfoMany", []interface{}{arg1, arg2Copy})
+	fake.getInfoManyMutex.Unlock()
+	if fake.GetInfoManyStub != nil {
+		return fake.GetInfoManyStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getInfoManyReturns.result1, fake.getInfoManyReturns.result2
+}
+
+func (fake *FakeWCFRepository) GetInfoManyCallCount() int {
+	fake.getInfoManyMutex.RLock()
+	defer fake.getInfoManyMutex.RUnlock()
+	return len(fake.getInfoManyArgsForCall)
+}
+
+func (fake *FakeWCFRepository) GetInfoManyArgsForCall(i int) (context.Context, []user.WCFUserID) {
+	fake.getInfoManyMutex.RLock()
+	defer fake.getInfoManyMutex.RUnlock()
+	return fake.getInfoManyArgsForCall[i].arg1, fake.getInfoManyArgsForCall[i].arg2
+}
+
+func (fake *FakeWCFRepository) GetInfoManyReturns(result1 map[user.WCFUserID]*user.WCFUserInfo, result2 error) {
+	fake.GetInfoManyStub = nil
+	fake.getInfoManyReturns = struct {
+		result1 map[user.WCFUserID]*user.WCFUserInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWCFRepository) GetInfoManyReturnsOnCall(i int, result1 map[user.WCFUserID]*user.WCFUserInfo, result2 error) {
+	fake.GetInfoManyStub = nil
+	if fake.getInfoManyReturnsOnCall == nil {
+		fake.getInfoManyReturnsOnCall = make(map[int]struct {
+			result1 map[user.WCFUserID]*user.WCFUserInfo
+			result2 error
+		})
+	}
+	fake.getInfoManyReturnsOnCall[i] = struct {
+		result1 map[user.WCFUserID]*user.WCFUserInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWCFRepository) SearchUserIDs(ctx context.Context, query string, limit int) ([]user.WCFUserID, error) {
+	fake.searchUserIDsMutex.Lock()
+	ret, specificReturn := fake.searchUserIDsReturnsOnCall[len(fake.searchUserIDsArgsForCall)]
+	fake.searchUserIDsArgsForCall = append(fake.searchUserIDsArgsForCall, struct {
+		ctx   context.Context
+		query string
+		limit int
+	}{ctx, query, limit})
+	fake.recordInvocation("SearchUserIDs", []interface{}{ctx, query, limit})
+	fake.searchUserIDsMutex.Unlock()
+	if fake.SearchUserIDsStub != nil {
+		return fake.SearchUserIDsStub(ctx, query, limit)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.searchUserIDsReturns.result1, fake.searchUserIDsReturns.result2
+}
+
+func (fake *FakeWCFRepository) SearchUserIDsCallCount() int {
+	fake.searchUserIDsMutex.RLock()
+	defer fake.searchUserIDsMutex.RUnlock()
+	return len(fake.searchUserIDsArgsForCall)
+}
+
+func (fake *FakeWCFRepository) SearchUserIDsArgsForCall(i int) (context.Context, string, int) {
+	fake.searchUserIDsMutex.RLock()
+	defer fake.searchUserIDsMutex.RUnlock()
+	return fake.searchUserIDsArgsForCall[i].ctx, fake.searchUserIDsArgsForCall[i].query, fake.searchUserIDsArgsForCall[i].limit
+}
+
+func (fake *FakeWCFRepository) SearchUserIDsReturns(result1 []user.WCFUserID, result2 error) {
+	fake.SearchUserIDsStub = nil
+	fake.searchUserIDsReturns = struct {
+		result1 []user.WCFUserID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWCFRepository) SearchUserIDsReturnsOnCall(i int, result1 []user.WCFUserID, result2 error) {
+	fake.SearchUserIDsStub = nil
+	if fake.searchUserIDsReturnsOnCall == nil {
+		fake.searchUserIDsReturnsOnCall = make(map[int]struct {
+			result1 []user.WCFUserID
+			result2 error
+		})
+	}
+	fake.searchUserIDsReturnsOnCall[i] = struct {
+		result1 []user.WCFUserID
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWCFRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -220,6 +425,12 @@ func (fake *FakeWCFRepository) Invocations() map[string][][]interface{} {
 	defer fake.getInfoByEmailMutex.RUnlock()
 	fake.getInfoByUsernameMutex.RLock()
 	defer fake.getInfoByUsernameMutex.RUnlock()
+	fake.getPasswordMutex.RLock()
+	defer fake.getPasswordMutex.RUnlock()
+	fake.getInfoManyMutex.RLock()
+	defer fake.getInfoManyMutex.RUnlock()
+	fake.searchUserIDsMutex.RLock()
+	defer fake.searchUserIDsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
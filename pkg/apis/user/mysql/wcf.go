@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"strings"
 
 	_ "github.com/go-sql-driver/mysql"
 
@@ -14,22 +15,20 @@ type wcfRepository struct {
 }
 
 // NewWCFRepository for fetching specific Woltlab Community Framwork user data
-// This function returns, as defined in the user package, only the hashed
-// password and the email of the wcf user
+// This function returns, as defined in the user package, only the name and
+// the email of the wcf user and its hashed password on its own
 func NewWCFRepository(db *sql.DB) user.WCFRepository {
 	return &wcfRepository{db}
 }
 
 func (r *wcfRepository) GetInfo(ctx context.Context, id user.WCFUserID) (*user.WCFUserInfo, error) {
 	var info user.WCFUserInfo
-	hash := make([]byte, 0)
 
 	if err := r.database.QueryRowContext(
 		ctx,
 		`SELECT userId,
         username,
-        email,
-        password
+        email
         FROM wcf1_user
         WHERE userId = ?`,
 		id,
@@ -37,26 +36,21 @@ func (r *wcfRepository) GetInfo(ctx context.Context, id user.WCFUserID) (*user.W
 		&info.UserID,
 		&info.Username,
 		&info.Email,
-		&hash,
 	); err != nil {
 		return nil, err
 	}
 
-	info.Password = newCompletePassword(hash)
-
 	return &info, nil
 }
 
 func (r *wcfRepository) GetInfoByEmail(ctx context.Context, wcfEmail string) (*user.WCFUserInfo, error) {
 	var info user.WCFUserInfo
-	hash := make([]byte, 0)
 
 	if err := r.database.QueryRowContext(
 		ctx,
 		`SELECT userId,
         username,
-        email,
-        password
+        email
         FROM wcf1_user
         WHERE email = ?`,
 		wcfEmail,
@@ -64,26 +58,21 @@ func (r *wcfRepository) GetInfoByEmail(ctx context.Context, wcfEmail string) (*u
 		&info.UserID,
 		&info.Username,
 		&info.Email,
-		&hash,
 	); err != nil {
 		return nil, err
 	}
 
-	info.Password = newCompletePassword(hash)
-
 	return &info, nil
 }
 
 func (r *wcfRepository) GetInfoByUsername(ctx context.Context, username string) (*user.WCFUserInfo, error) {
 	var info user.WCFUserInfo
-	hash := make([]byte, 0)
 
 	if err := r.database.QueryRowContext(
 		ctx,
 		`SELECT userId,
         username,
-        email,
-        password
+        email
         FROM wcf1_user
         WHERE username = ?`,
 		username,
@@ -91,14 +80,105 @@ func (r *wcfRepository) GetInfoByUsername(ctx context.Context, username string)
 		&info.UserID,
 		&info.Username,
 		&info.Email,
+	); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+func (r *wcfRepository) GetInfoMany(ctx context.Context, ids []user.WCFUserID) (map[user.WCFUserID]*user.WCFUserInfo, error) {
+	infos := make(map[user.WCFUserID]*user.WCFUserInfo)
+	if len(ids) == 0 {
+		return infos, nil
+	}
+
+	args := make([]interface{}, 0, len(ids))
+	for _, v := range ids {
+		args = append(args, v)
+	}
+
+	rows, err := r.database.QueryContext(
+		ctx,
+		`SELECT userId,
+        username,
+        email
+        FROM wcf1_user
+        WHERE userId IN (?`+strings.Repeat(", ?", len(ids)-1)+`)`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var info user.WCFUserInfo
+		if err := rows.Scan(
+			&info.UserID,
+			&info.Username,
+			&info.Email,
+		); err != nil {
+			return nil, err
+		}
+
+		infos[info.UserID] = &info
+	}
+
+	return infos, rows.Err()
+}
+
+func (r *wcfRepository) GetPassword(ctx context.Context, id user.WCFUserID) (user.CompletePassword, error) {
+	hash := make([]byte, 0)
+
+	if err := r.database.QueryRowContext(
+		ctx,
+		`SELECT password
+        FROM wcf1_user
+        WHERE userId = ?`,
+		id,
+	).Scan(
 		&hash,
 	); err != nil {
 		return nil, err
 	}
 
-	info.Password = newCompletePassword(hash)
+	return newCompletePassword(hash), nil
+}
 
-	return &info, nil
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func (r *wcfRepository) SearchUserIDs(ctx context.Context, query string, limit int) ([]user.WCFUserID, error) {
+	pattern := "%" + likeEscaper.Replace(query) + "%"
+
+	rows, err := r.database.QueryContext(
+		ctx,
+		`SELECT userId
+        FROM wcf1_user
+        WHERE username LIKE ?
+        OR email LIKE ?
+        ORDER BY userId
+        LIMIT ?`,
+		pattern,
+		pattern,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]user.WCFUserID, 0)
+	for rows.Next() {
+		var id user.WCFUserID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
 }
 
 type completePassword struct {
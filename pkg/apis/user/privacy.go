@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+)
+
+// PrivacyHandler exports and erases the data the user service stores about a user.
+// The forum account of a user is not part of it, it is managed by the forum.
+type PrivacyHandler struct {
+	repository    Repository
+	wcfRepository WCFRepository
+}
+
+// NewPrivacyHandler for the data of the user service
+func NewPrivacyHandler(r Repository, wcf WCFRepository) *PrivacyHandler {
+	return &PrivacyHandler{
+		r,
+		wcf,
+	}
+}
+
+type privacyExport struct {
+	User      Complete    `json:"user,omitempty"`
+	Bans      []*Ban      `json:"bans"`
+	GameLinks []*GameLink `json:"game_links"`
+}
+
+// Export the user including its bans and game links
+func (h *PrivacyHandler) Export(ctx context.Context, userUUID string) (interface{}, error) {
+	id := newIdentifier(userUUID)
+	export := &privacyExport{}
+
+	c, err := h.repository.Get(ctx, id)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	if err == nil {
+		wcfInfo, err := h.wcfRepository.GetInfo(ctx, c.Data().WCFUserID)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+
+		if err == nil {
+			c.Data().WCFUsername = wcfInfo.Username
+			c.Data().WCFEmail = wcfInfo.Email
+		}
+
+		export.User = c
+	}
+
+	if export.Bans, err = h.repository.GetBans(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if export.GameLinks, err = h.repository.GetGameLinks(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+// Erase the user including its bans and game links.
+// The user is usually deleted already, deleting it again is a no-op.
+func (h *PrivacyHandler) Erase(ctx context.Context, userUUID string) error {
+	return h.repository.Delete(ctx, newIdentifier(userUUID))
+}
@@ -474,7 +474,6 @@ type WCFUserInfo struct {
 	UserID               uint64   `protobuf:"varint,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
 	Username             string   `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
 	Email                string   `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
-	Password             []byte   `protobuf:"bytes,4,opt,name=Password,proto3" json:"Password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -526,13 +525,6 @@ func (m *WCFUserInfo) GetEmail() string {
 	return ""
 }
 
-func (m *WCFUserInfo) GetPassword() []byte {
-	if m != nil {
-		return m.Password
-	}
-	return nil
-}
-
 type GetWCFInfoRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -671,28 +663,687 @@ func (m *SetUserRolesRequest) XXX_Unmarshal(b []byte) error {
 func (m *SetUserRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_SetUserRolesRequest.Marshal(b, m, deterministic)
 }
-func (m *SetUserRolesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetUserRolesRequest.Merge(m, src)
+func (m *SetUserRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUserRolesRequest.Merge(m, src)
+}
+func (m *SetUserRolesRequest) XXX_Size() int {
+	return xxx_messageInfo_SetUserRolesRequest.Size(m)
+}
+func (m *SetUserRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUserRolesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUserRolesRequest proto.InternalMessageInfo
+
+func (m *SetUserRolesRequest) GetUUID() *UUID {
+	if m != nil {
+		return m.UUID
+	}
+	return nil
+}
+
+func (m *SetUserRolesRequest) GetRoles() *proto1.AccountRoles {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type ListUsersRequest struct {
+	Query                string       `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	FilterBanned         bool         `protobuf:"varint,2,opt,name=FilterBanned,proto3" json:"FilterBanned,omitempty"`
+	Banned               bool         `protobuf:"varint,3,opt,name=Banned,proto3" json:"Banned,omitempty"`
+	Page                 *proto1.Page `protobuf:"bytes,4,opt,name=Page,proto3" json:"Page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
+func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUsersRequest) ProtoMessage()    {}
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{15}
+}
+
+func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersRequest.Unmarshal(m, b)
+}
+func (m *ListUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersRequest.Merge(m, src)
+}
+func (m *ListUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUsersRequest.Size(m)
+}
+func (m *ListUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersRequest proto.InternalMessageInfo
+
+func (m *ListUsersRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *ListUsersRequest) GetFilterBanned() bool {
+	if m != nil {
+		return m.FilterBanned
+	}
+	return false
+}
+
+func (m *ListUsersRequest) GetBanned() bool {
+	if m != nil {
+		return m.Banned
+	}
+	return false
+}
+
+func (m *ListUsersRequest) GetPage() *proto1.Page {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type Users struct {
+	Users                []*User  `protobuf:"bytes,1,rep,name=Users,proto3" json:"Users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Users) Reset()         { *m = Users{} }
+func (m *Users) String() string { return proto.CompactTextString(m) }
+func (*Users) ProtoMessage()    {}
+func (*Users) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{16}
+}
+
+func (m *Users) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Users.Unmarshal(m, b)
+}
+func (m *Users) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Users.Marshal(b, m, deterministic)
+}
+func (m *Users) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Users.Merge(m, src)
+}
+func (m *Users) XXX_Size() int {
+	return xxx_messageInfo_Users.Size(m)
+}
+func (m *Users) XXX_DiscardUnknown() {
+	xxx_messageInfo_Users.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Users proto.InternalMessageInfo
+
+func (m *Users) GetUsers() []*User {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type Ban struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	UserUUID             string   `protobuf:"bytes,2,opt,name=UserUUID,proto3" json:"UserUUID,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Issuer               string   `protobuf:"bytes,4,opt,name=Issuer,proto3" json:"Issuer,omitempty"`
+	Scopes               []string `protobuf:"bytes,5,rep,name=Scopes,proto3" json:"Scopes,omitempty"`
+	StartsAt             int64    `protobuf:"varint,6,opt,name=StartsAt,proto3" json:"StartsAt,omitempty"`
+	EndsAt               int64    `protobuf:"varint,7,opt,name=EndsAt,proto3" json:"EndsAt,omitempty"`
+	RevokedAt            int64    `protobuf:"varint,8,opt,name=RevokedAt,proto3" json:"RevokedAt,omitempty"`
+	RevokedBy            string   `protobuf:"bytes,9,opt,name=RevokedBy,proto3" json:"RevokedBy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Ban) Reset()         { *m = Ban{} }
+func (m *Ban) String() string { return proto.CompactTextString(m) }
+func (*Ban) ProtoMessage()    {}
+func (*Ban) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{17}
+}
+
+func (m *Ban) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Ban.Unmarshal(m, b)
+}
+func (m *Ban) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Ban.Marshal(b, m, deterministic)
+}
+func (m *Ban) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ban.Merge(m, src)
+}
+func (m *Ban) XXX_Size() int {
+	return xxx_messageInfo_Ban.Size(m)
+}
+func (m *Ban) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ban.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ban proto.InternalMessageInfo
+
+func (m *Ban) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Ban) GetUserUUID() string {
+	if m != nil {
+		return m.UserUUID
+	}
+	return ""
+}
+
+func (m *Ban) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Ban) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *Ban) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *Ban) GetStartsAt() int64 {
+	if m != nil {
+		return m.StartsAt
+	}
+	return 0
+}
+
+func (m *Ban) GetEndsAt() int64 {
+	if m != nil {
+		return m.EndsAt
+	}
+	return 0
+}
+
+func (m *Ban) GetRevokedAt() int64 {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return 0
+}
+
+func (m *Ban) GetRevokedBy() string {
+	if m != nil {
+		return m.RevokedBy
+	}
+	return ""
+}
+
+type Bans struct {
+	Bans                 []*Ban   `protobuf:"bytes,1,rep,name=Bans,proto3" json:"Bans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Bans) Reset()         { *m = Bans{} }
+func (m *Bans) String() string { return proto.CompactTextString(m) }
+func (*Bans) ProtoMessage()    {}
+func (*Bans) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{18}
+}
+
+func (m *Bans) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Bans.Unmarshal(m, b)
+}
+func (m *Bans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Bans.Marshal(b, m, deterministic)
+}
+func (m *Bans) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bans.Merge(m, src)
+}
+func (m *Bans) XXX_Size() int {
+	return xxx_messageInfo_Bans.Size(m)
+}
+func (m *Bans) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bans.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bans proto.InternalMessageInfo
+
+func (m *Bans) GetBans() []*Ban {
+	if m != nil {
+		return m.Bans
+	}
+	return nil
+}
+
+type BanUserRequest struct {
+	UUID                 *UUID    `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	Ban                  *Ban     `protobuf:"bytes,2,opt,name=Ban,proto3" json:"Ban,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BanUserRequest) Reset()         { *m = BanUserRequest{} }
+func (m *BanUserRequest) String() string { return proto.CompactTextString(m) }
+func (*BanUserRequest) ProtoMessage()    {}
+func (*BanUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{19}
+}
+
+func (m *BanUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BanUserRequest.Unmarshal(m, b)
+}
+func (m *BanUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BanUserRequest.Marshal(b, m, deterministic)
+}
+func (m *BanUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BanUserRequest.Merge(m, src)
+}
+func (m *BanUserRequest) XXX_Size() int {
+	return xxx_messageInfo_BanUserRequest.Size(m)
+}
+func (m *BanUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BanUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BanUserRequest proto.InternalMessageInfo
+
+func (m *BanUserRequest) GetUUID() *UUID {
+	if m != nil {
+		return m.UUID
+	}
+	return nil
+}
+
+func (m *BanUserRequest) GetBan() *Ban {
+	if m != nil {
+		return m.Ban
+	}
+	return nil
+}
+
+type UnbanUserRequest struct {
+	UUID                 *UUID    `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	BanID                string   `protobuf:"bytes,2,opt,name=BanID,proto3" json:"BanID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnbanUserRequest) Reset()         { *m = UnbanUserRequest{} }
+func (m *UnbanUserRequest) String() string { return proto.CompactTextString(m) }
+func (*UnbanUserRequest) ProtoMessage()    {}
+func (*UnbanUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{20}
+}
+
+func (m *UnbanUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnbanUserRequest.Unmarshal(m, b)
+}
+func (m *UnbanUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnbanUserRequest.Marshal(b, m, deterministic)
+}
+func (m *UnbanUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbanUserRequest.Merge(m, src)
+}
+func (m *UnbanUserRequest) XXX_Size() int {
+	return xxx_messageInfo_UnbanUserRequest.Size(m)
+}
+func (m *UnbanUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbanUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbanUserRequest proto.InternalMessageInfo
+
+func (m *UnbanUserRequest) GetUUID() *UUID {
+	if m != nil {
+		return m.UUID
+	}
+	return nil
+}
+
+func (m *UnbanUserRequest) GetBanID() string {
+	if m != nil {
+		return m.BanID
+	}
+	return ""
+}
+
+type GetActiveUserBanRequest struct {
+	UUID                 *UUID    `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	Scope                string   `protobuf:"bytes,2,opt,name=Scope,proto3" json:"Scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetActiveUserBanRequest) Reset()         { *m = GetActiveUserBanRequest{} }
+func (m *GetActiveUserBanRequest) String() string { return proto.CompactTextString(m) }
+func (*GetActiveUserBanRequest) ProtoMessage()    {}
+func (*GetActiveUserBanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{21}
+}
+
+func (m *GetActiveUserBanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActiveUserBanRequest.Unmarshal(m, b)
+}
+func (m *GetActiveUserBanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActiveUserBanRequest.Marshal(b, m, deterministic)
+}
+func (m *GetActiveUserBanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActiveUserBanRequest.Merge(m, src)
+}
+func (m *GetActiveUserBanRequest) XXX_Size() int {
+	return xxx_messageInfo_GetActiveUserBanRequest.Size(m)
+}
+func (m *GetActiveUserBanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActiveUserBanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActiveUserBanRequest proto.InternalMessageInfo
+
+func (m *GetActiveUserBanRequest) GetUUID() *UUID {
+	if m != nil {
+		return m.UUID
+	}
+	return nil
+}
+
+func (m *GetActiveUserBanRequest) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+type ActiveUserBan struct {
+	Banned               bool     `protobuf:"varint,1,opt,name=Banned,proto3" json:"Banned,omitempty"`
+	Ban                  *Ban     `protobuf:"bytes,2,opt,name=Ban,proto3" json:"Ban,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActiveUserBan) Reset()         { *m = ActiveUserBan{} }
+func (m *ActiveUserBan) String() string { return proto.CompactTextString(m) }
+func (*ActiveUserBan) ProtoMessage()    {}
+func (*ActiveUserBan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{22}
+}
+
+func (m *ActiveUserBan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActiveUserBan.Unmarshal(m, b)
+}
+func (m *ActiveUserBan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActiveUserBan.Marshal(b, m, deterministic)
+}
+func (m *ActiveUserBan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveUserBan.Merge(m, src)
+}
+func (m *ActiveUserBan) XXX_Size() int {
+	return xxx_messageInfo_ActiveUserBan.Size(m)
+}
+func (m *ActiveUserBan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveUserBan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveUserBan proto.InternalMessageInfo
+
+func (m *ActiveUserBan) GetBanned() bool {
+	if m != nil {
+		return m.Banned
+	}
+	return false
+}
+
+func (m *ActiveUserBan) GetBan() *Ban {
+	if m != nil {
+		return m.Ban
+	}
+	return nil
+}
+
+type RequestLinkCodeRequest struct {
+	GameSerialHash       string   `protobuf:"bytes,1,opt,name=GameSerialHash,proto3" json:"GameSerialHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestLinkCodeRequest) Reset()         { *m = RequestLinkCodeRequest{} }
+func (m *RequestLinkCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestLinkCodeRequest) ProtoMessage()    {}
+func (*RequestLinkCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{23}
+}
+
+func (m *RequestLinkCodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestLinkCodeRequest.Unmarshal(m, b)
+}
+func (m *RequestLinkCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestLinkCodeRequest.Marshal(b, m, deterministic)
+}
+func (m *RequestLinkCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestLinkCodeRequest.Merge(m, src)
+}
+func (m *RequestLinkCodeRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestLinkCodeRequest.Size(m)
+}
+func (m *RequestLinkCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestLinkCodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestLinkCodeRequest proto.InternalMessageInfo
+
+func (m *RequestLinkCodeRequest) GetGameSerialHash() string {
+	if m != nil {
+		return m.GameSerialHash
+	}
+	return ""
+}
+
+type LinkCode struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,2,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LinkCode) Reset()         { *m = LinkCode{} }
+func (m *LinkCode) String() string { return proto.CompactTextString(m) }
+func (*LinkCode) ProtoMessage()    {}
+func (*LinkCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{24}
+}
+
+func (m *LinkCode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LinkCode.Unmarshal(m, b)
+}
+func (m *LinkCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LinkCode.Marshal(b, m, deterministic)
+}
+func (m *LinkCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkCode.Merge(m, src)
+}
+func (m *LinkCode) XXX_Size() int {
+	return xxx_messageInfo_LinkCode.Size(m)
+}
+func (m *LinkCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkCode proto.InternalMessageInfo
+
+func (m *LinkCode) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *LinkCode) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type RedeemLinkCodeRequest struct {
+	UUID                 *UUID    `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	Code                 string   `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RedeemLinkCodeRequest) Reset()         { *m = RedeemLinkCodeRequest{} }
+func (m *RedeemLinkCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*RedeemLinkCodeRequest) ProtoMessage()    {}
+func (*RedeemLinkCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{25}
+}
+
+func (m *RedeemLinkCodeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedeemLinkCodeRequest.Unmarshal(m, b)
+}
+func (m *RedeemLinkCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedeemLinkCodeRequest.Marshal(b, m, deterministic)
+}
+func (m *RedeemLinkCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeemLinkCodeRequest.Merge(m, src)
+}
+func (m *RedeemLinkCodeRequest) XXX_Size() int {
+	return xxx_messageInfo_RedeemLinkCodeRequest.Size(m)
+}
+func (m *RedeemLinkCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeemLinkCodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedeemLinkCodeRequest proto.InternalMessageInfo
+
+func (m *RedeemLinkCodeRequest) GetUUID() *UUID {
+	if m != nil {
+		return m.UUID
+	}
+	return nil
+}
+
+func (m *RedeemLinkCodeRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type GameLink struct {
+	UserUUID             string   `protobuf:"bytes,1,opt,name=UserUUID,proto3" json:"UserUUID,omitempty"`
+	GameSerialHash       string   `protobuf:"bytes,2,opt,name=GameSerialHash,proto3" json:"GameSerialHash,omitempty"`
+	LinkedAt             int64    `protobuf:"varint,3,opt,name=LinkedAt,proto3" json:"LinkedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GameLink) Reset()         { *m = GameLink{} }
+func (m *GameLink) String() string { return proto.CompactTextString(m) }
+func (*GameLink) ProtoMessage()    {}
+func (*GameLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{26}
+}
+
+func (m *GameLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GameLink.Unmarshal(m, b)
+}
+func (m *GameLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GameLink.Marshal(b, m, deterministic)
+}
+func (m *GameLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameLink.Merge(m, src)
+}
+func (m *GameLink) XXX_Size() int {
+	return xxx_messageInfo_GameLink.Size(m)
+}
+func (m *GameLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GameLink proto.InternalMessageInfo
+
+func (m *GameLink) GetUserUUID() string {
+	if m != nil {
+		return m.UserUUID
+	}
+	return ""
+}
+
+func (m *GameLink) GetGameSerialHash() string {
+	if m != nil {
+		return m.GameSerialHash
+	}
+	return ""
+}
+
+func (m *GameLink) GetLinkedAt() int64 {
+	if m != nil {
+		return m.LinkedAt
+	}
+	return 0
+}
+
+type GameLinks struct {
+	GameLinks            []*GameLink `protobuf:"bytes,1,rep,name=GameLinks,proto3" json:"GameLinks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GameLinks) Reset()         { *m = GameLinks{} }
+func (m *GameLinks) String() string { return proto.CompactTextString(m) }
+func (*GameLinks) ProtoMessage()    {}
+func (*GameLinks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cde9ec64f0d2c859, []int{27}
+}
+
+func (m *GameLinks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GameLinks.Unmarshal(m, b)
+}
+func (m *GameLinks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GameLinks.Marshal(b, m, deterministic)
+}
+func (m *GameLinks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GameLinks.Merge(m, src)
 }
-func (m *SetUserRolesRequest) XXX_Size() int {
-	return xxx_messageInfo_SetUserRolesRequest.Size(m)
+func (m *GameLinks) XXX_Size() int {
+	return xxx_messageInfo_GameLinks.Size(m)
 }
-func (m *SetUserRolesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetUserRolesRequest.DiscardUnknown(m)
+func (m *GameLinks) XXX_DiscardUnknown() {
+	xxx_messageInfo_GameLinks.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetUserRolesRequest proto.InternalMessageInfo
-
-func (m *SetUserRolesRequest) GetUUID() *UUID {
-	if m != nil {
-		return m.UUID
-	}
-	return nil
-}
+var xxx_messageInfo_GameLinks proto.InternalMessageInfo
 
-func (m *SetUserRolesRequest) GetRoles() *proto1.AccountRoles {
+func (m *GameLinks) GetGameLinks() []*GameLink {
 	if m != nil {
-		return m.Roles
+		return m.GameLinks
 	}
 	return nil
 }
@@ -713,53 +1364,99 @@ func init() {
 	proto.RegisterType((*GetUserByGameSerialHashRequest)(nil), "user.GetUserByGameSerialHashRequest")
 	proto.RegisterType((*GetUserByWCFUserIDRequest)(nil), "user.GetUserByWCFUserIDRequest")
 	proto.RegisterType((*SetUserRolesRequest)(nil), "user.SetUserRolesRequest")
+	proto.RegisterType((*ListUsersRequest)(nil), "user.ListUsersRequest")
+	proto.RegisterType((*Users)(nil), "user.Users")
+	proto.RegisterType((*Ban)(nil), "user.Ban")
+	proto.RegisterType((*Bans)(nil), "user.Bans")
+	proto.RegisterType((*BanUserRequest)(nil), "user.BanUserRequest")
+	proto.RegisterType((*UnbanUserRequest)(nil), "user.UnbanUserRequest")
+	proto.RegisterType((*GetActiveUserBanRequest)(nil), "user.GetActiveUserBanRequest")
+	proto.RegisterType((*ActiveUserBan)(nil), "user.ActiveUserBan")
+	proto.RegisterType((*RequestLinkCodeRequest)(nil), "user.RequestLinkCodeRequest")
+	proto.RegisterType((*LinkCode)(nil), "user.LinkCode")
+	proto.RegisterType((*RedeemLinkCodeRequest)(nil), "user.RedeemLinkCodeRequest")
+	proto.RegisterType((*GameLink)(nil), "user.GameLink")
+	proto.RegisterType((*GameLinks)(nil), "user.GameLinks")
 }
 
 func init() { proto.RegisterFile("manager.proto", fileDescriptor_cde9ec64f0d2c859) }
 
 var fileDescriptor_cde9ec64f0d2c859 = []byte{
-	// 646 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x5f, 0x6f, 0xd3, 0x3e,
-	0x14, 0x5d, 0x7f, 0xbf, 0x74, 0x5b, 0xef, 0x0a, 0x22, 0x66, 0xda, 0xb2, 0x80, 0xca, 0x14, 0x21,
-	0xe8, 0xcb, 0x92, 0xb2, 0x0e, 0x24, 0x78, 0x41, 0x2c, 0x5d, 0xcb, 0x1e, 0x8a, 0x50, 0xaa, 0x6a,
-	0x8f, 0xc8, 0x4d, 0xbd, 0xb6, 0x5a, 0x12, 0x87, 0xc4, 0x15, 0xda, 0x07, 0xe0, 0x85, 0x4f, 0x8d,
-	0x6c, 0xe7, 0x5f, 0x93, 0xb5, 0xdb, 0xc4, 0x9b, 0x7d, 0x7d, 0xcf, 0xf1, 0xf1, 0xbd, 0xe7, 0x1a,
-	0x9e, 0xf8, 0x38, 0xc0, 0x33, 0x12, 0x99, 0x61, 0x44, 0x19, 0x45, 0xca, 0x32, 0x26, 0x91, 0xfe,
-	0x62, 0x46, 0xe9, 0xcc, 0x23, 0x96, 0x88, 0x4d, 0x96, 0xd7, 0x16, 0xf1, 0x43, 0x76, 0x2b, 0x53,
-	0xf4, 0x0f, 0xb3, 0x05, 0x9b, 0x2f, 0x27, 0xa6, 0x4b, 0x7d, 0xeb, 0xfd, 0xbb, 0x98, 0x9d, 0xc4,
-	0x0c, 0x33, 0x62, 0xe1, 0x70, 0x61, 0x85, 0x37, 0x33, 0x2b, 0x9a, 0x60, 0x57, 0x02, 0x2d, 0x97,
-	0x06, 0x2c, 0xa2, 0x9e, 0xc4, 0x19, 0x7d, 0x50, 0xc6, 0x31, 0x89, 0x50, 0x0b, 0x94, 0xf1, 0xf8,
-	0xb2, 0xa7, 0xd5, 0x8e, 0x6b, 0xed, 0xbd, 0x53, 0x30, 0xf9, 0x8d, 0x26, 0x8f, 0x38, 0x22, 0xce,
-	0xcf, 0x7b, 0x98, 0x61, 0xed, 0xbf, 0xe2, 0x39, 0x8f, 0x38, 0x22, 0x6e, 0x74, 0x00, 0x5d, 0x06,
-	0x2e, 0xf5, 0x43, 0x8f, 0x30, 0xf2, 0x1d, 0xc7, 0xf1, 0x2f, 0x1a, 0x4d, 0x91, 0x0e, 0xbb, 0xe9,
-	0x5a, 0x30, 0x37, 0x9c, 0x6c, 0x6f, 0xbc, 0x81, 0x67, 0x76, 0x39, 0x1f, 0x81, 0xf2, 0x15, 0xc7,
-	0x73, 0x91, 0xdb, 0x74, 0xc4, 0xda, 0xd0, 0xa5, 0x32, 0x7e, 0x96, 0x29, 0x6c, 0x48, 0x55, 0xc6,
-	0x9f, 0x9a, 0x94, 0x85, 0x5e, 0x42, 0xe3, 0xca, 0xee, 0xf3, 0x97, 0x24, 0x19, 0x8a, 0x93, 0x07,
-	0xb8, 0x0c, 0xbe, 0x0a, 0xb0, 0x4f, 0xc4, 0x03, 0x1a, 0x4e, 0xb6, 0x47, 0xfb, 0x50, 0xbf, 0xf0,
-	0xf1, 0xc2, 0xd3, 0xfe, 0x17, 0x07, 0x72, 0xc3, 0x11, 0x03, 0xec, 0x13, 0x21, 0x46, 0x91, 0x88,
-	0x74, 0x8f, 0x0e, 0x60, 0xfb, 0x1c, 0x07, 0x01, 0x99, 0x6a, 0xf5, 0xe3, 0x5a, 0x7b, 0xd7, 0x49,
-	0x76, 0x46, 0x07, 0x9e, 0x0e, 0x08, 0xe3, 0xc4, 0x0e, 0xf9, 0xb9, 0x24, 0x31, 0xbb, 0xaf, 0xa8,
-	0x46, 0x17, 0x54, 0x3b, 0x22, 0x98, 0x91, 0x12, 0x48, 0x54, 0xba, 0xb6, 0xa6, 0xd2, 0x5d, 0x50,
-	0x7b, 0x84, 0x57, 0xed, 0x31, 0x37, 0x8d, 0x40, 0x1d, 0x87, 0x53, 0xfc, 0x28, 0xd0, 0xbd, 0x3d,
-	0x0f, 0x41, 0xb3, 0xe7, 0xc4, 0xbd, 0xe1, 0x9c, 0x69, 0x0b, 0x1f, 0xca, 0x7d, 0x56, 0x70, 0x86,
-	0xe4, 0xd7, 0x64, 0x4e, 0xd5, 0x45, 0x05, 0xcf, 0xc4, 0xb0, 0x97, 0x76, 0x35, 0xb8, 0xa6, 0xbc,
-	0x13, 0x2b, 0x2d, 0xdf, 0xfe, 0x97, 0x7e, 0x67, 0x72, 0x14, 0x61, 0xbe, 0xfc, 0xd2, 0xb7, 0xa0,
-	0x0e, 0x08, 0xbb, 0xb2, 0xfb, 0xfc, 0xce, 0xf4, 0x7d, 0x08, 0x94, 0x6f, 0x9c, 0x3e, 0x71, 0x23,
-	0x5f, 0x1b, 0x67, 0xd0, 0x4a, 0x0c, 0x70, 0x7e, 0xcb, 0xdd, 0x32, 0x22, 0xd1, 0x02, 0x7b, 0xdc,
-	0x33, 0x05, 0x54, 0xe6, 0xef, 0x46, 0xe2, 0xef, 0x8f, 0x70, 0x94, 0xa1, 0x32, 0xcb, 0xa6, 0x80,
-	0x8d, 0xbe, 0x36, 0x7e, 0xc0, 0xf3, 0x51, 0xe2, 0x38, 0xea, 0x91, 0xf8, 0xa1, 0xb5, 0x6f, 0x43,
-	0x5d, 0xe4, 0x27, 0x85, 0x47, 0x26, 0xff, 0x1d, 0xcc, 0x2f, 0xae, 0x4b, 0x97, 0x01, 0x93, 0x4c,
-	0x32, 0xe1, 0xf4, 0x77, 0x1d, 0x76, 0x86, 0xf2, 0x2b, 0x42, 0x27, 0xb0, 0x93, 0xe8, 0x44, 0xfb,
-	0x92, 0x72, 0xd5, 0xed, 0x7a, 0x7a, 0x51, 0x4c, 0x22, 0x63, 0x0b, 0x0d, 0xe1, 0x70, 0x4d, 0x31,
-	0xd0, 0xeb, 0x15, 0xf8, 0x9a, 0x5a, 0x95, 0xe8, 0x6c, 0x40, 0xd5, 0x2a, 0xa1, 0x57, 0x25, 0xa6,
-	0x72, 0xfd, 0x4a, 0x24, 0x5d, 0x80, 0x7c, 0xde, 0xd0, 0xa1, 0x3c, 0xab, 0x4c, 0x60, 0x09, 0xf4,
-	0x19, 0x20, 0x9f, 0xb7, 0x14, 0x54, 0x99, 0x40, 0xfd, 0xc0, 0x94, 0xdf, 0xb3, 0x99, 0x7e, 0xcf,
-	0xe6, 0x05, 0xff, 0x9e, 0x25, 0x41, 0x3e, 0x7b, 0x29, 0x41, 0x65, 0x1a, 0x37, 0x10, 0x0c, 0x41,
-	0xad, 0xcc, 0x19, 0x6a, 0x25, 0xea, 0xd7, 0x0c, 0xe0, 0x06, 0xba, 0x4f, 0x00, 0xb9, 0x9f, 0x53,
-	0x3d, 0x15, 0x87, 0xeb, 0xaa, 0x3c, 0x28, 0xcc, 0x9b, 0xb1, 0x85, 0x3a, 0xd0, 0x1c, 0x14, 0x1c,
-	0x87, 0x0a, 0xe6, 0xd2, 0xef, 0xf0, 0x91, 0x68, 0x5c, 0xb3, 0xe8, 0x51, 0x74, 0x24, 0x11, 0x77,
-	0xf8, 0x76, 0xbd, 0xe4, 0xc9, 0xb6, 0x88, 0x74, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x52,
-	0x04, 0x42, 0x18, 0x07, 0x00, 0x00,
+	// 1184 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4b, 0x53, 0x1b, 0x47,
+	0x10, 0xd6, 0xea, 0x01, 0x6c, 0x83, 0x65, 0x34, 0x26, 0x78, 0xbd, 0x60, 0xa2, 0x9a, 0x4a, 0x6c,
+	0x52, 0x65, 0x24, 0x02, 0x4e, 0xaa, 0x9c, 0x97, 0x83, 0xc4, 0xb3, 0x62, 0x12, 0xb2, 0x94, 0x8a,
+	0x63, 0x6a, 0x90, 0xc6, 0x42, 0x41, 0xda, 0x55, 0x76, 0x46, 0x4e, 0xb8, 0xe7, 0x94, 0xff, 0x99,
+	0x43, 0xfe, 0x45, 0x6a, 0x5e, 0xfb, 0x14, 0x02, 0x7c, 0xda, 0xed, 0x9e, 0xe9, 0xaf, 0x7b, 0x7a,
+	0xbe, 0xee, 0x1e, 0x78, 0x34, 0x22, 0x3e, 0xe9, 0xd3, 0xb0, 0x31, 0x0e, 0x03, 0x1e, 0xa0, 0xf2,
+	0x84, 0xd1, 0xd0, 0x5d, 0xeb, 0x07, 0x41, 0x7f, 0x48, 0x9b, 0x52, 0x77, 0x39, 0x79, 0xdf, 0xa4,
+	0xa3, 0x31, 0xbf, 0x51, 0x5b, 0xdc, 0xaf, 0xfb, 0x03, 0x7e, 0x35, 0xb9, 0x6c, 0x74, 0x83, 0x51,
+	0xf3, 0xab, 0x2f, 0x19, 0xdf, 0x62, 0x9c, 0x70, 0xda, 0x24, 0xe3, 0x41, 0x73, 0x7c, 0xdd, 0x6f,
+	0x86, 0x97, 0xa4, 0xab, 0x0c, 0x9b, 0xdd, 0xc0, 0xe7, 0x61, 0x30, 0x54, 0x76, 0xf8, 0x10, 0xca,
+	0x1d, 0x46, 0x43, 0xb4, 0x01, 0xe5, 0x4e, 0xe7, 0x64, 0xdf, 0xb1, 0xea, 0xd6, 0xe6, 0xe2, 0x0e,
+	0x34, 0x84, 0xc7, 0x86, 0xd0, 0x78, 0x52, 0x2f, 0xd6, 0xf7, 0x09, 0x27, 0x4e, 0x31, 0xb9, 0x2e,
+	0x34, 0x9e, 0xd4, 0xe3, 0x6d, 0x40, 0x27, 0x7e, 0x37, 0x18, 0x8d, 0x87, 0x94, 0xd3, 0x33, 0xc2,
+	0xd8, 0x9f, 0x41, 0xd8, 0x43, 0x2e, 0x2c, 0x98, 0x7f, 0x89, 0x6c, 0x7b, 0x91, 0x8c, 0x5f, 0xc0,
+	0x72, 0x3b, 0xbb, 0x1f, 0x41, 0xf9, 0x98, 0xb0, 0x2b, 0xb9, 0x77, 0xc9, 0x93, 0xff, 0xd8, 0x55,
+	0x91, 0x21, 0xa4, 0xbe, 0x1a, 0x47, 0xfe, 0xe3, 0x7f, 0x2c, 0x15, 0x16, 0x5a, 0x07, 0xfb, 0xa2,
+	0x7d, 0x28, 0x4e, 0xa2, 0x77, 0x94, 0xbd, 0x58, 0x21, 0xc2, 0x10, 0x7f, 0x3e, 0x19, 0x51, 0x79,
+	0x00, 0xdb, 0x8b, 0x64, 0xb4, 0x02, 0x95, 0x83, 0x11, 0x19, 0x0c, 0x9d, 0x92, 0x5c, 0x50, 0x82,
+	0xb0, 0x38, 0x22, 0x23, 0x2a, 0x83, 0x29, 0x2b, 0x0b, 0x23, 0xa3, 0x55, 0x98, 0x6b, 0x11, 0xdf,
+	0xa7, 0x3d, 0xa7, 0x52, 0xb7, 0x36, 0x17, 0x3c, 0x2d, 0xe1, 0x6d, 0xa8, 0x1e, 0x51, 0x2e, 0x80,
+	0x3d, 0xfa, 0xc7, 0x84, 0x32, 0x7e, 0x57, 0x52, 0xf1, 0x2e, 0xd4, 0xda, 0x21, 0x25, 0x9c, 0x66,
+	0x8c, 0x64, 0xa6, 0xad, 0x5b, 0x32, 0xbd, 0x0b, 0xb5, 0x7d, 0x3a, 0xa4, 0x39, 0xa3, 0x99, 0x9e,
+	0xce, 0xa1, 0xd6, 0x19, 0xf7, 0xc8, 0x83, 0x8c, 0xee, 0xbc, 0xf3, 0x31, 0x38, 0xed, 0x2b, 0xda,
+	0xbd, 0x16, 0x98, 0xe6, 0x0a, 0xef, 0x8b, 0xfd, 0x3a, 0xc1, 0x0c, 0x85, 0xef, 0xa8, 0x3d, 0x79,
+	0x16, 0x25, 0x38, 0x73, 0x01, 0x8b, 0xe6, 0x56, 0xfd, 0xf7, 0x81, 0xb8, 0x89, 0xd4, 0x95, 0xcf,
+	0x7d, 0xec, 0x7d, 0xe3, 0x97, 0x50, 0x3b, 0xa2, 0xfc, 0xa2, 0x7d, 0x28, 0x70, 0xcd, 0x19, 0x10,
+	0x94, 0x7f, 0x16, 0x10, 0x9a, 0x71, 0xe2, 0x1f, 0xbf, 0x86, 0x0d, 0x7d, 0xc9, 0xad, 0x1b, 0xc1,
+	0x88, 0x73, 0x1a, 0x0e, 0xc8, 0x50, 0xf0, 0x22, 0x61, 0x15, 0x71, 0xd8, 0xd6, 0x1c, 0x7e, 0x03,
+	0xcf, 0x22, 0xab, 0x88, 0x96, 0xc6, 0x60, 0x26, 0x77, 0xf1, 0x6f, 0xf0, 0xe4, 0x5c, 0xb3, 0x2a,
+	0x18, 0x52, 0x76, 0xdf, 0xfc, 0x6e, 0x42, 0x45, 0xee, 0xd7, 0xc9, 0x45, 0x0d, 0xd1, 0x01, 0x1a,
+	0x7b, 0xdd, 0x6e, 0x30, 0xf1, 0xb9, 0x42, 0x52, 0x1b, 0xf0, 0xdf, 0x16, 0x2c, 0xbf, 0x1b, 0x30,
+	0xe9, 0x22, 0x82, 0x5f, 0x81, 0xca, 0xaf, 0x13, 0x1a, 0xde, 0xe8, 0x53, 0x28, 0x01, 0x61, 0x58,
+	0x3a, 0x1c, 0x0c, 0x39, 0x0d, 0x35, 0xff, 0x8b, 0x92, 0xff, 0x29, 0x5d, 0xa2, 0x3a, 0x4a, 0xc9,
+	0xea, 0x10, 0x01, 0x9f, 0x91, 0x3e, 0x75, 0xca, 0x3a, 0x60, 0x19, 0x8f, 0xd0, 0x78, 0x52, 0x8f,
+	0xbf, 0x80, 0x8a, 0x8c, 0x00, 0xd5, 0xf5, 0x8f, 0x63, 0xd5, 0x4b, 0x89, 0xa3, 0x89, 0x04, 0xa8,
+	0x05, 0xfc, 0x9f, 0x05, 0xa5, 0x16, 0xf1, 0x51, 0x15, 0x8a, 0x51, 0x3f, 0x28, 0xc6, 0xd7, 0x2e,
+	0xf3, 0x92, 0xb8, 0x76, 0x99, 0x8f, 0x55, 0x98, 0xf3, 0x28, 0x61, 0x81, 0xaf, 0xef, 0x5d, 0x4b,
+	0x42, 0x7f, 0xc2, 0xd8, 0x84, 0x86, 0xba, 0xcc, 0xb5, 0x24, 0xf4, 0xe7, 0xdd, 0x60, 0x4c, 0x99,
+	0x53, 0xa9, 0x97, 0x84, 0x5e, 0x49, 0xc2, 0xc7, 0x39, 0x27, 0x21, 0x67, 0x7b, 0xdc, 0x99, 0xab,
+	0x5b, 0x9b, 0x25, 0x2f, 0x92, 0x85, 0xcd, 0x81, 0xdf, 0x13, 0x2b, 0xf3, 0x72, 0x45, 0x4b, 0xe2,
+	0x82, 0x3d, 0xfa, 0x21, 0xb8, 0xa6, 0xbd, 0x3d, 0xee, 0x2c, 0xc8, 0xa5, 0x58, 0x91, 0x58, 0x6d,
+	0xdd, 0x38, 0xb6, 0x0c, 0x22, 0x56, 0xe0, 0xcf, 0xa1, 0xdc, 0x22, 0x3e, 0x43, 0xcf, 0xd5, 0x57,
+	0x27, 0xc5, 0x56, 0x49, 0x69, 0x11, 0xdf, 0x93, 0x6a, 0x7c, 0x0a, 0xd5, 0x16, 0xf1, 0x1f, 0x52,
+	0xdc, 0x6b, 0x32, 0x87, 0x9a, 0x1e, 0x09, 0x3c, 0xa1, 0xc5, 0xc7, 0xb0, 0xdc, 0xf1, 0x2f, 0x1f,
+	0x06, 0xb8, 0x02, 0x95, 0x16, 0xf1, 0xa3, 0xd4, 0x2b, 0x01, 0xff, 0x02, 0x4f, 0x8f, 0x28, 0xdf,
+	0xeb, 0xf2, 0xc1, 0x07, 0xd9, 0x7b, 0x84, 0x8b, 0xfb, 0x03, 0xca, 0xa4, 0x1b, 0x40, 0x29, 0xe0,
+	0x7d, 0x78, 0x94, 0x42, 0x4b, 0x10, 0xce, 0x4a, 0x11, 0x6e, 0xe6, 0x01, 0x7f, 0x84, 0x55, 0x1d,
+	0xc6, 0xbb, 0x81, 0x7f, 0xdd, 0x0e, 0x7a, 0xd4, 0x44, 0xf5, 0x02, 0xaa, 0xe9, 0xba, 0xd6, 0x04,
+	0xcb, 0x68, 0xf1, 0x77, 0xb0, 0x60, 0x4c, 0x45, 0xc9, 0x8b, 0xaf, 0x29, 0x79, 0xa9, 0x5b, 0x07,
+	0xfb, 0xe0, 0xaf, 0xf1, 0x20, 0xa4, 0x82, 0x0f, 0x45, 0x75, 0xe9, 0x91, 0x02, 0xff, 0x04, 0x9f,
+	0x78, 0xb4, 0x47, 0xe9, 0x28, 0xeb, 0xfe, 0xae, 0xa4, 0x18, 0x57, 0xc5, 0xd8, 0x15, 0xfe, 0x5d,
+	0x0d, 0x2b, 0x01, 0x95, 0xaa, 0x01, 0x2b, 0x53, 0x03, 0xf9, 0xa3, 0x15, 0xa7, 0x1d, 0x4d, 0x60,
+	0x08, 0x2c, 0x49, 0xd7, 0x92, 0xe2, 0xb8, 0x91, 0xf1, 0x1b, 0xb0, 0x8d, 0x2f, 0x86, 0x5e, 0x25,
+	0x04, 0xcd, 0xcc, 0xaa, 0x8a, 0xd8, 0xa8, 0xbd, 0x78, 0xc3, 0xce, 0xbf, 0x36, 0xcc, 0x9f, 0xaa,
+	0x77, 0x0d, 0xda, 0x82, 0x79, 0xdd, 0x10, 0xd1, 0x8a, 0xb6, 0x48, 0x8d, 0x4e, 0x37, 0x51, 0xf6,
+	0xb8, 0x80, 0x4e, 0x25, 0x8b, 0xa6, 0x75, 0x5d, 0xf4, 0x59, 0xca, 0xfc, 0x96, 0xa6, 0x9c, 0x81,
+	0x6b, 0x03, 0xca, 0xb7, 0x63, 0xf4, 0x69, 0x06, 0x29, 0xdb, 0xa8, 0x33, 0x20, 0x3b, 0x60, 0x47,
+	0x6d, 0x13, 0xad, 0xaa, 0xa5, 0x6c, 0x1f, 0x75, 0x17, 0x63, 0x13, 0x86, 0x0b, 0x68, 0x17, 0x20,
+	0x1e, 0xf8, 0xe8, 0xa9, 0x5a, 0xcc, 0x3d, 0x01, 0x32, 0x8e, 0xde, 0x02, 0xc4, 0x03, 0xdf, 0x18,
+	0xe5, 0x9e, 0x00, 0xee, 0x6a, 0x43, 0xbd, 0x0f, 0x1b, 0xe6, 0x7d, 0xd8, 0x38, 0x10, 0xef, 0x43,
+	0x5c, 0x40, 0x2f, 0x61, 0xd1, 0xa3, 0x8c, 0x07, 0xa1, 0x42, 0x48, 0x90, 0x2a, 0xe3, 0xe9, 0x07,
+	0x80, 0xb3, 0x49, 0xd8, 0xa7, 0xe6, 0x4c, 0x53, 0x01, 0x67, 0x38, 0x7a, 0x0b, 0x10, 0xbf, 0x32,
+	0x4c, 0xa4, 0xb9, 0x77, 0xc7, 0x0c, 0x80, 0x53, 0xa8, 0xe5, 0x5e, 0x14, 0x68, 0x43, 0xa7, 0xe9,
+	0x96, 0xa7, 0xc6, 0x0c, 0xb8, 0x6f, 0x00, 0xe2, 0xa9, 0x6e, 0xe2, 0xc9, 0xcd, 0x79, 0xb7, 0xa6,
+	0x16, 0x12, 0x2f, 0x0b, 0x5c, 0x40, 0xdb, 0xb0, 0x74, 0x94, 0x98, 0xbb, 0xa9, 0xac, 0x4d, 0x99,
+	0xa6, 0x92, 0x55, 0x4b, 0xc9, 0x49, 0x8d, 0x9e, 0x29, 0x8b, 0x29, 0xd3, 0x7b, 0xf6, 0x5d, 0x19,
+	0x02, 0x12, 0x3f, 0xed, 0x15, 0xa2, 0x1e, 0x26, 0xbc, 0x1d, 0xc3, 0x72, 0xb6, 0xb1, 0xa2, 0xe7,
+	0xd1, 0x09, 0xa7, 0x35, 0x5c, 0xf7, 0x89, 0x5a, 0x4e, 0xad, 0xe1, 0x02, 0x7a, 0x05, 0xf3, 0x7a,
+	0x76, 0x98, 0x5a, 0x4c, 0x8f, 0x12, 0x37, 0x6e, 0x9e, 0xb8, 0x80, 0xbe, 0x07, 0x3b, 0x1a, 0x0d,
+	0x86, 0xf6, 0xd9, 0x59, 0x31, 0xe3, 0x7c, 0x7b, 0xf0, 0x38, 0xd3, 0x78, 0xd1, 0xba, 0x02, 0x99,
+	0xde, 0x8f, 0xdd, 0xaa, 0xa9, 0x2c, 0xa5, 0xc6, 0x05, 0xf4, 0x2d, 0x54, 0xd3, 0xbd, 0x13, 0xad,
+	0x19, 0x84, 0x29, 0x1d, 0x35, 0x57, 0xb5, 0xd0, 0xf1, 0x87, 0x03, 0xff, 0x5a, 0xf4, 0x89, 0x54,
+	0x7a, 0x6f, 0x8f, 0x79, 0x4b, 0x52, 0x21, 0x6e, 0x7b, 0x49, 0xab, 0xc7, 0xe9, 0x7e, 0xc7, 0x70,
+	0xe1, 0x72, 0x4e, 0x02, 0xec, 0xfe, 0x3f, 0x00, 0x7b, 0x5c, 0xdd, 0xfe, 0xc5, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -777,13 +1474,24 @@ type ManagerClient interface {
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error)
 	GetUserByGameSerialHash(ctx context.Context, in *GetUserByGameSerialHashRequest, opts ...grpc.CallOption) (*User, error)
 	GetUserByWCFUserID(ctx context.Context, in *GetUserByWCFUserIDRequest, opts ...grpc.CallOption) (*User, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*Users, error)
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RestoreUser(ctx context.Context, in *UUID, opts ...grpc.CallOption) (*User, error)
+	PurgeUsers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	CheckUserPassword(ctx context.Context, in *CheckUserPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	GetWCFInfo(ctx context.Context, in *GetWCFInfoRequest, opts ...grpc.CallOption) (*WCFUserInfo, error)
 	GetUserRoles(ctx context.Context, in *UUID, opts ...grpc.CallOption) (*proto1.AccountRoles, error)
 	SetUserRoles(ctx context.Context, in *SetUserRolesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetUserBans(ctx context.Context, in *UUID, opts ...grpc.CallOption) (*Bans, error)
+	GetActiveUserBan(ctx context.Context, in *GetActiveUserBanRequest, opts ...grpc.CallOption) (*ActiveUserBan, error)
+	BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*Ban, error)
+	UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RequestLinkCode(ctx context.Context, in *RequestLinkCodeRequest, opts ...grpc.CallOption) (*LinkCode, error)
+	RedeemLinkCode(ctx context.Context, in *RedeemLinkCodeRequest, opts ...grpc.CallOption) (*User, error)
+	UnlinkGame(ctx context.Context, in *UUID, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetGameLinks(ctx context.Context, in *UUID, opts ...grpc.CallOption) (*GameLinks, error)
 }
 
 type managerClient struct {
@@ -821,6 +1529,15 @@ func (c *managerClient) GetUserByWCFUserID(ctx context.Context, in *GetUserByWCF
 	return out, nil
 }
 
+func (c *managerClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*Users, error) {
+	out := new(Users)
+	err := c.cc.Invoke(ctx, "/user.Manager/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managerClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
 	out := new(User)
 	err := c.cc.Invoke(ctx, "/user.Manager/CreateUser", in, out, opts...)
@@ -839,6 +1556,24 @@ func (c *managerClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, o
 	return out, nil
 }
 
+func (c *managerClient) RestoreUser(ctx context.Context, in *UUID, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/user.Manager/RestoreUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) PurgeUsers(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/user.Manager/PurgeUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managerClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/user.Manager/UpdateUser", in, out, opts...)
@@ -884,18 +1619,101 @@ func (c *managerClient) SetUserRoles(ctx context.Context, in *SetUserRolesReques
 	return out, nil
 }
 
+func (c *managerClient) GetUserBans(ctx context.Context, in *UUID, opts ...grpc.CallOption) (*Bans, error) {
+	out := new(Bans)
+	err := c.cc.Invoke(ctx, "/user.Manager/GetUserBans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetActiveUserBan(ctx context.Context, in *GetActiveUserBanRequest, opts ...grpc.CallOption) (*ActiveUserBan, error) {
+	out := new(ActiveUserBan)
+	err := c.cc.Invoke(ctx, "/user.Manager/GetActiveUserBan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) BanUser(ctx context.Context, in *BanUserRequest, opts ...grpc.CallOption) (*Ban, error) {
+	out := new(Ban)
+	err := c.cc.Invoke(ctx, "/user.Manager/BanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) UnbanUser(ctx context.Context, in *UnbanUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/user.Manager/UnbanUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) RequestLinkCode(ctx context.Context, in *RequestLinkCodeRequest, opts ...grpc.CallOption) (*LinkCode, error) {
+	out := new(LinkCode)
+	err := c.cc.Invoke(ctx, "/user.Manager/RequestLinkCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) RedeemLinkCode(ctx context.Context, in *RedeemLinkCodeRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, "/user.Manager/RedeemLinkCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) UnlinkGame(ctx context.Context, in *UUID, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/user.Manager/UnlinkGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerClient) GetGameLinks(ctx context.Context, in *UUID, opts ...grpc.CallOption) (*GameLinks, error) {
+	out := new(GameLinks)
+	err := c.cc.Invoke(ctx, "/user.Manager/GetGameLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ManagerServer is the server API for Manager service.
 type ManagerServer interface {
 	GetUser(context.Context, *GetUserRequest) (*User, error)
 	GetUserByGameSerialHash(context.Context, *GetUserByGameSerialHashRequest) (*User, error)
 	GetUserByWCFUserID(context.Context, *GetUserByWCFUserIDRequest) (*User, error)
+	ListUsers(context.Context, *ListUsersRequest) (*Users, error)
 	CreateUser(context.Context, *CreateUserRequest) (*User, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*empty.Empty, error)
+	RestoreUser(context.Context, *UUID) (*User, error)
+	PurgeUsers(context.Context, *empty.Empty) (*empty.Empty, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*empty.Empty, error)
 	CheckUserPassword(context.Context, *CheckUserPasswordRequest) (*empty.Empty, error)
 	GetWCFInfo(context.Context, *GetWCFInfoRequest) (*WCFUserInfo, error)
 	GetUserRoles(context.Context, *UUID) (*proto1.AccountRoles, error)
 	SetUserRoles(context.Context, *SetUserRolesRequest) (*empty.Empty, error)
+	GetUserBans(context.Context, *UUID) (*Bans, error)
+	GetActiveUserBan(context.Context, *GetActiveUserBanRequest) (*ActiveUserBan, error)
+	BanUser(context.Context, *BanUserRequest) (*Ban, error)
+	UnbanUser(context.Context, *UnbanUserRequest) (*empty.Empty, error)
+	RequestLinkCode(context.Context, *RequestLinkCodeRequest) (*LinkCode, error)
+	RedeemLinkCode(context.Context, *RedeemLinkCodeRequest) (*User, error)
+	UnlinkGame(context.Context, *UUID) (*empty.Empty, error)
+	GetGameLinks(context.Context, *UUID) (*GameLinks, error)
 }
 
 func RegisterManagerServer(s *grpc.Server, srv ManagerServer) {
@@ -956,6 +1774,24 @@ func _Manager_GetUserByWCFUserID_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Manager_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.Manager/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Manager_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUserRequest)
 	if err := dec(in); err != nil {
@@ -992,6 +1828,42 @@ func _Manager_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Manager_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.Manager/RestoreUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).RestoreUser(ctx, req.(*UUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_PurgeUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).PurgeUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.Manager/PurgeUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).PurgeUsers(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Manager_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateUserRequest)
 	if err := dec(in); err != nil {
@@ -1082,6 +1954,150 @@ func _Manager_SetUserRoles_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Manager_GetUserBans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetUserBans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.Manager/GetUserBans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetUserBans(ctx, req.(*UUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetActiveUserBan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActiveUserBanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetActiveUserBan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.Manager/GetActiveUserBan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetActiveUserBan(ctx, req.(*GetActiveUserBanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_BanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).BanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.Manager/BanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).BanUser(ctx, req.(*BanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_UnbanUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).UnbanUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.Manager/UnbanUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).UnbanUser(ctx, req.(*UnbanUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_RequestLinkCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestLinkCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).RequestLinkCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.Manager/RequestLinkCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).RequestLinkCode(ctx, req.(*RequestLinkCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_RedeemLinkCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemLinkCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).RedeemLinkCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.Manager/RedeemLinkCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).RedeemLinkCode(ctx, req.(*RedeemLinkCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_UnlinkGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).UnlinkGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.Manager/UnlinkGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).UnlinkGame(ctx, req.(*UUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Manager_GetGameLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UUID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServer).GetGameLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.Manager/GetGameLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServer).GetGameLinks(ctx, req.(*UUID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Manager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "user.Manager",
 	HandlerType: (*ManagerServer)(nil),
@@ -1098,6 +2114,10 @@ var _Manager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserByWCFUserID",
 			Handler:    _Manager_GetUserByWCFUserID_Handler,
 		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _Manager_ListUsers_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _Manager_CreateUser_Handler,
@@ -1106,6 +2126,14 @@ var _Manager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _Manager_DeleteUser_Handler,
 		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _Manager_RestoreUser_Handler,
+		},
+		{
+			MethodName: "PurgeUsers",
+			Handler:    _Manager_PurgeUsers_Handler,
+		},
 		{
 			MethodName: "UpdateUser",
 			Handler:    _Manager_UpdateUser_Handler,
@@ -1126,6 +2154,38 @@ var _Manager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetUserRoles",
 			Handler:    _Manager_SetUserRoles_Handler,
 		},
+		{
+			MethodName: "GetUserBans",
+			Handler:    _Manager_GetUserBans_Handler,
+		},
+		{
+			MethodName: "GetActiveUserBan",
+			Handler:    _Manager_GetActiveUserBan_Handler,
+		},
+		{
+			MethodName: "BanUser",
+			Handler:    _Manager_BanUser_Handler,
+		},
+		{
+			MethodName: "UnbanUser",
+			Handler:    _Manager_UnbanUser_Handler,
+		},
+		{
+			MethodName: "RequestLinkCode",
+			Handler:    _Manager_RequestLinkCode_Handler,
+		},
+		{
+			MethodName: "RedeemLinkCode",
+			Handler:    _Manager_RedeemLinkCode_Handler,
+		},
+		{
+			MethodName: "UnlinkGame",
+			Handler:    _Manager_UnlinkGame_Handler,
+		},
+		{
+			MethodName: "GetGameLinks",
+			Handler:    _Manager_GetGameLinks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "manager.proto",
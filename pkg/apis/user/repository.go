@@ -3,9 +3,19 @@ package user
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/51st-state/api/pkg/pagination"
+	"github.com/51st-state/api/pkg/rbac"
 )
 
-// Repository of user objects
+// ErrDuplicateGameSerialHash is returned by a repository if the game serial hash is linked to another user
+var ErrDuplicateGameSerialHash = errors.New("duplicate game serial hash")
+
+// Repository of user objects.
+// Soft deleted users are not returned by the lookups and are not updated,
+// but keep their wcf user id and game serial hash until they are deleted.
 //go:generate counterfeiter -o ./mocks/repository.go . Repository
 type Repository interface {
 	Get(context.Context, Identifier) (Complete, error)
@@ -13,7 +23,29 @@ type Repository interface {
 	GetByWCFUserID(context.Context, WCFUserID) (Complete, error)
 	Create(context.Context, Incomplete) (Complete, error)
 	Update(context.Context, Complete) error
+	// Delete a user including its bans and game links, even if it is soft deleted
 	Delete(context.Context, Identifier) error
+	// SoftDelete a user, sql.ErrNoRows is returned if there is no such user
+	SoftDelete(ctx context.Context, id Identifier, at time.Time) error
+	// Restore a soft deleted user, sql.ErrNoRows is returned if there is no such deleted user
+	Restore(context.Context, Identifier) error
+	GetDeletion(context.Context, Identifier) (*Deletion, error)
+	GetDeletionByWCFUserID(context.Context, WCFUserID) (*Deletion, error)
+	// GetDeletions of users deleted before a time ordered by the time they were deleted
+	GetDeletions(ctx context.Context, before time.Time) ([]*Deletion, error)
+	// List users ordered by their uuid. The banned flag of the listed users
+	// already includes their active bans.
+	List(context.Context, *ListOptions, pagination.Page) ([]Complete, error)
+	GetBan(ctx context.Context, banID string) (*Ban, error)
+	GetBans(context.Context, Identifier) ([]*Ban, error)
+	AddBan(context.Context, *Ban) (*Ban, error)
+	RevokeBan(ctx context.Context, banID string, by rbac.AccountID, at time.Time) error
+	AddLinkCode(context.Context, *LinkCode) error
+	// TakeLinkCode removes a link code and returns it, so that it can only be redeemed once
+	TakeLinkCode(ctx context.Context, code string) (*LinkCode, error)
+	AddGameLink(context.Context, *GameLink) error
+	// GetGameLinks of a user ordered by the time they were linked
+	GetGameLinks(context.Context, Identifier) ([]*GameLink, error)
 }
 
 // WCFUserID of the user existing in the Woltlab Community Framwork database
@@ -36,7 +68,6 @@ type WCFUserInfo struct {
 	UserID   WCFUserID
 	Username string
 	Email    string
-	Password CompletePassword
 }
 
 // WCFRepository of the Woltlab Community Framwork database
@@ -45,4 +76,11 @@ type WCFRepository interface {
 	GetInfo(context.Context, WCFUserID) (*WCFUserInfo, error)
 	GetInfoByEmail(context.Context, string) (*WCFUserInfo, error)
 	GetInfoByUsername(context.Context, string) (*WCFUserInfo, error)
+	// GetPassword returns the password hash of a wcf user,
+	// it is kept out of the infos so that it is never cached
+	GetPassword(context.Context, WCFUserID) (CompletePassword, error)
+	// GetInfoMany returns the infos of the known users in a single query
+	GetInfoMany(context.Context, []WCFUserID) (map[WCFUserID]*WCFUserInfo, error)
+	// SearchUserIDs of users with a username or email containing the query
+	SearchUserIDs(ctx context.Context, query string, limit int) ([]WCFUserID, error)
 }
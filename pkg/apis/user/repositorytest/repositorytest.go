@@ -0,0 +1,671 @@
+// Package repositorytest contains the conformance tests of user repositories
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/pagination"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) user.Repository) {
+	t.Run("Get", func(t *testing.T) {
+		testGet(t, newRepository(t))
+	})
+	t.Run("Unique", func(t *testing.T) {
+		testUnique(t, newRepository(t))
+	})
+	t.Run("Update", func(t *testing.T) {
+		testUpdate(t, newRepository(t))
+	})
+	t.Run("Delete", func(t *testing.T) {
+		testDelete(t, newRepository(t))
+	})
+	t.Run("SoftDelete", func(t *testing.T) {
+		testSoftDelete(t, newRepository(t))
+	})
+	t.Run("List", func(t *testing.T) {
+		testList(t, newRepository(t))
+	})
+	t.Run("Bans", func(t *testing.T) {
+		testBans(t, newRepository(t))
+	})
+	t.Run("LinkCodes", func(t *testing.T) {
+		testLinkCodes(t, newRepository(t))
+	})
+	t.Run("GameLinks", func(t *testing.T) {
+		testGameLinks(t, newRepository(t))
+	})
+}
+
+type identifier struct {
+	uuid string
+}
+
+func (i *identifier) UUID() string {
+	return i.uuid
+}
+
+func randomIdentifier(t *testing.T) user.Identifier {
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return &identifier{rand.String()}
+}
+
+type complete struct {
+	user.Identifier
+	user.Incomplete
+}
+
+func testGet(t *testing.T, r user.Repository) {
+	ctx := context.Background()
+
+	if _, err := r.Get(ctx, randomIdentifier(t)); err != sql.ErrNoRows {
+		t.Fatal("an unknown user should not be found")
+	}
+
+	if _, err := r.GetByWCFUserID(ctx, 1); err != sql.ErrNoRows {
+		t.Fatal("an unknown wcf user id should not be found")
+	}
+
+	if _, err := r.GetByGameSerialHash(ctx, "hash"); err != sql.ErrNoRows {
+		t.Fatal("an unknown game serial hash should not be found")
+	}
+
+	c, err := r.Create(ctx, user.NewIncomplete(1, "username", "email", "hash", true))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.UUID() == "" {
+		t.Fatal("the created user should have an id")
+	}
+
+	for _, get := range []func() (user.Complete, error){
+		func() (user.Complete, error) { return r.Get(ctx, c) },
+		func() (user.Complete, error) { return r.GetByWCFUserID(ctx, 1) },
+		func() (user.Complete, error) { return r.GetByGameSerialHash(ctx, "hash") },
+	} {
+		got, err := get()
+		if err != nil {
+			t.Fatal("there should be no error")
+		}
+
+		if got.UUID() != c.UUID() {
+			t.Fatal("the ids are not equal")
+		}
+
+		if got.Data().WCFUserID != 1 || got.Data().GameSerialHash != "hash" || !got.Data().Banned {
+			t.Fatal("the stored data is not equal")
+		}
+
+		if got.Data().WCFUsername != "" || got.Data().WCFEmail != "" {
+			t.Fatal("the wcf username and email should not be stored")
+		}
+	}
+}
+
+func testUnique(t *testing.T, r user.Repository) {
+	ctx := context.Background()
+
+	if _, err := r.Create(ctx, user.NewIncomplete(1, "", "", "hash", false)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Create(ctx, user.NewIncomplete(1, "", "", "other", false)); err == nil {
+		t.Fatal("the wcf user id is already used")
+	}
+
+	if _, err := r.Create(ctx, user.NewIncomplete(2, "", "", "hash", false)); err == nil {
+		t.Fatal("the game serial hash is already used")
+	}
+
+	if _, err := r.Create(ctx, user.NewIncomplete(2, "", "", "other", false)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Create(ctx, user.NewIncomplete(3, "", "", "", false)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Create(ctx, user.NewIncomplete(4, "", "", "", false)); err != nil {
+		t.Fatal("users without a linked game account should not conflict")
+	}
+
+	c, err := r.Create(ctx, user.NewIncomplete(5, "", "", "", false))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Update(ctx, c); err != nil {
+		t.Fatal("users without a linked game account should not conflict")
+	}
+
+	stored, err := r.Get(ctx, c)
+	if err != nil || stored.Data().GameSerialHash != "" {
+		t.Fatal("the game serial hash should be empty")
+	}
+
+	c.Data().GameSerialHash = "hash"
+	if err := r.Update(ctx, c); err != user.ErrDuplicateGameSerialHash {
+		t.Fatal("the game serial hash is already linked to another user")
+	}
+}
+
+func testUpdate(t *testing.T, r user.Repository) {
+	ctx := context.Background()
+
+	first, err := r.Create(ctx, user.NewIncomplete(1, "", "", "first", false))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	second, err := r.Create(ctx, user.NewIncomplete(2, "", "", "second", false))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Update(ctx, &complete{
+		first,
+		user.NewIncomplete(3, "", "", "third", true),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	c, err := r.Get(ctx, first)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Data().WCFUserID != 3 || c.Data().GameSerialHash != "third" || !c.Data().Banned {
+		t.Fatal("the user should be updated")
+	}
+
+	if err := r.Update(ctx, &complete{
+		second,
+		user.NewIncomplete(3, "", "", "second", false),
+	}); err == nil {
+		t.Fatal("the wcf user id is already used")
+	}
+
+	c, err = r.Get(ctx, second)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Data().WCFUserID != 2 {
+		t.Fatal("a failed update should not change the user")
+	}
+
+	if err := r.Update(ctx, &complete{
+		randomIdentifier(t),
+		user.NewIncomplete(4, "", "", "fourth", false),
+	}); err != nil {
+		t.Fatal("updating an unknown user should not return an error")
+	}
+
+	if _, err := r.GetByWCFUserID(ctx, 4); err != sql.ErrNoRows {
+		t.Fatal("updating an unknown user should not create it")
+	}
+}
+
+func testDelete(t *testing.T, r user.Repository) {
+	ctx := context.Background()
+
+	c, err := r.Create(ctx, user.NewIncomplete(1, "", "", "hash", false))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	other, err := r.Create(ctx, user.NewIncomplete(2, "", "", "other", false))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	for _, v := range []user.Identifier{c, other} {
+		if _, err := r.AddBan(ctx, &user.Ban{
+			UserUUID: v.UUID(),
+			Reason:   "cheating",
+			Scopes:   []user.BanScope{user.BanScopeGame},
+			StartsAt: time.Now().UTC().Truncate(time.Second),
+		}); err != nil {
+			t.Fatal("there should be no error")
+		}
+
+		if err := r.AddGameLink(ctx, &user.GameLink{
+			UserUUID:       v.UUID(),
+			GameSerialHash: v.UUID(),
+			LinkedAt:       time.Now().UTC().Truncate(time.Second),
+		}); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	if err := r.Delete(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Get(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("the user should be deleted")
+	}
+
+	bans, err := r.GetBans(ctx, c)
+	if err != nil || len(bans) != 0 {
+		t.Fatal("the bans of the user should be deleted")
+	}
+
+	links, err := r.GetGameLinks(ctx, c)
+	if err != nil || len(links) != 0 {
+		t.Fatal("the game links of the user should be deleted")
+	}
+
+	bans, err = r.GetBans(ctx, other)
+	if err != nil || len(bans) != 1 {
+		t.Fatal("the bans of other users should be kept")
+	}
+
+	links, err = r.GetGameLinks(ctx, other)
+	if err != nil || len(links) != 1 {
+		t.Fatal("the game links of other users should be kept")
+	}
+
+	if err := r.Delete(ctx, randomIdentifier(t)); err != nil {
+		t.Fatal("deleting an unknown user should not return an error")
+	}
+
+	if _, err := r.Create(ctx, user.NewIncomplete(1, "", "", "hash", false)); err != nil {
+		t.Fatal("the unique fields of a deleted user should be available again")
+	}
+}
+
+func testSoftDelete(t *testing.T, r user.Repository) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	c, err := r.Create(ctx, user.NewIncomplete(1, "", "", "hash", false))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	other, err := r.Create(ctx, user.NewIncomplete(2, "", "", "other", false))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.Restore(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("an active user can not be restored")
+	}
+
+	if err := r.SoftDelete(ctx, c, now.Add(-time.Hour)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SoftDelete(ctx, other, now); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SoftDelete(ctx, c, now); err != sql.ErrNoRows {
+		t.Fatal("a deleted user can not be deleted again")
+	}
+
+	if err := r.SoftDelete(ctx, randomIdentifier(t), now); err != sql.ErrNoRows {
+		t.Fatal("an unknown user can not be deleted")
+	}
+
+	if _, err := r.Get(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("a deleted user should not be found")
+	}
+
+	if _, err := r.GetByWCFUserID(ctx, 1); err != sql.ErrNoRows {
+		t.Fatal("a deleted user should not be found by its wcf user id")
+	}
+
+	if _, err := r.GetByGameSerialHash(ctx, "hash"); err != sql.ErrNoRows {
+		t.Fatal("a deleted user should not be found by its game serial hash")
+	}
+
+	users, err := r.List(ctx, &user.ListOptions{}, pagination.New("", 10))
+	if err != nil || len(users) != 0 {
+		t.Fatal("deleted users should not be listed")
+	}
+
+	if err := r.Update(ctx, &complete{c, user.NewIncomplete(1, "", "", "changed", false)}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.Create(ctx, user.NewIncomplete(1, "", "", "", false)); err == nil {
+		t.Fatal("the wcf user id of a deleted user should be kept")
+	}
+
+	d, err := r.GetDeletion(ctx, c)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if d.UserUUID != c.UUID() || d.WCFUserID != 1 || !d.DeletedAt.Equal(now.Add(-time.Hour)) {
+		t.Fatal("the deletion is not equal")
+	}
+
+	d, err = r.GetDeletionByWCFUserID(ctx, 1)
+	if err != nil || d.UserUUID != c.UUID() {
+		t.Fatal("the deletion should be found by the wcf user id")
+	}
+
+	if _, err := r.GetDeletionByWCFUserID(ctx, 3); err != sql.ErrNoRows {
+		t.Fatal("an unknown deletion should not be found")
+	}
+
+	deletions, err := r.GetDeletions(ctx, now)
+	if err != nil || len(deletions) != 1 || deletions[0].UserUUID != c.UUID() {
+		t.Fatal("only the users deleted before the given time should be returned")
+	}
+
+	deletions, err = r.GetDeletions(ctx, now.Add(time.Second))
+	if err != nil || len(deletions) != 2 || deletions[0].UserUUID != c.UUID() || deletions[1].UserUUID != other.UUID() {
+		t.Fatal("the deletions should be ordered by the time the users were deleted")
+	}
+
+	if err := r.Restore(ctx, c); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	restored, err := r.Get(ctx, c)
+	if err != nil {
+		t.Fatal("the restored user should be found")
+	}
+
+	if restored.Data().GameSerialHash != "hash" {
+		t.Fatal("a deleted user should not be updated")
+	}
+
+	if _, err := r.GetDeletion(ctx, c); err != sql.ErrNoRows {
+		t.Fatal("the restored user is not deleted anymore")
+	}
+
+	if err := r.Delete(ctx, other); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.GetDeletion(ctx, other); err != sql.ErrNoRows {
+		t.Fatal("a deleted user should be purged by deleting it")
+	}
+}
+
+func testBans(t *testing.T, r user.Repository) {
+	ctx := context.Background()
+	id := randomIdentifier(t)
+	now := time.Now().UTC().Truncate(time.Second)
+	endsAt := now.Add(time.Hour)
+
+	if _, err := r.GetBan(ctx, randomIdentifier(t).UUID()); err != sql.ErrNoRows {
+		t.Fatal("an unknown ban should not be found")
+	}
+
+	permanent, err := r.AddBan(ctx, &user.Ban{
+		UserUUID: id.UUID(),
+		Reason:   "cheating",
+		Issuer:   "user/moderator",
+		Scopes:   []user.BanScope{user.BanScopeGame, user.BanScopeAPI},
+		StartsAt: now,
+	})
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	temporary, err := r.AddBan(ctx, &user.Ban{
+		UserUUID: id.UUID(),
+		Reason:   "insults",
+		Issuer:   "user/moderator",
+		Scopes:   []user.BanScope{user.BanScopeForum},
+		StartsAt: now.Add(-time.Minute),
+		EndsAt:   &endsAt,
+	})
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if permanent.ID == "" || permanent.ID == temporary.ID {
+		t.Fatal("the added bans should have distinct ids")
+	}
+
+	if _, err := r.AddBan(ctx, &user.Ban{
+		UserUUID: randomIdentifier(t).UUID(),
+		Reason:   "cheating",
+		Scopes:   []user.BanScope{user.BanScopeGame},
+		StartsAt: now,
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	b, err := r.GetBan(ctx, permanent.ID)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if b.UserUUID != id.UUID() || b.Reason != "cheating" || b.Issuer != "user/moderator" || !b.StartsAt.Equal(now) ||
+		b.EndsAt != nil || b.RevokedAt != nil || len(b.Scopes) != 2 || !b.Covers(user.BanScopeAPI) {
+		t.Fatal("the stored ban is not equal")
+	}
+
+	bans, err := r.GetBans(ctx, id)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(bans) != 2 || bans[0].ID != temporary.ID || bans[1].ID != permanent.ID {
+		t.Fatal("the bans of the user should be ordered by their start")
+	}
+
+	if bans[0].EndsAt == nil || !bans[0].EndsAt.Equal(endsAt) {
+		t.Fatal("the end of the ban should be stored")
+	}
+
+	revokedAt := now.Add(time.Minute)
+	if err := r.RevokeBan(ctx, permanent.ID, "user/admin", revokedAt); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	b, err = r.GetBan(ctx, permanent.ID)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if b.RevokedAt == nil || !b.RevokedAt.Equal(revokedAt) || b.RevokedBy != "user/admin" {
+		t.Fatal("the ban should be revoked")
+	}
+
+	if err := r.RevokeBan(ctx, randomIdentifier(t).UUID(), "user/admin", revokedAt); err != nil {
+		t.Fatal("revoking an unknown ban should not return an error")
+	}
+
+	bans, err = r.GetBans(ctx, randomIdentifier(t))
+	if err != nil || len(bans) != 0 {
+		t.Fatal("the bans of another user should be empty")
+	}
+}
+
+func testList(t *testing.T, r user.Repository) {
+	ctx := context.Background()
+
+	uuids := make(map[user.WCFUserID]string)
+	for i := user.WCFUserID(1); i <= 5; i++ {
+		c, err := r.Create(ctx, user.NewIncomplete(i, "", "", fmt.Sprintf("hash%d", i), i == 5))
+		if err != nil {
+			t.Fatal("there should be no error")
+		}
+
+		uuids[i] = c.UUID()
+	}
+
+	if _, err := r.AddBan(ctx, &user.Ban{
+		UserUUID: uuids[2],
+		Reason:   "cheating",
+		Scopes:   []user.BanScope{user.BanScopeGame},
+		StartsAt: time.Now().Add(-time.Minute),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.AddBan(ctx, &user.Ban{
+		UserUUID: uuids[3],
+		Reason:   "cheating",
+		Scopes:   []user.BanScope{user.BanScopeGame},
+		StartsAt: time.Now().Add(time.Hour),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.AddBan(ctx, &user.Ban{
+		UserUUID: uuids[4],
+		Reason:   "spam",
+		Scopes:   []user.BanScope{user.BanScopeForum},
+		StartsAt: time.Now().Add(-time.Minute),
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	users, err := r.List(ctx, &user.ListOptions{}, pagination.New("", 10))
+	if err != nil || len(users) != 5 {
+		t.Fatal("every user should be listed")
+	}
+
+	for i := 1; i < len(users); i++ {
+		if users[i-1].UUID() >= users[i].UUID() {
+			t.Fatal("the users should be ordered by their uuid")
+		}
+	}
+
+	first, err := r.List(ctx, &user.ListOptions{}, pagination.New("", 2))
+	if err != nil || len(first) != 2 || first[1].UUID() != users[1].UUID() {
+		t.Fatal("the listing should be limited")
+	}
+
+	rest, err := r.List(ctx, &user.ListOptions{}, pagination.New(first[1].UUID(), 10))
+	if err != nil || len(rest) != 3 || rest[0].UUID() != users[2].UUID() {
+		t.Fatal("the listing should start after the given uuid")
+	}
+
+	banned := true
+	bannedUsers, err := r.List(ctx, &user.ListOptions{Banned: &banned}, pagination.New("", 10))
+	if err != nil || len(bannedUsers) != 2 {
+		t.Fatal("the users with an active game ban or the banned flag should be listed")
+	}
+
+	for _, v := range bannedUsers {
+		if !v.Data().Banned || (v.UUID() != uuids[2] && v.UUID() != uuids[5]) {
+			t.Fatal("only banned users should be listed")
+		}
+	}
+
+	banned = false
+	notBanned, err := r.List(ctx, &user.ListOptions{Banned: &banned}, pagination.New("", 10))
+	if err != nil || len(notBanned) != 3 {
+		t.Fatal("the users without an active ban should be listed")
+	}
+
+	matched, err := r.List(ctx, &user.ListOptions{WCFUserIDs: []user.WCFUserID{1, 3, 42}}, pagination.New("", 10))
+	if err != nil || len(matched) != 2 {
+		t.Fatal("only the users with the given wcf user ids should be listed")
+	}
+
+	for _, v := range matched {
+		if v.Data().WCFUserID != 1 && v.Data().WCFUserID != 3 {
+			t.Fatal("only the users with the given wcf user ids should be listed")
+		}
+	}
+
+	none, err := r.List(ctx, &user.ListOptions{WCFUserIDs: []user.WCFUserID{}}, pagination.New("", 10))
+	if err != nil || len(none) != 0 {
+		t.Fatal("an empty list of wcf user ids should match no user")
+	}
+}
+
+func testLinkCodes(t *testing.T, r user.Repository) {
+	ctx := context.Background()
+	expiresAt := time.Now().UTC().Truncate(time.Second).Add(time.Minute)
+
+	if _, err := r.TakeLinkCode(ctx, "UNKNOWN1"); err != sql.ErrNoRows {
+		t.Fatal("an unknown link code should not be found")
+	}
+
+	if err := r.AddLinkCode(ctx, &user.LinkCode{
+		Code:           "ABCD2345",
+		GameSerialHash: "hash",
+		ExpiresAt:      expiresAt,
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.AddLinkCode(ctx, &user.LinkCode{
+		Code:           "ABCD2345",
+		GameSerialHash: "other",
+		ExpiresAt:      expiresAt,
+	}); err == nil {
+		t.Fatal("the link code is already used")
+	}
+
+	c, err := r.TakeLinkCode(ctx, "ABCD2345")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if c.Code != "ABCD2345" || c.GameSerialHash != "hash" || !c.ExpiresAt.Equal(expiresAt) {
+		t.Fatal("the stored link code is not equal")
+	}
+
+	if _, err := r.TakeLinkCode(ctx, "ABCD2345"); err != sql.ErrNoRows {
+		t.Fatal("a link code should only be taken once")
+	}
+}
+
+func testGameLinks(t *testing.T, r user.Repository) {
+	ctx := context.Background()
+	id := randomIdentifier(t)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	links, err := r.GetGameLinks(ctx, id)
+	if err != nil || len(links) != 0 {
+		t.Fatal("there should be no game links")
+	}
+
+	for i, v := range []struct {
+		uuid string
+		hash string
+	}{
+		{id.UUID(), "second"},
+		{randomIdentifier(t).UUID(), "other"},
+		{id.UUID(), "first"},
+	} {
+		if err := r.AddGameLink(ctx, &user.GameLink{
+			UserUUID:       v.uuid,
+			GameSerialHash: v.hash,
+			LinkedAt:       now.Add(-time.Duration(i) * time.Hour),
+		}); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	links, err = r.GetGameLinks(ctx, id)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(links) != 2 || links[0].GameSerialHash != "first" || links[1].GameSerialHash != "second" {
+		t.Fatal("the game links should be ordered by the time they were linked")
+	}
+
+	if links[0].UserUUID != id.UUID() || !links[1].LinkedAt.Equal(now) {
+		t.Fatal("the stored game link is not equal")
+	}
+}
@@ -0,0 +1,39 @@
+package user
+
+import "github.com/51st-state/api/pkg/rbac"
+
+// rules enforced by the user service
+const (
+	ruleGet        rbac.Rule = "users.get"
+	ruleList       rbac.Rule = "users.list"
+	ruleGetByHash  rbac.Rule = "users.getByHash"
+	ruleCreate     rbac.Rule = "users.create"
+	ruleDelete     rbac.Rule = "users.delete"
+	ruleRestore    rbac.Rule = "users.restore"
+	ruleUpdate     rbac.Rule = "users.update"
+	ruleRolesGet   rbac.Rule = "users.roles.get"
+	ruleRolesSet   rbac.Rule = "users.roles.set"
+	ruleBansGet    rbac.Rule = "users.bans.get"
+	ruleBansAdd    rbac.Rule = "users.bans.add"
+	ruleBansRevoke rbac.Rule = "users.bans.revoke"
+	ruleLinkCreate rbac.Rule = "users.link.codes.create"
+	ruleLinkDelete rbac.Rule = "users.link.delete"
+)
+
+// Rules enforced by the user service
+var Rules = rbac.RuleCatalog{
+	{Rule: ruleGet, Description: "Get a user by its uuid", Service: "user"},
+	{Rule: ruleList, Description: "List and search users and their game link history", Service: "user"},
+	{Rule: ruleGetByHash, Description: "Get a user by its game serial hash", Service: "user"},
+	{Rule: ruleCreate, Description: "Create a user", Service: "user"},
+	{Rule: ruleDelete, Description: "Delete a user", Service: "user"},
+	{Rule: ruleRestore, Description: "Restore a deleted user before it is purged", Service: "user"},
+	{Rule: ruleUpdate, Description: "Update a user", Service: "user"},
+	{Rule: ruleRolesGet, Description: "Get the roles of a user", Service: "user"},
+	{Rule: ruleRolesSet, Description: "Set the roles of a user", Service: "user"},
+	{Rule: ruleBansGet, Description: "Get the ban history of a user", Service: "user"},
+	{Rule: ruleBansAdd, Description: "Ban a user", Service: "user"},
+	{Rule: ruleBansRevoke, Description: "Revoke a ban of a user", Service: "user"},
+	{Rule: ruleLinkCreate, Description: "Request a link code for a game account", Service: "user"},
+	{Rule: ruleLinkDelete, Description: "Unlink the game account of a user", Service: "user"},
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"crypto/rsa"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/51st-state/api/pkg/api/endpoint"
 	"github.com/51st-state/api/pkg/encode"
+	"github.com/51st-state/api/pkg/pagination"
 	"github.com/51st-state/api/pkg/rbac"
 	rbacMiddleware "github.com/51st-state/api/pkg/rbac/middleware"
 	"github.com/51st-state/api/pkg/token"
@@ -22,7 +25,49 @@ func MakeGetEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control
 		return m.Get(ctx, newIdentifier(chi.URLParam(r, "uuid")))
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("users.get"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleGet)).
+		HandlerFunc(l)
+}
+
+// MakeListEndpoint for the user service
+// API-Path: GET /users?query=&banned=&cursor=&limit=
+func MakeListEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		page, err := pagination.FromRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		f := &ListFilter{
+			Query: r.URL.Query().Get("query"),
+		}
+
+		if v := r.URL.Query().Get("banned"); v != "" {
+			banned, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, err
+			}
+
+			f.Banned = &banned
+		}
+
+		users, err := m.List(ctx, f, page)
+		if err != nil {
+			return nil, err
+		}
+
+		var last string
+		if len(users) > 0 {
+			last = users[len(users)-1].UUID()
+		}
+
+		return &pagination.List{
+			Items: users,
+			Next:  page.Next(len(users), last),
+		}, nil
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleList)).
 		HandlerFunc(l)
 }
 
@@ -33,7 +78,7 @@ func MakeGetByGameSerialHashEndpoint(l *zap.Logger, m Manager, e encode.Encoder,
 		return m.GetByGameSerialHash(ctx, chi.URLParam(r, "hash"))
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("users.getByHash"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleGetByHash)).
 		HandlerFunc(l)
 }
 
@@ -50,7 +95,7 @@ func MakeCreateEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Cont
 		return m.Create(ctx, inc)
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("users.create"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleCreate)).
 		HandlerFunc(l)
 }
 
@@ -62,7 +107,18 @@ func MakeDeleteEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Cont
 		return struct{}{}, m.Delete(ctx, newIdentifier(uuid))
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("users.delete"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleDelete)).
+		HandlerFunc(l)
+}
+
+// MakeRestoreEndpoint for the user service
+// API-Path: /users/{uuid}/restore
+func MakeRestoreEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return m.Restore(ctx, newIdentifier(chi.URLParam(r, "uuid")))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleRestore)).
 		HandlerFunc(l)
 }
 
@@ -84,7 +140,7 @@ func MakeUpdateEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Cont
 		))
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("users.update"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleUpdate)).
 		HandlerFunc(l)
 }
 
@@ -97,7 +153,7 @@ func MakeGetRolesEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Co
 		return m.GetRoles(ctx, newIdentifier(uuid))
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("users.roles.get"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleRolesGet)).
 		HandlerFunc(l)
 }
 
@@ -115,6 +171,149 @@ func MakeSetRolesEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Co
 		return struct{}{}, m.SetRoles(ctx, newIdentifier(uuid), roles)
 	}).
 		WithBefore(token.NewMiddleware(pubKey)).
-		WithBefore(rbacMiddleware.NewRulecheck(rb, rbac.Rule("users.roles.set"))).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleRolesSet)).
+		HandlerFunc(l)
+}
+
+// MakeGetBansEndpoint for the user service
+// API-Endpoint: GET /users/{uuid}/bans
+func MakeGetBansEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		uuid := chi.URLParam(r, "uuid")
+
+		return m.GetBans(ctx, newIdentifier(uuid))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleBansGet)).
+		HandlerFunc(l)
+}
+
+// MakeBanEndpoint for the user service
+// API-Endpoint: POST /users/{uuid}/bans
+func MakeBanEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		uuid := chi.URLParam(r, "uuid")
+
+		b := &Ban{}
+		if err := json.NewDecoder(r.Body).Decode(b); err != nil {
+			return nil, err
+		}
+
+		return m.Ban(ctx, newIdentifier(uuid), b)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleBansAdd)).
+		HandlerFunc(l)
+}
+
+// MakeUnbanEndpoint for the user service
+// API-Endpoint: DELETE /users/{uuid}/bans/{ban}
+func MakeUnbanEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		uuid := chi.URLParam(r, "uuid")
+
+		return struct{}{}, m.Unban(ctx, newIdentifier(uuid), chi.URLParam(r, "ban"))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleBansRevoke)).
+		HandlerFunc(l)
+}
+
+var errNoUserToken = errors.New("the token does not belong to a user")
+
+// tokenUser returns the user authenticated by the token of a context
+func tokenUser(ctx context.Context) (Identifier, error) {
+	tok, err := token.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if tok.Data().User == nil || tok.Data().User.Type != "user" {
+		return nil, errNoUserToken
+	}
+
+	return newIdentifier(tok.Data().User.ID), nil
+}
+
+// MakeRequestLinkCodeEndpoint for the user service
+// API-Endpoint: POST /users/link/codes
+func MakeRequestLinkCodeEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		var body struct {
+			GameSerialHash string `json:"game_serial_hash"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+
+		return m.RequestLinkCode(ctx, body.GameSerialHash)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleLinkCreate)).
+		HandlerFunc(l)
+}
+
+// MakeRedeemLinkCodeEndpoint for the user service.
+// Links the game account to the user of the token.
+// API-Endpoint: POST /users/link
+func MakeRedeemLinkCodeEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := tokenUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var body struct {
+			Code string `json:"code"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+
+		return m.RedeemLinkCode(ctx, id, body.Code)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeUnlinkOwnGameEndpoint for the user service.
+// Unlinks the game account of the user of the token.
+// API-Endpoint: DELETE /users/link
+func MakeUnlinkOwnGameEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		id, err := tokenUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return struct{}{}, m.UnlinkGame(ctx, id)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeUnlinkGameEndpoint for the user service
+// API-Endpoint: DELETE /users/{uuid}/link
+func MakeUnlinkGameEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		uuid := chi.URLParam(r, "uuid")
+
+		return struct{}{}, m.UnlinkGame(ctx, newIdentifier(uuid))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleLinkDelete)).
+		HandlerFunc(l)
+}
+
+// MakeGetGameLinksEndpoint for the user service
+// API-Endpoint: GET /users/{uuid}/links
+func MakeGetGameLinksEndpoint(l *zap.Logger, m Manager, e encode.Encoder, rb rbac.Control, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		uuid := chi.URLParam(r, "uuid")
+
+		return m.GetGameLinks(ctx, newIdentifier(uuid))
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(rbacMiddleware.NewRulecheck(rb, ruleList)).
 		HandlerFunc(l)
 }
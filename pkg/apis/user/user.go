@@ -1,5 +1,7 @@
 package user
 
+import "encoding/json"
+
 // Identifier of a user object
 //go:generate counterfeiter -o ./mocks/identifier.go . Identifier
 type Identifier interface {
@@ -20,6 +22,15 @@ func (i *identifier) UUID() string {
 	return i.uuid
 }
 
+// MarshalJSON of an identifier
+func (i *identifier) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		UUID string `json:"uuid"`
+	}{
+		i.uuid,
+	})
+}
+
 // Provider of user data
 type Provider interface {
 	Data() *data
@@ -0,0 +1,46 @@
+package wcfcache
+
+import (
+	"sync"
+
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+type call struct {
+	wg   sync.WaitGroup
+	info *user.WCFUserInfo
+	err  error
+}
+
+// group merges concurrent lookups of the same key into one lookup
+type group struct {
+	mutex sync.Mutex
+	calls map[string]*call
+}
+
+func (g *group) do(key string, fn func() (*user.WCFUserInfo, error)) (*user.WCFUserInfo, error) {
+	g.mutex.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*call)
+	}
+
+	if c, ok := g.calls[key]; ok {
+		g.mutex.Unlock()
+		c.wg.Wait()
+		return c.info, c.err
+	}
+
+	c := &call{}
+	c.wg.Add(1)
+	g.calls[key] = c
+	g.mutex.Unlock()
+
+	c.info, c.err = fn()
+	c.wg.Done()
+
+	g.mutex.Lock()
+	delete(g.calls, key)
+	g.mutex.Unlock()
+
+	return c.info, c.err
+}
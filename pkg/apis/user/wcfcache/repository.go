@@ -0,0 +1,303 @@
+// Package wcfcache contains a caching decorator of wcf repositories
+package wcfcache
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/user"
+)
+
+type entry struct {
+	info    *user.WCFUserInfo
+	expires time.Time
+}
+
+// maxEntries bounds the number of infos cached by id and by name each.
+// Lookups are not cached while the cache is full until expired infos are swept.
+const maxEntries = 100000
+
+// Repository caching the infos of a wcf repository.
+// Unknown users are cached as well, but only for the negative ttl.
+// Concurrent lookups of the same user are merged into one lookup.
+// Expired infos are dropped on lookup and by Sweep, which has to be called periodically.
+type Repository struct {
+	repository  user.WCFRepository
+	ttl         time.Duration
+	negativeTTL time.Duration
+
+	mutex  sync.Mutex
+	byID   map[user.WCFUserID]*entry
+	byName map[string]*entry
+	group  group
+	// generation is increased by every invalidation, so that
+	// lookups started before are not cached
+	generation uint64
+}
+
+// NewRepository caching the infos of r for ttl and unknown users for negativeTTL
+func NewRepository(r user.WCFRepository, ttl, negativeTTL time.Duration) *Repository {
+	return &Repository{
+		repository:  r,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		byID:        make(map[user.WCFUserID]*entry),
+		byName:      make(map[string]*entry),
+	}
+}
+
+func (e *entry) expired(now time.Time) bool {
+	return now.After(e.expires)
+}
+
+// lookup returns a cached info. An unknown user is returned as sql.ErrNoRows.
+func lookup(e *entry, ok bool) (*user.WCFUserInfo, bool, error) {
+	if !ok || e.expired(time.Now()) {
+		return nil, false, nil
+	}
+
+	if e.info == nil {
+		return nil, true, sql.ErrNoRows
+	}
+
+	return e.info, true, nil
+}
+
+// lookupID returns the info cached by id and drops it if it is expired.
+// The lock has to be held by the caller.
+func (r *Repository) lookupID(id user.WCFUserID) (*user.WCFUserInfo, bool, error) {
+	e, found := r.byID[id]
+	info, ok, err := lookup(e, found)
+	if found && !ok {
+		delete(r.byID, id)
+	}
+
+	return info, ok, err
+}
+
+// lookupName returns the info cached by name and drops it if it is expired.
+// The lock has to be held by the caller.
+func (r *Repository) lookupName(key string) (*user.WCFUserInfo, bool, error) {
+	e, found := r.byName[key]
+	info, ok, err := lookup(e, found)
+	if found && !ok {
+		delete(r.byName, key)
+	}
+
+	return info, ok, err
+}
+
+// putID caches an info by id unless the cache is full.
+// The lock has to be held by the caller.
+func (r *Repository) putID(id user.WCFUserID, e *entry) {
+	if _, ok := r.byID[id]; ok || len(r.byID) < maxEntries {
+		r.byID[id] = e
+	}
+}
+
+// putName caches an info by name unless the cache is full.
+// The lock has to be held by the caller.
+func (r *Repository) putName(key string, e *entry) {
+	if _, ok := r.byName[key]; ok || len(r.byName) < maxEntries {
+		r.byName[key] = e
+	}
+}
+
+func (r *Repository) newEntry(info *user.WCFUserInfo) *entry {
+	if info == nil {
+		return &entry{nil, time.Now().Add(r.negativeTTL)}
+	}
+
+	return &entry{info, time.Now().Add(r.ttl)}
+}
+
+// store the result of a lookup. Errors other than sql.ErrNoRows are not cached.
+func (r *Repository) store(generation uint64, key string, id user.WCFUserID, info *user.WCFUserInfo, err error) {
+	if err != nil && err != sql.ErrNoRows {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if generation != r.generation {
+		return
+	}
+
+	e := r.newEntry(info)
+	if key == "" {
+		r.putID(id, e)
+		return
+	}
+
+	r.putName(key, e)
+	if info != nil {
+		r.putID(info.UserID, r.newEntry(info))
+	}
+}
+
+func (r *Repository) get(key string, id user.WCFUserID, fetch func() (*user.WCFUserInfo, error)) (*user.WCFUserInfo, error) {
+	r.mutex.Lock()
+	var (
+		info *user.WCFUserInfo
+		ok   bool
+		err  error
+	)
+	generation := r.generation
+	if key == "" {
+		info, ok, err = r.lookupID(id)
+	} else {
+		info, ok, err = r.lookupName(key)
+	}
+	r.mutex.Unlock()
+
+	if ok {
+		return info, err
+	}
+
+	flightKey := key
+	if key == "" {
+		flightKey = fmt.Sprintf("id:%d", id)
+	}
+
+	return r.group.do(flightKey, func() (*user.WCFUserInfo, error) {
+		info, err := fetch()
+		r.store(generation, key, id, info, err)
+		return info, err
+	})
+}
+
+// GetInfo of a wcf user
+func (r *Repository) GetInfo(ctx context.Context, id user.WCFUserID) (*user.WCFUserInfo, error) {
+	return r.get("", id, func() (*user.WCFUserInfo, error) {
+		return r.repository.GetInfo(ctx, id)
+	})
+}
+
+// GetInfoByEmail of a wcf user
+func (r *Repository) GetInfoByEmail(ctx context.Context, email string) (*user.WCFUserInfo, error) {
+	return r.get("email:"+strings.ToLower(email), 0, func() (*user.WCFUserInfo, error) {
+		return r.repository.GetInfoByEmail(ctx, email)
+	})
+}
+
+// GetInfoByUsername of a wcf user
+func (r *Repository) GetInfoByUsername(ctx context.Context, username string) (*user.WCFUserInfo, error) {
+	return r.get("username:"+strings.ToLower(username), 0, func() (*user.WCFUserInfo, error) {
+		return r.repository.GetInfoByUsername(ctx, username)
+	})
+}
+
+// GetInfoMany returns the cached infos and fetches the missing ones in one batch
+func (r *Repository) GetInfoMany(ctx context.Context, ids []user.WCFUserID) (map[user.WCFUserID]*user.WCFUserInfo, error) {
+	infos := make(map[user.WCFUserID]*user.WCFUserInfo)
+	missing := make([]user.WCFUserID, 0)
+
+	r.mutex.Lock()
+	generation := r.generation
+	for _, id := range ids {
+		info, ok, _ := r.lookupID(id)
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+
+		if info != nil {
+			infos[id] = info
+		}
+	}
+	r.mutex.Unlock()
+
+	if len(missing) == 0 {
+		return infos, nil
+	}
+
+	fetched, err := r.repository.GetInfoMany(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, id := range missing {
+		info := fetched[id]
+		if generation == r.generation {
+			r.putID(id, r.newEntry(info))
+		}
+
+		if info != nil {
+			infos[id] = info
+		}
+	}
+
+	return infos, nil
+}
+
+// GetPassword of a wcf user. Passwords are not cached,
+// so a changed password takes effect right away.
+func (r *Repository) GetPassword(ctx context.Context, id user.WCFUserID) (user.CompletePassword, error) {
+	return r.repository.GetPassword(ctx, id)
+}
+
+// SearchUserIDs of wcf users. Searches are not cached.
+func (r *Repository) SearchUserIDs(ctx context.Context, query string, limit int) ([]user.WCFUserID, error) {
+	return r.repository.SearchUserIDs(ctx, query, limit)
+}
+
+// Invalidate the cached infos of a wcf user
+func (r *Repository) Invalidate(id user.WCFUserID) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.generation++
+	delete(r.byID, id)
+	for key, e := range r.byName {
+		// unknown names are dropped as well, the user may just have been renamed
+		if e.info == nil || e.info.UserID == id {
+			delete(r.byName, key)
+		}
+	}
+}
+
+// InvalidateAll cached infos
+func (r *Repository) InvalidateAll() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.generation++
+	r.byID = make(map[user.WCFUserID]*entry)
+	r.byName = make(map[string]*entry)
+}
+
+// Sweep drops the expired infos, so neither unknown nor stale users
+// are kept in memory beyond their ttl
+func (r *Repository) Sweep() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	for id, e := range r.byID {
+		if e.expired(now) {
+			delete(r.byID, id)
+		}
+	}
+
+	for key, e := range r.byName {
+		if e.expired(now) {
+			delete(r.byName, key)
+		}
+	}
+}
+
+// Len returns the number of infos cached by id and by name
+func (r *Repository) Len() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return len(r.byID) + len(r.byName)
+}
@@ -0,0 +1,260 @@
+package wcfcache_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/51st-state/api/pkg/apis/user"
+	"github.com/51st-state/api/pkg/apis/user/mocks"
+	"github.com/51st-state/api/pkg/apis/user/wcfcache"
+)
+
+func TestRepositoryGetInfo(t *testing.T) {
+	ctx := context.Background()
+	wcfRepo := &mocks.FakeWCFRepository{}
+	wcfRepo.GetInfoReturns(&user.WCFUserInfo{UserID: 1, Username: "test"}, nil)
+
+	r := wcfcache.NewRepository(wcfRepo, time.Hour, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		info, err := r.GetInfo(ctx, 1)
+		if err != nil || info.Username != "test" {
+			t.Fatal("the info should be returned")
+		}
+	}
+
+	if wcfRepo.GetInfoCallCount() != 1 {
+		t.Fatal("the info should be cached")
+	}
+
+	r.Invalidate(1)
+	wcfRepo.GetInfoReturns(&user.WCFUserInfo{UserID: 1, Username: "renamed"}, nil)
+
+	info, err := r.GetInfo(ctx, 1)
+	if err != nil || info.Username != "renamed" || wcfRepo.GetInfoCallCount() != 2 {
+		t.Fatal("an invalidated info should be fetched again")
+	}
+
+	wcfRepo.GetInfoReturns(nil, errors.New("fake error"))
+	if _, err := r.GetInfo(ctx, 2); err == nil {
+		t.Fatal("the wcf repository returns an error")
+	}
+
+	wcfRepo.GetInfoReturns(&user.WCFUserInfo{UserID: 2}, nil)
+	if _, err := r.GetInfo(ctx, 2); err != nil || wcfRepo.GetInfoCallCount() != 4 {
+		t.Fatal("errors should not be cached")
+	}
+}
+
+func TestRepositoryTTL(t *testing.T) {
+	ctx := context.Background()
+	wcfRepo := &mocks.FakeWCFRepository{}
+	wcfRepo.GetInfoReturns(nil, sql.ErrNoRows)
+
+	r := wcfcache.NewRepository(wcfRepo, time.Hour, 20*time.Millisecond)
+
+	for i := 0; i < 2; i++ {
+		if _, err := r.GetInfo(ctx, 1); err != sql.ErrNoRows {
+			t.Fatal("an unknown user should not be found")
+		}
+	}
+
+	if wcfRepo.GetInfoCallCount() != 1 {
+		t.Fatal("an unknown user should be cached")
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	wcfRepo.GetInfoReturns(&user.WCFUserInfo{UserID: 1}, nil)
+
+	if _, err := r.GetInfo(ctx, 1); err != nil || wcfRepo.GetInfoCallCount() != 2 {
+		t.Fatal("an unknown user should only be cached for the negative ttl")
+	}
+
+	r = wcfcache.NewRepository(wcfRepo, 20*time.Millisecond, time.Hour)
+	if _, err := r.GetInfo(ctx, 1); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	if _, err := r.GetInfo(ctx, 1); err != nil || wcfRepo.GetInfoCallCount() != 4 {
+		t.Fatal("an expired info should be fetched again")
+	}
+}
+
+func TestRepositorySweep(t *testing.T) {
+	ctx := context.Background()
+	wcfRepo := &mocks.FakeWCFRepository{}
+	wcfRepo.GetInfoReturns(nil, sql.ErrNoRows)
+	wcfRepo.GetInfoByUsernameReturns(&user.WCFUserInfo{UserID: 2, Username: "test"}, nil)
+
+	r := wcfcache.NewRepository(wcfRepo, time.Hour, 20*time.Millisecond)
+
+	for i := 3; i <= 5; i++ {
+		if _, err := r.GetInfo(ctx, user.WCFUserID(i)); err != sql.ErrNoRows {
+			t.Fatal("an unknown user should not be found")
+		}
+	}
+
+	if _, err := r.GetInfoByUsername(ctx, "test"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if r.Len() != 5 {
+		t.Fatal("the infos should be cached by id and by name")
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	wcfRepo.GetInfoReturns(nil, errors.New("fake error"))
+	if _, err := r.GetInfo(ctx, 3); err == nil || r.Len() != 4 {
+		t.Fatal("an expired info should be dropped on lookup")
+	}
+
+	r.Sweep()
+
+	if r.Len() != 2 {
+		t.Fatal("the expired infos should be dropped")
+	}
+
+	if info, err := r.GetInfo(ctx, 2); err != nil || info.Username != "test" {
+		t.Fatal("the infos within their ttl should be kept")
+	}
+}
+
+func TestRepositorySingleflight(t *testing.T) {
+	ctx := context.Background()
+	wcfRepo := &mocks.FakeWCFRepository{}
+
+	release := make(chan struct{})
+	wcfRepo.GetInfoStub = func(ctx context.Context, id user.WCFUserID) (*user.WCFUserInfo, error) {
+		<-release
+		return &user.WCFUserInfo{UserID: id}, nil
+	}
+
+	r := wcfcache.NewRepository(wcfRepo, time.Hour, time.Hour)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := r.GetInfo(ctx, 1)
+			errs <- err
+		}()
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	if wcfRepo.GetInfoCallCount() != 1 {
+		t.Fatal("concurrent lookups should be merged")
+	}
+}
+
+func TestRepositoryGetInfoByName(t *testing.T) {
+	ctx := context.Background()
+	wcfRepo := &mocks.FakeWCFRepository{}
+	wcfRepo.GetInfoByUsernameReturns(&user.WCFUserInfo{UserID: 1, Username: "Test"}, nil)
+	wcfRepo.GetInfoByEmailReturns(&user.WCFUserInfo{UserID: 1, Email: "test@example.com"}, nil)
+
+	r := wcfcache.NewRepository(wcfRepo, time.Hour, time.Hour)
+
+	if _, err := r.GetInfoByUsername(ctx, "Test"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, err := r.GetInfoByUsername(ctx, "test"); err != nil || wcfRepo.GetInfoByUsernameCallCount() != 1 {
+		t.Fatal("usernames should be cached case insensitive")
+	}
+
+	if _, err := r.GetInfo(ctx, 1); err != nil || wcfRepo.GetInfoCallCount() != 0 {
+		t.Fatal("the info found by the username should be cached by its id")
+	}
+
+	if _, err := r.GetInfoByEmail(ctx, "test@example.com"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	r.Invalidate(1)
+
+	if _, err := r.GetInfoByUsername(ctx, "test"); err != nil || wcfRepo.GetInfoByUsernameCallCount() != 2 {
+		t.Fatal("the username should be invalidated with its user")
+	}
+
+	if _, err := r.GetInfoByEmail(ctx, "test@example.com"); err != nil || wcfRepo.GetInfoByEmailCallCount() != 2 {
+		t.Fatal("the email should be invalidated with its user")
+	}
+}
+
+func TestRepositoryGetInfoMany(t *testing.T) {
+	ctx := context.Background()
+	wcfRepo := &mocks.FakeWCFRepository{}
+	wcfRepo.GetInfoReturns(&user.WCFUserInfo{UserID: 1}, nil)
+	wcfRepo.GetInfoManyReturns(map[user.WCFUserID]*user.WCFUserInfo{
+		2: {UserID: 2},
+	}, nil)
+
+	r := wcfcache.NewRepository(wcfRepo, time.Hour, time.Hour)
+
+	if _, err := r.GetInfo(ctx, 1); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	infos, err := r.GetInfoMany(ctx, []user.WCFUserID{1, 2, 3})
+	if err != nil || len(infos) != 2 || infos[1] == nil || infos[2] == nil {
+		t.Fatal("the known infos should be returned")
+	}
+
+	if _, ids := wcfRepo.GetInfoManyArgsForCall(0); len(ids) != 2 || ids[0] != 2 || ids[1] != 3 {
+		t.Fatal("only the missing infos should be fetched")
+	}
+
+	infos, err = r.GetInfoMany(ctx, []user.WCFUserID{1, 2, 3})
+	if err != nil || len(infos) != 2 || wcfRepo.GetInfoManyCallCount() != 1 {
+		t.Fatal("the fetched and unknown infos should be cached")
+	}
+
+	if _, err := r.GetInfo(ctx, 3); err != sql.ErrNoRows || wcfRepo.GetInfoCallCount() != 1 {
+		t.Fatal("an unknown info of a batch should be cached")
+	}
+
+	r.InvalidateAll()
+	wcfRepo.GetInfoManyReturns(nil, errors.New("fake error"))
+
+	if _, err := r.GetInfoMany(ctx, []user.WCFUserID{1}); err == nil {
+		t.Fatal("the wcf repository returns an error")
+	}
+}
+
+func TestRepositoryGetPassword(t *testing.T) {
+	ctx := context.Background()
+	wcfRepo := &mocks.FakeWCFRepository{}
+	pw := &mocks.FakeCompletePassword{}
+	wcfRepo.GetPasswordReturns(pw, nil)
+
+	r := wcfcache.NewRepository(wcfRepo, time.Hour, time.Hour)
+
+	for i := 0; i < 2; i++ {
+		if p, err := r.GetPassword(ctx, 1); err != nil || p != pw {
+			t.Fatal("the password should be returned")
+		}
+	}
+
+	if wcfRepo.GetPasswordCallCount() != 2 || r.Len() != 0 {
+		t.Fatal("passwords should not be cached")
+	}
+}
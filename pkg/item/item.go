@@ -1,5 +1,7 @@
 package item
 
+import "errors"
+
 // ConfigEntry is a config entry of a virtual GTA5 item
 type ConfigEntry struct {
 	Weight    float64 `json:"weight"`
@@ -8,3 +10,21 @@ type ConfigEntry struct {
 
 // Config of available virtual GTA5 items
 type Config map[string]*ConfigEntry
+
+// Registry of the available items
+type Registry interface {
+	Get(id string) (*ConfigEntry, error)
+}
+
+// ErrUnknown is returned for items missing in the config
+var ErrUnknown = errors.New("unknown item")
+
+// Get the config entry of an item
+func (c Config) Get(id string) (*ConfigEntry, error) {
+	entry, ok := c[id]
+	if !ok || entry == nil {
+		return nil, ErrUnknown
+	}
+
+	return entry, nil
+}
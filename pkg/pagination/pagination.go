@@ -0,0 +1,92 @@
+package pagination
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/51st-state/api/pkg/problems"
+)
+
+// limits of a page
+const (
+	DefaultLimit uint64 = 25
+	MaxLimit     uint64 = 100
+)
+
+var errInvalidLimit = problems.New("invalid limit", "the limit has to be a positive number", http.StatusBadRequest)
+
+// Page of a list request.
+// Items are ordered by their id and only items with an id
+// greater than the cursor are returned.
+type Page struct {
+	Cursor string
+	Limit  uint64
+}
+
+// New page with a limit between 1 and MaxLimit,
+// a limit of 0 is replaced by the DefaultLimit
+func New(cursor string, limit uint64) Page {
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	return Page{
+		Cursor: cursor,
+		Limit:  limit,
+	}
+}
+
+// FromRequest reads a page from the cursor and limit query parameters
+func FromRequest(r *http.Request) (Page, error) {
+	var limit uint64
+	if v := r.URL.Query().Get("limit"); v != "" {
+		l, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return Page{}, errInvalidLimit
+		}
+		limit = l
+	}
+
+	return New(r.URL.Query().Get("cursor"), limit), nil
+}
+
+// Next returns the cursor of the following page.
+// It is empty if the given number of items does not fill the page.
+func (p Page) Next(count int, last string) string {
+	if count == 0 || uint64(count) < p.Limit {
+		return ""
+	}
+
+	return last
+}
+
+// Apply the page to a list of ids.
+// The ids are sorted and the ids following the cursor up to the limit are returned.
+func (p Page) Apply(ids []string) []string {
+	sorted := append([]string{}, ids...)
+	sort.Strings(sorted)
+
+	page := make([]string, 0)
+	for _, v := range sorted {
+		if uint64(len(page)) >= p.Limit {
+			break
+		}
+
+		if v > p.Cursor {
+			page = append(page, v)
+		}
+	}
+
+	return page
+}
+
+// List of items returned by list endpoints
+type List struct {
+	Items interface{} `json:"items"`
+	Next  string      `json:"next"`
+}
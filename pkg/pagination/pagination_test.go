@@ -0,0 +1,88 @@
+package pagination_test
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/51st-state/api/pkg/pagination"
+)
+
+func TestNew(t *testing.T) {
+	if p := pagination.New("cursor", 0); p.Limit != pagination.DefaultLimit || p.Cursor != "cursor" {
+		t.Fatal("an empty limit should be replaced by the default limit")
+	}
+
+	if p := pagination.New("", pagination.MaxLimit+1); p.Limit != pagination.MaxLimit {
+		t.Fatal("the limit should not exceed the max limit")
+	}
+
+	if p := pagination.New("", 10); p.Limit != 10 {
+		t.Fatal("the limit should be kept")
+	}
+}
+
+func TestFromRequest(t *testing.T) {
+	if _, err := pagination.FromRequest(httptest.NewRequest("GET", "/?limit=abc", nil)); err == nil {
+		t.Fatal("the limit is not a number")
+	}
+
+	if _, err := pagination.FromRequest(httptest.NewRequest("GET", "/?limit=-1", nil)); err == nil {
+		t.Fatal("the limit is negative")
+	}
+
+	p, err := pagination.FromRequest(httptest.NewRequest("GET", "/?cursor=abc&limit=10", nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if p.Cursor != "abc" || p.Limit != 10 {
+		t.Fatal("the page does not match the query")
+	}
+
+	p, err = pagination.FromRequest(httptest.NewRequest("GET", "/", nil))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if p.Cursor != "" || p.Limit != pagination.DefaultLimit {
+		t.Fatal("the page should start at the beginning with the default limit")
+	}
+}
+
+func TestPageNext(t *testing.T) {
+	p := pagination.New("", 2)
+
+	if p.Next(1, "a") != "" {
+		t.Fatal("a page which is not full has no following page")
+	}
+
+	if p.Next(0, "") != "" {
+		t.Fatal("an empty page has no following page")
+	}
+
+	if p.Next(2, "b") != "b" {
+		t.Fatal("the cursor of the following page should be the last item")
+	}
+}
+
+func TestPageApply(t *testing.T) {
+	ids := []string{"c", "a", "d", "b"}
+
+	page := pagination.New("", 2).Apply(ids)
+	if len(page) != 2 || page[0] != "a" || page[1] != "b" {
+		t.Fatal("the first page should contain the first sorted ids")
+	}
+
+	page = pagination.New("b", 2).Apply(ids)
+	if len(page) != 2 || page[0] != "c" || page[1] != "d" {
+		t.Fatal("the page should start after the cursor")
+	}
+
+	if len(pagination.New("d", 2).Apply(ids)) != 0 {
+		t.Fatal("there are no ids after the last id")
+	}
+
+	if ids[0] != "c" {
+		t.Fatal("the ids should not be modified")
+	}
+}
@@ -16,3 +16,13 @@ func (r AccountRoles) Contains(roleID RoleID) bool {
 
 	return false
 }
+
+// Strings returns the roles as strings
+func (r AccountRoles) Strings() []string {
+	values := make([]string, 0)
+	for _, v := range r {
+		values = append(values, string(v))
+	}
+
+	return values
+}
@@ -0,0 +1,146 @@
+package rbac
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/51st-state/api/pkg/token"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// SystemActor is recorded for changes made without an authenticated account
+const SystemActor AccountID = "system"
+
+// authorizationMetadataKey forwards the token of the acting account to the grpc server
+const authorizationMetadataKey = "authorization"
+
+// actorMetadataKey forwards an actor set explicitly to the grpc server,
+// which is only accepted from trusted peers
+const actorMetadataKey = "rbac-actor"
+
+var errUntrustedPeer = errors.New("the actor may only be set by trusted peers")
+
+type actorContextKey struct{}
+
+// ActorToContext sets the account acting in a context
+func ActorToContext(ctx context.Context, actor AccountID) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the account acting in a context.
+// An actor set explicitly takes precedence over the account of the token.
+// Without either the SystemActor is returned.
+func ActorFromContext(ctx context.Context) AccountID {
+	if actor, ok := ctx.Value(actorContextKey{}).(AccountID); ok && actor != "" {
+		return actor
+	}
+
+	tok, err := token.FromContext(ctx)
+	if err != nil || tok.Data().User == nil {
+		return SystemActor
+	}
+
+	return AccountID(tok.Data().User.String())
+}
+
+// ActorToOutgoingContext adds the acting account to the grpc metadata.
+// The signed token of the context is forwarded to be verified by the server,
+// an actor set explicitly is forwarded as it is.
+func ActorToOutgoingContext(ctx context.Context) context.Context {
+	if tok, err := token.FromContext(ctx); err == nil && tok.Token().Raw != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, authorizationMetadataKey, "Bearer "+tok.Token().Raw)
+	}
+
+	if actor, ok := ctx.Value(actorContextKey{}).(AccountID); ok && actor != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, actorMetadataKey, string(actor))
+	}
+
+	return ctx
+}
+
+// NewActorInterceptor moves the acting account of a grpc request into its context.
+// A forwarded token is verified with the public key, an actor set explicitly
+// is only accepted from peers in the trusted networks. Requests with an invalid
+// token or an actor from any other peer are rejected as unauthenticated.
+func NewActorInterceptor(pK rsa.PublicKey, trusted []*net.IPNet) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := actorFromIncomingContext(ctx, &pK, trusted)
+		if err != nil {
+			return nil, status.New(codes.Unauthenticated, err.Error()).Err()
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func actorFromIncomingContext(ctx context.Context, pK *rsa.PublicKey, trusted []*net.IPNet) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+
+	if auth := md.Get(authorizationMetadataKey); len(auth) > 0 {
+		tok, err := token.NewFromString(pK, strings.TrimPrefix(auth[0], "Bearer "))
+		if err != nil {
+			return nil, err
+		}
+
+		ctx = token.ToContext(ctx, tok)
+	}
+
+	if actors := md.Get(actorMetadataKey); len(actors) > 0 {
+		if !trustedPeer(ctx, trusted) {
+			return nil, errUntrustedPeer
+		}
+
+		ctx = ActorToContext(ctx, AccountID(actors[0]))
+	}
+
+	return ctx, nil
+}
+
+func trustedPeer(ctx context.Context, trusted []*net.IPNet) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	addr, ok := p.Addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+
+	for _, v := range trusted {
+		if v.Contains(addr.IP) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseNetworks parses a comma separated list of networks in CIDR notation
+func ParseNetworks(s string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0)
+	for _, v := range strings.Split(s, ",") {
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(strings.TrimSpace(v))
+		if err != nil {
+			return nil, err
+		}
+
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
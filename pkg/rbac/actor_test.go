@@ -0,0 +1,117 @@
+package rbac_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/51st-state/api/pkg/keys"
+	"github.com/51st-state/api/pkg/rbac"
+	"github.com/51st-state/api/pkg/token"
+	"github.com/51st-state/api/test"
+	jwt "github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestActorFromContext(t *testing.T) {
+	ctx := context.Background()
+	if rbac.ActorFromContext(ctx) != rbac.SystemActor {
+		t.Fatal("changes without token are made by the system")
+	}
+
+	ctx = token.ToContext(ctx, token.New(&jwt.StandardClaims{}, &token.User{
+		ID:   "1",
+		Type: "user",
+	}))
+	if rbac.ActorFromContext(ctx) != "user/1" {
+		t.Fatal("the account of the token should act")
+	}
+
+	ctx = rbac.ActorToContext(ctx, "service_account/2")
+	if rbac.ActorFromContext(ctx) != "service_account/2" {
+		t.Fatal("an explicit actor should take precedence over the token")
+	}
+}
+
+// forward the actor of a client context to the interceptor of a server
+// and return the actor seen by the server
+func forward(ctx context.Context, addr string, i grpc.UnaryServerInterceptor) (rbac.AccountID, error) {
+	md, _ := metadata.FromOutgoingContext(rbac.ActorToOutgoingContext(ctx))
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 1234}})
+
+	var actor rbac.AccountID
+	_, err := i(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		actor = rbac.ActorFromContext(ctx)
+		return nil, nil
+	})
+
+	return actor, err
+}
+
+func TestActorInterceptor(t *testing.T) {
+	privateKey, err := keys.GetPrivateKey(test.GetTestPrivateKey())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	publicKey, err := keys.GetPublicKey(test.GetTestPublicKey())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	trusted, err := rbac.ParseNetworks("10.0.0.0/8, 127.0.0.0/8")
+	if err != nil || len(trusted) != 2 {
+		t.Fatal("the networks should be parsed")
+	}
+
+	i := rbac.NewActorInterceptor(*publicKey, trusted)
+
+	actor, err := forward(context.Background(), "192.168.0.1", i)
+	if err != nil || actor != rbac.SystemActor {
+		t.Fatal("requests without actor are made by the system")
+	}
+
+	tokStr, err := token.New(&jwt.StandardClaims{
+		ExpiresAt: time.Now().Add(time.Minute).Unix(),
+	}, &token.User{
+		ID:   "1",
+		Type: "user",
+	}).String(privateKey)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	tok, err := token.NewFromString(publicKey, tokStr)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	actor, err = forward(token.ToContext(context.Background(), tok), "192.168.0.1", i)
+	if err != nil || actor != "user/1" {
+		t.Fatal("the account of a verified token should act from any peer")
+	}
+
+	forged := token.New(&jwt.StandardClaims{}, &token.User{ID: "2", Type: "user"})
+	forged.Token().Raw = tokStr[:len(tokStr)-4] + "AAAA"
+	if _, err := forward(token.ToContext(context.Background(), forged), "10.0.0.1", i); err == nil {
+		t.Fatal("a token with an invalid signature should be rejected")
+	}
+
+	ctx := rbac.ActorToContext(context.Background(), "user/3")
+	if _, err := forward(ctx, "192.168.0.1", i); err == nil {
+		t.Fatal("an explicit actor from an untrusted peer should be rejected")
+	}
+
+	actor, err = forward(ctx, "10.1.2.3", i)
+	if err != nil || actor != "user/3" {
+		t.Fatal("an explicit actor from a trusted peer should act")
+	}
+
+	if _, err := rbac.ParseNetworks("10.0.0.0"); err == nil {
+		t.Fatal("networks have to be given in cidr notation")
+	}
+}
@@ -0,0 +1,149 @@
+package rbac
+
+import (
+	"context"
+	"time"
+
+	"github.com/51st-state/api/pkg/event"
+)
+
+// AuditBinding describes which kind of binding an audit entry changed
+type AuditBinding string
+
+// bindings recorded in the audit trail
+const (
+	AuditRoleRules     AuditBinding = "role_rules"
+	AuditRoleDenyRules AuditBinding = "role_deny_rules"
+	AuditRoleParents   AuditBinding = "role_parents"
+	AuditAccountRoles  AuditBinding = "account_roles"
+)
+
+// AuditAction describes whether a binding was added or removed
+type AuditAction string
+
+// actions recorded in the audit trail
+const (
+	AuditAdd    AuditAction = "add"
+	AuditRemove AuditAction = "remove"
+)
+
+// AuditEntry records a single binding added to or removed from
+// a role or an account together with the complete old and new values
+type AuditEntry struct {
+	ID        string       `json:"id"`
+	Actor     AccountID    `json:"actor"`
+	Binding   AuditBinding `json:"binding"`
+	Action    AuditAction  `json:"action"`
+	RoleID    RoleID       `json:"role_id,omitempty"`
+	AccountID AccountID    `json:"account_id,omitempty"`
+	Value     string       `json:"value"`
+	Old       []string     `json:"old"`
+	New       []string     `json:"new"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// AuditFilter narrows down the listed audit entries.
+// Empty fields do not filter.
+type AuditFilter struct {
+	AccountID AccountID
+	RoleID    RoleID
+	Actor     AccountID
+}
+
+// Matches checks whether an audit entry passes the filter
+func (f AuditFilter) Matches(e AuditEntry) bool {
+	return (f.AccountID == "" || f.AccountID == e.AccountID) &&
+		(f.RoleID == "" || f.RoleID == e.RoleID) &&
+		(f.Actor == "" || f.Actor == e.Actor)
+}
+
+// BindingChangedEventID of an audit entry
+const BindingChangedEventID event.ID = "rbac_binding_changed"
+
+// BindingChangedEvent of an audit entry
+type BindingChangedEvent struct {
+	Meta *event.PayloadMeta `json:"meta"`
+	Data AuditEntry         `json:"data"`
+}
+
+// Auditor creates the audit entries of a binding changed from the old to the new values.
+// It is called by the repository within the transaction changing the binding,
+// a nil auditor adds no entries.
+type Auditor func(old, new []string) []AuditEntry
+
+// publishBatchSize is the maximum number of events published at once
+const publishBatchSize = 100
+
+// PublishAuditEntries produces the events of the audit entries which have not been
+// published yet in the order they were added. The events are produced at least once,
+// an entry is published again if it could not be marked as published.
+func PublishAuditEntries(ctx context.Context, r Repository, prod *event.Producer) error {
+	entries, err := r.GetUnpublishedAuditEntries(ctx, publishBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range entries {
+		if err := prod.Produce(ctx, BindingChangedEventID, &BindingChangedEvent{
+			&event.PayloadMeta{
+				Version: "1",
+			},
+			v,
+		}); err != nil {
+			return err
+		}
+
+		if err := r.MarkAuditEntryPublished(ctx, v.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// auditEntries creates the entries for every value added to or removed from
+// a binding. The role and account of an entry are filled in by the caller.
+func auditEntries(actor AccountID, binding AuditBinding, old, new []string) []AuditEntry {
+	now := time.Now().UTC()
+	entries := make([]AuditEntry, 0)
+
+	for i, v := range old {
+		if !containsString(new, v) && !containsString(old[:i], v) {
+			entries = append(entries, AuditEntry{
+				Actor:     actor,
+				Binding:   binding,
+				Action:    AuditRemove,
+				Value:     v,
+				Old:       old,
+				New:       new,
+				CreatedAt: now,
+			})
+		}
+	}
+
+	for i, v := range new {
+		if !containsString(old, v) && !containsString(new[:i], v) {
+			entries = append(entries, AuditEntry{
+				Actor:     actor,
+				Binding:   binding,
+				Action:    AuditAdd,
+				Value:     v,
+				Old:       old,
+				New:       new,
+				CreatedAt: now,
+			})
+		}
+	}
+
+	return entries
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,31 @@
+package rbac_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+func TestAuditFilterMatches(t *testing.T) {
+	entry := rbac.AuditEntry{
+		Actor:     "user/1",
+		RoleID:    "admin",
+		AccountID: "user/2",
+	}
+
+	if !(rbac.AuditFilter{}).Matches(entry) {
+		t.Fatal("an empty filter matches all entries")
+	}
+
+	if !(rbac.AuditFilter{Actor: "user/1", RoleID: "admin", AccountID: "user/2"}).Matches(entry) {
+		t.Fatal("all fields match the entry")
+	}
+
+	if (rbac.AuditFilter{Actor: "user/2"}).Matches(entry) {
+		t.Fatal("the entry has a different actor")
+	}
+
+	if (rbac.AuditFilter{RoleID: "admin", AccountID: "user/3"}).Matches(entry) {
+		t.Fatal("the entry has a different account")
+	}
+}
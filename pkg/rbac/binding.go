@@ -0,0 +1,72 @@
+package rbac
+
+// Binding of a rule to a role
+type Binding struct {
+	RoleID RoleID `json:"role_id"`
+	Rule   Rule   `json:"rule"`
+}
+
+// Bindings is a list of role rule bindings
+type Bindings []Binding
+
+// Roles returns the distinct role ids of the bindings
+func (b Bindings) Roles() AccountRoles {
+	roles := make(AccountRoles, 0)
+	for _, v := range b {
+		if !roles.Contains(v.RoleID) {
+			roles = append(roles, v.RoleID)
+		}
+	}
+
+	return roles
+}
+
+// Rules returns the distinct rules of the bindings
+func (b Bindings) Rules() RoleRules {
+	rules := make(RoleRules, 0)
+	for _, v := range b {
+		if !rules.Contains(v.Rule) {
+			rules = append(rules, v.Rule)
+		}
+	}
+
+	return rules
+}
+
+// Matching returns all bindings whose rule matches the given rule
+func (b Bindings) Matching(rule Rule) Bindings {
+	bindings := make(Bindings, 0)
+	for _, v := range b {
+		if v.Rule.Matches(rule) {
+			bindings = append(bindings, v)
+		}
+	}
+
+	return bindings
+}
+
+// isAllowed checks whether the grants allow access to a rule.
+// Denials always take precedence over grants.
+func isAllowed(grants, denials Bindings, rule Rule) bool {
+	if len(denials.Matching(rule)) > 0 {
+		return false
+	}
+
+	return len(grants.Matching(rule)) > 0
+}
+
+// Explanation of a permission check of an account
+type Explanation struct {
+	AccountID AccountID `json:"account_id"`
+	Rule      Rule      `json:"rule"`
+	Allowed   bool      `json:"allowed"`
+	// Granted contains the bindings of roles of the account
+	// granting access to the rule
+	Granted Bindings `json:"granted"`
+	// Grantable contains the bindings of roles the account
+	// does not have, but which would grant access to the rule
+	Grantable Bindings `json:"grantable"`
+	// Denied contains the deny bindings of roles of the account
+	// denying access to the rule. Denials override all grants.
+	Denied Bindings `json:"denied"`
+}
@@ -0,0 +1,57 @@
+package rbac_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+func TestBindingsRoles(t *testing.T) {
+	bindings := rbac.Bindings{
+		{RoleID: "role1", Rule: "rule1"},
+		{RoleID: "role1", Rule: "rule2"},
+		{RoleID: "role2", Rule: "rule1"},
+	}
+
+	roles := bindings.Roles()
+	if len(roles) != 2 {
+		t.Fatal("the roles of the bindings should be distinct")
+	}
+
+	if !roles.Contains("role1") || !roles.Contains("role2") {
+		t.Fatal("all roles of the bindings should be returned")
+	}
+}
+
+func TestBindingsRules(t *testing.T) {
+	bindings := rbac.Bindings{
+		{RoleID: "role1", Rule: "rule1"},
+		{RoleID: "role1", Rule: "rule2"},
+		{RoleID: "role2", Rule: "rule1"},
+	}
+
+	rules := bindings.Rules()
+	if len(rules) != 2 {
+		t.Fatal("the rules of the bindings should be distinct")
+	}
+
+	if !rules.Contains("rule1") || !rules.Contains("rule2") {
+		t.Fatal("all rules of the bindings should be returned")
+	}
+}
+
+func TestBindingsMatching(t *testing.T) {
+	bindings := rbac.Bindings{
+		{RoleID: "role1", Rule: "chat.*"},
+		{RoleID: "role2", Rule: "chat.send"},
+		{RoleID: "role3", Rule: "inventory.read"},
+	}
+
+	if len(bindings.Matching("chat.send")) != 2 {
+		t.Fatal("the wildcard and the exact binding match")
+	}
+
+	if len(bindings.Matching("vehicle.drive")) != 0 {
+		t.Fatal("no binding matches")
+	}
+}
@@ -0,0 +1,38 @@
+package rbac
+
+// RuleInfo describes a rule enforced by a service
+type RuleInfo struct {
+	Rule        Rule   `json:"rule"`
+	Description string `json:"description"`
+	Service     string `json:"service"`
+}
+
+// RuleCatalog is a list of known rules
+type RuleCatalog []RuleInfo
+
+// Rules returns the rules of the catalog
+func (c RuleCatalog) Rules() RoleRules {
+	rules := make(RoleRules, 0)
+	for _, v := range c {
+		rules = append(rules, v.Rule)
+	}
+
+	return rules
+}
+
+// Knows checks whether a rule is part of the catalog.
+// A wildcard rule is known if it matches at least one rule
+// of the catalog.
+func (c RuleCatalog) Knows(rule Rule) bool {
+	if rule == Wildcard {
+		return true
+	}
+
+	for _, v := range c {
+		if rule.Matches(v.Rule) {
+			return true
+		}
+	}
+
+	return false
+}
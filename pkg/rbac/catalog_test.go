@@ -0,0 +1,40 @@
+package rbac_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+func TestRuleCatalogRules(t *testing.T) {
+	catalog := rbac.RuleCatalog{
+		{Rule: "chat.send", Service: "chat"},
+		{Rule: "chat.read", Service: "chat"},
+	}
+
+	if len(catalog.Rules()) != 2 {
+		t.Fatal("the catalog contains two rules")
+	}
+}
+
+func TestRuleCatalogKnows(t *testing.T) {
+	catalog := rbac.RuleCatalog{
+		{Rule: "chat.send", Service: "chat"},
+	}
+
+	if !catalog.Knows("chat.send") {
+		t.Fatal("the rule is part of the catalog")
+	}
+
+	if catalog.Knows("chat.sned") {
+		t.Fatal("the rule is not part of the catalog")
+	}
+
+	if !catalog.Knows("chat.*") || !catalog.Knows(rbac.Wildcard) {
+		t.Fatal("the wildcards match a rule of the catalog")
+	}
+
+	if catalog.Knows("inventory.*") {
+		t.Fatal("the wildcard does not match any rule of the catalog")
+	}
+}
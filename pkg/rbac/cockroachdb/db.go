@@ -3,7 +3,11 @@ package cockroachdb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
 
+	"github.com/51st-state/api/pkg/pagination"
 	"github.com/51st-state/api/pkg/rbac"
 )
 
@@ -37,12 +41,59 @@ func CreateSchema(ctx context.Context, db *sql.DB) (err error) {
             roleId integer references role_ids (roleId)
         );
         CREATE UNIQUE INDEX IF NOT EXISTS rolebindings_idx_accountId_roleId ON rolebindings (accountId, roleId);
+        CREATE INDEX IF NOT EXISTS rolebindings_idx_roleId ON rolebindings (roleId);
 
         CREATE TABLE IF NOT EXISTS rulebindings (
             roleId integer references role_ids (roleId),
             ruleId integer references rule_ids (ruleId)
         );
-        CREATE UNIQUE INDEX IF NOT EXISTS rulebindings_idx_roleId_ruleId ON rulebindings (roleId, ruleId);`,
+        CREATE UNIQUE INDEX IF NOT EXISTS rulebindings_idx_roleId_ruleId ON rulebindings (roleId, ruleId);
+        CREATE INDEX IF NOT EXISTS rulebindings_idx_ruleId ON rulebindings (ruleId);
+
+        CREATE TABLE IF NOT EXISTS denybindings (
+            roleId integer references role_ids (roleId),
+            ruleId integer references rule_ids (ruleId)
+        );
+        CREATE UNIQUE INDEX IF NOT EXISTS denybindings_idx_roleId_ruleId ON denybindings (roleId, ruleId);
+
+        CREATE TABLE IF NOT EXISTS roleparents (
+            roleId integer references role_ids (roleId),
+            parentId integer references role_ids (roleId)
+        );
+        CREATE UNIQUE INDEX IF NOT EXISTS roleparents_idx_roleId_parentId ON roleparents (roleId, parentId);
+
+        CREATE TABLE IF NOT EXISTS rule_catalog (
+            ruleIdStr TEXT PRIMARY KEY,
+            description TEXT NOT NULL DEFAULT '',
+            service TEXT NOT NULL DEFAULT ''
+        );
+
+        CREATE TABLE IF NOT EXISTS audit_entries (
+            entryId SERIAL PRIMARY KEY,
+            actor TEXT NOT NULL DEFAULT '',
+            binding TEXT NOT NULL DEFAULT '',
+            action TEXT NOT NULL DEFAULT '',
+            roleIdStr TEXT NOT NULL DEFAULT '',
+            accountIdStr TEXT NOT NULL DEFAULT '',
+            bindingValue TEXT NOT NULL DEFAULT '',
+            oldValues JSONB NOT NULL DEFAULT '[]',
+            newValues JSONB NOT NULL DEFAULT '[]',
+            createdAt TIMESTAMPTZ NOT NULL DEFAULT now()
+        );
+        CREATE INDEX IF NOT EXISTS audit_entries_idx_actor ON audit_entries (actor);
+        CREATE INDEX IF NOT EXISTS audit_entries_idx_roleIdStr ON audit_entries (roleIdStr);
+        CREATE INDEX IF NOT EXISTS audit_entries_idx_accountIdStr ON audit_entries (accountIdStr);
+
+        ALTER TABLE audit_entries ADD COLUMN IF NOT EXISTS published BOOL NOT NULL DEFAULT true;`,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the index on the added column is created after the column has been committed
+	_, err = db.ExecContext(
+		ctx,
+		`CREATE INDEX IF NOT EXISTS audit_entries_idx_published_entryId ON audit_entries (published, entryId);`,
 	)
 	return err
 }
@@ -58,21 +109,46 @@ func NewRepository(d *sql.DB) rbac.Repository {
 	}
 }
 
+// tables binding rules to roles
+const (
+	ruleBindingsTable = "rulebindings"
+	denyBindingsTable = "denybindings"
+)
+
+// querier is implemented by the database and its transactions
+type querier interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
 func (d *db) GetRoleRules(ctx context.Context, roleID rbac.RoleID) (rbac.RoleRules, error) {
-	rows, err := d.database.QueryContext(
+	return getRoleRules(ctx, d.database, ruleBindingsTable, roleID)
+}
+
+func (d *db) GetRoleDenyRules(ctx context.Context, roleID rbac.RoleID) (rbac.RoleRules, error) {
+	return getRoleRules(ctx, d.database, denyBindingsTable, roleID)
+}
+
+func getRoleRules(ctx context.Context, q querier, table string, roleID rbac.RoleID) (rbac.RoleRules, error) {
+	rows, err := q.QueryContext(
 		ctx,
-		`SELECT rule_ids.ruleIdStr
-        FROM rule_ids,
-        role_ids,
-        rulebindings
-        WHERE role_ids.roleIdStr = $1
-        AND rulebindings.roleId = role_ids.roleId
-        AND rule_ids.ruleId = rulebindings.ruleId`,
+		fmt.Sprintf(
+			`SELECT rule_ids.ruleIdStr
+            FROM rule_ids,
+            role_ids,
+            %[1]s
+            WHERE role_ids.roleIdStr = $1
+            AND %[1]s.roleId = role_ids.roleId
+            AND rule_ids.ruleId = %[1]s.ruleId`,
+			table,
+		),
 		roleID,
 	)
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	roleRules := make(rbac.RoleRules, 0)
 	for rows.Next() {
@@ -84,11 +160,11 @@ func (d *db) GetRoleRules(ctx context.Context, roleID rbac.RoleID) (rbac.RoleRul
 		roleRules = append(roleRules, rule)
 	}
 
-	return roleRules, nil
+	return roleRules, rows.Err()
 }
 
-func (d *db) upsertRoleID(ctx context.Context, roleID rbac.RoleID) error {
-	_, err := d.database.ExecContext(
+func upsertRoleID(ctx context.Context, q querier, roleID rbac.RoleID) error {
+	_, err := q.ExecContext(
 		ctx,
 		`INSERT INTO role_ids (
             roleIdStr
@@ -108,32 +184,43 @@ func txError(tx *sql.Tx, err error) error {
 	return err
 }
 
-func (d *db) SetRoleRules(ctx context.Context, roleID rbac.RoleID, rules rbac.RoleRules) error {
-	if err := d.upsertRoleID(ctx, roleID); err != nil {
-		return err
-	}
+func (d *db) SetRoleRules(ctx context.Context, roleID rbac.RoleID, rules rbac.RoleRules, auditor rbac.Auditor) error {
+	return d.setRoleRules(ctx, ruleBindingsTable, roleID, rules, auditor)
+}
 
-	roleRules, err := d.GetRoleRules(ctx, roleID)
+func (d *db) SetRoleDenyRules(ctx context.Context, roleID rbac.RoleID, rules rbac.RoleRules, auditor rbac.Auditor) error {
+	return d.setRoleRules(ctx, denyBindingsTable, roleID, rules, auditor)
+}
+
+func (d *db) setRoleRules(ctx context.Context, table string, roleID rbac.RoleID, rules rbac.RoleRules, auditor rbac.Auditor) error {
+	tx, err := d.database.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
-	tx, err := d.database.BeginTx(ctx, nil)
+	if err := upsertRoleID(ctx, tx, roleID); err != nil {
+		return txError(tx, err)
+	}
+
+	roleRules, err := getRoleRules(ctx, tx, table, roleID)
 	if err != nil {
-		return err
+		return txError(tx, err)
 	}
 
 	for _, roleRule := range roleRules {
 		if !rules.Contains(roleRule) {
 			if _, err := tx.ExecContext(
 				ctx,
-				`DELETE FROM rulebindings
-                USING role_ids,
-                rule_ids
-                WHERE role_ids.roleIdStr = $1
-                AND rule_ids.ruleIdStr = $2
-                AND rulebindings.ruleId = rule_ids.ruleId
-                AND rulebindings.roleId = role_ids.roleId`,
+				fmt.Sprintf(
+					`DELETE FROM %[1]s
+                    USING role_ids,
+                    rule_ids
+                    WHERE role_ids.roleIdStr = $1
+                    AND rule_ids.ruleIdStr = $2
+                    AND %[1]s.ruleId = rule_ids.ruleId
+                    AND %[1]s.roleId = role_ids.roleId`,
+					table,
+				),
 				roleID,
 				roleRule,
 			); err != nil {
@@ -158,15 +245,18 @@ func (d *db) SetRoleRules(ctx context.Context, roleID rbac.RoleID, rules rbac.Ro
 
 			if _, err := tx.ExecContext(
 				ctx,
-				`INSERT INTO rulebindings (
-                    roleId,
-                    ruleId
-                ) SELECT role_ids.roleId,
-                rule_ids.ruleId
-                FROM role_ids,
-                rule_ids
-                WHERE role_ids.roleIdStr = $1
-                AND rule_ids.ruleIdStr = $2`,
+				fmt.Sprintf(
+					`INSERT INTO %s (
+                        roleId,
+                        ruleId
+                    ) SELECT role_ids.roleId,
+                    rule_ids.ruleId
+                    FROM role_ids,
+                    rule_ids
+                    WHERE role_ids.roleIdStr = $1
+                    AND rule_ids.ruleIdStr = $2`,
+					table,
+				),
 				roleID,
 				rule,
 			); err != nil {
@@ -175,11 +265,24 @@ func (d *db) SetRoleRules(ctx context.Context, roleID rbac.RoleID, rules rbac.Ro
 		}
 	}
 
+	newRules, err := getRoleRules(ctx, tx, table, roleID)
+	if err != nil {
+		return txError(tx, err)
+	}
+
+	if err := audit(ctx, tx, auditor, roleRules.Strings(), newRules.Strings()); err != nil {
+		return txError(tx, err)
+	}
+
 	return tx.Commit()
 }
 
 func (d *db) GetAccountRoles(ctx context.Context, accountID rbac.AccountID) (rbac.AccountRoles, error) {
-	rows, err := d.database.QueryContext(
+	return getAccountRoles(ctx, d.database, accountID)
+}
+
+func getAccountRoles(ctx context.Context, q querier, accountID rbac.AccountID) (rbac.AccountRoles, error) {
+	rows, err := q.QueryContext(
 		ctx,
 		`SELECT role_ids.roleIdStr
         FROM role_ids,
@@ -193,6 +296,7 @@ func (d *db) GetAccountRoles(ctx context.Context, accountID rbac.AccountID) (rba
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
 	accountRoles := make(rbac.AccountRoles, 0)
 	for rows.Next() {
@@ -206,11 +310,11 @@ func (d *db) GetAccountRoles(ctx context.Context, accountID rbac.AccountID) (rba
 		accountRoles = append(accountRoles, role)
 	}
 
-	return accountRoles, nil
+	return accountRoles, rows.Err()
 }
 
-func (d *db) upsertAccountID(ctx context.Context, accountID rbac.AccountID) error {
-	_, err := d.database.ExecContext(
+func upsertAccountID(ctx context.Context, q querier, accountID rbac.AccountID) error {
+	_, err := q.ExecContext(
 		ctx,
 		`INSERT INTO account_ids (
             accountIdStr
@@ -222,21 +326,51 @@ func (d *db) upsertAccountID(ctx context.Context, accountID rbac.AccountID) erro
 	return err
 }
 
-func (d *db) SetAccountRoles(ctx context.Context, accountID rbac.AccountID, roles rbac.AccountRoles) error {
-	if err := d.upsertAccountID(ctx, accountID); err != nil {
-		return err
-	}
+func (d *db) SetAccountRoles(ctx context.Context, accountID rbac.AccountID, roles rbac.AccountRoles, auditor rbac.Auditor) error {
+	return d.updateAccountRoles(ctx, accountID, auditor, func(rbac.AccountRoles) rbac.AccountRoles {
+		return roles
+	})
+}
+
+func (d *db) AddAccountRoles(ctx context.Context, accountID rbac.AccountID, roles rbac.AccountRoles, auditor rbac.Auditor) error {
+	return d.updateAccountRoles(ctx, accountID, auditor, func(accountRoles rbac.AccountRoles) rbac.AccountRoles {
+		return append(append(make(rbac.AccountRoles, 0), accountRoles...), roles...)
+	})
+}
+
+func (d *db) RemoveAccountRoles(ctx context.Context, accountID rbac.AccountID, roles rbac.AccountRoles, auditor rbac.Auditor) error {
+	return d.updateAccountRoles(ctx, accountID, auditor, func(accountRoles rbac.AccountRoles) rbac.AccountRoles {
+		kept := make(rbac.AccountRoles, 0)
+		for _, v := range accountRoles {
+			if !roles.Contains(v) {
+				kept = append(kept, v)
+			}
+		}
 
-	accountRoles, err := d.GetAccountRoles(ctx, accountID)
+		return kept
+	})
+}
+
+// updateAccountRoles binds the roles returned by update for the current roles
+// of an account. The roles are read and written in one serializable transaction,
+// so concurrent updates of an account do not overwrite each other.
+func (d *db) updateAccountRoles(ctx context.Context, accountID rbac.AccountID, auditor rbac.Auditor, update func(rbac.AccountRoles) rbac.AccountRoles) error {
+	tx, err := d.database.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 
-	tx, err := d.database.BeginTx(ctx, nil)
+	if err := upsertAccountID(ctx, tx, accountID); err != nil {
+		return txError(tx, err)
+	}
+
+	accountRoles, err := getAccountRoles(ctx, tx, accountID)
 	if err != nil {
-		return err
+		return txError(tx, err)
 	}
 
+	roles := update(accountRoles)
+
 	for _, accountRoleID := range accountRoles {
 		if !roles.Contains(accountRoleID) {
 			if _, err := tx.ExecContext(
@@ -277,30 +411,688 @@ func (d *db) SetAccountRoles(ctx context.Context, accountID rbac.AccountID, role
 		}
 	}
 
+	newRoles, err := getAccountRoles(ctx, tx, accountID)
+	if err != nil {
+		return txError(tx, err)
+	}
+
+	if err := audit(ctx, tx, auditor, accountRoles.Strings(), newRoles.Strings()); err != nil {
+		return txError(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+func (d *db) GetRoleParents(ctx context.Context, roleID rbac.RoleID) (rbac.RoleParents, error) {
+	return getRoleParents(ctx, d.database, roleID)
+}
+
+func getRoleParents(ctx context.Context, q querier, roleID rbac.RoleID) (rbac.RoleParents, error) {
+	rows, err := q.QueryContext(
+		ctx,
+		`SELECT parent_ids.roleIdStr
+        FROM roleparents,
+        role_ids,
+        role_ids AS parent_ids
+        WHERE role_ids.roleIdStr = $1
+        AND roleparents.roleId = role_ids.roleId
+        AND parent_ids.roleId = roleparents.parentId`,
+		roleID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	parents := make(rbac.RoleParents, 0)
+	for rows.Next() {
+		var parent rbac.RoleID
+		if err := rows.Scan(&parent); err != nil {
+			return nil, err
+		}
+
+		parents = append(parents, parent)
+	}
+
+	return parents, rows.Err()
+}
+
+func (d *db) SetRoleParents(ctx context.Context, roleID rbac.RoleID, parents rbac.RoleParents, auditor rbac.Auditor) error {
+	tx, err := d.database.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := upsertRoleID(ctx, tx, roleID); err != nil {
+		return txError(tx, err)
+	}
+
+	roleParents, err := getRoleParents(ctx, tx, roleID)
+	if err != nil {
+		return txError(tx, err)
+	}
+
+	for _, roleParent := range roleParents {
+		if !parents.Contains(roleParent) {
+			if _, err := tx.ExecContext(
+				ctx,
+				`DELETE FROM roleparents
+                USING role_ids,
+                role_ids AS parent_ids
+                WHERE role_ids.roleIdStr = $1
+                AND parent_ids.roleIdStr = $2
+                AND roleparents.roleId = role_ids.roleId
+                AND roleparents.parentId = parent_ids.roleId`,
+				roleID,
+				roleParent,
+			); err != nil {
+				return txError(tx, err)
+			}
+		}
+	}
+
+	for _, parent := range parents {
+		if !roleParents.Contains(parent) {
+			if _, err := tx.ExecContext(
+				ctx,
+				`INSERT INTO role_ids (
+                    roleIdStr
+                ) SELECT $1
+                ON CONFLICT
+                DO NOTHING`,
+				parent,
+			); err != nil {
+				return txError(tx, err)
+			}
+
+			if _, err := tx.ExecContext(
+				ctx,
+				`INSERT INTO roleparents (
+                    roleId,
+                    parentId
+                ) SELECT role_ids.roleId,
+                parent_ids.roleId
+                FROM role_ids,
+                role_ids AS parent_ids
+                WHERE role_ids.roleIdStr = $1
+                AND parent_ids.roleIdStr = $2`,
+				roleID,
+				parent,
+			); err != nil {
+				return txError(tx, err)
+			}
+		}
+	}
+
+	newParents, err := getRoleParents(ctx, tx, roleID)
+	if err != nil {
+		return txError(tx, err)
+	}
+
+	if err := audit(ctx, tx, auditor, roleParents.Strings(), newParents.Strings()); err != nil {
+		return txError(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+func scanBindings(rows *sql.Rows) (rbac.Bindings, error) {
+	bindings := make(rbac.Bindings, 0)
+	for rows.Next() {
+		var binding rbac.Binding
+		if err := rows.Scan(
+			&binding.RoleID,
+			&binding.Rule,
+		); err != nil {
+			return nil, err
+		}
+
+		bindings = append(bindings, binding)
+	}
+
+	return bindings, rows.Err()
+}
+
+func (d *db) GetAccountBindings(ctx context.Context, accountID rbac.AccountID) (rbac.Bindings, error) {
+	return d.getAccountBindings(ctx, ruleBindingsTable, accountID)
+}
+
+func (d *db) GetAccountDenyBindings(ctx context.Context, accountID rbac.AccountID) (rbac.Bindings, error) {
+	return d.getAccountBindings(ctx, denyBindingsTable, accountID)
+}
+
+func (d *db) getAccountBindings(ctx context.Context, table string, accountID rbac.AccountID) (rbac.Bindings, error) {
+	rows, err := d.database.QueryContext(
+		ctx,
+		fmt.Sprintf(
+			`WITH RECURSIVE account_roles (roleId) AS (
+                SELECT rolebindings.roleId
+                FROM rolebindings,
+                account_ids
+                WHERE account_ids.accountIdStr = $1
+                AND rolebindings.accountId = account_ids.accountId
+                UNION
+                SELECT roleparents.parentId
+                FROM roleparents,
+                account_roles
+                WHERE roleparents.roleId = account_roles.roleId
+            )
+            SELECT role_ids.roleIdStr,
+            rule_ids.ruleIdStr
+            FROM account_roles,
+            %[1]s,
+            role_ids,
+            rule_ids
+            WHERE %[1]s.roleId = account_roles.roleId
+            AND role_ids.roleId = account_roles.roleId
+            AND rule_ids.ruleId = %[1]s.ruleId`,
+			table,
+		),
+		accountID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanBindings(rows)
+}
+
+// matchingRuleCondition matches the rule ids equal to the rule $1
+// or a wildcard rule matching it
+const matchingRuleCondition = `(
+            rule_ids.ruleIdStr = $1
+            OR rule_ids.ruleIdStr = '*'
+            OR (
+                rule_ids.ruleIdStr LIKE '%.*'
+                AND substr($1, 1, length(rule_ids.ruleIdStr) - 1) = substr(rule_ids.ruleIdStr, 1, length(rule_ids.ruleIdStr) - 1)
+            )
+        )`
+
+func (d *db) GetRuleBindings(ctx context.Context, rule rbac.Rule) (rbac.Bindings, error) {
+	rows, err := d.database.QueryContext(
+		ctx,
+		`SELECT role_ids.roleIdStr,
+        rule_ids.ruleIdStr
+        FROM rulebindings,
+        role_ids,
+        rule_ids
+        WHERE `+matchingRuleCondition+`
+        AND rulebindings.ruleId = rule_ids.ruleId
+        AND role_ids.roleId = rulebindings.roleId`,
+		rule,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanBindings(rows)
+}
+
+func (d *db) RegisterRules(ctx context.Context, catalog rbac.RuleCatalog) error {
+	tx, err := d.database.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range catalog {
+		if _, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO rule_catalog (
+                ruleIdStr,
+                description,
+                service
+            ) VALUES (
+                $1,
+                $2,
+                $3
+            ) ON CONFLICT (
+                ruleIdStr
+            ) DO UPDATE SET description = $4,
+            service = $5`,
+			v.Rule,
+			v.Description,
+			v.Service,
+			v.Description,
+			v.Service,
+		); err != nil {
+			return txError(tx, err)
+		}
+	}
+
 	return tx.Commit()
 }
 
-func (d *db) GetAccountRuleCount(ctx context.Context, accountID rbac.AccountID, rule rbac.Rule) (uint64, error) {
-	var count uint64
-	if err := d.database.QueryRowContext(
+func (d *db) GetRuleCatalog(ctx context.Context) (rbac.RuleCatalog, error) {
+	rows, err := d.database.QueryContext(
+		ctx,
+		`SELECT ruleIdStr,
+        description,
+        service
+        FROM rule_catalog
+        ORDER BY ruleIdStr`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	catalog := make(rbac.RuleCatalog, 0)
+	for rows.Next() {
+		var info rbac.RuleInfo
+		if err := rows.Scan(
+			&info.Rule,
+			&info.Description,
+			&info.Service,
+		); err != nil {
+			return nil, err
+		}
+
+		catalog = append(catalog, info)
+	}
+
+	return catalog, rows.Err()
+}
+
+func scanIDs(rows *sql.Rows) ([]string, error) {
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+func (d *db) queryIDs(ctx context.Context, query string, args ...interface{}) ([]string, error) {
+	rows, err := d.database.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanIDs(rows)
+}
+
+func toRoleIDs(ids []string) []rbac.RoleID {
+	roleIDs := make([]rbac.RoleID, 0)
+	for _, v := range ids {
+		roleIDs = append(roleIDs, rbac.RoleID(v))
+	}
+
+	return roleIDs
+}
+
+func (d *db) ListRoles(ctx context.Context, page pagination.Page) ([]rbac.RoleID, error) {
+	ids, err := d.queryIDs(
 		ctx,
-		`SELECT COUNT(rulebindings.ruleId)
+		`SELECT roleIdStr
+        FROM role_ids
+        WHERE roleIdStr > $1
+        ORDER BY roleIdStr
+        LIMIT $2`,
+		page.Cursor,
+		page.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRoleIDs(ids), nil
+}
+
+func (d *db) ListRoleAccounts(ctx context.Context, roleID rbac.RoleID, page pagination.Page) ([]rbac.AccountID, error) {
+	ids, err := d.queryIDs(
+		ctx,
+		`SELECT account_ids.accountIdStr
         FROM rolebindings,
+        role_ids,
+        account_ids
+        WHERE role_ids.roleIdStr = $1
+        AND rolebindings.roleId = role_ids.roleId
+        AND account_ids.accountId = rolebindings.accountId
+        AND account_ids.accountIdStr > $2
+        ORDER BY account_ids.accountIdStr
+        LIMIT $3`,
+		roleID,
+		page.Cursor,
+		page.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	accountIDs := make([]rbac.AccountID, 0)
+	for _, v := range ids {
+		accountIDs = append(accountIDs, rbac.AccountID(v))
+	}
+
+	return accountIDs, nil
+}
+
+func (d *db) ListRuleRoles(ctx context.Context, rule rbac.Rule, page pagination.Page) ([]rbac.RoleID, error) {
+	// a role grants a rule if it is allowed to an account holding only this role,
+	// so the bindings of the roles inherited from are taken into account
+	ids, err := d.queryIDs(
+		ctx,
+		`WITH RECURSIVE role_ancestors (roleId, ancestorId) AS (
+            SELECT role_ids.roleId,
+            role_ids.roleId
+            FROM role_ids
+            UNION
+            SELECT role_ancestors.roleId,
+            roleparents.parentId
+            FROM roleparents,
+            role_ancestors
+            WHERE roleparents.roleId = role_ancestors.ancestorId
+        )
+        SELECT DISTINCT role_ids.roleIdStr
+        FROM role_ancestors,
         rulebindings,
-        account_ids,
+        role_ids,
         rule_ids
+        WHERE `+matchingRuleCondition+`
+        AND rulebindings.ruleId = rule_ids.ruleId
+        AND rulebindings.roleId = role_ancestors.ancestorId
+        AND role_ids.roleId = role_ancestors.roleId
+        AND role_ids.roleIdStr > $2
+        AND NOT EXISTS (
+            SELECT 1
+            FROM role_ancestors AS denying,
+            denybindings,
+            rule_ids
+            WHERE `+matchingRuleCondition+`
+            AND denybindings.ruleId = rule_ids.ruleId
+            AND denybindings.roleId = denying.ancestorId
+            AND denying.roleId = role_ancestors.roleId
+        )
+        ORDER BY role_ids.roleIdStr
+        LIMIT $3`,
+		rule,
+		page.Cursor,
+		page.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRoleIDs(ids), nil
+}
+
+func (d *db) ListRules(ctx context.Context, page pagination.Page) ([]rbac.Rule, error) {
+	ids, err := d.queryIDs(
+		ctx,
+		`SELECT rule_ids.ruleIdStr
+        FROM rule_ids
+        WHERE rule_ids.ruleIdStr > $1
+        AND EXISTS (
+            SELECT 1
+            FROM rulebindings
+            WHERE rulebindings.ruleId = rule_ids.ruleId
+        )
+        ORDER BY rule_ids.ruleIdStr
+        LIMIT $2`,
+		page.Cursor,
+		page.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]rbac.Rule, 0)
+	for _, v := range ids {
+		rules = append(rules, rbac.Rule(v))
+	}
+
+	return rules, nil
+}
+
+func (d *db) AddAuditEntry(ctx context.Context, entry rbac.AuditEntry) (rbac.AuditEntry, error) {
+	return addAuditEntry(ctx, d.database, entry)
+}
+
+// audit adds the entries of a changed binding
+func audit(ctx context.Context, q querier, auditor rbac.Auditor, old, new []string) error {
+	if auditor == nil {
+		return nil
+	}
+
+	for _, v := range auditor(old, new) {
+		if _, err := addAuditEntry(ctx, q, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addAuditEntry inserts an entry which has not been published yet
+func addAuditEntry(ctx context.Context, q querier, entry rbac.AuditEntry) (rbac.AuditEntry, error) {
+	oldValues, err := json.Marshal(entry.Old)
+	if err != nil {
+		return entry, err
+	}
+
+	newValues, err := json.Marshal(entry.New)
+	if err != nil {
+		return entry, err
+	}
+
+	var id int64
+	if err := q.QueryRowContext(
+		ctx,
+		`INSERT INTO audit_entries (
+            actor,
+            binding,
+            action,
+            roleIdStr,
+            accountIdStr,
+            bindingValue,
+            oldValues,
+            newValues,
+            createdAt,
+            published
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, false)
+        RETURNING entryId`,
+		entry.Actor,
+		entry.Binding,
+		entry.Action,
+		entry.RoleID,
+		entry.AccountID,
+		entry.Value,
+		string(oldValues),
+		string(newValues),
+		entry.CreatedAt,
+	).Scan(&id); err != nil {
+		return entry, err
+	}
+
+	entry.ID = strconv.FormatInt(id, 10)
+
+	return entry, nil
+}
+
+func (d *db) ListAuditEntries(ctx context.Context, filter rbac.AuditFilter, page pagination.Page) ([]rbac.AuditEntry, error) {
+	var cursor int64
+	if page.Cursor != "" {
+		c, err := strconv.ParseInt(page.Cursor, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		cursor = c
+	}
+
+	rows, err := d.database.QueryContext(
+		ctx,
+		`SELECT entryId,
+        actor,
+        binding,
+        action,
+        roleIdStr,
+        accountIdStr,
+        bindingValue,
+        oldValues,
+        newValues,
+        createdAt
+        FROM audit_entries
+        WHERE entryId > $1
+        AND ($2 = '' OR accountIdStr = $2)
+        AND ($3 = '' OR roleIdStr = $3)
+        AND ($4 = '' OR actor = $4)
+        ORDER BY entryId
+        LIMIT $5`,
+		cursor,
+		filter.AccountID,
+		filter.RoleID,
+		filter.Actor,
+		page.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanAuditEntries(rows)
+}
+
+func (d *db) GetUnpublishedAuditEntries(ctx context.Context, limit uint64) ([]rbac.AuditEntry, error) {
+	rows, err := d.database.QueryContext(
+		ctx,
+		`SELECT entryId,
+        actor,
+        binding,
+        action,
+        roleIdStr,
+        accountIdStr,
+        bindingValue,
+        oldValues,
+        newValues,
+        createdAt
+        FROM audit_entries
+        WHERE NOT published
+        ORDER BY entryId
+        LIMIT $1`,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanAuditEntries(rows)
+}
+
+func (d *db) MarkAuditEntryPublished(ctx context.Context, id string) error {
+	_, err := d.database.ExecContext(
+		ctx,
+		`UPDATE audit_entries
+        SET published = true
+        WHERE entryId = $1`,
+		id,
+	)
+	return err
+}
+
+func scanAuditEntries(rows *sql.Rows) ([]rbac.AuditEntry, error) {
+	entries := make([]rbac.AuditEntry, 0)
+	for rows.Next() {
+		var (
+			id        int64
+			entry     rbac.AuditEntry
+			oldValues []byte
+			newValues []byte
+		)
+		if err := rows.Scan(
+			&id,
+			&entry.Actor,
+			&entry.Binding,
+			&entry.Action,
+			&entry.RoleID,
+			&entry.AccountID,
+			&entry.Value,
+			&oldValues,
+			&newValues,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(oldValues, &entry.Old); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(newValues, &entry.New); err != nil {
+			return nil, err
+		}
+
+		entry.ID = strconv.FormatInt(id, 10)
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
+
+func (d *db) DeleteAccount(ctx context.Context, accountID rbac.AccountID) error {
+	tx, err := d.database.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		`DELETE FROM rolebindings
+        USING account_ids
         WHERE account_ids.accountIdStr = $1
-        AND rule_ids.ruleIdStr = $2
-        AND rolebindings.accountId = account_ids.accountId
-        AND rulebindings.roleId = rolebindings.roleId
-        AND rulebindings.ruleId = rule_ids.ruleId`,
+        AND rolebindings.accountId = account_ids.accountId`,
+		accountID,
+	); err != nil {
+		return txError(tx, err)
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		`DELETE FROM account_ids
+        WHERE accountIdStr = $1`,
 		accountID,
-		rule,
-	).Scan(
-		&count,
 	); err != nil {
-		return 0, err
+		return txError(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+func (d *db) AnonymizeAuditEntries(ctx context.Context, accountID, replacement rbac.AccountID) error {
+	tx, err := d.database.BeginTx(ctx, nil)
+	if err != nil {
+		return err
 	}
 
-	return count, nil
+	if _, err := tx.ExecContext(
+		ctx,
+		`UPDATE audit_entries
+        SET accountIdStr = $2
+        WHERE accountIdStr = $1`,
+		accountID,
+		replacement,
+	); err != nil {
+		return txError(tx, err)
+	}
+
+	if _, err := tx.ExecContext(
+		ctx,
+		`UPDATE audit_entries
+        SET actor = $2
+        WHERE actor = $1`,
+		accountID,
+		replacement,
+	); err != nil {
+		return txError(tx, err)
+	}
+
+	return tx.Commit()
 }
@@ -3,6 +3,10 @@ package rbac
 import (
 	"context"
 	"errors"
+	"strconv"
+
+	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/pagination"
 )
 
 // Control of the rbac system
@@ -10,20 +14,39 @@ import (
 type Control interface {
 	GetRoleRules(ctx context.Context, roleID RoleID) (RoleRules, error)
 	SetRoleRules(ctx context.Context, roleID RoleID, rules RoleRules) error
+	GetRoleDenyRules(ctx context.Context, roleID RoleID) (RoleRules, error)
+	SetRoleDenyRules(ctx context.Context, roleID RoleID, rules RoleRules) error
+	GetRoleParents(ctx context.Context, roleID RoleID) (RoleParents, error)
+	SetRoleParents(ctx context.Context, roleID RoleID, parents RoleParents) error
 	GetAccountRoles(ctx context.Context, accountID AccountID) (AccountRoles, error)
 	SetAccountRoles(ctx context.Context, accountID AccountID, roles AccountRoles) error
+	AddAccountRoles(ctx context.Context, accountID AccountID, roles AccountRoles) error
+	RemoveAccountRoles(ctx context.Context, accountID AccountID, roles AccountRoles) error
 	IsAccountAllowed(ctx context.Context, accountID AccountID, rule Rule) (bool, error)
+	CheckMany(ctx context.Context, accountID AccountID, rules []Rule) (map[Rule]bool, error)
+	Explain(ctx context.Context, accountID AccountID, rule Rule) (*Explanation, error)
+	GetAccountBindings(ctx context.Context, accountID AccountID) (Bindings, error)
+	GetAccountDenyBindings(ctx context.Context, accountID AccountID) (Bindings, error)
+	RegisterRules(ctx context.Context, catalog RuleCatalog) error
+	GetRuleCatalog(ctx context.Context) (RuleCatalog, error)
+	ListRoles(ctx context.Context, page pagination.Page) ([]RoleID, error)
+	ListRoleAccounts(ctx context.Context, roleID RoleID, page pagination.Page) ([]AccountID, error)
+	ListRuleRoles(ctx context.Context, rule Rule, page pagination.Page) ([]RoleID, error)
+	ListRules(ctx context.Context, page pagination.Page) ([]Rule, error)
+	ListAuditEntries(ctx context.Context, filter AuditFilter, page pagination.Page) ([]AuditEntry, error)
 }
 
 type control struct {
 	repository Repository
+	event      *event.Producer
 }
 
 // NewControl instantiates a new RBAC control
 //go:generate protoc -I ./proto --go_out=plugins=grpc:./proto ./proto/control.proto
-func NewControl(r Repository) Control {
+func NewControl(r Repository, prod *event.Producer) Control {
 	return &control{
 		r,
+		prod,
 	}
 }
 
@@ -31,6 +54,11 @@ var (
 	errEmptyRoleID    = errors.New("empty role id")
 	errEmptyRule      = errors.New("empty rule")
 	errEmptyAccountID = errors.New("empty account id")
+	errEmptyService   = errors.New("empty service")
+	errUnknownRule    = errors.New("unknown rule")
+	errWildcardRule   = errors.New("wildcard rules can not be registered")
+	errRoleCycle      = errors.New("role inheritance must not contain cycles")
+	errInvalidCursor  = errors.New("invalid audit cursor")
 )
 
 // GetRoleRules gets the rules of  role
@@ -48,13 +76,170 @@ func (m *control) SetRoleRules(ctx context.Context, roleID RoleID, rules RoleRul
 		return errEmptyRoleID
 	}
 
+	if err := m.validateRules(ctx, rules); err != nil {
+		return err
+	}
+
+	if err := m.repository.SetRoleRules(ctx, roleID, rules, auditRole(ctx, AuditRoleRules, roleID)); err != nil {
+		return err
+	}
+
+	m.publish(ctx)
+
+	return nil
+}
+
+// GetRoleDenyRules gets the denied rules of a role
+func (m *control) GetRoleDenyRules(ctx context.Context, roleID RoleID) (RoleRules, error) {
+	if roleID == "" {
+		return nil, errEmptyRoleID
+	}
+
+	return m.repository.GetRoleDenyRules(ctx, roleID)
+}
+
+// SetRoleDenyRules sets the denied rules of a role
+func (m *control) SetRoleDenyRules(ctx context.Context, roleID RoleID, rules RoleRules) error {
+	if roleID == "" {
+		return errEmptyRoleID
+	}
+
+	if err := m.validateRules(ctx, rules); err != nil {
+		return err
+	}
+
+	if err := m.repository.SetRoleDenyRules(ctx, roleID, rules, auditRole(ctx, AuditRoleDenyRules, roleID)); err != nil {
+		return err
+	}
+
+	m.publish(ctx)
+
+	return nil
+}
+
+// validateRules checks whether all rules are known to the rule catalog
+func (m *control) validateRules(ctx context.Context, rules RoleRules) error {
 	for _, v := range rules {
 		if v == "" {
 			return errEmptyRule
 		}
 	}
 
-	return m.repository.SetRoleRules(ctx, roleID, rules)
+	if len(rules) == 0 {
+		return nil
+	}
+
+	catalog, err := m.repository.GetRuleCatalog(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range rules {
+		if !catalog.Knows(v) {
+			return errUnknownRule
+		}
+	}
+
+	return nil
+}
+
+// GetRoleParents gets the roles a role inherits from
+func (m *control) GetRoleParents(ctx context.Context, roleID RoleID) (RoleParents, error) {
+	if roleID == "" {
+		return nil, errEmptyRoleID
+	}
+
+	return m.repository.GetRoleParents(ctx, roleID)
+}
+
+// SetRoleParents sets the roles a role inherits from
+func (m *control) SetRoleParents(ctx context.Context, roleID RoleID, parents RoleParents) error {
+	if roleID == "" {
+		return errEmptyRoleID
+	}
+
+	for _, v := range parents {
+		if v == "" {
+			return errEmptyRoleID
+		}
+	}
+
+	inherits, err := m.inheritsFrom(ctx, parents, roleID)
+	if err != nil {
+		return err
+	}
+
+	if inherits {
+		return errRoleCycle
+	}
+
+	if err := m.repository.SetRoleParents(ctx, roleID, parents, auditRole(ctx, AuditRoleParents, roleID)); err != nil {
+		return err
+	}
+
+	m.publish(ctx)
+
+	return nil
+}
+
+// auditRole creates the auditor of a changed role binding
+func auditRole(ctx context.Context, binding AuditBinding, roleID RoleID) Auditor {
+	actor := ActorFromContext(ctx)
+	return func(old, new []string) []AuditEntry {
+		entries := auditEntries(actor, binding, old, new)
+		for i := range entries {
+			entries[i].RoleID = roleID
+		}
+
+		return entries
+	}
+}
+
+// auditAccount creates the auditor of changed account roles
+func auditAccount(ctx context.Context, accountID AccountID) Auditor {
+	actor := ActorFromContext(ctx)
+	return func(old, new []string) []AuditEntry {
+		entries := auditEntries(actor, AuditAccountRoles, old, new)
+		for i := range entries {
+			entries[i].AccountID = accountID
+			entries[i].RoleID = RoleID(entries[i].Value)
+		}
+
+		return entries
+	}
+}
+
+// publish the events of the committed changes. The change has been applied already,
+// so failures are not returned but left to the periodic PublishAuditEntries.
+func (m *control) publish(ctx context.Context) {
+	PublishAuditEntries(ctx, m.repository, m.event)
+}
+
+// inheritsFrom checks whether one of the roles is or inherits from the given role
+func (m *control) inheritsFrom(ctx context.Context, roles RoleParents, roleID RoleID) (bool, error) {
+	visited := make(RoleParents, 0)
+	for len(roles) > 0 {
+		current := roles[0]
+		roles = roles[1:]
+
+		if current == roleID {
+			return true, nil
+		}
+
+		if visited.Contains(current) {
+			continue
+		}
+		visited = append(visited, current)
+
+		parents, err := m.repository.GetRoleParents(ctx, current)
+		if err != nil {
+			return false, err
+		}
+
+		roles = append(roles, parents...)
+	}
+
+	return false, nil
 }
 
 // GetAccountRoles returns the account roles
@@ -68,6 +253,52 @@ func (m *control) GetAccountRoles(ctx context.Context, accountID AccountID) (Acc
 
 // SetAccountRoles sets the roles of a account
 func (m *control) SetAccountRoles(ctx context.Context, accountID AccountID, roles AccountRoles) error {
+	if err := validateAccountRoles(accountID, roles); err != nil {
+		return err
+	}
+
+	if err := m.repository.SetAccountRoles(ctx, accountID, roles, auditAccount(ctx, accountID)); err != nil {
+		return err
+	}
+
+	m.publish(ctx)
+
+	return nil
+}
+
+// AddAccountRoles binds roles to an account in addition to its current roles.
+// Unlike reading and setting the roles, concurrent changes of the account are kept.
+func (m *control) AddAccountRoles(ctx context.Context, accountID AccountID, roles AccountRoles) error {
+	if err := validateAccountRoles(accountID, roles); err != nil {
+		return err
+	}
+
+	if err := m.repository.AddAccountRoles(ctx, accountID, roles, auditAccount(ctx, accountID)); err != nil {
+		return err
+	}
+
+	m.publish(ctx)
+
+	return nil
+}
+
+// RemoveAccountRoles unbinds roles from an account and keeps its other roles.
+// Unlike reading and setting the roles, concurrent changes of the account are kept.
+func (m *control) RemoveAccountRoles(ctx context.Context, accountID AccountID, roles AccountRoles) error {
+	if err := validateAccountRoles(accountID, roles); err != nil {
+		return err
+	}
+
+	if err := m.repository.RemoveAccountRoles(ctx, accountID, roles, auditAccount(ctx, accountID)); err != nil {
+		return err
+	}
+
+	m.publish(ctx)
+
+	return nil
+}
+
+func validateAccountRoles(accountID AccountID, roles AccountRoles) error {
 	if accountID == "" {
 		return errEmptyAccountID
 	}
@@ -78,7 +309,7 @@ func (m *control) SetAccountRoles(ctx context.Context, accountID AccountID, role
 		}
 	}
 
-	return m.repository.SetAccountRoles(ctx, accountID, roles)
+	return nil
 }
 
 // IsAccountAllowed checks whether a account has access to a rule
@@ -91,10 +322,171 @@ func (m *control) IsAccountAllowed(ctx context.Context, accountID AccountID, rul
 		return false, errEmptyRule
 	}
 
-	count, err := m.repository.GetAccountRuleCount(ctx, accountID, rule)
+	grants, denials, err := m.getAccountGrantsAndDenials(ctx, accountID)
 	if err != nil {
 		return false, err
 	}
 
-	return count > 0, nil
+	return isAllowed(grants, denials, rule), nil
+}
+
+func (m *control) getAccountGrantsAndDenials(ctx context.Context, accountID AccountID) (Bindings, Bindings, error) {
+	grants, err := m.repository.GetAccountBindings(ctx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	denials, err := m.repository.GetAccountDenyBindings(ctx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return grants, denials, nil
+}
+
+// CheckMany checks whether a account has access to each of the given rules
+func (m *control) CheckMany(ctx context.Context, accountID AccountID, rules []Rule) (map[Rule]bool, error) {
+	if accountID == "" {
+		return nil, errEmptyAccountID
+	}
+
+	for _, v := range rules {
+		if v == "" {
+			return nil, errEmptyRule
+		}
+	}
+
+	grants, denials, err := m.getAccountGrantsAndDenials(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make(map[Rule]bool)
+	for _, v := range rules {
+		allowed[v] = isAllowed(grants, denials, v)
+	}
+
+	return allowed, nil
+}
+
+// Explain returns which roles of a account grant or deny access to a rule
+// and which roles would grant access to it
+func (m *control) Explain(ctx context.Context, accountID AccountID, rule Rule) (*Explanation, error) {
+	if accountID == "" {
+		return nil, errEmptyAccountID
+	}
+
+	if rule == "" {
+		return nil, errEmptyRule
+	}
+
+	grants, denials, err := m.getAccountGrantsAndDenials(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleBindings, err := m.repository.GetRuleBindings(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &Explanation{
+		AccountID: accountID,
+		Rule:      rule,
+		Granted:   grants.Matching(rule),
+		Grantable: make(Bindings, 0),
+		Denied:    denials.Matching(rule),
+	}
+
+	grantingRoles := explanation.Granted.Roles()
+	for _, v := range ruleBindings {
+		if !grantingRoles.Contains(v.RoleID) {
+			explanation.Grantable = append(explanation.Grantable, v)
+		}
+	}
+
+	explanation.Allowed = len(explanation.Granted) > 0 && len(explanation.Denied) == 0
+
+	return explanation, nil
+}
+
+// GetAccountBindings returns the effective rule bindings of a account
+func (m *control) GetAccountBindings(ctx context.Context, accountID AccountID) (Bindings, error) {
+	if accountID == "" {
+		return nil, errEmptyAccountID
+	}
+
+	return m.repository.GetAccountBindings(ctx, accountID)
+}
+
+// GetAccountDenyBindings returns the deny bindings of a account
+func (m *control) GetAccountDenyBindings(ctx context.Context, accountID AccountID) (Bindings, error) {
+	if accountID == "" {
+		return nil, errEmptyAccountID
+	}
+
+	return m.repository.GetAccountDenyBindings(ctx, accountID)
+}
+
+// RegisterRules adds the rules of a service to the rule catalog
+func (m *control) RegisterRules(ctx context.Context, catalog RuleCatalog) error {
+	for _, v := range catalog {
+		if v.Rule == "" {
+			return errEmptyRule
+		}
+
+		if v.Rule.IsWildcard() {
+			return errWildcardRule
+		}
+
+		if v.Service == "" {
+			return errEmptyService
+		}
+	}
+
+	return m.repository.RegisterRules(ctx, catalog)
+}
+
+// GetRuleCatalog returns all registered rules
+func (m *control) GetRuleCatalog(ctx context.Context) (RuleCatalog, error) {
+	return m.repository.GetRuleCatalog(ctx)
+}
+
+// ListRoles returns a page of all roles
+func (m *control) ListRoles(ctx context.Context, page pagination.Page) ([]RoleID, error) {
+	return m.repository.ListRoles(ctx, pagination.New(page.Cursor, page.Limit))
+}
+
+// ListRoleAccounts returns a page of the accounts holding a role
+func (m *control) ListRoleAccounts(ctx context.Context, roleID RoleID, page pagination.Page) ([]AccountID, error) {
+	if roleID == "" {
+		return nil, errEmptyRoleID
+	}
+
+	return m.repository.ListRoleAccounts(ctx, roleID, pagination.New(page.Cursor, page.Limit))
+}
+
+// ListRuleRoles returns a page of the roles granting a rule
+func (m *control) ListRuleRoles(ctx context.Context, rule Rule, page pagination.Page) ([]RoleID, error) {
+	if rule == "" {
+		return nil, errEmptyRule
+	}
+
+	return m.repository.ListRuleRoles(ctx, rule, pagination.New(page.Cursor, page.Limit))
+}
+
+// ListRules returns a page of all rules bound to roles
+func (m *control) ListRules(ctx context.Context, page pagination.Page) ([]Rule, error) {
+	return m.repository.ListRules(ctx, pagination.New(page.Cursor, page.Limit))
+}
+
+// ListAuditEntries returns a page of the audit trail matching a filter
+func (m *control) ListAuditEntries(ctx context.Context, filter AuditFilter, page pagination.Page) ([]AuditEntry, error) {
+	if page.Cursor != "" {
+		if _, err := strconv.ParseUint(page.Cursor, 10, 64); err != nil {
+			return nil, errInvalidCursor
+		}
+	}
+
+	return m.repository.ListAuditEntries(ctx, filter, pagination.New(page.Cursor, page.Limit))
 }
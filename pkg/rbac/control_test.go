@@ -6,19 +6,25 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/51st-state/api/pkg/event"
+	"github.com/51st-state/api/pkg/pagination"
+	pubsubMocks "github.com/51st-state/api/pkg/pubsub/mocks"
 	"github.com/51st-state/api/pkg/rbac"
+	"github.com/51st-state/api/pkg/rbac/memory"
 	"github.com/51st-state/api/pkg/rbac/mocks"
+	"github.com/51st-state/api/pkg/token"
+	jwt "github.com/dgrijalva/jwt-go"
 )
 
 func TestNewControl(t *testing.T) {
 	repo := &mocks.FakeRepository{}
-	ctrl := rbac.NewControl(repo)
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
 	fmt.Println(ctrl)
 }
 
 func TestControlGetRoleRules(t *testing.T) {
 	repo := &mocks.FakeRepository{}
-	ctrl := rbac.NewControl(repo)
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
 
 	if _, err := ctrl.GetRoleRules(context.Background(), ""); err == nil {
 		t.Fatal("empty role id")
@@ -31,7 +37,7 @@ func TestControlGetRoleRules(t *testing.T) {
 
 func TestControlSetRoleRules(t *testing.T) {
 	repo := &mocks.FakeRepository{}
-	ctrl := rbac.NewControl(repo)
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
 
 	if err := ctrl.SetRoleRules(context.Background(), "", rbac.RoleRules{}); err == nil {
 		t.Fatal("empty role id")
@@ -48,9 +54,103 @@ func TestControlSetRoleRules(t *testing.T) {
 	}
 }
 
+func TestControlSetRoleRulesUnknownRule(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	repo.GetRuleCatalogReturns(nil, errors.New("fake error"))
+	if err := ctrl.SetRoleRules(context.Background(), "testid", rbac.RoleRules{"chat.send"}); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+
+	repo.GetRuleCatalogReturns(rbac.RuleCatalog{
+		{Rule: "chat.send", Service: "chat"},
+	}, nil)
+	if err := ctrl.SetRoleRules(context.Background(), "testid", rbac.RoleRules{"chat.sned"}); err == nil {
+		t.Fatal("the rule is not registered")
+	}
+
+	if err := ctrl.SetRoleDenyRules(context.Background(), "testid", rbac.RoleRules{"chat.sned"}); err == nil {
+		t.Fatal("the rule is not registered")
+	}
+
+	if repo.SetRoleRulesCallCount() != 0 || repo.SetRoleDenyRulesCallCount() != 0 {
+		t.Fatal("unknown rules should not be stored")
+	}
+
+	if err := ctrl.SetRoleRules(context.Background(), "testid", rbac.RoleRules{"chat.send", "chat.*"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestControlGetRoleParents(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	if _, err := ctrl.GetRoleParents(context.Background(), ""); err == nil {
+		t.Fatal("empty role id")
+	}
+
+	if _, err := ctrl.GetRoleParents(context.Background(), "testid"); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestControlSetRoleParents(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	if err := ctrl.SetRoleParents(context.Background(), "", rbac.RoleParents{}); err == nil {
+		t.Fatal("empty role id")
+	}
+
+	if err := ctrl.SetRoleParents(context.Background(), "child", rbac.RoleParents{""}); err == nil {
+		t.Fatal("empty parent id")
+	}
+
+	if err := ctrl.SetRoleParents(context.Background(), "child", rbac.RoleParents{"child"}); err == nil {
+		t.Fatal("a role can not inherit from itself")
+	}
+
+	// parent inherits from grandparent which inherits from child
+	repo.GetRoleParentsStub = func(ctx context.Context, roleID rbac.RoleID) (rbac.RoleParents, error) {
+		switch roleID {
+		case "parent":
+			return rbac.RoleParents{"grandparent"}, nil
+		case "grandparent":
+			return rbac.RoleParents{"child"}, nil
+		}
+		return rbac.RoleParents{}, nil
+	}
+	if err := ctrl.SetRoleParents(context.Background(), "child", rbac.RoleParents{"parent"}); err == nil {
+		t.Fatal("the inheritance would contain a cycle")
+	}
+
+	repo.GetRoleParentsStub = func(ctx context.Context, roleID rbac.RoleID) (rbac.RoleParents, error) {
+		return nil, errors.New("fake error")
+	}
+	if err := ctrl.SetRoleParents(context.Background(), "child", rbac.RoleParents{"parent"}); err == nil {
+		t.Fatal("repository returns an error")
+	}
+
+	repo.GetRoleParentsStub = func(ctx context.Context, roleID rbac.RoleID) (rbac.RoleParents, error) {
+		if roleID == "parent" {
+			return rbac.RoleParents{"grandparent"}, nil
+		}
+		return rbac.RoleParents{}, nil
+	}
+	if err := ctrl.SetRoleParents(context.Background(), "child", rbac.RoleParents{"parent"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if repo.SetRoleParentsCallCount() != 1 {
+		t.Fatal("the parents should be stored once")
+	}
+}
+
 func TestControlGetAccountRoles(t *testing.T) {
 	repo := &mocks.FakeRepository{}
-	ctrl := rbac.NewControl(repo)
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
 
 	if _, err := ctrl.GetAccountRoles(context.Background(), ""); err == nil {
 		t.Fatal("empty role id")
@@ -63,7 +163,7 @@ func TestControlGetAccountRoles(t *testing.T) {
 
 func TestControlSetAccountRoles(t *testing.T) {
 	repo := &mocks.FakeRepository{}
-	ctrl := rbac.NewControl(repo)
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
 
 	if err := ctrl.SetAccountRoles(context.Background(), "", rbac.AccountRoles{}); err == nil {
 		t.Fatal("empty account id")
@@ -80,9 +180,54 @@ func TestControlSetAccountRoles(t *testing.T) {
 	}
 }
 
+func TestControlAddRemoveAccountRoles(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+	ctx := rbac.ActorToContext(context.Background(), "user/1")
+
+	if err := ctrl.AddAccountRoles(ctx, "", rbac.AccountRoles{"member"}); err == nil {
+		t.Fatal("empty account id")
+	}
+
+	if err := ctrl.RemoveAccountRoles(ctx, "user/2", rbac.AccountRoles{""}); err == nil {
+		t.Fatal("empty role id")
+	}
+
+	if err := ctrl.AddAccountRoles(ctx, "user/2", rbac.AccountRoles{"member"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	_, accountID, roles, auditor := repo.AddAccountRolesArgsForCall(0)
+	if accountID != "user/2" || !roles.Contains("member") {
+		t.Fatal("the roles should be added by the repository")
+	}
+
+	if entries := auditor([]string{}, []string{"member"}); len(entries) != 1 || entries[0].Actor != "user/1" || entries[0].AccountID != "user/2" {
+		t.Fatal("the added role should be audited")
+	}
+
+	if err := ctrl.RemoveAccountRoles(ctx, "user/2", rbac.AccountRoles{"member"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if _, accountID, roles, _ := repo.RemoveAccountRolesArgsForCall(0); accountID != "user/2" || !roles.Contains("member") {
+		t.Fatal("the roles should be removed by the repository")
+	}
+
+	repo.AddAccountRolesReturns(errors.New("fake error"))
+	if err := ctrl.AddAccountRoles(ctx, "user/2", rbac.AccountRoles{"member"}); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+
+	repo.RemoveAccountRolesReturns(errors.New("fake error"))
+	if err := ctrl.RemoveAccountRoles(ctx, "user/2", rbac.AccountRoles{"member"}); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+}
+
 func TestControlIsAccountAllowed(t *testing.T) {
 	repo := &mocks.FakeRepository{}
-	ctrl := rbac.NewControl(repo)
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
 
 	if _, err := ctrl.IsAccountAllowed(context.Background(), "", "ruleID"); err == nil {
 		t.Fatal("empty account id")
@@ -92,22 +237,593 @@ func TestControlIsAccountAllowed(t *testing.T) {
 		t.Fatal("empty rule")
 	}
 
-	repo.GetAccountRuleCountReturns(0, errors.New("fake error"))
+	repo.GetAccountBindingsReturns(nil, errors.New("fake error"))
+	if _, err := ctrl.IsAccountAllowed(context.Background(), "accountID", "rule"); err == nil {
+		t.Fatal("repository returns an error")
+	}
+
+	repo.GetAccountBindingsReturns(rbac.Bindings{}, nil)
+	repo.GetAccountDenyBindingsReturns(nil, errors.New("fake error"))
 	if _, err := ctrl.IsAccountAllowed(context.Background(), "accountID", "rule"); err == nil {
 		t.Fatal("repository returns an error")
 	}
 
-	repo.GetAccountRuleCountReturns(0, nil)
+	repo.GetAccountDenyBindingsReturns(rbac.Bindings{}, nil)
 	if allowed, err := ctrl.IsAccountAllowed(context.Background(), "accountID", "rule"); err != nil {
 		t.Fatal("there should be no error")
 	} else if allowed {
 		t.Fatal("this account is not allowed")
 	}
 
-	repo.GetAccountRuleCountReturns(1, nil)
+	repo.GetAccountBindingsReturns(rbac.Bindings{
+		{RoleID: "role1", Rule: "rule"},
+	}, nil)
 	if allowed, err := ctrl.IsAccountAllowed(context.Background(), "accountID", "rule"); err != nil {
 		t.Fatal("there should be no error")
 	} else if !allowed {
 		t.Fatal("this account has all necessary permissions")
 	}
 }
+
+func TestControlIsAccountAllowedDenyPrecedence(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	// role1 grants everything, role2 denies the whole chat namespace
+	repo.GetAccountBindingsReturns(rbac.Bindings{
+		{RoleID: "role1", Rule: rbac.Wildcard},
+		{RoleID: "role2", Rule: "chat.send"},
+	}, nil)
+	repo.GetAccountDenyBindingsReturns(rbac.Bindings{
+		{RoleID: "role2", Rule: "chat.*"},
+	}, nil)
+
+	if allowed, err := ctrl.IsAccountAllowed(context.Background(), "accountID", "inventory.read"); err != nil {
+		t.Fatal("there should be no error")
+	} else if !allowed {
+		t.Fatal("the wildcard grant of role1 allows the rule")
+	}
+
+	if allowed, err := ctrl.IsAccountAllowed(context.Background(), "accountID", "chat.send"); err != nil {
+		t.Fatal("there should be no error")
+	} else if allowed {
+		t.Fatal("the deny of role2 overrides the grants of role1 and role2")
+	}
+
+	// a deny on another role held by the account overrides the exact grant
+	repo.GetAccountBindingsReturns(rbac.Bindings{
+		{RoleID: "role1", Rule: "inventory.read"},
+	}, nil)
+	repo.GetAccountDenyBindingsReturns(rbac.Bindings{
+		{RoleID: "role2", Rule: rbac.Wildcard},
+	}, nil)
+
+	if allowed, err := ctrl.IsAccountAllowed(context.Background(), "accountID", "inventory.read"); err != nil {
+		t.Fatal("there should be no error")
+	} else if allowed {
+		t.Fatal("the wildcard deny of role2 overrides all grants")
+	}
+}
+
+func TestControlIsAccountAllowedInheritedDenyPrecedence(t *testing.T) {
+	ctx := context.Background()
+	ctrl := rbac.NewControl(memory.NewRepository(), event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	if err := ctrl.RegisterRules(ctx, rbac.RuleCatalog{
+		{Rule: "chat.send", Description: "Send chat messages", Service: "chat"},
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	// the parent denies what the child allows
+	if err := ctrl.SetRoleDenyRules(ctx, "deny-parent", rbac.RoleRules{"chat.send"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := ctrl.SetRoleRules(ctx, "allow-child", rbac.RoleRules{"chat.send"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := ctrl.SetRoleParents(ctx, "allow-child", rbac.RoleParents{"deny-parent"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := ctrl.SetAccountRoles(ctx, "account1", rbac.AccountRoles{"allow-child"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if allowed, err := ctrl.IsAccountAllowed(ctx, "account1", "chat.send"); err != nil {
+		t.Fatal("there should be no error")
+	} else if allowed {
+		t.Fatal("the inherited deny of the parent overrides the grant of the child")
+	}
+
+	// the child denies what the parent allows
+	if err := ctrl.SetRoleRules(ctx, "allow-parent", rbac.RoleRules{"chat.send"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := ctrl.SetRoleDenyRules(ctx, "deny-child", rbac.RoleRules{"chat.send"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := ctrl.SetRoleParents(ctx, "deny-child", rbac.RoleParents{"allow-parent"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := ctrl.SetAccountRoles(ctx, "account2", rbac.AccountRoles{"deny-child"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if allowed, err := ctrl.IsAccountAllowed(ctx, "account2", "chat.send"); err != nil {
+		t.Fatal("there should be no error")
+	} else if allowed {
+		t.Fatal("the deny of the child overrides the inherited grant of the parent")
+	}
+
+	// without the deny the inherited grant applies
+	if err := ctrl.SetRoleDenyRules(ctx, "deny-child", rbac.RoleRules{}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if allowed, err := ctrl.IsAccountAllowed(ctx, "account2", "chat.send"); err != nil {
+		t.Fatal("there should be no error")
+	} else if !allowed {
+		t.Fatal("the grant of the parent is inherited by the child")
+	}
+}
+
+func TestControlCheckMany(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	if _, err := ctrl.CheckMany(context.Background(), "", []rbac.Rule{"rule"}); err == nil {
+		t.Fatal("empty account id")
+	}
+
+	if _, err := ctrl.CheckMany(context.Background(), "accountID", []rbac.Rule{"rule", ""}); err == nil {
+		t.Fatal("empty rule")
+	}
+
+	repo.GetAccountBindingsReturns(nil, errors.New("fake error"))
+	if _, err := ctrl.CheckMany(context.Background(), "accountID", []rbac.Rule{"rule"}); err == nil {
+		t.Fatal("repository returns an error")
+	}
+
+	repo.GetAccountBindingsReturns(rbac.Bindings{
+		{RoleID: "role1", Rule: "rule1"},
+		{RoleID: "role2", Rule: "rule2"},
+		{RoleID: "role2", Rule: "chat.*"},
+	}, nil)
+	repo.GetAccountDenyBindingsReturns(rbac.Bindings{
+		{RoleID: "role1", Rule: "chat.admin"},
+	}, nil)
+	allowed, err := ctrl.CheckMany(context.Background(), "accountID", []rbac.Rule{"rule1", "rule2", "rule3", "chat.send", "chat.admin"})
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !allowed["rule1"] || !allowed["rule2"] || !allowed["chat.send"] {
+		t.Fatal("the account has access to rule1, rule2 and chat.send")
+	}
+
+	if v, ok := allowed["rule3"]; !ok || v {
+		t.Fatal("the account has no access to rule3")
+	}
+
+	if allowed["chat.admin"] {
+		t.Fatal("chat.admin is denied")
+	}
+
+	if repo.GetAccountBindingsCallCount() != 2 || repo.GetAccountDenyBindingsCallCount() != 1 {
+		t.Fatal("the bindings should be fetched once per check")
+	}
+}
+
+func TestControlExplain(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	if _, err := ctrl.Explain(context.Background(), "", "rule"); err == nil {
+		t.Fatal("empty account id")
+	}
+
+	if _, err := ctrl.Explain(context.Background(), "accountID", ""); err == nil {
+		t.Fatal("empty rule")
+	}
+
+	repo.GetAccountBindingsReturns(nil, errors.New("fake error"))
+	if _, err := ctrl.Explain(context.Background(), "accountID", "rule"); err == nil {
+		t.Fatal("repository returns an error")
+	}
+
+	repo.GetAccountBindingsReturns(rbac.Bindings{
+		{RoleID: "role1", Rule: "rule"},
+		{RoleID: "role1", Rule: "other"},
+	}, nil)
+	repo.GetAccountDenyBindingsReturns(nil, errors.New("fake error"))
+	if _, err := ctrl.Explain(context.Background(), "accountID", "rule"); err == nil {
+		t.Fatal("repository returns an error")
+	}
+
+	repo.GetAccountDenyBindingsReturns(rbac.Bindings{}, nil)
+	repo.GetRuleBindingsReturns(nil, errors.New("fake error"))
+	if _, err := ctrl.Explain(context.Background(), "accountID", "rule"); err == nil {
+		t.Fatal("repository returns an error")
+	}
+
+	repo.GetRuleBindingsReturns(rbac.Bindings{
+		{RoleID: "role1", Rule: "rule"},
+		{RoleID: "role2", Rule: "rule"},
+	}, nil)
+	explanation, err := ctrl.Explain(context.Background(), "accountID", "rule")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !explanation.Allowed {
+		t.Fatal("the account has access through role1")
+	}
+
+	if len(explanation.Granted) != 1 || explanation.Granted[0].RoleID != "role1" {
+		t.Fatal("role1 should grant the rule")
+	}
+
+	if len(explanation.Grantable) != 1 || explanation.Grantable[0].RoleID != "role2" {
+		t.Fatal("role2 would grant the rule")
+	}
+
+	repo.GetAccountBindingsReturns(rbac.Bindings{}, nil)
+	explanation, err = ctrl.Explain(context.Background(), "accountID", "rule")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if explanation.Allowed {
+		t.Fatal("the account has no roles granting the rule")
+	}
+
+	repo.GetAccountBindingsReturns(rbac.Bindings{
+		{RoleID: "role1", Rule: "rule"},
+	}, nil)
+	repo.GetAccountDenyBindingsReturns(rbac.Bindings{
+		{RoleID: "role3", Rule: rbac.Wildcard},
+		{RoleID: "role3", Rule: "other"},
+	}, nil)
+	explanation, err = ctrl.Explain(context.Background(), "accountID", "rule")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if explanation.Allowed {
+		t.Fatal("the deny of role3 overrides the grant of role1")
+	}
+
+	if len(explanation.Denied) != 1 || explanation.Denied[0].Rule != rbac.Wildcard {
+		t.Fatal("only the wildcard deny matches the rule")
+	}
+}
+
+func TestControlGetRoleDenyRules(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	if _, err := ctrl.GetRoleDenyRules(context.Background(), ""); err == nil {
+		t.Fatal("empty role id")
+	}
+
+	if _, err := ctrl.GetRoleDenyRules(context.Background(), "testid"); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestControlSetRoleDenyRules(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	if err := ctrl.SetRoleDenyRules(context.Background(), "", rbac.RoleRules{}); err == nil {
+		t.Fatal("empty role id")
+	}
+
+	if err := ctrl.SetRoleDenyRules(context.Background(), "testid", rbac.RoleRules{
+		"",
+	}); err == nil {
+		t.Fatal("empty rule id")
+	}
+
+	if err := ctrl.SetRoleDenyRules(context.Background(), "testid", rbac.RoleRules{}); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestControlGetAccountDenyBindings(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	if _, err := ctrl.GetAccountDenyBindings(context.Background(), ""); err == nil {
+		t.Fatal("empty account id")
+	}
+
+	if _, err := ctrl.GetAccountDenyBindings(context.Background(), "accountID"); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestControlGetAccountBindings(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	if _, err := ctrl.GetAccountBindings(context.Background(), ""); err == nil {
+		t.Fatal("empty account id")
+	}
+
+	if _, err := ctrl.GetAccountBindings(context.Background(), "accountID"); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestControlRegisterRules(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	if err := ctrl.RegisterRules(context.Background(), rbac.RuleCatalog{
+		{Rule: "", Service: "chat"},
+	}); err == nil {
+		t.Fatal("empty rule")
+	}
+
+	if err := ctrl.RegisterRules(context.Background(), rbac.RuleCatalog{
+		{Rule: "chat.send", Service: ""},
+	}); err == nil {
+		t.Fatal("empty service")
+	}
+
+	if err := ctrl.RegisterRules(context.Background(), rbac.RuleCatalog{
+		{Rule: "chat.*", Service: "chat"},
+	}); err == nil {
+		t.Fatal("wildcards can not be registered")
+	}
+
+	if err := ctrl.RegisterRules(context.Background(), rbac.RuleCatalog{
+		{Rule: "chat.send", Description: "Send a chat message", Service: "chat"},
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if repo.RegisterRulesCallCount() != 1 {
+		t.Fatal("the rules should be registered once")
+	}
+}
+
+func TestControlListRoles(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	repo.ListRolesReturns(nil, errors.New("fake error"))
+	if _, err := ctrl.ListRoles(context.Background(), pagination.Page{}); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+
+	repo.ListRolesReturns([]rbac.RoleID{"a", "b"}, nil)
+	roles, err := ctrl.ListRoles(context.Background(), pagination.Page{Cursor: "0", Limit: pagination.MaxLimit + 1})
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(roles) != 2 {
+		t.Fatal("the roles of the repository should be returned")
+	}
+
+	_, page := repo.ListRolesArgsForCall(1)
+	if page.Cursor != "0" || page.Limit != pagination.MaxLimit {
+		t.Fatal("the limit of the page should be capped")
+	}
+}
+
+func TestControlListRoleAccounts(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	if _, err := ctrl.ListRoleAccounts(context.Background(), "", pagination.Page{}); err == nil {
+		t.Fatal("empty role id")
+	}
+
+	if _, err := ctrl.ListRoleAccounts(context.Background(), "testid", pagination.Page{}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	_, _, page := repo.ListRoleAccountsArgsForCall(0)
+	if page.Limit != pagination.DefaultLimit {
+		t.Fatal("an empty limit should be replaced by the default limit")
+	}
+}
+
+func TestControlListRuleRoles(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	if _, err := ctrl.ListRuleRoles(context.Background(), "", pagination.Page{}); err == nil {
+		t.Fatal("empty rule")
+	}
+
+	if _, err := ctrl.ListRuleRoles(context.Background(), "users.delete", pagination.Page{}); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestControlListRules(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	repo.ListRulesReturns(nil, errors.New("fake error"))
+	if _, err := ctrl.ListRules(context.Background(), pagination.Page{}); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+
+	repo.ListRulesReturns([]rbac.Rule{"users.delete"}, nil)
+	if _, err := ctrl.ListRules(context.Background(), pagination.Page{}); err != nil {
+		t.Fatal("there should be no error")
+	}
+}
+
+func TestControlSetAccountRolesAudit(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	prod := &pubsubMocks.FakeProducer{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(prod))
+
+	ctx := token.ToContext(context.Background(), token.New(&jwt.StandardClaims{}, &token.User{
+		ID:   "1",
+		Type: "user",
+	}))
+
+	if err := ctrl.SetAccountRoles(ctx, "user/2", rbac.AccountRoles{"member", "moderator"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	_, _, _, auditor := repo.SetAccountRolesArgsForCall(0)
+	entries := auditor([]string{"admin", "member"}, []string{"member", "moderator"})
+	if len(entries) != 2 {
+		t.Fatal("the removed and the added role should be audited")
+	}
+
+	removed := entries[0]
+	if removed.Action != rbac.AuditRemove ||
+		removed.Binding != rbac.AuditAccountRoles ||
+		removed.Actor != "user/1" ||
+		removed.AccountID != "user/2" ||
+		removed.RoleID != "admin" ||
+		len(removed.Old) != 2 ||
+		len(removed.New) != 2 {
+		t.Fatal("the removed role should be audited")
+	}
+
+	added := entries[1]
+	if added.Action != rbac.AuditAdd || added.RoleID != "moderator" {
+		t.Fatal("the added role should be audited")
+	}
+
+	if len(auditor([]string{"member", "moderator"}, []string{"moderator", "member"})) != 0 {
+		t.Fatal("unchanged roles should not be audited")
+	}
+
+	repo.SetAccountRolesReturns(errors.New("fake error"))
+	if err := ctrl.SetAccountRoles(ctx, "user/2", rbac.AccountRoles{}); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+
+	if repo.GetUnpublishedAuditEntriesCallCount() != 1 {
+		t.Fatal("nothing should be published when the change failed")
+	}
+}
+
+func TestControlSetRoleRulesAudit(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	prod := &pubsubMocks.FakeProducer{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(prod))
+
+	repo.GetRuleCatalogReturns(rbac.RuleCatalog{
+		{Rule: "chat.send", Service: "chat"},
+		{Rule: "chat.read", Service: "chat"},
+	}, nil)
+	repo.GetUnpublishedAuditEntriesReturns([]rbac.AuditEntry{{ID: "1"}}, nil)
+
+	if err := ctrl.SetRoleRules(context.Background(), "testid", rbac.RoleRules{"chat.read", "chat.send"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	_, _, _, auditor := repo.SetRoleRulesArgsForCall(0)
+	entries := auditor([]string{"chat.read"}, []string{"chat.read", "chat.send"})
+	if len(entries) != 1 {
+		t.Fatal("the added rule should be audited")
+	}
+
+	entry := entries[0]
+	if entry.Actor != rbac.SystemActor ||
+		entry.Binding != rbac.AuditRoleRules ||
+		entry.RoleID != "testid" ||
+		entry.Value != "chat.send" {
+		t.Fatal("changes without token should be audited for the system actor")
+	}
+
+	_, data := prod.ProduceArgsForCall(0)
+	e, err := event.Decode(data)
+	if err != nil || e.Meta.ID != rbac.BindingChangedEventID {
+		t.Fatal("a binding changed event should be published")
+	}
+
+	if repo.MarkAuditEntryPublishedCallCount() != 1 {
+		t.Fatal("the published entry should be marked")
+	}
+
+	prod.ProduceReturns(errors.New("fake error"))
+	if err := ctrl.SetRoleRules(context.Background(), "testid", rbac.RoleRules{}); err != nil {
+		t.Fatal("the change is committed even if its event could not be published yet")
+	}
+
+	if repo.MarkAuditEntryPublishedCallCount() != 1 {
+		t.Fatal("an entry should not be marked if its event could not be produced")
+	}
+
+	repo.SetRoleRulesReturns(errors.New("fake error"))
+	if err := ctrl.SetRoleRules(context.Background(), "testid", rbac.RoleRules{}); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+}
+
+func TestPublishAuditEntries(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	prod := &pubsubMocks.FakeProducer{}
+
+	repo.GetUnpublishedAuditEntriesReturns(nil, errors.New("fake error"))
+	if err := rbac.PublishAuditEntries(context.Background(), repo, event.NewProducer(prod)); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+
+	repo.GetUnpublishedAuditEntriesReturns([]rbac.AuditEntry{{ID: "1"}, {ID: "2"}}, nil)
+	repo.MarkAuditEntryPublishedReturns(errors.New("fake error"))
+	if err := rbac.PublishAuditEntries(context.Background(), repo, event.NewProducer(prod)); err == nil {
+		t.Fatal("the entry could not be marked")
+	}
+
+	if prod.ProduceCallCount() != 1 {
+		t.Fatal("publishing should stop at the first error")
+	}
+
+	repo.MarkAuditEntryPublishedReturns(nil)
+	if err := rbac.PublishAuditEntries(context.Background(), repo, event.NewProducer(prod)); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if prod.ProduceCallCount() != 3 || repo.MarkAuditEntryPublishedCallCount() != 3 {
+		t.Fatal("every entry should be published and marked")
+	}
+
+	_, id := repo.MarkAuditEntryPublishedArgsForCall(2)
+	if id != "2" {
+		t.Fatal("the entries should be published in order")
+	}
+}
+
+func TestControlListAuditEntries(t *testing.T) {
+	repo := &mocks.FakeRepository{}
+	ctrl := rbac.NewControl(repo, event.NewProducer(&pubsubMocks.FakeProducer{}))
+
+	if _, err := ctrl.ListAuditEntries(context.Background(), rbac.AuditFilter{}, pagination.Page{Cursor: "abc"}); err == nil {
+		t.Fatal("invalid cursor")
+	}
+
+	repo.ListAuditEntriesReturns(nil, errors.New("fake error"))
+	if _, err := ctrl.ListAuditEntries(context.Background(), rbac.AuditFilter{}, pagination.Page{}); err == nil {
+		t.Fatal("the repository returns an error")
+	}
+
+	repo.ListAuditEntriesReturns([]rbac.AuditEntry{{ID: "1"}}, nil)
+	if _, err := ctrl.ListAuditEntries(context.Background(), rbac.AuditFilter{Actor: "user/1"}, pagination.Page{Cursor: "12"}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	_, filter, page := repo.ListAuditEntriesArgsForCall(1)
+	if filter.Actor != "user/1" || page.Cursor != "12" || page.Limit != pagination.DefaultLimit {
+		t.Fatal("the filter and the normalized page should be passed to the repository")
+	}
+}
@@ -2,9 +2,12 @@ package rbac
 
 import (
 	"context"
+	"time"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc"
 
+	"github.com/51st-state/api/pkg/pagination"
 	pb "github.com/51st-state/api/pkg/rbac/proto"
 )
 
@@ -45,7 +48,7 @@ func (c *grpcClient) SetRoleRules(ctx context.Context, roleID RoleID, rules Role
 		grpcRules.Rules = append(grpcRules.Rules, string(v))
 	}
 
-	_, err := c.client.SetRoleRules(ctx, &pb.SetRoleRulesRequest{
+	_, err := c.client.SetRoleRules(ActorToOutgoingContext(ctx), &pb.SetRoleRulesRequest{
 		RoleID: &pb.RoleID{
 			ID: string(roleID),
 		},
@@ -54,6 +57,76 @@ func (c *grpcClient) SetRoleRules(ctx context.Context, roleID RoleID, rules Role
 	return err
 }
 
+// GetRoleDenyRules gets the denied rules of a role
+func (c *grpcClient) GetRoleDenyRules(ctx context.Context, roleID RoleID) (RoleRules, error) {
+	grpcRules, err := c.client.GetRoleDenyRules(ctx, &pb.RoleID{
+		ID: string(roleID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make(RoleRules, 0)
+	for _, v := range grpcRules.GetRules() {
+		rules = append(rules, Rule(v))
+	}
+
+	return rules, nil
+}
+
+// SetRoleDenyRules sets the denied rules of a role
+func (c *grpcClient) SetRoleDenyRules(ctx context.Context, roleID RoleID, rules RoleRules) error {
+	grpcRules := &pb.RoleRules{
+		Rules: []string{},
+	}
+	for _, v := range rules {
+		grpcRules.Rules = append(grpcRules.Rules, string(v))
+	}
+
+	_, err := c.client.SetRoleDenyRules(ActorToOutgoingContext(ctx), &pb.SetRoleRulesRequest{
+		RoleID: &pb.RoleID{
+			ID: string(roleID),
+		},
+		RoleRules: grpcRules,
+	})
+	return err
+}
+
+// GetRoleParents gets the roles a role inherits from
+func (c *grpcClient) GetRoleParents(ctx context.Context, roleID RoleID) (RoleParents, error) {
+	grpcParents, err := c.client.GetRoleParents(ctx, &pb.RoleID{
+		ID: string(roleID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parents := make(RoleParents, 0)
+	for _, v := range grpcParents.GetRoleIDs() {
+		parents = append(parents, RoleID(v))
+	}
+
+	return parents, nil
+}
+
+// SetRoleParents sets the roles a role inherits from
+func (c *grpcClient) SetRoleParents(ctx context.Context, roleID RoleID, parents RoleParents) error {
+	grpcParents := &pb.RoleParents{
+		RoleIDs: []string{},
+	}
+	for _, v := range parents {
+		grpcParents.RoleIDs = append(grpcParents.RoleIDs, string(v))
+	}
+
+	_, err := c.client.SetRoleParents(ActorToOutgoingContext(ctx), &pb.SetRoleParentsRequest{
+		RoleID: &pb.RoleID{
+			ID: string(roleID),
+		},
+		RoleParents: grpcParents,
+	})
+	return err
+}
+
 // GetAccountRoles returns the account roles
 func (c *grpcClient) GetAccountRoles(ctx context.Context, accountID AccountID) (AccountRoles, error) {
 	grpcRoles, err := c.client.GetAccountRoles(ctx, &pb.AccountID{
@@ -73,6 +146,23 @@ func (c *grpcClient) GetAccountRoles(ctx context.Context, accountID AccountID) (
 
 // SetAccountRoles sets the roles of a account
 func (c *grpcClient) SetAccountRoles(ctx context.Context, accountID AccountID, roles AccountRoles) error {
+	_, err := c.client.SetAccountRoles(ActorToOutgoingContext(ctx), accountRolesRequest(accountID, roles))
+	return err
+}
+
+// AddAccountRoles binds roles to an account in addition to its current roles
+func (c *grpcClient) AddAccountRoles(ctx context.Context, accountID AccountID, roles AccountRoles) error {
+	_, err := c.client.AddAccountRoles(ActorToOutgoingContext(ctx), accountRolesRequest(accountID, roles))
+	return err
+}
+
+// RemoveAccountRoles unbinds roles from an account and keeps its other roles
+func (c *grpcClient) RemoveAccountRoles(ctx context.Context, accountID AccountID, roles AccountRoles) error {
+	_, err := c.client.RemoveAccountRoles(ActorToOutgoingContext(ctx), accountRolesRequest(accountID, roles))
+	return err
+}
+
+func accountRolesRequest(accountID AccountID, roles AccountRoles) *pb.SetAccountRolesRequest {
 	grpcRoles := &pb.AccountRoles{
 		RoleIDs: []string{},
 	}
@@ -80,13 +170,12 @@ func (c *grpcClient) SetAccountRoles(ctx context.Context, accountID AccountID, r
 		grpcRoles.RoleIDs = append(grpcRoles.RoleIDs, string(v))
 	}
 
-	_, err := c.client.SetAccountRoles(ctx, &pb.SetAccountRolesRequest{
+	return &pb.SetAccountRolesRequest{
 		AccountID: &pb.AccountID{
 			ID: string(accountID),
 		},
 		AccountRoles: grpcRoles,
-	})
-	return err
+	}
 }
 
 // IsAccountAllowed checks whether a account has access to a rule
@@ -105,3 +194,229 @@ func (c *grpcClient) IsAccountAllowed(ctx context.Context, accountID AccountID,
 
 	return resp.GetAllowed(), nil
 }
+
+// CheckMany checks whether a account has access to each of the given rules
+func (c *grpcClient) CheckMany(ctx context.Context, accountID AccountID, rules []Rule) (map[Rule]bool, error) {
+	grpcRules := make([]*pb.Rule, 0)
+	for _, v := range rules {
+		grpcRules = append(grpcRules, &pb.Rule{
+			Rule: string(v),
+		})
+	}
+
+	resp, err := c.client.CheckMany(ctx, &pb.CheckManyRequest{
+		AccountID: &pb.AccountID{
+			ID: string(accountID),
+		},
+		Rules: grpcRules,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make(map[Rule]bool)
+	for _, v := range resp.GetRuleChecks() {
+		allowed[Rule(v.GetRule().GetRule())] = v.GetAllowed()
+	}
+
+	return allowed, nil
+}
+
+// Explain returns which roles of a account grant or deny access to a rule
+// and which roles would grant access to it
+func (c *grpcClient) Explain(ctx context.Context, accountID AccountID, rule Rule) (*Explanation, error) {
+	resp, err := c.client.Explain(ctx, &pb.ExplainRequest{
+		AccountID: &pb.AccountID{
+			ID: string(accountID),
+		},
+		Rule: &pb.Rule{
+			Rule: string(rule),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Explanation{
+		AccountID: AccountID(resp.GetAccountID().GetID()),
+		Rule:      Rule(resp.GetRule().GetRule()),
+		Allowed:   resp.GetAllowed(),
+		Granted:   bindingsFromGRPC(resp.GetGranted()),
+		Grantable: bindingsFromGRPC(resp.GetGrantable()),
+		Denied:    bindingsFromGRPC(resp.GetDenied()),
+	}, nil
+}
+
+// GetAccountBindings returns the effective rule bindings of a account
+func (c *grpcClient) GetAccountBindings(ctx context.Context, accountID AccountID) (Bindings, error) {
+	resp, err := c.client.GetAccountBindings(ctx, &pb.AccountID{
+		ID: string(accountID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bindingsFromGRPC(resp), nil
+}
+
+// GetAccountDenyBindings returns the deny bindings of a account
+func (c *grpcClient) GetAccountDenyBindings(ctx context.Context, accountID AccountID) (Bindings, error) {
+	resp, err := c.client.GetAccountDenyBindings(ctx, &pb.AccountID{
+		ID: string(accountID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bindingsFromGRPC(resp), nil
+}
+
+// RegisterRules adds the rules of a service to the rule catalog
+func (c *grpcClient) RegisterRules(ctx context.Context, catalog RuleCatalog) error {
+	_, err := c.client.RegisterRules(ctx, catalogToGRPC(catalog))
+	return err
+}
+
+// GetRuleCatalog returns all registered rules
+func (c *grpcClient) GetRuleCatalog(ctx context.Context) (RuleCatalog, error) {
+	resp, err := c.client.GetRuleCatalog(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return catalogFromGRPC(resp), nil
+}
+
+// ListRoles returns a page of all roles
+func (c *grpcClient) ListRoles(ctx context.Context, page pagination.Page) ([]RoleID, error) {
+	resp, err := c.client.ListRoles(ctx, pageToGRPC(page))
+	if err != nil {
+		return nil, err
+	}
+
+	return roleIDsFromGRPC(resp), nil
+}
+
+// ListRoleAccounts returns a page of the accounts holding a role
+func (c *grpcClient) ListRoleAccounts(ctx context.Context, roleID RoleID, page pagination.Page) ([]AccountID, error) {
+	resp, err := c.client.ListRoleAccounts(ctx, &pb.ListRoleAccountsRequest{
+		RoleID: &pb.RoleID{
+			ID: string(roleID),
+		},
+		Page: pageToGRPC(page),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accountIDs := make([]AccountID, 0)
+	for _, v := range resp.GetIDs() {
+		accountIDs = append(accountIDs, AccountID(v))
+	}
+
+	return accountIDs, nil
+}
+
+// ListRuleRoles returns a page of the roles granting a rule
+func (c *grpcClient) ListRuleRoles(ctx context.Context, rule Rule, page pagination.Page) ([]RoleID, error) {
+	resp, err := c.client.ListRuleRoles(ctx, &pb.ListRuleRolesRequest{
+		Rule: &pb.Rule{
+			Rule: string(rule),
+		},
+		Page: pageToGRPC(page),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roleIDsFromGRPC(resp), nil
+}
+
+// ListRules returns a page of all rules bound to roles
+func (c *grpcClient) ListRules(ctx context.Context, page pagination.Page) ([]Rule, error) {
+	resp, err := c.client.ListRules(ctx, pageToGRPC(page))
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]Rule, 0)
+	for _, v := range resp.GetRules() {
+		rules = append(rules, Rule(v))
+	}
+
+	return rules, nil
+}
+
+// ListAuditEntries returns a page of the audit trail matching a filter
+func (c *grpcClient) ListAuditEntries(ctx context.Context, filter AuditFilter, page pagination.Page) ([]AuditEntry, error) {
+	resp, err := c.client.ListAuditEntries(ctx, &pb.ListAuditEntriesRequest{
+		Filter: &pb.AuditFilter{
+			AccountID: string(filter.AccountID),
+			RoleID:    string(filter.RoleID),
+			Actor:     string(filter.Actor),
+		},
+		Page: pageToGRPC(page),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]AuditEntry, 0)
+	for _, v := range resp.GetEntries() {
+		entries = append(entries, AuditEntry{
+			ID:        v.GetID(),
+			Actor:     AccountID(v.GetActor()),
+			Binding:   AuditBinding(v.GetBinding()),
+			Action:    AuditAction(v.GetAction()),
+			RoleID:    RoleID(v.GetRoleID()),
+			AccountID: AccountID(v.GetAccountID()),
+			Value:     v.GetValue(),
+			Old:       append(make([]string, 0), v.GetOld()...),
+			New:       append(make([]string, 0), v.GetNew()...),
+			CreatedAt: time.Unix(0, v.GetCreatedAt()).UTC(),
+		})
+	}
+
+	return entries, nil
+}
+
+func pageToGRPC(page pagination.Page) *pb.Page {
+	return &pb.Page{
+		Cursor: page.Cursor,
+		Limit:  page.Limit,
+	}
+}
+
+func roleIDsFromGRPC(grpcRoleIDs *pb.RoleIDs) []RoleID {
+	roleIDs := make([]RoleID, 0)
+	for _, v := range grpcRoleIDs.GetIDs() {
+		roleIDs = append(roleIDs, RoleID(v))
+	}
+
+	return roleIDs
+}
+
+func bindingsFromGRPC(grpcBindings *pb.Bindings) Bindings {
+	bindings := make(Bindings, 0)
+	for _, v := range grpcBindings.GetBindings() {
+		bindings = append(bindings, Binding{
+			RoleID: RoleID(v.GetRoleID().GetID()),
+			Rule:   Rule(v.GetRule().GetRule()),
+		})
+	}
+
+	return bindings
+}
+
+func catalogFromGRPC(grpcCatalog *pb.RuleCatalog) RuleCatalog {
+	catalog := make(RuleCatalog, 0)
+	for _, v := range grpcCatalog.GetRules() {
+		catalog = append(catalog, RuleInfo{
+			Rule:        Rule(v.GetRule().GetRule()),
+			Description: v.GetDescription(),
+			Service:     v.GetService(),
+		})
+	}
+
+	return catalog
+}
@@ -3,6 +3,7 @@ package rbac
 import (
 	"context"
 
+	"github.com/51st-state/api/pkg/pagination"
 	pb "github.com/51st-state/api/pkg/rbac/proto"
 	"github.com/golang/protobuf/ptypes/empty"
 )
@@ -43,6 +44,56 @@ func (s *grpcServer) SetRoleRules(ctx context.Context, req *pb.SetRoleRulesReque
 	return &empty.Empty{}, s.control.SetRoleRules(ctx, RoleID(req.GetRoleID().GetID()), roleRules)
 }
 
+func (s *grpcServer) GetRoleDenyRules(ctx context.Context, roleID *pb.RoleID) (*pb.RoleRules, error) {
+	roleRules, err := s.control.GetRoleDenyRules(ctx, RoleID(roleID.GetID()))
+	if err != nil {
+		return nil, err
+	}
+
+	grpcRoleRules := make([]string, 0)
+	for _, v := range roleRules {
+		grpcRoleRules = append(grpcRoleRules, string(v))
+	}
+
+	return &pb.RoleRules{
+		Rules: grpcRoleRules,
+	}, nil
+}
+
+func (s *grpcServer) SetRoleDenyRules(ctx context.Context, req *pb.SetRoleRulesRequest) (*empty.Empty, error) {
+	roleRules := make(RoleRules, 0)
+	for _, v := range req.GetRoleRules().GetRules() {
+		roleRules = append(roleRules, Rule(v))
+	}
+
+	return &empty.Empty{}, s.control.SetRoleDenyRules(ctx, RoleID(req.GetRoleID().GetID()), roleRules)
+}
+
+func (s *grpcServer) GetRoleParents(ctx context.Context, roleID *pb.RoleID) (*pb.RoleParents, error) {
+	parents, err := s.control.GetRoleParents(ctx, RoleID(roleID.GetID()))
+	if err != nil {
+		return nil, err
+	}
+
+	grpcParents := make([]string, 0)
+	for _, v := range parents {
+		grpcParents = append(grpcParents, string(v))
+	}
+
+	return &pb.RoleParents{
+		RoleIDs: grpcParents,
+	}, nil
+}
+
+func (s *grpcServer) SetRoleParents(ctx context.Context, req *pb.SetRoleParentsRequest) (*empty.Empty, error) {
+	parents := make(RoleParents, 0)
+	for _, v := range req.GetRoleParents().GetRoleIDs() {
+		parents = append(parents, RoleID(v))
+	}
+
+	return &empty.Empty{}, s.control.SetRoleParents(ctx, RoleID(req.GetRoleID().GetID()), parents)
+}
+
 func (s *grpcServer) GetAccountRoles(ctx context.Context, accountID *pb.AccountID) (*pb.AccountRoles, error) {
 	accountRoles, err := s.control.GetAccountRoles(ctx, AccountID(accountID.GetID()))
 	if err != nil {
@@ -60,12 +111,24 @@ func (s *grpcServer) GetAccountRoles(ctx context.Context, accountID *pb.AccountI
 }
 
 func (s *grpcServer) SetAccountRoles(ctx context.Context, req *pb.SetAccountRolesRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, s.control.SetAccountRoles(ctx, AccountID(req.GetAccountID().GetID()), accountRolesFromGRPC(req.GetAccountRoles()))
+}
+
+func (s *grpcServer) AddAccountRoles(ctx context.Context, req *pb.SetAccountRolesRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, s.control.AddAccountRoles(ctx, AccountID(req.GetAccountID().GetID()), accountRolesFromGRPC(req.GetAccountRoles()))
+}
+
+func (s *grpcServer) RemoveAccountRoles(ctx context.Context, req *pb.SetAccountRolesRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, s.control.RemoveAccountRoles(ctx, AccountID(req.GetAccountID().GetID()), accountRolesFromGRPC(req.GetAccountRoles()))
+}
+
+func accountRolesFromGRPC(grpcRoles *pb.AccountRoles) AccountRoles {
 	accountRoles := make(AccountRoles, 0)
-	for _, v := range req.GetAccountRoles().GetRoleIDs() {
+	for _, v := range grpcRoles.GetRoleIDs() {
 		accountRoles = append(accountRoles, RoleID(v))
 	}
 
-	return &empty.Empty{}, s.control.SetAccountRoles(ctx, AccountID(req.GetAccountID().GetID()), accountRoles)
+	return accountRoles
 }
 
 func (s *grpcServer) IsAccountAllowed(ctx context.Context, req *pb.IsAccountAllowedRequest) (*pb.IsAccountAllowedResponse, error) {
@@ -78,3 +141,211 @@ func (s *grpcServer) IsAccountAllowed(ctx context.Context, req *pb.IsAccountAllo
 		Allowed: allowed,
 	}, nil
 }
+
+func (s *grpcServer) CheckMany(ctx context.Context, req *pb.CheckManyRequest) (*pb.CheckManyResponse, error) {
+	rules := make([]Rule, 0)
+	for _, v := range req.GetRules() {
+		rules = append(rules, Rule(v.GetRule()))
+	}
+
+	allowed, err := s.control.CheckMany(ctx, AccountID(req.GetAccountID().GetID()), rules)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcRuleChecks := make([]*pb.RuleCheck, 0)
+	for _, v := range rules {
+		grpcRuleChecks = append(grpcRuleChecks, &pb.RuleCheck{
+			Rule: &pb.Rule{
+				Rule: string(v),
+			},
+			Allowed: allowed[v],
+		})
+	}
+
+	return &pb.CheckManyResponse{
+		RuleChecks: grpcRuleChecks,
+	}, nil
+}
+
+func (s *grpcServer) Explain(ctx context.Context, req *pb.ExplainRequest) (*pb.Explanation, error) {
+	explanation, err := s.control.Explain(ctx, AccountID(req.GetAccountID().GetID()), Rule(req.GetRule().GetRule()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Explanation{
+		AccountID: &pb.AccountID{
+			ID: string(explanation.AccountID),
+		},
+		Rule: &pb.Rule{
+			Rule: string(explanation.Rule),
+		},
+		Allowed:   explanation.Allowed,
+		Granted:   bindingsToGRPC(explanation.Granted),
+		Grantable: bindingsToGRPC(explanation.Grantable),
+		Denied:    bindingsToGRPC(explanation.Denied),
+	}, nil
+}
+
+func (s *grpcServer) GetAccountBindings(ctx context.Context, accountID *pb.AccountID) (*pb.Bindings, error) {
+	bindings, err := s.control.GetAccountBindings(ctx, AccountID(accountID.GetID()))
+	if err != nil {
+		return nil, err
+	}
+
+	return bindingsToGRPC(bindings), nil
+}
+
+func (s *grpcServer) GetAccountDenyBindings(ctx context.Context, accountID *pb.AccountID) (*pb.Bindings, error) {
+	bindings, err := s.control.GetAccountDenyBindings(ctx, AccountID(accountID.GetID()))
+	if err != nil {
+		return nil, err
+	}
+
+	return bindingsToGRPC(bindings), nil
+}
+
+func (s *grpcServer) RegisterRules(ctx context.Context, req *pb.RuleCatalog) (*empty.Empty, error) {
+	return &empty.Empty{}, s.control.RegisterRules(ctx, catalogFromGRPC(req))
+}
+
+func (s *grpcServer) GetRuleCatalog(ctx context.Context, req *empty.Empty) (*pb.RuleCatalog, error) {
+	catalog, err := s.control.GetRuleCatalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return catalogToGRPC(catalog), nil
+}
+
+func (s *grpcServer) ListRoles(ctx context.Context, page *pb.Page) (*pb.RoleIDs, error) {
+	roleIDs, err := s.control.ListRoles(ctx, pageFromGRPC(page))
+	if err != nil {
+		return nil, err
+	}
+
+	return roleIDsToGRPC(roleIDs), nil
+}
+
+func (s *grpcServer) ListRoleAccounts(ctx context.Context, req *pb.ListRoleAccountsRequest) (*pb.AccountIDs, error) {
+	accountIDs, err := s.control.ListRoleAccounts(ctx, RoleID(req.GetRoleID().GetID()), pageFromGRPC(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0)
+	for _, v := range accountIDs {
+		ids = append(ids, string(v))
+	}
+
+	return &pb.AccountIDs{
+		IDs: ids,
+	}, nil
+}
+
+func (s *grpcServer) ListRuleRoles(ctx context.Context, req *pb.ListRuleRolesRequest) (*pb.RoleIDs, error) {
+	roleIDs, err := s.control.ListRuleRoles(ctx, Rule(req.GetRule().GetRule()), pageFromGRPC(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	return roleIDsToGRPC(roleIDs), nil
+}
+
+func (s *grpcServer) ListRules(ctx context.Context, page *pb.Page) (*pb.Rules, error) {
+	rules, err := s.control.ListRules(ctx, pageFromGRPC(page))
+	if err != nil {
+		return nil, err
+	}
+
+	grpcRules := make([]string, 0)
+	for _, v := range rules {
+		grpcRules = append(grpcRules, string(v))
+	}
+
+	return &pb.Rules{
+		Rules: grpcRules,
+	}, nil
+}
+
+func (s *grpcServer) ListAuditEntries(ctx context.Context, req *pb.ListAuditEntriesRequest) (*pb.AuditEntries, error) {
+	entries, err := s.control.ListAuditEntries(ctx, AuditFilter{
+		AccountID: AccountID(req.GetFilter().GetAccountID()),
+		RoleID:    RoleID(req.GetFilter().GetRoleID()),
+		Actor:     AccountID(req.GetFilter().GetActor()),
+	}, pageFromGRPC(req.GetPage()))
+	if err != nil {
+		return nil, err
+	}
+
+	grpcEntries := make([]*pb.AuditEntry, 0)
+	for _, v := range entries {
+		grpcEntries = append(grpcEntries, &pb.AuditEntry{
+			ID:        v.ID,
+			Actor:     string(v.Actor),
+			Binding:   string(v.Binding),
+			Action:    string(v.Action),
+			RoleID:    string(v.RoleID),
+			AccountID: string(v.AccountID),
+			Value:     v.Value,
+			Old:       v.Old,
+			New:       v.New,
+			CreatedAt: v.CreatedAt.UnixNano(),
+		})
+	}
+
+	return &pb.AuditEntries{
+		Entries: grpcEntries,
+	}, nil
+}
+
+func pageFromGRPC(page *pb.Page) pagination.Page {
+	return pagination.New(page.GetCursor(), page.GetLimit())
+}
+
+func roleIDsToGRPC(roleIDs []RoleID) *pb.RoleIDs {
+	ids := make([]string, 0)
+	for _, v := range roleIDs {
+		ids = append(ids, string(v))
+	}
+
+	return &pb.RoleIDs{
+		IDs: ids,
+	}
+}
+
+func bindingsToGRPC(bindings Bindings) *pb.Bindings {
+	grpcBindings := make([]*pb.Binding, 0)
+	for _, v := range bindings {
+		grpcBindings = append(grpcBindings, &pb.Binding{
+			RoleID: &pb.RoleID{
+				ID: string(v.RoleID),
+			},
+			Rule: &pb.Rule{
+				Rule: string(v.Rule),
+			},
+		})
+	}
+
+	return &pb.Bindings{
+		Bindings: grpcBindings,
+	}
+}
+
+func catalogToGRPC(catalog RuleCatalog) *pb.RuleCatalog {
+	grpcRules := make([]*pb.RuleInfo, 0)
+	for _, v := range catalog {
+		grpcRules = append(grpcRules, &pb.RuleInfo{
+			Rule: &pb.Rule{
+				Rule: string(v.Rule),
+			},
+			Description: v.Description,
+			Service:     v.Service,
+		})
+	}
+
+	return &pb.RuleCatalog{
+		Rules: grpcRules,
+	}
+}
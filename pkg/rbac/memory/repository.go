@@ -0,0 +1,458 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/51st-state/api/pkg/pagination"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+type repository struct {
+	mutex sync.RWMutex
+
+	// roleIDs contains all roles known to the repository
+	roleIDs      map[rbac.RoleID]bool
+	ruleBindings map[rbac.RoleID]rbac.RoleRules
+	denyBindings map[rbac.RoleID]rbac.RoleRules
+	parents      map[rbac.RoleID]rbac.RoleParents
+	accountRoles map[rbac.AccountID]rbac.AccountRoles
+	catalog      map[rbac.Rule]rbac.RuleInfo
+	// auditEntries in the order they were added
+	auditEntries []rbac.AuditEntry
+	// published contains the ids of the audit entries published already
+	published map[string]bool
+}
+
+// NewRepository for rbac storage in memory
+func NewRepository() rbac.Repository {
+	return &repository{
+		roleIDs:      make(map[rbac.RoleID]bool),
+		ruleBindings: make(map[rbac.RoleID]rbac.RoleRules),
+		denyBindings: make(map[rbac.RoleID]rbac.RoleRules),
+		parents:      make(map[rbac.RoleID]rbac.RoleParents),
+		accountRoles: make(map[rbac.AccountID]rbac.AccountRoles),
+		catalog:      make(map[rbac.Rule]rbac.RuleInfo),
+		published:    make(map[string]bool),
+	}
+}
+
+func (r *repository) GetRoleRules(ctx context.Context, roleID rbac.RoleID) (rbac.RoleRules, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return append(make(rbac.RoleRules, 0), r.ruleBindings[roleID]...), nil
+}
+
+func (r *repository) SetRoleRules(ctx context.Context, roleID rbac.RoleID, rules rbac.RoleRules, auditor rbac.Auditor) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	old := r.ruleBindings[roleID]
+	r.roleIDs[roleID] = true
+	r.ruleBindings[roleID] = distinctRules(rules)
+	r.audit(auditor, old.Strings(), r.ruleBindings[roleID].Strings())
+
+	return nil
+}
+
+func (r *repository) GetRoleDenyRules(ctx context.Context, roleID rbac.RoleID) (rbac.RoleRules, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return append(make(rbac.RoleRules, 0), r.denyBindings[roleID]...), nil
+}
+
+func (r *repository) SetRoleDenyRules(ctx context.Context, roleID rbac.RoleID, rules rbac.RoleRules, auditor rbac.Auditor) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	old := r.denyBindings[roleID]
+	r.roleIDs[roleID] = true
+	r.denyBindings[roleID] = distinctRules(rules)
+	r.audit(auditor, old.Strings(), r.denyBindings[roleID].Strings())
+
+	return nil
+}
+
+func distinctRules(rules rbac.RoleRules) rbac.RoleRules {
+	distinct := make(rbac.RoleRules, 0)
+	for _, v := range rules {
+		if !distinct.Contains(v) {
+			distinct = append(distinct, v)
+		}
+	}
+
+	return distinct
+}
+
+func (r *repository) GetRoleParents(ctx context.Context, roleID rbac.RoleID) (rbac.RoleParents, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return append(make(rbac.RoleParents, 0), r.parents[roleID]...), nil
+}
+
+func (r *repository) SetRoleParents(ctx context.Context, roleID rbac.RoleID, parents rbac.RoleParents, auditor rbac.Auditor) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	old := r.parents[roleID]
+	r.roleIDs[roleID] = true
+
+	distinct := make(rbac.RoleParents, 0)
+	for _, v := range parents {
+		r.roleIDs[v] = true
+		if !distinct.Contains(v) {
+			distinct = append(distinct, v)
+		}
+	}
+	r.parents[roleID] = distinct
+	r.audit(auditor, old.Strings(), distinct.Strings())
+
+	return nil
+}
+
+func (r *repository) GetAccountRoles(ctx context.Context, accountID rbac.AccountID) (rbac.AccountRoles, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return append(make(rbac.AccountRoles, 0), r.accountRoles[accountID]...), nil
+}
+
+// SetAccountRoles binds the roles to an account.
+// Like in the sql repositories only roles known to the repository are bound.
+func (r *repository) SetAccountRoles(ctx context.Context, accountID rbac.AccountID, roles rbac.AccountRoles, auditor rbac.Auditor) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.setAccountRoles(accountID, roles, auditor)
+
+	return nil
+}
+
+func (r *repository) AddAccountRoles(ctx context.Context, accountID rbac.AccountID, roles rbac.AccountRoles, auditor rbac.Auditor) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.setAccountRoles(accountID, append(append(make(rbac.AccountRoles, 0), r.accountRoles[accountID]...), roles...), auditor)
+
+	return nil
+}
+
+func (r *repository) RemoveAccountRoles(ctx context.Context, accountID rbac.AccountID, roles rbac.AccountRoles, auditor rbac.Auditor) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	kept := make(rbac.AccountRoles, 0)
+	for _, v := range r.accountRoles[accountID] {
+		if !roles.Contains(v) {
+			kept = append(kept, v)
+		}
+	}
+	r.setAccountRoles(accountID, kept, auditor)
+
+	return nil
+}
+
+// setAccountRoles binds the roles to an account, the caller has to hold the lock
+func (r *repository) setAccountRoles(accountID rbac.AccountID, roles rbac.AccountRoles, auditor rbac.Auditor) {
+	old := r.accountRoles[accountID]
+
+	accountRoles := make(rbac.AccountRoles, 0)
+	for _, v := range roles {
+		if r.roleIDs[v] && !accountRoles.Contains(v) {
+			accountRoles = append(accountRoles, v)
+		}
+	}
+	r.accountRoles[accountID] = accountRoles
+	r.audit(auditor, old.Strings(), accountRoles.Strings())
+}
+
+func (r *repository) GetAccountBindings(ctx context.Context, accountID rbac.AccountID) (rbac.Bindings, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.getAccountBindings(r.ruleBindings, accountID), nil
+}
+
+func (r *repository) GetAccountDenyBindings(ctx context.Context, accountID rbac.AccountID) (rbac.Bindings, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.getAccountBindings(r.denyBindings, accountID), nil
+}
+
+// getAccountBindings returns the bindings of the roles of an account
+// including the roles inherited from
+func (r *repository) getAccountBindings(table map[rbac.RoleID]rbac.RoleRules, accountID rbac.AccountID) rbac.Bindings {
+	return r.getBindings(table, r.accountRoles[accountID]...)
+}
+
+// getBindings returns the bindings of roles including the roles inherited from
+func (r *repository) getBindings(table map[rbac.RoleID]rbac.RoleRules, roleIDs ...rbac.RoleID) rbac.Bindings {
+	roles := append(make(rbac.RoleParents, 0), roleIDs...)
+	visited := make(rbac.RoleParents, 0)
+
+	bindings := make(rbac.Bindings, 0)
+	for len(roles) > 0 {
+		current := roles[0]
+		roles = roles[1:]
+
+		if visited.Contains(current) {
+			continue
+		}
+		visited = append(visited, current)
+
+		for _, v := range table[current] {
+			bindings = append(bindings, rbac.Binding{
+				RoleID: current,
+				Rule:   v,
+			})
+		}
+
+		roles = append(roles, r.parents[current]...)
+	}
+
+	return bindings
+}
+
+func (r *repository) RegisterRules(ctx context.Context, catalog rbac.RuleCatalog) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, v := range catalog {
+		r.catalog[v.Rule] = v
+	}
+
+	return nil
+}
+
+func (r *repository) GetRuleCatalog(ctx context.Context) (rbac.RuleCatalog, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	catalog := make(rbac.RuleCatalog, 0)
+	for _, v := range r.catalog {
+		catalog = append(catalog, v)
+	}
+
+	sort.Slice(catalog, func(i, j int) bool {
+		return catalog[i].Rule < catalog[j].Rule
+	})
+
+	return catalog, nil
+}
+
+func (r *repository) GetRuleBindings(ctx context.Context, rule rbac.Rule) (rbac.Bindings, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.ruleBindingsMatching(rule), nil
+}
+
+func (r *repository) ruleBindingsMatching(rule rbac.Rule) rbac.Bindings {
+	bindings := make(rbac.Bindings, 0)
+	for roleID, rules := range r.ruleBindings {
+		for _, v := range rules {
+			bindings = append(bindings, rbac.Binding{
+				RoleID: roleID,
+				Rule:   v,
+			})
+		}
+	}
+
+	return bindings.Matching(rule)
+}
+
+func (r *repository) ListRoles(ctx context.Context, page pagination.Page) ([]rbac.RoleID, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	ids := make([]string, 0)
+	for v := range r.roleIDs {
+		ids = append(ids, string(v))
+	}
+
+	return toRoleIDs(page.Apply(ids)), nil
+}
+
+func (r *repository) ListRoleAccounts(ctx context.Context, roleID rbac.RoleID, page pagination.Page) ([]rbac.AccountID, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	ids := make([]string, 0)
+	for accountID, roles := range r.accountRoles {
+		if roles.Contains(roleID) {
+			ids = append(ids, string(accountID))
+		}
+	}
+
+	accountIDs := make([]rbac.AccountID, 0)
+	for _, v := range page.Apply(ids) {
+		accountIDs = append(accountIDs, rbac.AccountID(v))
+	}
+
+	return accountIDs, nil
+}
+
+func (r *repository) ListRuleRoles(ctx context.Context, rule rbac.Rule, page pagination.Page) ([]rbac.RoleID, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	// a role grants a rule if it is allowed to an account holding only this role
+	ids := make([]string, 0)
+	for v := range r.roleIDs {
+		if len(r.getBindings(r.ruleBindings, v).Matching(rule)) > 0 &&
+			len(r.getBindings(r.denyBindings, v).Matching(rule)) == 0 {
+			ids = append(ids, string(v))
+		}
+	}
+
+	return toRoleIDs(page.Apply(ids)), nil
+}
+
+func (r *repository) ListRules(ctx context.Context, page pagination.Page) ([]rbac.Rule, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	rules := make(rbac.RoleRules, 0)
+	for _, roleRules := range r.ruleBindings {
+		for _, v := range roleRules {
+			if !rules.Contains(v) {
+				rules = append(rules, v)
+			}
+		}
+	}
+
+	ids := make([]string, 0)
+	for _, v := range rules {
+		ids = append(ids, string(v))
+	}
+
+	list := make([]rbac.Rule, 0)
+	for _, v := range page.Apply(ids) {
+		list = append(list, rbac.Rule(v))
+	}
+
+	return list, nil
+}
+
+func toRoleIDs(ids []string) []rbac.RoleID {
+	roleIDs := make([]rbac.RoleID, 0)
+	for _, v := range ids {
+		roleIDs = append(roleIDs, rbac.RoleID(v))
+	}
+
+	return roleIDs
+}
+
+func (r *repository) AddAuditEntry(ctx context.Context, entry rbac.AuditEntry) (rbac.AuditEntry, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.addAuditEntry(entry), nil
+}
+
+// audit adds the entries of a changed binding, the caller has to hold the lock
+func (r *repository) audit(auditor rbac.Auditor, old, new []string) {
+	if auditor == nil {
+		return
+	}
+
+	for _, v := range auditor(old, new) {
+		r.addAuditEntry(v)
+	}
+}
+
+func (r *repository) addAuditEntry(entry rbac.AuditEntry) rbac.AuditEntry {
+	entry.ID = strconv.Itoa(len(r.auditEntries) + 1)
+	entry = copyAuditEntry(entry)
+	r.auditEntries = append(r.auditEntries, entry)
+
+	return copyAuditEntry(entry)
+}
+
+func (r *repository) GetUnpublishedAuditEntries(ctx context.Context, limit uint64) ([]rbac.AuditEntry, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	entries := make([]rbac.AuditEntry, 0)
+	for _, v := range r.auditEntries {
+		if uint64(len(entries)) >= limit {
+			break
+		}
+
+		if !r.published[v.ID] {
+			entries = append(entries, copyAuditEntry(v))
+		}
+	}
+
+	return entries, nil
+}
+
+func (r *repository) MarkAuditEntryPublished(ctx context.Context, id string) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.published[id] = true
+
+	return nil
+}
+
+func (r *repository) ListAuditEntries(ctx context.Context, filter rbac.AuditFilter, page pagination.Page) ([]rbac.AuditEntry, error) {
+	var cursor int
+	if page.Cursor != "" {
+		c, err := strconv.Atoi(page.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		cursor = c
+	}
+
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	entries := make([]rbac.AuditEntry, 0)
+	for i := cursor; i >= 0 && i < len(r.auditEntries) && uint64(len(entries)) < page.Limit; i++ {
+		if filter.Matches(r.auditEntries[i]) {
+			entries = append(entries, copyAuditEntry(r.auditEntries[i]))
+		}
+	}
+
+	return entries, nil
+}
+
+func copyAuditEntry(entry rbac.AuditEntry) rbac.AuditEntry {
+	entry.Old = append(make([]string, 0), entry.Old...)
+	entry.New = append(make([]string, 0), entry.New...)
+	return entry
+}
+
+func (r *repository) DeleteAccount(ctx context.Context, accountID rbac.AccountID) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	delete(r.accountRoles, accountID)
+
+	return nil
+}
+
+func (r *repository) AnonymizeAuditEntries(ctx context.Context, accountID, replacement rbac.AccountID) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for i, v := range r.auditEntries {
+		if v.AccountID == accountID {
+			r.auditEntries[i].AccountID = replacement
+		}
+
+		if v.Actor == accountID {
+			r.auditEntries[i].Actor = replacement
+		}
+	}
+
+	return nil
+}
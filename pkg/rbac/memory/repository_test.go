@@ -0,0 +1,15 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/rbac"
+	"github.com/51st-state/api/pkg/rbac/memory"
+	"github.com/51st-state/api/pkg/rbac/repositorytest"
+)
+
+func TestRepository(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) rbac.Repository {
+		return memory.NewRepository()
+	})
+}
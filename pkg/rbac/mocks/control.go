@@ -5,6 +5,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/51st-state/api/pkg/pagination"
 	"github.com/51st-state/api/pkg/rbac"
 )
 
@@ -36,6 +37,60 @@ type FakeControl struct {
 	setRoleRulesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetRoleDenyRulesStub        func(ctx context.Context, roleID rbac.RoleID) (rbac.RoleRules, error)
+	getRoleDenyRulesMutex       sync.RWMutex
+	getRoleDenyRulesArgsForCall []struct {
+		ctx    context.Context
+		roleID rbac.RoleID
+	}
+	getRoleDenyRulesReturns struct {
+		result1 rbac.RoleRules
+		result2 error
+	}
+	getRoleDenyRulesReturnsOnCall map[int]struct {
+		result1 rbac.RoleRules
+		result2 error
+	}
+	SetRoleDenyRulesStub        func(ctx context.Context, roleID rbac.RoleID, rules rbac.RoleRules) error
+	setRoleDenyRulesMutex       sync.RWMutex
+	setRoleDenyRulesArgsForCall []struct {
+		ctx    context.Context
+		roleID rbac.RoleID
+		rules  rbac.RoleRules
+	}
+	setRoleDenyRulesReturns struct {
+		result1 error
+	}
+	setRoleDenyRulesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetRoleParentsStub        func(ctx context.Context, roleID rbac.RoleID) (rbac.RoleParents, error)
+	getRoleParentsMutex       sync.RWMutex
+	getRoleParentsArgsForCall []struct {
+		ctx    context.Context
+		roleID rbac.RoleID
+	}
+	getRoleParentsReturns struct {
+		result1 rbac.RoleParents
+		result2 error
+	}
+	getRoleParentsReturnsOnCall map[int]struct {
+		result1 rbac.RoleParents
+		result2 error
+	}
+	SetRoleParentsStub        func(ctx context.Context, roleID rbac.RoleID, parents rbac.RoleParents) error
+	setRoleParentsMutex       sync.RWMutex
+	setRoleParentsArgsForCall []struct {
+		ctx     context.Context
+		roleID  rbac.RoleID
+		parents rbac.RoleParents
+	}
+	setRoleParentsReturns struct {
+		result1 error
+	}
+	setRoleParentsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetAccountRolesStub        func(ctx context.Context, accountID rbac.AccountID) (rbac.AccountRoles, error)
 	getAccountRolesMutex       sync.RWMutex
 	getAccountRolesArgsForCall []struct {
@@ -63,6 +118,32 @@ type FakeControl struct {
 	setAccountRolesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AddAccountRolesStub        func(ctx context.Context, accountID rbac.AccountID, roles rbac.AccountRoles) error
+	addAccountRolesMutex       sync.RWMutex
+	addAccountRolesArgsForCall []struct {
+		ctx       context.Context
+		accountID rbac.AccountID
+		roles     rbac.AccountRoles
+	}
+	addAccountRolesReturns struct {
+		result1 error
+	}
+	addAccountRolesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveAccountRolesStub        func(ctx context.Context, accountID rbac.AccountID, roles rbac.AccountRoles) error
+	removeAccountRolesMutex       sync.RWMutex
+	removeAccountRolesArgsForCall []struct {
+		ctx       context.Context
+		accountID rbac.AccountID
+		roles     rbac.AccountRoles
+	}
+	removeAccountRolesReturns struct {
+		result1 error
+	}
+	removeAccountRolesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	IsAccountAllowedStub        func(ctx context.Context, accountID rbac.AccountID, rule rbac.Rule) (bool, error)
 	isAccountAllowedMutex       sync.RWMutex
 	isAccountAllowedArgsForCall []struct {
@@ -78,6 +159,162 @@ type FakeControl struct {
 		result1 bool
 		result2 error
 	}
+	CheckManyStub        func(ctx context.Context, accountID rbac.AccountID, rules []rbac.Rule) (map[rbac.Rule]bool, error)
+	checkManyMutex       sync.RWMutex
+	checkManyArgsForCall []struct {
+		ctx       context.Context
+		accountID rbac.AccountID
+		rules     []rbac.Rule
+	}
+	checkManyReturns struct {
+		result1 map[rbac.Rule]bool
+		result2 error
+	}
+	checkManyReturnsOnCall map[int]struct {
+		result1 map[rbac.Rule]bool
+		result2 error
+	}
+	ExplainStub        func(ctx context.Context, accountID rbac.AccountID, rule rbac.Rule) (*rbac.Explanation, error)
+	explainMutex       sync.RWMutex
+	explainArgsForCall []struct {
+		ctx       context.Context
+		accountID rbac.AccountID
+		rule      rbac.Rule
+	}
+	explainReturns struct {
+		result1 *rbac.Explanation
+		result2 error
+	}
+	explainReturnsOnCall map[int]struct {
+		result1 *rbac.Explanation
+		result2 error
+	}
+	GetAccountBindingsStub        func(ctx context.Context, accountID rbac.AccountID) (rbac.Bindings, error)
+	getAccountBindingsMutex       sync.RWMutex
+	getAccountBindingsArgsForCall []struct {
+		ctx       context.Context
+		accountID rbac.AccountID
+	}
+	getAccountBindingsReturns struct {
+		result1 rbac.Bindings
+		result2 error
+	}
+	getAccountBindingsReturnsOnCall map[int]struct {
+		result1 rbac.Bindings
+		result2 error
+	}
+	GetAccountDenyBindingsStub        func(ctx context.Context, accountID rbac.AccountID) (rbac.Bindings, error)
+	getAccountDenyBindingsMutex       sync.RWMutex
+	getAccountDenyBindingsArgsForCall []struct {
+		ctx       context.Context
+		accountID rbac.AccountID
+	}
+	getAccountDenyBindingsReturns struct {
+		result1 rbac.Bindings
+		result2 error
+	}
+	getAccountDenyBindingsReturnsOnCall map[int]struct {
+		result1 rbac.Bindings
+		result2 error
+	}
+	RegisterRulesStub        func(ctx context.Context, catalog rbac.RuleCatalog) error
+	registerRulesMutex       sync.RWMutex
+	registerRulesArgsForCall []struct {
+		ctx     context.Context
+		catalog rbac.RuleCatalog
+	}
+	registerRulesReturns struct {
+		result1 error
+	}
+	registerRulesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetRuleCatalogStub        func(ctx context.Context) (rbac.RuleCatalog, error)
+	getRuleCatalogMutex       sync.RWMutex
+	getRuleCatalogArgsForCall []struct {
+		ctx context.Context
+	}
+	getRuleCatalogReturns struct {
+		result1 rbac.RuleCatalog
+		result2 error
+	}
+	getRuleCatalogReturnsOnCall map[int]struct {
+		result1 rbac.RuleCatalog
+		result2 error
+	}
+	ListRolesStub        func(ctx context.Context, page pagination.Page) ([]rbac.RoleID, error)
+	listRolesMutex       sync.RWMutex
+	listRolesArgsForCall []struct {
+		ctx  context.Context
+		page pagination.Page
+	}
+	listRolesReturns struct {
+		result1 []rbac.RoleID
+		result2 error
+	}
+	listRolesReturnsOnCall map[int]struct {
+		result1 []rbac.RoleID
+		result2 error
+	}
+	ListRoleAccountsStub        func(ctx context.Context, roleID rbac.RoleID, page pagination.Page) ([]rbac.AccountID, error)
+	listRoleAccountsMutex       sync.RWMutex
+	listRoleAccountsArgsForCall []struct {
+		ctx    context.Context
+		roleID rbac.RoleID
+		page   pagination.Page
+	}
+	listRoleAccountsReturns struct {
+		result1 []rbac.AccountID
+		result2 error
+	}
+	listRoleAccountsReturnsOnCall map[int]struct {
+		result1 []rbac.AccountID
+		result2 error
+	}
+	ListRuleRolesStub        func(ctx context.Context, rule rbac.Rule, page pagination.Page) ([]rbac.RoleID, error)
+	listRuleRolesMutex       sync.RWMutex
+	listRuleRolesArgsForCall []struct {
+		ctx  context.Context
+		rule rbac.Rule
+		page pagination.Page
+	}
+	listRuleRolesReturns struct {
+		result1 []rbac.RoleID
+		result2 error
+	}
+	listRuleRolesReturnsOnCall map[int]struct {
+		result1 []rbac.RoleID
+		result2 error
+	}
+	ListRulesStub        func(ctx context.Context, page pagination.Page) ([]rbac.Rule, error)
+	listRulesMutex       sync.RWMutex
+	listRulesArgsForCall []struct {
+		ctx  context.Context
+		page pagination.Page
+	}
+	listRulesReturns struct {
+		result1 []rbac.Rule
+		result2 error
+	}
+	listRulesReturnsOnCall map[int]struct {
+		result1 []rbac.Rule
+		result2 error
+	}
+	ListAuditEntriesStub        func(ctx context.Context, filter rbac.AuditFilter, page pagination.Page) ([]rbac.AuditEntry, error)
+	listAuditEntriesMutex       sync.RWMutex
+	listAuditEntriesArgsForCall []struct {
+		ctx    context.Context
+		filter rbac.AuditFilter
+		page   pagination.Page
+	}
+	listAuditEntriesReturns struct {
+		result1 []rbac.AuditEntry
+		result2 error
+	}
+	listAuditEntriesReturnsOnCall map[int]struct {
+		result1 []rbac.AuditEntry
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -184,6 +421,210 @@ func (fake *FakeControl) SetRoleRulesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeControl) GetRoleDenyRules(ctx context.Context, roleID rbac.RoleID) (rbac.RoleRules, error) {
+	fake.getRoleDenyRulesMutex.Lock()
+	ret, specificReturn := fake.getRoleDenyRulesReturnsOnCall[len(fake.getRoleDenyRulesArgsForCall)]
+	fake.getRoleDenyRulesArgsForCall = append(fake.getRoleDenyRulesArgsForCall, struct {
+		ctx    context.Context
+		roleID rbac.RoleID
+	}{ctx, roleID})
+	fake.recordInvocation("GetRoleDenyRules", []interface{}{ctx, roleID})
+	fake.getRoleDenyRulesMutex.Unlock()
+	if fake.GetRoleDenyRulesStub != nil {
+		return fake.GetRoleDenyRulesStub(ctx, roleID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getRoleDenyRulesReturns.result1, fake.getRoleDenyRulesReturns.result2
+}
+
+func (fake *FakeControl) GetRoleDenyRulesCallCount() int {
+	fake.getRoleDenyRulesMutex.RLock()
+	defer fake.getRoleDenyRulesMutex.RUnlock()
+	return len(fake.getRoleDenyRulesArgsForCall)
+}
+
+func (fake *FakeControl) GetRoleDenyRulesArgsForCall(i int) (context.Context, rbac.RoleID) {
+	fake.getRoleDenyRulesMutex.RLock()
+	defer fake.getRoleDenyRulesMutex.RUnlock()
+	return fake.getRoleDenyRulesArgsForCall[i].ctx, fake.getRoleDenyRulesArgsForCall[i].roleID
+}
+
+func (fake *FakeControl) GetRoleDenyRulesReturns(result1 rbac.RoleRules, result2 error) {
+	fake.GetRoleDenyRulesStub = nil
+	fake.getRoleDenyRulesReturns = struct {
+		result1 rbac.RoleRules
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) GetRoleDenyRulesReturnsOnCall(i int, result1 rbac.RoleRules, result2 error) {
+	fake.GetRoleDenyRulesStub = nil
+	if fake.getRoleDenyRulesReturnsOnCall == nil {
+		fake.getRoleDenyRulesReturnsOnCall = make(map[int]struct {
+			result1 rbac.RoleRules
+			result2 error
+		})
+	}
+	fake.getRoleDenyRulesReturnsOnCall[i] = struct {
+		result1 rbac.RoleRules
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) SetRoleDenyRules(ctx context.Context, roleID rbac.RoleID, rules rbac.RoleRules) error {
+	fake.setRoleDenyRulesMutex.Lock()
+	ret, specificReturn := fake.setRoleDenyRulesReturnsOnCall[len(fake.setRoleDenyRulesArgsForCall)]
+	fake.setRoleDenyRulesArgsForCall = append(fake.setRoleDenyRulesArgsForCall, struct {
+		ctx    context.Context
+		roleID rbac.RoleID
+		rules  rbac.RoleRules
+	}{ctx, roleID, rules})
+	fake.recordInvocation("SetRoleDenyRules", []interface{}{ctx, roleID, rules})
+	fake.setRoleDenyRulesMutex.Unlock()
+	if fake.SetRoleDenyRulesStub != nil {
+		return fake.SetRoleDenyRulesStub(ctx, roleID, rules)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setRoleDenyRulesReturns.result1
+}
+
+func (fake *FakeControl) SetRoleDenyRulesCallCount() int {
+	fake.setRoleDenyRulesMutex.RLock()
+	defer fake.setRoleDenyRulesMutex.RUnlock()
+	return len(fake.setRoleDenyRulesArgsForCall)
+}
+
+func (fake *FakeControl) SetRoleDenyRulesArgsForCall(i int) (context.Context, rbac.RoleID, rbac.RoleRules) {
+	fake.setRoleDenyRulesMutex.RLock()
+	defer fake.setRoleDenyRulesMutex.RUnlock()
+	return fake.setRoleDenyRulesArgsForCall[i].ctx, fake.setRoleDenyRulesArgsForCall[i].roleID, fake.setRoleDenyRulesArgsForCall[i].rules
+}
+
+func (fake *FakeControl) SetRoleDenyRulesReturns(result1 error) {
+	fake.SetRoleDenyRulesStub = nil
+	fake.setRoleDenyRulesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeControl) SetRoleDenyRulesReturnsOnCall(i int, result1 error) {
+	fake.SetRoleDenyRulesStub = nil
+	if fake.setRoleDenyRulesReturnsOnCall == nil {
+		fake.setRoleDenyRulesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setRoleDenyRulesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeControl) GetRoleParents(ctx context.Context, roleID rbac.RoleID) (rbac.RoleParents, error) {
+	fake.getRoleParentsMutex.Lock()
+	ret, specificReturn := fake.getRoleParentsReturnsOnCall[len(fake.getRoleParentsArgsForCall)]
+	fake.getRoleParentsArgsForCall = append(fake.getRoleParentsArgsForCall, struct {
+		ctx    context.Context
+		roleID rbac.RoleID
+	}{ctx, roleID})
+	fake.recordInvocation("GetRoleParents", []interface{}{ctx, roleID})
+	fake.getRoleParentsMutex.Unlock()
+	if fake.GetRoleParentsStub != nil {
+		return fake.GetRoleParentsStub(ctx, roleID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getRoleParentsReturns.result1, fake.getRoleParentsReturns.result2
+}
+
+func (fake *FakeControl) GetRoleParentsCallCount() int {
+	fake.getRoleParentsMutex.RLock()
+	defer fake.getRoleParentsMutex.RUnlock()
+	return len(fake.getRoleParentsArgsForCall)
+}
+
+func (fake *FakeControl) GetRoleParentsArgsForCall(i int) (context.Context, rbac.RoleID) {
+	fake.getRoleParentsMutex.RLock()
+	defer fake.getRoleParentsMutex.RUnlock()
+	return fake.getRoleParentsArgsForCall[i].ctx, fake.getRoleParentsArgsForCall[i].roleID
+}
+
+func (fake *FakeControl) GetRoleParentsReturns(result1 rbac.RoleParents, result2 error) {
+	fake.GetRoleParentsStub = nil
+	fake.getRoleParentsReturns = struct {
+		result1 rbac.RoleParents
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) GetRoleParentsReturnsOnCall(i int, result1 rbac.RoleParents, result2 error) {
+	fake.GetRoleParentsStub = nil
+	if fake.getRoleParentsReturnsOnCall == nil {
+		fake.getRoleParentsReturnsOnCall = make(map[int]struct {
+			result1 rbac.RoleParents
+			result2 error
+		})
+	}
+	fake.getRoleParentsReturnsOnCall[i] = struct {
+		result1 rbac.RoleParents
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) SetRoleParents(ctx context.Context, roleID rbac.RoleID, parents rbac.RoleParents) error {
+	fake.setRoleParentsMutex.Lock()
+	ret, specificReturn := fake.setRoleParentsReturnsOnCall[len(fake.setRoleParentsArgsForCall)]
+	fake.setRoleParentsArgsForCall = append(fake.setRoleParentsArgsForCall, struct {
+		ctx     context.Context
+		roleID  rbac.RoleID
+		parents rbac.RoleParents
+	}{ctx, roleID, parents})
+	fake.recordInvocation("SetRoleParents", []interface{}{ctx, roleID, parents})
+	fake.setRoleParentsMutex.Unlock()
+	if fake.SetRoleParentsStub != nil {
+		return fake.SetRoleParentsStub(ctx, roleID, parents)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setRoleParentsReturns.result1
+}
+
+func (fake *FakeControl) SetRoleParentsCallCount() int {
+	fake.setRoleParentsMutex.RLock()
+	defer fake.setRoleParentsMutex.RUnlock()
+	return len(fake.setRoleParentsArgsForCall)
+}
+
+func (fake *FakeControl) SetRoleParentsArgsForCall(i int) (context.Context, rbac.RoleID, rbac.RoleParents) {
+	fake.setRoleParentsMutex.RLock()
+	defer fake.setRoleParentsMutex.RUnlock()
+	return fake.setRoleParentsArgsForCall[i].ctx, fake.setRoleParentsArgsForCall[i].roleID, fake.setRoleParentsArgsForCall[i].parents
+}
+
+func (fake *FakeControl) SetRoleParentsReturns(result1 error) {
+	fake.SetRoleParentsStub = nil
+	fake.setRoleParentsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeControl) SetRoleParentsReturnsOnCall(i int, result1 error) {
+	fake.SetRoleParentsStub = nil
+	if fake.setRoleParentsReturnsOnCall == nil {
+		fake.setRoleParentsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setRoleParentsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeControl) GetAccountRoles(ctx context.Context, accountID rbac.AccountID) (rbac.AccountRoles, error) {
 	fake.getAccountRolesMutex.Lock()
 	ret, specificReturn := fake.getAccountRolesReturnsOnCall[len(fake.getAccountRolesArgsForCall)]
@@ -286,6 +727,106 @@ func (fake *FakeControl) SetAccountRolesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeControl) AddAccountRoles(ctx context.Context, accountID rbac.AccountID, roles rbac.AccountRoles) error {
+	fake.addAccountRolesMutex.Lock()
+	ret, specificReturn := fake.addAccountRolesReturnsOnCall[len(fake.addAccountRolesArgsForCall)]
+	fake.addAccountRolesArgsForCall = append(fake.addAccountRolesArgsForCall, struct {
+		ctx       context.Context
+		accountID rbac.AccountID
+		roles     rbac.AccountRoles
+	}{ctx, accountID, roles})
+	fake.recordInvocation("AddAccountRoles", []interface{}{ctx, accountID, roles})
+	fake.addAccountRolesMutex.Unlock()
+	if fake.AddAccountRolesStub != nil {
+		return fake.AddAccountRolesStub(ctx, accountID, roles)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.addAccountRolesReturns.result1
+}
+
+func (fake *FakeControl) AddAccountRolesCallCount() int {
+	fake.addAccountRolesMutex.RLock()
+	defer fake.addAccountRolesMutex.RUnlock()
+	return len(fake.addAccountRolesArgsForCall)
+}
+
+func (fake *FakeControl) AddAccountRolesArgsForCall(i int) (context.Context, rbac.AccountID, rbac.AccountRoles) {
+	fake.addAccountRolesMutex.RLock()
+	defer fake.addAccountRolesMutex.RUnlock()
+	return fake.addAccountRolesArgsForCall[i].ctx, fake.addAccountRolesArgsForCall[i].accountID, fake.addAccountRolesArgsForCall[i].roles
+}
+
+func (fake *FakeControl) AddAccountRolesReturns(result1 error) {
+	fake.AddAccountRolesStub = nil
+	fake.addAccountRolesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeControl) AddAccountRolesReturnsOnCall(i int, result1 error) {
+	fake.AddAccountRolesStub = nil
+	if fake.addAccountRolesReturnsOnCall == nil {
+		fake.addAccountRolesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addAccountRolesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeControl) RemoveAccountRoles(ctx context.Context, accountID rbac.AccountID, roles rbac.AccountRoles) error {
+	fake.removeAccountRolesMutex.Lock()
+	ret, specificReturn := fake.removeAccountRolesReturnsOnCall[len(fake.removeAccountRolesArgsForCall)]
+	fake.removeAccountRolesArgsForCall = append(fake.removeAccountRolesArgsForCall, struct {
+		ctx       context.Context
+		accountID rbac.AccountID
+		roles     rbac.AccountRoles
+	}{ctx, accountID, roles})
+	fake.recordInvocation("RemoveAccountRoles", []interface{}{ctx, accountID, roles})
+	fake.removeAccountRolesMutex.Unlock()
+	if fake.RemoveAccountRolesStub != nil {
+		return fake.RemoveAccountRolesStub(ctx, accountID, roles)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.removeAccountRolesReturns.result1
+}
+
+func (fake *FakeControl) RemoveAccountRolesCallCount() int {
+	fake.removeAccountRolesMutex.RLock()
+	defer fake.removeAccountRolesMutex.RUnlock()
+	return len(fake.removeAccountRolesArgsForCall)
+}
+
+func (fake *FakeControl) RemoveAccountRolesArgsForCall(i int) (context.Context, rbac.AccountID, rbac.AccountRoles) {
+	fake.removeAccountRolesMutex.RLock()
+	defer fake.removeAccountRolesMutex.RUnlock()
+	return fake.removeAccountRolesArgsForCall[i].ctx, fake.removeAccountRolesArgsForCall[i].accountID, fake.removeAccountRolesArgsForCall[i].roles
+}
+
+func (fake *FakeControl) RemoveAccountRolesReturns(result1 error) {
+	fake.RemoveAccountRolesStub = nil
+	fake.removeAccountRolesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeControl) RemoveAccountRolesReturnsOnCall(i int, result1 error) {
+	fake.RemoveAccountRolesStub = nil
+	if fake.removeAccountRolesReturnsOnCall == nil {
+		fake.removeAccountRolesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeAccountRolesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeControl) IsAccountAllowed(ctx context.Context, accountID rbac.AccountID, rule rbac.Rule) (bool, error) {
 	fake.isAccountAllowedMutex.Lock()
 	ret, specificReturn := fake.isAccountAllowedReturnsOnCall[len(fake.isAccountAllowedArgsForCall)]
@@ -339,6 +880,584 @@ func (fake *FakeControl) IsAccountAllowedReturnsOnCall(i int, result1 bool, resu
 	}{result1, result2}
 }
 
+func (fake *FakeControl) CheckMany(ctx context.Context, accountID rbac.AccountID, rules []rbac.Rule) (map[rbac.Rule]bool, error) {
+	var rulesCopy []rbac.Rule
+	if rules != nil {
+		rulesCopy = make([]rbac.Rule, len(rules))
+		copy(rulesCopy, rules)
+	}
+	fake.checkManyMutex.Lock()
+	ret, specificReturn := fake.checkManyReturnsOnCall[len(fake.checkManyArgsForCall)]
+	fake.checkManyArgsForCall = append(fake.checkManyArgsForCall, struct {
+		ctx       context.Context
+		accountID rbac.AccountID
+		rules     []rbac.Rule
+	}{ctx, accountID, rulesCopy})
+	fake.recordInvocation("CheckMany", []interface{}{ctx, accountID, rulesCopy})
+	fake.checkManyMutex.Unlock()
+	if fake.CheckManyStub != nil {
+		return fake.CheckManyStub(ctx, accountID, rules)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.checkManyReturns.result1, fake.checkManyReturns.result2
+}
+
+func (fake *FakeControl) CheckManyCallCount() int {
+	fake.checkManyMutex.RLock()
+	defer fake.checkManyMutex.RUnlock()
+	return len(fake.checkManyArgsForCall)
+}
+
+func (fake *FakeControl) CheckManyArgsForCall(i int) (context.Context, rbac.AccountID, []rbac.Rule) {
+	fake.checkManyMutex.RLock()
+	defer fake.checkManyMutex.RUnlock()
+	return fake.checkManyArgsForCall[i].ctx, fake.checkManyArgsForCall[i].accountID, fake.checkManyArgsForCall[i].rules
+}
+
+func (fake *FakeControl) CheckManyReturns(result1 map[rbac.Rule]bool, result2 error) {
+	fake.CheckManyStub = nil
+	fake.checkManyReturns = struct {
+		result1 map[rbac.Rule]bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) CheckManyReturnsOnCall(i int, result1 map[rbac.Rule]bool, result2 error) {
+	fake.CheckManyStub = nil
+	if fake.checkManyReturnsOnCall == nil {
+		fake.checkManyReturnsOnCall = make(map[int]struct {
+			result1 map[rbac.Rule]bool
+			result2 error
+		})
+	}
+	fake.checkManyReturnsOnCall[i] = struct {
+		result1 map[rbac.Rule]bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) Explain(ctx context.Context, accountID rbac.AccountID, rule rbac.Rule) (*rbac.Explanation, error) {
+	fake.explainMutex.Lock()
+	ret, specificReturn := fake.explainReturnsOnCall[len(fake.explainArgsForCall)]
+	fake.explainArgsForCall = append(fake.explainArgsForCall, struct {
+		ctx       context.Context
+		accountID rbac.AccountID
+		rule      rbac.Rule
+	}{ctx, accountID, rule})
+	fake.recordInvocation("Explain", []interface{}{ctx, accountID, rule})
+	fake.explainMutex.Unlock()
+	if fake.ExplainStub != nil {
+		return fake.ExplainStub(ctx, accountID, rule)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.explainReturns.result1, fake.explainReturns.result2
+}
+
+func (fake *FakeControl) ExplainCallCount() int {
+	fake.explainMutex.RLock()
+	defer fake.explainMutex.RUnlock()
+	return len(fake.explainArgsForCall)
+}
+
+func (fake *FakeControl) ExplainArgsForCall(i int) (context.Context, rbac.AccountID, rbac.Rule) {
+	fake.explainMutex.RLock()
+	defer fake.explainMutex.RUnlock()
+	return fake.explainArgsForCall[i].ctx, fake.explainArgsForCall[i].accountID, fake.explainArgsForCall[i].rule
+}
+
+func (fake *FakeControl) ExplainReturns(result1 *rbac.Explanation, result2 error) {
+	fake.ExplainStub = nil
+	fake.explainReturns = struct {
+		result1 *rbac.Explanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) ExplainReturnsOnCall(i int, result1 *rbac.Explanation, result2 error) {
+	fake.ExplainStub = nil
+	if fake.explainReturnsOnCall == nil {
+		fake.explainReturnsOnCall = make(map[int]struct {
+			result1 *rbac.Explanation
+			result2 error
+		})
+	}
+	fake.explainReturnsOnCall[i] = struct {
+		result1 *rbac.Explanation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) GetAccountBindings(ctx context.Context, accountID rbac.AccountID) (rbac.Bindings, error) {
+	fake.getAccountBindingsMutex.Lock()
+	ret, specificReturn := fake.getAccountBindingsReturnsOnCall[len(fake.getAccountBindingsArgsForCall)]
+	fake.getAccountBindingsArgsForCall = append(fake.getAccountBindingsArgsForCall, struct {
+		ctx       context.Context
+		accountID rbac.AccountID
+	}{ctx, accountID})
+	fake.recordInvocation("GetAccountBindings", []interface{}{ctx, accountID})
+	fake.getAccountBindingsMutex.Unlock()
+	if fake.GetAccountBindingsStub != nil {
+		return fake.GetAccountBindingsStub(ctx, accountID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAccountBindingsReturns.result1, fake.getAccountBindingsReturns.result2
+}
+
+func (fake *FakeControl) GetAccountBindingsCallCount() int {
+	fake.getAccountBindingsMutex.RLock()
+	defer fake.getAccountBindingsMutex.RUnlock()
+	return len(fake.getAccountBindingsArgsForCall)
+}
+
+func (fake *FakeControl) GetAccountBindingsArgsForCall(i int) (context.Context, rbac.AccountID) {
+	fake.getAccountBindingsMutex.RLock()
+	defer fake.getAccountBindingsMutex.RUnlock()
+	return fake.getAccountBindingsArgsForCall[i].ctx, fake.getAccountBindingsArgsForCall[i].accountID
+}
+
+func (fake *FakeControl) GetAccountBindingsReturns(result1 rbac.Bindings, result2 error) {
+	fake.GetAccountBindingsStub = nil
+	fake.getAccountBindingsReturns = struct {
+		result1 rbac.Bindings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) GetAccountBindingsReturnsOnCall(i int, result1 rbac.Bindings, result2 error) {
+	fake.GetAccountBindingsStub = nil
+	if fake.getAccountBindingsReturnsOnCall == nil {
+		fake.getAccountBindingsReturnsOnCall = make(map[int]struct {
+			result1 rbac.Bindings
+			result2 error
+		})
+	}
+	fake.getAccountBindingsReturnsOnCall[i] = struct {
+		result1 rbac.Bindings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) GetAccountDenyBindings(ctx context.Context, accountID rbac.AccountID) (rbac.Bindings, error) {
+	fake.getAccountDenyBindingsMutex.Lock()
+	ret, specificReturn := fake.getAccountDenyBindingsReturnsOnCall[len(fake.getAccountDenyBindingsArgsForCall)]
+	fake.getAccountDenyBindingsArgsForCall = append(fake.getAccountDenyBindingsArgsForCall, struct {
+		ctx       context.Context
+		accountID rbac.AccountID
+	}{ctx, accountID})
+	fake.recordInvocation("GetAccountDenyBindings", []interface{}{ctx, accountID})
+	fake.getAccountDenyBindingsMutex.Unlock()
+	if fake.GetAccountDenyBindingsStub != nil {
+		return fake.GetAccountDenyBindingsStub(ctx, accountID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAccountDenyBindingsReturns.result1, fake.getAccountDenyBindingsReturns.result2
+}
+
+func (fake *FakeControl) GetAccountDenyBindingsCallCount() int {
+	fake.getAccountDenyBindingsMutex.RLock()
+	defer fake.getAccountDenyBindingsMutex.RUnlock()
+	return len(fake.getAccountDenyBindingsArgsForCall)
+}
+
+func (fake *FakeControl) GetAccountDenyBindingsArgsForCall(i int) (context.Context, rbac.AccountID) {
+	fake.getAccountDenyBindingsMutex.RLock()
+	defer fake.getAccountDenyBindingsMutex.RUnlock()
+	return fake.getAccountDenyBindingsArgsForCall[i].ctx, fake.getAccountDenyBindingsArgsForCall[i].accountID
+}
+
+func (fake *FakeControl) GetAccountDenyBindingsReturns(result1 rbac.Bindings, result2 error) {
+	fake.GetAccountDenyBindingsStub = nil
+	fake.getAccountDenyBindingsReturns = struct {
+		result1 rbac.Bindings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) GetAccountDenyBindingsReturnsOnCall(i int, result1 rbac.Bindings, result2 error) {
+	fake.GetAccountDenyBindingsStub = nil
+	if fake.getAccountDenyBindingsReturnsOnCall == nil {
+		fake.getAccountDenyBindingsReturnsOnCall = make(map[int]struct {
+			result1 rbac.Bindings
+			result2 error
+		})
+	}
+	fake.getAccountDenyBindingsReturnsOnCall[i] = struct {
+		result1 rbac.Bindings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) RegisterRules(ctx context.Context, catalog rbac.RuleCatalog) error {
+	fake.registerRulesMutex.Lock()
+	ret, specificReturn := fake.registerRulesReturnsOnCall[len(fake.registerRulesArgsForCall)]
+	fake.registerRulesArgsForCall = append(fake.registerRulesArgsForCall, struct {
+		ctx     context.Context
+		catalog rbac.RuleCatalog
+	}{ctx, catalog})
+	fake.recordInvocation("RegisterRules", []interface{}{ctx, catalog})
+	fake.registerRulesMutex.Unlock()
+	if fake.RegisterRulesStub != nil {
+		return fake.RegisterRulesStub(ctx, catalog)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.registerRulesReturns.result1
+}
+
+func (fake *FakeControl) RegisterRulesCallCount() int {
+	fake.registerRulesMutex.RLock()
+	defer fake.registerRulesMutex.RUnlock()
+	return len(fake.registerRulesArgsForCall)
+}
+
+func (fake *FakeControl) RegisterRulesArgsForCall(i int) (context.Context, rbac.RuleCatalog) {
+	fake.registerRulesMutex.RLock()
+	defer fake.registerRulesMutex.RUnlock()
+	return fake.registerRulesArgsForCall[i].ctx, fake.registerRulesArgsForCall[i].catalog
+}
+
+func (fake *FakeControl) RegisterRulesReturns(result1 error) {
+	fake.RegisterRulesStub = nil
+	fake.registerRulesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeControl) RegisterRulesReturnsOnCall(i int, result1 error) {
+	fake.RegisterRulesStub = nil
+	if fake.registerRulesReturnsOnCall == nil {
+		fake.registerRulesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.registerRulesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeControl) GetRuleCatalog(ctx context.Context) (rbac.RuleCatalog, error) {
+	fake.getRuleCatalogMutex.Lock()
+	ret, specificReturn := fake.getRuleCatalogReturnsOnCall[len(fake.getRuleCatalogArgsForCall)]
+	fake.getRuleCatalogArgsForCall = append(fake.getRuleCatalogArgsForCall, struct {
+		ctx context.Context
+	}{ctx})
+	fake.recordInvocation("GetRuleCatalog", []interface{}{ctx})
+	fake.getRuleCatalogMutex.Unlock()
+	if fake.GetRuleCatalogStub != nil {
+		return fake.GetRuleCatalogStub(ctx)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getRuleCatalogReturns.result1, fake.getRuleCatalogReturns.result2
+}
+
+func (fake *FakeControl) GetRuleCatalogCallCount() int {
+	fake.getRuleCatalogMutex.RLock()
+	defer fake.getRuleCatalogMutex.RUnlock()
+	return len(fake.getRuleCatalogArgsForCall)
+}
+
+func (fake *FakeControl) GetRuleCatalogArgsForCall(i int) context.Context {
+	fake.getRuleCatalogMutex.RLock()
+	defer fake.getRuleCatalogMutex.RUnlock()
+	return fake.getRuleCatalogArgsForCall[i].ctx
+}
+
+func (fake *FakeControl) GetRuleCatalogReturns(result1 rbac.RuleCatalog, result2 error) {
+	fake.GetRuleCatalogStub = nil
+	fake.getRuleCatalogReturns = struct {
+		result1 rbac.RuleCatalog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) GetRuleCatalogReturnsOnCall(i int, result1 rbac.RuleCatalog, result2 error) {
+	fake.GetRuleCatalogStub = nil
+	if fake.getRuleCatalogReturnsOnCall == nil {
+		fake.getRuleCatalogReturnsOnCall = make(map[int]struct {
+			result1 rbac.RuleCatalog
+			result2 error
+		})
+	}
+	fake.getRuleCatalogReturnsOnCall[i] = struct {
+		result1 rbac.RuleCatalog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) ListRoles(ctx context.Context, page pagination.Page) ([]rbac.RoleID, error) {
+	fake.listRolesMutex.Lock()
+	ret, specificReturn := fake.listRolesReturnsOnCall[len(fake.listRolesArgsForCall)]
+	fake.listRolesArgsForCall = append(fake.listRolesArgsForCall, struct {
+		ctx  context.Context
+		page pagination.Page
+	}{ctx, page})
+	fake.recordInvocation("ListRoles", []interface{}{ctx, page})
+	fake.listRolesMutex.Unlock()
+	if fake.ListRolesStub != nil {
+		return fake.ListRolesStub(ctx, page)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listRolesReturns.result1, fake.listRolesReturns.result2
+}
+
+func (fake *FakeControl) ListRolesCallCount() int {
+	fake.listRolesMutex.RLock()
+	defer fake.listRolesMutex.RUnlock()
+	return len(fake.listRolesArgsForCall)
+}
+
+func (fake *FakeControl) ListRolesArgsForCall(i int) (context.Context, pagination.Page) {
+	fake.listRolesMutex.RLock()
+	defer fake.listRolesMutex.RUnlock()
+	return fake.listRolesArgsForCall[i].ctx, fake.listRolesArgsForCall[i].page
+}
+
+func (fake *FakeControl) ListRolesReturns(result1 []rbac.RoleID, result2 error) {
+	fake.ListRolesStub = nil
+	fake.listRolesReturns = struct {
+		result1 []rbac.RoleID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) ListRolesReturnsOnCall(i int, result1 []rbac.RoleID, result2 error) {
+	fake.ListRolesStub = nil
+	if fake.listRolesReturnsOnCall == nil {
+		fake.listRolesReturnsOnCall = make(map[int]struct {
+			result1 []rbac.RoleID
+			result2 error
+		})
+	}
+	fake.listRolesReturnsOnCall[i] = struct {
+		result1 []rbac.RoleID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) ListRoleAccounts(ctx context.Context, roleID rbac.RoleID, page pagination.Page) ([]rbac.AccountID, error) {
+	fake.listRoleAccountsMutex.Lock()
+	ret, specificReturn := fake.listRoleAccountsReturnsOnCall[len(fake.listRoleAccountsArgsForCall)]
+	fake.listRoleAccountsArgsForCall = append(fake.listRoleAccountsArgsForCall, struct {
+		ctx    context.Context
+		roleID rbac.RoleID
+		page   pagination.Page
+	}{ctx, roleID, page})
+	fake.recordInvocation("ListRoleAccounts", []interface{}{ctx, roleID, page})
+	fake.listRoleAccountsMutex.Unlock()
+	if fake.ListRoleAccountsStub != nil {
+		return fake.ListRoleAccountsStub(ctx, roleID, page)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listRoleAccountsReturns.result1, fake.listRoleAccountsReturns.result2
+}
+
+func (fake *FakeControl) ListRoleAccountsCallCount() int {
+	fake.listRoleAccountsMutex.RLock()
+	defer fake.listRoleAccountsMutex.RUnlock()
+	return len(fake.listRoleAccountsArgsForCall)
+}
+
+func (fake *FakeControl) ListRoleAccountsArgsForCall(i int) (context.Context, rbac.RoleID, pagination.Page) {
+	fake.listRoleAccountsMutex.RLock()
+	defer fake.listRoleAccountsMutex.RUnlock()
+	return fake.listRoleAccountsArgsForCall[i].ctx, fake.listRoleAccountsArgsForCall[i].roleID, fake.listRoleAccountsArgsForCall[i].page
+}
+
+func (fake *FakeControl) ListRoleAccountsReturns(result1 []rbac.AccountID, result2 error) {
+	fake.ListRoleAccountsStub = nil
+	fake.listRoleAccountsReturns = struct {
+		result1 []rbac.AccountID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) ListRoleAccountsReturnsOnCall(i int, result1 []rbac.AccountID, result2 error) {
+	fake.ListRoleAccountsStub = nil
+	if fake.listRoleAccountsReturnsOnCall == nil {
+		fake.listRoleAccountsReturnsOnCall = make(map[int]struct {
+			result1 []rbac.AccountID
+			result2 error
+		})
+	}
+	fake.listRoleAccountsReturnsOnCall[i] = struct {
+		result1 []rbac.AccountID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) ListRuleRoles(ctx context.Context, rule rbac.Rule, page pagination.Page) ([]rbac.RoleID, error) {
+	fake.listRuleRolesMutex.Lock()
+	ret, specificReturn := fake.listRuleRolesReturnsOnCall[len(fake.listRuleRolesArgsForCall)]
+	fake.listRuleRolesArgsForCall = append(fake.listRuleRolesArgsForCall, struct {
+		ctx  context.Context
+		rule rbac.Rule
+		page pagination.Page
+	}{ctx, rule, page})
+	fake.recordInvocation("ListRuleRoles", []interface{}{ctx, rule, page})
+	fake.listRuleRolesMutex.Unlock()
+	if fake.ListRuleRolesStub != nil {
+		return fake.ListRuleRolesStub(ctx, rule, page)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listRuleRolesReturns.result1, fake.listRuleRolesReturns.result2
+}
+
+func (fake *FakeControl) ListRuleRolesCallCount() int {
+	fake.listRuleRolesMutex.RLock()
+	defer fake.listRuleRolesMutex.RUnlock()
+	return len(fake.listRuleRolesArgsForCall)
+}
+
+func (fake *FakeControl) ListRuleRolesArgsForCall(i int) (context.Context, rbac.Rule, pagination.Page) {
+	fake.listRuleRolesMutex.RLock()
+	defer fake.listRuleRolesMutex.RUnlock()
+	return fake.listRuleRolesArgsForCall[i].ctx, fake.listRuleRolesArgsForCall[i].rule, fake.listRuleRolesArgsForCall[i].page
+}
+
+func (fake *FakeControl) ListRuleRolesReturns(result1 []rbac.RoleID, result2 error) {
+	fake.ListRuleRolesStub = nil
+	fake.listRuleRolesReturns = struct {
+		result1 []rbac.RoleID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) ListRuleRolesReturnsOnCall(i int, result1 []rbac.RoleID, result2 error) {
+	fake.ListRuleRolesStub = nil
+	if fake.listRuleRolesReturnsOnCall == nil {
+		fake.listRuleRolesReturnsOnCall = make(map[int]struct {
+			result1 []rbac.RoleID
+			result2 error
+		})
+	}
+	fake.listRuleRolesReturnsOnCall[i] = struct {
+		result1 []rbac.RoleID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) ListRules(ctx context.Context, page pagination.Page) ([]rbac.Rule, error) {
+	fake.listRulesMutex.Lock()
+	ret, specificReturn := fake.listRulesReturnsOnCall[len(fake.listRulesArgsForCall)]
+	fake.listRulesArgsForCall = append(fake.listRulesArgsForCall, struct {
+		ctx  context.Context
+		page pagination.Page
+	}{ctx, page})
+	fake.recordInvocation("ListRules", []interface{}{ctx, page})
+	fake.listRulesMutex.Unlock()
+	if fake.ListRulesStub != nil {
+		return fake.ListRulesStub(ctx, page)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listRulesReturns.result1, fake.listRulesReturns.result2
+}
+
+func (fake *FakeControl) ListRulesCallCount() int {
+	fake.listRulesMutex.RLock()
+	defer fake.listRulesMutex.RUnlock()
+	return len(fake.listRulesArgsForCall)
+}
+
+func (fake *FakeControl) ListRulesArgsForCall(i int) (context.Context, pagination.Page) {
+	fake.listRulesMutex.RLock()
+	defer fake.listRulesMutex.RUnlock()
+	return fake.listRulesArgsForCall[i].ctx, fake.listRulesArgsForCall[i].page
+}
+
+func (fake *FakeControl) ListRulesReturns(result1 []rbac.Rule, result2 error) {
+	fake.ListRulesStub = nil
+	fake.listRulesReturns = struct {
+		result1 []rbac.Rule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) ListRulesReturnsOnCall(i int, result1 []rbac.Rule, result2 error) {
+	fake.ListRulesStub = nil
+	if fake.listRulesReturnsOnCall == nil {
+		fake.listRulesReturnsOnCall = make(map[int]struct {
+			result1 []rbac.Rule
+			result2 error
+		})
+	}
+	fake.listRulesReturnsOnCall[i] = struct {
+		result1 []rbac.Rule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) ListAuditEntries(ctx context.Context, filter rbac.AuditFilter, page pagination.Page) ([]rbac.AuditEntry, error) {
+	fake.listAuditEntriesMutex.Lock()
+	ret, specificReturn := fake.listAuditEntriesReturnsOnCall[len(fake.listAuditEntriesArgsForCall)]
+	fake.listAuditEntriesArgsForCall = append(fake.listAuditEntriesArgsForCall, struct {
+		ctx    context.Context
+		filter rbac.AuditFilter
+		page   pagination.Page
+	}{ctx, filter, page})
+	fake.recordInvocation("ListAuditEntries", []interface{}{ctx, filter, page})
+	fake.listAuditEntriesMutex.Unlock()
+	if fake.ListAuditEntriesStub != nil {
+		return fake.ListAuditEntriesStub(ctx, filter, page)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listAuditEntriesReturns.result1, fake.listAuditEntriesReturns.result2
+}
+
+func (fake *FakeControl) ListAuditEntriesCallCount() int {
+	fake.listAuditEntriesMutex.RLock()
+	defer fake.listAuditEntriesMutex.RUnlock()
+	return len(fake.listAuditEntriesArgsForCall)
+}
+
+func (fake *FakeControl) ListAuditEntriesArgsForCall(i int) (context.Context, rbac.AuditFilter, pagination.Page) {
+	fake.listAuditEntriesMutex.RLock()
+	defer fake.listAuditEntriesMutex.RUnlock()
+	return fake.listAuditEntriesArgsForCall[i].ctx, fake.listAuditEntriesArgsForCall[i].filter, fake.listAuditEntriesArgsForCall[i].page
+}
+
+func (fake *FakeControl) ListAuditEntriesReturns(result1 []rbac.AuditEntry, result2 error) {
+	fake.ListAuditEntriesStub = nil
+	fake.listAuditEntriesReturns = struct {
+		result1 []rbac.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeControl) ListAuditEntriesReturnsOnCall(i int, result1 []rbac.AuditEntry, result2 error) {
+	fake.ListAuditEntriesStub = nil
+	if fake.listAuditEntriesReturnsOnCall == nil {
+		fake.listAuditEntriesReturnsOnCall = make(map[int]struct {
+			result1 []rbac.AuditEntry
+			result2 error
+		})
+	}
+	fake.listAuditEntriesReturnsOnCall[i] = struct {
+		result1 []rbac.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeControl) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -346,12 +1465,46 @@ func (fake *FakeControl) Invocations() map[string][][]interface{} {
 	defer fake.getRoleRulesMutex.RUnlock()
 	fake.setRoleRulesMutex.RLock()
 	defer fake.setRoleRulesMutex.RUnlock()
+	fake.getRoleDenyRulesMutex.RLock()
+	defer fake.getRoleDenyRulesMutex.RUnlock()
+	fake.setRoleDenyRulesMutex.RLock()
+	defer fake.setRoleDenyRulesMutex.RUnlock()
+	fake.getRoleParentsMutex.RLock()
+	defer fake.getRoleParentsMutex.RUnlock()
+	fake.setRoleParentsMutex.RLock()
+	defer fake.setRoleParentsMutex.RUnlock()
 	fake.getAccountRolesMutex.RLock()
 	defer fake.getAccountRolesMutex.RUnlock()
 	fake.setAccountRolesMutex.RLock()
 	defer fake.setAccountRolesMutex.RUnlock()
+	fake.addAccountRolesMutex.RLock()
+	defer fake.addAccountRolesMutex.RUnlock()
+	fake.removeAccountRolesMutex.RLock()
+	defer fake.removeAccountRolesMutex.RUnlock()
 	fake.isAccountAllowedMutex.RLock()
 	defer fake.isAccountAllowedMutex.RUnlock()
+	fake.checkManyMutex.RLock()
+	defer fake.checkManyMutex.RUnlock()
+	fake.explainMutex.RLock()
+	defer fake.explainMutex.RUnlock()
+	fake.getAccountBindingsMutex.RLock()
+	defer fake.getAccountBindingsMutex.RUnlock()
+	fake.getAccountDenyBindingsMutex.RLock()
+	defer fake.getAccountDenyBindingsMutex.RUnlock()
+	fake.registerRulesMutex.RLock()
+	defer fake.registerRulesMutex.RUnlock()
+	fake.getRuleCatalogMutex.RLock()
+	defer fake.getRuleCatalogMutex.RUnlock()
+	fake.listRolesMutex.RLock()
+	defer fake.listRolesMutex.RUnlock()
+	fake.listRoleAccountsMutex.RLock()
+	defer fake.listRoleAccountsMutex.RUnlock()
+	fake.listRuleRolesMutex.RLock()
+	defer fake.listRuleRolesMutex.RUnlock()
+	fake.listRulesMutex.RLock()
+	defer fake.listRulesMutex.RUnlock()
+	fake.listAuditEntriesMutex.RLock()
+	defer fake.listAuditEntriesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -5,6 +5,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/51st-state/api/pkg/pagination"
 	"github.com/51st-state/api/pkg/rbac"
 )
 
@@ -23,12 +24,13 @@ type FakeRepository struct {
 		result1 rbac.RoleRules
 		result2 error
 	}
-	SetRoleRulesStub        func(context.Context, rbac.RoleID, rbac.RoleRules) error
+	SetRoleRulesStub        func(context.Context, rbac.RoleID, rbac.RoleRules, rbac.Auditor) error
 	setRoleRulesMutex       sync.RWMutex
 	setRoleRulesArgsForCall []struct {
 		arg1 context.Context
 		arg2 rbac.RoleID
 		arg3 rbac.RoleRules
+		arg4 rbac.Auditor
 	}
 	setRoleRulesReturns struct {
 		result1 error
@@ -36,6 +38,62 @@ type FakeRepository struct {
 	setRoleRulesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	GetRoleDenyRulesStub        func(context.Context, rbac.RoleID) (rbac.RoleRules, error)
+	getRoleDenyRulesMutex       sync.RWMutex
+	getRoleDenyRulesArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.RoleID
+	}
+	getRoleDenyRulesReturns struct {
+		result1 rbac.RoleRules
+		result2 error
+	}
+	getRoleDenyRulesReturnsOnCall map[int]struct {
+		result1 rbac.RoleRules
+		result2 error
+	}
+	SetRoleDenyRulesStub        func(context.Context, rbac.RoleID, rbac.RoleRules, rbac.Auditor) error
+	setRoleDenyRulesMutex       sync.RWMutex
+	setRoleDenyRulesArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.RoleID
+		arg3 rbac.RoleRules
+		arg4 rbac.Auditor
+	}
+	setRoleDenyRulesReturns struct {
+		result1 error
+	}
+	setRoleDenyRulesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetRoleParentsStub        func(context.Context, rbac.RoleID) (rbac.RoleParents, error)
+	getRoleParentsMutex       sync.RWMutex
+	getRoleParentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.RoleID
+	}
+	getRoleParentsReturns struct {
+		result1 rbac.RoleParents
+		result2 error
+	}
+	getRoleParentsReturnsOnCall map[int]struct {
+		result1 rbac.RoleParents
+		result2 error
+	}
+	SetRoleParentsStub        func(context.Context, rbac.RoleID, rbac.RoleParents, rbac.Auditor) error
+	setRoleParentsMutex       sync.RWMutex
+	setRoleParentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.RoleID
+		arg3 rbac.RoleParents
+		arg4 rbac.Auditor
+	}
+	setRoleParentsReturns struct {
+		result1 error
+	}
+	setRoleParentsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetAccountRolesStub        func(context.Context, rbac.AccountID) (rbac.AccountRoles, error)
 	getAccountRolesMutex       sync.RWMutex
 	getAccountRolesArgsForCall []struct {
@@ -50,12 +108,13 @@ type FakeRepository struct {
 		result1 rbac.AccountRoles
 		result2 error
 	}
-	SetAccountRolesStub        func(context.Context, rbac.AccountID, rbac.AccountRoles) error
+	SetAccountRolesStub        func(context.Context, rbac.AccountID, rbac.AccountRoles, rbac.Auditor) error
 	setAccountRolesMutex       sync.RWMutex
 	setAccountRolesArgsForCall []struct {
 		arg1 context.Context
 		arg2 rbac.AccountID
 		arg3 rbac.AccountRoles
+		arg4 rbac.Auditor
 	}
 	setAccountRolesReturns struct {
 		result1 error
@@ -63,21 +122,239 @@ type FakeRepository struct {
 	setAccountRolesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	GetAccountRuleCountStub        func(context.Context, rbac.AccountID, rbac.Rule) (uint64, error)
-	getAccountRuleCountMutex       sync.RWMutex
-	getAccountRuleCountArgsForCall []struct {
+	AddAccountRolesStub        func(context.Context, rbac.AccountID, rbac.AccountRoles, rbac.Auditor) error
+	addAccountRolesMutex       sync.RWMutex
+	addAccountRolesArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.AccountID
+		arg3 rbac.AccountRoles
+		arg4 rbac.Auditor
+	}
+	addAccountRolesReturns struct {
+		result1 error
+	}
+	addAccountRolesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveAccountRolesStub        func(context.Context, rbac.AccountID, rbac.AccountRoles, rbac.Auditor) error
+	removeAccountRolesMutex       sync.RWMutex
+	removeAccountRolesArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.AccountID
+		arg3 rbac.AccountRoles
+		arg4 rbac.Auditor
+	}
+	removeAccountRolesReturns struct {
+		result1 error
+	}
+	removeAccountRolesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAccountBindingsStub        func(context.Context, rbac.AccountID) (rbac.Bindings, error)
+	getAccountBindingsMutex       sync.RWMutex
+	getAccountBindingsArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.AccountID
+	}
+	getAccountBindingsReturns struct {
+		result1 rbac.Bindings
+		result2 error
+	}
+	getAccountBindingsReturnsOnCall map[int]struct {
+		result1 rbac.Bindings
+		result2 error
+	}
+	GetAccountDenyBindingsStub        func(context.Context, rbac.AccountID) (rbac.Bindings, error)
+	getAccountDenyBindingsMutex       sync.RWMutex
+	getAccountDenyBindingsArgsForCall []struct {
 		arg1 context.Context
 		arg2 rbac.AccountID
-		arg3 rbac.Rule
 	}
-	getAccountRuleCountReturns struct {
-		result1 uint64
+	getAccountDenyBindingsReturns struct {
+		result1 rbac.Bindings
+		result2 error
+	}
+	getAccountDenyBindingsReturnsOnCall map[int]struct {
+		result1 rbac.Bindings
+		result2 error
+	}
+	RegisterRulesStub        func(context.Context, rbac.RuleCatalog) error
+	registerRulesMutex       sync.RWMutex
+	registerRulesArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.RuleCatalog
+	}
+	registerRulesReturns struct {
+		result1 error
+	}
+	registerRulesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetRuleCatalogStub        func(context.Context) (rbac.RuleCatalog, error)
+	getRuleCatalogMutex       sync.RWMutex
+	getRuleCatalogArgsForCall []struct {
+		arg1 context.Context
+	}
+	getRuleCatalogReturns struct {
+		result1 rbac.RuleCatalog
+		result2 error
+	}
+	getRuleCatalogReturnsOnCall map[int]struct {
+		result1 rbac.RuleCatalog
+		result2 error
+	}
+	GetRuleBindingsStub        func(context.Context, rbac.Rule) (rbac.Bindings, error)
+	getRuleBindingsMutex       sync.RWMutex
+	getRuleBindingsArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.Rule
+	}
+	getRuleBindingsReturns struct {
+		result1 rbac.Bindings
+		result2 error
+	}
+	getRuleBindingsReturnsOnCall map[int]struct {
+		result1 rbac.Bindings
+		result2 error
+	}
+	ListRolesStub        func(context.Context, pagination.Page) ([]rbac.RoleID, error)
+	listRolesMutex       sync.RWMutex
+	listRolesArgsForCall []struct {
+		arg1 context.Context
+		arg2 pagination.Page
+	}
+	listRolesReturns struct {
+		result1 []rbac.RoleID
+		result2 error
+	}
+	listRolesReturnsOnCall map[int]struct {
+		result1 []rbac.RoleID
+		result2 error
+	}
+	ListRoleAccountsStub        func(context.Context, rbac.RoleID, pagination.Page) ([]rbac.AccountID, error)
+	listRoleAccountsMutex       sync.RWMutex
+	listRoleAccountsArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.RoleID
+		arg3 pagination.Page
+	}
+	listRoleAccountsReturns struct {
+		result1 []rbac.AccountID
+		result2 error
+	}
+	listRoleAccountsReturnsOnCall map[int]struct {
+		result1 []rbac.AccountID
+		result2 error
+	}
+	ListRuleRolesStub        func(context.Context, rbac.Rule, pagination.Page) ([]rbac.RoleID, error)
+	listRuleRolesMutex       sync.RWMutex
+	listRuleRolesArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.Rule
+		arg3 pagination.Page
+	}
+	listRuleRolesReturns struct {
+		result1 []rbac.RoleID
+		result2 error
+	}
+	listRuleRolesReturnsOnCall map[int]struct {
+		result1 []rbac.RoleID
+		result2 error
+	}
+	ListRulesStub        func(context.Context, pagination.Page) ([]rbac.Rule, error)
+	listRulesMutex       sync.RWMutex
+	listRulesArgsForCall []struct {
+		arg1 context.Context
+		arg2 pagination.Page
+	}
+	listRulesReturns struct {
+		result1 []rbac.Rule
+		result2 error
+	}
+	listRulesReturnsOnCall map[int]struct {
+		result1 []rbac.Rule
+		result2 error
+	}
+	AddAuditEntryStub        func(context.Context, rbac.AuditEntry) (rbac.AuditEntry, error)
+	addAuditEntryMutex       sync.RWMutex
+	addAuditEntryArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.AuditEntry
+	}
+	addAuditEntryReturns struct {
+		result1 rbac.AuditEntry
+		result2 error
+	}
+	addAuditEntryReturnsOnCall map[int]struct {
+		result1 rbac.AuditEntry
+		result2 error
+	}
+	ListAuditEntriesStub        func(context.Context, rbac.AuditFilter, pagination.Page) ([]rbac.AuditEntry, error)
+	listAuditEntriesMutex       sync.RWMutex
+	listAuditEntriesArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.AuditFilter
+		arg3 pagination.Page
+	}
+	listAuditEntriesReturns struct {
+		result1 []rbac.AuditEntry
+		result2 error
+	}
+	listAuditEntriesReturnsOnCall map[int]struct {
+		result1 []rbac.AuditEntry
+		result2 error
+	}
+	GetUnpublishedAuditEntriesStub        func(ctx context.Context, limit uint64) ([]rbac.AuditEntry, error)
+	getUnpublishedAuditEntriesMutex       sync.RWMutex
+	getUnpublishedAuditEntriesArgsForCall []struct {
+		ctx   context.Context
+		limit uint64
+	}
+	getUnpublishedAuditEntriesReturns struct {
+		result1 []rbac.AuditEntry
 		result2 error
 	}
-	getAccountRuleCountReturnsOnCall map[int]struct {
-		result1 uint64
+	getUnpublishedAuditEntriesReturnsOnCall map[int]struct {
+		result1 []rbac.AuditEntry
 		result2 error
 	}
+	MarkAuditEntryPublishedStub        func(ctx context.Context, id string) error
+	markAuditEntryPublishedMutex       sync.RWMutex
+	markAuditEntryPublishedArgsForCall []struct {
+		ctx context.Context
+		id  string
+	}
+	markAuditEntryPublishedReturns struct {
+		result1 error
+	}
+	markAuditEntryPublishedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteAccountStub        func(context.Context, rbac.AccountID) error
+	deleteAccountMutex       sync.RWMutex
+	deleteAccountArgsForCall []struct {
+		arg1 context.Context
+		arg2 rbac.AccountID
+	}
+	deleteAccountReturns struct {
+		result1 error
+	}
+	deleteAccountReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AnonymizeAuditEntriesStub        func(ctx context.Context, accountID rbac.AccountID, replacement rbac.AccountID) error
+	anonymizeAuditEntriesMutex       sync.RWMutex
+	anonymizeAuditEntriesArgsForCall []struct {
+		ctx         context.Context
+		accountID   rbac.AccountID
+		replacement rbac.AccountID
+	}
+	anonymizeAuditEntriesReturns struct {
+		result1 error
+	}
+	anonymizeAuditEntriesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -134,18 +411,19 @@ func (fake *FakeRepository) GetRoleRulesReturnsOnCall(i int, result1 rbac.RoleRu
 	}{result1, result2}
 }
 
-func (fake *FakeRepository) SetRoleRules(arg1 context.Context, arg2 rbac.RoleID, arg3 rbac.RoleRules) error {
+func (fake *FakeRepository) SetRoleRules(arg1 context.Context, arg2 rbac.RoleID, arg3 rbac.RoleRules, arg4 rbac.Auditor) error {
 	fake.setRoleRulesMutex.Lock()
 	ret, specificReturn := fake.setRoleRulesReturnsOnCall[len(fake.setRoleRulesArgsForCall)]
 	fake.setRoleRulesArgsForCall = append(fake.setRoleRulesArgsForCall, struct {
 		arg1 context.Context
 		arg2 rbac.RoleID
 		arg3 rbac.RoleRules
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("SetRoleRules", []interface{}{arg1, arg2, arg3})
+		arg4 rbac.Auditor
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SetRoleRules", []interface{}{arg1, arg2, arg3, arg4})
 	fake.setRoleRulesMutex.Unlock()
 	if fake.SetRoleRulesStub != nil {
-		return fake.SetRoleRulesStub(arg1, arg2, arg3)
+		return fake.SetRoleRulesStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -159,10 +437,10 @@ func (fake *FakeRepository) SetRoleRulesCallCount() int {
 	return len(fake.setRoleRulesArgsForCall)
 }
 
-func (fake *FakeRepository) SetRoleRulesArgsForCall(i int) (context.Context, rbac.RoleID, rbac.RoleRules) {
+func (fake *FakeRepository) SetRoleRulesArgsForCall(i int) (context.Context, rbac.RoleID, rbac.RoleRules, rbac.Auditor) {
 	fake.setRoleRulesMutex.RLock()
 	defer fake.setRoleRulesMutex.RUnlock()
-	return fake.setRoleRulesArgsForCall[i].arg1, fake.setRoleRulesArgsForCall[i].arg2, fake.setRoleRulesArgsForCall[i].arg3
+	return fake.setRoleRulesArgsForCall[i].arg1, fake.setRoleRulesArgsForCall[i].arg2, fake.setRoleRulesArgsForCall[i].arg3, fake.setRoleRulesArgsForCall[i].arg4
 }
 
 func (fake *FakeRepository) SetRoleRulesReturns(result1 error) {
@@ -184,6 +462,212 @@ func (fake *FakeRepository) SetRoleRulesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeRepository) GetRoleDenyRules(arg1 context.Context, arg2 rbac.RoleID) (rbac.RoleRules, error) {
+	fake.getRoleDenyRulesMutex.Lock()
+	ret, specificReturn := fake.getRoleDenyRulesReturnsOnCall[len(fake.getRoleDenyRulesArgsForCall)]
+	fake.getRoleDenyRulesArgsForCall = append(fake.getRoleDenyRulesArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.RoleID
+	}{arg1, arg2})
+	fake.recordInvocation("GetRoleDenyRules", []interface{}{arg1, arg2})
+	fake.getRoleDenyRulesMutex.Unlock()
+	if fake.GetRoleDenyRulesStub != nil {
+		return fake.GetRoleDenyRulesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getRoleDenyRulesReturns.result1, fake.getRoleDenyRulesReturns.result2
+}
+
+func (fake *FakeRepository) GetRoleDenyRulesCallCount() int {
+	fake.getRoleDenyRulesMutex.RLock()
+	defer fake.getRoleDenyRulesMutex.RUnlock()
+	return len(fake.getRoleDenyRulesArgsForCall)
+}
+
+func (fake *FakeRepository) GetRoleDenyRulesArgsForCall(i int) (context.Context, rbac.RoleID) {
+	fake.getRoleDenyRulesMutex.RLock()
+	defer fake.getRoleDenyRulesMutex.RUnlock()
+	return fake.getRoleDenyRulesArgsForCall[i].arg1, fake.getRoleDenyRulesArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetRoleDenyRulesReturns(result1 rbac.RoleRules, result2 error) {
+	fake.GetRoleDenyRulesStub = nil
+	fake.getRoleDenyRulesReturns = struct {
+		result1 rbac.RoleRules
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetRoleDenyRulesReturnsOnCall(i int, result1 rbac.RoleRules, result2 error) {
+	fake.GetRoleDenyRulesStub = nil
+	if fake.getRoleDenyRulesReturnsOnCall == nil {
+		fake.getRoleDenyRulesReturnsOnCall = make(map[int]struct {
+			result1 rbac.RoleRules
+			result2 error
+		})
+	}
+	fake.getRoleDenyRulesReturnsOnCall[i] = struct {
+		result1 rbac.RoleRules
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) SetRoleDenyRules(arg1 context.Context, arg2 rbac.RoleID, arg3 rbac.RoleRules, arg4 rbac.Auditor) error {
+	fake.setRoleDenyRulesMutex.Lock()
+	ret, specificReturn := fake.setRoleDenyRulesReturnsOnCall[len(fake.setRoleDenyRulesArgsForCall)]
+	fake.setRoleDenyRulesArgsForCall = append(fake.setRoleDenyRulesArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.RoleID
+		arg3 rbac.RoleRules
+		arg4 rbac.Auditor
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SetRoleDenyRules", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setRoleDenyRulesMutex.Unlock()
+	if fake.SetRoleDenyRulesStub != nil {
+		return fake.SetRoleDenyRulesStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setRoleDenyRulesReturns.result1
+}
+
+func (fake *FakeRepository) SetRoleDenyRulesCallCount() int {
+	fake.setRoleDenyRulesMutex.RLock()
+	defer fake.setRoleDenyRulesMutex.RUnlock()
+	return len(fake.setRoleDenyRulesArgsForCall)
+}
+
+func (fake *FakeRepository) SetRoleDenyRulesArgsForCall(i int) (context.Context, rbac.RoleID, rbac.RoleRules, rbac.Auditor) {
+	fake.setRoleDenyRulesMutex.RLock()
+	defer fake.setRoleDenyRulesMutex.RUnlock()
+	return fake.setRoleDenyRulesArgsForCall[i].arg1, fake.setRoleDenyRulesArgsForCall[i].arg2, fake.setRoleDenyRulesArgsForCall[i].arg3, fake.setRoleDenyRulesArgsForCall[i].arg4
+}
+
+func (fake *FakeRepository) SetRoleDenyRulesReturns(result1 error) {
+	fake.SetRoleDenyRulesStub = nil
+	fake.setRoleDenyRulesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) SetRoleDenyRulesReturnsOnCall(i int, result1 error) {
+	fake.SetRoleDenyRulesStub = nil
+	if fake.setRoleDenyRulesReturnsOnCall == nil {
+		fake.setRoleDenyRulesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setRoleDenyRulesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetRoleParents(arg1 context.Context, arg2 rbac.RoleID) (rbac.RoleParents, error) {
+	fake.getRoleParentsMutex.Lock()
+	ret, specificReturn := fake.getRoleParentsReturnsOnCall[len(fake.getRoleParentsArgsForCall)]
+	fake.getRoleParentsArgsForCall = append(fake.getRoleParentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.RoleID
+	}{arg1, arg2})
+	fake.recordInvocation("GetRoleParents", []interface{}{arg1, arg2})
+	fake.getRoleParentsMutex.Unlock()
+	if fake.GetRoleParentsStub != nil {
+		return fake.GetRoleParentsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getRoleParentsReturns.result1, fake.getRoleParentsReturns.result2
+}
+
+func (fake *FakeRepository) GetRoleParentsCallCount() int {
+	fake.getRoleParentsMutex.RLock()
+	defer fake.getRoleParentsMutex.RUnlock()
+	return len(fake.getRoleParentsArgsForCall)
+}
+
+func (fake *FakeRepository) GetRoleParentsArgsForCall(i int) (context.Context, rbac.RoleID) {
+	fake.getRoleParentsMutex.RLock()
+	defer fake.getRoleParentsMutex.RUnlock()
+	return fake.getRoleParentsArgsForCall[i].arg1, fake.getRoleParentsArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetRoleParentsReturns(result1 rbac.RoleParents, result2 error) {
+	fake.GetRoleParentsStub = nil
+	fake.getRoleParentsReturns = struct {
+		result1 rbac.RoleParents
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetRoleParentsReturnsOnCall(i int, result1 rbac.RoleParents, result2 error) {
+	fake.GetRoleParentsStub = nil
+	if fake.getRoleParentsReturnsOnCall == nil {
+		fake.getRoleParentsReturnsOnCall = make(map[int]struct {
+			result1 rbac.RoleParents
+			result2 error
+		})
+	}
+	fake.getRoleParentsReturnsOnCall[i] = struct {
+		result1 rbac.RoleParents
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) SetRoleParents(arg1 context.Context, arg2 rbac.RoleID, arg3 rbac.RoleParents, arg4 rbac.Auditor) error {
+	fake.setRoleParentsMutex.Lock()
+	ret, specificReturn := fake.setRoleParentsReturnsOnCall[len(fake.setRoleParentsArgsForCall)]
+	fake.setRoleParentsArgsForCall = append(fake.setRoleParentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.RoleID
+		arg3 rbac.RoleParents
+		arg4 rbac.Auditor
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SetRoleParents", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setRoleParentsMutex.Unlock()
+	if fake.SetRoleParentsStub != nil {
+		return fake.SetRoleParentsStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setRoleParentsReturns.result1
+}
+
+func (fake *FakeRepository) SetRoleParentsCallCount() int {
+	fake.setRoleParentsMutex.RLock()
+	defer fake.setRoleParentsMutex.RUnlock()
+	return len(fake.setRoleParentsArgsForCall)
+}
+
+func (fake *FakeRepository) SetRoleParentsArgsForCall(i int) (context.Context, rbac.RoleID, rbac.RoleParents, rbac.Auditor) {
+	fake.setRoleParentsMutex.RLock()
+	defer fake.setRoleParentsMutex.RUnlock()
+	return fake.setRoleParentsArgsForCall[i].arg1, fake.setRoleParentsArgsForCall[i].arg2, fake.setRoleParentsArgsForCall[i].arg3, fake.setRoleParentsArgsForCall[i].arg4
+}
+
+func (fake *FakeRepository) SetRoleParentsReturns(result1 error) {
+	fake.SetRoleParentsStub = nil
+	fake.setRoleParentsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) SetRoleParentsReturnsOnCall(i int, result1 error) {
+	fake.SetRoleParentsStub = nil
+	if fake.setRoleParentsReturnsOnCall == nil {
+		fake.setRoleParentsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setRoleParentsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRepository) GetAccountRoles(arg1 context.Context, arg2 rbac.AccountID) (rbac.AccountRoles, error) {
 	fake.getAccountRolesMutex.Lock()
 	ret, specificReturn := fake.getAccountRolesReturnsOnCall[len(fake.getAccountRolesArgsForCall)]
@@ -236,18 +720,19 @@ func (fake *FakeRepository) GetAccountRolesReturnsOnCall(i int, result1 rbac.Acc
 	}{result1, result2}
 }
 
-func (fake *FakeRepository) SetAccountRoles(arg1 context.Context, arg2 rbac.AccountID, arg3 rbac.AccountRoles) error {
+func (fake *FakeRepository) SetAccountRoles(arg1 context.Context, arg2 rbac.AccountID, arg3 rbac.AccountRoles, arg4 rbac.Auditor) error {
 	fake.setAccountRolesMutex.Lock()
 	ret, specificReturn := fake.setAccountRolesReturnsOnCall[len(fake.setAccountRolesArgsForCall)]
 	fake.setAccountRolesArgsForCall = append(fake.setAccountRolesArgsForCall, struct {
 		arg1 context.Context
 		arg2 rbac.AccountID
 		arg3 rbac.AccountRoles
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("SetAccountRoles", []interface{}{arg1, arg2, arg3})
+		arg4 rbac.Auditor
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SetAccountRoles", []interface{}{arg1, arg2, arg3, arg4})
 	fake.setAccountRolesMutex.Unlock()
 	if fake.SetAccountRolesStub != nil {
-		return fake.SetAccountRolesStub(arg1, arg2, arg3)
+		return fake.SetAccountRolesStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -261,10 +746,10 @@ func (fake *FakeRepository) SetAccountRolesCallCount() int {
 	return len(fake.setAccountRolesArgsForCall)
 }
 
-func (fake *FakeRepository) SetAccountRolesArgsForCall(i int) (context.Context, rbac.AccountID, rbac.AccountRoles) {
+func (fake *FakeRepository) SetAccountRolesArgsForCall(i int) (context.Context, rbac.AccountID, rbac.AccountRoles, rbac.Auditor) {
 	fake.setAccountRolesMutex.RLock()
 	defer fake.setAccountRolesMutex.RUnlock()
-	return fake.setAccountRolesArgsForCall[i].arg1, fake.setAccountRolesArgsForCall[i].arg2, fake.setAccountRolesArgsForCall[i].arg3
+	return fake.setAccountRolesArgsForCall[i].arg1, fake.setAccountRolesArgsForCall[i].arg2, fake.setAccountRolesArgsForCall[i].arg3, fake.setAccountRolesArgsForCall[i].arg4
 }
 
 func (fake *FakeRepository) SetAccountRolesReturns(result1 error) {
@@ -286,57 +771,877 @@ func (fake *FakeRepository) SetAccountRolesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeRepository) GetAccountRuleCount(arg1 context.Context, arg2 rbac.AccountID, arg3 rbac.Rule) (uint64, error) {
-	fake.getAccountRuleCountMutex.Lock()
-	ret, specificReturn := fake.getAccountRuleCountReturnsOnCall[len(fake.getAccountRuleCountArgsForCall)]
-	fake.getAccountRuleCountArgsForCall = append(fake.getAccountRuleCountArgsForCall, struct {
+func (fake *FakeRepository) AddAccountRoles(arg1 context.Context, arg2 rbac.AccountID, arg3 rbac.AccountRoles, arg4 rbac.Auditor) error {
+	fake.addAccountRolesMutex.Lock()
+	ret, specificReturn := fake.addAccountRolesReturnsOnCall[len(fake.addAccountRolesArgsForCall)]
+	fake.addAccountRolesArgsForCall = append(fake.addAccountRolesArgsForCall, struct {
 		arg1 context.Context
 		arg2 rbac.AccountID
-		arg3 rbac.Rule
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("GetAccountRuleCount", []interface{}{arg1, arg2, arg3})
-	fake.getAccountRuleCountMutex.Unlock()
-	if fake.GetAccountRuleCountStub != nil {
-		return fake.GetAccountRuleCountStub(arg1, arg2, arg3)
+		arg3 rbac.AccountRoles
+		arg4 rbac.Auditor
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("AddAccountRoles", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addAccountRolesMutex.Unlock()
+	if fake.AddAccountRolesStub != nil {
+		return fake.AddAccountRolesStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fake.getAccountRuleCountReturns.result1, fake.getAccountRuleCountReturns.result2
+	return fake.addAccountRolesReturns.result1
 }
 
-func (fake *FakeRepository) GetAccountRuleCountCallCount() int {
-	fake.getAccountRuleCountMutex.RLock()
-	defer fake.getAccountRuleCountMutex.RUnlock()
-	return len(fake.getAccountRuleCountArgsForCall)
+func (fake *FakeRepository) AddAccountRolesCallCount() int {
+	fake.addAccountRolesMutex.RLock()
+	defer fake.addAccountRolesMutex.RUnlock()
+	return len(fake.addAccountRolesArgsForCall)
 }
 
-func (fake *FakeRepository) GetAccountRuleCountArgsForCall(i int) (context.Context, rbac.AccountID, rbac.Rule) {
-	fake.getAccountRuleCountMutex.RLock()
-	defer fake.getAccountRuleCountMutex.RUnlock()
-	return fake.getAccountRuleCountArgsForCall[i].arg1, fake.getAccountRuleCountArgsForCall[i].arg2, fake.getAccountRuleCountArgsForCall[i].arg3
+func (fake *FakeRepository) AddAccountRolesArgsForCall(i int) (context.Context, rbac.AccountID, rbac.AccountRoles, rbac.Auditor) {
+	fake.addAccountRolesMutex.RLock()
+	defer fake.addAccountRolesMutex.RUnlock()
+	return fake.addAccountRolesArgsForCall[i].arg1, fake.addAccountRolesArgsForCall[i].arg2, fake.addAccountRolesArgsForCall[i].arg3, fake.addAccountRolesArgsForCall[i].arg4
 }
 
-func (fake *FakeRepository) GetAccountRuleCountReturns(result1 uint64, result2 error) {
-	fake.GetAccountRuleCountStub = nil
-	fake.getAccountRuleCountReturns = struct {
-		result1 uint64
-		result2 error
-	}{result1, result2}
+func (fake *FakeRepository) AddAccountRolesReturns(result1 error) {
+	fake.AddAccountRolesStub = nil
+	fake.addAccountRolesReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeRepository) GetAccountRuleCountReturnsOnCall(i int, result1 uint64, result2 error) {
-	fake.GetAccountRuleCountStub = nil
-	if fake.getAccountRuleCountReturnsOnCall == nil {
-		fake.getAccountRuleCountReturnsOnCall = make(map[int]struct {
-			result1 uint64
-			result2 error
+func (fake *FakeRepository) AddAccountRolesReturnsOnCall(i int, result1 error) {
+	fake.AddAccountRolesStub = nil
+	if fake.addAccountRolesReturnsOnCall == nil {
+		fake.addAccountRolesReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.getAccountRuleCountReturnsOnCall[i] = struct {
-		result1 uint64
-		result2 error
-	}{result1, result2}
+	fake.addAccountRolesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) RemoveAccountRoles(arg1 context.Context, arg2 rbac.AccountID, arg3 rbac.AccountRoles, arg4 rbac.Auditor) error {
+	fake.removeAccountRolesMutex.Lock()
+	ret, specificReturn := fake.removeAccountRolesReturnsOnCall[len(fake.removeAccountRolesArgsForCall)]
+	fake.removeAccountRolesArgsForCall = append(fake.removeAccountRolesArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.AccountID
+		arg3 rbac.AccountRoles
+		arg4 rbac.Auditor
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RemoveAccountRoles", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeAccountRolesMutex.Unlock()
+	if fake.RemoveAccountRolesStub != nil {
+		return fake.RemoveAccountRolesStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.removeAccountRolesReturns.result1
+}
+
+func (fake *FakeRepository) RemoveAccountRolesCallCount() int {
+	fake.removeAccountRolesMutex.RLock()
+	defer fake.removeAccountRolesMutex.RUnlock()
+	return len(fake.removeAccountRolesArgsForCall)
+}
+
+func (fake *FakeRepository) RemoveAccountRolesArgsForCall(i int) (context.Context, rbac.AccountID, rbac.AccountRoles, rbac.Auditor) {
+	fake.removeAccountRolesMutex.RLock()
+	defer fake.removeAccountRolesMutex.RUnlock()
+	return fake.removeAccountRolesArgsForCall[i].arg1, fake.removeAccountRolesArgsForCall[i].arg2, fake.removeAccountRolesArgsForCall[i].arg3, fake.removeAccountRolesArgsForCall[i].arg4
+}
+
+func (fake *FakeRepository) RemoveAccountRolesReturns(result1 error) {
+	fake.RemoveAccountRolesStub = nil
+	fake.removeAccountRolesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) RemoveAccountRolesReturnsOnCall(i int, result1 error) {
+	fake.RemoveAccountRolesStub = nil
+	if fake.removeAccountRolesReturnsOnCall == nil {
+		fake.removeAccountRolesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeAccountRolesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetAccountBindings(arg1 context.Context, arg2 rbac.AccountID) (rbac.Bindings, error) {
+	fake.getAccountBindingsMutex.Lock()
+	ret, specificReturn := fake.getAccountBindingsReturnsOnCall[len(fake.getAccountBindingsArgsForCall)]
+	fake.getAccountBindingsArgsForCall = append(fake.getAccountBindingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.AccountID
+	}{arg1, arg2})
+	fake.recordInvocation("GetAccountBindings", []interface{}{arg1, arg2})
+	fake.getAccountBindingsMutex.Unlock()
+	if fake.GetAccountBindingsStub != nil {
+		return fake.GetAccountBindingsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAccountBindingsReturns.result1, fake.getAccountBindingsReturns.result2
+}
+
+func (fake *FakeRepository) GetAccountBindingsCallCount() int {
+	fake.getAccountBindingsMutex.RLock()
+	defer fake.getAccountBindingsMutex.RUnlock()
+	return len(fake.getAccountBindingsArgsForCall)
+}
+
+func (fake *FakeRepository) GetAccountBindingsArgsForCall(i int) (context.Context, rbac.AccountID) {
+	fake.getAccountBindingsMutex.RLock()
+	defer fake.getAccountBindingsMutex.RUnlock()
+	return fake.getAccountBindingsArgsForCall[i].arg1, fake.getAccountBindingsArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetAccountBindingsReturns(result1 rbac.Bindings, result2 error) {
+	fake.GetAccountBindingsStub = nil
+	fake.getAccountBindingsReturns = struct {
+		result1 rbac.Bindings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetAccountBindingsReturnsOnCall(i int, result1 rbac.Bindings, result2 error) {
+	fake.GetAccountBindingsStub = nil
+	if fake.getAccountBindingsReturnsOnCall == nil {
+		fake.getAccountBindingsReturnsOnCall = make(map[int]struct {
+			result1 rbac.Bindings
+			result2 error
+		})
+	}
+	fake.getAccountBindingsReturnsOnCall[i] = struct {
+		result1 rbac.Bindings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetAccountDenyBindings(arg1 context.Context, arg2 rbac.AccountID) (rbac.Bindings, error) {
+	fake.getAccountDenyBindingsMutex.Lock()
+	ret, specificReturn := fake.getAccountDenyBindingsReturnsOnCall[len(fake.getAccountDenyBindingsArgsForCall)]
+	fake.getAccountDenyBindingsArgsForCall = append(fake.getAccountDenyBindingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.AccountID
+	}{arg1, arg2})
+	fake.recordInvocation("GetAccountDenyBindings", []interface{}{arg1, arg2})
+	fake.getAccountDenyBindingsMutex.Unlock()
+	if fake.GetAccountDenyBindingsStub != nil {
+		return fake.GetAccountDenyBindingsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getAccountDenyBindingsReturns.result1, fake.getAccountDenyBindingsReturns.result2
+}
+
+func (fake *FakeRepository) GetAccountDenyBindingsCallCount() int {
+	fake.getAccountDenyBindingsMutex.RLock()
+	defer fake.getAccountDenyBindingsMutex.RUnlock()
+	return len(fake.getAccountDenyBindingsArgsForCall)
+}
+
+func (fake *FakeRepository) GetAccountDenyBindingsArgsForCall(i int) (context.Context, rbac.AccountID) {
+	fake.getAccountDenyBindingsMutex.RLock()
+	defer fake.getAccountDenyBindingsMutex.RUnlock()
+	return fake.getAccountDenyBindingsArgsForCall[i].arg1, fake.getAccountDenyBindingsArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetAccountDenyBindingsReturns(result1 rbac.Bindings, result2 error) {
+	fake.GetAccountDenyBindingsStub = nil
+	fake.getAccountDenyBindingsReturns = struct {
+		result1 rbac.Bindings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetAccountDenyBindingsReturnsOnCall(i int, result1 rbac.Bindings, result2 error) {
+	fake.GetAccountDenyBindingsStub = nil
+	if fake.getAccountDenyBindingsReturnsOnCall == nil {
+		fake.getAccountDenyBindingsReturnsOnCall = make(map[int]struct {
+			result1 rbac.Bindings
+			result2 error
+		})
+	}
+	fake.getAccountDenyBindingsReturnsOnCall[i] = struct {
+		result1 rbac.Bindings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) RegisterRules(arg1 context.Context, arg2 rbac.RuleCatalog) error {
+	fake.registerRulesMutex.Lock()
+	ret, specificReturn := fake.registerRulesReturnsOnCall[len(fake.registerRulesArgsForCall)]
+	fake.registerRulesArgsForCall = append(fake.registerRulesArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.RuleCatalog
+	}{arg1, arg2})
+	fake.recordInvocation("RegisterRules", []interface{}{arg1, arg2})
+	fake.registerRulesMutex.Unlock()
+	if fake.RegisterRulesStub != nil {
+		return fake.RegisterRulesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.registerRulesReturns.result1
+}
+
+func (fake *FakeRepository) RegisterRulesCallCount() int {
+	fake.registerRulesMutex.RLock()
+	defer fake.registerRulesMutex.RUnlock()
+	return len(fake.registerRulesArgsForCall)
+}
+
+func (fake *FakeRepository) RegisterRulesArgsForCall(i int) (context.Context, rbac.RuleCatalog) {
+	fake.registerRulesMutex.RLock()
+	defer fake.registerRulesMutex.RUnlock()
+	return fake.registerRulesArgsForCall[i].arg1, fake.registerRulesArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) RegisterRulesReturns(result1 error) {
+	fake.RegisterRulesStub = nil
+	fake.registerRulesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) RegisterRulesReturnsOnCall(i int, result1 error) {
+	fake.RegisterRulesStub = nil
+	if fake.registerRulesReturnsOnCall == nil {
+		fake.registerRulesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.registerRulesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetRuleCatalog(arg1 context.Context) (rbac.RuleCatalog, error) {
+	fake.getRuleCatalogMutex.Lock()
+	ret, specificReturn := fake.getRuleCatalogReturnsOnCall[len(fake.getRuleCatalogArgsForCall)]
+	fake.getRuleCatalogArgsForCall = append(fake.getRuleCatalogArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("GetRuleCatalog", []interface{}{arg1})
+	fake.getRuleCatalogMutex.Unlock()
+	if fake.GetRuleCatalogStub != nil {
+		return fake.GetRuleCatalogStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getRuleCatalogReturns.result1, fake.getRuleCatalogReturns.result2
+}
+
+func (fake *FakeRepository) GetRuleCatalogCallCount() int {
+	fake.getRuleCatalogMutex.RLock()
+	defer fake.getRuleCatalogMutex.RUnlock()
+	return len(fake.getRuleCatalogArgsForCall)
+}
+
+func (fake *FakeRepository) GetRuleCatalogArgsForCall(i int) context.Context {
+	fake.getRuleCatalogMutex.RLock()
+	defer fake.getRuleCatalogMutex.RUnlock()
+	return fake.getRuleCatalogArgsForCall[i].arg1
+}
+
+func (fake *FakeRepository) GetRuleCatalogReturns(result1 rbac.RuleCatalog, result2 error) {
+	fake.GetRuleCatalogStub = nil
+	fake.getRuleCatalogReturns = struct {
+		result1 rbac.RuleCatalog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetRuleCatalogReturnsOnCall(i int, result1 rbac.RuleCatalog, result2 error) {
+	fake.GetRuleCatalogStub = nil
+	if fake.getRuleCatalogReturnsOnCall == nil {
+		fake.getRuleCatalogReturnsOnCall = make(map[int]struct {
+			result1 rbac.RuleCatalog
+			result2 error
+		})
+	}
+	fake.getRuleCatalogReturnsOnCall[i] = struct {
+		result1 rbac.RuleCatalog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetRuleBindings(arg1 context.Context, arg2 rbac.Rule) (rbac.Bindings, error) {
+	fake.getRuleBindingsMutex.Lock()
+	ret, specificReturn := fake.getRuleBindingsReturnsOnCall[len(fake.getRuleBindingsArgsForCall)]
+	fake.getRuleBindingsArgsForCall = append(fake.getRuleBindingsArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.Rule
+	}{arg1, arg2})
+	fake.recordInvocation("GetRuleBindings", []interface{}{arg1, arg2})
+	fake.getRuleBindingsMutex.Unlock()
+	if fake.GetRuleBindingsStub != nil {
+		return fake.GetRuleBindingsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getRuleBindingsReturns.result1, fake.getRuleBindingsReturns.result2
+}
+
+func (fake *FakeRepository) GetRuleBindingsCallCount() int {
+	fake.getRuleBindingsMutex.RLock()
+	defer fake.getRuleBindingsMutex.RUnlock()
+	return len(fake.getRuleBindingsArgsForCall)
+}
+
+func (fake *FakeRepository) GetRuleBindingsArgsForCall(i int) (context.Context, rbac.Rule) {
+	fake.getRuleBindingsMutex.RLock()
+	defer fake.getRuleBindingsMutex.RUnlock()
+	return fake.getRuleBindingsArgsForCall[i].arg1, fake.getRuleBindingsArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) GetRuleBindingsReturns(result1 rbac.Bindings, result2 error) {
+	fake.GetRuleBindingsStub = nil
+	fake.getRuleBindingsReturns = struct {
+		result1 rbac.Bindings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetRuleBindingsReturnsOnCall(i int, result1 rbac.Bindings, result2 error) {
+	fake.GetRuleBindingsStub = nil
+	if fake.getRuleBindingsReturnsOnCall == nil {
+		fake.getRuleBindingsReturnsOnCall = make(map[int]struct {
+			result1 rbac.Bindings
+			result2 error
+		})
+	}
+	fake.getRuleBindingsReturnsOnCall[i] = struct {
+		result1 rbac.Bindings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListRoles(arg1 context.Context, arg2 pagination.Page) ([]rbac.RoleID, error) {
+	fake.listRolesMutex.Lock()
+	ret, specificReturn := fake.listRolesReturnsOnCall[len(fake.listRolesArgsForCall)]
+	fake.listRolesArgsForCall = append(fake.listRolesArgsForCall, struct {
+		arg1 context.Context
+		arg2 pagination.Page
+	}{arg1, arg2})
+	fake.recordInvocation("ListRoles", []interface{}{arg1, arg2})
+	fake.listRolesMutex.Unlock()
+	if fake.ListRolesStub != nil {
+		return fake.ListRolesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listRolesReturns.result1, fake.listRolesReturns.result2
+}
+
+func (fake *FakeRepository) ListRolesCallCount() int {
+	fake.listRolesMutex.RLock()
+	defer fake.listRolesMutex.RUnlock()
+	return len(fake.listRolesArgsForCall)
+}
+
+func (fake *FakeRepository) ListRolesArgsForCall(i int) (context.Context, pagination.Page) {
+	fake.listRolesMutex.RLock()
+	defer fake.listRolesMutex.RUnlock()
+	return fake.listRolesArgsForCall[i].arg1, fake.listRolesArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) ListRolesReturns(result1 []rbac.RoleID, result2 error) {
+	fake.ListRolesStub = nil
+	fake.listRolesReturns = struct {
+		result1 []rbac.RoleID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListRolesReturnsOnCall(i int, result1 []rbac.RoleID, result2 error) {
+	fake.ListRolesStub = nil
+	if fake.listRolesReturnsOnCall == nil {
+		fake.listRolesReturnsOnCall = make(map[int]struct {
+			result1 []rbac.RoleID
+			result2 error
+		})
+	}
+	fake.listRolesReturnsOnCall[i] = struct {
+		result1 []rbac.RoleID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListRoleAccounts(arg1 context.Context, arg2 rbac.RoleID, arg3 pagination.Page) ([]rbac.AccountID, error) {
+	fake.listRoleAccountsMutex.Lock()
+	ret, specificReturn := fake.listRoleAccountsReturnsOnCall[len(fake.listRoleAccountsArgsForCall)]
+	fake.listRoleAccountsArgsForCall = append(fake.listRoleAccountsArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.RoleID
+		arg3 pagination.Page
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ListRoleAccounts", []interface{}{arg1, arg2, arg3})
+	fake.listRoleAccountsMutex.Unlock()
+	if fake.ListRoleAccountsStub != nil {
+		return fake.ListRoleAccountsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listRoleAccountsReturns.result1, fake.listRoleAccountsReturns.result2
+}
+
+func (fake *FakeRepository) ListRoleAccountsCallCount() int {
+	fake.listRoleAccountsMutex.RLock()
+	defer fake.listRoleAccountsMutex.RUnlock()
+	return len(fake.listRoleAccountsArgsForCall)
+}
+
+func (fake *FakeRepository) ListRoleAccountsArgsForCall(i int) (context.Context, rbac.RoleID, pagination.Page) {
+	fake.listRoleAccountsMutex.RLock()
+	defer fake.listRoleAccountsMutex.RUnlock()
+	return fake.listRoleAccountsArgsForCall[i].arg1, fake.listRoleAccountsArgsForCall[i].arg2, fake.listRoleAccountsArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) ListRoleAccountsReturns(result1 []rbac.AccountID, result2 error) {
+	fake.ListRoleAccountsStub = nil
+	fake.listRoleAccountsReturns = struct {
+		result1 []rbac.AccountID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListRoleAccountsReturnsOnCall(i int, result1 []rbac.AccountID, result2 error) {
+	fake.ListRoleAccountsStub = nil
+	if fake.listRoleAccountsReturnsOnCall == nil {
+		fake.listRoleAccountsReturnsOnCall = make(map[int]struct {
+			result1 []rbac.AccountID
+			result2 error
+		})
+	}
+	fake.listRoleAccountsReturnsOnCall[i] = struct {
+		result1 []rbac.AccountID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListRuleRoles(arg1 context.Context, arg2 rbac.Rule, arg3 pagination.Page) ([]rbac.RoleID, error) {
+	fake.listRuleRolesMutex.Lock()
+	ret, specificReturn := fake.listRuleRolesReturnsOnCall[len(fake.listRuleRolesArgsForCall)]
+	fake.listRuleRolesArgsForCall = append(fake.listRuleRolesArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.Rule
+		arg3 pagination.Page
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ListRuleRoles", []interface{}{arg1, arg2, arg3})
+	fake.listRuleRolesMutex.Unlock()
+	if fake.ListRuleRolesStub != nil {
+		return fake.ListRuleRolesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listRuleRolesReturns.result1, fake.listRuleRolesReturns.result2
+}
+
+func (fake *FakeRepository) ListRuleRolesCallCount() int {
+	fake.listRuleRolesMutex.RLock()
+	defer fake.listRuleRolesMutex.RUnlock()
+	return len(fake.listRuleRolesArgsForCall)
+}
+
+func (fake *FakeRepository) ListRuleRolesArgsForCall(i int) (context.Context, rbac.Rule, pagination.Page) {
+	fake.listRuleRolesMutex.RLock()
+	defer fake.listRuleRolesMutex.RUnlock()
+	return fake.listRuleRolesArgsForCall[i].arg1, fake.listRuleRolesArgsForCall[i].arg2, fake.listRuleRolesArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) ListRuleRolesReturns(result1 []rbac.RoleID, result2 error) {
+	fake.ListRuleRolesStub = nil
+	fake.listRuleRolesReturns = struct {
+		result1 []rbac.RoleID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListRuleRolesReturnsOnCall(i int, result1 []rbac.RoleID, result2 error) {
+	fake.ListRuleRolesStub = nil
+	if fake.listRuleRolesReturnsOnCall == nil {
+		fake.listRuleRolesReturnsOnCall = make(map[int]struct {
+			result1 []rbac.RoleID
+			result2 error
+		})
+	}
+	fake.listRuleRolesReturnsOnCall[i] = struct {
+		result1 []rbac.RoleID
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListRules(arg1 context.Context, arg2 pagination.Page) ([]rbac.Rule, error) {
+	fake.listRulesMutex.Lock()
+	ret, specificReturn := fake.listRulesReturnsOnCall[len(fake.listRulesArgsForCall)]
+	fake.listRulesArgsForCall = append(fake.listRulesArgsForCall, struct {
+		arg1 context.Context
+		arg2 pagination.Page
+	}{arg1, arg2})
+	fake.recordInvocation("ListRules", []interface{}{arg1, arg2})
+	fake.listRulesMutex.Unlock()
+	if fake.ListRulesStub != nil {
+		return fake.ListRulesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listRulesReturns.result1, fake.listRulesReturns.result2
+}
+
+func (fake *FakeRepository) ListRulesCallCount() int {
+	fake.listRulesMutex.RLock()
+	defer fake.listRulesMutex.RUnlock()
+	return len(fake.listRulesArgsForCall)
+}
+
+func (fake *FakeRepository) ListRulesArgsForCall(i int) (context.Context, pagination.Page) {
+	fake.listRulesMutex.RLock()
+	defer fake.listRulesMutex.RUnlock()
+	return fake.listRulesArgsForCall[i].arg1, fake.listRulesArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) ListRulesReturns(result1 []rbac.Rule, result2 error) {
+	fake.ListRulesStub = nil
+	fake.listRulesReturns = struct {
+		result1 []rbac.Rule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListRulesReturnsOnCall(i int, result1 []rbac.Rule, result2 error) {
+	fake.ListRulesStub = nil
+	if fake.listRulesReturnsOnCall == nil {
+		fake.listRulesReturnsOnCall = make(map[int]struct {
+			result1 []rbac.Rule
+			result2 error
+		})
+	}
+	fake.listRulesReturnsOnCall[i] = struct {
+		result1 []rbac.Rule
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) AddAuditEntry(arg1 context.Context, arg2 rbac.AuditEntry) (rbac.AuditEntry, error) {
+	fake.addAuditEntryMutex.Lock()
+	ret, specificReturn := fake.addAuditEntryReturnsOnCall[len(fake.addAuditEntryArgsForCall)]
+	fake.addAuditEntryArgsForCall = append(fake.addAuditEntryArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.AuditEntry
+	}{arg1, arg2})
+	fake.recordInvocation("AddAuditEntry", []interface{}{arg1, arg2})
+	fake.addAuditEntryMutex.Unlock()
+	if fake.AddAuditEntryStub != nil {
+		return fake.AddAuditEntryStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.addAuditEntryReturns.result1, fake.addAuditEntryReturns.result2
+}
+
+func (fake *FakeRepository) AddAuditEntryCallCount() int {
+	fake.addAuditEntryMutex.RLock()
+	defer fake.addAuditEntryMutex.RUnlock()
+	return len(fake.addAuditEntryArgsForCall)
+}
+
+func (fake *FakeRepository) AddAuditEntryArgsForCall(i int) (context.Context, rbac.AuditEntry) {
+	fake.addAuditEntryMutex.RLock()
+	defer fake.addAuditEntryMutex.RUnlock()
+	return fake.addAuditEntryArgsForCall[i].arg1, fake.addAuditEntryArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) AddAuditEntryReturns(result1 rbac.AuditEntry, result2 error) {
+	fake.AddAuditEntryStub = nil
+	fake.addAuditEntryReturns = struct {
+		result1 rbac.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) AddAuditEntryReturnsOnCall(i int, result1 rbac.AuditEntry, result2 error) {
+	fake.AddAuditEntryStub = nil
+	if fake.addAuditEntryReturnsOnCall == nil {
+		fake.addAuditEntryReturnsOnCall = make(map[int]struct {
+			result1 rbac.AuditEntry
+			result2 error
+		})
+	}
+	fake.addAuditEntryReturnsOnCall[i] = struct {
+		result1 rbac.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListAuditEntries(arg1 context.Context, arg2 rbac.AuditFilter, arg3 pagination.Page) ([]rbac.AuditEntry, error) {
+	fake.listAuditEntriesMutex.Lock()
+	ret, specificReturn := fake.listAuditEntriesReturnsOnCall[len(fake.listAuditEntriesArgsForCall)]
+	fake.listAuditEntriesArgsForCall = append(fake.listAuditEntriesArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.AuditFilter
+		arg3 pagination.Page
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ListAuditEntries", []interface{}{arg1, arg2, arg3})
+	fake.listAuditEntriesMutex.Unlock()
+	if fake.ListAuditEntriesStub != nil {
+		return fake.ListAuditEntriesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listAuditEntriesReturns.result1, fake.listAuditEntriesReturns.result2
+}
+
+func (fake *FakeRepository) ListAuditEntriesCallCount() int {
+	fake.listAuditEntriesMutex.RLock()
+	defer fake.listAuditEntriesMutex.RUnlock()
+	return len(fake.listAuditEntriesArgsForCall)
+}
+
+func (fake *FakeRepository) ListAuditEntriesArgsForCall(i int) (context.Context, rbac.AuditFilter, pagination.Page) {
+	fake.listAuditEntriesMutex.RLock()
+	defer fake.listAuditEntriesMutex.RUnlock()
+	return fake.listAuditEntriesArgsForCall[i].arg1, fake.listAuditEntriesArgsForCall[i].arg2, fake.listAuditEntriesArgsForCall[i].arg3
+}
+
+func (fake *FakeRepository) ListAuditEntriesReturns(result1 []rbac.AuditEntry, result2 error) {
+	fake.ListAuditEntriesStub = nil
+	fake.listAuditEntriesReturns = struct {
+		result1 []rbac.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) ListAuditEntriesReturnsOnCall(i int, result1 []rbac.AuditEntry, result2 error) {
+	fake.ListAuditEntriesStub = nil
+	if fake.listAuditEntriesReturnsOnCall == nil {
+		fake.listAuditEntriesReturnsOnCall = make(map[int]struct {
+			result1 []rbac.AuditEntry
+			result2 error
+		})
+	}
+	fake.listAuditEntriesReturnsOnCall[i] = struct {
+		result1 []rbac.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetUnpublishedAuditEntries(ctx context.Context, limit uint64) ([]rbac.AuditEntry, error) {
+	fake.getUnpublishedAuditEntriesMutex.Lock()
+	ret, specificReturn := fake.getUnpublishedAuditEntriesReturnsOnCall[len(fake.getUnpublishedAuditEntriesArgsForCall)]
+	fake.getUnpublishedAuditEntriesArgsForCall = append(fake.getUnpublishedAuditEntriesArgsForCall, struct {
+		ctx   context.Context
+		limit uint64
+	}{ctx, limit})
+	fake.recordInvocation("GetUnpublishedAuditEntries", []interface{}{ctx, limit})
+	fake.getUnpublishedAuditEntriesMutex.Unlock()
+	if fake.GetUnpublishedAuditEntriesStub != nil {
+		return fake.GetUnpublishedAuditEntriesStub(ctx, limit)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getUnpublishedAuditEntriesReturns.result1, fake.getUnpublishedAuditEntriesReturns.result2
+}
+
+func (fake *FakeRepository) GetUnpublishedAuditEntriesCallCount() int {
+	fake.getUnpublishedAuditEntriesMutex.RLock()
+	defer fake.getUnpublishedAuditEntriesMutex.RUnlock()
+	return len(fake.getUnpublishedAuditEntriesArgsForCall)
+}
+
+func (fake *FakeRepository) GetUnpublishedAuditEntriesArgsForCall(i int) (context.Context, uint64) {
+	fake.getUnpublishedAuditEntriesMutex.RLock()
+	defer fake.getUnpublishedAuditEntriesMutex.RUnlock()
+	return fake.getUnpublishedAuditEntriesArgsForCall[i].ctx, fake.getUnpublishedAuditEntriesArgsForCall[i].limit
+}
+
+func (fake *FakeRepository) GetUnpublishedAuditEntriesReturns(result1 []rbac.AuditEntry, result2 error) {
+	fake.GetUnpublishedAuditEntriesStub = nil
+	fake.getUnpublishedAuditEntriesReturns = struct {
+		result1 []rbac.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetUnpublishedAuditEntriesReturnsOnCall(i int, result1 []rbac.AuditEntry, result2 error) {
+	fake.GetUnpublishedAuditEntriesStub = nil
+	if fake.getUnpublishedAuditEntriesReturnsOnCall == nil {
+		fake.getUnpublishedAuditEntriesReturnsOnCall = make(map[int]struct {
+			result1 []rbac.AuditEntry
+			result2 error
+		})
+	}
+	fake.getUnpublishedAuditEntriesReturnsOnCall[i] = struct {
+		result1 []rbac.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) MarkAuditEntryPublished(ctx context.Context, id string) error {
+	fake.markAuditEntryPublishedMutex.Lock()
+	ret, specificReturn := fake.markAuditEntryPublishedReturnsOnCall[len(fake.markAuditEntryPublishedArgsForCall)]
+	fake.markAuditEntryPublishedArgsForCall = append(fake.markAuditEntryPublishedArgsForCall, struct {
+		ctx context.Context
+		id  string
+	}{ctx, id})
+	fake.recordInvocation("MarkAuditEntryPublished", []interface{}{ctx, id})
+	fake.markAuditEntryPublishedMutex.Unlock()
+	if fake.MarkAuditEntryPublishedStub != nil {
+		return fake.MarkAuditEntryPublishedStub(ctx, id)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.markAuditEntryPublishedReturns.result1
+}
+
+func (fake *FakeRepository) MarkAuditEntryPublishedCallCount() int {
+	fake.markAuditEntryPublishedMutex.RLock()
+	defer fake.markAuditEntryPublishedMutex.RUnlock()
+	return len(fake.markAuditEntryPublishedArgsForCall)
+}
+
+func (fake *FakeRepository) MarkAuditEntryPublishedArgsForCall(i int) (context.Context, string) {
+	fake.markAuditEntryPublishedMutex.RLock()
+	defer fake.markAuditEntryPublishedMutex.RUnlock()
+	return fake.markAuditEntryPublishedArgsForCall[i].ctx, fake.markAuditEntryPublishedArgsForCall[i].id
+}
+
+func (fake *FakeRepository) MarkAuditEntryPublishedReturns(result1 error) {
+	fake.MarkAuditEntryPublishedStub = nil
+	fake.markAuditEntryPublishedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) MarkAuditEntryPublishedReturnsOnCall(i int, result1 error) {
+	fake.MarkAuditEntryPublishedStub = nil
+	if fake.markAuditEntryPublishedReturnsOnCall == nil {
+		fake.markAuditEntryPublishedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markAuditEntryPublishedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeleteAccount(arg1 context.Context, arg2 rbac.AccountID) error {
+	fake.deleteAccountMutex.Lock()
+	ret, specificReturn := fake.deleteAccountReturnsOnCall[len(fake.deleteAccountArgsForCall)]
+	fake.deleteAccountArgsForCall = append(fake.deleteAccountArgsForCall, struct {
+		arg1 context.Context
+		arg2 rbac.AccountID
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteAccount", []interface{}{arg1, arg2})
+	fake.deleteAccountMutex.Unlock()
+	if fake.DeleteAccountStub != nil {
+		return fake.DeleteAccountStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteAccountReturns.result1
+}
+
+func (fake *FakeRepository) DeleteAccountCallCount() int {
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	return len(fake.deleteAccountArgsForCall)
+}
+
+func (fake *FakeRepository) DeleteAccountArgsForCall(i int) (context.Context, rbac.AccountID) {
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	return fake.deleteAccountArgsForCall[i].arg1, fake.deleteAccountArgsForCall[i].arg2
+}
+
+func (fake *FakeRepository) DeleteAccountReturns(result1 error) {
+	fake.DeleteAccountStub = nil
+	fake.deleteAccountReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) DeleteAccountReturnsOnCall(i int, result1 error) {
+	fake.DeleteAccountStub = nil
+	if fake.deleteAccountReturnsOnCall == nil {
+		fake.deleteAccountReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteAccountReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) AnonymizeAuditEntries(ctx context.Context, accountID rbac.AccountID, replacement rbac.AccountID) error {
+	fake.anonymizeAuditEntriesMutex.Lock()
+	ret, specificReturn := fake.anonymizeAuditEntriesReturnsOnCall[len(fake.anonymizeAuditEntriesArgsForCall)]
+	fake.anonymizeAuditEntriesArgsForCall = append(fake.anonymizeAuditEntriesArgsForCall, struct {
+		ctx         context.Context
+		accountID   rbac.AccountID
+		replacement rbac.AccountID
+	}{ctx, accountID, replacement})
+	fake.recordInvocation("AnonymizeAuditEntries", []interface{}{ctx, accountID, replacement})
+	fake.anonymizeAuditEntriesMutex.Unlock()
+	if fake.AnonymizeAuditEntriesStub != nil {
+		return fake.AnonymizeAuditEntriesStub(ctx, accountID, replacement)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.anonymizeAuditEntriesReturns.result1
+}
+
+func (fake *FakeRepository) AnonymizeAuditEntriesCallCount() int {
+	fake.anonymizeAuditEntriesMutex.RLock()
+	defer fake.anonymizeAuditEntriesMutex.RUnlock()
+	return len(fake.anonymizeAuditEntriesArgsForCall)
+}
+
+func (fake *FakeRepository) AnonymizeAuditEntriesArgsForCall(i int) (context.Context, rbac.AccountID, rbac.AccountID) {
+	fake.anonymizeAuditEntriesMutex.RLock()
+	defer fake.anonymizeAuditEntriesMutex.RUnlock()
+	return fake.anonymizeAuditEntriesArgsForCall[i].ctx, fake.anonymizeAuditEntriesArgsForCall[i].accountID, fake.anonymizeAuditEntriesArgsForCall[i].replacement
+}
+
+func (fake *FakeRepository) AnonymizeAuditEntriesReturns(result1 error) {
+	fake.AnonymizeAuditEntriesStub = nil
+	fake.anonymizeAuditEntriesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) AnonymizeAuditEntriesReturnsOnCall(i int, result1 error) {
+	fake.AnonymizeAuditEntriesStub = nil
+	if fake.anonymizeAuditEntriesReturnsOnCall == nil {
+		fake.anonymizeAuditEntriesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.anonymizeAuditEntriesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
 func (fake *FakeRepository) Invocations() map[string][][]interface{} {
@@ -346,12 +1651,52 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.getRoleRulesMutex.RUnlock()
 	fake.setRoleRulesMutex.RLock()
 	defer fake.setRoleRulesMutex.RUnlock()
+	fake.getRoleDenyRulesMutex.RLock()
+	defer fake.getRoleDenyRulesMutex.RUnlock()
+	fake.setRoleDenyRulesMutex.RLock()
+	defer fake.setRoleDenyRulesMutex.RUnlock()
+	fake.getRoleParentsMutex.RLock()
+	defer fake.getRoleParentsMutex.RUnlock()
+	fake.setRoleParentsMutex.RLock()
+	defer fake.setRoleParentsMutex.RUnlock()
 	fake.getAccountRolesMutex.RLock()
 	defer fake.getAccountRolesMutex.RUnlock()
 	fake.setAccountRolesMutex.RLock()
 	defer fake.setAccountRolesMutex.RUnlock()
-	fake.getAccountRuleCountMutex.RLock()
-	defer fake.getAccountRuleCountMutex.RUnlock()
+	fake.addAccountRolesMutex.RLock()
+	defer fake.addAccountRolesMutex.RUnlock()
+	fake.removeAccountRolesMutex.RLock()
+	defer fake.removeAccountRolesMutex.RUnlock()
+	fake.getAccountBindingsMutex.RLock()
+	defer fake.getAccountBindingsMutex.RUnlock()
+	fake.getAccountDenyBindingsMutex.RLock()
+	defer fake.getAccountDenyBindingsMutex.RUnlock()
+	fake.registerRulesMutex.RLock()
+	defer fake.registerRulesMutex.RUnlock()
+	fake.getRuleCatalogMutex.RLock()
+	defer fake.getRuleCatalogMutex.RUnlock()
+	fake.getRuleBindingsMutex.RLock()
+	defer fake.getRuleBindingsMutex.RUnlock()
+	fake.listRolesMutex.RLock()
+	defer fake.listRolesMutex.RUnlock()
+	fake.listRoleAccountsMutex.RLock()
+	defer fake.listRoleAccountsMutex.RUnlock()
+	fake.listRuleRolesMutex.RLock()
+	defer fake.listRuleRolesMutex.RUnlock()
+	fake.listRulesMutex.RLock()
+	defer fake.listRulesMutex.RUnlock()
+	fake.addAuditEntryMutex.RLock()
+	defer fake.addAuditEntryMutex.RUnlock()
+	fake.listAuditEntriesMutex.RLock()
+	defer fake.listAuditEntriesMutex.RUnlock()
+	fake.getUnpublishedAuditEntriesMutex.RLock()
+	defer fake.getUnpublishedAuditEntriesMutex.RUnlock()
+	fake.markAuditEntryPublishedMutex.RLock()
+	defer fake.markAuditEntryPublishedMutex.RUnlock()
+	fake.deleteAccountMutex.RLock()
+	defer fake.deleteAccountMutex.RUnlock()
+	fake.anonymizeAuditEntriesMutex.RLock()
+	defer fake.anonymizeAuditEntriesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,296 @@
+package policy
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/51st-state/api/pkg/apis/role"
+	"github.com/51st-state/api/pkg/rbac"
+	"github.com/pkg/errors"
+)
+
+// Manager compares policies with the live state of the
+// role and rbac services and applies them
+//go:generate counterfeiter -o ./mocks/manager.go . Manager
+type Manager interface {
+	Plan(context.Context, *Policy) (*Plan, error)
+	Apply(context.Context, *Plan) error
+	Export(context.Context, []rbac.RoleID, []rbac.AccountID) (*Policy, error)
+}
+
+type manager struct {
+	roles role.Manager
+	rbac  rbac.Control
+}
+
+// NewManager creates a new policy manager
+func NewManager(roles role.Manager, rb rbac.Control) Manager {
+	return &manager{
+		roles,
+		rb,
+	}
+}
+
+var (
+	errUnknownRule = errors.New("unknown rule")
+	errSystemRole  = errors.New("system roles can not be changed")
+	errRoleCycle   = errors.New("role inheritance must not contain cycles")
+)
+
+type complete struct {
+	role.Identifier
+	role.Incomplete
+}
+
+// Plan the changes needed to apply a policy
+func (m *manager) Plan(ctx context.Context, p *Policy) (*Plan, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	catalog, err := m.rbac.GetRuleCatalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{
+		Roles:    make([]*RoleChange, 0),
+		Bindings: make([]*BindingChange, 0),
+	}
+
+	for _, v := range p.Roles {
+		for _, rule := range append(append(rbac.RoleRules{}, v.Rules...), v.DenyRules...) {
+			if !catalog.Knows(rule) {
+				return nil, errors.Wrapf(errUnknownRule, "role %s: rule %s", v.ID, rule)
+			}
+		}
+
+		live, err := m.getRole(ctx, v.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if live != nil && rolesEqual(live, v) {
+			continue
+		}
+
+		if role.IsSystemRole(v.ID) {
+			return nil, errors.Wrapf(errSystemRole, "role %s", v.ID)
+		}
+
+		plan.Roles = append(plan.Roles, &RoleChange{
+			From: live,
+			To:   v,
+		})
+	}
+
+	plan.Roles, err = sortByParents(plan.Roles)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range p.Bindings {
+		live, err := m.rbac.GetAccountRoles(ctx, v.AccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		if equalSets(roleStrings(live), roleStrings(v.Roles)) {
+			continue
+		}
+
+		plan.Bindings = append(plan.Bindings, &BindingChange{
+			AccountID: v.AccountID,
+			From:      live,
+			To:        v.Roles,
+		})
+	}
+
+	return plan, nil
+}
+
+// getRole returns the live state of a role or nil if it does not exist
+func (m *manager) getRole(ctx context.Context, id rbac.RoleID) (*Role, error) {
+	c, err := m.roles.Get(ctx, role.NewIdentifier(id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Role{
+		ID:          id,
+		Title:       c.Data().Title,
+		Description: c.Data().Description,
+		Rules:       c.Data().Rules,
+		DenyRules:   c.Data().DenyRules,
+		Parents:     c.Data().Parents,
+	}, nil
+}
+
+// Apply all changes of a plan in its order.
+//
+// If a change fails, the changes applied before and a partially applied
+// update are reverted and the error of the change is returned.
+// The roles are stored by the role manager right away, their rules and
+// parents however are applied to the rbac system by the sync of the role
+// manager. So the rbac system may lag behind the applied or reverted roles
+// until their changes have been synced, and bindings of roles which have not
+// been synced yet are left out by the rbac system.
+func (m *manager) Apply(ctx context.Context, plan *Plan) error {
+	reverts := make([]func() error, 0)
+
+	rollback := func(err error) error {
+		for i := len(reverts) - 1; i >= 0; i-- {
+			if revertErr := reverts[i](); revertErr != nil {
+				return errors.Wrapf(err, "rollback failed (%s)", revertErr.Error())
+			}
+		}
+
+		return err
+	}
+
+	for _, v := range plan.Roles {
+		change := v
+		if change.From == nil {
+			if err := m.roles.Create(ctx, toComplete(change.To)); err != nil {
+				return rollback(errors.Wrapf(err, "create role %s", change.To.ID))
+			}
+
+			reverts = append(reverts, func() error {
+				return m.roles.Delete(ctx, role.NewIdentifier(change.To.ID))
+			})
+			continue
+		}
+
+		reverts = append(reverts, func() error {
+			return m.roles.Set(ctx, toComplete(change.From))
+		})
+
+		if err := m.roles.Set(ctx, toComplete(change.To)); err != nil {
+			return rollback(errors.Wrapf(err, "update role %s", change.To.ID))
+		}
+	}
+
+	for _, v := range plan.Bindings {
+		change := v
+		reverts = append(reverts, func() error {
+			return m.rbac.SetAccountRoles(ctx, change.AccountID, change.From)
+		})
+
+		if err := m.rbac.SetAccountRoles(ctx, change.AccountID, change.To); err != nil {
+			return rollback(errors.Wrapf(err, "set roles of account %s", change.AccountID))
+		}
+	}
+
+	return nil
+}
+
+// sortByParents orders the role changes so every role is changed after
+// the parents it inherits from, because a role may only inherit from
+// existing roles. The order of independent changes is kept.
+func sortByParents(changes []*RoleChange) ([]*RoleChange, error) {
+	planned := make(rbac.RoleParents, 0)
+	for _, v := range changes {
+		planned = append(planned, v.To.ID)
+	}
+
+	sorted := make([]*RoleChange, 0)
+	sortedIDs := make(rbac.RoleParents, 0)
+	for len(changes) > 0 {
+		next := -1
+		for i, v := range changes {
+			if parentsSorted(v.To.Parents, planned, sortedIDs) {
+				next = i
+				break
+			}
+		}
+
+		if next < 0 {
+			return nil, errors.Wrapf(errRoleCycle, "role %s", changes[0].To.ID)
+		}
+
+		sorted = append(sorted, changes[next])
+		sortedIDs = append(sortedIDs, changes[next].To.ID)
+		changes = append(changes[:next:next], changes[next+1:]...)
+	}
+
+	return sorted, nil
+}
+
+// parentsSorted checks whether all parents changed by the plan are sorted already
+func parentsSorted(parents, planned, sorted rbac.RoleParents) bool {
+	for _, v := range parents {
+		if planned.Contains(v) && !sorted.Contains(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func toComplete(r *Role) role.Complete {
+	return &complete{
+		role.NewIdentifier(r.ID),
+		role.NewIncomplete(
+			r.Title,
+			r.Description,
+			orEmptyRules(r.Rules),
+			orEmptyRules(r.DenyRules),
+			orEmptyParents(r.Parents),
+		),
+	}
+}
+
+func orEmptyRules(rules rbac.RoleRules) rbac.RoleRules {
+	if rules == nil {
+		return make(rbac.RoleRules, 0)
+	}
+
+	return rules
+}
+
+func orEmptyParents(parents rbac.RoleParents) rbac.RoleParents {
+	if parents == nil {
+		return make(rbac.RoleParents, 0)
+	}
+
+	return parents
+}
+
+// Export the live state of roles and accounts as a policy
+func (m *manager) Export(ctx context.Context, roleIDs []rbac.RoleID, accountIDs []rbac.AccountID) (*Policy, error) {
+	p := &Policy{
+		Roles:    make([]*Role, 0),
+		Bindings: make([]*Binding, 0),
+	}
+
+	for _, v := range roleIDs {
+		r, err := m.getRole(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+
+		if r == nil {
+			return nil, errors.Wrapf(sql.ErrNoRows, "role %s", v)
+		}
+
+		p.Roles = append(p.Roles, r)
+	}
+
+	for _, v := range accountIDs {
+		roles, err := m.rbac.GetAccountRoles(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+
+		p.Bindings = append(p.Bindings, &Binding{
+			AccountID: v,
+			Roles:     roles,
+		})
+	}
+
+	return p, nil
+}
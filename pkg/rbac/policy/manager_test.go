@@ -0,0 +1,238 @@
+package policy_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/51st-state/api/pkg/apis/role"
+	roleMocks "github.com/51st-state/api/pkg/apis/role/mocks"
+	"github.com/51st-state/api/pkg/rbac"
+	rbacMocks "github.com/51st-state/api/pkg/rbac/mocks"
+	"github.com/51st-state/api/pkg/rbac/policy"
+)
+
+type fakeComplete struct {
+	role.Identifier
+	role.Incomplete
+}
+
+func newTestManager() (policy.Manager, *roleMocks.FakeManager, *rbacMocks.FakeControl) {
+	roles := &roleMocks.FakeManager{}
+	control := &rbacMocks.FakeControl{}
+	control.GetRuleCatalogReturns(rbac.RuleCatalog{
+		{Rule: "chat.send", Service: "chat"},
+		{Rule: "chat.admin", Service: "chat"},
+	}, nil)
+
+	return policy.NewManager(roles, control), roles, control
+}
+
+func testPolicy() *policy.Policy {
+	return &policy.Policy{
+		Roles: []*policy.Role{
+			{
+				ID:        "system/moderator",
+				Title:     "Moderator",
+				Rules:     rbac.RoleRules{"chat.*"},
+				DenyRules: rbac.RoleRules{"chat.admin"},
+			},
+		},
+		Bindings: []*policy.Binding{
+			{
+				AccountID: "serviceaccount/chatbot",
+				Roles:     rbac.AccountRoles{"system/moderator"},
+			},
+		},
+	}
+}
+
+func TestManagerPlanSystemRole(t *testing.T) {
+	m, roles, control := newTestManager()
+	control.GetAccountRolesReturns(rbac.AccountRoles{"system/moderator"}, nil)
+
+	p := testPolicy()
+	p.Roles[0].ID = role.SuperadminID
+	p.Roles[0].Rules = rbac.RoleRules{rbac.Wildcard}
+	p.Roles[0].DenyRules = rbac.RoleRules{}
+
+	roles.GetReturns(&fakeComplete{
+		role.NewIdentifier(role.SuperadminID),
+		role.NewIncomplete("Superadmin", "", rbac.RoleRules{rbac.Wildcard}, rbac.RoleRules{}, rbac.RoleParents{}),
+	}, nil)
+	p.Roles[0].Title = "Superadmin"
+	if _, err := m.Plan(context.Background(), p); err != nil {
+		t.Fatal("unchanged system roles can be part of a policy")
+	}
+
+	p.Roles[0].Rules = rbac.RoleRules{"chat.send"}
+	if _, err := m.Plan(context.Background(), p); err == nil {
+		t.Fatal("system roles can not be changed")
+	}
+}
+
+func TestManagerPlan(t *testing.T) {
+	m, roles, control := newTestManager()
+
+	p := testPolicy()
+	p.Roles[0].Rules = rbac.RoleRules{"chat.sned"}
+	if _, err := m.Plan(context.Background(), p); err == nil {
+		t.Fatal("the rule is unknown")
+	}
+
+	roles.GetReturns(nil, errors.New("fake error"))
+	if _, err := m.Plan(context.Background(), testPolicy()); err == nil {
+		t.Fatal("the role manager returns an error")
+	}
+
+	roles.GetReturns(nil, sql.ErrNoRows)
+	control.GetAccountRolesReturns(rbac.AccountRoles{}, nil)
+	plan, err := m.Plan(context.Background(), testPolicy())
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(plan.Roles) != 1 || plan.Roles[0].From != nil {
+		t.Fatal("the role has to be created")
+	}
+
+	if len(plan.Bindings) != 1 {
+		t.Fatal("the roles of the account have to be changed")
+	}
+
+	if !strings.Contains(plan.String(), "+ role system/moderator") {
+		t.Fatal("the plan should describe the creation of the role")
+	}
+
+	roles.GetReturns(&fakeComplete{
+		role.NewIdentifier("system/moderator"),
+		role.NewIncomplete("Moderator", "", rbac.RoleRules{"chat.*"}, rbac.RoleRules{"chat.admin"}, rbac.RoleParents{}),
+	}, nil)
+	control.GetAccountRolesReturns(rbac.AccountRoles{"system/moderator"}, nil)
+	plan, err = m.Plan(context.Background(), testPolicy())
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !plan.IsEmpty() {
+		t.Fatal("the live state matches the policy")
+	}
+
+	p = testPolicy()
+	p.Roles[0].Parents = rbac.RoleParents{"system/player"}
+	plan, err = m.Plan(context.Background(), p)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(plan.Roles) != 1 || plan.Roles[0].From == nil {
+		t.Fatal("the role has to be updated")
+	}
+
+	if !strings.Contains(plan.String(), "parents: +system/player") {
+		t.Fatal("the plan should describe the new parent")
+	}
+}
+
+func TestManagerApply(t *testing.T) {
+	m, roles, control := newTestManager()
+
+	plan := &policy.Plan{
+		Roles: []*policy.RoleChange{
+			{From: nil, To: testPolicy().Roles[0]},
+		},
+		Bindings: []*policy.BindingChange{
+			{AccountID: "serviceaccount/chatbot", From: rbac.AccountRoles{}, To: rbac.AccountRoles{"system/moderator"}},
+		},
+	}
+
+	if err := m.Apply(context.Background(), plan); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if roles.CreateCallCount() != 1 || control.SetAccountRolesCallCount() != 1 {
+		t.Fatal("all changes should be applied")
+	}
+
+	control.SetAccountRolesReturnsOnCall(1, errors.New("fake error"))
+	if err := m.Apply(context.Background(), plan); err == nil {
+		t.Fatal("the rbac control returns an error")
+	}
+
+	if roles.DeleteCallCount() != 1 {
+		t.Fatal("the created role should be deleted again")
+	}
+
+	if _, _, accountRoles := control.SetAccountRolesArgsForCall(2); len(accountRoles) != 0 {
+		t.Fatal("the roles of the account should be reverted")
+	}
+
+	roles.CreateReturns(errors.New("fake error"))
+	if err := m.Apply(context.Background(), plan); err == nil {
+		t.Fatal("the role manager returns an error")
+	}
+
+	if roles.DeleteCallCount() != 1 {
+		t.Fatal("a role which could not be created should not be deleted")
+	}
+}
+
+func TestManagerPlanOrder(t *testing.T) {
+	m, roles, control := newTestManager()
+	roles.GetReturns(nil, sql.ErrNoRows)
+	control.GetAccountRolesReturns(rbac.AccountRoles{}, nil)
+
+	p := &policy.Policy{
+		Roles: []*policy.Role{
+			{ID: "child", Parents: rbac.RoleParents{"parent", "system/player"}},
+			{ID: "other"},
+			{ID: "parent", Parents: rbac.RoleParents{"grandparent"}},
+			{ID: "grandparent"},
+		},
+	}
+
+	plan, err := m.Plan(context.Background(), p)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	order := make([]rbac.RoleID, 0)
+	for _, v := range plan.Roles {
+		order = append(order, v.To.ID)
+	}
+
+	if len(order) != 4 || order[0] != "other" || order[1] != "grandparent" || order[2] != "parent" || order[3] != "child" {
+		t.Fatal("the roles should be changed after their parents")
+	}
+
+	p.Roles[3].Parents = rbac.RoleParents{"child"}
+	if _, err := m.Plan(context.Background(), p); err == nil {
+		t.Fatal("the roles inherit from each other")
+	}
+}
+
+func TestManagerExport(t *testing.T) {
+	m, roles, control := newTestManager()
+
+	roles.GetReturns(nil, sql.ErrNoRows)
+	if _, err := m.Export(context.Background(), []rbac.RoleID{"system/moderator"}, nil); err == nil {
+		t.Fatal("the role does not exist")
+	}
+
+	roles.GetReturns(&fakeComplete{
+		role.NewIdentifier("system/moderator"),
+		role.NewIncomplete("Moderator", "", rbac.RoleRules{"chat.*"}, rbac.RoleRules{}, rbac.RoleParents{}),
+	}, nil)
+	control.GetAccountRolesReturns(rbac.AccountRoles{"system/moderator"}, nil)
+
+	p, err := m.Export(context.Background(), []rbac.RoleID{"system/moderator"}, []rbac.AccountID{"serviceaccount/chatbot"})
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(p.Roles) != 1 || p.Roles[0].Title != "Moderator" || len(p.Bindings) != 1 {
+		t.Fatal("the live state should be exported")
+	}
+}
@@ -0,0 +1,250 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/51st-state/api/pkg/rbac"
+	"github.com/51st-state/api/pkg/rbac/policy"
+)
+
+type FakeManager struct {
+	PlanStub        func(context.Context, *policy.Policy) (*policy.Plan, error)
+	planMutex       sync.RWMutex
+	planArgsForCall []struct {
+		arg1 context.Context
+		arg2 *policy.Policy
+	}
+	planReturns struct {
+		result1 *policy.Plan
+		result2 error
+	}
+	planReturnsOnCall map[int]struct {
+		result1 *policy.Plan
+		result2 error
+	}
+	ApplyStub        func(context.Context, *policy.Plan) error
+	applyMutex       sync.RWMutex
+	applyArgsForCall []struct {
+		arg1 context.Context
+		arg2 *policy.Plan
+	}
+	applyReturns struct {
+		result1 error
+	}
+	applyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExportStub        func(context.Context, []rbac.RoleID, []rbac.AccountID) (*policy.Policy, error)
+	exportMutex       sync.RWMutex
+	exportArgsForCall []struct {
+		arg1 context.Context
+		arg2 []rbac.RoleID
+		arg3 []rbac.AccountID
+	}
+	exportReturns struct {
+		result1 *policy.Policy
+		result2 error
+	}
+	exportReturnsOnCall map[int]struct {
+		result1 *policy.Policy
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeManager) Plan(arg1 context.Context, arg2 *policy.Policy) (*policy.Plan, error) {
+	fake.planMutex.Lock()
+	ret, specificReturn := fake.planReturnsOnCall[len(fake.planArgsForCall)]
+	fake.planArgsForCall = append(fake.planArgsForCall, struct {
+		arg1 context.Context
+		arg2 *policy.Policy
+	}{arg1, arg2})
+	fake.recordInvocation("Plan", []interface{}{arg1, arg2})
+	fake.planMutex.Unlock()
+	if fake.PlanStub != nil {
+		return fake.PlanStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.planReturns.result1, fake.planReturns.result2
+}
+
+func (fake *FakeManager) PlanCallCount() int {
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	return len(fake.planArgsForCall)
+}
+
+func (fake *FakeManager) PlanArgsForCall(i int) (context.Context, *policy.Policy) {
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	return fake.planArgsForCall[i].arg1, fake.planArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) PlanReturns(result1 *policy.Plan, result2 error) {
+	fake.PlanStub = nil
+	fake.planReturns = struct {
+		result1 *policy.Plan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) PlanReturnsOnCall(i int, result1 *policy.Plan, result2 error) {
+	fake.PlanStub = nil
+	if fake.planReturnsOnCall == nil {
+		fake.planReturnsOnCall = make(map[int]struct {
+			result1 *policy.Plan
+			result2 error
+		})
+	}
+	fake.planReturnsOnCall[i] = struct {
+		result1 *policy.Plan
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Apply(arg1 context.Context, arg2 *policy.Plan) error {
+	fake.applyMutex.Lock()
+	ret, specificReturn := fake.applyReturnsOnCall[len(fake.applyArgsForCall)]
+	fake.applyArgsForCall = append(fake.applyArgsForCall, struct {
+		arg1 context.Context
+		arg2 *policy.Plan
+	}{arg1, arg2})
+	fake.recordInvocation("Apply", []interface{}{arg1, arg2})
+	fake.applyMutex.Unlock()
+	if fake.ApplyStub != nil {
+		return fake.ApplyStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.applyReturns.result1
+}
+
+func (fake *FakeManager) ApplyCallCount() int {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return len(fake.applyArgsForCall)
+}
+
+func (fake *FakeManager) ApplyArgsForCall(i int) (context.Context, *policy.Plan) {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return fake.applyArgsForCall[i].arg1, fake.applyArgsForCall[i].arg2
+}
+
+func (fake *FakeManager) ApplyReturns(result1 error) {
+	fake.ApplyStub = nil
+	fake.applyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) ApplyReturnsOnCall(i int, result1 error) {
+	fake.ApplyStub = nil
+	if fake.applyReturnsOnCall == nil {
+		fake.applyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.applyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeManager) Export(arg1 context.Context, arg2 []rbac.RoleID, arg3 []rbac.AccountID) (*policy.Policy, error) {
+	var arg2Copy []rbac.RoleID
+	if arg2 != nil {
+		arg2Copy = make([]rbac.RoleID, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	var arg3Copy []rbac.AccountID
+	if arg3 != nil {
+		arg3Copy = make([]rbac.AccountID, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.exportMutex.Lock()
+	ret, specificReturn := fake.exportReturnsOnCall[len(fake.exportArgsForCall)]
+	fake.exportArgsForCall = append(fake.exportArgsForCall, struct {
+		arg1 context.Context
+		arg2 []rbac.RoleID
+		arg3 []rbac.AccountID
+	}{arg1, arg2Copy, arg3Copy})
+	fake.recordInvocation("Export", []interface{}{arg1, arg2Copy, arg3Copy})
+	fake.exportMutex.Unlock()
+	if fake.ExportStub != nil {
+		return fake.ExportStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.exportReturns.result1, fake.exportReturns.result2
+}
+
+func (fake *FakeManager) ExportCallCount() int {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return len(fake.exportArgsForCall)
+}
+
+func (fake *FakeManager) ExportArgsForCall(i int) (context.Context, []rbac.RoleID, []rbac.AccountID) {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return fake.exportArgsForCall[i].arg1, fake.exportArgsForCall[i].arg2, fake.exportArgsForCall[i].arg3
+}
+
+func (fake *FakeManager) ExportReturns(result1 *policy.Policy, result2 error) {
+	fake.ExportStub = nil
+	fake.exportReturns = struct {
+		result1 *policy.Policy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) ExportReturnsOnCall(i int, result1 *policy.Policy, result2 error) {
+	fake.ExportStub = nil
+	if fake.exportReturnsOnCall == nil {
+		fake.exportReturnsOnCall = make(map[int]struct {
+			result1 *policy.Policy
+			result2 error
+		})
+	}
+	fake.exportReturnsOnCall[i] = struct {
+		result1 *policy.Policy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeManager) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeManager) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ policy.Manager = new(FakeManager)
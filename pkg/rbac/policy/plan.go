@@ -0,0 +1,158 @@
+package policy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+// RoleChange needed to bring a live role to the state of a policy
+type RoleChange struct {
+	// From is the live role, nil if the role does not exist yet
+	From *Role
+	To   *Role
+}
+
+// BindingChange needed to bring the live roles of an account
+// to the state of a policy
+type BindingChange struct {
+	AccountID rbac.AccountID
+	From      rbac.AccountRoles
+	To        rbac.AccountRoles
+}
+
+// Plan contains all changes needed to apply a policy.
+//
+// Roles and accounts which are not part of the policy are left untouched.
+// The roles are changed after the roles they inherit from.
+type Plan struct {
+	Roles    []*RoleChange
+	Bindings []*BindingChange
+}
+
+// IsEmpty checks whether the live state already matches the policy
+func (p *Plan) IsEmpty() bool {
+	return len(p.Roles) == 0 && len(p.Bindings) == 0
+}
+
+// String returns a human readable description of the plan
+func (p *Plan) String() string {
+	if p.IsEmpty() {
+		return "no changes\n"
+	}
+
+	var b strings.Builder
+	for _, v := range p.Roles {
+		if v.From == nil {
+			fmt.Fprintf(&b, "+ role %s\n", v.To.ID)
+			writeRoleDiff(&b, &Role{}, v.To)
+			continue
+		}
+
+		fmt.Fprintf(&b, "~ role %s\n", v.To.ID)
+		writeRoleDiff(&b, v.From, v.To)
+	}
+
+	for _, v := range p.Bindings {
+		fmt.Fprintf(&b, "~ account %s\n", v.AccountID)
+		writeListDiff(&b, "roles", roleStrings(v.From), roleStrings(v.To))
+	}
+
+	return b.String()
+}
+
+func writeRoleDiff(b *strings.Builder, from, to *Role) {
+	if from.Title != to.Title {
+		fmt.Fprintf(b, "    title: %q -> %q\n", from.Title, to.Title)
+	}
+
+	if from.Description != to.Description {
+		fmt.Fprintf(b, "    description: %q -> %q\n", from.Description, to.Description)
+	}
+
+	writeListDiff(b, "rules", ruleStrings(from.Rules), ruleStrings(to.Rules))
+	writeListDiff(b, "deny_rules", ruleStrings(from.DenyRules), ruleStrings(to.DenyRules))
+	writeListDiff(b, "parents", roleStrings(from.Parents), roleStrings(to.Parents))
+}
+
+func writeListDiff(b *strings.Builder, name string, from, to []string) {
+	added, removed := diff(from, to)
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+
+	changes := make([]string, 0)
+	for _, v := range added {
+		changes = append(changes, "+"+v)
+	}
+	for _, v := range removed {
+		changes = append(changes, "-"+v)
+	}
+
+	fmt.Fprintf(b, "    %s: %s\n", name, strings.Join(changes, " "))
+}
+
+// diff returns the sorted values added to and removed from a list
+func diff(from, to []string) ([]string, []string) {
+	added := make([]string, 0)
+	for _, v := range to {
+		if !contains(from, v) && !contains(added, v) {
+			added = append(added, v)
+		}
+	}
+
+	removed := make([]string, 0)
+	for _, v := range from {
+		if !contains(to, v) && !contains(removed, v) {
+			removed = append(removed, v)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	return added, removed
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func equalSets(from, to []string) bool {
+	added, removed := diff(from, to)
+	return len(added) == 0 && len(removed) == 0
+}
+
+func ruleStrings(rules rbac.RoleRules) []string {
+	s := make([]string, 0)
+	for _, v := range rules {
+		s = append(s, string(v))
+	}
+
+	return s
+}
+
+func roleStrings(roles []rbac.RoleID) []string {
+	s := make([]string, 0)
+	for _, v := range roles {
+		s = append(s, string(v))
+	}
+
+	return s
+}
+
+func rolesEqual(a, b *Role) bool {
+	return a.Title == b.Title &&
+		a.Description == b.Description &&
+		equalSets(ruleStrings(a.Rules), ruleStrings(b.Rules)) &&
+		equalSets(ruleStrings(a.DenyRules), ruleStrings(b.DenyRules)) &&
+		equalSets(roleStrings(a.Parents), roleStrings(b.Parents))
+}
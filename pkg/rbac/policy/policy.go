@@ -0,0 +1,124 @@
+package policy
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/51st-state/api/pkg/rbac"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Policy describes roles and the roles of accounts declaratively
+type Policy struct {
+	Roles    []*Role    `json:"roles" yaml:"roles"`
+	Bindings []*Binding `json:"bindings" yaml:"bindings"`
+}
+
+// Role of a policy
+type Role struct {
+	ID          rbac.RoleID      `json:"id" yaml:"id"`
+	Title       string           `json:"title" yaml:"title"`
+	Description string           `json:"description" yaml:"description"`
+	Rules       rbac.RoleRules   `json:"rules" yaml:"rules"`
+	DenyRules   rbac.RoleRules   `json:"deny_rules" yaml:"deny_rules"`
+	Parents     rbac.RoleParents `json:"parents" yaml:"parents"`
+}
+
+// Binding of roles to an account, e.g. a system account
+type Binding struct {
+	AccountID rbac.AccountID    `json:"account_id" yaml:"account_id"`
+	Roles     rbac.AccountRoles `json:"roles" yaml:"roles"`
+}
+
+// Format of a policy file
+type Format string
+
+// available policy file formats
+const (
+	FormatYAML Format = "yaml"
+	FormatJSON Format = "json"
+)
+
+// FormatFromPath returns the format of a policy file by its extension.
+// Files without a .json extension are treated as yaml.
+func FormatFromPath(path string) Format {
+	if filepath.Ext(path) == ".json" {
+		return FormatJSON
+	}
+
+	return FormatYAML
+}
+
+var (
+	errEmptyRoleID      = errors.New("empty role id")
+	errEmptyAccountID   = errors.New("empty account id")
+	errDuplicateRole    = errors.New("duplicate role")
+	errDuplicateBinding = errors.New("duplicate binding")
+	errUnknownFormat    = errors.New("unknown policy format")
+)
+
+// Decode a policy in the given format
+func Decode(b []byte, f Format) (*Policy, error) {
+	p := &Policy{}
+
+	switch f {
+	case FormatJSON:
+		if err := json.Unmarshal(b, p); err != nil {
+			return nil, err
+		}
+	case FormatYAML:
+		if err := yaml.UnmarshalStrict(b, p); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errUnknownFormat
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Encode a policy in the given format
+func Encode(p *Policy, f Format) ([]byte, error) {
+	switch f {
+	case FormatJSON:
+		return json.MarshalIndent(p, "", "    ")
+	case FormatYAML:
+		return yaml.Marshal(p)
+	}
+
+	return nil, errUnknownFormat
+}
+
+// Validate checks whether every role and account is described once
+func (p *Policy) Validate() error {
+	roles := make(rbac.AccountRoles, 0)
+	for _, v := range p.Roles {
+		if v.ID == "" {
+			return errEmptyRoleID
+		}
+
+		if roles.Contains(v.ID) {
+			return errors.Wrapf(errDuplicateRole, "role %s", v.ID)
+		}
+		roles = append(roles, v.ID)
+	}
+
+	accounts := make(map[rbac.AccountID]bool)
+	for _, v := range p.Bindings {
+		if v.AccountID == "" {
+			return errEmptyAccountID
+		}
+
+		if accounts[v.AccountID] {
+			return errors.Wrapf(errDuplicateBinding, "account %s", v.AccountID)
+		}
+		accounts[v.AccountID] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/rbac/policy"
+)
+
+const testYAML = `
+roles:
+  - id: system/moderator
+    title: Moderator
+    description: Moderates the chat
+    rules:
+      - chat.*
+    deny_rules:
+      - chat.admin
+    parents:
+      - system/player
+bindings:
+  - account_id: serviceaccount/chatbot
+    roles:
+      - system/moderator
+`
+
+func TestFormatFromPath(t *testing.T) {
+	if policy.FormatFromPath("policy.json") != policy.FormatJSON {
+		t.Fatal("json files are decoded as json")
+	}
+
+	if policy.FormatFromPath("policy.yml") != policy.FormatYAML || policy.FormatFromPath("policy.yaml") != policy.FormatYAML {
+		t.Fatal("yaml files are decoded as yaml")
+	}
+}
+
+func TestDecode(t *testing.T) {
+	p, err := policy.Decode([]byte(testYAML), policy.FormatYAML)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(p.Roles) != 1 || p.Roles[0].ID != "system/moderator" || p.Roles[0].DenyRules[0] != "chat.admin" || p.Roles[0].Parents[0] != "system/player" {
+		t.Fatal("the role was not decoded")
+	}
+
+	if len(p.Bindings) != 1 || p.Bindings[0].AccountID != "serviceaccount/chatbot" {
+		t.Fatal("the binding was not decoded")
+	}
+
+	if _, err := policy.Decode([]byte("roles:\n  - id: a\n    unknown: b\n"), policy.FormatYAML); err == nil {
+		t.Fatal("unknown fields are not allowed")
+	}
+
+	if _, err := policy.Decode([]byte(`{"roles": [{"id": "a"}, {"id": "a"}]}`), policy.FormatJSON); err == nil {
+		t.Fatal("the role is described twice")
+	}
+
+	if _, err := policy.Decode([]byte(`{"bindings": [{"account_id": ""}]}`), policy.FormatJSON); err == nil {
+		t.Fatal("the account id is empty")
+	}
+
+	if _, err := policy.Decode([]byte(`{}`), policy.Format("xml")); err == nil {
+		t.Fatal("the format is unknown")
+	}
+}
+
+func TestEncode(t *testing.T) {
+	p, err := policy.Decode([]byte(testYAML), policy.FormatYAML)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	for _, f := range []policy.Format{policy.FormatYAML, policy.FormatJSON} {
+		b, err := policy.Encode(p, f)
+		if err != nil {
+			t.Fatal("there should be no error")
+		}
+
+		decoded, err := policy.Decode(b, f)
+		if err != nil {
+			t.Fatal("the encoded policy should be decodable")
+		}
+
+		if decoded.Roles[0].Title != "Moderator" || decoded.Bindings[0].Roles[0] != "system/moderator" {
+			t.Fatal("the decoded policy differs")
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/51st-state/api/pkg/pagination"
+)
+
+// PrivacyHandler exports and erases the roles and audit trail of user accounts
+type PrivacyHandler struct {
+	repository Repository
+}
+
+// NewPrivacyHandler for the data of the rbac service
+func NewPrivacyHandler(r Repository) *PrivacyHandler {
+	return &PrivacyHandler{r}
+}
+
+type privacyExport struct {
+	Roles        AccountRoles `json:"roles"`
+	AuditEntries []AuditEntry `json:"audit_entries"`
+}
+
+func userAccount(userUUID string) AccountID {
+	return AccountID(fmt.Sprintf("user/%s", userUUID))
+}
+
+// listAllAuditEntries pages through all audit entries matching a filter
+func (h *PrivacyHandler) listAllAuditEntries(ctx context.Context, filter AuditFilter) ([]AuditEntry, error) {
+	entries := make([]AuditEntry, 0)
+	page := pagination.New("", pagination.MaxLimit)
+	for {
+		list, err := h.repository.ListAuditEntries(ctx, filter, page)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, list...)
+
+		if len(list) == 0 {
+			return entries, nil
+		}
+
+		if page.Cursor = page.Next(len(list), list[len(list)-1].ID); page.Cursor == "" {
+			return entries, nil
+		}
+	}
+}
+
+// Export the roles of a user and the audit entries changing
+// the roles of the user or changed by the user
+func (h *PrivacyHandler) Export(ctx context.Context, userUUID string) (interface{}, error) {
+	account := userAccount(userUUID)
+
+	roles, err := h.repository.GetAccountRoles(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := h.listAllAuditEntries(ctx, AuditFilter{AccountID: account})
+	if err != nil {
+		return nil, err
+	}
+
+	acted, err := h.listAllAuditEntries(ctx, AuditFilter{Actor: account})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := changed
+	for _, v := range acted {
+		if v.AccountID != account {
+			entries = append(entries, v)
+		}
+	}
+
+	return &privacyExport{roles, entries}, nil
+}
+
+// Erase the roles of a user. The audit trail is kept,
+// but the user is replaced by a random erased account.
+func (h *PrivacyHandler) Erase(ctx context.Context, userUUID string) error {
+	account := userAccount(userUUID)
+
+	if err := h.repository.DeleteAccount(ctx, account); err != nil {
+		return err
+	}
+
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+
+	return h.repository.AnonymizeAuditEntries(ctx, account, AccountID(fmt.Sprintf("erased/%s", rand.String())))
+}
@@ -265,6 +265,92 @@ func (m *SetRoleRulesRequest) GetRoleRules() *RoleRules {
 	return nil
 }
 
+type RoleParents struct {
+	RoleIDs              []string `protobuf:"bytes,1,rep,name=RoleIDs,proto3" json:"RoleIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoleParents) Reset()         { *m = RoleParents{} }
+func (m *RoleParents) String() string { return proto.CompactTextString(m) }
+func (*RoleParents) ProtoMessage()    {}
+func (*RoleParents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{6}
+}
+
+func (m *RoleParents) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleParents.Unmarshal(m, b)
+}
+func (m *RoleParents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleParents.Marshal(b, m, deterministic)
+}
+func (m *RoleParents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleParents.Merge(m, src)
+}
+func (m *RoleParents) XXX_Size() int {
+	return xxx_messageInfo_RoleParents.Size(m)
+}
+func (m *RoleParents) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleParents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleParents proto.InternalMessageInfo
+
+func (m *RoleParents) GetRoleIDs() []string {
+	if m != nil {
+		return m.RoleIDs
+	}
+	return nil
+}
+
+type SetRoleParentsRequest struct {
+	RoleID               *RoleID      `protobuf:"bytes,1,opt,name=RoleID,proto3" json:"RoleID,omitempty"`
+	RoleParents          *RoleParents `protobuf:"bytes,2,opt,name=RoleParents,proto3" json:"RoleParents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SetRoleParentsRequest) Reset()         { *m = SetRoleParentsRequest{} }
+func (m *SetRoleParentsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRoleParentsRequest) ProtoMessage()    {}
+func (*SetRoleParentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{7}
+}
+
+func (m *SetRoleParentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRoleParentsRequest.Unmarshal(m, b)
+}
+func (m *SetRoleParentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRoleParentsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetRoleParentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRoleParentsRequest.Merge(m, src)
+}
+func (m *SetRoleParentsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetRoleParentsRequest.Size(m)
+}
+func (m *SetRoleParentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRoleParentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRoleParentsRequest proto.InternalMessageInfo
+
+func (m *SetRoleParentsRequest) GetRoleID() *RoleID {
+	if m != nil {
+		return m.RoleID
+	}
+	return nil
+}
+
+func (m *SetRoleParentsRequest) GetRoleParents() *RoleParents {
+	if m != nil {
+		return m.RoleParents
+	}
+	return nil
+}
+
 type SetAccountRolesRequest struct {
 	AccountID            *AccountID    `protobuf:"bytes,1,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
 	AccountRoles         *AccountRoles `protobuf:"bytes,2,opt,name=AccountRoles,proto3" json:"AccountRoles,omitempty"`
@@ -277,7 +363,7 @@ func (m *SetAccountRolesRequest) Reset()         { *m = SetAccountRolesRequest{}
 func (m *SetAccountRolesRequest) String() string { return proto.CompactTextString(m) }
 func (*SetAccountRolesRequest) ProtoMessage()    {}
 func (*SetAccountRolesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{6}
+	return fileDescriptor_0c5120591600887d, []int{8}
 }
 
 func (m *SetAccountRolesRequest) XXX_Unmarshal(b []byte) error {
@@ -324,7 +410,7 @@ func (m *IsAccountAllowedRequest) Reset()         { *m = IsAccountAllowedRequest
 func (m *IsAccountAllowedRequest) String() string { return proto.CompactTextString(m) }
 func (*IsAccountAllowedRequest) ProtoMessage()    {}
 func (*IsAccountAllowedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{7}
+	return fileDescriptor_0c5120591600887d, []int{9}
 }
 
 func (m *IsAccountAllowedRequest) XXX_Unmarshal(b []byte) error {
@@ -370,7 +456,7 @@ func (m *IsAccountAllowedResponse) Reset()         { *m = IsAccountAllowedRespon
 func (m *IsAccountAllowedResponse) String() string { return proto.CompactTextString(m) }
 func (*IsAccountAllowedResponse) ProtoMessage()    {}
 func (*IsAccountAllowedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c5120591600887d, []int{8}
+	return fileDescriptor_0c5120591600887d, []int{10}
 }
 
 func (m *IsAccountAllowedResponse) XXX_Unmarshal(b []byte) error {
@@ -398,226 +484,1736 @@ func (m *IsAccountAllowedResponse) GetAllowed() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*Rule)(nil), "rbac.Rule")
-	proto.RegisterType((*RoleID)(nil), "rbac.RoleID")
-	proto.RegisterType((*RoleRules)(nil), "rbac.RoleRules")
-	proto.RegisterType((*AccountID)(nil), "rbac.AccountID")
-	proto.RegisterType((*AccountRoles)(nil), "rbac.AccountRoles")
-	proto.RegisterType((*SetRoleRulesRequest)(nil), "rbac.SetRoleRulesRequest")
-	proto.RegisterType((*SetAccountRolesRequest)(nil), "rbac.SetAccountRolesRequest")
-	proto.RegisterType((*IsAccountAllowedRequest)(nil), "rbac.IsAccountAllowedRequest")
-	proto.RegisterType((*IsAccountAllowedResponse)(nil), "rbac.IsAccountAllowedResponse")
+type CheckManyRequest struct {
+	AccountID            *AccountID `protobuf:"bytes,1,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	Rules                []*Rule    `protobuf:"bytes,2,rep,name=Rules,proto3" json:"Rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func init() { proto.RegisterFile("control.proto", fileDescriptor_0c5120591600887d) }
-
-var fileDescriptor_0c5120591600887d = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4d, 0x4f, 0xea, 0x40,
-	0x14, 0x2d, 0x7d, 0x3c, 0x78, 0xbd, 0xaf, 0xef, 0x61, 0xae, 0x06, 0x6b, 0x51, 0x82, 0x13, 0x17,
-	0x6c, 0x28, 0x09, 0x1a, 0xe3, 0x96, 0x80, 0x21, 0xdd, 0x0e, 0xbf, 0x40, 0xea, 0x88, 0x31, 0x95,
-	0x41, 0x3a, 0x8d, 0x71, 0xe5, 0xef, 0xf4, 0xdf, 0x98, 0xce, 0x07, 0xb4, 0xb5, 0xb8, 0x70, 0xd5,
-	0xce, 0x9d, 0x33, 0xe7, 0x9c, 0x7b, 0xcf, 0x85, 0x7f, 0x11, 0x5f, 0x89, 0x0d, 0x8f, 0x83, 0xf5,
-	0x86, 0x0b, 0x8e, 0xf5, 0xcd, 0xe2, 0x2e, 0xf2, 0x3b, 0x4b, 0xce, 0x97, 0x31, 0x1b, 0xca, 0xda,
-	0x22, 0x7d, 0x18, 0xb2, 0xe7, 0xb5, 0x78, 0x53, 0x10, 0xe2, 0x43, 0x9d, 0xa6, 0x31, 0x43, 0x54,
-	0x5f, 0xaf, 0xd6, 0xab, 0xf5, 0x1d, 0x2a, 0xff, 0x89, 0x07, 0x0d, 0xca, 0x63, 0x16, 0x4e, 0xf1,
-	0x3f, 0xd8, 0xe1, 0x54, 0xdf, 0xd9, 0xe1, 0x94, 0x9c, 0x83, 0x93, 0xdd, 0x64, 0xa8, 0x04, 0x8f,
-	0xe0, 0xb7, 0xfc, 0xf1, 0x6a, 0xbd, 0x5f, 0x7d, 0x87, 0xaa, 0x03, 0xe9, 0x80, 0x33, 0x8e, 0x22,
-	0x9e, 0xae, 0x44, 0xc5, 0xfb, 0x3e, 0xb8, 0xfa, 0x32, 0xa3, 0x49, 0xd0, 0x83, 0xa6, 0x52, 0x32,
-	0x24, 0xe6, 0x48, 0x9e, 0xe0, 0x70, 0xce, 0xc4, 0x56, 0x8c, 0xb2, 0x97, 0x94, 0x25, 0x02, 0x2f,
-	0x8c, 0x35, 0x49, 0xfa, 0x77, 0xe4, 0x06, 0x59, 0xab, 0x81, 0xaa, 0x51, 0x63, 0x7b, 0x90, 0xb3,
-	0xe9, 0xd9, 0x12, 0xd8, 0xda, 0x01, 0x15, 0xe1, 0x0e, 0x41, 0xde, 0xa1, 0x3d, 0x67, 0x22, 0x6f,
-	0xcc, 0xc8, 0x0d, 0x72, 0xcd, 0x68, 0x45, 0x4d, 0xb4, 0x2d, 0xd3, 0x5c, 0xbb, 0xd7, 0xc5, 0xf6,
-	0xb4, 0x34, 0x16, 0x5e, 0x28, 0xfe, 0x02, 0x8e, 0x3c, 0xc2, 0x71, 0x98, 0xe8, 0xca, 0x38, 0x8e,
-	0xf9, 0x2b, 0xbb, 0xff, 0xa1, 0x83, 0xae, 0x8e, 0x53, 0x29, 0x83, 0x6e, 0x3a, 0x8d, 0x99, 0x8e,
-	0xf6, 0x0a, 0xbc, 0xaf, 0x4a, 0xc9, 0x9a, 0xaf, 0x12, 0x96, 0x85, 0xa1, 0x4b, 0x52, 0xe8, 0x0f,
-	0x35, 0xc7, 0xd1, 0x87, 0x0d, 0xcd, 0x89, 0xda, 0x30, 0x1c, 0x82, 0x3b, 0xcb, 0x05, 0x83, 0x85,
-	0x04, 0xfc, 0xf2, 0x98, 0x89, 0x85, 0x13, 0x70, 0xf3, 0x49, 0xe2, 0x89, 0x82, 0x54, 0xa4, 0xeb,
-	0xb7, 0x03, 0xb5, 0xb2, 0x81, 0x59, 0xd9, 0xe0, 0x36, 0x5b, 0x59, 0x62, 0xe1, 0x0d, 0xb4, 0x66,
-	0xc5, 0x88, 0xb0, 0x3c, 0x06, 0xbf, 0x62, 0xce, 0xc4, 0xc2, 0x10, 0x5a, 0xa5, 0x70, 0xf1, 0x74,
-	0xeb, 0xa0, 0x22, 0xf3, 0x6f, 0x4c, 0xcc, 0xe1, 0xa0, 0x3c, 0x3c, 0x3c, 0x53, 0x5c, 0x7b, 0xe2,
-	0xf3, 0xbb, 0xfb, 0xae, 0xd5, 0xcc, 0x89, 0xb5, 0x68, 0x48, 0x99, 0xcb, 0xcf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x31, 0x65, 0x58, 0x39, 0xc3, 0x03, 0x00, 0x00,
+func (m *CheckManyRequest) Reset()         { *m = CheckManyRequest{} }
+func (m *CheckManyRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckManyRequest) ProtoMessage()    {}
+func (*CheckManyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{11}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *CheckManyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckManyRequest.Unmarshal(m, b)
+}
+func (m *CheckManyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckManyRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckManyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckManyRequest.Merge(m, src)
+}
+func (m *CheckManyRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckManyRequest.Size(m)
+}
+func (m *CheckManyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckManyRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_CheckManyRequest proto.InternalMessageInfo
 
-// ControlClient is the client API for Control service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ControlClient interface {
-	GetRoleRules(ctx context.Context, in *RoleID, opts ...grpc.CallOption) (*RoleRules, error)
-	SetRoleRules(ctx context.Context, in *SetRoleRulesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	GetAccountRoles(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*AccountRoles, error)
-	SetAccountRoles(ctx context.Context, in *SetAccountRolesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	IsAccountAllowed(ctx context.Context, in *IsAccountAllowedRequest, opts ...grpc.CallOption) (*IsAccountAllowedResponse, error)
+func (m *CheckManyRequest) GetAccountID() *AccountID {
+	if m != nil {
+		return m.AccountID
+	}
+	return nil
 }
 
-type controlClient struct {
-	cc *grpc.ClientConn
+func (m *CheckManyRequest) GetRules() []*Rule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
 }
 
-func NewControlClient(cc *grpc.ClientConn) ControlClient {
-	return &controlClient{cc}
+type RuleCheck struct {
+	Rule                 *Rule    `protobuf:"bytes,1,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	Allowed              bool     `protobuf:"varint,2,opt,name=Allowed,proto3" json:"Allowed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *controlClient) GetRoleRules(ctx context.Context, in *RoleID, opts ...grpc.CallOption) (*RoleRules, error) {
-	out := new(RoleRules)
-	err := c.cc.Invoke(ctx, "/rbac.Control/GetRoleRules", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RuleCheck) Reset()         { *m = RuleCheck{} }
+func (m *RuleCheck) String() string { return proto.CompactTextString(m) }
+func (*RuleCheck) ProtoMessage()    {}
+func (*RuleCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{12}
 }
 
-func (c *controlClient) SetRoleRules(ctx context.Context, in *SetRoleRulesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/rbac.Control/SetRoleRules", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RuleCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuleCheck.Unmarshal(m, b)
+}
+func (m *RuleCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuleCheck.Marshal(b, m, deterministic)
+}
+func (m *RuleCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleCheck.Merge(m, src)
+}
+func (m *RuleCheck) XXX_Size() int {
+	return xxx_messageInfo_RuleCheck.Size(m)
+}
+func (m *RuleCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleCheck.DiscardUnknown(m)
 }
 
-func (c *controlClient) GetAccountRoles(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*AccountRoles, error) {
-	out := new(AccountRoles)
-	err := c.cc.Invoke(ctx, "/rbac.Control/GetAccountRoles", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RuleCheck proto.InternalMessageInfo
+
+func (m *RuleCheck) GetRule() *Rule {
+	if m != nil {
+		return m.Rule
 	}
-	return out, nil
+	return nil
 }
 
-func (c *controlClient) SetAccountRoles(ctx context.Context, in *SetAccountRolesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
-	out := new(empty.Empty)
-	err := c.cc.Invoke(ctx, "/rbac.Control/SetAccountRoles", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RuleCheck) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
 	}
-	return out, nil
+	return false
 }
 
-func (c *controlClient) IsAccountAllowed(ctx context.Context, in *IsAccountAllowedRequest, opts ...grpc.CallOption) (*IsAccountAllowedResponse, error) {
-	out := new(IsAccountAllowedResponse)
-	err := c.cc.Invoke(ctx, "/rbac.Control/IsAccountAllowed", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CheckManyResponse struct {
+	RuleChecks           []*RuleCheck `protobuf:"bytes,1,rep,name=RuleChecks,proto3" json:"RuleChecks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-// ControlServer is the server API for Control service.
-type ControlServer interface {
-	GetRoleRules(context.Context, *RoleID) (*RoleRules, error)
-	SetRoleRules(context.Context, *SetRoleRulesRequest) (*empty.Empty, error)
-	GetAccountRoles(context.Context, *AccountID) (*AccountRoles, error)
-	SetAccountRoles(context.Context, *SetAccountRolesRequest) (*empty.Empty, error)
-	IsAccountAllowed(context.Context, *IsAccountAllowedRequest) (*IsAccountAllowedResponse, error)
+func (m *CheckManyResponse) Reset()         { *m = CheckManyResponse{} }
+func (m *CheckManyResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckManyResponse) ProtoMessage()    {}
+func (*CheckManyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{13}
 }
 
-func RegisterControlServer(s *grpc.Server, srv ControlServer) {
-	s.RegisterService(&_Control_serviceDesc, srv)
+func (m *CheckManyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckManyResponse.Unmarshal(m, b)
+}
+func (m *CheckManyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckManyResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckManyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckManyResponse.Merge(m, src)
+}
+func (m *CheckManyResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckManyResponse.Size(m)
+}
+func (m *CheckManyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckManyResponse.DiscardUnknown(m)
 }
 
-func _Control_GetRoleRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RoleID)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).GetRoleRules(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rbac.Control/GetRoleRules",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).GetRoleRules(ctx, req.(*RoleID))
+var xxx_messageInfo_CheckManyResponse proto.InternalMessageInfo
+
+func (m *CheckManyResponse) GetRuleChecks() []*RuleCheck {
+	if m != nil {
+		return m.RuleChecks
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Control_SetRoleRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetRoleRulesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).SetRoleRules(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rbac.Control/SetRoleRules",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).SetRoleRules(ctx, req.(*SetRoleRulesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type Binding struct {
+	RoleID               *RoleID  `protobuf:"bytes,1,opt,name=RoleID,proto3" json:"RoleID,omitempty"`
+	Rule                 *Rule    `protobuf:"bytes,2,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _Control_GetAccountRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountID)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).GetAccountRoles(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rbac.Control/GetAccountRoles",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).GetAccountRoles(ctx, req.(*AccountID))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *Binding) Reset()         { *m = Binding{} }
+func (m *Binding) String() string { return proto.CompactTextString(m) }
+func (*Binding) ProtoMessage()    {}
+func (*Binding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{14}
 }
 
-func _Control_SetAccountRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetAccountRolesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).SetAccountRoles(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rbac.Control/SetAccountRoles",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).SetAccountRoles(ctx, req.(*SetAccountRolesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *Binding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Binding.Unmarshal(m, b)
+}
+func (m *Binding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Binding.Marshal(b, m, deterministic)
+}
+func (m *Binding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Binding.Merge(m, src)
+}
+func (m *Binding) XXX_Size() int {
+	return xxx_messageInfo_Binding.Size(m)
+}
+func (m *Binding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Binding.DiscardUnknown(m)
 }
 
-func _Control_IsAccountAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsAccountAllowedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ControlServer).IsAccountAllowed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rbac.Control/IsAccountAllowed",
+var xxx_messageInfo_Binding proto.InternalMessageInfo
+
+func (m *Binding) GetRoleID() *RoleID {
+	if m != nil {
+		return m.RoleID
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlServer).IsAccountAllowed(ctx, req.(*IsAccountAllowedRequest))
+	return nil
+}
+
+func (m *Binding) GetRule() *Rule {
+	if m != nil {
+		return m.Rule
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Control_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "rbac.Control",
+type Bindings struct {
+	Bindings             []*Binding `protobuf:"bytes,1,rep,name=Bindings,proto3" json:"Bindings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *Bindings) Reset()         { *m = Bindings{} }
+func (m *Bindings) String() string { return proto.CompactTextString(m) }
+func (*Bindings) ProtoMessage()    {}
+func (*Bindings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{15}
+}
+
+func (m *Bindings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Bindings.Unmarshal(m, b)
+}
+func (m *Bindings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Bindings.Marshal(b, m, deterministic)
+}
+func (m *Bindings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bindings.Merge(m, src)
+}
+func (m *Bindings) XXX_Size() int {
+	return xxx_messageInfo_Bindings.Size(m)
+}
+func (m *Bindings) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bindings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bindings proto.InternalMessageInfo
+
+func (m *Bindings) GetBindings() []*Binding {
+	if m != nil {
+		return m.Bindings
+	}
+	return nil
+}
+
+type ExplainRequest struct {
+	AccountID            *AccountID `protobuf:"bytes,1,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	Rule                 *Rule      `protobuf:"bytes,2,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ExplainRequest) Reset()         { *m = ExplainRequest{} }
+func (m *ExplainRequest) String() string { return proto.CompactTextString(m) }
+func (*ExplainRequest) ProtoMessage()    {}
+func (*ExplainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{16}
+}
+
+func (m *ExplainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainRequest.Unmarshal(m, b)
+}
+func (m *ExplainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainRequest.Marshal(b, m, deterministic)
+}
+func (m *ExplainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainRequest.Merge(m, src)
+}
+func (m *ExplainRequest) XXX_Size() int {
+	return xxx_messageInfo_ExplainRequest.Size(m)
+}
+func (m *ExplainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainRequest proto.InternalMessageInfo
+
+func (m *ExplainRequest) GetAccountID() *AccountID {
+	if m != nil {
+		return m.AccountID
+	}
+	return nil
+}
+
+func (m *ExplainRequest) GetRule() *Rule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+type Explanation struct {
+	AccountID            *AccountID `protobuf:"bytes,1,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	Rule                 *Rule      `protobuf:"bytes,2,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	Allowed              bool       `protobuf:"varint,3,opt,name=Allowed,proto3" json:"Allowed,omitempty"`
+	Granted              *Bindings  `protobuf:"bytes,4,opt,name=Granted,proto3" json:"Granted,omitempty"`
+	Grantable            *Bindings  `protobuf:"bytes,5,opt,name=Grantable,proto3" json:"Grantable,omitempty"`
+	Denied               *Bindings  `protobuf:"bytes,6,opt,name=Denied,proto3" json:"Denied,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *Explanation) Reset()         { *m = Explanation{} }
+func (m *Explanation) String() string { return proto.CompactTextString(m) }
+func (*Explanation) ProtoMessage()    {}
+func (*Explanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{17}
+}
+
+func (m *Explanation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Explanation.Unmarshal(m, b)
+}
+func (m *Explanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Explanation.Marshal(b, m, deterministic)
+}
+func (m *Explanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Explanation.Merge(m, src)
+}
+func (m *Explanation) XXX_Size() int {
+	return xxx_messageInfo_Explanation.Size(m)
+}
+func (m *Explanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Explanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Explanation proto.InternalMessageInfo
+
+func (m *Explanation) GetAccountID() *AccountID {
+	if m != nil {
+		return m.AccountID
+	}
+	return nil
+}
+
+func (m *Explanation) GetRule() *Rule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+func (m *Explanation) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *Explanation) GetGranted() *Bindings {
+	if m != nil {
+		return m.Granted
+	}
+	return nil
+}
+
+func (m *Explanation) GetGrantable() *Bindings {
+	if m != nil {
+		return m.Grantable
+	}
+	return nil
+}
+
+func (m *Explanation) GetDenied() *Bindings {
+	if m != nil {
+		return m.Denied
+	}
+	return nil
+}
+
+type RuleInfo struct {
+	Rule                 *Rule    `protobuf:"bytes,1,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	Service              string   `protobuf:"bytes,3,opt,name=Service,proto3" json:"Service,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RuleInfo) Reset()         { *m = RuleInfo{} }
+func (m *RuleInfo) String() string { return proto.CompactTextString(m) }
+func (*RuleInfo) ProtoMessage()    {}
+func (*RuleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{18}
+}
+
+func (m *RuleInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuleInfo.Unmarshal(m, b)
+}
+func (m *RuleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuleInfo.Marshal(b, m, deterministic)
+}
+func (m *RuleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleInfo.Merge(m, src)
+}
+func (m *RuleInfo) XXX_Size() int {
+	return xxx_messageInfo_RuleInfo.Size(m)
+}
+func (m *RuleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuleInfo proto.InternalMessageInfo
+
+func (m *RuleInfo) GetRule() *Rule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+func (m *RuleInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RuleInfo) GetService() string {
+	if m != nil {
+		return m.Service
+	}
+	return ""
+}
+
+type RuleCatalog struct {
+	Rules                []*RuleInfo `protobuf:"bytes,1,rep,name=Rules,proto3" json:"Rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RuleCatalog) Reset()         { *m = RuleCatalog{} }
+func (m *RuleCatalog) String() string { return proto.CompactTextString(m) }
+func (*RuleCatalog) ProtoMessage()    {}
+func (*RuleCatalog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{19}
+}
+
+func (m *RuleCatalog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RuleCatalog.Unmarshal(m, b)
+}
+func (m *RuleCatalog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RuleCatalog.Marshal(b, m, deterministic)
+}
+func (m *RuleCatalog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleCatalog.Merge(m, src)
+}
+func (m *RuleCatalog) XXX_Size() int {
+	return xxx_messageInfo_RuleCatalog.Size(m)
+}
+func (m *RuleCatalog) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleCatalog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuleCatalog proto.InternalMessageInfo
+
+func (m *RuleCatalog) GetRules() []*RuleInfo {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type Page struct {
+	Cursor               string   `protobuf:"bytes,1,opt,name=Cursor,proto3" json:"Cursor,omitempty"`
+	Limit                uint64   `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Page) Reset()         { *m = Page{} }
+func (m *Page) String() string { return proto.CompactTextString(m) }
+func (*Page) ProtoMessage()    {}
+func (*Page) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{20}
+}
+
+func (m *Page) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Page.Unmarshal(m, b)
+}
+func (m *Page) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Page.Marshal(b, m, deterministic)
+}
+func (m *Page) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Page.Merge(m, src)
+}
+func (m *Page) XXX_Size() int {
+	return xxx_messageInfo_Page.Size(m)
+}
+func (m *Page) XXX_DiscardUnknown() {
+	xxx_messageInfo_Page.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Page proto.InternalMessageInfo
+
+func (m *Page) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *Page) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListRoleAccountsRequest struct {
+	RoleID               *RoleID  `protobuf:"bytes,1,opt,name=RoleID,proto3" json:"RoleID,omitempty"`
+	Page                 *Page    `protobuf:"bytes,2,opt,name=Page,proto3" json:"Page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRoleAccountsRequest) Reset()         { *m = ListRoleAccountsRequest{} }
+func (m *ListRoleAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRoleAccountsRequest) ProtoMessage()    {}
+func (*ListRoleAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{21}
+}
+
+func (m *ListRoleAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRoleAccountsRequest.Unmarshal(m, b)
+}
+func (m *ListRoleAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRoleAccountsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRoleAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRoleAccountsRequest.Merge(m, src)
+}
+func (m *ListRoleAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRoleAccountsRequest.Size(m)
+}
+func (m *ListRoleAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRoleAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRoleAccountsRequest proto.InternalMessageInfo
+
+func (m *ListRoleAccountsRequest) GetRoleID() *RoleID {
+	if m != nil {
+		return m.RoleID
+	}
+	return nil
+}
+
+func (m *ListRoleAccountsRequest) GetPage() *Page {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type ListRuleRolesRequest struct {
+	Rule                 *Rule    `protobuf:"bytes,1,opt,name=Rule,proto3" json:"Rule,omitempty"`
+	Page                 *Page    `protobuf:"bytes,2,opt,name=Page,proto3" json:"Page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRuleRolesRequest) Reset()         { *m = ListRuleRolesRequest{} }
+func (m *ListRuleRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRuleRolesRequest) ProtoMessage()    {}
+func (*ListRuleRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{22}
+}
+
+func (m *ListRuleRolesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRuleRolesRequest.Unmarshal(m, b)
+}
+func (m *ListRuleRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRuleRolesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRuleRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRuleRolesRequest.Merge(m, src)
+}
+func (m *ListRuleRolesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRuleRolesRequest.Size(m)
+}
+func (m *ListRuleRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRuleRolesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRuleRolesRequest proto.InternalMessageInfo
+
+func (m *ListRuleRolesRequest) GetRule() *Rule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+func (m *ListRuleRolesRequest) GetPage() *Page {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type RoleIDs struct {
+	IDs                  []string `protobuf:"bytes,1,rep,name=IDs,proto3" json:"IDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoleIDs) Reset()         { *m = RoleIDs{} }
+func (m *RoleIDs) String() string { return proto.CompactTextString(m) }
+func (*RoleIDs) ProtoMessage()    {}
+func (*RoleIDs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{23}
+}
+
+func (m *RoleIDs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoleIDs.Unmarshal(m, b)
+}
+func (m *RoleIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoleIDs.Marshal(b, m, deterministic)
+}
+func (m *RoleIDs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleIDs.Merge(m, src)
+}
+func (m *RoleIDs) XXX_Size() int {
+	return xxx_messageInfo_RoleIDs.Size(m)
+}
+func (m *RoleIDs) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleIDs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleIDs proto.InternalMessageInfo
+
+func (m *RoleIDs) GetIDs() []string {
+	if m != nil {
+		return m.IDs
+	}
+	return nil
+}
+
+type AccountIDs struct {
+	IDs                  []string `protobuf:"bytes,1,rep,name=IDs,proto3" json:"IDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountIDs) Reset()         { *m = AccountIDs{} }
+func (m *AccountIDs) String() string { return proto.CompactTextString(m) }
+func (*AccountIDs) ProtoMessage()    {}
+func (*AccountIDs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{24}
+}
+
+func (m *AccountIDs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountIDs.Unmarshal(m, b)
+}
+func (m *AccountIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountIDs.Marshal(b, m, deterministic)
+}
+func (m *AccountIDs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountIDs.Merge(m, src)
+}
+func (m *AccountIDs) XXX_Size() int {
+	return xxx_messageInfo_AccountIDs.Size(m)
+}
+func (m *AccountIDs) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountIDs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountIDs proto.InternalMessageInfo
+
+func (m *AccountIDs) GetIDs() []string {
+	if m != nil {
+		return m.IDs
+	}
+	return nil
+}
+
+type Rules struct {
+	Rules                []string `protobuf:"bytes,1,rep,name=Rules,proto3" json:"Rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Rules) Reset()         { *m = Rules{} }
+func (m *Rules) String() string { return proto.CompactTextString(m) }
+func (*Rules) ProtoMessage()    {}
+func (*Rules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{25}
+}
+
+func (m *Rules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rules.Unmarshal(m, b)
+}
+func (m *Rules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rules.Marshal(b, m, deterministic)
+}
+func (m *Rules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rules.Merge(m, src)
+}
+func (m *Rules) XXX_Size() int {
+	return xxx_messageInfo_Rules.Size(m)
+}
+func (m *Rules) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rules proto.InternalMessageInfo
+
+func (m *Rules) GetRules() []string {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type AuditFilter struct {
+	AccountID            string   `protobuf:"bytes,1,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	RoleID               string   `protobuf:"bytes,2,opt,name=RoleID,proto3" json:"RoleID,omitempty"`
+	Actor                string   `protobuf:"bytes,3,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditFilter) Reset()         { *m = AuditFilter{} }
+func (m *AuditFilter) String() string { return proto.CompactTextString(m) }
+func (*AuditFilter) ProtoMessage()    {}
+func (*AuditFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{26}
+}
+
+func (m *AuditFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditFilter.Unmarshal(m, b)
+}
+func (m *AuditFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditFilter.Marshal(b, m, deterministic)
+}
+func (m *AuditFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditFilter.Merge(m, src)
+}
+func (m *AuditFilter) XXX_Size() int {
+	return xxx_messageInfo_AuditFilter.Size(m)
+}
+func (m *AuditFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditFilter proto.InternalMessageInfo
+
+func (m *AuditFilter) GetAccountID() string {
+	if m != nil {
+		return m.AccountID
+	}
+	return ""
+}
+
+func (m *AuditFilter) GetRoleID() string {
+	if m != nil {
+		return m.RoleID
+	}
+	return ""
+}
+
+func (m *AuditFilter) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+type ListAuditEntriesRequest struct {
+	Filter               *AuditFilter `protobuf:"bytes,1,opt,name=Filter,proto3" json:"Filter,omitempty"`
+	Page                 *Page        `protobuf:"bytes,2,opt,name=Page,proto3" json:"Page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListAuditEntriesRequest) Reset()         { *m = ListAuditEntriesRequest{} }
+func (m *ListAuditEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEntriesRequest) ProtoMessage()    {}
+func (*ListAuditEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{27}
+}
+
+func (m *ListAuditEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEntriesRequest.Unmarshal(m, b)
+}
+func (m *ListAuditEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEntriesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEntriesRequest.Merge(m, src)
+}
+func (m *ListAuditEntriesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEntriesRequest.Size(m)
+}
+func (m *ListAuditEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEntriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEntriesRequest proto.InternalMessageInfo
+
+func (m *ListAuditEntriesRequest) GetFilter() *AuditFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *ListAuditEntriesRequest) GetPage() *Page {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type AuditEntry struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Actor                string   `protobuf:"bytes,2,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	Binding              string   `protobuf:"bytes,3,opt,name=Binding,proto3" json:"Binding,omitempty"`
+	Action               string   `protobuf:"bytes,4,opt,name=Action,proto3" json:"Action,omitempty"`
+	RoleID               string   `protobuf:"bytes,5,opt,name=RoleID,proto3" json:"RoleID,omitempty"`
+	AccountID            string   `protobuf:"bytes,6,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	Value                string   `protobuf:"bytes,7,opt,name=Value,proto3" json:"Value,omitempty"`
+	Old                  []string `protobuf:"bytes,8,rep,name=Old,proto3" json:"Old,omitempty"`
+	New                  []string `protobuf:"bytes,9,rep,name=New,proto3" json:"New,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,10,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEntry) Reset()         { *m = AuditEntry{} }
+func (m *AuditEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditEntry) ProtoMessage()    {}
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{28}
+}
+
+func (m *AuditEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEntry.Unmarshal(m, b)
+}
+func (m *AuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEntry.Marshal(b, m, deterministic)
+}
+func (m *AuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntry.Merge(m, src)
+}
+func (m *AuditEntry) XXX_Size() int {
+	return xxx_messageInfo_AuditEntry.Size(m)
+}
+func (m *AuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEntry proto.InternalMessageInfo
+
+func (m *AuditEntry) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetBinding() string {
+	if m != nil {
+		return m.Binding
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetRoleID() string {
+	if m != nil {
+		return m.RoleID
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetAccountID() string {
+	if m != nil {
+		return m.AccountID
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetOld() []string {
+	if m != nil {
+		return m.Old
+	}
+	return nil
+}
+
+func (m *AuditEntry) GetNew() []string {
+	if m != nil {
+		return m.New
+	}
+	return nil
+}
+
+func (m *AuditEntry) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type AuditEntries struct {
+	Entries              []*AuditEntry `protobuf:"bytes,1,rep,name=Entries,proto3" json:"Entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AuditEntries) Reset()         { *m = AuditEntries{} }
+func (m *AuditEntries) String() string { return proto.CompactTextString(m) }
+func (*AuditEntries) ProtoMessage()    {}
+func (*AuditEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c5120591600887d, []int{29}
+}
+
+func (m *AuditEntries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEntries.Unmarshal(m, b)
+}
+func (m *AuditEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEntries.Marshal(b, m, deterministic)
+}
+func (m *AuditEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntries.Merge(m, src)
+}
+func (m *AuditEntries) XXX_Size() int {
+	return xxx_messageInfo_AuditEntries.Size(m)
+}
+func (m *AuditEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEntries proto.InternalMessageInfo
+
+func (m *AuditEntries) GetEntries() []*AuditEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Rule)(nil), "rbac.Rule")
+	proto.RegisterType((*RoleID)(nil), "rbac.RoleID")
+	proto.RegisterType((*RoleRules)(nil), "rbac.RoleRules")
+	proto.RegisterType((*AccountID)(nil), "rbac.AccountID")
+	proto.RegisterType((*AccountRoles)(nil), "rbac.AccountRoles")
+	proto.RegisterType((*SetRoleRulesRequest)(nil), "rbac.SetRoleRulesRequest")
+	proto.RegisterType((*RoleParents)(nil), "rbac.RoleParents")
+	proto.RegisterType((*SetRoleParentsRequest)(nil), "rbac.SetRoleParentsRequest")
+	proto.RegisterType((*SetAccountRolesRequest)(nil), "rbac.SetAccountRolesRequest")
+	proto.RegisterType((*IsAccountAllowedRequest)(nil), "rbac.IsAccountAllowedRequest")
+	proto.RegisterType((*IsAccountAllowedResponse)(nil), "rbac.IsAccountAllowedResponse")
+	proto.RegisterType((*CheckManyRequest)(nil), "rbac.CheckManyRequest")
+	proto.RegisterType((*RuleCheck)(nil), "rbac.RuleCheck")
+	proto.RegisterType((*CheckManyResponse)(nil), "rbac.CheckManyResponse")
+	proto.RegisterType((*Binding)(nil), "rbac.Binding")
+	proto.RegisterType((*Bindings)(nil), "rbac.Bindings")
+	proto.RegisterType((*ExplainRequest)(nil), "rbac.ExplainRequest")
+	proto.RegisterType((*Explanation)(nil), "rbac.Explanation")
+	proto.RegisterType((*RuleInfo)(nil), "rbac.RuleInfo")
+	proto.RegisterType((*RuleCatalog)(nil), "rbac.RuleCatalog")
+	proto.RegisterType((*Page)(nil), "rbac.Page")
+	proto.RegisterType((*ListRoleAccountsRequest)(nil), "rbac.ListRoleAccountsRequest")
+	proto.RegisterType((*ListRuleRolesRequest)(nil), "rbac.ListRuleRolesRequest")
+	proto.RegisterType((*RoleIDs)(nil), "rbac.RoleIDs")
+	proto.RegisterType((*AccountIDs)(nil), "rbac.AccountIDs")
+	proto.RegisterType((*Rules)(nil), "rbac.Rules")
+	proto.RegisterType((*AuditFilter)(nil), "rbac.AuditFilter")
+	proto.RegisterType((*ListAuditEntriesRequest)(nil), "rbac.ListAuditEntriesRequest")
+	proto.RegisterType((*AuditEntry)(nil), "rbac.AuditEntry")
+	proto.RegisterType((*AuditEntries)(nil), "rbac.AuditEntries")
+}
+
+func init() { proto.RegisterFile("control.proto", fileDescriptor_0c5120591600887d) }
+
+var fileDescriptor_0c5120591600887d = []byte{
+	// 1142 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x5b, 0x6f, 0xe2, 0x46,
+	0x14, 0xe6, 0x16, 0x88, 0x0f, 0x84, 0x90, 0x69, 0x4a, 0x5c, 0x67, 0x37, 0xa2, 0xa3, 0x6a, 0xcb,
+	0x56, 0x5d, 0x22, 0x6d, 0xd2, 0x8b, 0x7a, 0x93, 0x28, 0x50, 0x84, 0x94, 0x6e, 0x56, 0x83, 0xb4,
+	0x52, 0x9f, 0x56, 0x8e, 0x3d, 0x61, 0xdd, 0x3a, 0x36, 0xb5, 0xcd, 0xa6, 0x79, 0xea, 0x7f, 0xee,
+	0x43, 0xfb, 0x5a, 0xcd, 0xc5, 0xf6, 0xd8, 0x40, 0x48, 0xa2, 0xec, 0x93, 0x67, 0xce, 0x9c, 0x39,
+	0xe7, 0x3b, 0x17, 0xcf, 0xf9, 0x60, 0xc7, 0xf2, 0xbd, 0x28, 0xf0, 0xdd, 0xde, 0x3c, 0xf0, 0x23,
+	0x1f, 0x55, 0x82, 0x0b, 0xd3, 0x32, 0x0e, 0x67, 0xbe, 0x3f, 0x73, 0xe9, 0x31, 0x97, 0x5d, 0x2c,
+	0x2e, 0x8f, 0xe9, 0xd5, 0x3c, 0xba, 0x11, 0x2a, 0xd8, 0x80, 0x0a, 0x59, 0xb8, 0x14, 0x21, 0xf1,
+	0xd5, 0x8b, 0x9d, 0x62, 0x57, 0x23, 0x7c, 0x8d, 0x75, 0xa8, 0x12, 0xdf, 0xa5, 0x93, 0x21, 0x6a,
+	0x42, 0x69, 0x32, 0x94, 0x67, 0xa5, 0xc9, 0x10, 0x7f, 0x0a, 0x1a, 0x3b, 0x61, 0x5a, 0x21, 0xda,
+	0x87, 0x2d, 0xbe, 0xd0, 0x8b, 0x9d, 0x72, 0x57, 0x23, 0x62, 0x83, 0x0f, 0x41, 0xeb, 0x5b, 0x96,
+	0xbf, 0xf0, 0xa2, 0x15, 0xf7, 0xbb, 0xd0, 0x90, 0x87, 0xcc, 0x4c, 0x88, 0x74, 0xa8, 0x09, 0x4f,
+	0xb1, 0x91, 0x78, 0x8b, 0x7f, 0x87, 0x8f, 0xa6, 0x34, 0x4a, 0x9c, 0x11, 0xfa, 0xe7, 0x82, 0x86,
+	0x11, 0xfa, 0x2c, 0x86, 0xc6, 0x8d, 0xd6, 0x5f, 0x36, 0x7a, 0x2c, 0xd4, 0x9e, 0x90, 0x91, 0x18,
+	0xf6, 0x0b, 0x05, 0xa6, 0x5e, 0xe2, 0x8a, 0xbb, 0xa9, 0xa2, 0x30, 0x98, 0x6a, 0xe0, 0xcf, 0xa1,
+	0xce, 0x36, 0xaf, 0xcd, 0x80, 0x7a, 0xd1, 0x6d, 0xa0, 0x02, 0xf8, 0x58, 0x82, 0x92, 0xba, 0xf7,
+	0x83, 0x75, 0x92, 0xf1, 0x23, 0x81, 0xed, 0xa5, 0xaa, 0xb1, 0x51, 0x55, 0x0b, 0xff, 0x0d, 0xed,
+	0x29, 0x8d, 0xd4, 0xac, 0xc5, 0x4e, 0x5f, 0x28, 0x99, 0xd6, 0x8b, 0x6a, 0x94, 0x89, 0x98, 0x28,
+	0xb5, 0xf8, 0x3a, 0x9b, 0x7b, 0xe9, 0x1e, 0x65, 0x6e, 0x08, 0xfb, 0x19, 0x3d, 0xfc, 0x0e, 0x0e,
+	0x26, 0xa1, 0x94, 0xf4, 0x5d, 0xd7, 0xbf, 0xa6, 0xf6, 0x03, 0x11, 0x1c, 0xc9, 0x5e, 0x13, 0x9e,
+	0x41, 0x06, 0xbe, 0x70, 0xa9, 0xec, 0xbb, 0x53, 0xd0, 0x97, 0x3d, 0x85, 0x73, 0xdf, 0x0b, 0x29,
+	0x2b, 0x8a, 0x14, 0x71, 0x47, 0xdb, 0x24, 0xde, 0x62, 0x0b, 0x5a, 0x83, 0x77, 0xd4, 0xfa, 0xe3,
+	0x57, 0xd3, 0xbb, 0x79, 0x20, 0xb0, 0x4e, 0xdc, 0xc9, 0xa5, 0x4e, 0x39, 0x87, 0x4c, 0x76, 0xf5,
+	0x08, 0x34, 0xb6, 0xe0, 0x8e, 0x92, 0x38, 0x8a, 0xab, 0xe3, 0x50, 0xb1, 0x96, 0xb2, 0x58, 0x87,
+	0xb0, 0xa7, 0x60, 0x95, 0xa1, 0x1d, 0x03, 0x24, 0xb6, 0x45, 0xcb, 0xa5, 0xed, 0x1a, 0xcb, 0x89,
+	0xa2, 0x82, 0xcf, 0xa1, 0xf6, 0xb3, 0xe3, 0xd9, 0x8e, 0x37, 0xbb, 0x63, 0xe3, 0x6d, 0x4a, 0xfc,
+	0x57, 0xb0, 0x2d, 0x0d, 0x86, 0xe8, 0x79, 0xba, 0x96, 0x58, 0x76, 0x84, 0xbe, 0x94, 0x92, 0xe4,
+	0x18, 0xbf, 0x85, 0xe6, 0xe8, 0xaf, 0xb9, 0x6b, 0x3a, 0xde, 0x07, 0x6a, 0x88, 0x7f, 0x8b, 0x50,
+	0xe7, 0x1e, 0x3c, 0x33, 0x72, 0x7c, 0xef, 0x91, 0xcd, 0xab, 0x75, 0x2a, 0x67, 0xea, 0x84, 0xba,
+	0x50, 0x1b, 0x07, 0xa6, 0x17, 0x51, 0x5b, 0xaf, 0xf0, 0xcb, 0xcd, 0x4c, 0x0e, 0x42, 0x12, 0x1f,
+	0xa3, 0x2f, 0x41, 0xe3, 0x4b, 0xf3, 0xc2, 0xa5, 0xfa, 0xd6, 0x4a, 0xdd, 0x54, 0x01, 0x3d, 0x83,
+	0xea, 0x90, 0x7a, 0x0e, 0xb5, 0xf5, 0xea, 0x4a, 0x55, 0x79, 0x8a, 0x2f, 0x61, 0x9b, 0x21, 0x9c,
+	0x78, 0x97, 0xfe, 0xc6, 0x6e, 0xeb, 0x40, 0x7d, 0x48, 0x43, 0x2b, 0x70, 0xe6, 0x2c, 0x47, 0x3c,
+	0x58, 0x8d, 0xa8, 0x22, 0x16, 0xe7, 0x94, 0x06, 0xef, 0x1d, 0x8b, 0xf2, 0x38, 0x35, 0x12, 0x6f,
+	0x31, 0x7b, 0x91, 0x58, 0x5f, 0x99, 0x91, 0xe9, 0xfa, 0xac, 0x9b, 0x94, 0x17, 0x3d, 0x41, 0x17,
+	0x23, 0x89, 0xff, 0x85, 0x53, 0xa8, 0xbc, 0x36, 0x67, 0x14, 0xb5, 0xa1, 0x3a, 0x58, 0x04, 0xa1,
+	0x1f, 0xc8, 0x07, 0x5e, 0xee, 0xd8, 0x5c, 0x38, 0x73, 0xae, 0x9c, 0x88, 0x43, 0xa9, 0x10, 0xb1,
+	0xc1, 0x6f, 0xe1, 0xe0, 0xcc, 0x09, 0xf9, 0x9b, 0x22, 0x2b, 0x74, 0xcf, 0xd7, 0xf3, 0x48, 0xb8,
+	0xcd, 0x56, 0x93, 0x49, 0x08, 0x97, 0xe3, 0x37, 0xb0, 0xcf, 0x1d, 0xb0, 0xcc, 0xa8, 0xcf, 0xe4,
+	0xa6, 0xfc, 0x6d, 0xb2, 0x7b, 0x98, 0x8c, 0x03, 0xd4, 0x82, 0x72, 0x3a, 0x15, 0xd8, 0x12, 0x1f,
+	0x01, 0x24, 0xfd, 0xb6, 0xea, 0xfc, 0xa9, 0xcc, 0xe8, 0x9a, 0x61, 0xf9, 0x1b, 0xd4, 0xfb, 0x0b,
+	0xdb, 0x89, 0x7e, 0x71, 0xdc, 0x88, 0x06, 0xe8, 0x49, 0xbe, 0xbf, 0x35, 0xb5, 0x9d, 0xdb, 0x49,
+	0x9a, 0x44, 0x8d, 0xe5, 0x8e, 0x99, 0xee, 0x5b, 0x91, 0x1f, 0xc8, 0xe2, 0x8a, 0x0d, 0xb6, 0x45,
+	0xbe, 0xb9, 0xf9, 0x91, 0x17, 0x05, 0x4e, 0x9a, 0x91, 0xe7, 0x50, 0x15, 0x0e, 0xf5, 0xa2, 0x3a,
+	0x82, 0x14, 0x24, 0x44, 0x2a, 0x6c, 0x4c, 0xce, 0x3f, 0x45, 0x80, 0xc4, 0xc5, 0x4d, 0x7e, 0xde,
+	0xa7, 0xd0, 0x4a, 0x0a, 0x34, 0xd6, 0x8f, 0xb2, 0xe3, 0xe3, 0x7e, 0x94, 0x5b, 0x16, 0x62, 0xdf,
+	0xe2, 0x6d, 0x5c, 0x11, 0x21, 0x8a, 0x9d, 0x12, 0xfa, 0x56, 0x26, 0xf4, 0x4c, 0xc2, 0xaa, 0xf9,
+	0x84, 0xed, 0xc3, 0xd6, 0x1b, 0xd3, 0x5d, 0x50, 0xbd, 0x26, 0xbc, 0xf3, 0x0d, 0x2b, 0xd2, 0xb9,
+	0x6b, 0xeb, 0xdb, 0xa2, 0x48, 0xe7, 0xae, 0xcd, 0x24, 0xaf, 0xe8, 0xb5, 0xae, 0x09, 0xc9, 0x2b,
+	0x7a, 0xcd, 0xec, 0x0e, 0x02, 0x6a, 0x46, 0xd4, 0xee, 0x47, 0x3a, 0x74, 0x8a, 0xdd, 0x32, 0x49,
+	0x05, 0xf8, 0x3b, 0x68, 0xa8, 0x69, 0x45, 0x5f, 0x40, 0x4d, 0x2e, 0xe5, 0x8f, 0xd3, 0x52, 0x12,
+	0xca, 0x13, 0x43, 0x62, 0x85, 0x97, 0xff, 0x01, 0xd4, 0x06, 0x82, 0xac, 0xa1, 0x63, 0x68, 0x8c,
+	0x15, 0x8e, 0x83, 0x32, 0x7d, 0x6f, 0xe4, 0x19, 0x0b, 0x2e, 0xa0, 0x01, 0x34, 0x54, 0x52, 0x84,
+	0x3e, 0x11, 0x2a, 0x2b, 0x88, 0x92, 0xd1, 0xee, 0x09, 0xf6, 0xd7, 0x8b, 0xd9, 0x5f, 0x6f, 0xc4,
+	0xd8, 0x1f, 0x2e, 0xa0, 0x13, 0x68, 0x49, 0xaf, 0x43, 0xea, 0xdd, 0xdc, 0xd1, 0xf3, 0x18, 0x5a,
+	0xd3, 0xfc, 0xa5, 0x07, 0x7a, 0x6f, 0x8e, 0x33, 0x14, 0x2a, 0xe7, 0x7b, 0x99, 0x0e, 0x71, 0xef,
+	0xcd, 0x2c, 0xef, 0x42, 0x87, 0x19, 0xdf, 0x59, 0x36, 0x76, 0x8b, 0xf7, 0x6f, 0x61, 0x77, 0x9c,
+	0x25, 0x53, 0x28, 0x3f, 0x40, 0x8c, 0x15, 0x8c, 0x08, 0x17, 0xd0, 0x04, 0x76, 0x73, 0x34, 0x0c,
+	0x3d, 0x49, 0x30, 0xac, 0x60, 0x67, 0xb7, 0x80, 0x98, 0xc0, 0x6e, 0xdf, 0xb6, 0x1f, 0xc5, 0xd4,
+	0x19, 0x20, 0x42, 0xaf, 0xfc, 0xf7, 0xf4, 0x51, 0xac, 0x4d, 0xa1, 0x95, 0xe7, 0x5f, 0xe8, 0xa9,
+	0xb0, 0xb5, 0x86, 0x01, 0x1a, 0x47, 0xeb, 0x8e, 0x05, 0xb7, 0xc1, 0x05, 0xf4, 0x13, 0x68, 0x09,
+	0xe5, 0x41, 0x6d, 0xa1, 0x9e, 0xe7, 0x6b, 0xc6, 0xc1, 0x92, 0x3c, 0xb9, 0x7f, 0x0a, 0x35, 0x49,
+	0x32, 0xd0, 0xbe, 0xd0, 0xca, 0x72, 0x0e, 0x63, 0x4f, 0x91, 0x0a, 0x9e, 0x80, 0x0b, 0xe8, 0x1b,
+	0x40, 0x69, 0xa1, 0x13, 0x6e, 0xb3, 0x54, 0xeb, 0xdc, 0xfc, 0xc5, 0x05, 0xf4, 0x3d, 0xb4, 0xd3,
+	0x8b, 0xac, 0xd7, 0xef, 0x73, 0xf9, 0x07, 0xd8, 0x21, 0x74, 0xe6, 0x84, 0xec, 0x05, 0xe5, 0xbf,
+	0xc8, 0x9e, 0x42, 0xe3, 0xc4, 0x8c, 0xbd, 0x25, 0xfd, 0x3f, 0x8a, 0x5f, 0x23, 0xd5, 0x45, 0x6b,
+	0x74, 0x8d, 0x65, 0xb3, 0xb8, 0x80, 0xba, 0xa0, 0xc5, 0x03, 0x36, 0x44, 0xca, 0x4b, 0x6d, 0xec,
+	0xa8, 0x3f, 0x18, 0x83, 0x39, 0x82, 0x56, 0x7e, 0x14, 0xc7, 0x75, 0x5e, 0x33, 0xa2, 0x8d, 0x56,
+	0x2e, 0x78, 0x19, 0x6d, 0x66, 0xe0, 0x22, 0x43, 0xb1, 0x91, 0x9b, 0xc2, 0xcb, 0x20, 0x9e, 0x49,
+	0xb8, 0x8b, 0x3c, 0xdc, 0x7a, 0x1a, 0x9c, 0x7c, 0x79, 0xf2, 0x73, 0x4c, 0x05, 0xbb, 0x62, 0xbe,
+	0x25, 0x7f, 0xb0, 0x72, 0x84, 0x0b, 0x17, 0x55, 0x9e, 0xc4, 0x93, 0xff, 0x07, 0x00, 0xab, 0x0d,
+	0xe6, 0xb1, 0x2c, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ControlClient is the client API for Control service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ControlClient interface {
+	GetRoleRules(ctx context.Context, in *RoleID, opts ...grpc.CallOption) (*RoleRules, error)
+	SetRoleRules(ctx context.Context, in *SetRoleRulesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetRoleDenyRules(ctx context.Context, in *RoleID, opts ...grpc.CallOption) (*RoleRules, error)
+	SetRoleDenyRules(ctx context.Context, in *SetRoleRulesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetRoleParents(ctx context.Context, in *RoleID, opts ...grpc.CallOption) (*RoleParents, error)
+	SetRoleParents(ctx context.Context, in *SetRoleParentsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetAccountRoles(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*AccountRoles, error)
+	SetAccountRoles(ctx context.Context, in *SetAccountRolesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	AddAccountRoles(ctx context.Context, in *SetAccountRolesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	RemoveAccountRoles(ctx context.Context, in *SetAccountRolesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	IsAccountAllowed(ctx context.Context, in *IsAccountAllowedRequest, opts ...grpc.CallOption) (*IsAccountAllowedResponse, error)
+	CheckMany(ctx context.Context, in *CheckManyRequest, opts ...grpc.CallOption) (*CheckManyResponse, error)
+	Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*Explanation, error)
+	GetAccountBindings(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*Bindings, error)
+	GetAccountDenyBindings(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*Bindings, error)
+	RegisterRules(ctx context.Context, in *RuleCatalog, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetRuleCatalog(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RuleCatalog, error)
+	ListRoles(ctx context.Context, in *Page, opts ...grpc.CallOption) (*RoleIDs, error)
+	ListRoleAccounts(ctx context.Context, in *ListRoleAccountsRequest, opts ...grpc.CallOption) (*AccountIDs, error)
+	ListRuleRoles(ctx context.Context, in *ListRuleRolesRequest, opts ...grpc.CallOption) (*RoleIDs, error)
+	ListRules(ctx context.Context, in *Page, opts ...grpc.CallOption) (*Rules, error)
+	ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*AuditEntries, error)
+}
+
+type controlClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewControlClient(cc *grpc.ClientConn) ControlClient {
+	return &controlClient{cc}
+}
+
+func (c *controlClient) GetRoleRules(ctx context.Context, in *RoleID, opts ...grpc.CallOption) (*RoleRules, error) {
+	out := new(RoleRules)
+	err := c.cc.Invoke(ctx, "/rbac.Control/GetRoleRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) SetRoleRules(ctx context.Context, in *SetRoleRulesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/rbac.Control/SetRoleRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetRoleDenyRules(ctx context.Context, in *RoleID, opts ...grpc.CallOption) (*RoleRules, error) {
+	out := new(RoleRules)
+	err := c.cc.Invoke(ctx, "/rbac.Control/GetRoleDenyRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) SetRoleDenyRules(ctx context.Context, in *SetRoleRulesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/rbac.Control/SetRoleDenyRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetRoleParents(ctx context.Context, in *RoleID, opts ...grpc.CallOption) (*RoleParents, error) {
+	out := new(RoleParents)
+	err := c.cc.Invoke(ctx, "/rbac.Control/GetRoleParents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) SetRoleParents(ctx context.Context, in *SetRoleParentsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/rbac.Control/SetRoleParents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetAccountRoles(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*AccountRoles, error) {
+	out := new(AccountRoles)
+	err := c.cc.Invoke(ctx, "/rbac.Control/GetAccountRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) SetAccountRoles(ctx context.Context, in *SetAccountRolesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/rbac.Control/SetAccountRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) AddAccountRoles(ctx context.Context, in *SetAccountRolesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/rbac.Control/AddAccountRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) RemoveAccountRoles(ctx context.Context, in *SetAccountRolesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/rbac.Control/RemoveAccountRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) IsAccountAllowed(ctx context.Context, in *IsAccountAllowedRequest, opts ...grpc.CallOption) (*IsAccountAllowedResponse, error) {
+	out := new(IsAccountAllowedResponse)
+	err := c.cc.Invoke(ctx, "/rbac.Control/IsAccountAllowed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) CheckMany(ctx context.Context, in *CheckManyRequest, opts ...grpc.CallOption) (*CheckManyResponse, error) {
+	out := new(CheckManyResponse)
+	err := c.cc.Invoke(ctx, "/rbac.Control/CheckMany", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) Explain(ctx context.Context, in *ExplainRequest, opts ...grpc.CallOption) (*Explanation, error) {
+	out := new(Explanation)
+	err := c.cc.Invoke(ctx, "/rbac.Control/Explain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetAccountBindings(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*Bindings, error) {
+	out := new(Bindings)
+	err := c.cc.Invoke(ctx, "/rbac.Control/GetAccountBindings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetAccountDenyBindings(ctx context.Context, in *AccountID, opts ...grpc.CallOption) (*Bindings, error) {
+	out := new(Bindings)
+	err := c.cc.Invoke(ctx, "/rbac.Control/GetAccountDenyBindings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) RegisterRules(ctx context.Context, in *RuleCatalog, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/rbac.Control/RegisterRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) GetRuleCatalog(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*RuleCatalog, error) {
+	out := new(RuleCatalog)
+	err := c.cc.Invoke(ctx, "/rbac.Control/GetRuleCatalog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ListRoles(ctx context.Context, in *Page, opts ...grpc.CallOption) (*RoleIDs, error) {
+	out := new(RoleIDs)
+	err := c.cc.Invoke(ctx, "/rbac.Control/ListRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ListRoleAccounts(ctx context.Context, in *ListRoleAccountsRequest, opts ...grpc.CallOption) (*AccountIDs, error) {
+	out := new(AccountIDs)
+	err := c.cc.Invoke(ctx, "/rbac.Control/ListRoleAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ListRuleRoles(ctx context.Context, in *ListRuleRolesRequest, opts ...grpc.CallOption) (*RoleIDs, error) {
+	out := new(RoleIDs)
+	err := c.cc.Invoke(ctx, "/rbac.Control/ListRuleRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ListRules(ctx context.Context, in *Page, opts ...grpc.CallOption) (*Rules, error) {
+	out := new(Rules)
+	err := c.cc.Invoke(ctx, "/rbac.Control/ListRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*AuditEntries, error) {
+	out := new(AuditEntries)
+	err := c.cc.Invoke(ctx, "/rbac.Control/ListAuditEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ControlServer is the server API for Control service.
+type ControlServer interface {
+	GetRoleRules(context.Context, *RoleID) (*RoleRules, error)
+	SetRoleRules(context.Context, *SetRoleRulesRequest) (*empty.Empty, error)
+	GetRoleDenyRules(context.Context, *RoleID) (*RoleRules, error)
+	SetRoleDenyRules(context.Context, *SetRoleRulesRequest) (*empty.Empty, error)
+	GetRoleParents(context.Context, *RoleID) (*RoleParents, error)
+	SetRoleParents(context.Context, *SetRoleParentsRequest) (*empty.Empty, error)
+	GetAccountRoles(context.Context, *AccountID) (*AccountRoles, error)
+	SetAccountRoles(context.Context, *SetAccountRolesRequest) (*empty.Empty, error)
+	AddAccountRoles(context.Context, *SetAccountRolesRequest) (*empty.Empty, error)
+	RemoveAccountRoles(context.Context, *SetAccountRolesRequest) (*empty.Empty, error)
+	IsAccountAllowed(context.Context, *IsAccountAllowedRequest) (*IsAccountAllowedResponse, error)
+	CheckMany(context.Context, *CheckManyRequest) (*CheckManyResponse, error)
+	Explain(context.Context, *ExplainRequest) (*Explanation, error)
+	GetAccountBindings(context.Context, *AccountID) (*Bindings, error)
+	GetAccountDenyBindings(context.Context, *AccountID) (*Bindings, error)
+	RegisterRules(context.Context, *RuleCatalog) (*empty.Empty, error)
+	GetRuleCatalog(context.Context, *empty.Empty) (*RuleCatalog, error)
+	ListRoles(context.Context, *Page) (*RoleIDs, error)
+	ListRoleAccounts(context.Context, *ListRoleAccountsRequest) (*AccountIDs, error)
+	ListRuleRoles(context.Context, *ListRuleRolesRequest) (*RoleIDs, error)
+	ListRules(context.Context, *Page) (*Rules, error)
+	ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*AuditEntries, error)
+}
+
+func RegisterControlServer(s *grpc.Server, srv ControlServer) {
+	s.RegisterService(&_Control_serviceDesc, srv)
+}
+
+func _Control_GetRoleRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetRoleRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/GetRoleRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetRoleRules(ctx, req.(*RoleID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_SetRoleRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRoleRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).SetRoleRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/SetRoleRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).SetRoleRules(ctx, req.(*SetRoleRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetRoleDenyRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetRoleDenyRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/GetRoleDenyRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetRoleDenyRules(ctx, req.(*RoleID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_SetRoleDenyRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRoleRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).SetRoleDenyRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/SetRoleDenyRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).SetRoleDenyRules(ctx, req.(*SetRoleRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetRoleParents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoleID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetRoleParents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/GetRoleParents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetRoleParents(ctx, req.(*RoleID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_SetRoleParents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRoleParentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).SetRoleParents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/SetRoleParents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).SetRoleParents(ctx, req.(*SetRoleParentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetAccountRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetAccountRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/GetAccountRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetAccountRoles(ctx, req.(*AccountID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_SetAccountRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).SetAccountRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/SetAccountRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).SetAccountRoles(ctx, req.(*SetAccountRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_AddAccountRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).AddAccountRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/AddAccountRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).AddAccountRoles(ctx, req.(*SetAccountRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_RemoveAccountRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAccountRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).RemoveAccountRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/RemoveAccountRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).RemoveAccountRoles(ctx, req.(*SetAccountRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_IsAccountAllowed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsAccountAllowedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).IsAccountAllowed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/IsAccountAllowed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).IsAccountAllowed(ctx, req.(*IsAccountAllowedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_CheckMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckManyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).CheckMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/CheckMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).CheckMany(ctx, req.(*CheckManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_Explain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).Explain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/Explain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).Explain(ctx, req.(*ExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetAccountBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetAccountBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/GetAccountBindings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetAccountBindings(ctx, req.(*AccountID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetAccountDenyBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetAccountDenyBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/GetAccountDenyBindings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetAccountDenyBindings(ctx, req.(*AccountID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_RegisterRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RuleCatalog)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).RegisterRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/RegisterRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).RegisterRules(ctx, req.(*RuleCatalog))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_GetRuleCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetRuleCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/GetRuleCatalog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetRuleCatalog(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Page)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/ListRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ListRoles(ctx, req.(*Page))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ListRoleAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ListRoleAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/ListRoleAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ListRoleAccounts(ctx, req.(*ListRoleAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ListRuleRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuleRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ListRuleRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/ListRuleRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ListRuleRoles(ctx, req.(*ListRuleRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ListRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Page)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ListRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/ListRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ListRules(ctx, req.(*Page))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Control_ListAuditEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).ListAuditEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rbac.Control/ListAuditEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).ListAuditEntries(ctx, req.(*ListAuditEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Control_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rbac.Control",
 	HandlerType: (*ControlServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -628,6 +2224,22 @@ var _Control_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetRoleRules",
 			Handler:    _Control_SetRoleRules_Handler,
 		},
+		{
+			MethodName: "GetRoleDenyRules",
+			Handler:    _Control_GetRoleDenyRules_Handler,
+		},
+		{
+			MethodName: "SetRoleDenyRules",
+			Handler:    _Control_SetRoleDenyRules_Handler,
+		},
+		{
+			MethodName: "GetRoleParents",
+			Handler:    _Control_GetRoleParents_Handler,
+		},
+		{
+			MethodName: "SetRoleParents",
+			Handler:    _Control_SetRoleParents_Handler,
+		},
 		{
 			MethodName: "GetAccountRoles",
 			Handler:    _Control_GetAccountRoles_Handler,
@@ -636,10 +2248,62 @@ var _Control_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetAccountRoles",
 			Handler:    _Control_SetAccountRoles_Handler,
 		},
+		{
+			MethodName: "AddAccountRoles",
+			Handler:    _Control_AddAccountRoles_Handler,
+		},
+		{
+			MethodName: "RemoveAccountRoles",
+			Handler:    _Control_RemoveAccountRoles_Handler,
+		},
 		{
 			MethodName: "IsAccountAllowed",
 			Handler:    _Control_IsAccountAllowed_Handler,
 		},
+		{
+			MethodName: "CheckMany",
+			Handler:    _Control_CheckMany_Handler,
+		},
+		{
+			MethodName: "Explain",
+			Handler:    _Control_Explain_Handler,
+		},
+		{
+			MethodName: "GetAccountBindings",
+			Handler:    _Control_GetAccountBindings_Handler,
+		},
+		{
+			MethodName: "GetAccountDenyBindings",
+			Handler:    _Control_GetAccountDenyBindings_Handler,
+		},
+		{
+			MethodName: "RegisterRules",
+			Handler:    _Control_RegisterRules_Handler,
+		},
+		{
+			MethodName: "GetRuleCatalog",
+			Handler:    _Control_GetRuleCatalog_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _Control_ListRoles_Handler,
+		},
+		{
+			MethodName: "ListRoleAccounts",
+			Handler:    _Control_ListRoleAccounts_Handler,
+		},
+		{
+			MethodName: "ListRuleRoles",
+			Handler:    _Control_ListRuleRoles_Handler,
+		},
+		{
+			MethodName: "ListRules",
+			Handler:    _Control_ListRules_Handler,
+		},
+		{
+			MethodName: "ListAuditEntries",
+			Handler:    _Control_ListAuditEntries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "control.proto",
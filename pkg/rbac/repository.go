@@ -1,19 +1,76 @@
 package rbac
 
-import "context"
+import (
+	"context"
+
+	"github.com/51st-state/api/pkg/pagination"
+)
 
 // Repository for persistent RBAC storage
 //go:generate counterfeiter -o ./mocks/repository.go . Repository
 type Repository interface {
 	// GetRoleRules fetches all available Rules from a role
 	GetRoleRules(context.Context, RoleID) (RoleRules, error)
-	// SetRoleRules sets the rules of a role
-	SetRoleRules(context.Context, RoleID, RoleRules) error
+	// SetRoleRules sets the rules of a role and adds the audit entries
+	// of the change in the same transaction
+	SetRoleRules(context.Context, RoleID, RoleRules, Auditor) error
+	// GetRoleDenyRules fetches all denied Rules of a role
+	GetRoleDenyRules(context.Context, RoleID) (RoleRules, error)
+	// SetRoleDenyRules sets the denied rules of a role and adds the audit
+	// entries of the change in the same transaction
+	SetRoleDenyRules(context.Context, RoleID, RoleRules, Auditor) error
+	// GetRoleParents returns the roles a role inherits from
+	GetRoleParents(context.Context, RoleID) (RoleParents, error)
+	// SetRoleParents sets the roles a role inherits from and adds the audit
+	// entries of the change in the same transaction
+	SetRoleParents(context.Context, RoleID, RoleParents, Auditor) error
 	// GetAccountRoles returns the roles of a subject
 	GetAccountRoles(context.Context, AccountID) (AccountRoles, error)
-	// SetAccountRoles sets the roles of a subject
-	SetAccountRoles(context.Context, AccountID, AccountRoles) error
-	// GetAccountRuleCount returns the amount of occurrences of a given rule
-	// for a given subject
-	GetAccountRuleCount(context.Context, AccountID, Rule) (uint64, error)
+	// SetAccountRoles sets the roles of a subject and adds the audit
+	// entries of the change in the same transaction
+	SetAccountRoles(context.Context, AccountID, AccountRoles, Auditor) error
+	// AddAccountRoles binds roles to a subject in addition to its current roles
+	// and adds the audit entries of the change in the same transaction
+	AddAccountRoles(context.Context, AccountID, AccountRoles, Auditor) error
+	// RemoveAccountRoles unbinds roles from a subject and keeps its other roles,
+	// the audit entries of the change are added in the same transaction
+	RemoveAccountRoles(context.Context, AccountID, AccountRoles, Auditor) error
+	// GetAccountBindings returns all rule bindings of the roles
+	// of a given subject including the roles inherited from
+	GetAccountBindings(context.Context, AccountID) (Bindings, error)
+	// GetAccountDenyBindings returns all deny bindings of the roles
+	// of a given subject including the roles inherited from
+	GetAccountDenyBindings(context.Context, AccountID) (Bindings, error)
+	// RegisterRules adds or updates rules of the rule catalog
+	RegisterRules(context.Context, RuleCatalog) error
+	// GetRuleCatalog returns all registered rules
+	GetRuleCatalog(context.Context) (RuleCatalog, error)
+	// GetRuleBindings returns all role bindings matching a given rule
+	GetRuleBindings(context.Context, Rule) (Bindings, error)
+	// ListRoles returns a page of all known roles
+	ListRoles(context.Context, pagination.Page) ([]RoleID, error)
+	// ListRoleAccounts returns a page of the accounts holding a role
+	ListRoleAccounts(context.Context, RoleID, pagination.Page) ([]AccountID, error)
+	// ListRuleRoles returns a page of the roles granting a rule
+	// either directly or by a matching wildcard rule. The roles inherited
+	// from are taken into account and roles denying the rule are left out.
+	ListRuleRoles(context.Context, Rule, pagination.Page) ([]RoleID, error)
+	// ListRules returns a page of all rules bound to roles
+	ListRules(context.Context, pagination.Page) ([]Rule, error)
+	// AddAuditEntry appends an entry to the audit trail and
+	// returns it with its assigned id
+	AddAuditEntry(context.Context, AuditEntry) (AuditEntry, error)
+	// ListAuditEntries returns a page of the audit entries matching
+	// a filter in the order they were added
+	ListAuditEntries(context.Context, AuditFilter, pagination.Page) ([]AuditEntry, error)
+	// GetUnpublishedAuditEntries returns at most limit entries, which have not
+	// been marked as published yet, in the order they were added
+	GetUnpublishedAuditEntries(ctx context.Context, limit uint64) ([]AuditEntry, error)
+	// MarkAuditEntryPublished after the event of an entry has been produced
+	MarkAuditEntryPublished(ctx context.Context, id string) error
+	// DeleteAccount removes the roles of an account and the account itself
+	DeleteAccount(context.Context, AccountID) error
+	// AnonymizeAuditEntries replaces an account in the audit trail,
+	// both as the changed account and as the actor of a change
+	AnonymizeAuditEntries(ctx context.Context, accountID, replacement AccountID) error
 }
@@ -0,0 +1,874 @@
+// Package repositorytest contains the conformance tests of rbac repositories
+package repositorytest
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/51st-state/api/pkg/pagination"
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+// Run the conformance tests against the repositories created by newRepository.
+// Every call of newRepository has to return an empty repository.
+func Run(t *testing.T, newRepository func(*testing.T) rbac.Repository) {
+	tests := map[string]func(*testing.T, rbac.Repository){
+		"RoleRules":        testRoleRules,
+		"RoleDenyRules":    testRoleDenyRules,
+		"RoleParents":      testRoleParents,
+		"AccountRoles":     testAccountRoles,
+		"AccountBindings":  testAccountBindings,
+		"RuleCatalog":      testRuleCatalog,
+		"RuleBindings":     testRuleBindings,
+		"ListRoles":        testListRoles,
+		"ListRoleAccounts": testListRoleAccounts,
+		"ListRuleRoles":    testListRuleRoles,
+		"ListRules":        testListRules,
+		"AuditEntries":     testAuditEntries,
+		"BindingAudit":     testBindingAudit,
+		"Unpublished":      testUnpublishedAuditEntries,
+		"DeleteAccount":    testDeleteAccount,
+		"Anonymize":        testAnonymizeAuditEntries,
+	}
+
+	names := make([]string, 0)
+	for name := range tests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		test := tests[name]
+		t.Run(name, func(t *testing.T) {
+			test(t, newRepository(t))
+		})
+	}
+}
+
+func sameSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	sortedA := append([]string{}, a...)
+	sortedB := append([]string{}, b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func ruleStrings(rules []rbac.Rule) []string {
+	s := make([]string, 0)
+	for _, v := range rules {
+		s = append(s, string(v))
+	}
+
+	return s
+}
+
+func roleStrings(roles []rbac.RoleID) []string {
+	s := make([]string, 0)
+	for _, v := range roles {
+		s = append(s, string(v))
+	}
+
+	return s
+}
+
+func accountStrings(accounts []rbac.AccountID) []string {
+	s := make([]string, 0)
+	for _, v := range accounts {
+		s = append(s, string(v))
+	}
+
+	return s
+}
+
+func bindingStrings(bindings rbac.Bindings) []string {
+	s := make([]string, 0)
+	for _, v := range bindings {
+		s = append(s, string(v.RoleID)+" "+string(v.Rule))
+	}
+
+	return s
+}
+
+func testRoleRules(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	rules, err := r.GetRoleRules(ctx, "unknown")
+	if err != nil {
+		t.Fatal("an unknown role should not return an error")
+	}
+
+	if rules == nil || len(rules) != 0 {
+		t.Fatal("an unknown role should have no rules")
+	}
+
+	if err := r.SetRoleRules(ctx, "role", rbac.RoleRules{"users.get", "users.set"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	rules, err = r.GetRoleRules(ctx, "role")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !sameSet(ruleStrings(rules), []string{"users.get", "users.set"}) {
+		t.Fatal("the rules should be stored")
+	}
+
+	if err := r.SetRoleRules(ctx, "role", rbac.RoleRules{"users.set", "users.delete"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	rules, err = r.GetRoleRules(ctx, "role")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !sameSet(ruleStrings(rules), []string{"users.set", "users.delete"}) {
+		t.Fatal("the rules should be replaced")
+	}
+
+	if err := r.SetRoleRules(ctx, "role", rbac.RoleRules{}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if rules, err := r.GetRoleRules(ctx, "role"); err != nil || len(rules) != 0 {
+		t.Fatal("the rules should be removed")
+	}
+}
+
+func testRoleDenyRules(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	if err := r.SetRoleRules(ctx, "role", rbac.RoleRules{"users.*"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleDenyRules(ctx, "role", rbac.RoleRules{"users.delete"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	denyRules, err := r.GetRoleDenyRules(ctx, "role")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !sameSet(ruleStrings(denyRules), []string{"users.delete"}) {
+		t.Fatal("the deny rules should be stored")
+	}
+
+	rules, err := r.GetRoleRules(ctx, "role")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !sameSet(ruleStrings(rules), []string{"users.*"}) {
+		t.Fatal("the deny rules should not change the rules")
+	}
+
+	if err := r.SetRoleDenyRules(ctx, "role", rbac.RoleRules{}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if denyRules, err := r.GetRoleDenyRules(ctx, "role"); err != nil || len(denyRules) != 0 {
+		t.Fatal("the deny rules should be removed")
+	}
+}
+
+func testRoleParents(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	parents, err := r.GetRoleParents(ctx, "unknown")
+	if err != nil || parents == nil || len(parents) != 0 {
+		t.Fatal("an unknown role should have no parents")
+	}
+
+	if err := r.SetRoleParents(ctx, "child", rbac.RoleParents{"parent-a", "parent-b"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	parents, err = r.GetRoleParents(ctx, "child")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !sameSet(roleStrings(parents), []string{"parent-a", "parent-b"}) {
+		t.Fatal("the parents should be stored")
+	}
+
+	if err := r.SetRoleParents(ctx, "child", rbac.RoleParents{"parent-b"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	parents, err = r.GetRoleParents(ctx, "child")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !sameSet(roleStrings(parents), []string{"parent-b"}) {
+		t.Fatal("the parents should be replaced")
+	}
+}
+
+func testAccountRoles(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	roles, err := r.GetAccountRoles(ctx, "unknown")
+	if err != nil || roles == nil || len(roles) != 0 {
+		t.Fatal("an unknown account should have no roles")
+	}
+
+	for _, v := range []rbac.RoleID{"role-a", "role-b"} {
+		if err := r.SetRoleRules(ctx, v, rbac.RoleRules{"users.get"}, nil); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	if err := r.SetAccountRoles(ctx, "account", rbac.AccountRoles{"role-a", "role-b"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	roles, err = r.GetAccountRoles(ctx, "account")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !sameSet(roleStrings(roles), []string{"role-a", "role-b"}) {
+		t.Fatal("the roles should be stored")
+	}
+
+	if err := r.SetAccountRoles(ctx, "account", rbac.AccountRoles{"role-b"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	roles, err = r.GetAccountRoles(ctx, "account")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !sameSet(roleStrings(roles), []string{"role-b"}) {
+		t.Fatal("the roles should be replaced")
+	}
+
+	if roles, err := r.GetAccountRoles(ctx, "other"); err != nil || len(roles) != 0 {
+		t.Fatal("the roles of other accounts should not change")
+	}
+
+	if err := r.AddAccountRoles(ctx, "account", rbac.AccountRoles{"role-a", "role-b"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	roles, err = r.GetAccountRoles(ctx, "account")
+	if err != nil || len(roles) != 2 || !sameSet(roleStrings(roles), []string{"role-a", "role-b"}) {
+		t.Fatal("the roles should be added once to the current roles")
+	}
+
+	if err := r.RemoveAccountRoles(ctx, "account", rbac.AccountRoles{"role-a", "role-c"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	roles, err = r.GetAccountRoles(ctx, "account")
+	if err != nil || !sameSet(roleStrings(roles), []string{"role-b"}) {
+		t.Fatal("only the given roles should be removed")
+	}
+}
+
+func testAccountBindings(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	if err := r.SetRoleRules(ctx, "grandparent", rbac.RoleRules{"chat.*"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleDenyRules(ctx, "grandparent", rbac.RoleRules{"chat.ban"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleRules(ctx, "parent", rbac.RoleRules{"users.get"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleParents(ctx, "parent", rbac.RoleParents{"grandparent"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleRules(ctx, "child", rbac.RoleRules{"users.set"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleParents(ctx, "child", rbac.RoleParents{"parent"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetAccountRoles(ctx, "account", rbac.AccountRoles{"child"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	bindings, err := r.GetAccountBindings(ctx, "account")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !sameSet(bindingStrings(bindings), []string{
+		"child users.set",
+		"parent users.get",
+		"grandparent chat.*",
+	}) {
+		t.Fatal("the bindings should include the inherited roles")
+	}
+
+	denyBindings, err := r.GetAccountDenyBindings(ctx, "account")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !sameSet(bindingStrings(denyBindings), []string{"grandparent chat.ban"}) {
+		t.Fatal("the deny bindings should include the inherited roles")
+	}
+
+	if bindings, err := r.GetAccountBindings(ctx, "unknown"); err != nil || len(bindings) != 0 {
+		t.Fatal("an unknown account should have no bindings")
+	}
+}
+
+func testRuleCatalog(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	catalog, err := r.GetRuleCatalog(ctx)
+	if err != nil || catalog == nil || len(catalog) != 0 {
+		t.Fatal("the catalog should be empty")
+	}
+
+	if err := r.RegisterRules(ctx, rbac.RuleCatalog{
+		{Rule: "users.set", Description: "set", Service: "user"},
+		{Rule: "users.get", Description: "get", Service: "user"},
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.RegisterRules(ctx, rbac.RuleCatalog{
+		{Rule: "users.get", Description: "get a user", Service: "user"},
+		{Rule: "roles.get", Description: "get a role", Service: "role"},
+	}); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	catalog, err = r.GetRuleCatalog(ctx)
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !equal(ruleStrings(catalog.Rules()), []string{"roles.get", "users.get", "users.set"}) {
+		t.Fatal("the catalog should contain all registered rules ordered by the rule")
+	}
+
+	if catalog[1].Description != "get a user" || catalog[1].Service != "user" {
+		t.Fatal("registering a rule again should update it")
+	}
+}
+
+func testRuleBindings(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	if err := r.SetRoleRules(ctx, "exact", rbac.RoleRules{"users.delete", "users.get"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleRules(ctx, "namespace", rbac.RoleRules{"users.*"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleRules(ctx, "admin", rbac.RoleRules{"*"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleRules(ctx, "other", rbac.RoleRules{"roles.*", "users.deleted"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	bindings, err := r.GetRuleBindings(ctx, "users.delete")
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !sameSet(bindingStrings(bindings), []string{
+		"exact users.delete",
+		"namespace users.*",
+		"admin *",
+	}) {
+		t.Fatal("the bindings should match the rule exactly or by a wildcard")
+	}
+}
+
+func testListRoles(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	roles, err := r.ListRoles(ctx, pagination.New("", 2))
+	if err != nil || roles == nil || len(roles) != 0 {
+		t.Fatal("there should be no roles")
+	}
+
+	for _, v := range []rbac.RoleID{"c", "a", "b"} {
+		if err := r.SetRoleRules(ctx, v, rbac.RoleRules{"users.get"}, nil); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	roles, err = r.ListRoles(ctx, pagination.New("", 2))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !equal(roleStrings(roles), []string{"a", "b"}) {
+		t.Fatal("the first page should contain the first roles ordered by their id")
+	}
+
+	roles, err = r.ListRoles(ctx, pagination.New("b", 2))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !equal(roleStrings(roles), []string{"c"}) {
+		t.Fatal("the page should start after the cursor")
+	}
+}
+
+func testListRoleAccounts(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	for _, v := range []rbac.RoleID{"role", "other"} {
+		if err := r.SetRoleRules(ctx, v, rbac.RoleRules{"users.get"}, nil); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	for _, v := range []rbac.AccountID{"c", "a", "b"} {
+		if err := r.SetAccountRoles(ctx, v, rbac.AccountRoles{"role"}, nil); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	if err := r.SetAccountRoles(ctx, "d", rbac.AccountRoles{"other"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	accounts, err := r.ListRoleAccounts(ctx, "role", pagination.New("", 2))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !equal(accountStrings(accounts), []string{"a", "b"}) {
+		t.Fatal("the first page should contain the first accounts ordered by their id")
+	}
+
+	accounts, err = r.ListRoleAccounts(ctx, "role", pagination.New("b", 2))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !equal(accountStrings(accounts), []string{"c"}) {
+		t.Fatal("the page should start after the cursor and only contain accounts holding the role")
+	}
+}
+
+func testListRuleRoles(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	if err := r.SetRoleRules(ctx, "c", rbac.RoleRules{"users.delete", "users.*"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleRules(ctx, "a", rbac.RoleRules{"*"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleRules(ctx, "b", rbac.RoleRules{"users.*"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleRules(ctx, "d", rbac.RoleRules{"users.get"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleDenyRules(ctx, "e", rbac.RoleRules{"users.delete"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleParents(ctx, "f", rbac.RoleParents{"d", "b"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleParents(ctx, "g", rbac.RoleParents{"a"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleDenyRules(ctx, "g", rbac.RoleRules{"users.*"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleParents(ctx, "h", rbac.RoleParents{"e"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleRules(ctx, "h", rbac.RoleRules{"users.delete"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	roles, err := r.ListRuleRoles(ctx, "users.delete", pagination.New("", 2))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !equal(roleStrings(roles), []string{"a", "b"}) {
+		t.Fatal("the first page should contain the first granting roles ordered by their id")
+	}
+
+	roles, err = r.ListRuleRoles(ctx, "users.delete", pagination.New("b", 2))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !equal(roleStrings(roles), []string{"c", "f"}) {
+		t.Fatal("the page should contain each granting role once, including the roles granting it by inheritance")
+	}
+
+	roles, err = r.ListRuleRoles(ctx, "users.delete", pagination.New("f", 2))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(roles) != 0 {
+		t.Fatal("the roles denying the rule themselves or by inheritance should be left out")
+	}
+}
+
+func testListRules(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	if err := r.SetRoleRules(ctx, "a", rbac.RoleRules{"users.set", "users.get"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleRules(ctx, "b", rbac.RoleRules{"users.get", "roles.get"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleDenyRules(ctx, "b", rbac.RoleRules{"users.delete"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	rules, err := r.ListRules(ctx, pagination.New("", 2))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !equal(ruleStrings(rules), []string{"roles.get", "users.get"}) {
+		t.Fatal("the first page should contain the first bound rules ordered by the rule")
+	}
+
+	rules, err = r.ListRules(ctx, pagination.New("users.get", 2))
+	if err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if !equal(ruleStrings(rules), []string{"users.set"}) {
+		t.Fatal("the page should only contain rules granted by a role")
+	}
+}
+
+func testAuditEntries(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	entries, err := r.ListAuditEntries(ctx, rbac.AuditFilter{}, pagination.New("", 10))
+	if err != nil || entries == nil || len(entries) != 0 {
+		t.Fatal("there should be no audit entries")
+	}
+
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	for _, v := range []rbac.AuditEntry{
+		{Actor: "user/1", Binding: rbac.AuditRoleRules, Action: rbac.AuditAdd, RoleID: "admin", Value: "users.get", Old: []string{}, New: []string{"users.get"}},
+		{Actor: "user/2", Binding: rbac.AuditAccountRoles, Action: rbac.AuditAdd, RoleID: "admin", AccountID: "user/3", Value: "admin", Old: []string{}, New: []string{"admin"}},
+		{Actor: "user/1", Binding: rbac.AuditAccountRoles, Action: rbac.AuditRemove, RoleID: "admin", AccountID: "user/3", Value: "admin", Old: []string{"admin"}, New: []string{}},
+	} {
+		v.CreatedAt = createdAt
+		e, err := r.AddAuditEntry(ctx, v)
+		if err != nil {
+			t.Fatal("there should be no error")
+		}
+
+		if e.ID == "" {
+			t.Fatal("the entry should get an id")
+		}
+	}
+
+	entries, err = r.ListAuditEntries(ctx, rbac.AuditFilter{}, pagination.New("", 2))
+	if err != nil || len(entries) != 2 {
+		t.Fatal("the first page should contain two entries")
+	}
+
+	first := entries[0]
+	if first.Actor != "user/1" ||
+		first.Binding != rbac.AuditRoleRules ||
+		first.Action != rbac.AuditAdd ||
+		first.RoleID != "admin" ||
+		first.AccountID != "" ||
+		first.Value != "users.get" ||
+		!equal(first.Old, []string{}) ||
+		!equal(first.New, []string{"users.get"}) ||
+		!first.CreatedAt.Equal(createdAt) {
+		t.Fatal("the entries should be listed in the order they were added")
+	}
+
+	entries, err = r.ListAuditEntries(ctx, rbac.AuditFilter{}, pagination.New(entries[1].ID, 2))
+	if err != nil || len(entries) != 1 || entries[0].Action != rbac.AuditRemove {
+		t.Fatal("the page should start after the cursor")
+	}
+
+	for _, c := range []struct {
+		filter rbac.AuditFilter
+		count  int
+	}{
+		{rbac.AuditFilter{Actor: "user/1"}, 2},
+		{rbac.AuditFilter{AccountID: "user/3"}, 2},
+		{rbac.AuditFilter{RoleID: "admin"}, 3},
+		{rbac.AuditFilter{Actor: "user/1", AccountID: "user/3"}, 1},
+		{rbac.AuditFilter{RoleID: "unknown"}, 0},
+	} {
+		entries, err := r.ListAuditEntries(ctx, c.filter, pagination.New("", 10))
+		if err != nil || len(entries) != c.count {
+			t.Fatal("the entries should be filtered")
+		}
+	}
+}
+
+// auditor records the values it has been called with
+type auditor struct {
+	calls [][2][]string
+}
+
+func (a *auditor) audit(old, new []string) []rbac.AuditEntry {
+	a.calls = append(a.calls, [2][]string{old, new})
+
+	entries := make([]rbac.AuditEntry, 0)
+	for _, v := range new {
+		entries = append(entries, rbac.AuditEntry{
+			Action: rbac.AuditAdd,
+			Value:  v,
+			Old:    old,
+			New:    new,
+		})
+	}
+
+	return entries
+}
+
+func testBindingAudit(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	a := &auditor{}
+	if err := r.SetRoleRules(ctx, "admin", rbac.RoleRules{"users.get"}, a.audit); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleDenyRules(ctx, "admin", rbac.RoleRules{"users.delete"}, a.audit); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleParents(ctx, "admin", rbac.RoleParents{"moderator"}, a.audit); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetAccountRoles(ctx, "user/1", rbac.AccountRoles{"admin", "unknown"}, a.audit); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.SetRoleRules(ctx, "admin", rbac.RoleRules{"users.get", "users.set"}, a.audit); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.AddAccountRoles(ctx, "user/1", rbac.AccountRoles{"moderator"}, a.audit); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if err := r.RemoveAccountRoles(ctx, "user/1", rbac.AccountRoles{"admin"}, a.audit); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	if len(a.calls) != 7 {
+		t.Fatal("the auditor should be called for every change")
+	}
+
+	for i, c := range []struct {
+		old []string
+		new []string
+	}{
+		{[]string{}, []string{"users.get"}},
+		{[]string{}, []string{"users.delete"}},
+		{[]string{}, []string{"moderator"}},
+		{[]string{}, []string{"admin"}},
+		{[]string{"users.get"}, []string{"users.get", "users.set"}},
+		{[]string{"admin"}, []string{"admin", "moderator"}},
+		{[]string{"admin", "moderator"}, []string{"moderator"}},
+	} {
+		if !sameSet(a.calls[i][0], c.old) || !sameSet(a.calls[i][1], c.new) {
+			t.Fatal("the auditor should get the stored values before and after the change")
+		}
+	}
+
+	entries, err := r.ListAuditEntries(ctx, rbac.AuditFilter{}, pagination.New("", 10))
+	if err != nil || len(entries) != 9 {
+		t.Fatal("the entries of the auditor should be added")
+	}
+
+	if entries[0].Value != "users.get" || entries[3].Value != "admin" || !sameSet(entries[5].Old, []string{"users.get"}) {
+		t.Fatal("the entries should be added in the order of the changes")
+	}
+
+	if err := r.SetRoleRules(ctx, "admin", rbac.RoleRules{}, nil); err != nil {
+		t.Fatal("a change without auditor should not return an error")
+	}
+
+	entries, err = r.ListAuditEntries(ctx, rbac.AuditFilter{}, pagination.New("", 10))
+	if err != nil || len(entries) != 9 {
+		t.Fatal("a change without auditor should add no entries")
+	}
+}
+
+func testUnpublishedAuditEntries(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	entries, err := r.GetUnpublishedAuditEntries(ctx, 10)
+	if err != nil || entries == nil || len(entries) != 0 {
+		t.Fatal("there should be no unpublished audit entries")
+	}
+
+	ids := make([]string, 0)
+	for _, v := range []string{"users.get", "users.set", "users.delete"} {
+		e, err := r.AddAuditEntry(ctx, rbac.AuditEntry{
+			Binding:   rbac.AuditRoleRules,
+			Action:    rbac.AuditAdd,
+			RoleID:    "admin",
+			Value:     v,
+			Old:       []string{},
+			New:       []string{v},
+			CreatedAt: time.Now().UTC(),
+		})
+		if err != nil {
+			t.Fatal("there should be no error")
+		}
+
+		ids = append(ids, e.ID)
+	}
+
+	entries, err = r.GetUnpublishedAuditEntries(ctx, 2)
+	if err != nil || len(entries) != 2 || entries[0].ID != ids[0] || entries[1].ID != ids[1] {
+		t.Fatal("the unpublished entries should be returned in the order they were added")
+	}
+
+	if err := r.MarkAuditEntryPublished(ctx, ids[0]); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	entries, err = r.GetUnpublishedAuditEntries(ctx, 10)
+	if err != nil || len(entries) != 2 || entries[0].ID != ids[1] || entries[1].Value != "users.delete" {
+		t.Fatal("published entries should not be returned")
+	}
+
+	entries, err = r.ListAuditEntries(ctx, rbac.AuditFilter{}, pagination.New("", 10))
+	if err != nil || len(entries) != 3 {
+		t.Fatal("published entries should be kept in the audit trail")
+	}
+}
+
+func testDeleteAccount(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	if err := r.SetRoleRules(ctx, "admin", rbac.RoleRules{"users.get"}, nil); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	for _, v := range []rbac.AccountID{"user/1", "user/2"} {
+		if err := r.SetAccountRoles(ctx, v, rbac.AccountRoles{"admin"}, nil); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	if err := r.DeleteAccount(ctx, "user/1"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	roles, err := r.GetAccountRoles(ctx, "user/1")
+	if err != nil || len(roles) != 0 {
+		t.Fatal("the roles of the account should be deleted")
+	}
+
+	accounts, err := r.ListRoleAccounts(ctx, "admin", pagination.New("", 10))
+	if err != nil || len(accounts) != 1 || accounts[0] != "user/2" {
+		t.Fatal("only the deleted account should lose its roles")
+	}
+
+	if err := r.DeleteAccount(ctx, "user/unknown"); err != nil {
+		t.Fatal("deleting an unknown account should not return an error")
+	}
+
+	if err := r.SetAccountRoles(ctx, "user/1", rbac.AccountRoles{"admin"}, nil); err != nil {
+		t.Fatal("a deleted account should be usable again")
+	}
+}
+
+func testAnonymizeAuditEntries(t *testing.T, r rbac.Repository) {
+	ctx := context.Background()
+
+	createdAt := time.Now().UTC().Truncate(time.Second)
+	for _, v := range []rbac.AuditEntry{
+		{Actor: "user/1", Binding: rbac.AuditAccountRoles, Action: rbac.AuditAdd, AccountID: "user/2", Value: "admin", Old: []string{}, New: []string{"admin"}},
+		{Actor: "user/2", Binding: rbac.AuditAccountRoles, Action: rbac.AuditAdd, AccountID: "user/1", Value: "admin", Old: []string{}, New: []string{"admin"}},
+		{Actor: "user/2", Binding: rbac.AuditRoleRules, Action: rbac.AuditAdd, RoleID: "admin", Value: "users.get", Old: []string{}, New: []string{"users.get"}},
+	} {
+		v.CreatedAt = createdAt
+		if _, err := r.AddAuditEntry(ctx, v); err != nil {
+			t.Fatal("there should be no error")
+		}
+	}
+
+	if err := r.AnonymizeAuditEntries(ctx, "user/1", "erased/1"); err != nil {
+		t.Fatal("there should be no error")
+	}
+
+	entries, err := r.ListAuditEntries(ctx, rbac.AuditFilter{}, pagination.New("", 10))
+	if err != nil || len(entries) != 3 {
+		t.Fatal("the audit entries should be kept")
+	}
+
+	if entries[0].Actor != "erased/1" || entries[0].AccountID != "user/2" ||
+		entries[1].Actor != "user/2" || entries[1].AccountID != "erased/1" ||
+		entries[2].Actor != "user/2" || entries[2].RoleID != "admin" {
+		t.Fatal("only the anonymized account should be replaced")
+	}
+}
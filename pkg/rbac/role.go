@@ -16,3 +16,37 @@ func (r RoleRules) Contains(rule Rule) bool {
 
 	return false
 }
+
+// Strings returns the rules as strings
+func (r RoleRules) Strings() []string {
+	values := make([]string, 0)
+	for _, v := range r {
+		values = append(values, string(v))
+	}
+
+	return values
+}
+
+// RoleParents are the roles a role inherits its rules from
+type RoleParents []RoleID
+
+// Contains checks whether a role is a parent
+func (r RoleParents) Contains(roleID RoleID) bool {
+	for _, v := range r {
+		if roleID == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Strings returns the parents as strings
+func (r RoleParents) Strings() []string {
+	values := make([]string, 0)
+	for _, v := range r {
+		values = append(values, string(v))
+	}
+
+	return values
+}
@@ -20,3 +20,18 @@ func TestRoleRulesContains(t *testing.T) {
 		t.Fatal("this rule does not exist")
 	}
 }
+
+func TestRoleParentsContains(t *testing.T) {
+	parents := rbac.RoleParents{
+		"parent1",
+		"parent2",
+	}
+
+	if !parents.Contains("parent1") {
+		t.Fatal("this role is a parent")
+	}
+
+	if parents.Contains("parent3") {
+		t.Fatal("this role is no parent")
+	}
+}
@@ -1,4 +1,31 @@
 package rbac
 
+import "strings"
+
 // Rule of the RBAC system
 type Rule string
+
+// Wildcard rule matching all rules
+const Wildcard Rule = "*"
+
+// Matches checks whether the rule covers a given rule.
+//
+// A rule ending with ".*" matches all rules of its namespace, e.g.
+// "chat.*" matches "chat.send" and "chat.channels.join". The
+// wildcard rule "*" matches all rules.
+func (r Rule) Matches(rule Rule) bool {
+	if r == rule || r == Wildcard {
+		return true
+	}
+
+	if r.IsWildcard() {
+		return strings.HasPrefix(string(rule), string(r[:len(r)-1]))
+	}
+
+	return false
+}
+
+// IsWildcard checks whether the rule matches more than a single rule
+func (r Rule) IsWildcard() bool {
+	return r == Wildcard || strings.HasSuffix(string(r), ".*")
+}
@@ -0,0 +1,29 @@
+package rbac_test
+
+import (
+	"testing"
+
+	"github.com/51st-state/api/pkg/rbac"
+)
+
+func TestRuleMatches(t *testing.T) {
+	if !rbac.Rule("chat.send").Matches("chat.send") {
+		t.Fatal("equal rules match")
+	}
+
+	if rbac.Rule("chat.send").Matches("chat.sendall") {
+		t.Fatal("different rules do not match")
+	}
+
+	if !rbac.Wildcard.Matches("chat.send") {
+		t.Fatal("the wildcard matches all rules")
+	}
+
+	if !rbac.Rule("chat.*").Matches("chat.send") || !rbac.Rule("chat.*").Matches("chat.channels.join") {
+		t.Fatal("a namespace wildcard matches all rules of the namespace")
+	}
+
+	if rbac.Rule("chat.*").Matches("chatter.send") || rbac.Rule("chat.*").Matches("chat") {
+		t.Fatal("a namespace wildcard does not match rules outside of the namespace")
+	}
+}
@@ -0,0 +1,21 @@
+package rbac
+
+// rules enforced by the rbac service
+const (
+	ruleRulesGet         Rule = "rbac.rules.get"
+	ruleRulesList        Rule = "rbac.rules.list"
+	ruleRulesRolesList   Rule = "rbac.rules.roles.list"
+	ruleRolesList        Rule = "rbac.roles.list"
+	ruleRolesAccountList Rule = "rbac.roles.accounts.list"
+	ruleAuditList        Rule = "rbac.audit.list"
+)
+
+// Rules enforced by the rbac service
+var Rules = RuleCatalog{
+	{Rule: ruleRulesGet, Description: "List the catalog of all registered rules", Service: "rbac"},
+	{Rule: ruleRulesList, Description: "List all rules bound to roles", Service: "rbac"},
+	{Rule: ruleRulesRolesList, Description: "List the roles granting a rule", Service: "rbac"},
+	{Rule: ruleRolesList, Description: "List all roles known to the rbac system", Service: "rbac"},
+	{Rule: ruleRolesAccountList, Description: "List the accounts holding a role", Service: "rbac"},
+	{Rule: ruleAuditList, Description: "List the audit trail of changed role and account bindings", Service: "rbac"},
+}
@@ -0,0 +1,291 @@
+package rbac
+
+import (
+	"context"
+	"crypto/rsa"
+	"net/http"
+
+	"github.com/51st-state/api/pkg/api/endpoint"
+	"github.com/51st-state/api/pkg/encode"
+	"github.com/51st-state/api/pkg/pagination"
+	"github.com/51st-state/api/pkg/problems"
+	"github.com/51st-state/api/pkg/token"
+	"github.com/go-chi/chi"
+	"go.uber.org/zap"
+)
+
+var (
+	errMissingRule            = problems.New("missing rule", "at least one rule has to be given", http.StatusBadRequest)
+	errInsufficientPermission = problems.New("insufficient permissions", "the account is not allowed to access this resource", http.StatusForbidden)
+)
+
+func accountIDFromContext(ctx context.Context) (AccountID, error) {
+	tok, err := token.FromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return AccountID(tok.Data().User.String()), nil
+}
+
+// newRulecheck middleware to check whether the token has access to a rule.
+// The rbac middleware package can not be used here as it depends on this package.
+func newRulecheck(c Control, rule Rule) endpoint.MiddlewareFunc {
+	return func(ctx context.Context, r *http.Request) (context.Context, error) {
+		accountID, err := accountIDFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		allowed, err := c.IsAccountAllowed(ctx, accountID, rule)
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			return nil, errInsufficientPermission
+		}
+
+		return ctx, nil
+	}
+}
+
+// MakeGetOwnPermissionsEndpoint for the rbac service
+// API-Path: GET /rbac/me/permissions
+func MakeGetOwnPermissionsEndpoint(l *zap.Logger, c Control, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		accountID, err := accountIDFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		bindings, err := c.GetAccountBindings(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+
+		denyBindings, err := c.GetAccountDenyBindings(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+
+		return struct {
+			AccountID    AccountID    `json:"account_id"`
+			Roles        AccountRoles `json:"roles"`
+			Rules        RoleRules    `json:"rules"`
+			DenyRules    RoleRules    `json:"deny_rules"`
+			Bindings     Bindings     `json:"bindings"`
+			DenyBindings Bindings     `json:"deny_bindings"`
+		}{
+			accountID,
+			bindings.Roles(),
+			bindings.Rules(),
+			denyBindings.Rules(),
+			bindings,
+			denyBindings,
+		}, nil
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeCheckOwnPermissionsEndpoint for the rbac service
+// API-Path: GET /rbac/me/permissions/check?rule={rule}&rule={rule}
+func MakeCheckOwnPermissionsEndpoint(l *zap.Logger, c Control, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		accountID, err := accountIDFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		rules := make([]Rule, 0)
+		for _, v := range r.URL.Query()["rule"] {
+			rules = append(rules, Rule(v))
+		}
+
+		if len(rules) == 0 {
+			return nil, errMissingRule
+		}
+
+		return c.CheckMany(ctx, accountID, rules)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeExplainOwnPermissionEndpoint for the rbac service
+// API-Path: GET /rbac/me/permissions/explain?rule={rule}
+func MakeExplainOwnPermissionEndpoint(l *zap.Logger, c Control, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		accountID, err := accountIDFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		rule := Rule(r.URL.Query().Get("rule"))
+		if rule == "" {
+			return nil, errMissingRule
+		}
+
+		return c.Explain(ctx, accountID, rule)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		HandlerFunc(l)
+}
+
+// MakeGetRulesEndpoint for the rbac service
+// API-Path: GET /rbac/rules
+func MakeGetRulesEndpoint(l *zap.Logger, c Control, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		return c.GetRuleCatalog(ctx)
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(newRulecheck(c, ruleRulesGet)).
+		HandlerFunc(l)
+}
+
+// MakeListRolesEndpoint for the rbac service
+// API-Path: GET /rbac/roles?cursor={cursor}&limit={limit}
+func MakeListRolesEndpoint(l *zap.Logger, c Control, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		page, err := pagination.FromRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		roleIDs, err := c.ListRoles(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+
+		return roleIDList(page, roleIDs), nil
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(newRulecheck(c, ruleRolesList)).
+		HandlerFunc(l)
+}
+
+// MakeListRoleAccountsEndpoint for the rbac service
+// API-Path: GET /rbac/roles/{id}/accounts?cursor={cursor}&limit={limit}
+func MakeListRoleAccountsEndpoint(l *zap.Logger, c Control, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		page, err := pagination.FromRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		accountIDs, err := c.ListRoleAccounts(ctx, RoleID(chi.URLParam(r, "id")), page)
+		if err != nil {
+			return nil, err
+		}
+
+		var last string
+		if len(accountIDs) > 0 {
+			last = string(accountIDs[len(accountIDs)-1])
+		}
+
+		return &pagination.List{
+			Items: accountIDs,
+			Next:  page.Next(len(accountIDs), last),
+		}, nil
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(newRulecheck(c, ruleRolesAccountList)).
+		HandlerFunc(l)
+}
+
+// MakeListRulesEndpoint for the rbac service
+// API-Path: GET /rbac/rules/bound?cursor={cursor}&limit={limit}
+func MakeListRulesEndpoint(l *zap.Logger, c Control, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		page, err := pagination.FromRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		rules, err := c.ListRules(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+
+		var last string
+		if len(rules) > 0 {
+			last = string(rules[len(rules)-1])
+		}
+
+		return &pagination.List{
+			Items: rules,
+			Next:  page.Next(len(rules), last),
+		}, nil
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(newRulecheck(c, ruleRulesList)).
+		HandlerFunc(l)
+}
+
+// MakeListRuleRolesEndpoint for the rbac service
+// API-Path: GET /rbac/rules/{rule}/roles?cursor={cursor}&limit={limit}
+func MakeListRuleRolesEndpoint(l *zap.Logger, c Control, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		page, err := pagination.FromRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		roleIDs, err := c.ListRuleRoles(ctx, Rule(chi.URLParam(r, "rule")), page)
+		if err != nil {
+			return nil, err
+		}
+
+		return roleIDList(page, roleIDs), nil
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(newRulecheck(c, ruleRulesRolesList)).
+		HandlerFunc(l)
+}
+
+// MakeListAuditEntriesEndpoint for the rbac service
+// API-Path: GET /rbac/audit?account={account}&role={role}&actor={actor}&cursor={cursor}&limit={limit}
+func MakeListAuditEntriesEndpoint(l *zap.Logger, c Control, e encode.Encoder, pubKey rsa.PublicKey) http.HandlerFunc {
+	return endpoint.New(e, func(ctx context.Context, r *http.Request) (interface{}, error) {
+		page, err := pagination.FromRequest(r)
+		if err != nil {
+			return nil, err
+		}
+
+		query := r.URL.Query()
+		entries, err := c.ListAuditEntries(ctx, AuditFilter{
+			AccountID: AccountID(query.Get("account")),
+			RoleID:    RoleID(query.Get("role")),
+			Actor:     AccountID(query.Get("actor")),
+		}, page)
+		if err != nil {
+			return nil, err
+		}
+
+		var last string
+		if len(entries) > 0 {
+			last = entries[len(entries)-1].ID
+		}
+
+		return &pagination.List{
+			Items: entries,
+			Next:  page.Next(len(entries), last),
+		}, nil
+	}).
+		WithBefore(token.NewMiddleware(pubKey)).
+		WithBefore(newRulecheck(c, ruleAuditList)).
+		HandlerFunc(l)
+}
+
+func roleIDList(page pagination.Page, roleIDs []RoleID) *pagination.List {
+	var last string
+	if len(roleIDs) > 0 {
+		last = string(roleIDs[len(roleIDs)-1])
+	}
+
+	return &pagination.List{
+		Items: roleIDs,
+		Next:  page.Next(len(roleIDs), last),
+	}
+}
@@ -0,0 +1,70 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	// the postgres driver is used to connect to cockroachdb
+	_ "github.com/lib/pq"
+)
+
+// CockroachDBURLEnv is the environment variable containing the url of the
+// cockroachdb instance used by the tests of the sql repositories,
+// e.g. postgres://root@localhost:26257?sslmode=disable
+const CockroachDBURLEnv = "COCKROACHDB_TEST_URL"
+
+// NewCockroachDB creates an empty database containing the given schema.
+// The database is dropped when the test finishes. If no cockroachdb instance
+// is configured the test is skipped.
+func NewCockroachDB(t *testing.T, createSchema func(context.Context, *sql.DB) error) *sql.DB {
+	t.Helper()
+
+	rawURL := os.Getenv(CockroachDBURLEnv)
+	if rawURL == "" {
+		t.Skipf("%s is not set", CockroachDBURLEnv)
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	admin, err := sql.Open("postgres", rawURL)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rand, err := uuid.NewRandom()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	name := "test_" + strings.Replace(rand.String(), "-", "_", -1)
+	if _, err := admin.Exec("CREATE DATABASE " + name); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	u.Path = "/" + name
+	db, err := sql.Open("postgres", u.String())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	t.Cleanup(func() {
+		db.Close()
+		admin.Exec("DROP DATABASE " + name + " CASCADE")
+		admin.Close()
+	})
+
+	if err := createSchema(context.Background(), db); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return db
+}